@@ -181,5 +181,32 @@ var ErrGetWaitingManagedKeys = errors.New("error getting the waiting managed key
 // ErrGetWaitingEpochsLeftForPublicKey signals that an error occurred while getting the waiting epochs left for public key
 var ErrGetWaitingEpochsLeftForPublicKey = errors.New("error getting the waiting epochs left for public key")
 
+// ErrAddManagedKey signals that an error occurred while adding a managed key
+var ErrAddManagedKey = errors.New("error adding the managed key")
+
+// ErrRemoveManagedKey signals that an error occurred while removing a managed key
+var ErrRemoveManagedKey = errors.New("error removing the managed key")
+
 // ErrRecursiveRelayedTxIsNotAllowed signals that recursive relayed tx is not allowed
 var ErrRecursiveRelayedTxIsNotAllowed = errors.New("recursive relayed tx is not allowed")
+
+// ErrGetOutGoingOperations signals an error happening when trying to fetch outgoing operations
+var ErrGetOutGoingOperations = errors.New("getting outgoing operations failed")
+
+// ErrGetLastCrossNotarizedHeader signals an error happening when trying to fetch the last cross notarized header
+var ErrGetLastCrossNotarizedHeader = errors.New("getting last cross notarized header failed")
+
+// ErrGetIncomingSCRs signals an error happening when trying to fetch incoming smart contract results
+var ErrGetIncomingSCRs = errors.New("getting incoming smart contract results failed")
+
+// ErrRequeueDeadLetteredOutGoingOperations signals an error happening when trying to re-queue dead-lettered outgoing operations
+var ErrRequeueDeadLetteredOutGoingOperations = errors.New("re-queueing dead-lettered outgoing operations failed")
+
+// ErrDropDeadLetteredOutGoingOperations signals an error happening when trying to drop dead-lettered outgoing operations
+var ErrDropDeadLetteredOutGoingOperations = errors.New("dropping dead-lettered outgoing operations failed")
+
+// ErrUnauthorizedRequest signals that a request on an admin endpoint did not provide a valid admin token
+var ErrUnauthorizedRequest = errors.New("unauthorized request")
+
+// ErrGetOutGoingOperationProof signals an error happening when trying to fetch the proof of an outgoing operation
+var ErrGetOutGoingOperationProof = errors.New("getting outgoing operation proof failed")
@@ -16,6 +16,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -26,11 +27,13 @@ var log = logger.GetOrCreate("api/gin")
 
 const prometheusMetricsRoute = "/debug/metrics/prometheus"
 
-// ArgsNewWebServer holds the arguments needed to create a new instance of webServer
+// ArgsNewWebServer holds the arguments needed to create a new instance of webServer. The sovereign group is only
+// registered if the ChainRunType is sovereign.
 type ArgsNewWebServer struct {
 	Facade          shared.FacadeHandler
 	ApiConfig       config.ApiRoutesConfig
 	AntiFloodConfig config.WebServerAntifloodConfig
+	ChainRunType    common.ChainRunType
 }
 
 type webServer struct {
@@ -38,6 +41,7 @@ type webServer struct {
 	facade          shared.FacadeHandler
 	apiConfig       config.ApiRoutesConfig
 	antiFloodConfig config.WebServerAntifloodConfig
+	chainRunType    common.ChainRunType
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
 	cancelFunc      func()
@@ -54,6 +58,7 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 		facade:          args.Facade,
 		antiFloodConfig: args.AntiFloodConfig,
 		apiConfig:       args.ApiConfig,
+		chainRunType:    args.ChainRunType,
 	}, nil
 }
 
@@ -192,6 +197,14 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["proof"] = proofGroup
 
+	if ws.chainRunType == common.ChainRunTypeSovereign {
+		sovereignGroup, errSovereign := groups.NewSovereignGroup(ws.facade)
+		if errSovereign != nil {
+			return errSovereign
+		}
+		groupsMap["sovereign"] = sovereignGroup
+	}
+
 	transactionGroup, err := groups.NewTransactionGroup(ws.facade)
 	if err != nil {
 		return err
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/testscommon/api"
@@ -135,6 +136,35 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 	})
 }
 
+func TestWebServer_CreateGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("regular chain should not register the sovereign group", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.ChainRunType = common.ChainRunTypeRegular
+		ws, _ := NewGinWebServerHandler(args)
+
+		err := ws.createGroups()
+		require.Nil(t, err)
+		require.NotContains(t, ws.groups, "sovereign")
+		require.Contains(t, ws.groups, "transaction")
+	})
+	t.Run("sovereign chain should register the sovereign group", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.ChainRunType = common.ChainRunTypeSovereign
+		ws, _ := NewGinWebServerHandler(args)
+
+		err := ws.createGroups()
+		require.Nil(t, err)
+		require.Contains(t, ws.groups, "sovereign")
+		require.Contains(t, ws.groups, "transaction")
+	})
+}
+
 func TestWebServer_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -9,8 +9,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
@@ -33,6 +35,9 @@ const (
 	eligibleManagedKeys       = "/managed-keys/eligible"
 	waitingManagedKeys        = "/managed-keys/waiting"
 	epochsLeftInWaiting       = "/waiting-epochs-left/:key"
+	equivocationProofsPath    = "/equivocation-proofs"
+	addManagedKeyPath         = "/managed-keys/add"
+	removeManagedKeyPath      = "/managed-keys/remove"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -49,6 +54,10 @@ type nodeFacadeHandler interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	GetEquivocationProofs() []*equivocation.Proof
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKey string) error
+	IsAdminTokenValid(token string) bool
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +67,16 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// AddManagedKeyRequest represents the structure on which user input for adding a managed key will validate against
+type AddManagedKeyRequest struct {
+	PrivateKey string `json:"privateKey"`
+}
+
+// RemoveManagedKeyRequest represents the structure on which user input for removing a managed key will validate against
+type RemoveManagedKeyRequest struct {
+	PublicKey string `json:"publicKey"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -151,6 +170,33 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.waitingEpochsLeft,
 		},
+		{
+			Path:    equivocationProofsPath,
+			Method:  http.MethodGet,
+			Handler: ng.equivocationProofs,
+		},
+		{
+			Path:    addManagedKeyPath,
+			Method:  http.MethodPost,
+			Handler: ng.addManagedKey,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateAdminAuthenticatorFromFacade(facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    removeManagedKeyPath,
+			Method:  http.MethodPost,
+			Handler: ng.removeManagedKey,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateAdminAuthenticatorFromFacade(facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -479,6 +525,48 @@ func (ng *nodeGroup) waitingEpochsLeft(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochsLeft": epochsLeft})
 }
 
+// equivocationProofs returns the proofs of the validators which sent conflicting consensus messages, as detected by the node
+func (ng *nodeGroup) equivocationProofs(c *gin.Context) {
+	proofs := ng.getFacade().GetEquivocationProofs()
+	shared.RespondWithSuccess(c, gin.H{"proofs": proofs})
+}
+
+// addManagedKey schedules the addition of a managed key, which becomes effective at the start of the next round
+func (ng *nodeGroup) addManagedKey(c *gin.Context) {
+	var request = AddManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	publicKey, err := ng.getFacade().AddManagedKey(request.PrivateKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrAddManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": publicKey})
+}
+
+// removeManagedKey schedules the removal of a managed key, which becomes effective at the start of the next round
+func (ng *nodeGroup) removeManagedKey(c *gin.Context) {
+	var request = RemoveManagedKeyRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	err = ng.getFacade().RemoveManagedKey(request.PublicKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrRemoveManagedKey, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"publicKey": request.PublicKey})
+}
+
 func (ng *nodeGroup) getFacade() nodeFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
@@ -20,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
@@ -109,6 +110,20 @@ type waitingEpochsLeftResponse struct {
 	generalResponse
 }
 
+type equivocationProofsResponse struct {
+	Data struct {
+		Proofs []*equivocation.Proof `json:"proofs"`
+	} `json:"data"`
+	generalResponse
+}
+
+type managedKeyChangeResponse struct {
+	Data struct {
+		PublicKey string `json:"publicKey"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -1057,6 +1072,299 @@ func TestNodeGroup_IsInterfaceNil(t *testing.T) {
 	require.False(t, nodeGroup.IsInterfaceNil())
 }
 
+func TestNodeGroup_EquivocationProofs(t *testing.T) {
+	t.Parallel()
+
+	providedProofs := []*equivocation.Proof{
+		{
+			Type:            equivocation.DoubleSignature,
+			PubKey:          "pk1",
+			Round:           10,
+			FirstMessage:    &equivocation.SignedMessage{HeaderHash: "hash1"},
+			SecondMessage:   &equivocation.SignedMessage{HeaderHash: "hash2"},
+			TransactionData: "submitEquivocationProof@pk1",
+		},
+	}
+	facade := mock.FacadeStub{
+		GetEquivocationProofsCalled: func() []*equivocation.Proof {
+			return providedProofs
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/equivocation-proofs", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &equivocationProofsResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, providedProofs, response.Data.Proofs)
+}
+
+func TestNodeGroup_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedToken := "admin token"
+	providedPrivateKey := "private key"
+	providedPublicKey := "public key"
+	isAdminTokenValid := func(token string) bool {
+		return token == providedToken
+	}
+	addManagedKeyRequest := func(body string, authorization string) *http.Request {
+		req, _ := http.NewRequest("POST", "/node/managed-keys/add", bytes.NewBuffer([]byte(body)))
+		if len(authorization) > 0 {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		return req
+	}
+
+	t.Run("missing admin token should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+				require.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, addManagedKeyRequest(`{"privateKey":"private key"}`, ""))
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorizedRequest.Error(), response.Error)
+	})
+	t.Run("wrong admin token should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+				require.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, addManagedKeyRequest(`{"privateKey":"private key"}`, "Bearer wrong token"))
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+		resp = httptest.NewRecorder()
+		ws.ServeHTTP(resp, addManagedKeyRequest(`{"privateKey":"private key"}`, providedToken))
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, addManagedKeyRequest("invalid", "Bearer "+providedToken))
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+				return "", expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, addManagedKeyRequest(`{"privateKey":"private key"}`, "Bearer "+providedToken))
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrAddManagedKey.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+				assert.Equal(t, providedPrivateKey, privateKeyHex)
+				return providedPublicKey, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, addManagedKeyRequest(`{"privateKey":"private key"}`, "Bearer "+providedToken))
+
+		response := &managedKeyChangeResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedPublicKey, response.Data.PublicKey)
+	})
+}
+
+func TestNodeGroup_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedToken := "admin token"
+	providedPublicKey := "public key"
+	isAdminTokenValid := func(token string) bool {
+		return token == providedToken
+	}
+	removeManagedKeyRequest := func(body string, authorization string) *http.Request {
+		req, _ := http.NewRequest("POST", "/node/managed-keys/remove", bytes.NewBuffer([]byte(body)))
+		if len(authorization) > 0 {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		return req
+	}
+
+	t.Run("wrong admin token should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			RemoveManagedKeyCalled: func(publicKey string) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, removeManagedKeyRequest(`{"publicKey":"public key"}`, "Bearer wrong token"))
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorizedRequest.Error(), response.Error)
+	})
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, removeManagedKeyRequest("invalid", "Bearer "+providedToken))
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			RemoveManagedKeyCalled: func(publicKey string) error {
+				return expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, removeManagedKeyRequest(`{"publicKey":"public key"}`, "Bearer "+providedToken))
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrRemoveManagedKey.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			RemoveManagedKeyCalled: func(publicKey string) error {
+				assert.Equal(t, providedPublicKey, publicKey)
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, removeManagedKeyRequest(`{"publicKey":"public key"}`, "Bearer "+providedToken))
+
+		response := &managedKeyChangeResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedPublicKey, response.Data.PublicKey)
+	})
+}
+
 func loadResponseAsString(rsp io.Reader, response *statusResponse) {
 	buff, err := io.ReadAll(rsp)
 	if err != nil {
@@ -1087,6 +1395,9 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/managed-keys/eligible", Open: true},
 					{Name: "/managed-keys/waiting", Open: true},
 					{Name: "/waiting-epochs-left/:key", Open: true},
+					{Name: "/equivocation-proofs", Open: true},
+					{Name: "/managed-keys/add", Open: true},
+					{Name: "/managed-keys/remove", Open: true},
 				},
 			},
 		},
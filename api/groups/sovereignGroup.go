@@ -0,0 +1,337 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const (
+	getUnconfirmedOutGoingOperationsEndpoint      = "/sovereign/outgoing-operations/unconfirmed"
+	getOutGoingOperationsEndpoint                 = "/sovereign/outgoing-operations/:hash"
+	getLastCrossNotarizedIncomingHeaderEndpoint   = "/sovereign/incoming-chains/last-notarized-header"
+	getIncomingSCRsEndpoint                       = "/sovereign/incoming-scrs/:txhash"
+	getDeadLetteredOutGoingOperationsEndpoint     = "/sovereign/outgoing-operations/dead-lettered"
+	requeueDeadLetteredOutGoingOperationsEndpoint = "/sovereign/outgoing-operations/dead-lettered/:hash/requeue"
+	dropDeadLetteredOutGoingOperationsEndpoint    = "/sovereign/outgoing-operations/dead-lettered/:hash"
+	getBridgePauseStateEndpoint                   = "/sovereign/bridge/pause-state"
+	getOutGoingOperationProofEndpoint             = "/sovereign/outgoing-operations/:hash/proof"
+	getUnconfirmedOutGoingOperationsPath          = "/outgoing-operations/unconfirmed"
+	getOutGoingOperationsPath                     = "/outgoing-operations/:hash"
+	getLastCrossNotarizedIncomingHeaderPath       = "/incoming-chains/last-notarized-header"
+	getIncomingSCRsPath                           = "/incoming-scrs/:txhash"
+	getDeadLetteredOutGoingOperationsPath         = "/outgoing-operations/dead-lettered"
+	requeueDeadLetteredOutGoingOperationsPath     = "/outgoing-operations/dead-lettered/:hash/requeue"
+	dropDeadLetteredOutGoingOperationsPath        = "/outgoing-operations/dead-lettered/:hash"
+	getBridgePauseStatePath                       = "/bridge/pause-state"
+	getOutGoingOperationProofPath                 = "/outgoing-operations/:hash/proof"
+
+	urlParamChainID = "chainID"
+)
+
+// sovereignFacadeHandler defines the methods to be implemented by a facade for sovereign bridge requests
+type sovereignFacadeHandler interface {
+	GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error)
+	GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error)
+	GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	RequeueDeadLetteredOutGoingOperations(hash string) error
+	DropDeadLetteredOutGoingOperations(hash string) error
+	IsAdminTokenValid(token string) bool
+	GetBridgePauseState() *common.BridgePauseStateAPIResponse
+	GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error)
+	GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+type sovereignGroup struct {
+	*baseGroup
+	facade    sovereignFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewSovereignGroup returns a new instance of sovereignGroup
+func NewSovereignGroup(facade sovereignFacadeHandler) (*sovereignGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for sovereign group", errors.ErrNilFacadeHandler)
+	}
+
+	sg := &sovereignGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getUnconfirmedOutGoingOperationsPath,
+			Method:  http.MethodGet,
+			Handler: sg.getUnconfirmedOutGoingOperations,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getUnconfirmedOutGoingOperationsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getOutGoingOperationsPath,
+			Method:  http.MethodGet,
+			Handler: sg.getOutGoingOperations,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getOutGoingOperationsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getLastCrossNotarizedIncomingHeaderPath,
+			Method:  http.MethodGet,
+			Handler: sg.getLastCrossNotarizedIncomingHeader,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getLastCrossNotarizedIncomingHeaderEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getIncomingSCRsPath,
+			Method:  http.MethodGet,
+			Handler: sg.getIncomingSCRs,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getIncomingSCRsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getDeadLetteredOutGoingOperationsPath,
+			Method:  http.MethodGet,
+			Handler: sg.getDeadLetteredOutGoingOperations,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getDeadLetteredOutGoingOperationsEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    requeueDeadLetteredOutGoingOperationsPath,
+			Method:  http.MethodPost,
+			Handler: sg.requeueDeadLetteredOutGoingOperations,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(requeueDeadLetteredOutGoingOperationsEndpoint, facade),
+					Position:   shared.Before,
+				},
+				{
+					Middleware: middleware.CreateAdminAuthenticatorFromFacade(facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    dropDeadLetteredOutGoingOperationsPath,
+			Method:  http.MethodDelete,
+			Handler: sg.dropDeadLetteredOutGoingOperations,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(dropDeadLetteredOutGoingOperationsEndpoint, facade),
+					Position:   shared.Before,
+				},
+				{
+					Middleware: middleware.CreateAdminAuthenticatorFromFacade(facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getBridgePauseStatePath,
+			Method:  http.MethodGet,
+			Handler: sg.getBridgePauseState,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getBridgePauseStateEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getOutGoingOperationProofPath,
+			Method:  http.MethodGet,
+			Handler: sg.getOutGoingOperationProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getOutGoingOperationProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// getUnconfirmedOutGoingOperations returns all the outgoing operations batches not yet confirmed by the main chain
+func (sg *sovereignGroup) getUnconfirmedOutGoingOperations(c *gin.Context) {
+	batches := sg.getFacade().GetUnconfirmedOutGoingOperations()
+
+	shared.RespondWithSuccess(c, gin.H{"batches": batches})
+}
+
+// getOutGoingOperations returns the outgoing operations batch by its hash or by one of its operations hash
+func (sg *sovereignGroup) getOutGoingOperations(c *gin.Context) {
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+		return
+	}
+
+	batch, err := sg.getFacade().GetOutGoingOperations(hash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetOutGoingOperations, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"batch": batch})
+}
+
+// getLastCrossNotarizedIncomingHeader returns the last header of an incoming chain notarized by the sovereign chain. The
+// incoming chain is provided by its chain ID, while no chain ID stands for the main chain.
+func (sg *sovereignGroup) getLastCrossNotarizedIncomingHeader(c *gin.Context) {
+	chainID := c.Request.URL.Query().Get(urlParamChainID)
+	header, err := sg.getFacade().GetLastCrossNotarizedIncomingHeader(chainID)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetLastCrossNotarizedHeader, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"header": header})
+}
+
+// getIncomingSCRs returns the incoming smart contract results generated by a main chain transaction
+func (sg *sovereignGroup) getIncomingSCRs(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+		return
+	}
+
+	scrs, err := sg.getFacade().GetIncomingSCRsByMainChainTxHash(txHash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetIncomingSCRs, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"scrs": scrs})
+}
+
+// getDeadLetteredOutGoingOperations returns all the outgoing operations batches not confirmed by the main chain after
+// the max send attempts
+func (sg *sovereignGroup) getDeadLetteredOutGoingOperations(c *gin.Context) {
+	batches := sg.getFacade().GetDeadLetteredOutGoingOperations()
+
+	shared.RespondWithSuccess(c, gin.H{"batches": batches})
+}
+
+// requeueDeadLetteredOutGoingOperations moves a dead-lettered outgoing operations batch back to the pool, to be resent
+// by the next leader
+func (sg *sovereignGroup) requeueDeadLetteredOutGoingOperations(c *gin.Context) {
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+		return
+	}
+
+	err := sg.getFacade().RequeueDeadLetteredOutGoingOperations(hash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrRequeueDeadLetteredOutGoingOperations, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"hash": hash})
+}
+
+// dropDeadLetteredOutGoingOperations permanently removes a dead-lettered outgoing operations batch
+func (sg *sovereignGroup) dropDeadLetteredOutGoingOperations(c *gin.Context) {
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+		return
+	}
+
+	err := sg.getFacade().DropDeadLetteredOutGoingOperations(hash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrDropDeadLetteredOutGoingOperations, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"hash": hash})
+}
+
+// getBridgePauseState returns the current pause state of the incoming and outgoing bridge
+func (sg *sovereignGroup) getBridgePauseState(c *gin.Context) {
+	state := sg.getFacade().GetBridgePauseState()
+
+	shared.RespondWithSuccess(c, gin.H{"state": state})
+}
+
+// getOutGoingOperationProof returns the merkle inclusion proof of an outgoing operation, along with its sovereign header
+// and the merkle root of its batch
+func (sg *sovereignGroup) getOutGoingOperationProof(c *gin.Context) {
+	hash := c.Param("hash")
+	if hash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyTxHash)
+		return
+	}
+
+	proof, err := sg.getFacade().GetOutGoingOperationProof(hash)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetOutGoingOperationProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"proof": proof})
+}
+
+func (sg *sovereignGroup) getFacade() sovereignFacadeHandler {
+	sg.mutFacade.RLock()
+	defer sg.mutFacade.RUnlock()
+
+	return sg.facade
+}
+
+// UpdateFacade will update the facade
+func (sg *sovereignGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(sovereignFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	sg.mutFacade.Lock()
+	sg.facade = castFacade
+	sg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *sovereignGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,702 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/groups"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type outGoingOperationsBatchesResponseData struct {
+	Batches []*common.OutGoingOperationsBatchAPIResponse `json:"batches"`
+}
+
+type outGoingOperationsBatchesResponse struct {
+	Data  outGoingOperationsBatchesResponseData `json:"data"`
+	Error string                                `json:"error"`
+	Code  string                                `json:"code"`
+}
+
+type outGoingOperationsBatchResponseData struct {
+	Batch *common.OutGoingOperationsBatchAPIResponse `json:"batch"`
+}
+
+type outGoingOperationsBatchResponse struct {
+	Data  outGoingOperationsBatchResponseData `json:"data"`
+	Error string                              `json:"error"`
+	Code  string                              `json:"code"`
+}
+
+type crossNotarizedHeaderResponseData struct {
+	Header *common.CrossNotarizedHeaderAPIResponse `json:"header"`
+}
+
+type crossNotarizedHeaderResponse struct {
+	Data  crossNotarizedHeaderResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+type incomingSCRsResponseData struct {
+	SCRs []*transaction.ApiSmartContractResult `json:"scrs"`
+}
+
+type incomingSCRsResponse struct {
+	Data  incomingSCRsResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+type bridgePauseStateResponseData struct {
+	State *common.BridgePauseStateAPIResponse `json:"state"`
+}
+
+type bridgePauseStateResponse struct {
+	Data  bridgePauseStateResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+type outGoingOperationProofResponseData struct {
+	Proof *common.OutGoingOperationProofAPIResponse `json:"proof"`
+}
+
+type outGoingOperationProofResponse struct {
+	Data  outGoingOperationProofResponseData `json:"data"`
+	Error string                             `json:"error"`
+	Code  string                             `json:"code"`
+}
+
+func TestNewSovereignGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		sg, err := groups.NewSovereignGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, sg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		sg, err := groups.NewSovereignGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, sg)
+	})
+}
+
+func TestSovereignGroup_getUnconfirmedOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	expectedBatches := []*common.OutGoingOperationsBatchAPIResponse{
+		{
+			Hash: "batchHash",
+			Operations: []*common.OutGoingOperationAPIResponse{
+				{
+					Hash: "opHash",
+					Data: "opData",
+				},
+			},
+			AggregatedSignature: "aggSig",
+			LeaderSignature:     "leaderSig",
+			Status:              "unconfirmed",
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetUnconfirmedOutGoingOperationsCalled: func() []*common.OutGoingOperationsBatchAPIResponse {
+			return expectedBatches
+		},
+	}
+
+	sovereignGroup, err := groups.NewSovereignGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/sovereign/outgoing-operations/unconfirmed", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := outGoingOperationsBatchesResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBatches, response.Data.Batches)
+}
+
+func TestSovereignGroup_getOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetOutGoingOperationsCalled: func(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/outgoing-operations/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetOutGoingOperations.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBatch := &common.OutGoingOperationsBatchAPIResponse{
+			Hash:   "aabb",
+			Status: "pending",
+		}
+		facade := &mock.FacadeStub{
+			GetOutGoingOperationsCalled: func(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+				assert.Equal(t, "aabb", hash)
+				return expectedBatch, nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/outgoing-operations/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := outGoingOperationsBatchResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedBatch, response.Data.Batch)
+	})
+}
+
+func TestSovereignGroup_getLastCrossNotarizedIncomingHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetLastCrossNotarizedIncomingHeaderCalled: func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/incoming-chains/last-notarized-header", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetLastCrossNotarizedHeader.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHeader := &common.CrossNotarizedHeaderAPIResponse{
+			Hash:      "headerHash",
+			Nonce:     10,
+			Round:     11,
+			Epoch:     2,
+			Timestamp: 1234,
+		}
+		facade := &mock.FacadeStub{
+			GetLastCrossNotarizedIncomingHeaderCalled: func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+				assert.Equal(t, "incomingChain", chainID)
+				return expectedHeader, nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/incoming-chains/last-notarized-header?chainID=incomingChain", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := crossNotarizedHeaderResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedHeader, response.Data.Header)
+	})
+}
+
+func TestSovereignGroup_getIncomingSCRs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetIncomingSCRsByMainChainTxHashCalled: func(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+				return nil, expectedErr
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/incoming-scrs/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetIncomingSCRs.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSCRs := []*transaction.ApiSmartContractResult{
+			{
+				Hash:           "scrHash",
+				OriginalTxHash: "aabb",
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetIncomingSCRsByMainChainTxHashCalled: func(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+				assert.Equal(t, "aabb", txHash)
+				return expectedSCRs, nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/incoming-scrs/aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := incomingSCRsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedSCRs, response.Data.SCRs)
+	})
+}
+
+func TestSovereignGroup_getDeadLetteredOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	expectedBatches := []*common.OutGoingOperationsBatchAPIResponse{
+		{
+			Hash:   "aabb",
+			Status: "dead-lettered",
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetDeadLetteredOutGoingOperationsCalled: func() []*common.OutGoingOperationsBatchAPIResponse {
+			return expectedBatches
+		},
+	}
+
+	sovereignGroup, err := groups.NewSovereignGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/sovereign/outgoing-operations/dead-lettered", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := outGoingOperationsBatchesResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBatches, response.Data.Batches)
+}
+
+const adminToken = "admin token"
+
+func isAdminTokenValid(token string) bool {
+	return token == adminToken
+}
+
+func TestSovereignGroup_requeueDeadLetteredOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid admin token should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			RequeueDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+				require.Fail(t, "should not have been called")
+				return nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/sovereign/outgoing-operations/dead-lettered/aabb/requeue", nil)
+		req.Header.Set("Authorization", "Bearer wrong token")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorizedRequest.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			RequeueDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+				return expectedErr
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/sovereign/outgoing-operations/dead-lettered/aabb/requeue", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrRequeueDeadLetteredOutGoingOperations.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			RequeueDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+				assert.Equal(t, "aabb", hash)
+				wasCalled = true
+				return nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/sovereign/outgoing-operations/dead-lettered/aabb/requeue", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestSovereignGroup_dropDeadLetteredOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid admin token should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			DropDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+				require.Fail(t, "should not have been called")
+				return nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("DELETE", "/sovereign/outgoing-operations/dead-lettered/aabb", nil)
+		req.Header.Set("Authorization", "Bearer wrong token")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, apiErrors.ErrUnauthorizedRequest.Error(), response.Error)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			DropDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+				return expectedErr
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("DELETE", "/sovereign/outgoing-operations/dead-lettered/aabb", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrDropDeadLetteredOutGoingOperations.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mock.FacadeStub{
+			IsAdminTokenValidCalled: isAdminTokenValid,
+			DropDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+				assert.Equal(t, "aabb", hash)
+				wasCalled = true
+				return nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("DELETE", "/sovereign/outgoing-operations/dead-lettered/aabb", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestSovereignGroup_getBridgePauseState(t *testing.T) {
+	t.Parallel()
+
+	expectedState := &common.BridgePauseStateAPIResponse{
+		IncomingPaused: true,
+		OutGoingPaused: false,
+	}
+	facade := &mock.FacadeStub{
+		GetBridgePauseStateCalled: func() *common.BridgePauseStateAPIResponse {
+			return expectedState
+		},
+	}
+
+	sovereignGroup, err := groups.NewSovereignGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/sovereign/bridge/pause-state", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := bridgePauseStateResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedState, response.Data.State)
+}
+
+func TestSovereignGroup_getOutGoingOperationProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mock.FacadeStub{
+			GetOutGoingOperationProofCalled: func(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/outgoing-operations/aabb/proof", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetOutGoingOperationProof.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedProof := &common.OutGoingOperationProofAPIResponse{
+			OperationHash:  "aabb",
+			HeaderHash:     "headerHash",
+			Header:         &block.SovereignChainHeader{Header: &block.Header{Nonce: 4}},
+			MerkleRoot:     "merkleRoot",
+			OperationIndex: 1,
+			Proof: []*common.OutGoingOperationProofStepAPIResponse{
+				{Hash: "sibling1", IsLeft: true},
+				{Hash: "sibling2", IsLeft: false},
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetOutGoingOperationProofCalled: func(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+				assert.Equal(t, "aabb", hash)
+				return expectedProof, nil
+			},
+		}
+
+		sovereignGroup, err := groups.NewSovereignGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/outgoing-operations/aabb/proof", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := outGoingOperationProofResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedProof, response.Data.Proof)
+	})
+}
+
+func TestSovereignGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignGroup, err := groups.NewSovereignGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = sovereignGroup.UpdateFacade(nil)
+		require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("cast failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignGroup, err := groups.NewSovereignGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		err = sovereignGroup.UpdateFacade("this is not a facade handler")
+		require.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignGroup, err := groups.NewSovereignGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		newFacade := &mock.FacadeStub{
+			GetLastCrossNotarizedIncomingHeaderCalled: func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+				return &common.CrossNotarizedHeaderAPIResponse{Hash: "newHash"}, nil
+			},
+		}
+		err = sovereignGroup.UpdateFacade(newFacade)
+		require.NoError(t, err)
+
+		ws := startWebServer(sovereignGroup, "sovereign", getSovereignRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/sovereign/incoming-chains/last-notarized-header", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := crossNotarizedHeaderResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "newHash", response.Data.Header.Hash)
+	})
+}
+
+func TestSovereignGroup_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	sovereignGroup, _ := groups.NewSovereignGroup(nil)
+	require.True(t, sovereignGroup.IsInterfaceNil())
+
+	sovereignGroup, _ = groups.NewSovereignGroup(&mock.FacadeStub{})
+	require.False(t, sovereignGroup.IsInterfaceNil())
+}
+
+func getSovereignRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"sovereign": {
+				Routes: []config.RouteConfig{
+					{Name: "/outgoing-operations/unconfirmed", Open: true},
+					{Name: "/outgoing-operations/:hash", Open: true},
+					{Name: "/incoming-chains/last-notarized-header", Open: true},
+					{Name: "/incoming-scrs/:txhash", Open: true},
+					{Name: "/outgoing-operations/dead-lettered", Open: true},
+					{Name: "/outgoing-operations/dead-lettered/:hash/requeue", Open: true},
+					{Name: "/outgoing-operations/dead-lettered/:hash", Open: true},
+					{Name: "/bridge/pause-state", Open: true},
+					{Name: "/outgoing-operations/:hash/proof", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type adminTokenValidator interface {
+	IsAdminTokenValid(token string) bool
+}
+
+// CreateAdminAuthenticatorFromFacade will create a middleware-type of handler to be used on the admin REST API end
+// points, which rejects the requests that do not provide the admin token as a bearer token
+func CreateAdminAuthenticatorFromFacade(facade interface{}) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		validator, ok := facade.(adminTokenValidator)
+		if !ok {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrInvalidAppContext.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		header := c.GetHeader(authorizationHeader)
+		isAuthorized := strings.HasPrefix(header, bearerPrefix) &&
+			validator.IsAdminTokenValid(strings.TrimPrefix(header, bearerPrefix))
+		if !isAuthorized {
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrUnauthorizedRequest.Error(),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
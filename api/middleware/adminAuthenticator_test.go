@@ -0,0 +1,74 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-go/api/middleware"
+	"github.com/multiversx/mx-chain-go/api/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func startNodeServerAdminAuthenticator(facade interface{}) *gin.Engine {
+	ws := gin.New()
+	ws.Use(middleware.CreateAdminAuthenticatorFromFacade(facade))
+	ws.Handle(http.MethodPost, "/admin", func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+
+	return ws
+}
+
+func makeAdminRequest(ws *gin.Engine, authorization string) int {
+	req, _ := http.NewRequest(http.MethodPost, "/admin", nil)
+	if len(authorization) > 0 {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestCreateAdminAuthenticatorFromFacade(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		IsAdminTokenValidCalled: func(token string) bool {
+			return token == "token"
+		},
+	}
+
+	t.Run("invalid facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator("not a facade")
+		assert.Equal(t, http.StatusInternalServerError, makeAdminRequest(ws, "Bearer token"))
+	})
+	t.Run("missing token should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator(facade)
+		assert.Equal(t, http.StatusUnauthorized, makeAdminRequest(ws, ""))
+	})
+	t.Run("token not sent as bearer token should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator(facade)
+		assert.Equal(t, http.StatusUnauthorized, makeAdminRequest(ws, "token"))
+	})
+	t.Run("invalid token should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator(facade)
+		assert.Equal(t, http.StatusUnauthorized, makeAdminRequest(ws, "Bearer wrong token"))
+	})
+	t.Run("valid token should execute", func(t *testing.T) {
+		t.Parallel()
+
+		ws := startNodeServerAdminAuthenticator(facade)
+		assert.Equal(t, http.StatusOK, makeAdminRequest(ws, "Bearer token"))
+	})
+}
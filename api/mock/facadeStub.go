@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
@@ -95,9 +96,22 @@ type FacadeStub struct {
 	GetEligibleManagedKeysCalled                func() ([]string, error)
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
+	GetEquivocationProofsCalled                 func() []*equivocation.Proof
+	AddManagedKeyCalled                         func(privateKeyHex string) (string, error)
+	RemoveManagedKeyCalled                      func(publicKey string) error
 	P2PPrometheusMetricsEnabledCalled           func() bool
 	AuctionListHandler                          func() ([]*common.AuctionListValidatorAPIResponse, error)
 	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetIncomingSCRsByMainChainTxHashCalled      func(txHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetUnconfirmedOutGoingOperationsCalled      func() []*common.OutGoingOperationsBatchAPIResponse
+	GetOutGoingOperationsCalled                 func(hash string) (*common.OutGoingOperationsBatchAPIResponse, error)
+	GetLastCrossNotarizedIncomingHeaderCalled   func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error)
+	GetDeadLetteredOutGoingOperationsCalled     func() []*common.OutGoingOperationsBatchAPIResponse
+	RequeueDeadLetteredOutGoingOperationsCalled func(hash string) error
+	DropDeadLetteredOutGoingOperationsCalled    func(hash string) error
+	IsAdminTokenValidCalled                     func(token string) bool
+	GetBridgePauseStateCalled                   func() *common.BridgePauseStateAPIResponse
+	GetOutGoingOperationProofCalled             func(hash string) (*common.OutGoingOperationProofAPIResponse, error)
 }
 
 // GetSCRsByTxHash -
@@ -109,6 +123,96 @@ func (f *FacadeStub) GetSCRsByTxHash(txHash string, scrHash string) ([]*transact
 	return nil, nil
 }
 
+// GetIncomingSCRsByMainChainTxHash -
+func (f *FacadeStub) GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+	if f.GetIncomingSCRsByMainChainTxHashCalled != nil {
+		return f.GetIncomingSCRsByMainChainTxHashCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetUnconfirmedOutGoingOperations -
+func (f *FacadeStub) GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	if f.GetUnconfirmedOutGoingOperationsCalled != nil {
+		return f.GetUnconfirmedOutGoingOperationsCalled()
+	}
+
+	return nil
+}
+
+// GetOutGoingOperations -
+func (f *FacadeStub) GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+	if f.GetOutGoingOperationsCalled != nil {
+		return f.GetOutGoingOperationsCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetLastCrossNotarizedIncomingHeader -
+func (f *FacadeStub) GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+	if f.GetLastCrossNotarizedIncomingHeaderCalled != nil {
+		return f.GetLastCrossNotarizedIncomingHeaderCalled(chainID)
+	}
+
+	return nil, nil
+}
+
+// GetDeadLetteredOutGoingOperations -
+func (f *FacadeStub) GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	if f.GetDeadLetteredOutGoingOperationsCalled != nil {
+		return f.GetDeadLetteredOutGoingOperationsCalled()
+	}
+
+	return nil
+}
+
+// RequeueDeadLetteredOutGoingOperations -
+func (f *FacadeStub) RequeueDeadLetteredOutGoingOperations(hash string) error {
+	if f.RequeueDeadLetteredOutGoingOperationsCalled != nil {
+		return f.RequeueDeadLetteredOutGoingOperationsCalled(hash)
+	}
+
+	return nil
+}
+
+// DropDeadLetteredOutGoingOperations -
+func (f *FacadeStub) DropDeadLetteredOutGoingOperations(hash string) error {
+	if f.DropDeadLetteredOutGoingOperationsCalled != nil {
+		return f.DropDeadLetteredOutGoingOperationsCalled(hash)
+	}
+
+	return nil
+}
+
+// IsAdminTokenValid -
+func (f *FacadeStub) IsAdminTokenValid(token string) bool {
+	if f.IsAdminTokenValidCalled != nil {
+		return f.IsAdminTokenValidCalled(token)
+	}
+
+	return false
+}
+
+// GetBridgePauseState -
+func (f *FacadeStub) GetBridgePauseState() *common.BridgePauseStateAPIResponse {
+	if f.GetBridgePauseStateCalled != nil {
+		return f.GetBridgePauseStateCalled()
+	}
+
+	return nil
+}
+
+// GetOutGoingOperationProof -
+func (f *FacadeStub) GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+	if f.GetOutGoingOperationProofCalled != nil {
+		return f.GetOutGoingOperationProofCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetTokenSupply -
 func (f *FacadeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	if f.GetTokenSupplyCalled != nil {
@@ -737,6 +841,30 @@ func (f *FacadeStub) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32,
 	return 0, nil
 }
 
+// GetEquivocationProofs -
+func (f *FacadeStub) GetEquivocationProofs() []*equivocation.Proof {
+	if f.GetEquivocationProofsCalled != nil {
+		return f.GetEquivocationProofsCalled()
+	}
+	return make([]*equivocation.Proof, 0)
+}
+
+// AddManagedKey -
+func (f *FacadeStub) AddManagedKey(privateKeyHex string) (string, error) {
+	if f.AddManagedKeyCalled != nil {
+		return f.AddManagedKeyCalled(privateKeyHex)
+	}
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (f *FacadeStub) RemoveManagedKey(publicKey string) error {
+	if f.RemoveManagedKeyCalled != nil {
+		return f.RemoveManagedKeyCalled(publicKey)
+	}
+	return nil
+}
+
 // P2PPrometheusMetricsEnabled -
 func (f *FacadeStub) P2PPrometheusMetricsEnabled() bool {
 	if f.P2PPrometheusMetricsEnabledCalled != nil {
@@ -2,9 +2,11 @@
 
 generate() {
     generateForAssessmentTool
+    generateForBridgeInspect
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
+    generateForRemoteSigner
     generateForSeedNode
     generateForTermUi
 }
@@ -23,6 +25,20 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForBridgeInspect() {
+    HELP="
+# Bridge Inspect CLI
+
+The **Bridge data inspection Tool** exposes the following Command Line Interface:
+$(code)
+\$ bridgeinspect --help
+
+$(./bridgeinspect/bridgeinspect --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./bridgeinspect/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -65,6 +81,20 @@ $(code)
     echo "$HELP" > ./node/CLI.md
 }
 
+generateForRemoteSigner() {
+    HELP="
+# Remote Signer CLI
+
+The **Remote signer** exposes the following Command Line Interface:
+$(code)
+\$ remotesigner --help
+
+$(./remotesigner/remotesigner --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./remotesigner/CLI.md
+}
+
 generateForSeedNode() {
     HELP="
 # MultiversX SeedNode CLI
@@ -0,0 +1,169 @@
+package inspector
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+const (
+	// HexEncoding defines the hex encoding of the provided input
+	HexEncoding = "hex"
+	// Base64Encoding defines the base64 encoding of the provided input
+	Base64Encoding = "base64"
+)
+
+// ArgsBridgeInspector holds the arguments needed to create a bridge inspector
+type ArgsBridgeInspector struct {
+	DataCodec        DataCodec
+	OperationsHasher hashing.Hasher
+	MultiSigner      crypto.MultiSigner
+}
+
+type bridgeInspector struct {
+	dataCodec        DataCodec
+	operationsHasher hashing.Hasher
+	multiSigner      crypto.MultiSigner
+}
+
+// NewBridgeInspector creates a bridge inspector able to decode bridge data, recompute outgoing operations hashes and
+// verify their aggregated signatures
+func NewBridgeInspector(args ArgsBridgeInspector) (*bridgeInspector, error) {
+	if check.IfNil(args.DataCodec) {
+		return nil, errors.ErrNilDataCodec
+	}
+	if check.IfNil(args.OperationsHasher) {
+		return nil, errors.ErrNilOperationsHasher
+	}
+	if check.IfNil(args.MultiSigner) {
+		return nil, errors.ErrNilMultiSigner
+	}
+
+	return &bridgeInspector{
+		dataCodec:        args.DataCodec,
+		operationsHasher: args.OperationsHasher,
+		multiSigner:      args.MultiSigner,
+	}, nil
+}
+
+// DecodeInput decodes the provided hex or base64 input
+func DecodeInput(input string, encoding string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	if len(input) == 0 {
+		return nil, ErrEmptyInput
+	}
+
+	switch encoding {
+	case HexEncoding:
+		return hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	case Base64Encoding:
+		return base64.StdEncoding.DecodeString(input)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEncoding, encoding)
+	}
+}
+
+// DecodeOperation decodes an outgoing operation
+func (bi *bridgeInspector) DecodeOperation(data []byte) (*OperationView, error) {
+	operation, err := bi.dataCodec.DeserializeOperation(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOperationView(operation), nil
+}
+
+// DecodeEventData decodes the event data of a bridge transfer
+func (bi *bridgeInspector) DecodeEventData(data []byte) (*EventView, error) {
+	eventData, err := bi.dataCodec.DeserializeEventData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return newEventView(eventData), nil
+}
+
+// DecodeTokenData decodes the esdt token data of a bridge transfer
+func (bi *bridgeInspector) DecodeTokenData(data []byte) (*TokenDataView, error) {
+	tokenData, err := bi.dataCodec.DeserializeTokenData(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTokenDataView(tokenData), nil
+}
+
+// ComputeHashOfHashes recomputes the hash of each provided operation and the hash of hashes of the batch, in the same
+// way they are computed when the outgoing mini block is created
+func (bi *bridgeInspector) ComputeHashOfHashes(operations [][]byte) (*HashOfHashesView, error) {
+	if len(operations) == 0 {
+		return nil, ErrNoOperations
+	}
+
+	operationsHashes := make([]string, 0, len(operations))
+	aggregatedOperationsHashes := make([]byte, 0)
+	for _, operation := range operations {
+		operationHash := bi.operationsHasher.Compute(string(operation))
+		aggregatedOperationsHashes = append(aggregatedOperationsHashes, operationHash...)
+		operationsHashes = append(operationsHashes, hex.EncodeToString(operationHash))
+	}
+
+	return &HashOfHashesView{
+		OperationsHashes: operationsHashes,
+		HashOfHashes:     hex.EncodeToString(bi.operationsHasher.Compute(string(aggregatedOperationsHashes))),
+	}, nil
+}
+
+// VerifyAggregatedSignature verifies the aggregated signature of an outgoing operations batch against the provided
+// validator set. The outgoing operations hash is the signed batch hash (hash of hashes), as bridged to the main chain.
+// If a bitmap is provided, only the validators marked in it are considered signers.
+func (bi *bridgeInspector) VerifyAggregatedSignature(
+	outGoingOperationsHash []byte,
+	aggregatedSignature []byte,
+	validators [][]byte,
+	bitmap []byte,
+) error {
+	signers, err := getSigners(validators, bitmap)
+	if err != nil {
+		return err
+	}
+
+	return bi.multiSigner.VerifyAggregatedSig(signers, outGoingOperationsHash, aggregatedSignature)
+}
+
+func getSigners(validators [][]byte, bitmap []byte) ([][]byte, error) {
+	if len(validators) == 0 {
+		return nil, ErrNoValidators
+	}
+	if len(bitmap) == 0 {
+		return validators, nil
+	}
+	if len(bitmap)*8 < len(validators) {
+		return nil, fmt.Errorf("%w, bitmap len = %d, num validators = %d", ErrInvalidBitmap, len(bitmap), len(validators))
+	}
+
+	signers := make([][]byte, 0, len(validators))
+	for idx, validator := range validators {
+		if bitmap[idx/8]&(1<<uint8(idx%8)) != 0 {
+			signers = append(signers, validator)
+		}
+	}
+
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("%w, no signer is set", ErrInvalidBitmap)
+	}
+
+	return signers, nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (bi *bridgeInspector) IsInterfaceNil() bool {
+	return bi == nil
+}
@@ -0,0 +1,336 @@
+package inspector
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclMultiSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	"github.com/multiversx/mx-sdk-abi-go/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/cmd/sovereignnode/dataCodec"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createArgs() ArgsBridgeInspector {
+	serializer, _ := abi.NewSerializer(abi.ArgsNewSerializer{
+		PartsSeparator: "@",
+	})
+	codec, _ := dataCodec.NewDataCodec(serializer)
+	multiSigner, _ := multisig.NewBLSMultisig(&mclMultiSig.BlsMultiSignerKOSK{}, signing.NewKeyGenerator(mcl.NewSuiteBLS12()))
+
+	return ArgsBridgeInspector{
+		DataCodec:        codec,
+		OperationsHasher: sha256.NewSha256(),
+		MultiSigner:      multiSigner,
+	}
+}
+
+func TestNewBridgeInspector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data codec should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.DataCodec = nil
+
+		bi, err := NewBridgeInspector(args)
+		require.Nil(t, bi)
+		require.Equal(t, errorsMx.ErrNilDataCodec, err)
+	})
+	t.Run("nil operations hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.OperationsHasher = nil
+
+		bi, err := NewBridgeInspector(args)
+		require.Nil(t, bi)
+		require.Equal(t, errorsMx.ErrNilOperationsHasher, err)
+	})
+	t.Run("nil multi signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.MultiSigner = nil
+
+		bi, err := NewBridgeInspector(args)
+		require.Nil(t, bi)
+		require.Equal(t, errorsMx.ErrNilMultiSigner, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bi, err := NewBridgeInspector(createArgs())
+		require.Nil(t, err)
+		require.False(t, bi.IsInterfaceNil())
+	})
+}
+
+func TestDecodeInput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty input should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := DecodeInput("  ", HexEncoding)
+		require.Nil(t, decoded)
+		require.Equal(t, ErrEmptyInput, err)
+	})
+	t.Run("invalid encoding should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := DecodeInput("0102", "base58")
+		require.Nil(t, decoded)
+		require.ErrorIs(t, err, ErrInvalidEncoding)
+	})
+	t.Run("hex input should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := DecodeInput("0x0102", HexEncoding)
+		require.Nil(t, err)
+		require.Equal(t, []byte{1, 2}, decoded)
+	})
+	t.Run("base64 input should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoded, err := DecodeInput(base64.StdEncoding.EncodeToString([]byte{1, 2}), Base64Encoding)
+		require.Nil(t, err)
+		require.Equal(t, []byte{1, 2}, decoded)
+	})
+}
+
+func TestBridgeInspector_DecodeOperation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("codec error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeOperationCalled: func(data []byte) (*sovereign.Operation, error) {
+				return nil, expectedErr
+			},
+		}
+		bi, _ := NewBridgeInspector(args)
+
+		operation, err := bi.DecodeOperation([]byte("data"))
+		require.Nil(t, operation)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		bi, _ := NewBridgeInspector(createArgs())
+		serializedOperation, _ := hex.DecodeString("c0c0739e0cf6232a934d2e56cfcd10881eb1c7336f128fc155a4a84292cfe7f6000000010000000a53564e2d3132333435360000000000000000000000000906aaf7c8516d0c00000000000004686173680000000453564e3100000004617474720000000000000000000000000000000000000000000000000000000000000000000000021b58000000010000000475726c31000000000000000a000000000000000000000000000000000000000000000000000000000000000000")
+
+		operation, err := bi.DecodeOperation(serializedOperation)
+		require.Nil(t, err)
+		require.Equal(t, &OperationView{
+			Receiver: "c0c0739e0cf6232a934d2e56cfcd10881eb1c7336f128fc155a4a84292cfe7f6",
+			Tokens: []*TokenView{
+				{
+					Identifier: "SVN-123456",
+					Nonce:      0,
+					Data: &TokenDataView{
+						TokenType:  core.FungibleESDT,
+						Amount:     "123000000000000000000",
+						Frozen:     false,
+						Hash:       hex.EncodeToString([]byte("hash")),
+						Name:       "SVN1",
+						Attributes: hex.EncodeToString([]byte("attr")),
+						Creator:    "0000000000000000000000000000000000000000000000000000000000000000",
+						Royalties:  "7000",
+						Uris:       []string{"url1"},
+					},
+				},
+			},
+			Data: &EventView{
+				Nonce:  10,
+				Sender: "0000000000000000000000000000000000000000000000000000000000000000",
+			},
+		}, operation)
+	})
+}
+
+func TestBridgeInspector_DecodeEventAndTokenData(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	bi, _ := NewBridgeInspector(args)
+
+	sender, _ := hex.DecodeString("c0c0739e0cf6232a934d2e56cfcd10881eb1c7336f128fc155a4a84292cfe7f6")
+	serializer, _ := abi.NewSerializer(abi.ArgsNewSerializer{
+		PartsSeparator: "@",
+	})
+	codec, _ := dataCodec.NewDataCodec(serializer)
+
+	eventDataBytes, _ := codec.SerializeEventData(sovereign.EventData{
+		Nonce:  7,
+		Sender: sender,
+		TransferData: &sovereign.TransferData{
+			GasLimit: 5000000,
+			Function: []byte("deposit"),
+			Args:     [][]byte{{1}},
+		},
+	})
+	eventData, err := bi.DecodeEventData(eventDataBytes)
+	require.Nil(t, err)
+	require.Equal(t, &EventView{
+		Nonce:  7,
+		Sender: hex.EncodeToString(sender),
+		TransferData: &TransferDataView{
+			GasLimit: 5000000,
+			Function: "deposit",
+			Args:     []string{"01"},
+		},
+	}, eventData)
+
+	tokenDataBytes, _ := codec.SerializeTokenData(sovereign.EsdtTokenData{
+		TokenType: core.NonFungibleV2,
+		Amount:    big.NewInt(1),
+		Creator:   sender,
+		Royalties: big.NewInt(100),
+		Uris:      [][]byte{[]byte("uri")},
+	})
+	tokenData, err := bi.DecodeTokenData(tokenDataBytes)
+	require.Nil(t, err)
+	require.Equal(t, core.NonFungibleESDTv2, tokenData.TokenType)
+	require.Equal(t, "1", tokenData.Amount)
+	require.Equal(t, hex.EncodeToString(sender), tokenData.Creator)
+	require.Equal(t, "100", tokenData.Royalties)
+	require.Equal(t, []string{"uri"}, tokenData.Uris)
+}
+
+func TestBridgeInspector_ComputeHashOfHashes(t *testing.T) {
+	t.Parallel()
+
+	bi, _ := NewBridgeInspector(createArgs())
+
+	t.Run("no operations should error", func(t *testing.T) {
+		t.Parallel()
+
+		hashOfHashes, err := bi.ComputeHashOfHashes(nil)
+		require.Nil(t, hashOfHashes)
+		require.Equal(t, ErrNoOperations, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hasher := sha256.NewSha256()
+		op1 := []byte("op1")
+		op2 := []byte("op2")
+		op1Hash := hasher.Compute(string(op1))
+		op2Hash := hasher.Compute(string(op2))
+		expectedHashOfHashes := hasher.Compute(string(append(append([]byte{}, op1Hash...), op2Hash...)))
+
+		hashOfHashes, err := bi.ComputeHashOfHashes([][]byte{op1, op2})
+		require.Nil(t, err)
+		require.Equal(t, &HashOfHashesView{
+			OperationsHashes: []string{hex.EncodeToString(op1Hash), hex.EncodeToString(op2Hash)},
+			HashOfHashes:     hex.EncodeToString(expectedHashOfHashes),
+		}, hashOfHashes)
+	})
+}
+
+func TestBridgeInspector_VerifyAggregatedSignature(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	bi, _ := NewBridgeInspector(args)
+
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	numValidators := 3
+	privateKeys := make([][]byte, 0, numValidators)
+	publicKeys := make([][]byte, 0, numValidators)
+	for i := 0; i < numValidators; i++ {
+		sk, pk := keyGen.GeneratePair()
+		skBytes, _ := sk.ToByteArray()
+		pkBytes, _ := pk.ToByteArray()
+		privateKeys = append(privateKeys, skBytes)
+		publicKeys = append(publicKeys, pkBytes)
+	}
+
+	aggregateSig := func(message []byte, signersIndexes []int) []byte {
+		pubKeys := make([][]byte, 0)
+		sigShares := make([][]byte, 0)
+		for _, idx := range signersIndexes {
+			sigShare, _ := args.MultiSigner.CreateSignatureShare(privateKeys[idx], message)
+			sigShares = append(sigShares, sigShare)
+			pubKeys = append(pubKeys, publicKeys[idx])
+		}
+
+		sig, _ := args.MultiSigner.AggregateSigs(pubKeys, sigShares)
+		return sig
+	}
+
+	batch1Hash := args.OperationsHasher.Compute("batch1")
+	batch2Hash := args.OperationsHasher.Compute("batch2")
+
+	t.Run("no validators should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := bi.VerifyAggregatedSignature(batch1Hash, aggregateSig(batch1Hash, []int{0, 1, 2}), nil, nil)
+		require.Equal(t, ErrNoValidators, err)
+	})
+	t.Run("invalid bitmap should error", func(t *testing.T) {
+		t.Parallel()
+
+		manyValidators := make([][]byte, 9)
+		err := bi.VerifyAggregatedSignature(batch1Hash, aggregateSig(batch1Hash, []int{0}), manyValidators, []byte{1})
+		require.ErrorIs(t, err, ErrInvalidBitmap)
+
+		err = bi.VerifyAggregatedSignature(batch1Hash, aggregateSig(batch1Hash, []int{0}), publicKeys, []byte{0})
+		require.ErrorIs(t, err, ErrInvalidBitmap)
+	})
+	t.Run("all validators signed should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := bi.VerifyAggregatedSignature(batch1Hash, aggregateSig(batch1Hash, []int{0, 1, 2}), publicKeys, nil)
+		require.Nil(t, err)
+	})
+	t.Run("signers from bitmap should work", func(t *testing.T) {
+		t.Parallel()
+
+		sig := aggregateSig(batch1Hash, []int{0, 2})
+		err := bi.VerifyAggregatedSignature(batch1Hash, sig, publicKeys, []byte{5})
+		require.Nil(t, err)
+
+		err = bi.VerifyAggregatedSignature(batch1Hash, sig, publicKeys, nil)
+		require.NotNil(t, err)
+	})
+	t.Run("signature of another batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := bi.VerifyAggregatedSignature(batch1Hash, aggregateSig(batch2Hash, []int{0, 1, 2}), publicKeys, nil)
+		require.NotNil(t, err)
+	})
+	t.Run("multi signer error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		argsMock := createArgs()
+		argsMock.MultiSigner = &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				return expectedErr
+			},
+		}
+		biMock, _ := NewBridgeInspector(argsMock)
+
+		err := biMock.VerifyAggregatedSignature(batch1Hash, []byte("sig"), publicKeys, nil)
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
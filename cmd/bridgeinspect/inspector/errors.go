@@ -0,0 +1,18 @@
+package inspector
+
+import "errors"
+
+// ErrEmptyInput signals that an empty input has been provided
+var ErrEmptyInput = errors.New("empty input")
+
+// ErrInvalidEncoding signals that an unknown input encoding has been provided
+var ErrInvalidEncoding = errors.New("invalid input encoding")
+
+// ErrNoOperations signals that no operation has been provided to compute the hash of hashes
+var ErrNoOperations = errors.New("no operations provided")
+
+// ErrNoValidators signals that an empty validator set has been provided
+var ErrNoValidators = errors.New("no validators provided")
+
+// ErrInvalidBitmap signals that the provided signers bitmap does not match the validator set
+var ErrInvalidBitmap = errors.New("invalid signers bitmap")
@@ -0,0 +1,13 @@
+package inspector
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+)
+
+// DataCodec is the interface able to decode the bridge data
+type DataCodec interface {
+	DeserializeEventData(data []byte) (*sovereign.EventData, error)
+	DeserializeTokenData(data []byte) (*sovereign.EsdtTokenData, error)
+	DeserializeOperation(data []byte) (*sovereign.Operation, error)
+	IsInterfaceNil() bool
+}
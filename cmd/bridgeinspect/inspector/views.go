@@ -0,0 +1,132 @@
+package inspector
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+)
+
+// OperationView is the human-readable form of a decoded operation
+type OperationView struct {
+	Receiver string       `json:"receiver"`
+	Tokens   []*TokenView `json:"tokens"`
+	Data     *EventView   `json:"data"`
+}
+
+// TokenView is the human-readable form of a decoded operation token
+type TokenView struct {
+	Identifier string         `json:"identifier"`
+	Nonce      uint64         `json:"nonce"`
+	Data       *TokenDataView `json:"data"`
+}
+
+// TokenDataView is the human-readable form of a decoded esdt token data
+type TokenDataView struct {
+	TokenType  string   `json:"tokenType"`
+	Amount     string   `json:"amount"`
+	Frozen     bool     `json:"frozen"`
+	Hash       string   `json:"hash"`
+	Name       string   `json:"name"`
+	Attributes string   `json:"attributes"`
+	Creator    string   `json:"creator"`
+	Royalties  string   `json:"royalties"`
+	Uris       []string `json:"uris"`
+}
+
+// EventView is the human-readable form of a decoded event data
+type EventView struct {
+	Nonce        uint64            `json:"nonce"`
+	Sender       string            `json:"sender"`
+	TransferData *TransferDataView `json:"transferData,omitempty"`
+}
+
+// TransferDataView is the human-readable form of a decoded transfer data
+type TransferDataView struct {
+	GasLimit uint64   `json:"gasLimit"`
+	Function string   `json:"function"`
+	Args     []string `json:"args"`
+}
+
+// HashOfHashesView holds the recomputed hashes of a batch of outgoing operations
+type HashOfHashesView struct {
+	OperationsHashes []string `json:"operationsHashes"`
+	HashOfHashes     string   `json:"hashOfHashes"`
+}
+
+func newOperationView(operation *sovereign.Operation) *OperationView {
+	tokens := make([]*TokenView, 0, len(operation.Tokens))
+	for i := range operation.Tokens {
+		tokens = append(tokens, &TokenView{
+			Identifier: string(operation.Tokens[i].Identifier),
+			Nonce:      operation.Tokens[i].Nonce,
+			Data:       newTokenDataView(&operation.Tokens[i].Data),
+		})
+	}
+
+	return &OperationView{
+		Receiver: hex.EncodeToString(operation.Address),
+		Tokens:   tokens,
+		Data:     newEventView(operation.Data),
+	}
+}
+
+func newTokenDataView(tokenData *sovereign.EsdtTokenData) *TokenDataView {
+	return &TokenDataView{
+		TokenType:  tokenData.TokenType.String(),
+		Amount:     bigIntToString(tokenData.Amount),
+		Frozen:     tokenData.Frozen,
+		Hash:       hex.EncodeToString(tokenData.Hash),
+		Name:       string(tokenData.Name),
+		Attributes: hex.EncodeToString(tokenData.Attributes),
+		Creator:    hex.EncodeToString(tokenData.Creator),
+		Royalties:  bigIntToString(tokenData.Royalties),
+		Uris:       bytesSliceToStrings(tokenData.Uris),
+	}
+}
+
+func newEventView(eventData *sovereign.EventData) *EventView {
+	if eventData == nil {
+		return nil
+	}
+
+	view := &EventView{
+		Nonce:  eventData.Nonce,
+		Sender: hex.EncodeToString(eventData.Sender),
+	}
+	if eventData.TransferData != nil {
+		view.TransferData = &TransferDataView{
+			GasLimit: eventData.TransferData.GasLimit,
+			Function: string(eventData.TransferData.Function),
+			Args:     bytesSliceToHex(eventData.TransferData.Args),
+		}
+	}
+
+	return view
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+func bytesSliceToStrings(values [][]byte) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, string(value))
+	}
+
+	return result
+}
+
+func bytesSliceToHex(values [][]byte) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, hex.EncodeToString(value))
+	}
+
+	return result
+}
@@ -0,0 +1,372 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclMultiSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
+	"github.com/multiversx/mx-chain-crypto-go/signing/multisig"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-abi-go/abi"
+	"github.com/urfave/cli"
+
+	"github.com/multiversx/mx-chain-go/cmd/bridgeinspect/inspector"
+	"github.com/multiversx/mx-chain-go/cmd/sovereignnode/dataCodec"
+)
+
+const (
+	blsNoKOSK = "no-KOSK"
+	blsKOSK   = "KOSK"
+)
+
+type cfg struct {
+	data             string
+	encoding         string
+	operations       cli.StringSlice
+	hash             string
+	signature        string
+	validators       cli.StringSlice
+	bitmap           string
+	operationsHasher string
+	multiSigType     string
+}
+
+type inspectorHandler interface {
+	DecodeOperation(data []byte) (*inspector.OperationView, error)
+	DecodeEventData(data []byte) (*inspector.EventView, error)
+	DecodeTokenData(data []byte) (*inspector.TokenDataView, error)
+	ComputeHashOfHashes(operations [][]byte) (*inspector.HashOfHashesView, error)
+	VerifyAggregatedSignature(outGoingOperationsHash []byte, aggregatedSignature []byte, validators [][]byte, bitmap []byte) error
+	IsInterfaceNil() bool
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}{{end}}{{if .VisibleFlags}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}{{end}}
+VERSION:
+   {{.Version}}
+`
+
+	// data defines a flag for the encoded bridge data to be decoded
+	data = cli.StringFlag{
+		Name:        "data",
+		Usage:       "The encoded bridge data to be decoded",
+		Destination: &argsConfig.data,
+	}
+	// encoding defines a flag for the encoding of all the provided bridge data
+	encoding = cli.StringFlag{
+		Name:        "encoding",
+		Usage:       fmt.Sprintf("The encoding of the provided data. Available options: %s, %s", inspector.HexEncoding, inspector.Base64Encoding),
+		Value:       inspector.HexEncoding,
+		Destination: &argsConfig.encoding,
+	}
+	// operations defines a flag for the encoded outgoing operations of a batch, in batch order
+	operations = cli.StringSliceFlag{
+		Name:  "operation",
+		Usage: "An encoded outgoing operation of the batch. Should be provided once for each operation, in batch order",
+		Value: &argsConfig.operations,
+	}
+	// hash defines a flag for the outgoing operations hash (hash of hashes) which was signed
+	hash = cli.StringFlag{
+		Name:        "hash",
+		Usage:       "The outgoing operations batch hash (hash of hashes) which was signed",
+		Destination: &argsConfig.hash,
+	}
+	// signature defines a flag for the aggregated signature of the outgoing operations
+	signature = cli.StringFlag{
+		Name:        "signature",
+		Usage:       "The aggregated signature of the outgoing operations batch",
+		Destination: &argsConfig.signature,
+	}
+	// validators defines a flag for the validators BLS public keys, in consensus group order
+	validators = cli.StringSliceFlag{
+		Name:  "validator",
+		Usage: "A hex encoded BLS public key of the validator set. Should be provided once for each validator, in consensus group order",
+		Value: &argsConfig.validators,
+	}
+	// bitmap defines a flag for the signers bitmap of the consensus group
+	bitmap = cli.StringFlag{
+		Name:        "bitmap",
+		Usage:       "Optional hex encoded signers bitmap. If not provided, all validators are considered signers",
+		Destination: &argsConfig.bitmap,
+	}
+	// operationsHasher defines a flag for the hasher type used for outgoing operations
+	operationsHasher = cli.StringFlag{
+		Name:        "operations-hasher",
+		Usage:       "The hasher type used for outgoing operations, as defined in sovereignConfig.toml",
+		Value:       "sha256",
+		Destination: &argsConfig.operationsHasher,
+	}
+	// multiSigType defines a flag for the BLS multi signer type
+	multiSigType = cli.StringFlag{
+		Name:        "multisig-type",
+		Usage:       fmt.Sprintf("The BLS multi signer type. Available options: %s, %s", blsKOSK, blsNoKOSK),
+		Value:       blsKOSK,
+		Destination: &argsConfig.multiSigType,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("bridgeinspect")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Bridge data inspection Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary decodes the sovereign bridge data and verifies the outgoing operations signatures, for debugging stuck transfers"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		encoding,
+		operationsHasher,
+		multiSigType,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "decode-operation",
+			Usage:  "Decodes an outgoing operation",
+			Flags:  []cli.Flag{data},
+			Action: decodeOperation,
+		},
+		{
+			Name:   "decode-event-data",
+			Usage:  "Decodes the event data of a bridge transfer",
+			Flags:  []cli.Flag{data},
+			Action: decodeEventData,
+		},
+		{
+			Name:   "decode-token-data",
+			Usage:  "Decodes the esdt token data of a bridge transfer",
+			Flags:  []cli.Flag{data},
+			Action: decodeTokenData,
+		},
+		{
+			Name:   "hash-of-hashes",
+			Usage:  "Recomputes the operations hashes and the hash of hashes of an outgoing operations batch",
+			Flags:  []cli.Flag{operations},
+			Action: computeHashOfHashes,
+		},
+		{
+			Name:   "verify-signature",
+			Usage:  "Verifies the aggregated BLS signature of the outgoing operations against a validator set",
+			Flags:  []cli.Flag{hash, signature, validators, bitmap},
+			Action: verifySignature,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error inspecting bridge data", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func decodeOperation(_ *cli.Context) error {
+	bridgeInspector, input, err := createInspectorAndDecodeData()
+	if err != nil {
+		return err
+	}
+
+	operation, err := bridgeInspector.DecodeOperation(input)
+	if err != nil {
+		return err
+	}
+
+	return printJson(operation)
+}
+
+func decodeEventData(_ *cli.Context) error {
+	bridgeInspector, input, err := createInspectorAndDecodeData()
+	if err != nil {
+		return err
+	}
+
+	eventData, err := bridgeInspector.DecodeEventData(input)
+	if err != nil {
+		return err
+	}
+
+	return printJson(eventData)
+}
+
+func decodeTokenData(_ *cli.Context) error {
+	bridgeInspector, input, err := createInspectorAndDecodeData()
+	if err != nil {
+		return err
+	}
+
+	tokenData, err := bridgeInspector.DecodeTokenData(input)
+	if err != nil {
+		return err
+	}
+
+	return printJson(tokenData)
+}
+
+func computeHashOfHashes(_ *cli.Context) error {
+	bridgeInspector, err := createBridgeInspector()
+	if err != nil {
+		return err
+	}
+
+	outGoingOperations := make([][]byte, 0, len(argsConfig.operations))
+	for idx, operation := range argsConfig.operations {
+		operationBytes, errDecode := inspector.DecodeInput(operation, argsConfig.encoding)
+		if errDecode != nil {
+			return fmt.Errorf("%w for operation %d", errDecode, idx)
+		}
+
+		outGoingOperations = append(outGoingOperations, operationBytes)
+	}
+
+	hashOfHashes, err := bridgeInspector.ComputeHashOfHashes(outGoingOperations)
+	if err != nil {
+		return err
+	}
+
+	return printJson(hashOfHashes)
+}
+
+func verifySignature(_ *cli.Context) error {
+	bridgeInspector, err := createBridgeInspector()
+	if err != nil {
+		return err
+	}
+
+	outGoingOperationsHash, err := inspector.DecodeInput(argsConfig.hash, argsConfig.encoding)
+	if err != nil {
+		return fmt.Errorf("%w for hash", err)
+	}
+	aggregatedSignature, err := inspector.DecodeInput(argsConfig.signature, argsConfig.encoding)
+	if err != nil {
+		return fmt.Errorf("%w for signature", err)
+	}
+	pubKeys, err := decodeValidators(argsConfig.validators)
+	if err != nil {
+		return err
+	}
+	signersBitmap, err := hex.DecodeString(argsConfig.bitmap)
+	if err != nil {
+		return fmt.Errorf("%w for bitmap", err)
+	}
+
+	err = bridgeInspector.VerifyAggregatedSignature(outGoingOperationsHash, aggregatedSignature, pubKeys, signersBitmap)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("aggregated signature is valid")
+	return nil
+}
+
+func decodeValidators(validatorsPubKeys []string) ([][]byte, error) {
+	pubKeys := make([][]byte, 0, len(validatorsPubKeys))
+	for idx, validatorPubKey := range validatorsPubKeys {
+		pubKey, err := hex.DecodeString(validatorPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w for validator %d", err, idx)
+		}
+
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	return pubKeys, nil
+}
+
+func createInspectorAndDecodeData() (inspectorHandler, []byte, error) {
+	bridgeInspector, err := createBridgeInspector()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	input, err := inspector.DecodeInput(argsConfig.data, argsConfig.encoding)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bridgeInspector, input, nil
+}
+
+func createBridgeInspector() (inspectorHandler, error) {
+	serializer, err := abi.NewSerializer(abi.ArgsNewSerializer{
+		PartsSeparator: "@",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	codec, err := dataCodec.NewDataCodec(serializer)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(argsConfig.operationsHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	multiSigner, err := createMultiSigner(argsConfig.multiSigType)
+	if err != nil {
+		return nil, err
+	}
+
+	return inspector.NewBridgeInspector(inspector.ArgsBridgeInspector{
+		DataCodec:        codec,
+		OperationsHasher: hasher,
+		MultiSigner:      multiSigner,
+	})
+}
+
+func createMultiSigner(multiSigType string) (crypto.MultiSigner, error) {
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+
+	switch multiSigType {
+	case blsKOSK:
+		return multisig.NewBLSMultisig(&mclMultiSig.BlsMultiSignerKOSK{}, keyGen)
+	case blsNoKOSK:
+		hasher, err := blake2b.NewBlake2bWithSize(mclMultiSig.HasherOutputSize)
+		if err != nil {
+			return nil, err
+		}
+
+		return multisig.NewBLSMultisig(&mclMultiSig.BlsMultiSigner{Hasher: hasher}, keyGen)
+	default:
+		return nil, fmt.Errorf("invalid multi signer type: %s", multiSigType)
+	}
+}
+
+func printJson(value interface{}) error {
+	jsonBytes, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(jsonBytes))
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
 	"github.com/multiversx/mx-chain-go/cmd/keygenerator/converter"
+	"github.com/multiversx/mx-chain-go/keysManagement/keyStore"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
@@ -31,6 +32,9 @@ type cfg struct {
 	noSplit       bool
 	prefixPattern string
 	shardIDByte   int
+	encrypt       bool
+	inputFile     string
+	outputFile    string
 }
 
 const validatorType = "validator"
@@ -62,11 +66,14 @@ var (
 	fileGenHelpTemplate = `NAME:
    {{.Name}} - {{.Usage}}
 USAGE:
-   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} [command [command options]]
    {{if len .Authors}}
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -123,11 +130,37 @@ VERSION:
 		Value:       -1,
 		Destination: &argsConfig.shardIDByte,
 	}
+	// encrypt is the flag that, if active, will save the generated validator keys in encrypted key files
+	encrypt = cli.BoolFlag{
+		Name: "encrypt",
+		Usage: "Boolean option that will save the generated validator keys in encrypted key files, unlocked with the " +
+			"passphrase read from the passphrase file or from the " + keyStore.PassphraseEnvVariable + " environment variable",
+		Destination: &argsConfig.encrypt,
+	}
+	// passphraseFile defines a flag for the file holding the passphrase of the encrypted key files
+	passphraseFile = cli.StringFlag{
+		Name: "passphrase-file",
+		Usage: "The file holding the passphrase of the encrypted key files. If not provided, the passphrase is read " +
+			"from the " + keyStore.PassphraseEnvVariable + " environment variable",
+	}
+	// inputFile defines a flag for the plain PEM file to be encrypted
+	inputFile = cli.StringFlag{
+		Name:        "input-file",
+		Usage:       "The plain PEM file holding the validator keys to be encrypted",
+		Destination: &argsConfig.inputFile,
+	}
+	// outputFile defines a flag for the encrypted key file
+	outputFile = cli.StringFlag{
+		Name:        "output-file",
+		Usage:       "The encrypted key file to be written. If not provided, the input file name with the .json extension is used",
+		Destination: &argsConfig.outputFile,
+	}
 	argsConfig = &cfg{}
 
-	walletKeyFilenameTemplate    = "walletKey%s.pem"
-	validatorKeyFilenameTemplate = "validatorKey%s.pem"
-	p2pKeyFilenameTemplate       = "p2pKey%s.pem"
+	walletKeyFilenameTemplate             = "walletKey%s.pem"
+	validatorKeyFilenameTemplate          = "validatorKey%s.pem"
+	encryptedValidatorKeyFilenameTemplate = "validatorKey%s.json"
+	p2pKeyFilenameTemplate                = "p2pKey%s.pem"
 
 	log = logger.GetOrCreate("keygenerator")
 
@@ -155,10 +188,20 @@ func main() {
 		noSplit,
 		shardIDByte,
 		keyPrefix,
+		encrypt,
+		passphraseFile,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "encrypt-pem",
+			Usage:  "Converts an existing plain PEM file holding validator keys into an encrypted key file",
+			Flags:  []cli.Flag{inputFile, outputFile, passphraseFile},
+			Action: encryptPemFile,
+		},
 	}
 
-	app.Action = func(_ *cli.Context) error {
-		return process()
+	app.Action = func(ctx *cli.Context) error {
+		return process(ctx.GlobalString(passphraseFile.Name))
 	}
 
 	err := app.Run(os.Args)
@@ -169,13 +212,66 @@ func main() {
 	}
 }
 
-func process() error {
+func process(passphraseFileName string) error {
+	var passphrase []byte
+	var err error
+	if argsConfig.encrypt {
+		if argsConfig.consoleOut {
+			return fmt.Errorf("the encrypted key files can not be printed on the console")
+		}
+
+		passphrase, err = keyStore.LoadPassphrase(passphraseFileName)
+		if err != nil {
+			return err
+		}
+	}
+
 	validatorKeys, walletKeys, p2pKeys, err := generateKeys(argsConfig.keyType, argsConfig.numKeys, argsConfig.prefixPattern, argsConfig.shardIDByte)
 	if err != nil {
 		return err
 	}
 
-	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit)
+	return outputKeys(validatorKeys, walletKeys, p2pKeys, argsConfig.consoleOut, argsConfig.noSplit, passphrase)
+}
+
+func encryptPemFile(ctx *cli.Context) error {
+	if len(argsConfig.inputFile) == 0 {
+		return fmt.Errorf("no input PEM file provided")
+	}
+
+	output := argsConfig.outputFile
+	if len(output) == 0 {
+		output = strings.TrimSuffix(argsConfig.inputFile, filepath.Ext(argsConfig.inputFile)) + ".json"
+	}
+	_, err := os.Stat(output)
+	if err == nil {
+		return fmt.Errorf("the output file %s already exists", output)
+	}
+
+	passphrase, err := keyStore.LoadPassphrase(ctx.String(passphraseFile.Name))
+	if err != nil {
+		return err
+	}
+
+	pemData, err := os.ReadFile(argsConfig.inputFile)
+	if err != nil {
+		return err
+	}
+
+	encryptedData, err := keyStore.EncryptPemData(pemData, passphrase, keyStore.DefaultScryptParams())
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(output, encryptedData, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	log.Info("encrypted the key file", "input", argsConfig.inputFile, "output", output)
+	log.Warn("the plain PEM file was not removed, delete it once the encrypted key file is in use")
+
+	return nil
 }
 
 func generateKeys(typeKey string, numKeys int, prefix string, shardID int) ([]key, []key, []key, error) {
@@ -308,12 +404,13 @@ func outputKeys(
 	p2pKeys []key,
 	consoleOut bool,
 	noSplit bool,
+	passphrase []byte,
 ) error {
 	if consoleOut {
 		return printKeys(validatorKeys, walletKeys, p2pKeys)
 	}
 
-	return saveKeys(validatorKeys, walletKeys, p2pKeys, noSplit)
+	return saveKeys(validatorKeys, walletKeys, p2pKeys, noSplit, passphrase)
 }
 
 func printKeys(validatorKeys, walletKeys, p2pKeys []key) error {
@@ -379,26 +476,31 @@ func writeKeyToStream(writer io.Writer, key key, converter pubKeyConverter) erro
 	return pem.Encode(writer, &blk)
 }
 
-func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool) error {
+func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool, passphrase []byte) error {
 	if len(validatorKeys)+len(walletKeys)+len(p2pKeys) == 0 {
 		return fmt.Errorf("internal error: no keys to save")
 	}
 
 	var errFound error
 	if len(validatorKeys) > 0 {
-		err := saveSliceKeys(validatorKeyFilenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit)
+		filenameTemplate := validatorKeyFilenameTemplate
+		if len(passphrase) > 0 {
+			filenameTemplate = encryptedValidatorKeyFilenameTemplate
+		}
+
+		err := saveSliceKeys(filenameTemplate, validatorKeys, validatorPubKeyConverter, noSplit, passphrase)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(walletKeys) > 0 {
-		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit)
+		err := saveSliceKeys(walletKeyFilenameTemplate, walletKeys, walletPubKeyConverter, noSplit, nil)
 		if err != nil {
 			errFound = err
 		}
 	}
 	if len(p2pKeys) > 0 {
-		err := saveSliceKeys(p2pKeyFilenameTemplate, p2pKeys, pidPubKeyConverter, noSplit)
+		err := saveSliceKeys(p2pKeyFilenameTemplate, p2pKeys, pidPubKeyConverter, noSplit, nil)
 		if err != nil {
 			errFound = err
 		}
@@ -407,8 +509,8 @@ func saveKeys(validatorKeys, walletKeys, p2pKeys []key, noSplit bool) error {
 	return errFound
 }
 
-func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConverter, noSplit bool) error {
-	var file *os.File
+func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConverter, noSplit bool, passphrase []byte) error {
+	var file io.WriteCloser
 	var err error
 	for i, k := range keys {
 		shouldCreateFile := !noSplit || i == 0
@@ -417,6 +519,12 @@ func saveSliceKeys(baseFilenameTemplate string, keys []key, converter pubKeyConv
 			if err != nil {
 				return err
 			}
+			if len(passphrase) > 0 {
+				file = &encryptedFileWriter{
+					file:       file,
+					passphrase: passphrase,
+				}
+			}
 		}
 
 		err = writeKeyToStream(file, k, converter)
@@ -487,3 +595,32 @@ func backupFileIfExists(filenameTemplate string) {
 	//if we reached here the file probably exists, make a timestamped backup
 	_ = os.Rename(existingFilename, fmt.Sprintf(filenameTemplate, fmt.Sprintf("_%d", time.Now().Unix())))
 }
+
+// encryptedFileWriter gathers the PEM encoded keys and writes them encrypted when closed
+type encryptedFileWriter struct {
+	file       io.WriteCloser
+	passphrase []byte
+	pemData    bytes.Buffer
+}
+
+// Write gathers the PEM encoded keys
+func (writer *encryptedFileWriter) Write(p []byte) (int, error) {
+	return writer.pemData.Write(p)
+}
+
+// Close encrypts the gathered keys and writes them in the underlying file
+func (writer *encryptedFileWriter) Close() error {
+	encryptedData, err := keyStore.EncryptPemData(writer.pemData.Bytes(), writer.passphrase, keyStore.DefaultScryptParams())
+	if err != nil {
+		_ = writer.file.Close()
+		return err
+	}
+
+	_, err = writer.file.Write(encryptedData)
+	if err != nil {
+		_ = writer.file.Close()
+		return err
+	}
+
+	return writer.file.Close()
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/operationmodes"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade"
+	"github.com/multiversx/mx-chain-go/keysManagement/keyStore"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
@@ -231,6 +232,14 @@ var (
 		Usage: "The `filepath` for the PEM file which contains all the secret keys managed by the current node.",
 		Value: "./config/allValidatorsKeys.pem",
 	}
+	// validatorKeysPassphraseFile defines a flag for the path to the file holding the passphrase of the encrypted key files
+	validatorKeysPassphraseFile = cli.StringFlag{
+		Name: "validator-keys-passphrase-file",
+		Usage: "The `filepath` for the file which contains the passphrase used to unlock the encrypted validator key " +
+			"files. If not provided, the passphrase is read from the " + keyStore.PassphraseEnvVariable + " environment " +
+			"variable. Only used when the validator key files are encrypted.",
+		Value: "",
+	}
 
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
@@ -430,6 +439,7 @@ func getFlags() []cli.Flag {
 		validatorKeyIndex,
 		validatorKeyPemFile,
 		allValidatorKeysPemFile,
+		validatorKeysPassphraseFile,
 		port,
 		fullArchivePort,
 		profileMode,
@@ -516,6 +526,7 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	cfgs.ConfigurationPathsHolder.SmartContracts = ctx.GlobalString(smartContractsFile.Name)
 	cfgs.ConfigurationPathsHolder.ValidatorKey = ctx.GlobalString(validatorKeyPemFile.Name)
 	cfgs.ConfigurationPathsHolder.AllValidatorKeys = ctx.GlobalString(allValidatorKeysPemFile.Name)
+	cfgs.ConfigurationPathsHolder.ValidatorKeysPassphrase = ctx.GlobalString(validatorKeysPassphraseFile.Name)
 	cfgs.ConfigurationPathsHolder.P2pKey = ctx.GlobalString(p2pKeyPemFile.Name)
 
 	if ctx.IsSet(startInEpoch.Name) {
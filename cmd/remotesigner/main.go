@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+
+	"github.com/multiversx/mx-chain-go/keysManagement/keyStore"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type cfg struct {
+	keysFile          string
+	passphraseFile    string
+	address           string
+	certificateFile   string
+	keyFile           string
+	caCertificateFile string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// keysFile defines a flag for the file holding the BLS keys used for signing
+	keysFile = cli.StringFlag{
+		Name:        "keys-file",
+		Usage:       "The file holding the BLS keys used for signing, either in the allValidatorsKeys.pem format or encrypted",
+		Value:       "./allValidatorsKeys.pem",
+		Destination: &argsConfig.keysFile,
+	}
+	// passphraseFile defines a flag for the file holding the passphrase of the encrypted keys file
+	passphraseFile = cli.StringFlag{
+		Name: "passphrase-file",
+		Usage: "The file holding the passphrase of the encrypted keys file. If not provided, the passphrase is read " +
+			"from the " + keyStore.PassphraseEnvVariable + " environment variable",
+		Destination: &argsConfig.passphraseFile,
+	}
+	// address defines a flag for the address on which the signer listens
+	address = cli.StringFlag{
+		Name:        "address",
+		Usage:       "The address on which the signer listens for HTTPS signing requests",
+		Value:       "localhost:9595",
+		Destination: &argsConfig.address,
+	}
+	// certificateFile defines a flag for the TLS certificate of the signer
+	certificateFile = cli.StringFlag{
+		Name:        "tls-certificate",
+		Usage:       "The TLS certificate of the signer",
+		Value:       "./server.crt",
+		Destination: &argsConfig.certificateFile,
+	}
+	// keyFile defines a flag for the key of the TLS certificate of the signer
+	keyFile = cli.StringFlag{
+		Name:        "tls-key",
+		Usage:       "The key of the TLS certificate of the signer",
+		Value:       "./server.key",
+		Destination: &argsConfig.keyFile,
+	}
+	// caCertificateFile defines a flag for the certificate of the authority which issued the nodes certificates
+	caCertificateFile = cli.StringFlag{
+		Name:        "tls-ca-certificate",
+		Usage:       "The certificate of the authority which issued the TLS certificates of the nodes allowed to request signatures",
+		Value:       "./ca.crt",
+		Destination: &argsConfig.caCertificateFile,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("remotesigner")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Remote signer"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary is a stand-in remote signer, holding the BLS keys of the validators and signing on their behalf over mutual TLS"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		keysFile,
+		passphraseFile,
+		address,
+		certificateFile,
+		keyFile,
+		caCertificateFile,
+	}
+	app.Action = startSigner
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error running the remote signer", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func startSigner(_ *cli.Context) error {
+	keyGenerator := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	privateKeys, err := loadPrivateKeys(keyGenerator, argsConfig.keysFile, argsConfig.passphraseFile)
+	if err != nil {
+		return err
+	}
+
+	handler, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+		PrivateKeys:  privateKeys,
+		SingleSigner: &mclSig.BlsSingleSigner{},
+	})
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := remoteSigner.NewServerTLSConfig(argsConfig.certificateFile, argsConfig.keyFile, argsConfig.caCertificateFile)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              argsConfig.address,
+		Handler:           handler,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: time.Second,
+	}
+
+	go func() {
+		log.Info("remote signer started", "address", argsConfig.address, "num keys", len(privateKeys))
+
+		errServe := server.ListenAndServeTLS("", "")
+		if errServe != nil && !errors.Is(errServe, http.ErrServerClosed) {
+			log.Error("remote signer stopped", "error", errServe)
+		}
+	}()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+
+	log.Info("terminating the remote signer")
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	return server.Shutdown(ctx)
+}
+
+func loadPrivateKeys(keyGenerator crypto.KeyGenerator, fileName string, passphraseFileName string) ([]crypto.PrivateKey, error) {
+	encodedPrivateKeys, publicKeys, err := keyStore.NewKeyLoader(passphraseFileName).LoadAllKeys(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeys := make([]crypto.PrivateKey, 0, len(encodedPrivateKeys))
+	for i, encodedPrivateKey := range encodedPrivateKeys {
+		privateKeyBytes, errDecode := hex.DecodeString(string(encodedPrivateKey))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for the private key of %s", errDecode, publicKeys[i])
+		}
+
+		privateKey, errKey := keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+		if errKey != nil {
+			return nil, fmt.Errorf("%w for the private key of %s", errKey, publicKeys[i])
+		}
+
+		log.Debug("loaded BLS key", "public key", publicKeys[i])
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	return privateKeys, nil
+}
@@ -1,8 +1,11 @@
 package chainSimulator
 
 import (
+	"math"
 	"path"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+
 	sovCommon "github.com/multiversx/mx-chain-go/cmd/sovereignnode/chainSimulator/common"
 	sovChainSimConfig "github.com/multiversx/mx-chain-go/cmd/sovereignnode/chainSimulator/configs"
 	sovereignConfig "github.com/multiversx/mx-chain-go/cmd/sovereignnode/config"
@@ -39,6 +42,9 @@ func NewSovereignChainSimulator(args ArgsSovereignChainSimulator) (chainSimulato
 	if err != nil {
 		return nil, err
 	}
+	configs.SovereignExtraConfig.MainChainNotarization.MainChainID = chainSimulatorConfigs.ChainID
+	// incoming headers are created by the simulator users, without any main chain to prove their events against
+	configs.SovereignEpochConfig.SovereignChainSpecificEnableEpochs.IncomingEventsProofsEnableEpoch = math.MaxUint32
 
 	args.AlterConfigsFunction = func(cfg *config.Configs) {
 		cfg.EconomicsConfig = configs.EconomicsConfig
@@ -58,8 +64,8 @@ func NewSovereignChainSimulator(args ArgsSovereignChainSimulator) (chainSimulato
 	args.CreateRunTypeCoreComponents = func() (factory.RunTypeCoreComponentsHolder, error) {
 		return createSovereignRunTypeCoreComponents(*configs.SovereignEpochConfig)
 	}
-	args.CreateIncomingHeaderSubscriber = func(config config.WebSocketConfig, dataPool dataRetriever.PoolsHolder, mainChainNotarizationStartRound uint64, runTypeComponents factory.RunTypeComponentsHolder) (process.IncomingHeaderSubscriber, error) {
-		return incomingHeader.CreateIncomingHeaderProcessor(config, dataPool, mainChainNotarizationStartRound, runTypeComponents)
+	args.CreateIncomingHeaderSubscriber = func(config config.SovereignConfig, addressPubKeyConverter core.PubkeyConverter, dataPool dataRetriever.PoolsHolder, storageService dataRetriever.StorageService, runTypeComponents factory.RunTypeComponentsHolder, enableEpochsHandler common.EnableEpochsHandler, appStatusHandler core.AppStatusHandler) (process.IncomingHeaderSubscriber, error) {
+		return incomingHeader.CreateIncomingHeadersRouter(config, addressPubKeyConverter, dataPool, storageService, runTypeComponents, enableEpochsHandler, appStatusHandler)
 	}
 	if args.CreateRunTypeComponents == nil {
 		args.CreateRunTypeComponents = func(args runType.ArgsRunTypeComponents) (factory.RunTypeComponentsHolder, error) {
@@ -107,8 +107,10 @@ func TestSovereignChainSimulator_DeployBridgeContractsThenIssueAndDeposit(t *tes
 
 	depositAndCheckTokens(t, cs, wallet, nonce, bridgeData, tokenIdentifier, supply)
 
-	// Wait for outgoing operations to get unconfirmed and check we have one, which is also saved in storage
-	time.Sleep(time.Second)
+	// Generate one more block, whose timestamp is past the time to wait, for outgoing operations to get unconfirmed and
+	// check we have one, which is also saved in storage
+	err = cs.GenerateBlocks(1)
+	require.Nil(t, err)
 
 	checkOutGoingOperation(t, cs)
 }
@@ -191,8 +193,10 @@ func TestSovereignChainSimulator_DeployBridgeContractsAndDepositMainChainToken(t
 
 	depositAndCheckTokens(t, cs, wallet, nonce, bridgeData, mainChainToken, mainChainTokenSupply)
 
-	// Wait for outgoing operations to get unconfirmed and check we have one, which is also saved in storage
-	time.Sleep(time.Second)
+	// Generate one more block, whose timestamp is past the time to wait, for outgoing operations to get unconfirmed and
+	// check we have one, which is also saved in storage
+	err = cs.GenerateBlocks(1)
+	require.Nil(t, err)
 
 	checkOutGoingOperation(t, cs)
 }
@@ -194,15 +194,22 @@ func checkEpochChangeHeader(
 	require.True(t, currentHeader.IsStartOfEpochBlock())
 
 	mbs := currentHeader.GetMiniBlockHeaderHandlers()
-	require.Len(t, mbs, 2)
+	require.Len(t, mbs, 3)
 
 	require.Equal(t, block.RewardsBlock, block.Type(mbs[0].GetTypeInt32()))
 	require.Equal(t, block.PeerBlock, block.Type(mbs[1].GetTypeInt32()))
+	require.Equal(t, block.TxBlock, block.Type(mbs[2].GetTypeInt32()))
+	require.Equal(t, core.MainChainShardId, mbs[2].GetReceiverShardID())
 
 	require.Equal(t, mbs[0].GetTxCount(), uint32(7))  // consensus group reward txs = 6 + 1 reward tx protocol sustainability
 	require.Equal(t, mbs[1].GetTxCount(), uint32(18)) // 18 validators in total => 18 peer block updates
+	require.Equal(t, mbs[2].GetTxCount(), uint32(1))  // validator set rotation outgoing operation
 
-	require.Equal(t, uint32(25), currentHeader.GetTxCount())
+	require.Equal(t, uint32(26), currentHeader.GetTxCount())
+
+	outGoingMbHeader := currentHeader.(data.SovereignChainHeaderHandler).GetOutGoingMiniBlockHeaderHandler()
+	require.NotNil(t, outGoingMbHeader)
+	require.Equal(t, mbs[2].GetHash(), outGoingMbHeader.GetHash())
 
 	unComputedRootHash := nodeHandler.GetCoreComponents().Hasher().Compute("uncomputed root hash")
 	require.NotEqual(t, unComputedRootHash, currentHeader.GetRootHash())
@@ -43,8 +43,8 @@ const (
 
 type sovChainBlockTracer interface {
 	proc.BlockTracker
-	ComputeLongestExtendedShardChainFromLastNotarized() ([]data.HeaderHandler, [][]byte, error)
-	IsGenesisLastCrossNotarizedHeader() bool
+	ComputeLongestExtendedShardChainFromLastNotarized(shardID uint32) ([]data.HeaderHandler, [][]byte, error)
+	IsGenesisLastCrossNotarizedHeader(shardID uint32) bool
 }
 
 // This test will simulate an incoming header.
@@ -160,7 +160,7 @@ func TestSovereignChainSimulator_AddIncomingHeaderCase1(t *testing.T) {
 		// We just received header in pool and notified all subscribed components, header has not been processed + committed.
 		// We check how leader will compute the longest incoming header chain
 		extendedHeaderHash := getExtendedHeaderHash(t, nodeHandler, incomingHdr)
-		longestChain, longestChainHdrHashes, err := sovBlockTracker.ComputeLongestExtendedShardChainFromLastNotarized()
+		longestChain, longestChainHdrHashes, err := sovBlockTracker.ComputeLongestExtendedShardChainFromLastNotarized(core.MainChainShardId)
 		require.Nil(t, err)
 
 		if currIncomingHeaderRound < startRound {
@@ -193,24 +193,24 @@ func TestSovereignChainSimulator_AddIncomingHeaderCase1(t *testing.T) {
 		// Check tracker and blockchain hook state for incoming processed data
 		if currIncomingHeaderRound <= 99 {
 			require.Zero(t, lastCrossNotarizedHeader.GetRound())
-			require.True(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+			require.True(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 			require.Empty(t, currentSovHeader.GetExtendedShardHeaderHashes())
 		} else if currIncomingHeaderRound == 100 { // pre-genesis incoming header is notarized
 			require.Equal(t, uint64(100), lastCrossNotarizedHeader.GetRound())
-			require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+			require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 			require.Empty(t, currentSovHeader.GetExtendedShardHeaderHashes())
 		} else { // since genesis main-chain header, each incoming header is instantly notarized (0 block finality)
 			if currentSovHeader.IsStartOfEpochBlock() { // epoch start block, no incoming header process is added to sovereign block
 				require.Equal(t, currIncomingHeaderRound-1, lastCrossNotarizedHeader.GetRound())
-				require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+				require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 				require.Empty(t, currentSovHeader.GetExtendedShardHeaderHashes())
 			} else if prevSovHdr.IsStartOfEpochBlock() { // prev sov block was epoch start, should have 2 accumulated incoming headers
 				require.Equal(t, currIncomingHeaderRound, lastCrossNotarizedHeader.GetRound())
-				require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+				require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 				require.Equal(t, [][]byte{previousExtendedHeaderHash, extendedHeaderHash}, currentSovHeader.GetExtendedShardHeaderHashes())
 			} else { // normal processing, in each sovereign block, there is an extended header hash
 				require.Equal(t, currIncomingHeaderRound, lastCrossNotarizedHeader.GetRound())
-				require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+				require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 				require.Equal(t, [][]byte{extendedHeaderHash}, currentSovHeader.GetExtendedShardHeaderHashes())
 			}
 		}
@@ -260,7 +260,7 @@ func TestSovereignChainSimulator_AddIncomingHeaderCase2(t *testing.T) {
 	nodeHandler := cs.GetNodeHandler(core.SovereignChainShardId)
 
 	sovBlockTracker := getSovereignBlockTracker(t, nodeHandler)
-	require.True(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+	require.True(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 
 	incomingHdrNonce := startRound - 1
 	prevHeader := createHeaderV2(incomingHdrNonce, generateRandomHash(), generateRandomHash())
@@ -318,7 +318,7 @@ func TestSovereignChainSimulator_AddIncomingHeaderCase3(t *testing.T) {
 		RequestExtendedShardHeaderCalled: func(hash []byte) {
 			require.Fail(t, "should not request any extended header")
 		},
-		RequestExtendedShardHeaderByNonceCalled: func(nonce uint64) {
+		RequestIncomingChainHeaderByNonceCalled: func(incomingChainShardID uint32, nonce uint64) {
 			require.Fail(t, "should not request any extended header")
 		},
 	}
@@ -365,7 +365,7 @@ func TestSovereignChainSimulator_AddIncomingHeaderCase3(t *testing.T) {
 	nodeHandler := cs.GetNodeHandler(core.SovereignChainShardId)
 
 	sovBlockTracker := getSovereignBlockTracker(t, nodeHandler)
-	require.True(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+	require.True(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 
 	incomingHdrNonce := startRound - 3
 	prevHeader := createHeaderV2(incomingHdrNonce, generateRandomHash(), generateRandomHash())
@@ -543,5 +543,5 @@ func checkLastCrossNotarizedRound(t *testing.T, sovBlockTracker sovChainBlockTra
 	lastCrossNotarizedHeader, _, err := sovBlockTracker.GetLastCrossNotarizedHeader(core.MainChainShardId)
 	require.Nil(t, err)
 	require.Equal(t, lastCrossNotarizedRound, lastCrossNotarizedHeader.GetRound())
-	require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader())
+	require.False(t, sovBlockTracker.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
 }
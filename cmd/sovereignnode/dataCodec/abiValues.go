@@ -0,0 +1,223 @@
+package dataCodec
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-sdk-abi-go/abi"
+)
+
+// eventDataAbiValues holds the abi placeholders in which an event data is decoded
+type eventDataAbiValues struct {
+	nonce    *abi.U64Value
+	sender   *abi.AddressValue
+	gasLimit *abi.U64Value
+	function *abi.BytesValue
+	args     *abi.ListValue
+}
+
+func newEventDataAbiValues() *eventDataAbiValues {
+	return &eventDataAbiValues{
+		nonce:    &abi.U64Value{},
+		sender:   &abi.AddressValue{},
+		gasLimit: &abi.U64Value{},
+		function: &abi.BytesValue{},
+		args: &abi.ListValue{
+			ItemCreator: func() abi.SingleValue {
+				return &abi.BytesValue{}
+			},
+		},
+	}
+}
+
+func (values *eventDataAbiValues) toStruct() *abi.StructValue {
+	return &abi.StructValue{
+		Fields: []abi.Field{
+			{
+				Name:  "op_nonce",
+				Value: values.nonce,
+			},
+			{
+				Name:  "op_sender",
+				Value: values.sender,
+			},
+			{
+				Name: "opt_transfer_data",
+				Value: &abi.OptionValue{
+					Value: &abi.StructValue{
+						Fields: []abi.Field{
+							{
+								Name:  "gas_limit",
+								Value: values.gasLimit,
+							},
+							{
+								Name:  "function",
+								Value: values.function,
+							},
+							{
+								Name:  "args",
+								Value: values.args,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (values *eventDataAbiValues) toEventData() (*sovereign.EventData, error) {
+	arguments, err := getTransferDataArguments(values.args.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sovereign.EventData{
+		Nonce:        values.nonce.Value,
+		Sender:       values.sender.Value,
+		TransferData: getTransferData(values.gasLimit.Value, values.function.Value, arguments),
+	}, nil
+}
+
+// tokenDataAbiValues holds the abi placeholders in which an esdt token data is decoded
+type tokenDataAbiValues struct {
+	tokenType  *abi.EnumValue
+	amount     *abi.BigUIntValue
+	frozen     *abi.BoolValue
+	hash       *abi.BytesValue
+	name       *abi.BytesValue
+	attributes *abi.BytesValue
+	creator    *abi.AddressValue
+	royalties  *abi.BigUIntValue
+	uris       *abi.ListValue
+}
+
+func newTokenDataAbiValues() *tokenDataAbiValues {
+	return &tokenDataAbiValues{
+		tokenType: &abi.EnumValue{
+			FieldsProvider: func(discriminant uint8) []abi.Field {
+				return nil
+			},
+		},
+		amount:     &abi.BigUIntValue{},
+		frozen:     &abi.BoolValue{},
+		hash:       &abi.BytesValue{},
+		name:       &abi.BytesValue{},
+		attributes: &abi.BytesValue{},
+		creator:    &abi.AddressValue{},
+		royalties:  &abi.BigUIntValue{},
+		uris: &abi.ListValue{
+			ItemCreator: func() abi.SingleValue {
+				return &abi.BytesValue{}
+			},
+		},
+	}
+}
+
+func (values *tokenDataAbiValues) toStruct() *abi.StructValue {
+	return &abi.StructValue{
+		Fields: []abi.Field{
+			{
+				Name:  "token_type",
+				Value: values.tokenType,
+			},
+			{
+				Name:  "amount",
+				Value: values.amount,
+			},
+			{
+				Name:  "frozen",
+				Value: values.frozen,
+			},
+			{
+				Name:  "hash",
+				Value: values.hash,
+			},
+			{
+				Name:  "name",
+				Value: values.name,
+			},
+			{
+				Name:  "attributes",
+				Value: values.attributes,
+			},
+			{
+				Name:  "creator",
+				Value: values.creator,
+			},
+			{
+				Name:  "royalties",
+				Value: values.royalties,
+			},
+			{
+				Name:  "uris",
+				Value: values.uris,
+			},
+		},
+	}
+}
+
+func (values *tokenDataAbiValues) toEsdtTokenData() (*sovereign.EsdtTokenData, error) {
+	tokenUris, err := getTokenDataUris(values.uris.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sovereign.EsdtTokenData{
+		TokenType:  core.ESDTType(values.tokenType.Discriminant),
+		Amount:     values.amount.Value,
+		Frozen:     values.frozen.Value,
+		Hash:       values.hash.Value,
+		Name:       values.name.Value,
+		Attributes: values.attributes.Value,
+		Creator:    values.creator.Value,
+		Royalties:  values.royalties.Value,
+		Uris:       tokenUris,
+	}, nil
+}
+
+// tokenAbiValues holds the abi placeholders in which an operation token is decoded
+type tokenAbiValues struct {
+	identifier *abi.BytesValue
+	nonce      *abi.U64Value
+	data       *tokenDataAbiValues
+}
+
+func newTokenAbiValues() *tokenAbiValues {
+	return &tokenAbiValues{
+		identifier: &abi.BytesValue{},
+		nonce:      &abi.U64Value{},
+		data:       newTokenDataAbiValues(),
+	}
+}
+
+func (values *tokenAbiValues) toStruct() *abi.StructValue {
+	return &abi.StructValue{
+		Fields: []abi.Field{
+			{
+				Name:  "token_identifier",
+				Value: values.identifier,
+			},
+			{
+				Name:  "token_nonce",
+				Value: values.nonce,
+			},
+			{
+				Name:  "token_data",
+				Value: values.data.toStruct(),
+			},
+		},
+	}
+}
+
+func (values *tokenAbiValues) toEsdtToken() (*sovereign.EsdtToken, error) {
+	tokenData, err := values.data.toEsdtTokenData()
+	if err != nil {
+		return nil, err
+	}
+
+	return &sovereign.EsdtToken{
+		Identifier: values.identifier.Value,
+		Nonce:      values.nonce.Value,
+		Data:       *tokenData,
+	}, nil
+}
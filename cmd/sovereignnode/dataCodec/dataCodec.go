@@ -6,7 +6,6 @@ import (
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-sdk-abi-go/abi"
 )
@@ -69,67 +68,13 @@ func (dc *dataCodec) DeserializeEventData(data []byte) (*sovereign.EventData, er
 		return nil, errEmptyData
 	}
 
-	nonce := &abi.U64Value{}
-	sender := &abi.AddressValue{}
-	gasLimit := &abi.U64Value{}
-	function := &abi.BytesValue{}
-	args := &abi.ListValue{
-		ItemCreator: func() abi.SingleValue {
-			return &abi.BytesValue{}
-		},
-	}
-
-	eventDataStruct := &abi.StructValue{
-		Fields: []abi.Field{
-			{
-				Name:  "op_nonce",
-				Value: nonce,
-			},
-			{
-				Name:  "op_sender",
-				Value: sender,
-			},
-			{
-				Name: "opt_transfer_data",
-				Value: &abi.OptionValue{
-					Value: &abi.StructValue{
-						Fields: []abi.Field{
-							{
-								Name:  "gas_limit",
-								Value: gasLimit,
-							},
-							{
-								Name:  "function",
-								Value: function,
-							},
-							{
-								Name:  "args",
-								Value: args,
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-
-	err := dc.serializer.Deserialize(hex.EncodeToString(data), []any{eventDataStruct})
-	if err != nil {
-		return nil, err
-	}
-
-	arguments, err := getTransferDataArguments(args.Items)
+	eventDataValues := newEventDataAbiValues()
+	err := dc.serializer.Deserialize(hex.EncodeToString(data), []any{eventDataValues.toStruct()})
 	if err != nil {
 		return nil, err
 	}
 
-	transferData := getTransferData(gasLimit.Value, function.Value, arguments)
-
-	return &sovereign.EventData{
-		Nonce:        nonce.Value,
-		Sender:       sender.Value,
-		TransferData: transferData,
-	}, nil
+	return eventDataValues.toEventData()
 }
 
 // SerializeTokenData will receive an esdt token data and serialize it
@@ -197,98 +142,86 @@ func (dc *dataCodec) DeserializeTokenData(data []byte) (*sovereign.EsdtTokenData
 		return nil, errEmptyTokenData
 	}
 
-	enum := &abi.EnumValue{
-		FieldsProvider: func(discriminant uint8) []abi.Field {
-			return nil
-		},
+	tokenDataValues := newTokenDataAbiValues()
+	err := dc.serializer.Deserialize(hex.EncodeToString(data), []any{tokenDataValues.toStruct()})
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenDataValues.toEsdtTokenData()
+}
+
+// SerializeOperation will receive an operation and serialize it
+func (dc *dataCodec) SerializeOperation(operation sovereign.Operation) ([]byte, error) {
+	operationStruct := getOperationStruct(operation)
+
+	encodedOp, err := dc.serializer.Serialize([]any{operationStruct})
+	if err != nil {
+		return nil, err
 	}
-	amount := &abi.BigUIntValue{}
-	frozen := &abi.BoolValue{}
-	hash := &abi.BytesValue{}
-	name := &abi.BytesValue{}
-	attributes := &abi.BytesValue{}
-	creator := &abi.AddressValue{}
-	royalties := &abi.BigUIntValue{}
-	uris := &abi.ListValue{
+
+	return hex.DecodeString(encodedOp)
+}
+
+// DeserializeOperation will deserialize bytes to an operation
+func (dc *dataCodec) DeserializeOperation(data []byte) (*sovereign.Operation, error) {
+	if len(data) == 0 {
+		return nil, errEmptyOperationData
+	}
+
+	address := &abi.AddressValue{}
+	tokensValues := make([]*tokenAbiValues, 0)
+	tokens := &abi.ListValue{
 		ItemCreator: func() abi.SingleValue {
-			return &abi.BytesValue{}
+			tokenValues := newTokenAbiValues()
+			tokensValues = append(tokensValues, tokenValues)
+			return tokenValues.toStruct()
 		},
 	}
+	eventDataValues := newEventDataAbiValues()
 
-	tokenDataStruct := &abi.StructValue{
+	operationStruct := &abi.StructValue{
 		Fields: []abi.Field{
 			{
-				Name:  "token_type",
-				Value: enum,
-			},
-			{
-				Name:  "amount",
-				Value: amount,
-			},
-			{
-				Name:  "frozen",
-				Value: frozen,
-			},
-			{
-				Name:  "hash",
-				Value: hash,
-			},
-			{
-				Name:  "name",
-				Value: name,
-			},
-			{
-				Name:  "attributes",
-				Value: attributes,
-			},
-			{
-				Name:  "creator",
-				Value: creator,
+				Name:  "to",
+				Value: address,
 			},
 			{
-				Name:  "royalties",
-				Value: royalties,
+				Name:  "tokens",
+				Value: tokens,
 			},
 			{
-				Name:  "uris",
-				Value: uris,
+				Name:  "data",
+				Value: eventDataValues.toStruct(),
 			},
 		},
 	}
 
-	err := dc.serializer.Deserialize(hex.EncodeToString(data), []any{tokenDataStruct})
-	if err != nil {
-		return nil, err
-	}
-
-	tokenUris, err := getTokenDataUris(uris.Items)
+	err := dc.serializer.Deserialize(hex.EncodeToString(data), []any{operationStruct})
 	if err != nil {
 		return nil, err
 	}
 
-	return &sovereign.EsdtTokenData{
-		TokenType:  core.ESDTType(enum.Discriminant),
-		Amount:     amount.Value,
-		Frozen:     frozen.Value,
-		Hash:       hash.Value,
-		Name:       name.Value,
-		Attributes: attributes.Value,
-		Creator:    creator.Value,
-		Royalties:  royalties.Value,
-		Uris:       tokenUris,
-	}, nil
-}
+	operationTokens := make([]sovereign.EsdtToken, 0, len(tokensValues))
+	for _, tokenValues := range tokensValues {
+		token, errGet := tokenValues.toEsdtToken()
+		if errGet != nil {
+			return nil, errGet
+		}
 
-// SerializeOperation will receive an operation and serialize it
-func (dc *dataCodec) SerializeOperation(operation sovereign.Operation) ([]byte, error) {
-	operationStruct := getOperationStruct(operation)
+		operationTokens = append(operationTokens, *token)
+	}
 
-	encodedOp, err := dc.serializer.Serialize([]any{operationStruct})
+	eventData, err := eventDataValues.toEventData()
 	if err != nil {
 		return nil, err
 	}
 
-	return hex.DecodeString(encodedOp)
+	return &sovereign.Operation{
+		Address: address.Value,
+		Tokens:  operationTokens,
+		Data:    eventData,
+	}, nil
 }
 
 func getOperationStruct(operation sovereign.Operation) *abi.StructValue {
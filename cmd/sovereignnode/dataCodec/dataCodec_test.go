@@ -323,3 +323,102 @@ func TestDataCodec_SerializeOperation(t *testing.T) {
 		require.Equal(t, "c0c0739e0cf6232a934d2e56cfcd10881eb1c7336f128fc155a4a84292cfe7f6000000010000000a53564e2d3132333435360000000000000000000000000906aaf7c8516d0c00000000000004686173680000000453564e3100000004617474720000000000000000000000000000000000000000000000000000000000000000000000021b58000000010000000475726c31000000000000000a000000000000000000000000000000000000000000000000000000000000000000", hex.EncodeToString(serialized))
 	})
 }
+
+func TestDataCodec_DeserializeOperation(t *testing.T) {
+	t.Parallel()
+
+	abiCodec := createDataCodec()
+
+	t.Run("empty data should fail", func(t *testing.T) {
+		t.Parallel()
+
+		operation, err := abiCodec.DeserializeOperation([]byte{})
+		require.Nil(t, operation)
+		require.Equal(t, errEmptyOperationData, err)
+	})
+	t.Run("invalid data should fail", func(t *testing.T) {
+		t.Parallel()
+
+		operation, err := abiCodec.DeserializeOperation([]byte("invalid"))
+		require.Nil(t, operation)
+		require.NotNil(t, err)
+	})
+	t.Run("full operation should work", func(t *testing.T) {
+		t.Parallel()
+
+		serialized, _ := hex.DecodeString("c0c0739e0cf6232a934d2e56cfcd10881eb1c7336f128fc155a4a84292cfe7f6000000020000000a53564e2d3132333435360000000000000000000000000906aaf7c8516d0c00000000000004686173680000000353564e00000004617474720000000000000000000000000000000000000000000000000000000000000000000000022710000000010000000475726c310000000a53564e2d3635343332310000000000000000000000000906aaf7c8516d0c0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000640000000000000000000000000000000000000000000000000000000000000000010000000001312d0000000003616464000000010000000401312d00")
+
+		receiver, _ := hex.DecodeString("c0c0739e0cf6232a934d2e56cfcd10881eb1c7336f128fc155a4a84292cfe7f6")
+		addr, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000000")
+		amount := new(big.Int)
+		amount.SetString("123000000000000000000", 10)
+
+		operation, err := abiCodec.DeserializeOperation(serialized)
+		require.Nil(t, err)
+		require.Equal(t, receiver, operation.Address)
+		require.Len(t, operation.Tokens, 2)
+
+		require.Equal(t, []byte("SVN-123456"), operation.Tokens[0].Identifier)
+		require.Equal(t, uint64(0), operation.Tokens[0].Nonce)
+		require.Equal(t, core.Fungible, operation.Tokens[0].Data.TokenType)
+		require.Equal(t, amount, operation.Tokens[0].Data.Amount)
+		require.False(t, operation.Tokens[0].Data.Frozen)
+		require.Equal(t, []byte("hash"), operation.Tokens[0].Data.Hash)
+		require.Equal(t, []byte("SVN"), operation.Tokens[0].Data.Name)
+		require.Equal(t, []byte("attr"), operation.Tokens[0].Data.Attributes)
+		require.Equal(t, addr, operation.Tokens[0].Data.Creator)
+		require.Equal(t, big.NewInt(10000), operation.Tokens[0].Data.Royalties)
+		require.Equal(t, [][]byte{[]byte("url1")}, operation.Tokens[0].Data.Uris)
+
+		require.Equal(t, []byte("SVN-654321"), operation.Tokens[1].Identifier)
+		require.Equal(t, amount, operation.Tokens[1].Data.Amount)
+		require.Empty(t, operation.Tokens[1].Data.Uris)
+
+		require.Equal(t, &sovereign.EventData{
+			Nonce:  100,
+			Sender: addr,
+			TransferData: &sovereign.TransferData{
+				GasLimit: 20000000,
+				Function: []byte("add"),
+				Args:     [][]byte{big.NewInt(20000000).Bytes()},
+			},
+		}, operation.Data)
+	})
+	t.Run("serialize and deserialize operation with nil transfer data should work", func(t *testing.T) {
+		t.Parallel()
+
+		receiver, _ := hex.DecodeString("c0c0739e0cf6232a934d2e56cfcd10881eb1c7336f128fc155a4a84292cfe7f6")
+		addr, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000000")
+		operation := sovereign.Operation{
+			Address: receiver,
+			Tokens: []sovereign.EsdtToken{
+				{
+					Identifier: []byte("sov-NFT-123456"),
+					Nonce:      5,
+					Data: sovereign.EsdtTokenData{
+						TokenType:  core.NonFungibleV2,
+						Amount:     big.NewInt(1),
+						Frozen:     true,
+						Hash:       []byte("hash"),
+						Name:       []byte("NFT"),
+						Attributes: []byte("attr"),
+						Creator:    addr,
+						Royalties:  big.NewInt(2500),
+						Uris:       [][]byte{[]byte("url1"), []byte("url2")},
+					},
+				},
+			},
+			Data: &sovereign.EventData{
+				Nonce:  10,
+				Sender: addr,
+			},
+		}
+
+		serialized, err := abiCodec.SerializeOperation(operation)
+		require.Nil(t, err)
+
+		deserialized, err := abiCodec.DeserializeOperation(serialized)
+		require.Nil(t, err)
+		require.Equal(t, operation, *deserialized)
+	})
+}
@@ -5,3 +5,5 @@ import "errors"
 var errEmptyData = errors.New("empty bytes to deserialize event data")
 
 var errEmptyTokenData = errors.New("empty bytes to deserialize token data")
+
+var errEmptyOperationData = errors.New("empty bytes to deserialize operation")
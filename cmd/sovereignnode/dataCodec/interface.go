@@ -22,7 +22,8 @@ type TokenDataEncoder interface {
 	DeserializeTokenData(data []byte) (*sovereign.EsdtTokenData, error)
 }
 
-// OperationDataEncoder is the interface for serializing operations
+// OperationDataEncoder is the interface for serializing/deserializing operations
 type OperationDataEncoder interface {
 	SerializeOperation(operation sovereign.Operation) ([]byte, error)
+	DeserializeOperation(data []byte) (*sovereign.Operation, error)
 }
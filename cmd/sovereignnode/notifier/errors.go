@@ -3,3 +3,11 @@ package notifier
 import "errors"
 
 var errNilSovereignNotifier = errors.New("nil sovereign notifier provided")
+
+var errNilPayloadProcessor = errors.New("nil payload processor provided")
+
+var errInvalidIncomingHeader = errors.New("invalid incoming header received from the sovereign notifier")
+
+var errNoOutportBlockNotified = errors.New("incoming header received without an outport block being notified")
+
+var errIncomingEventNotFoundInBlock = errors.New("incoming event not found in the logs of the notified outport block")
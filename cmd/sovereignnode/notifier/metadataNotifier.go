@@ -0,0 +1,522 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	notifierProcess "github.com/multiversx/mx-chain-sovereign-notifier-go/process"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/incomingHeader"
+)
+
+// ArgsMetadataNotifier defines args needed to create a new metadata notifier
+type ArgsMetadataNotifier struct {
+	SovereignNotifier notifierProcess.SovereignNotifier
+	Marshaller        marshal.Marshalizer
+	Hasher            hashing.Hasher
+}
+
+type metadataNotifier struct {
+	sovereignNotifier notifierProcess.SovereignNotifier
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+
+	mutNotify    sync.Mutex
+	currentBlock *outport.OutportBlock
+
+	mutSubscribers sync.RWMutex
+	subscribers    []notifierProcess.IncomingHeaderSubscriber
+}
+
+// NewMetadataNotifier creates a sovereign notifier which wraps the provided notifier and attaches to each incoming event
+// its metadata, computed from the notified outport block: the main chain transaction or smart contract result which
+// generated the event, the smart contract result which returns ok to its sender and the mini blocks which include them,
+// each of them only attached to the first event referencing it. The metadata is carried inside the events, so that it is also part of the extended headers created from them. The
+// incoming header hash is the one computed by the wrapped notifier.
+func NewMetadataNotifier(args ArgsMetadataNotifier) (*metadataNotifier, error) {
+	if check.IfNil(args.SovereignNotifier) {
+		return nil, errNilSovereignNotifier
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, errors.ErrNilHasher
+	}
+
+	mn := &metadataNotifier{
+		sovereignNotifier: args.SovereignNotifier,
+		marshaller:        args.Marshaller,
+		hasher:            args.Hasher,
+		subscribers:       make([]notifierProcess.IncomingHeaderSubscriber, 0),
+	}
+
+	err := args.SovereignNotifier.RegisterHandler(mn)
+	if err != nil {
+		return nil, err
+	}
+
+	return mn, nil
+}
+
+// Notify forwards the outport block to the wrapped notifier, which will notify back the created incoming header. The
+// blocks of an incoming sovereign chain are forwarded with their sovereign chain header wrapped in a header v2, which
+// is the only header type handled by the wrapped notifier.
+func (mn *metadataNotifier) Notify(outportBlock *outport.OutportBlock) error {
+	mn.mutNotify.Lock()
+	defer mn.mutNotify.Unlock()
+
+	notifiedBlock, err := mn.wrapSovereignChainHeader(outportBlock)
+	if err != nil {
+		return err
+	}
+
+	mn.currentBlock = notifiedBlock
+	defer func() {
+		mn.currentBlock = nil
+	}()
+
+	return mn.sovereignNotifier.Notify(notifiedBlock)
+}
+
+func (mn *metadataNotifier) wrapSovereignChainHeader(outportBlock *outport.OutportBlock) (*outport.OutportBlock, error) {
+	if outportBlock == nil || outportBlock.BlockData == nil || outportBlock.BlockData.HeaderType != core.SovereignChainHeader {
+		return outportBlock, nil
+	}
+
+	sovereignHeader := &block.SovereignChainHeader{}
+	err := mn.marshaller.Unmarshal(sovereignHeader, outportBlock.BlockData.HeaderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	headerV2, err := sovBlock.WrapSovereignChainHeader(mn.marshaller, sovereignHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	headerBytes, err := mn.marshaller.Marshal(headerV2)
+	if err != nil {
+		return nil, err
+	}
+
+	blockData := *outportBlock.BlockData
+	blockData.HeaderType = string(core.ShardHeaderV2)
+	blockData.HeaderBytes = headerBytes
+
+	notifiedBlock := *outportBlock
+	notifiedBlock.BlockData = &blockData
+
+	return &notifiedBlock, nil
+}
+
+// RegisterHandler registers a subscriber which will receive the incoming headers with their events metadata
+func (mn *metadataNotifier) RegisterHandler(handler notifierProcess.IncomingHeaderSubscriber) error {
+	if check.IfNil(handler) {
+		return errors.ErrNilIncomingHeaderSubscriber
+	}
+
+	mn.mutSubscribers.Lock()
+	mn.subscribers = append(mn.subscribers, handler)
+	mn.mutSubscribers.Unlock()
+
+	return nil
+}
+
+// AddHeader is called by the wrapped notifier, while notifying the current outport block. It attaches the events
+// metadata to the incoming header and notifies all the registered subscribers.
+func (mn *metadataNotifier) AddHeader(headerHash []byte, header sovereign.IncomingHeaderHandler) error {
+	notifiedHeader, castOk := header.(*sovereign.IncomingHeader)
+	if !castOk || check.IfNil(notifiedHeader) {
+		return errInvalidIncomingHeader
+	}
+	if mn.currentBlock == nil || mn.currentBlock.TransactionPool == nil {
+		return errNoOutportBlockNotified
+	}
+
+	headerWithMetadata, err := mn.createIncomingHeaderWithMetadata(notifiedHeader)
+	if err != nil {
+		return err
+	}
+
+	mn.mutSubscribers.RLock()
+	defer mn.mutSubscribers.RUnlock()
+
+	for _, subscriber := range mn.subscribers {
+		err = subscriber.AddHeader(headerHash, headerWithMetadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eventsProofs holds the proofs found in the notified block for the events of the notified header, together with the
+// ones already attached to its events
+type eventsProofs struct {
+	sourcesMiniBlocks       map[string]*block.MiniBlock
+	receiptsMiniBlocks      map[*block.MiniBlock]struct{}
+	receiptsMiniBlockHashes [][]byte
+	isReceiptsAttached      bool
+	attachedSources         map[string]struct{}
+	attachedResults         map[string]struct{}
+	attachedMiniBlocks      map[*block.MiniBlock][]byte
+}
+
+func (mn *metadataNotifier) createIncomingHeaderWithMetadata(header *sovereign.IncomingHeader) (*sovereign.IncomingHeader, error) {
+	eventsTxInfo := make(map[*transaction.Event]*incomingHeader.EventTxInfo)
+	for _, logData := range mn.currentBlock.TransactionPool.Logs {
+		txHash := decodeLogTxHash(logData.TxHash)
+		for eventIndex, event := range logData.GetLog().GetEvents() {
+			eventsTxInfo[event] = &incomingHeader.EventTxInfo{
+				TxHash:     txHash,
+				EventIndex: uint32(eventIndex),
+			}
+		}
+	}
+
+	proofs, err := mn.createEventsProofs(header.GetHeaderHandler())
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*transaction.Event, 0, len(header.IncomingEvents))
+	for _, event := range header.IncomingEvents {
+		txInfo, found := eventsTxInfo[event]
+		if !found {
+			return nil, errIncomingEventNotFoundInBlock
+		}
+
+		metadata := &incomingHeader.EventMetadata{
+			TxHash:     txInfo.TxHash,
+			EventIndex: txInfo.EventIndex,
+		}
+		err = mn.setEventSource(metadata, proofs)
+		if err != nil {
+			return nil, err
+		}
+		err = mn.setEventMiniBlock(metadata, proofs)
+		if err != nil {
+			return nil, err
+		}
+		err = mn.setEventExecutionResult(metadata, proofs)
+		if err != nil {
+			return nil, err
+		}
+
+		eventWithMetadata, err := incomingHeader.AttachEventMetadata(event, metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, eventWithMetadata)
+	}
+
+	return &sovereign.IncomingHeader{
+		Header:         header.Header,
+		IncomingEvents: events,
+	}, nil
+}
+
+// createEventsProofs maps each transaction and smart contract result of the notified block to the mini block which
+// includes it: either a mini block from the block body, committed in the mini block headers of the notified header, or
+// a mini block created inside the shard, committed by the receipts hash of the notified header
+func (mn *metadataNotifier) createEventsProofs(header data.HeaderHandler) (*eventsProofs, error) {
+	proofs := &eventsProofs{
+		sourcesMiniBlocks:  make(map[string]*block.MiniBlock),
+		receiptsMiniBlocks: make(map[*block.MiniBlock]struct{}),
+		attachedSources:    make(map[string]struct{}),
+		attachedResults:    make(map[string]struct{}),
+		attachedMiniBlocks: make(map[*block.MiniBlock][]byte),
+	}
+	if mn.currentBlock.BlockData == nil {
+		return proofs, nil
+	}
+
+	if mn.currentBlock.BlockData.Body != nil {
+		for _, miniBlock := range mn.currentBlock.BlockData.Body.MiniBlocks {
+			for _, txHash := range miniBlock.GetTxHashes() {
+				proofs.sourcesMiniBlocks[string(txHash)] = miniBlock
+			}
+		}
+	}
+
+	receiptsMiniBlocks, receiptsMiniBlockHashes, err := mn.getReceiptsMiniBlocks(header)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs.receiptsMiniBlockHashes = receiptsMiniBlockHashes
+	for _, miniBlock := range receiptsMiniBlocks {
+		proofs.receiptsMiniBlocks[miniBlock] = struct{}{}
+		for _, txHash := range miniBlock.GetTxHashes() {
+			_, isInBody := proofs.sourcesMiniBlocks[string(txHash)]
+			if !isInBody {
+				proofs.sourcesMiniBlocks[string(txHash)] = miniBlock
+			}
+		}
+	}
+
+	return proofs, nil
+}
+
+// getReceiptsMiniBlocks returns the mini blocks created inside the shard of the notified block, together with their
+// hashes, which are committed by the receipts hash of the notified header. The main chain computes the receipts hash
+// over these mini blocks ordered by type. The outport driver does not send again the ones which are also part of the
+// block body, hence these are also searched in the body if the receipts hash can not be rebuilt otherwise. No mini
+// blocks are returned if the receipts hash can not be rebuilt from the notified block.
+func (mn *metadataNotifier) getReceiptsMiniBlocks(header data.HeaderHandler) ([]*block.MiniBlock, [][]byte, error) {
+	intraShardMiniBlocks := mn.currentBlock.BlockData.IntraShardMiniBlocks
+	candidatesFromBody := make([]*block.MiniBlock, 0)
+	if mn.currentBlock.BlockData.Body != nil {
+		for _, miniBlock := range mn.currentBlock.BlockData.Body.MiniBlocks {
+			if isCreatedInShardMiniBlock(miniBlock, header.GetShardID()) {
+				candidatesFromBody = append(candidatesFromBody, miniBlock)
+			}
+		}
+	}
+	if len(intraShardMiniBlocks)+len(candidatesFromBody) == 0 {
+		return nil, nil, nil
+	}
+
+	miniBlocks, miniBlockHashes, err := mn.rebuildReceiptsHash(header, intraShardMiniBlocks)
+	if err != nil || miniBlocks != nil || len(candidatesFromBody) == 0 {
+		return miniBlocks, miniBlockHashes, err
+	}
+
+	allCandidates := make([]*block.MiniBlock, 0, len(intraShardMiniBlocks)+len(candidatesFromBody))
+	allCandidates = append(allCandidates, intraShardMiniBlocks...)
+	allCandidates = append(allCandidates, candidatesFromBody...)
+	miniBlocks, miniBlockHashes, err = mn.rebuildReceiptsHash(header, allCandidates)
+	if err != nil || miniBlocks != nil {
+		return miniBlocks, miniBlockHashes, err
+	}
+
+	log.Debug("metadataNotifier: could not rebuild the receipts hash of the notified header",
+		"nonce", header.GetNonce(),
+		"receipts hash", header.GetReceiptsHash(),
+	)
+	return nil, nil, nil
+}
+
+// rebuildReceiptsHash returns the provided mini blocks, ordered by type, and their hashes if they match the receipts
+// hash of the header, or nil otherwise
+func (mn *metadataNotifier) rebuildReceiptsHash(header data.HeaderHandler, candidates []*block.MiniBlock) ([]*block.MiniBlock, [][]byte, error) {
+	miniBlocks := make([]*block.MiniBlock, 0, len(candidates))
+	miniBlocks = append(miniBlocks, candidates...)
+	sort.SliceStable(miniBlocks, func(i, j int) bool {
+		return miniBlocks[i].Type < miniBlocks[j].Type
+	})
+
+	miniBlockHashes := make([][]byte, 0, len(miniBlocks))
+	for _, miniBlock := range miniBlocks {
+		miniBlockHash, err := core.CalculateHash(mn.marshaller, mn.hasher, miniBlock)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		miniBlockHashes = append(miniBlockHashes, miniBlockHash)
+	}
+
+	receiptsHash, err := core.CalculateHash(mn.marshaller, mn.hasher, &batch.Batch{Data: miniBlockHashes})
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(receiptsHash, header.GetReceiptsHash()) {
+		return nil, nil, nil
+	}
+
+	return miniBlocks, miniBlockHashes, nil
+}
+
+// isCreatedInShardMiniBlock returns true if the mini block is one of the smart contract results or receipts mini blocks
+// created inside the provided shard, whose hashes are committed by the receipts hash
+func isCreatedInShardMiniBlock(miniBlock *block.MiniBlock, shardID uint32) bool {
+	if miniBlock.SenderShardID != shardID || miniBlock.ReceiverShardID != shardID {
+		return false
+	}
+
+	return miniBlock.Type == block.SmartContractResultBlock || miniBlock.Type == block.ReceiptBlock
+}
+
+// setEventSource sets in the event metadata the marshalled transaction or smart contract result which generated the
+// event. Each source is only attached to the first event of the header which it generated, the next events only
+// carrying its hash.
+func (mn *metadataNotifier) setEventSource(metadata *incomingHeader.EventMetadata, proofs *eventsProofs) error {
+	_, isAttached := proofs.attachedSources[string(metadata.TxHash)]
+	if isAttached {
+		return nil
+	}
+
+	sourceType, source := mn.getSource(metadata.TxHash)
+	if check.IfNil(source) {
+		log.Debug("metadataNotifier: incoming event source not found in the notified block", "tx hash", metadata.TxHash)
+		return nil
+	}
+
+	sourceBytes, err := mn.marshaller.Marshal(source)
+	if err != nil {
+		return err
+	}
+
+	proofs.attachedSources[string(metadata.TxHash)] = struct{}{}
+	metadata.SourceType = sourceType
+	metadata.Source = sourceBytes
+	return nil
+}
+
+// getSource returns the notified block transaction, invalid transaction or smart contract result with the provided
+// hash, or nil if it is not found
+func (mn *metadataNotifier) getSource(txHash []byte) (incomingHeader.EventSourceType, data.TransactionHandler) {
+	txPool := mn.currentBlock.TransactionPool
+	encodedTxHash := hex.EncodeToString(txHash)
+
+	txInfo, found := txPool.Transactions[encodedTxHash]
+	if found && txInfo != nil && txInfo.Transaction != nil {
+		return incomingHeader.EventSourceTransaction, txInfo.Transaction
+	}
+
+	txInfo, found = txPool.InvalidTxs[encodedTxHash]
+	if found && txInfo != nil && txInfo.Transaction != nil {
+		return incomingHeader.EventSourceTransaction, txInfo.Transaction
+	}
+
+	scrInfo, found := txPool.SmartContractResults[encodedTxHash]
+	if found && scrInfo != nil && scrInfo.SmartContractResult != nil {
+		return incomingHeader.EventSourceSmartContractResult, scrInfo.SmartContractResult
+	}
+
+	return incomingHeader.EventSourceTransaction, nil
+}
+
+// setEventMiniBlock sets in the event metadata the marshalled mini block which includes the event's source
+func (mn *metadataNotifier) setEventMiniBlock(metadata *incomingHeader.EventMetadata, proofs *eventsProofs) error {
+	miniBlock, found := proofs.sourcesMiniBlocks[string(metadata.TxHash)]
+	if !found {
+		log.Debug("metadataNotifier: incoming event source mini block not found in the notified block", "tx hash", metadata.TxHash)
+		return nil
+	}
+
+	var err error
+	metadata.MiniBlock, metadata.MiniBlockHash, err = mn.attachMiniBlock(miniBlock, metadata, proofs)
+	return err
+}
+
+// attachMiniBlock returns the marshalled mini block, if it is the first time it is attached to an event of the header,
+// or only its hash otherwise. The hashes of the mini blocks committed by the receipts hash are attached together with
+// the first of them.
+func (mn *metadataNotifier) attachMiniBlock(
+	miniBlock *block.MiniBlock,
+	metadata *incomingHeader.EventMetadata,
+	proofs *eventsProofs,
+) ([]byte, []byte, error) {
+	miniBlockHash, isAttached := proofs.attachedMiniBlocks[miniBlock]
+	if isAttached {
+		return nil, miniBlockHash, nil
+	}
+
+	miniBlockBytes, err := mn.marshaller.Marshal(miniBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, isReceiptsMiniBlock := proofs.receiptsMiniBlocks[miniBlock]
+	if isReceiptsMiniBlock && !proofs.isReceiptsAttached {
+		proofs.isReceiptsAttached = true
+		metadata.ReceiptsMiniBlockHashes = proofs.receiptsMiniBlockHashes
+	}
+
+	proofs.attachedMiniBlocks[miniBlock] = mn.hasher.Compute(string(miniBlockBytes))
+	return miniBlockBytes, nil, nil
+}
+
+// setEventExecutionResult sets in the event metadata the marshalled smart contract result which returns ok to the sender
+// of the event's source, proving its successful execution, together with the mini block which includes it. Each
+// execution result is only attached to the first event of its source.
+func (mn *metadataNotifier) setEventExecutionResult(metadata *incomingHeader.EventMetadata, proofs *eventsProofs) error {
+	_, isAttached := proofs.attachedResults[string(metadata.TxHash)]
+	if isAttached {
+		return nil
+	}
+
+	resultHash, result := mn.getExecutionResult(metadata.TxHash)
+	if result == nil {
+		log.Debug("metadataNotifier: incoming event source execution result not found in the notified block", "tx hash", metadata.TxHash)
+		return nil
+	}
+
+	miniBlock, found := proofs.sourcesMiniBlocks[string(resultHash)]
+	if !found {
+		log.Debug("metadataNotifier: incoming event source execution result mini block not found in the notified block", "tx hash", metadata.TxHash)
+		return nil
+	}
+
+	resultBytes, err := mn.marshaller.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	metadata.ExecutionResultMiniBlock, metadata.ExecutionResultMiniBlockHash, err = mn.attachMiniBlock(miniBlock, metadata, proofs)
+	if err != nil {
+		return err
+	}
+
+	proofs.attachedResults[string(metadata.TxHash)] = struct{}{}
+	metadata.ExecutionResult = resultBytes
+	return nil
+}
+
+// getExecutionResult returns the notified block smart contract result, and its hash, which returns ok to the sender of
+// the source with the provided hash, or nil if it is not found
+func (mn *metadataNotifier) getExecutionResult(txHash []byte) ([]byte, *smartContractResult.SmartContractResult) {
+	_, source := mn.getSource(txHash)
+	if check.IfNil(source) {
+		return nil, nil
+	}
+
+	for encodedHash, scrInfo := range mn.currentBlock.TransactionPool.SmartContractResults {
+		if scrInfo == nil || scrInfo.SmartContractResult == nil {
+			continue
+		}
+
+		scr := scrInfo.SmartContractResult
+		isResultOfSource := bytes.Equal(scr.PrevTxHash, txHash) && bytes.Equal(scr.RcvAddr, source.GetSndAddr())
+		if isResultOfSource && incomingHeader.IsReturnOkData(scr.Data) {
+			return decodeLogTxHash(encodedHash), scr
+		}
+	}
+
+	return nil, nil
+}
+
+// decodeLogTxHash returns the tx hash of an outport log, which is hex encoded by the outport driver. Hashes which are
+// not hex encoded are returned as they are.
+func decodeLogTxHash(txHash string) []byte {
+	decodedTxHash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return []byte(txHash)
+	}
+
+	return decodedTxHash
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (mn *metadataNotifier) IsInterfaceNil() bool {
+	return mn == nil
+}
@@ -0,0 +1,350 @@
+package notifier
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/process"
+	sovNotifier "github.com/multiversx/mx-chain-sovereign-notifier-go/process/notifier"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/testscommon"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/incomingHeader"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createArgsMetadataNotifier() ArgsMetadataNotifier {
+	return ArgsMetadataNotifier{
+		SovereignNotifier: &testscommon.SovereignNotifierStub{},
+		Marshaller:        &marshal.GogoProtoMarshalizer{},
+		Hasher:            blake2b.NewBlake2b(),
+	}
+}
+
+func TestNewMetadataNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil sovereign notifier", func(t *testing.T) {
+		args := createArgsMetadataNotifier()
+		args.SovereignNotifier = nil
+		mn, err := NewMetadataNotifier(args)
+		require.Nil(t, mn)
+		require.Equal(t, errNilSovereignNotifier, err)
+	})
+	t.Run("nil marshaller", func(t *testing.T) {
+		args := createArgsMetadataNotifier()
+		args.Marshaller = nil
+		mn, err := NewMetadataNotifier(args)
+		require.Nil(t, mn)
+		require.Equal(t, errors.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher", func(t *testing.T) {
+		args := createArgsMetadataNotifier()
+		args.Hasher = nil
+		mn, err := NewMetadataNotifier(args)
+		require.Nil(t, mn)
+		require.Equal(t, errors.ErrNilHasher, err)
+	})
+	t.Run("should work and register to the wrapped notifier", func(t *testing.T) {
+		args := createArgsMetadataNotifier()
+		var registeredHandler interface{}
+		args.SovereignNotifier = &testscommon.SovereignNotifierStub{
+			RegisterHandlerCalled: func(handler process.IncomingHeaderSubscriber) error {
+				registeredHandler = handler
+				return nil
+			},
+		}
+		mn, err := NewMetadataNotifier(args)
+		require.Nil(t, err)
+		require.False(t, mn.IsInterfaceNil())
+		require.Equal(t, mn, registeredHandler)
+	})
+}
+
+func TestMetadataNotifier_AddHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid incoming header should error", func(t *testing.T) {
+		mn, _ := NewMetadataNotifier(createArgsMetadataNotifier())
+		err := mn.AddHeader([]byte("hash"), &sovTests.IncomingHeaderStub{})
+		require.Equal(t, errInvalidIncomingHeader, err)
+	})
+	t.Run("header received outside notify should error", func(t *testing.T) {
+		mn, _ := NewMetadataNotifier(createArgsMetadataNotifier())
+		err := mn.AddHeader([]byte("hash"), &sovereign.IncomingHeader{})
+		require.Equal(t, errNoOutportBlockNotified, err)
+	})
+}
+
+func TestMetadataNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	wrappedNotifier, err := sovNotifier.NewSovereignNotifier(sovNotifier.ArgsSovereignNotifier{
+		Marshaller: marshaller,
+		Hasher:     hasher,
+		SubscribedEvents: []sovNotifier.SubscribedEvent{
+			{
+				Identifier: []byte("deposit"),
+				Addresses:  map[string]string{"esdtSafe": "esdtSafeAddress"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	mn, err := NewMetadataNotifier(ArgsMetadataNotifier{
+		SovereignNotifier: wrappedNotifier,
+		Marshaller:        marshaller,
+		Hasher:            hasher,
+	})
+	require.Nil(t, err)
+
+	var receivedHeader sovereign.IncomingHeaderHandler
+	var receivedHash []byte
+	err = mn.RegisterHandler(&sovTests.IncomingHeaderSubscriberStub{
+		AddHeaderCalled: func(headerHash []byte, header sovereign.IncomingHeaderHandler) error {
+			receivedHash = headerHash
+			receivedHeader = header
+			return nil
+		},
+	})
+	require.Nil(t, err)
+
+	txs := []*transaction.Transaction{
+		{Nonce: 0, SndAddr: []byte("user0"), RcvAddr: []byte("esdtSafe"), Data: []byte("deposit@" + hex.EncodeToString([]byte("receiver0")))},
+		{Nonce: 1, SndAddr: []byte("user1"), RcvAddr: []byte("esdtSafe"), Data: []byte("deposit@" + hex.EncodeToString([]byte("receiver1")))},
+		{Nonce: 2, SndAddr: []byte("user2"), RcvAddr: []byte("other")},
+	}
+	txHashes := make([][]byte, 0, len(txs))
+	txsPool := make(map[string]*outport.TxInfo)
+	for _, tx := range txs {
+		txHash, errHash := core.CalculateHash(marshaller, hasher, tx)
+		require.Nil(t, errHash)
+
+		txHashes = append(txHashes, txHash)
+		txsPool[hex.EncodeToString(txHash)] = &outport.TxInfo{Transaction: tx}
+	}
+	// the smart contract result of a contract which deposits on the bridge contract, created inside the shard
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:   3,
+		SndAddr: []byte("contract"),
+		RcvAddr: []byte("esdtSafe"),
+		Data:    []byte("deposit@" + hex.EncodeToString([]byte("receiver2"))),
+	}
+	scrHash, err := core.CalculateHash(marshaller, hasher, scr)
+	require.Nil(t, err)
+
+	// the smart contract results which return ok to the senders of the deposits, created inside the shard
+	results := []*smartContractResult.SmartContractResult{
+		{RcvAddr: []byte("user0"), PrevTxHash: txHashes[0], Data: []byte("@6f6b")},
+		{RcvAddr: []byte("user1"), PrevTxHash: txHashes[1], Data: []byte("@6f6b")},
+		{RcvAddr: []byte("contract"), PrevTxHash: scrHash, Data: []byte("@6f6b")},
+		{RcvAddr: []byte("user2"), PrevTxHash: txHashes[2], Data: []byte("@" + hex.EncodeToString([]byte("user error")))},
+	}
+	resultHashes := make([][]byte, 0, len(results))
+	scrsPool := map[string]*outport.SCRInfo{
+		hex.EncodeToString(scrHash): {SmartContractResult: scr},
+	}
+	for _, result := range results {
+		resultHash, errHash := core.CalculateHash(marshaller, hasher, result)
+		require.Nil(t, errHash)
+
+		resultHashes = append(resultHashes, resultHash)
+		scrsPool[hex.EncodeToString(resultHash)] = &outport.SCRInfo{SmartContractResult: result}
+	}
+
+	blockEvents := []*transaction.Event{
+		{Address: []byte("other"), Identifier: []byte("deposit"), Data: []byte("user0")},
+		{Address: []byte("esdtSafe"), Identifier: []byte("deposit"), Topics: [][]byte{[]byte("deposit"), []byte("receiver0")}, Data: []byte("user0")},
+		{Address: []byte("esdtSafe"), Identifier: []byte("transfer"), Data: []byte("user1")},
+		{Address: []byte("esdtSafe"), Identifier: []byte("deposit"), Topics: [][]byte{[]byte("deposit"), []byte("receiver1")}, Data: []byte("user1")},
+		{Address: []byte("esdtSafe"), Identifier: []byte("deposit"), Topics: [][]byte{[]byte("deposit"), []byte("receiver2")}, Data: []byte("contract")},
+	}
+	miniBlocks := []*block.MiniBlock{
+		{TxHashes: [][]byte{txHashes[2], txHashes[0], txHashes[1]}},
+		{TxHashes: [][]byte{[]byte("tx3")}, SenderShardID: 1},
+	}
+	miniBlockHashes := make([][]byte, 0, len(miniBlocks))
+	miniBlockHeaders := make([]block.MiniBlockHeader, 0, len(miniBlocks))
+	for _, miniBlock := range miniBlocks {
+		miniBlockHash, errHash := core.CalculateHash(marshaller, hasher, miniBlock)
+		require.Nil(t, errHash)
+
+		miniBlockHashes = append(miniBlockHashes, miniBlockHash)
+		miniBlockHeaders = append(miniBlockHeaders, block.MiniBlockHeader{Hash: miniBlockHash})
+	}
+	// the mini blocks created inside the shard are not sent ordered by type by the outport driver
+	intraShardMiniBlocks := []*block.MiniBlock{
+		{TxHashes: [][]byte{[]byte("receipt")}, Type: block.ReceiptBlock},
+		{TxHashes: append([][]byte{scrHash}, resultHashes...), Type: block.SmartContractResultBlock},
+	}
+	receiptsMiniBlockHashes := make([][]byte, 0, len(intraShardMiniBlocks))
+	for _, miniBlock := range []*block.MiniBlock{intraShardMiniBlocks[1], intraShardMiniBlocks[0]} {
+		miniBlockHash, errHash := core.CalculateHash(marshaller, hasher, miniBlock)
+		require.Nil(t, errHash)
+
+		receiptsMiniBlockHashes = append(receiptsMiniBlockHashes, miniBlockHash)
+	}
+	receiptsHash, err := core.CalculateHash(marshaller, hasher, &batch.Batch{Data: receiptsMiniBlockHashes})
+	require.Nil(t, err)
+
+	headerV2 := &block.HeaderV2{Header: &block.Header{Nonce: 4, MiniBlockHeaders: miniBlockHeaders, ReceiptsHash: receiptsHash}}
+	headerBytes, err := marshaller.Marshal(headerV2)
+	require.Nil(t, err)
+
+	err = mn.Notify(&outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderType:           string(core.ShardHeaderV2),
+			HeaderBytes:          headerBytes,
+			Body:                 &block.Body{MiniBlocks: miniBlocks},
+			IntraShardMiniBlocks: intraShardMiniBlocks,
+		},
+		TransactionPool: &outport.TransactionPool{
+			Transactions:         txsPool,
+			SmartContractResults: scrsPool,
+			Logs: []*outport.LogData{
+				{TxHash: hex.EncodeToString(txHashes[0]), Log: &transaction.Log{Events: blockEvents[:2]}},
+				{TxHash: hex.EncodeToString(txHashes[1]), Log: &transaction.Log{Events: blockEvents[2:4]}},
+				{TxHash: hex.EncodeToString(scrHash), Log: &transaction.Log{Events: blockEvents[4:]}},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	headerWithMetadata, castOk := receivedHeader.(*sovereign.IncomingHeader)
+	require.True(t, castOk)
+	require.Len(t, headerWithMetadata.IncomingEvents, 3)
+
+	marshalForTest := func(obj interface{}) []byte {
+		objBytes, errMarshal := marshaller.Marshal(obj)
+		require.Nil(t, errMarshal)
+		return objBytes
+	}
+	expectedEvent1, err := incomingHeader.AttachEventMetadata(blockEvents[1], &incomingHeader.EventMetadata{
+		TxHash:                   txHashes[0],
+		EventIndex:               1,
+		Source:                   marshalForTest(txs[0]),
+		MiniBlock:                marshalForTest(miniBlocks[0]),
+		ReceiptsMiniBlockHashes:  receiptsMiniBlockHashes,
+		ExecutionResult:          marshalForTest(results[0]),
+		ExecutionResultMiniBlock: marshalForTest(intraShardMiniBlocks[1]),
+	})
+	require.Nil(t, err)
+	// the mini blocks were already attached to the previous event, hence only their hashes are referenced
+	expectedEvent3, err := incomingHeader.AttachEventMetadata(blockEvents[3], &incomingHeader.EventMetadata{
+		TxHash:                       txHashes[1],
+		EventIndex:                   1,
+		Source:                       marshalForTest(txs[1]),
+		MiniBlockHash:                miniBlockHashes[0],
+		ExecutionResult:              marshalForTest(results[1]),
+		ExecutionResultMiniBlockHash: receiptsMiniBlockHashes[0],
+	})
+	require.Nil(t, err)
+	expectedEvent4, err := incomingHeader.AttachEventMetadata(blockEvents[4], &incomingHeader.EventMetadata{
+		TxHash:                       scrHash,
+		SourceType:                   incomingHeader.EventSourceSmartContractResult,
+		Source:                       marshalForTest(scr),
+		MiniBlockHash:                receiptsMiniBlockHashes[0],
+		ExecutionResult:              marshalForTest(results[2]),
+		ExecutionResultMiniBlockHash: receiptsMiniBlockHashes[0],
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*transaction.Event{expectedEvent1, expectedEvent3, expectedEvent4}, headerWithMetadata.IncomingEvents)
+	require.Empty(t, blockEvents[1].AdditionalData)
+
+	expectedHash, err := core.CalculateHash(marshaller, hasher, &sovereign.IncomingHeader{
+		Header:         headerV2,
+		IncomingEvents: []*transaction.Event{blockEvents[1], blockEvents[3], blockEvents[4]},
+	})
+	require.Nil(t, err)
+	require.Equal(t, expectedHash, receivedHash)
+
+	depositNonce := uint64(0)
+	verifier, _ := incomingHeader.NewEventsProofVerifier(incomingHeader.ArgsEventsProofVerifier{
+		Marshaller: marshaller,
+		Hasher:     hasher,
+		DataCodec: &sovTests.DataCodecMock{
+			DeserializeEventDataCalled: func(data []byte) (*sovereign.EventData, error) {
+				depositNonce++
+				return &sovereign.EventData{Nonce: depositNonce, Sender: data}, nil
+			},
+		},
+		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.SovereignIncomingEventsProofsFlag),
+	})
+	require.Nil(t, verifier.VerifyEventsProofs(headerWithMetadata))
+}
+
+func TestMetadataNotifier_NotifySovereignChainHeader(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+	wrappedNotifier, err := sovNotifier.NewSovereignNotifier(sovNotifier.ArgsSovereignNotifier{
+		Marshaller: marshaller,
+		Hasher:     hasher,
+		SubscribedEvents: []sovNotifier.SubscribedEvent{
+			{
+				Identifier: []byte("deposit"),
+				Addresses:  map[string]string{"esdtSafe": "esdtSafeAddress"},
+			},
+		},
+	})
+	require.Nil(t, err)
+
+	mn, err := NewMetadataNotifier(ArgsMetadataNotifier{
+		SovereignNotifier: wrappedNotifier,
+		Marshaller:        marshaller,
+		Hasher:            hasher,
+	})
+	require.Nil(t, err)
+
+	var receivedHeader sovereign.IncomingHeaderHandler
+	err = mn.RegisterHandler(&sovTests.IncomingHeaderSubscriberStub{
+		AddHeaderCalled: func(_ []byte, header sovereign.IncomingHeaderHandler) error {
+			receivedHeader = header
+			return nil
+		},
+	})
+	require.Nil(t, err)
+
+	sovHeader := &block.SovereignChainHeader{
+		Header:         &block.Header{Nonce: 4, ChainID: []byte("sov2")},
+		IsStartOfEpoch: true,
+	}
+	headerBytes, err := marshaller.Marshal(sovHeader)
+	require.Nil(t, err)
+
+	outportBlock := &outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderType:  string(core.SovereignChainHeader),
+			HeaderBytes: headerBytes,
+			Body:        &block.Body{},
+		},
+		TransactionPool: &outport.TransactionPool{},
+	}
+	err = mn.Notify(outportBlock)
+	require.Nil(t, err)
+	require.Equal(t, string(core.SovereignChainHeader), outportBlock.BlockData.HeaderType)
+
+	headerV2, castOk := receivedHeader.GetHeaderHandler().(*block.HeaderV2)
+	require.True(t, castOk)
+
+	unwrappedHeader, isEnvelope, err := sovBlock.UnwrapSovereignChainHeader(marshaller, headerV2)
+	require.Nil(t, err)
+	require.True(t, isEnvelope)
+	require.Equal(t, sovHeader, unwrappedHeader)
+}
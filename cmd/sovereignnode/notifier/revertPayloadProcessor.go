@@ -0,0 +1,101 @@
+package notifier
+
+import (
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/process/indexer"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// ArgsRevertPayloadProcessor defines args needed to create a new revert payload processor
+type ArgsRevertPayloadProcessor struct {
+	PayloadProcessor      indexer.DataProcessor
+	IncomingHeaderHandler process.IncomingHeaderSubscriber
+	Marshaller            marshal.Marshalizer
+}
+
+type revertPayloadProcessor struct {
+	indexer.DataProcessor
+	incomingHeaderHandler process.IncomingHeaderSubscriber
+	marshaller            marshal.Marshalizer
+}
+
+// NewRevertPayloadProcessor creates a payload processor which forwards reverted main chain blocks to the incoming header
+// handler, so that headers which were forked out are no longer executed. Finalized main chain blocks are also forwarded
+// to the incoming header handler, so that their headers become executable without waiting for more confirmations. All
+// other topics are handled by the wrapped payload processor.
+func NewRevertPayloadProcessor(args ArgsRevertPayloadProcessor) (*revertPayloadProcessor, error) {
+	if check.IfNil(args.PayloadProcessor) {
+		return nil, errNilPayloadProcessor
+	}
+	if check.IfNil(args.IncomingHeaderHandler) {
+		return nil, errors.ErrNilIncomingHeaderSubscriber
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+
+	return &revertPayloadProcessor{
+		DataProcessor:         args.PayloadProcessor,
+		incomingHeaderHandler: args.IncomingHeaderHandler,
+		marshaller:            args.Marshaller,
+	}, nil
+}
+
+// ProcessPayload will revert the incoming header for a reverted block topic, finalize the incoming header for a
+// finalized block topic and delegate any other topic to the wrapped payload processor
+func (rpp *revertPayloadProcessor) ProcessPayload(payload []byte, topic string, version uint32) error {
+	switch topic {
+	case outport.TopicRevertIndexedBlock:
+		return rpp.revertBlock(payload)
+	case outport.TopicFinalizedBlock:
+		err := rpp.DataProcessor.ProcessPayload(payload, topic, version)
+		if err != nil {
+			return err
+		}
+
+		return rpp.finalizeBlock(payload)
+	default:
+		return rpp.DataProcessor.ProcessPayload(payload, topic, version)
+	}
+}
+
+func (rpp *revertPayloadProcessor) revertBlock(payload []byte) error {
+	blockData := &outport.BlockData{}
+	err := rpp.marshaller.Unmarshal(blockData, payload)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("revertPayloadProcessor.ProcessPayload reverting incoming header",
+		"hash", hex.EncodeToString(blockData.HeaderHash),
+		"shard", blockData.ShardID,
+	)
+
+	return rpp.incomingHeaderHandler.RevertHeader(blockData.HeaderHash)
+}
+
+func (rpp *revertPayloadProcessor) finalizeBlock(payload []byte) error {
+	finalizedBlock := &outport.FinalizedBlock{}
+	err := rpp.marshaller.Unmarshal(finalizedBlock, payload)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("revertPayloadProcessor.ProcessPayload finalizing incoming header",
+		"hash", hex.EncodeToString(finalizedBlock.HeaderHash),
+		"shard", finalizedBlock.ShardID,
+	)
+
+	return rpp.incomingHeaderHandler.FinalizeHeader(finalizedBlock.HeaderHash)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (rpp *revertPayloadProcessor) IsInterfaceNil() bool {
+	return rpp == nil
+}
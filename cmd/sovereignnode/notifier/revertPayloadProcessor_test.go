@@ -0,0 +1,146 @@
+package notifier
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/process/indexer"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/testscommon"
+	"github.com/stretchr/testify/require"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createArgsRevertPayloadProcessor() ArgsRevertPayloadProcessor {
+	payloadProc, _ := indexer.NewPayloadProcessor(&testscommon.IndexerStub{}, &marshallerMock.MarshalizerMock{})
+
+	return ArgsRevertPayloadProcessor{
+		PayloadProcessor:      payloadProc,
+		IncomingHeaderHandler: &sovereign.IncomingHeaderSubscriberStub{},
+		Marshaller:            &marshallerMock.MarshalizerMock{},
+	}
+}
+
+func TestNewRevertPayloadProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil payload processor", func(t *testing.T) {
+		args := createArgsRevertPayloadProcessor()
+		args.PayloadProcessor = nil
+		rpp, err := NewRevertPayloadProcessor(args)
+		require.Nil(t, rpp)
+		require.Equal(t, errNilPayloadProcessor, err)
+	})
+	t.Run("nil incoming header handler", func(t *testing.T) {
+		args := createArgsRevertPayloadProcessor()
+		args.IncomingHeaderHandler = nil
+		rpp, err := NewRevertPayloadProcessor(args)
+		require.Nil(t, rpp)
+		require.Equal(t, errorsMx.ErrNilIncomingHeaderSubscriber, err)
+	})
+	t.Run("nil marshaller", func(t *testing.T) {
+		args := createArgsRevertPayloadProcessor()
+		args.Marshaller = nil
+		rpp, err := NewRevertPayloadProcessor(args)
+		require.Nil(t, rpp)
+		require.Equal(t, errorsMx.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		rpp, err := NewRevertPayloadProcessor(createArgsRevertPayloadProcessor())
+		require.Nil(t, err)
+		require.False(t, rpp.IsInterfaceNil())
+	})
+}
+
+func TestRevertPayloadProcessor_ProcessPayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reverted block should revert incoming header", func(t *testing.T) {
+		marshaller := &marshallerMock.MarshalizerMock{}
+		revertedHash := []byte("hash")
+
+		wasReverted := false
+		args := createArgsRevertPayloadProcessor()
+		args.Marshaller = marshaller
+		args.IncomingHeaderHandler = &sovereign.IncomingHeaderSubscriberStub{
+			RevertHeaderCalled: func(headerHash []byte) error {
+				require.Equal(t, revertedHash, headerHash)
+				wasReverted = true
+				return nil
+			},
+		}
+		rpp, _ := NewRevertPayloadProcessor(args)
+
+		payload, err := marshaller.Marshal(&outport.BlockData{HeaderHash: revertedHash})
+		require.Nil(t, err)
+
+		err = rpp.ProcessPayload(payload, outport.TopicRevertIndexedBlock, 1)
+		require.Nil(t, err)
+		require.True(t, wasReverted)
+	})
+	t.Run("invalid reverted block payload should error", func(t *testing.T) {
+		args := createArgsRevertPayloadProcessor()
+		args.IncomingHeaderHandler = &sovereign.IncomingHeaderSubscriberStub{
+			RevertHeaderCalled: func(_ []byte) error {
+				require.Fail(t, "should not revert header")
+				return nil
+			},
+		}
+		rpp, _ := NewRevertPayloadProcessor(args)
+
+		err := rpp.ProcessPayload([]byte("invalid"), outport.TopicRevertIndexedBlock, 1)
+		require.NotNil(t, err)
+	})
+	t.Run("finalized block should be delegated and finalize incoming header", func(t *testing.T) {
+		marshaller := &marshallerMock.MarshalizerMock{}
+		finalizedHash := []byte("hash")
+
+		wasDelegated, wasFinalized := false, false
+		args := createArgsRevertPayloadProcessor()
+		args.PayloadProcessor, _ = indexer.NewPayloadProcessor(&testscommon.IndexerStub{
+			FinalizedBlockCalled: func(_ *outport.FinalizedBlock) error {
+				wasDelegated = true
+				return nil
+			},
+		}, marshaller)
+		args.IncomingHeaderHandler = &sovereign.IncomingHeaderSubscriberStub{
+			RevertHeaderCalled: func(_ []byte) error {
+				require.Fail(t, "should not revert header")
+				return nil
+			},
+			FinalizeHeaderCalled: func(headerHash []byte) error {
+				require.Equal(t, finalizedHash, headerHash)
+				wasFinalized = true
+				return nil
+			},
+		}
+		rpp, _ := NewRevertPayloadProcessor(args)
+
+		payload, err := marshaller.Marshal(&outport.FinalizedBlock{HeaderHash: finalizedHash})
+		require.Nil(t, err)
+
+		err = rpp.ProcessPayload(payload, outport.TopicFinalizedBlock, 1)
+		require.Nil(t, err)
+		require.True(t, wasDelegated)
+		require.True(t, wasFinalized)
+	})
+	t.Run("other topics should be delegated", func(t *testing.T) {
+		args := createArgsRevertPayloadProcessor()
+		args.IncomingHeaderHandler = &sovereign.IncomingHeaderSubscriberStub{
+			RevertHeaderCalled: func(_ []byte) error {
+				require.Fail(t, "should not revert header")
+				return nil
+			},
+			FinalizeHeaderCalled: func(_ []byte) error {
+				require.Fail(t, "should not finalize header")
+				return nil
+			},
+		}
+		rpp, _ := NewRevertPayloadProcessor(args)
+
+		err := rpp.ProcessPayload([]byte("payload"), outport.TopicSaveRoundsInfo, 1)
+		require.Nil(t, err)
+	})
+}
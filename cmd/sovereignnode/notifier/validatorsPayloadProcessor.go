@@ -0,0 +1,131 @@
+package notifier
+
+import (
+	"encoding/hex"
+	"math"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/process/indexer"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// the main chain nodes publish the validators ratings as a percentage of the max rating (see process/block/metrics.go)
+const publishedRatingMultiplier = 10000000 / 100
+
+// ArgsValidatorsPayloadProcessor defines args needed to create a new validators payload processor
+type ArgsValidatorsPayloadProcessor struct {
+	PayloadProcessor           indexer.DataProcessor
+	MainChainValidatorsHandler process.MainChainValidatorsHandler
+	Marshaller                 marshal.Marshalizer
+}
+
+type validatorsPayloadProcessor struct {
+	indexer.DataProcessor
+	mainChainValidatorsHandler process.MainChainValidatorsHandler
+	marshaller                 marshal.Marshalizer
+}
+
+// NewValidatorsPayloadProcessor creates a payload processor which forwards the eligible validators and the validators
+// ratings, published by the main chain nodes at each epoch start, to the main chain validators handler, so that the
+// main chain header signatures are verified against the main chain validators of each epoch. The notifiers of the other
+// incoming sovereign chains sync the validators of their own chain in the same way. All other topics are handled by the
+// wrapped payload processor.
+func NewValidatorsPayloadProcessor(args ArgsValidatorsPayloadProcessor) (*validatorsPayloadProcessor, error) {
+	if check.IfNil(args.PayloadProcessor) {
+		return nil, errNilPayloadProcessor
+	}
+	if check.IfNil(args.MainChainValidatorsHandler) {
+		return nil, errors.ErrNilMainChainValidatorsHandler
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+
+	return &validatorsPayloadProcessor{
+		DataProcessor:              args.PayloadProcessor,
+		mainChainValidatorsHandler: args.MainChainValidatorsHandler,
+		marshaller:                 args.Marshaller,
+	}, nil
+}
+
+// ProcessPayload will sync the main chain validators for the validators public keys and validators rating topics, after
+// delegating them to the wrapped payload processor. Any other topic is only delegated to the wrapped payload processor.
+func (vpp *validatorsPayloadProcessor) ProcessPayload(payload []byte, topic string, version uint32) error {
+	err := vpp.DataProcessor.ProcessPayload(payload, topic, version)
+	if err != nil {
+		return err
+	}
+
+	switch topic {
+	case outport.TopicSaveValidatorsPubKeys:
+		return vpp.saveValidatorsPubKeys(payload)
+	case outport.TopicSaveValidatorsRating:
+		return vpp.saveValidatorsRating(payload)
+	default:
+		return nil
+	}
+}
+
+func (vpp *validatorsPayloadProcessor) saveValidatorsPubKeys(payload []byte) error {
+	validatorsPubKeys := &outport.ValidatorsPubKeys{}
+	err := vpp.marshaller.Unmarshal(validatorsPubKeys, payload)
+	if err != nil {
+		return err
+	}
+
+	eligiblePubKeys := make(map[uint32][][]byte, len(validatorsPubKeys.ShardValidatorsPubKeys))
+	for shardID, pubKeys := range validatorsPubKeys.ShardValidatorsPubKeys {
+		if pubKeys == nil {
+			continue
+		}
+
+		eligiblePubKeys[shardID] = pubKeys.Keys
+	}
+
+	log.Debug("validatorsPayloadProcessor.ProcessPayload syncing main chain eligible validators",
+		"epoch", validatorsPubKeys.Epoch,
+		"num shards", len(eligiblePubKeys),
+	)
+
+	return vpp.mainChainValidatorsHandler.SetEpochEligiblePublicKeys(validatorsPubKeys.Epoch, eligiblePubKeys)
+}
+
+func (vpp *validatorsPayloadProcessor) saveValidatorsRating(payload []byte) error {
+	validatorsRating := &outport.ValidatorsRating{}
+	err := vpp.marshaller.Unmarshal(validatorsRating, payload)
+	if err != nil {
+		return err
+	}
+
+	ratings := make(map[string]uint32, len(validatorsRating.ValidatorsRatingInfo))
+	for _, ratingInfo := range validatorsRating.ValidatorsRatingInfo {
+		if ratingInfo == nil {
+			continue
+		}
+
+		pubKey, errDecode := hex.DecodeString(ratingInfo.PublicKey)
+		if errDecode != nil {
+			return errDecode
+		}
+
+		ratings[string(pubKey)] = uint32(math.Round(float64(ratingInfo.Rating) * publishedRatingMultiplier))
+	}
+
+	log.Debug("validatorsPayloadProcessor.ProcessPayload syncing main chain validators ratings",
+		"epoch", validatorsRating.Epoch,
+		"shard", validatorsRating.ShardID,
+		"num validators", len(ratings),
+	)
+
+	vpp.mainChainValidatorsHandler.SetValidatorsRatings(ratings)
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (vpp *validatorsPayloadProcessor) IsInterfaceNil() bool {
+	return vpp == nil
+}
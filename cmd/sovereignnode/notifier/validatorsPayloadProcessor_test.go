@@ -0,0 +1,178 @@
+package notifier
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/process/indexer"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/testscommon"
+	"github.com/stretchr/testify/require"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createArgsValidatorsPayloadProcessor() ArgsValidatorsPayloadProcessor {
+	payloadProc, _ := indexer.NewPayloadProcessor(&testscommon.IndexerStub{}, &marshallerMock.MarshalizerMock{})
+
+	return ArgsValidatorsPayloadProcessor{
+		PayloadProcessor:           payloadProc,
+		MainChainValidatorsHandler: &sovereign.MainChainValidatorsHandlerMock{},
+		Marshaller:                 &marshallerMock.MarshalizerMock{},
+	}
+}
+
+func TestNewValidatorsPayloadProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil payload processor", func(t *testing.T) {
+		args := createArgsValidatorsPayloadProcessor()
+		args.PayloadProcessor = nil
+		vpp, err := NewValidatorsPayloadProcessor(args)
+		require.Nil(t, vpp)
+		require.Equal(t, errNilPayloadProcessor, err)
+	})
+	t.Run("nil main chain validators handler", func(t *testing.T) {
+		args := createArgsValidatorsPayloadProcessor()
+		args.MainChainValidatorsHandler = nil
+		vpp, err := NewValidatorsPayloadProcessor(args)
+		require.Nil(t, vpp)
+		require.Equal(t, errorsMx.ErrNilMainChainValidatorsHandler, err)
+	})
+	t.Run("nil marshaller", func(t *testing.T) {
+		args := createArgsValidatorsPayloadProcessor()
+		args.Marshaller = nil
+		vpp, err := NewValidatorsPayloadProcessor(args)
+		require.Nil(t, vpp)
+		require.Equal(t, errorsMx.ErrNilMarshalizer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		vpp, err := NewValidatorsPayloadProcessor(createArgsValidatorsPayloadProcessor())
+		require.Nil(t, err)
+		require.False(t, vpp.IsInterfaceNil())
+	})
+}
+
+func TestValidatorsPayloadProcessor_ProcessPayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validators public keys should set the epoch eligible validators", func(t *testing.T) {
+		marshaller := &marshallerMock.MarshalizerMock{}
+		shard0Keys := [][]byte{[]byte("pk0"), []byte("pk1")}
+		metaKeys := [][]byte{[]byte("pk2")}
+
+		wasSet := false
+		args := createArgsValidatorsPayloadProcessor()
+		args.Marshaller = marshaller
+		args.MainChainValidatorsHandler = &sovereign.MainChainValidatorsHandlerMock{
+			SetEpochEligiblePublicKeysCalled: func(epoch uint32, eligiblePubKeys map[uint32][][]byte) error {
+				require.Equal(t, uint32(4), epoch)
+				require.Equal(t, map[uint32][][]byte{
+					0:                     shard0Keys,
+					core.MetachainShardId: metaKeys,
+				}, eligiblePubKeys)
+				wasSet = true
+				return nil
+			},
+		}
+		vpp, _ := NewValidatorsPayloadProcessor(args)
+
+		payload, err := marshaller.Marshal(&outport.ValidatorsPubKeys{
+			ShardValidatorsPubKeys: map[uint32]*outport.PubKeys{
+				0:                     {Keys: shard0Keys},
+				core.MetachainShardId: {Keys: metaKeys},
+			},
+			Epoch: 4,
+		})
+		require.Nil(t, err)
+
+		err = vpp.ProcessPayload(payload, outport.TopicSaveValidatorsPubKeys, 1)
+		require.Nil(t, err)
+		require.True(t, wasSet)
+	})
+	t.Run("validators rating should set the ratings on the main chain scale", func(t *testing.T) {
+		marshaller := &marshallerMock.MarshalizerMock{}
+		pk0, pk1 := []byte("pk0"), []byte("pk1")
+
+		wasSet := false
+		args := createArgsValidatorsPayloadProcessor()
+		args.Marshaller = marshaller
+		args.MainChainValidatorsHandler = &sovereign.MainChainValidatorsHandlerMock{
+			SetValidatorsRatingsCalled: func(ratings map[string]uint32) {
+				require.Equal(t, map[string]uint32{
+					string(pk0): 10000000,
+					string(pk1): 5000000,
+				}, ratings)
+				wasSet = true
+			},
+		}
+		vpp, _ := NewValidatorsPayloadProcessor(args)
+
+		payload, err := marshaller.Marshal(&outport.ValidatorsRating{
+			Epoch: 4,
+			ValidatorsRatingInfo: []*outport.ValidatorRatingInfo{
+				{PublicKey: hex.EncodeToString(pk0), Rating: 100},
+				{PublicKey: hex.EncodeToString(pk1), Rating: 50},
+			},
+		})
+		require.Nil(t, err)
+
+		err = vpp.ProcessPayload(payload, outport.TopicSaveValidatorsRating, 1)
+		require.Nil(t, err)
+		require.True(t, wasSet)
+	})
+	t.Run("invalid validators rating public key should error", func(t *testing.T) {
+		marshaller := &marshallerMock.MarshalizerMock{}
+
+		args := createArgsValidatorsPayloadProcessor()
+		args.Marshaller = marshaller
+		args.MainChainValidatorsHandler = &sovereign.MainChainValidatorsHandlerMock{
+			SetValidatorsRatingsCalled: func(_ map[string]uint32) {
+				require.Fail(t, "should not set ratings")
+			},
+		}
+		vpp, _ := NewValidatorsPayloadProcessor(args)
+
+		payload, err := marshaller.Marshal(&outport.ValidatorsRating{
+			ValidatorsRatingInfo: []*outport.ValidatorRatingInfo{
+				{PublicKey: "not hex", Rating: 100},
+			},
+		})
+		require.Nil(t, err)
+
+		err = vpp.ProcessPayload(payload, outport.TopicSaveValidatorsRating, 1)
+		require.NotNil(t, err)
+	})
+	t.Run("invalid validators public keys payload should error", func(t *testing.T) {
+		args := createArgsValidatorsPayloadProcessor()
+		args.MainChainValidatorsHandler = &sovereign.MainChainValidatorsHandlerMock{
+			SetEpochEligiblePublicKeysCalled: func(_ uint32, _ map[uint32][][]byte) error {
+				require.Fail(t, "should not set eligible validators")
+				return nil
+			},
+		}
+		vpp, _ := NewValidatorsPayloadProcessor(args)
+
+		err := vpp.ProcessPayload([]byte("invalid"), outport.TopicSaveValidatorsPubKeys, 1)
+		require.NotNil(t, err)
+	})
+	t.Run("other topics should only be delegated", func(t *testing.T) {
+		args := createArgsValidatorsPayloadProcessor()
+		args.MainChainValidatorsHandler = &sovereign.MainChainValidatorsHandlerMock{
+			SetEpochEligiblePublicKeysCalled: func(_ uint32, _ map[uint32][][]byte) error {
+				require.Fail(t, "should not set eligible validators")
+				return nil
+			},
+			SetValidatorsRatingsCalled: func(_ map[string]uint32) {
+				require.Fail(t, "should not set ratings")
+			},
+		}
+		vpp, _ := NewValidatorsPayloadProcessor(args)
+
+		err := vpp.ProcessPayload([]byte("payload"), outport.TopicSaveRoundsInfo, 1)
+		require.Nil(t, err)
+	})
+}
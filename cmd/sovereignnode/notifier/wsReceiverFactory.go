@@ -0,0 +1,83 @@
+package notifier
+
+import (
+	"github.com/multiversx/mx-chain-communication-go/websocket/data"
+	factoryHost "github.com/multiversx/mx-chain-communication-go/websocket/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal/factory"
+	notifierCfg "github.com/multiversx/mx-chain-sovereign-notifier-go/config"
+	notifierProcess "github.com/multiversx/mx-chain-sovereign-notifier-go/process"
+	"github.com/multiversx/mx-chain-sovereign-notifier-go/process/indexer"
+
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// ArgsWsClientReceiverNotifier is a struct placeholder for ws client receiver args
+type ArgsWsClientReceiverNotifier struct {
+	WebSocketConfig            notifierCfg.WebSocketConfig
+	SovereignNotifier          notifierProcess.SovereignNotifier
+	IncomingHeaderHandler      process.IncomingHeaderSubscriber
+	MainChainValidatorsHandler process.MainChainValidatorsHandler
+}
+
+// CreateWsClientReceiverNotifier creates a ws client receiver for incoming outport blocks, which also reverts and finalizes
+// incoming headers for reverted and finalized main chain blocks and syncs the main chain validators at each epoch start
+func CreateWsClientReceiverNotifier(args ArgsWsClientReceiverNotifier) (notifierProcess.WSClient, error) {
+	marshaller, err := factory.NewMarshalizer(args.WebSocketConfig.MarshallerType)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := indexer.NewOutportBlockCache()
+	dataIndexer, err := indexer.NewIndexer(args.SovereignNotifier, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadProcessor, err := indexer.NewPayloadProcessor(dataIndexer, marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	revertPayloadProc, err := NewRevertPayloadProcessor(ArgsRevertPayloadProcessor{
+		PayloadProcessor:      payloadProcessor,
+		IncomingHeaderHandler: args.IncomingHeaderHandler,
+		Marshaller:            marshaller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsPayloadProc, err := NewValidatorsPayloadProcessor(ArgsValidatorsPayloadProcessor{
+		PayloadProcessor:           revertPayloadProc,
+		MainChainValidatorsHandler: args.MainChainValidatorsHandler,
+		Marshaller:                 marshaller,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wsHost, err := factoryHost.CreateWebSocketHost(factoryHost.ArgsWebSocketHost{
+		WebSocketConfig: data.WebSocketConfig{
+			URL:                        args.WebSocketConfig.Url,
+			WithAcknowledge:            args.WebSocketConfig.WithAcknowledge,
+			Mode:                       args.WebSocketConfig.Mode,
+			RetryDurationInSec:         int(args.WebSocketConfig.RetryDuration),
+			BlockingAckOnError:         args.WebSocketConfig.BlockingAckOnError,
+			DropMessagesIfNoConnection: false,
+			AcknowledgeTimeoutInSec:    args.WebSocketConfig.AcknowledgeTimeout,
+			Version:                    args.WebSocketConfig.Version,
+		},
+		Marshaller: marshaller,
+		Log:        log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = wsHost.SetPayloadHandler(validatorsPayloadProc)
+	if err != nil {
+		return nil, err
+	}
+
+	return wsHost, nil
+}
@@ -37,10 +37,18 @@ func CreateSovereignArgsRunTypeComponents(
 		return nil, err
 	}
 
+	topicsChecker, err := incomingHeader.NewTopicsChecker(incomingHeader.ArgsTopicsChecker{
+		PubKeyConverter: argsRunType.CoreComponents.AddressPubKeyConverter(),
+		DataCodec:       dataCodecHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &runType.ArgsSovereignRunTypeComponents{
 		RunTypeComponentsFactory: runTypeComponentsFactory,
 		Config:                   configs,
 		DataCodec:                dataCodecHandler,
-		TopicsChecker:            incomingHeader.NewTopicsChecker(),
+		TopicsChecker:            topicsChecker,
 	}, nil
 }
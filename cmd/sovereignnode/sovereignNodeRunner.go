@@ -22,6 +22,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/throttler"
 	"github.com/multiversx/mx-chain-core-go/data/endProcess"
 	outportCore "github.com/multiversx/mx-chain-core-go/data/outport"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshallerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-sovereign-bridge-go/cert"
 	factoryBridge "github.com/multiversx/mx-chain-sovereign-bridge-go/client"
@@ -48,6 +50,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	dbLookupFactory "github.com/multiversx/mx-chain-go/dblookupext/factory"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/facade/initial"
 	mainFactory "github.com/multiversx/mx-chain-go/factory"
@@ -66,10 +69,12 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/statusCore"
 	"github.com/multiversx/mx-chain-go/genesis/parsing"
 	"github.com/multiversx/mx-chain-go/health"
+	"github.com/multiversx/mx-chain-go/keysManagement/keyStore"
 	"github.com/multiversx/mx-chain-go/node"
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/process"
+	sovereignBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/process/block/sovereign/incomingHeader"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -432,11 +437,14 @@ func (snr *sovereignNodeRunner) executeOneComponentCreationCycle(
 
 	log.Debug("creating process components")
 
-	incomingHeaderHandler, err := incomingHeader.CreateIncomingHeaderProcessor(
-		configs.SovereignExtraConfig.NotifierConfig.WebSocketConfig,
+	incomingHeaderHandler, err := incomingHeader.CreateIncomingHeadersRouter(
+		*configs.SovereignExtraConfig,
+		managedCoreComponents.AddressPubKeyConverter(),
 		managedDataComponents.Datapool(),
-		configs.SovereignExtraConfig.MainChainNotarization.MainChainNotarizationStartRound,
+		managedDataComponents.StorageService(),
 		managedRunTypeComponents,
+		managedCoreComponents.EnableEpochsHandler(),
+		managedStatusCoreComponents.AppStatusHandler(),
 	)
 	if err != nil {
 		return true, err
@@ -491,14 +499,19 @@ func (snr *sovereignNodeRunner) executeOneComponentCreationCycle(
 
 	log.Debug("starting node... executeOneComponentCreationCycle")
 
-	outGoingBridgeOpHandler, err := factoryBridge.CreateClient(&bridgeCfg.ClientConfig{
-		Enabled:  snr.configs.SovereignExtraConfig.OutGoingBridge.Enabled,
-		GRPCHost: snr.configs.SovereignExtraConfig.OutGoingBridge.GRPCHost,
-		GRPCPort: snr.configs.SovereignExtraConfig.OutGoingBridge.GRPCPort,
-		CertificateCfg: cert.FileCfg{
-			CertFile: snr.configs.SovereignExtraConfig.OutGoingBridgeCertificate.CertificatePath,
-			PkFile:   snr.configs.SovereignExtraConfig.OutGoingBridgeCertificate.CertificatePkPath,
-		},
+	outGoingBridgeClients, err := createOutGoingBridgeClients(snr.configs.SovereignExtraConfig)
+	if err != nil {
+		return true, err
+	}
+
+	bridgeOpHandlers := make(map[string]sovereignBlock.BridgeOperationsHandler, len(outGoingBridgeClients))
+	for destination, outGoingBridgeClient := range outGoingBridgeClients {
+		bridgeOpHandlers[destination] = outGoingBridgeClient
+	}
+
+	outGoingBridgeOpHandler, err := sovereignBlock.NewBridgeOperationsRouter(sovereignBlock.ArgsBridgeOperationsRouter{
+		BridgeOpHandlers:       bridgeOpHandlers,
+		OutGoingOperationsPool: managedRunTypeComponents.OutGoingOperationsPoolHandler(),
 	})
 	if err != nil {
 		return true, err
@@ -536,9 +549,15 @@ func (snr *sovereignNodeRunner) executeOneComponentCreationCycle(
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
+	mainChainHeaderHandler, err := incomingHeaderHandler.GetIncomingHeaderHandler(core.MainChainShardId)
+	if err != nil {
+		return true, err
+	}
+
 	notifierServices, err := createNotifierWSReceiverServicesIfNeeded(
 		&configs.SovereignExtraConfig.NotifierConfig,
-		incomingHeaderHandler,
+		mainChainHeaderHandler,
+		managedRunTypeComponents.MainChainValidatorsHandler(),
 		managedCoreComponents.GenesisNodesSetup().GetRoundDuration(),
 		managedProcessComponents.ForkDetector(),
 		managedConsensusComponents.Bootstrapper(),
@@ -548,6 +567,35 @@ func (snr *sovereignNodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	for idx := range configs.SovereignExtraConfig.IncomingChains {
+		incomingChain := &configs.SovereignExtraConfig.IncomingChains[idx]
+		log.Debug("creating notifier services for incoming chain", "chainID", incomingChain.ChainID)
+		incomingChainHeaderHandler, errGet := getIncomingChainHeaderHandler(incomingChain.ChainID, incomingHeaderHandler, managedRunTypeComponents.IncomingChainsHandler())
+		if errGet != nil {
+			return true, errGet
+		}
+
+		incomingChainValidatorsHandler, found := managedRunTypeComponents.IncomingChainsValidatorsHandlers()[incomingChain.ChainID]
+		if !found {
+			incomingChainValidatorsHandler = disabled.NewDisabledMainChainValidatorsHandler()
+		}
+
+		incomingChainNotifierServices, errCreate := createNotifierWSReceiverServicesIfNeeded(
+			&incomingChain.NotifierConfig,
+			incomingChainHeaderHandler,
+			incomingChainValidatorsHandler,
+			managedCoreComponents.GenesisNodesSetup().GetRoundDuration(),
+			managedProcessComponents.ForkDetector(),
+			managedConsensusComponents.Bootstrapper(),
+			sigs,
+		)
+		if errCreate != nil {
+			return true, errCreate
+		}
+
+		notifierServices = append(notifierServices, incomingChainNotifierServices...)
+	}
+
 	log.Debug("creating node structure")
 
 	extraOptionsNotifier := func(n *node.Node) error {
@@ -558,7 +606,10 @@ func (snr *sovereignNodeRunner) executeOneComponentCreationCycle(
 		return nil
 	}
 	extraOptionOutGoingBridgeSender := func(n *node.Node) error {
-		n.AddClosableComponent(outGoingBridgeOpHandler)
+		for _, outGoingBridgeClient := range outGoingBridgeClients {
+			n.AddClosableComponent(outGoingBridgeClient)
+		}
+
 		return nil
 	}
 
@@ -876,6 +927,7 @@ func (snr *sovereignNodeRunner) createHttpServer(managedStatusCoreComponents mai
 		Facade:          initialFacade,
 		ApiConfig:       *snr.configs.ApiRoutesConfig,
 		AntiFloodConfig: snr.configs.GeneralConfig.WebServerAntiflood,
+		ChainRunType:    common.ChainRunTypeSovereign,
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -972,18 +1024,32 @@ func (snr *sovereignNodeRunner) CreateManagedConsensusComponents(
 		return nil, err
 	}
 
-	extraSignersHolder, err := createOutGoingTxDataSigners(cryptoComponents.ConsensusSigningHandler())
+	// the consensus reads the bridge pause flags from the committed state, the same one from which the block
+	// processor loads them when a block processing starts
+	bridgePauseHandler, err := sovereignBlock.NewBridgePauseHandler(stateComponents.AccountsAdapterAPI())
 	if err != nil {
 		return nil, err
 	}
 
-	sovSubRoundEndCreator, err := bls.NewSovereignSubRoundEndCreator(runTypeComponents.OutGoingOperationsPoolHandler(), outGoingBridgeOpHandler)
+	// the outgoing operations signature shares are recorded in the same signing journal as the headers
+	signingJournal, err := consensusComp.CreateSigningJournal(snr.configs.GeneralConfig.SlashingProtection, snr.configs.FlagsConfig.WorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	extraSignersHolder, err := createOutGoingTxDataSigners(cryptoComponents.ConsensusSigningHandler(), bridgePauseHandler, signingJournal)
+	if err != nil {
+		return nil, err
+	}
+
+	sovSubRoundEndCreator, err := bls.NewSovereignSubRoundEndCreator(runTypeComponents.OutGoingOperationsPoolHandler(), outGoingBridgeOpHandler, bridgePauseHandler)
 	if err != nil {
 		return nil, err
 	}
 
 	consensusArgs := consensusComp.ConsensusComponentsFactoryArgs{
 		Config:                *snr.configs.GeneralConfig,
+		FlagsConfig:           *snr.configs.FlagsConfig,
 		BootstrapRoundIndex:   snr.configs.FlagsConfig.BootstrapRoundIndex,
 		CoreComponents:        coreComponents,
 		NetworkComponents:     networkComponents,
@@ -999,6 +1065,7 @@ func (snr *sovereignNodeRunner) CreateManagedConsensusComponents(
 		RunTypeComponents:     runTypeComponents,
 		ExtraSignersHolder:    extraSignersHolder,
 		SubRoundEndV2Creator:  sovSubRoundEndCreator,
+		SigningJournal:        signingJournal,
 	}
 
 	consensusFactory, err := consensusComp.NewConsensusComponentsFactory(consensusArgs)
@@ -1018,7 +1085,11 @@ func (snr *sovereignNodeRunner) CreateManagedConsensusComponents(
 	return managedConsensusComponents, nil
 }
 
-func createOutGoingTxDataSigners(signingHandler consensus.SigningHandler) (bls.ExtraSignersHolder, error) {
+func createOutGoingTxDataSigners(
+	signingHandler consensus.SigningHandler,
+	bridgePauseHandler bls.BridgePauseHandler,
+	signingJournal consensus.SigningJournal,
+) (bls.ExtraSignersHolder, error) {
 	extraSignerHandler := signingHandler.ShallowClone()
 	startRoundExtraSignersHolder := bls.NewSubRoundStartExtraSignersHolder()
 	startRoundExtraSigner, err := bls.NewSovereignSubRoundStartOutGoingTxData(extraSignerHandler)
@@ -1031,7 +1102,7 @@ func createOutGoingTxDataSigners(signingHandler consensus.SigningHandler) (bls.E
 	}
 
 	signRoundExtraSignersHolder := bls.NewSubRoundSignatureExtraSignersHolder()
-	signRoundExtraSigner, err := bls.NewSovereignSubRoundSignatureOutGoingTxData(extraSignerHandler)
+	signRoundExtraSigner, err := bls.NewSovereignSubRoundSignatureOutGoingTxData(extraSignerHandler, bridgePauseHandler, signingJournal)
 	if err != nil {
 		return nil, err
 	}
@@ -1663,7 +1734,7 @@ func (snr *sovereignNodeRunner) CreateManagedCryptoComponents(
 		PrefsConfig:                          *configs.PreferencesConfig,
 		CoreComponentsHolder:                 coreComponents,
 		ActivateBLSPubKeyMessageVerification: configs.SystemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
-		KeyLoader:                            core.NewKeyLoader(),
+		KeyLoader:                            keyStore.NewKeyLoader(configs.ConfigurationPathsHolder.ValidatorKeysPassphrase),
 		ImportModeNoSigCheck:                 configs.ImportDbConfig.ImportDbNoSigCheckFlag,
 		IsInImportMode:                       configs.ImportDbConfig.IsImportDBMode,
 		EnableEpochs:                         configs.EpochConfig.EnableEpochs,
@@ -1893,9 +1964,28 @@ func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteLi
 	return interceptors.NewWhiteListDataVerifier(whiteListCacheVerified)
 }
 
+func getIncomingChainHeaderHandler(
+	chainID string,
+	incomingHeadersRouter incomingHeader.IncomingHeadersRouter,
+	incomingChainsHandler process.IncomingChainsHandler,
+) (process.IncomingHeaderSubscriber, error) {
+	shardID, found := incomingChainsHandler.GetShardIDForChainID(chainID)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", errorsMx.ErrUnknownIncomingChainID, chainID)
+	}
+
+	incomingChainHeaderHandler, err := incomingHeadersRouter.GetIncomingHeaderHandler(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return incomingChainHeaderHandler, nil
+}
+
 func createNotifierWSReceiverServicesIfNeeded(
 	config *config.NotifierConfig,
 	incomingHeaderHandler process.IncomingHeaderSubscriber,
+	mainChainValidatorsHandler process.MainChainValidatorsHandler,
 	roundDuration uint64,
 	forkDetector process.ForkDetector,
 	bootstrapper process.Bootstrapper,
@@ -1914,6 +2004,8 @@ func createNotifierWSReceiverServicesIfNeeded(
 	sovereignWsReceiver, err := createSovereignWsReceiver(
 		config,
 		sovereignNotifier,
+		incomingHeaderHandler,
+		mainChainValidatorsHandler,
 	)
 	if err != nil {
 		return nil, err
@@ -1936,8 +2028,10 @@ func createNotifierWSReceiverServicesIfNeeded(
 func createSovereignWsReceiver(
 	config *config.NotifierConfig,
 	sovereignNotifier notifierProcess.SovereignNotifier,
+	incomingHeaderHandler process.IncomingHeaderSubscriber,
+	mainChainValidatorsHandler process.MainChainValidatorsHandler,
 ) (notifierProcess.WSClient, error) {
-	argsWsReceiver := factory.ArgsWsClientReceiverNotifier{
+	argsWsReceiver := notifier.ArgsWsClientReceiverNotifier{
 		WebSocketConfig: notifierCfg.WebSocketConfig{
 			Url:                config.WebSocketConfig.Url,
 			MarshallerType:     config.WebSocketConfig.MarshallerType,
@@ -1948,10 +2042,12 @@ func createSovereignWsReceiver(
 			AcknowledgeTimeout: config.WebSocketConfig.AcknowledgeTimeout,
 			Version:            config.WebSocketConfig.Version,
 		},
-		SovereignNotifier: sovereignNotifier,
+		SovereignNotifier:          sovereignNotifier,
+		IncomingHeaderHandler:      incomingHeaderHandler,
+		MainChainValidatorsHandler: mainChainValidatorsHandler,
 	}
 
-	return factory.CreateWsClientReceiverNotifier(argsWsReceiver)
+	return notifier.CreateWsClientReceiverNotifier(argsWsReceiver)
 }
 
 func createSovereignNotifier(config *config.NotifierConfig) (notifierProcess.SovereignNotifier, error) {
@@ -1961,7 +2057,24 @@ func createSovereignNotifier(config *config.NotifierConfig) (notifierProcess.Sov
 		HasherType:       config.WebSocketConfig.HasherType,
 	}
 
-	return factory.CreateSovereignNotifier(argsNotifier)
+	sovereignNotifier, err := factory.CreateSovereignNotifier(argsNotifier)
+	if err != nil {
+		return nil, err
+	}
+	marshaller, err := marshallerFactory.NewMarshalizer(config.WebSocketConfig.MarshallerType)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := hasherFactory.NewHasher(config.WebSocketConfig.HasherType)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifier.NewMetadataNotifier(notifier.ArgsMetadataNotifier{
+		SovereignNotifier: sovereignNotifier,
+		Marshaller:        marshaller,
+		Hasher:            hasher,
+	})
 }
 
 func startSovereignNotifierBootstrapper(
@@ -2002,3 +2115,45 @@ func getNotifierSubscribedEvents(events []config.SubscribedEvent) []notifierCfg.
 
 	return ret
 }
+
+func createOutGoingBridgeClients(sovConfig *config.SovereignConfig) (map[string]factoryBridge.ClientHandler, error) {
+	clients := make(map[string]factoryBridge.ClientHandler)
+
+	defaultClient, err := createOutGoingBridgeClient(sovConfig.OutGoingBridge, sovConfig.OutGoingBridgeCertificate)
+	if err != nil {
+		return nil, err
+	}
+	clients[""] = defaultClient
+
+	for _, destination := range sovConfig.OutgoingSubscribedEvents.Destinations {
+		client, errCreate := createOutGoingBridgeClient(destination.Bridge, getDestinationCertificate(destination, sovConfig.OutGoingBridgeCertificate))
+		if errCreate != nil {
+			return nil, fmt.Errorf("%w for outgoing destination %s", errCreate, destination.Name)
+		}
+
+		clients[destination.Name] = client
+	}
+
+	return clients, nil
+}
+
+// getDestinationCertificate returns the certificate of the destination bridge client, defaulting to the outgoing bridge one
+func getDestinationCertificate(destination config.OutGoingDestination, defaultCertificate config.OutGoingBridgeCertificate) config.OutGoingBridgeCertificate {
+	if len(destination.Certificate.CertificatePath) == 0 {
+		return defaultCertificate
+	}
+
+	return destination.Certificate
+}
+
+func createOutGoingBridgeClient(bridgeConfig config.OutGoingBridge, certificateConfig config.OutGoingBridgeCertificate) (factoryBridge.ClientHandler, error) {
+	return factoryBridge.CreateClient(&bridgeCfg.ClientConfig{
+		Enabled:  bridgeConfig.Enabled,
+		GRPCHost: bridgeConfig.GRPCHost,
+		GRPCPort: bridgeConfig.GRPCPort,
+		CertificateCfg: cert.FileCfg{
+			CertFile: certificateConfig.CertificatePath,
+			PkFile:   certificateConfig.CertificatePkPath,
+		},
+	})
+}
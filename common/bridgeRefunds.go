@@ -0,0 +1,31 @@
+package common
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+)
+
+// SetRefundedBridgeOperationHash marks the provided incoming scr as a refund of the failed outgoing bridge operation with
+// the provided hash. The refund is a result of the failed operation, hence the operation hash is its previous tx hash,
+// while its original tx hash remains the hash of the main chain tx which confirmed the execution of the operation.
+func SetRefundedBridgeOperationHash(scr *smartContractResult.SmartContractResult, operationHash []byte) {
+	scr.PrevTxHash = operationHash
+}
+
+// GetRefundedBridgeOperationHash returns the hash of the outgoing bridge operation refunded by the provided incoming scr.
+// Any other incoming scr is a result of the main chain tx which generated it, having the same previous and original tx
+// hash, hence it returns false for them.
+func GetRefundedBridgeOperationHash(scr *smartContractResult.SmartContractResult) ([]byte, bool) {
+	if scr == nil || !bytes.Equal(scr.GetSndAddr(), core.ESDTSCAddress) {
+		return nil, false
+	}
+
+	operationHash := scr.GetPrevTxHash()
+	if len(operationHash) == 0 || bytes.Equal(operationHash, scr.GetOriginalTxHash()) {
+		return nil, false
+	}
+
+	return operationHash, true
+}
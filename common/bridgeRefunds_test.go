@@ -0,0 +1,73 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+func TestSetRefundedBridgeOperationHash(t *testing.T) {
+	t.Parallel()
+
+	operationHash := []byte("operationHash")
+	mainChainTxHash := []byte("mainChainTxHash")
+	scr := &smartContractResult.SmartContractResult{
+		SndAddr:        core.ESDTSCAddress,
+		OriginalTxHash: mainChainTxHash,
+		PrevTxHash:     mainChainTxHash,
+	}
+
+	common.SetRefundedBridgeOperationHash(scr, operationHash)
+	require.Equal(t, operationHash, scr.PrevTxHash)
+	require.Equal(t, mainChainTxHash, scr.OriginalTxHash)
+
+	refundedOpHash, isRefund := common.GetRefundedBridgeOperationHash(scr)
+	require.True(t, isRefund)
+	require.Equal(t, operationHash, refundedOpHash)
+}
+
+func TestGetRefundedBridgeOperationHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil scr", func(t *testing.T) {
+		t.Parallel()
+
+		refundedOpHash, isRefund := common.GetRefundedBridgeOperationHash(nil)
+		require.False(t, isRefund)
+		require.Nil(t, refundedOpHash)
+	})
+
+	t.Run("incoming scr of a main chain tx is not a refund", func(t *testing.T) {
+		t.Parallel()
+
+		refundedOpHash, isRefund := common.GetRefundedBridgeOperationHash(&smartContractResult.SmartContractResult{
+			SndAddr:        core.ESDTSCAddress,
+			OriginalTxHash: []byte("mainChainTxHash"),
+			PrevTxHash:     []byte("mainChainTxHash"),
+		})
+		require.False(t, isRefund)
+		require.Nil(t, refundedOpHash)
+
+		refundedOpHash, isRefund = common.GetRefundedBridgeOperationHash(&smartContractResult.SmartContractResult{
+			SndAddr: core.ESDTSCAddress,
+		})
+		require.False(t, isRefund)
+		require.Nil(t, refundedOpHash)
+	})
+
+	t.Run("scr which is not incoming is not a refund", func(t *testing.T) {
+		t.Parallel()
+
+		refundedOpHash, isRefund := common.GetRefundedBridgeOperationHash(&smartContractResult.SmartContractResult{
+			SndAddr:        []byte("sender"),
+			OriginalTxHash: []byte("txHash"),
+			PrevTxHash:     []byte("prevTxHash"),
+		})
+		require.False(t, isRefund)
+		require.Nil(t, refundedOpHash)
+	})
+}
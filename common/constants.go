@@ -244,6 +244,34 @@ const MetricNumShardHeadersFromPool = "erd_num_shard_headers_from_pool"
 // MetricNumShardHeadersProcessed is the metric that stores number of shard header processed
 const MetricNumShardHeadersProcessed = "erd_num_shard_headers_processed"
 
+// MetricNumIncomingHeadersWithInvalidProofs is the metric that counts how many incoming main chain headers were rejected
+// because of invalid events inclusion proofs
+const MetricNumIncomingHeadersWithInvalidProofs = "erd_num_incoming_headers_invalid_proofs"
+
+// MetricNumRevertedIncomingHeaders is the metric that counts how many received incoming headers were reverted by the
+// notifier, or dropped because they were replaced by a fork, before becoming executable
+const MetricNumRevertedIncomingHeaders = "erd_num_reverted_incoming_headers"
+
+// MetricNumOutGoingOperationsRetries is the metric that counts how many times unconfirmed outgoing operations batches
+// were resent to the main chain
+const MetricNumOutGoingOperationsRetries = "erd_num_outgoing_operations_retries"
+
+// MetricNumOutGoingOperationsSendFailures is the metric that counts how many times sending outgoing operations to the
+// main chain failed
+const MetricNumOutGoingOperationsSendFailures = "erd_num_outgoing_operations_send_failures"
+
+// MetricNumDeadLetteredOutGoingOperations is the metric that outputs the number of outgoing operations batches which
+// were not confirmed after the max send attempts and were moved to the dead-letter store
+const MetricNumDeadLetteredOutGoingOperations = "erd_num_dead_lettered_outgoing_operations"
+
+// MetricNumRateLimitedIncomingHeaders is the metric that counts how many times an extended shard header was held
+// back from a proposed block because its incoming deposits exceeded the bridge rate limits
+const MetricNumRateLimitedIncomingHeaders = "erd_num_rate_limited_incoming_headers"
+
+// MetricNumRateLimitedOutGoingOperations is the metric that counts how many outgoing operations were queued because
+// they exceeded the bridge rate limits
+const MetricNumRateLimitedOutGoingOperations = "erd_num_rate_limited_outgoing_operations"
+
 // MetricNumTimesInForkChoice is the metric that counts how many times a node was in fork choice
 const MetricNumTimesInForkChoice = "erd_fork_choice_count"
 
@@ -1047,6 +1075,23 @@ const (
 	// TrieSyncedVal is the value that will be saved at TrieSyncedKey
 	TrieSyncedVal = "yes"
 
+	// BridgeIncomingPausedKey is the governance system smart contract storage key which holds the incoming bridge pause flag
+	BridgeIncomingPausedKey = "bridgeIncomingPaused"
+
+	// BridgeOutGoingPausedKey is the governance system smart contract storage key which holds the outgoing bridge pause flag
+	BridgeOutGoingPausedKey = "bridgeOutgoingPaused"
+
+	// BridgeIncomingRateLimitsKeyPrefix is the system account storage key prefix of the incoming bridge rate limits state
+	// of each incoming chain
+	BridgeIncomingRateLimitsKeyPrefix = "bridgeIncomingRateLimits"
+
+	// BridgeOutGoingRateLimitsKeyPrefix is the system account storage key prefix of the outgoing bridge rate limits state
+	BridgeOutGoingRateLimitsKeyPrefix = "bridgeOutgoingRateLimits"
+
+	// BridgeRefundedOperationsKeyPrefix is the system account storage key prefix of the refunds of the failed outgoing
+	// bridge operations
+	BridgeRefundedOperationsKeyPrefix = "bridgeRefundedOperations"
+
 	// TrieLeavesChannelDefaultCapacity represents the default value to be used as capacity for getting all trie leaves on
 	// a channel
 	TrieLeavesChannelDefaultCapacity = 100
@@ -1246,6 +1291,12 @@ const (
 	RelayedTransactionsV3Flag                          core.EnableEpochFlag = "RelayedTransactionsV3Flag"
 	RelayedTransactionsV3FixESDTTransferFlag           core.EnableEpochFlag = "RelayedTransactionsV3FixESDTTransferFlag"
 	ConsensusModelV2Flag                               core.EnableEpochFlag = "ConsensusModelV2Flag"
+	SovereignOutGoingOperationsBatchesFlag             core.EnableEpochFlag = "SovereignOutGoingOperationsBatchesFlag"
+	SovereignBridgePauseFlag                           core.EnableEpochFlag = "SovereignBridgePauseFlag"
+	SovereignValidatorSetRotationFlag                  core.EnableEpochFlag = "SovereignValidatorSetRotationFlag"
+	SovereignOutGoingOperationsMerkleRootFlag          core.EnableEpochFlag = "SovereignOutGoingOperationsMerkleRootFlag"
+	SovereignEquivocationProofsFlag                    core.EnableEpochFlag = "SovereignEquivocationProofsFlag"
+	SovereignIncomingEventsProofsFlag                  core.EnableEpochFlag = "SovereignIncomingEventsProofsFlag"
 	// all new flags must be added to createAllFlagsMap method, as part of enableEpochsHandler allFlagsDefined
 )
 
@@ -7,3 +7,17 @@ type MultiSignerContainer interface {
 	GetMultiSigner(epoch uint32) (crypto.MultiSigner, error)
 	IsInterfaceNil() bool
 }
+
+// RemoteSigner defines a component which signs messages with BLS keys held by an external signing service
+type RemoteSigner interface {
+	Sign(publicKey []byte, message []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// RemotePrivateKey defines a handle of a BLS private key held by a remote signer. The key material never reaches the
+// node, so the handle can only be used to sign messages
+type RemotePrivateKey interface {
+	crypto.PrivateKey
+	Sign(message []byte) ([]byte, error)
+	PublicKeyBytes() []byte
+}
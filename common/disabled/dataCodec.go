@@ -35,6 +35,11 @@ func (dc *dataCodec) SerializeOperation(_ sovereign.Operation) ([]byte, error) {
 	return make([]byte, 0), nil
 }
 
+// DeserializeOperation returns nothing
+func (dc *dataCodec) DeserializeOperation(_ []byte) (*sovereign.Operation, error) {
+	return &sovereign.Operation{}, nil
+}
+
 // IsInterfaceNil - returns true if there is no value under the interface
 func (dc *dataCodec) IsInterfaceNil() bool {
 	return dc == nil
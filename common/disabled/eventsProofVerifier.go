@@ -0,0 +1,21 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+type eventsProofVerifier struct {
+}
+
+// NewDisabledEventsProofVerifier -
+func NewDisabledEventsProofVerifier() *eventsProofVerifier {
+	return &eventsProofVerifier{}
+}
+
+// VerifyEventsProofs -
+func (epv *eventsProofVerifier) VerifyEventsProofs(_ sovereign.IncomingHeaderHandler) error {
+	return nil
+}
+
+// IsInterfaceNil - returns true if there is no value under the interface
+func (epv *eventsProofVerifier) IsInterfaceNil() bool {
+	return epv == nil
+}
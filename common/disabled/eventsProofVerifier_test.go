@@ -0,0 +1,21 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsProofVerifier_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	epv := NewDisabledEventsProofVerifier()
+	require.False(t, check.IfNil(epv))
+
+	require.NotPanics(t, func() {
+		err := epv.VerifyEventsProofs(&sovereign.IncomingHeader{})
+		require.NoError(t, err)
+	})
+}
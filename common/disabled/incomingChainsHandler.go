@@ -0,0 +1,56 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+type incomingChainsHandler struct {
+}
+
+// NewDisabledIncomingChainsHandler -
+func NewDisabledIncomingChainsHandler() *incomingChainsHandler {
+	return &incomingChainsHandler{}
+}
+
+// GetShardIDs -
+func (ich *incomingChainsHandler) GetShardIDs() []uint32 {
+	return make([]uint32, 0)
+}
+
+// GetShardIDForHeader -
+func (ich *incomingChainsHandler) GetShardIDForHeader(_ data.HeaderHandler) (uint32, error) {
+	return core.MainChainShardId, nil
+}
+
+// GetShardIDForChainID -
+func (ich *incomingChainsHandler) GetShardIDForChainID(chainID string) (uint32, bool) {
+	return core.MainChainShardId, len(chainID) == 0
+}
+
+// GetNotarizationStartRound -
+func (ich *incomingChainsHandler) GetNotarizationStartRound(_ uint32) uint64 {
+	return 0
+}
+
+// GetConfirmationDepth -
+func (ich *incomingChainsHandler) GetConfirmationDepth(_ uint32) uint64 {
+	return 0
+}
+
+// GetNonceHashDataUnit -
+func (ich *incomingChainsHandler) GetNonceHashDataUnit(_ uint32) dataRetriever.UnitType {
+	return dataRetriever.ExtendedShardHeadersNonceHashDataUnit
+}
+
+// IsIncomingChainShard -
+func (ich *incomingChainsHandler) IsIncomingChainShard(_ uint32) bool {
+	return false
+}
+
+// IsInterfaceNil - returns true if there is no value under the interface
+func (ich *incomingChainsHandler) IsInterfaceNil() bool {
+	return ich == nil
+}
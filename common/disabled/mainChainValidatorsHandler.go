@@ -0,0 +1,23 @@
+package disabled
+
+type mainChainValidatorsHandler struct {
+}
+
+// NewDisabledMainChainValidatorsHandler -
+func NewDisabledMainChainValidatorsHandler() *mainChainValidatorsHandler {
+	return &mainChainValidatorsHandler{}
+}
+
+// SetEpochEligiblePublicKeys -
+func (mcvh *mainChainValidatorsHandler) SetEpochEligiblePublicKeys(_ uint32, _ map[uint32][][]byte) error {
+	return nil
+}
+
+// SetValidatorsRatings -
+func (mcvh *mainChainValidatorsHandler) SetValidatorsRatings(_ map[string]uint32) {
+}
+
+// IsInterfaceNil - returns true if there is no value under the interface
+func (mcvh *mainChainValidatorsHandler) IsInterfaceNil() bool {
+	return mcvh == nil
+}
@@ -0,0 +1,21 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMainChainValidatorsHandler_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	mcvh := NewDisabledMainChainValidatorsHandler()
+	require.False(t, check.IfNil(mcvh))
+
+	require.NotPanics(t, func() {
+		err := mcvh.SetEpochEligiblePublicKeys(1, map[uint32][][]byte{0: {[]byte("pk")}})
+		require.NoError(t, err)
+		mcvh.SetValidatorsRatings(map[string]uint32{"pk": 1})
+	})
+}
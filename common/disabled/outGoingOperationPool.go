@@ -1,7 +1,12 @@
 package disabled
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 type outGoingOperationsPool struct {
@@ -15,11 +20,29 @@ func NewDisabledOutGoingOperationPool() *outGoingOperationsPool {
 // Add -
 func (op *outGoingOperationsPool) Add(_ *sovereign.BridgeOutGoingData) {}
 
+// AddWithDestination -
+func (op *outGoingOperationsPool) AddWithDestination(_ *sovereign.BridgeOutGoingData, _ string) {}
+
+// GetDestination -
+func (op *outGoingOperationsPool) GetDestination(_ []byte) string {
+	return ""
+}
+
 // Get -
 func (op *outGoingOperationsPool) Get(_ []byte) *sovereign.BridgeOutGoingData {
 	return &sovereign.BridgeOutGoingData{}
 }
 
+// GetByOperationHash -
+func (op *outGoingOperationsPool) GetByOperationHash(_ []byte) *sovereign.BridgeOutGoingData {
+	return nil
+}
+
+// SetSignatures -
+func (op *outGoingOperationsPool) SetSignatures(_ []byte, _ []byte, _ []byte) error {
+	return nil
+}
+
 // Delete -
 func (op *outGoingOperationsPool) Delete(_ []byte) {}
 
@@ -33,8 +56,33 @@ func (op *outGoingOperationsPool) GetUnconfirmedOperations() []*sovereign.Bridge
 	return make([]*sovereign.BridgeOutGoingData, 0)
 }
 
-// ResetTimer -
-func (op *outGoingOperationsPool) ResetTimer(_ [][]byte) {
+// ProcessUnconfirmedOperations -
+func (op *outGoingOperationsPool) ProcessUnconfirmedOperations(_ time.Time) {
+}
+
+// GetDeadLetteredOperations -
+func (op *outGoingOperationsPool) GetDeadLetteredOperations() []*sovereign.BridgeOutGoingData {
+	return make([]*sovereign.BridgeOutGoingData, 0)
+}
+
+// GetPendingOperations -
+func (op *outGoingOperationsPool) GetPendingOperations() map[string][]*sovereign.BridgeOutGoingData {
+	return make(map[string][]*sovereign.BridgeOutGoingData)
+}
+
+// RequeueDeadLetteredOperations -
+func (op *outGoingOperationsPool) RequeueDeadLetteredOperations(_ []byte) error {
+	return nil
+}
+
+// DropDeadLetteredOperations -
+func (op *outGoingOperationsPool) DropDeadLetteredOperations(_ []byte) error {
+	return nil
+}
+
+// SetStorer -
+func (op *outGoingOperationsPool) SetStorer(_ storage.Storer, _ marshal.Marshalizer) error {
+	return nil
 }
 
 // IsInterfaceNil checks if the underlying pointer is nil
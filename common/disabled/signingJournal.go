@@ -0,0 +1,24 @@
+package disabled
+
+type signingJournal struct {
+}
+
+// NewDisabledSigningJournal -
+func NewDisabledSigningJournal() *signingJournal {
+	return &signingJournal{}
+}
+
+// CheckAndRecord -
+func (sj *signingJournal) CheckAndRecord(_ []byte, _ int64, _ uint32, _ []byte) error {
+	return nil
+}
+
+// CheckAndRecordExtraData -
+func (sj *signingJournal) CheckAndRecordExtraData(_ string, _ []byte, _ int64, _ uint32, _ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil - returns true if there is no value under the interface
+func (sj *signingJournal) IsInterfaceNil() bool {
+	return sj == nil
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 )
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
@@ -91,3 +92,57 @@ type AuctionListValidatorAPIResponse struct {
 	QualifiedTopUp string         `json:"qualifiedTopUp"`
 	Nodes          []*AuctionNode `json:"nodes"`
 }
+
+// OutGoingOperationAPIResponse holds an outgoing bridge operation to be returned when responding to API calls
+type OutGoingOperationAPIResponse struct {
+	Hash string `json:"hash"`
+	Data string `json:"data"`
+}
+
+// OutGoingOperationsBatchAPIResponse holds a batch of outgoing bridge operations, along with its signatures and
+// confirmation status, to be returned when responding to API calls
+type OutGoingOperationsBatchAPIResponse struct {
+	Hash                string                          `json:"hash"`
+	Operations          []*OutGoingOperationAPIResponse `json:"operations"`
+	AggregatedSignature string                          `json:"aggregatedSignature"`
+	LeaderSignature     string                          `json:"leaderSignature"`
+	Status              string                          `json:"status"`
+}
+
+// OutGoingOperationProofStepAPIResponse holds a step from the merkle inclusion path of an outgoing bridge operation to
+// be returned when responding to API calls. IsLeft specifies if the hash is the left node when hashing with the current node.
+type OutGoingOperationProofStepAPIResponse struct {
+	Hash   string `json:"hash"`
+	IsLeft bool   `json:"isLeft"`
+}
+
+// OutGoingOperationProofAPIResponse holds the merkle inclusion proof of an outgoing bridge operation, along with the
+// sovereign header which holds it, the signed hash and the merkle root of its batch, to be returned when responding to
+// API calls
+type OutGoingOperationProofAPIResponse struct {
+	OperationHash  string                                   `json:"operationHash"`
+	HeaderHash     string                                   `json:"headerHash"`
+	Header         *block.SovereignChainHeader              `json:"header"`
+	BatchHash      string                                   `json:"batchHash"`
+	MerkleRoot     string                                   `json:"merkleRoot"`
+	OperationIndex uint32                                   `json:"operationIndex"`
+	Proof          []*OutGoingOperationProofStepAPIResponse `json:"proof"`
+}
+
+// BridgePauseStateAPIResponse holds the pause state of the sovereign bridge, as set by governance, to be returned when
+// responding to API calls
+type BridgePauseStateAPIResponse struct {
+	IncomingPaused bool `json:"incomingPaused"`
+	OutGoingPaused bool `json:"outgoingPaused"`
+}
+
+// CrossNotarizedHeaderAPIResponse holds the last cross notarized header of an incoming chain to be returned when
+// responding to API calls
+type CrossNotarizedHeaderAPIResponse struct {
+	ShardID   uint32 `json:"shardID"`
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	Timestamp uint64 `json:"timestamp"`
+}
@@ -3,6 +3,7 @@ package enablers
 import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 )
@@ -50,8 +51,42 @@ func (sovHandler *sovereignEnableEpochsHandler) addSovereignFlags() {
 }
 
 func (sovHandler *sovereignEnableEpochsHandler) addSovereignChainSpecificFlags() {
-	// follow the implementation from enableEpochsHandler.go to add a new sovereign flag
-	// add the new flags in sovHandler.enableEpochsHandler.allFlagsDefined map
+	sovHandler.allFlagsDefined[common.SovereignOutGoingOperationsBatchesFlag] = flagHandler{
+		isActiveInEpoch: func(epoch uint32) bool {
+			return epoch >= sovHandler.sovereignChainSpecificEnableEpochsConfig.OutGoingOperationsBatchesEnableEpoch
+		},
+		activationEpoch: sovHandler.sovereignChainSpecificEnableEpochsConfig.OutGoingOperationsBatchesEnableEpoch,
+	}
+	sovHandler.allFlagsDefined[common.SovereignBridgePauseFlag] = flagHandler{
+		isActiveInEpoch: func(epoch uint32) bool {
+			return epoch >= sovHandler.sovereignChainSpecificEnableEpochsConfig.BridgePauseEnableEpoch
+		},
+		activationEpoch: sovHandler.sovereignChainSpecificEnableEpochsConfig.BridgePauseEnableEpoch,
+	}
+	sovHandler.allFlagsDefined[common.SovereignValidatorSetRotationFlag] = flagHandler{
+		isActiveInEpoch: func(epoch uint32) bool {
+			return epoch >= sovHandler.sovereignChainSpecificEnableEpochsConfig.ValidatorSetRotationEnableEpoch
+		},
+		activationEpoch: sovHandler.sovereignChainSpecificEnableEpochsConfig.ValidatorSetRotationEnableEpoch,
+	}
+	sovHandler.allFlagsDefined[common.SovereignOutGoingOperationsMerkleRootFlag] = flagHandler{
+		isActiveInEpoch: func(epoch uint32) bool {
+			return epoch >= sovHandler.sovereignChainSpecificEnableEpochsConfig.OutGoingOperationsMerkleRootEnableEpoch
+		},
+		activationEpoch: sovHandler.sovereignChainSpecificEnableEpochsConfig.OutGoingOperationsMerkleRootEnableEpoch,
+	}
+	sovHandler.allFlagsDefined[common.SovereignEquivocationProofsFlag] = flagHandler{
+		isActiveInEpoch: func(epoch uint32) bool {
+			return epoch >= sovHandler.sovereignChainSpecificEnableEpochsConfig.EquivocationProofsEnableEpoch
+		},
+		activationEpoch: sovHandler.sovereignChainSpecificEnableEpochsConfig.EquivocationProofsEnableEpoch,
+	}
+	sovHandler.allFlagsDefined[common.SovereignIncomingEventsProofsFlag] = flagHandler{
+		isActiveInEpoch: func(epoch uint32) bool {
+			return epoch >= sovHandler.sovereignChainSpecificEnableEpochsConfig.IncomingEventsProofsEnableEpoch
+		},
+		activationEpoch: sovHandler.sovereignChainSpecificEnableEpochsConfig.IncomingEventsProofsEnableEpoch,
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -7,6 +7,7 @@ import (
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
@@ -36,3 +37,55 @@ func TestNewSovereignEnableEpochsHandler(t *testing.T) {
 		require.True(t, wasCalled)
 	})
 }
+
+func TestSovereignEnableEpochsHandler_SovereignChainSpecificFlags(t *testing.T) {
+	t.Parallel()
+
+	sovEpochConfig := config.SovereignEpochConfig{
+		SovereignChainSpecificEnableEpochs: config.SovereignChainSpecificEnableEpochs{
+			OutGoingOperationsBatchesEnableEpoch:    5,
+			BridgePauseEnableEpoch:                  6,
+			ValidatorSetRotationEnableEpoch:         7,
+			OutGoingOperationsMerkleRootEnableEpoch: 8,
+			EquivocationProofsEnableEpoch:           9,
+			IncomingEventsProofsEnableEpoch:         10,
+		},
+	}
+	sovHandler, err := NewSovereignEnableEpochsHandler(createEnableEpochsConfig(), sovEpochConfig, &epochNotifier.EpochNotifierStub{})
+	require.Nil(t, err)
+
+	require.True(t, sovHandler.IsFlagDefined(common.SovereignOutGoingOperationsBatchesFlag))
+	require.Equal(t, uint32(5), sovHandler.GetActivationEpoch(common.SovereignOutGoingOperationsBatchesFlag))
+	require.False(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignOutGoingOperationsBatchesFlag, 4))
+	require.True(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignOutGoingOperationsBatchesFlag, 5))
+
+	sovHandler.EpochConfirmed(4, 0)
+	require.False(t, sovHandler.IsFlagEnabled(common.SovereignOutGoingOperationsBatchesFlag))
+	sovHandler.EpochConfirmed(5, 0)
+	require.True(t, sovHandler.IsFlagEnabled(common.SovereignOutGoingOperationsBatchesFlag))
+
+	require.True(t, sovHandler.IsFlagDefined(common.SovereignBridgePauseFlag))
+	require.Equal(t, uint32(6), sovHandler.GetActivationEpoch(common.SovereignBridgePauseFlag))
+	require.False(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignBridgePauseFlag, 5))
+	require.True(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignBridgePauseFlag, 6))
+
+	require.True(t, sovHandler.IsFlagDefined(common.SovereignValidatorSetRotationFlag))
+	require.Equal(t, uint32(7), sovHandler.GetActivationEpoch(common.SovereignValidatorSetRotationFlag))
+	require.False(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignValidatorSetRotationFlag, 6))
+	require.True(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignValidatorSetRotationFlag, 7))
+
+	require.True(t, sovHandler.IsFlagDefined(common.SovereignOutGoingOperationsMerkleRootFlag))
+	require.Equal(t, uint32(8), sovHandler.GetActivationEpoch(common.SovereignOutGoingOperationsMerkleRootFlag))
+	require.False(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignOutGoingOperationsMerkleRootFlag, 7))
+	require.True(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignOutGoingOperationsMerkleRootFlag, 8))
+
+	require.True(t, sovHandler.IsFlagDefined(common.SovereignEquivocationProofsFlag))
+	require.Equal(t, uint32(9), sovHandler.GetActivationEpoch(common.SovereignEquivocationProofsFlag))
+	require.False(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignEquivocationProofsFlag, 8))
+	require.True(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignEquivocationProofsFlag, 9))
+
+	require.True(t, sovHandler.IsFlagDefined(common.SovereignIncomingEventsProofsFlag))
+	require.Equal(t, uint32(10), sovHandler.GetActivationEpoch(common.SovereignIncomingEventsProofsFlag))
+	require.False(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignIncomingEventsProofsFlag, 9))
+	require.True(t, sovHandler.IsFlagEnabledInEpoch(common.SovereignIncomingEventsProofsFlag, 10))
+}
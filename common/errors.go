@@ -13,3 +13,9 @@ var ErrNilStateSyncNotifierSubscriber = errors.New("nil state sync notifier subs
 
 // ErrCannotConvertBytesToUint64 signals that byte array cannot be converted to uin64
 var ErrCannotConvertBytesToUint64 = errors.New("cannot convert bytes to uint64")
+
+// ErrNoOutGoingOperations signals that no outgoing operations have been provided
+var ErrNoOutGoingOperations = errors.New("no outgoing operations")
+
+// ErrInvalidOutGoingOperationIndex signals that an invalid outgoing operation index has been provided
+var ErrInvalidOutGoingOperationIndex = errors.New("invalid outgoing operation index")
@@ -306,6 +306,7 @@ type EnableEpochsHandler interface {
 // ManagedPeersHolder defines the operations of an entity that holds managed identities for a node
 type ManagedPeersHolder interface {
 	AddManagedPeer(privateKeyBytes []byte) error
+	RemoveManagedPeer(pkBytes []byte) error
 	GetPrivateKey(pkBytes []byte) (crypto.PrivateKey, error)
 	GetP2PIdentity(pkBytes []byte) ([]byte, core.PeerID, error)
 	GetMachineID(pkBytes []byte) (string, error)
@@ -326,6 +327,14 @@ type ManagedPeersHolder interface {
 	IsInterfaceNil() bool
 }
 
+// ManagedKeysReloader defines the operations of an entity able to add or remove managed keys while the node is running
+type ManagedKeysReloader interface {
+	AddManagedKey(privateKeyBytes []byte) ([]byte, error)
+	RemoveManagedKey(pkBytes []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // MissingTrieNodesNotifier defines the operations of an entity that notifies about missing trie nodes
 type MissingTrieNodesNotifier interface {
 	RegisterHandler(handler StateSyncNotifierSubscriber) error
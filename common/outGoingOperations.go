@@ -0,0 +1,123 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+const (
+	merkleLeafPrefix = byte(0)
+	merkleNodePrefix = byte(1)
+)
+
+// OutGoingOperationProofStep holds a sibling hash from the inclusion path of an outgoing operation in the merkle tree of
+// its batch. IsLeft specifies if the sibling is the left node when hashing with the current node.
+type OutGoingOperationProofStep struct {
+	Hash   []byte
+	IsLeft bool
+}
+
+// ComputeOutGoingOperationsMerkleRoot computes the root of the merkle tree built over the hashes of the operations of an
+// outgoing operations batch. The tree is built as defined by RFC 6962: leaves are hashed with a 0x00 prefix, inner nodes
+// are hashed with a 0x01 prefix and the last node of a level with an odd number of nodes is promoted as it is.
+// This allows each operation to be proven against the batch merkle root, without providing the whole batch.
+func ComputeOutGoingOperationsMerkleRoot(hasher hashing.Hasher, operationsHashes [][]byte) ([]byte, error) {
+	if len(operationsHashes) == 0 {
+		return nil, ErrNoOutGoingOperations
+	}
+
+	level := computeMerkleLeaves(hasher, operationsHashes)
+	for len(level) > 1 {
+		level = computeMerkleParentLevel(hasher, level)
+	}
+
+	return level[0], nil
+}
+
+// ComputeOutGoingOperationMerkleProof computes the inclusion path of the outgoing operation with the provided index in
+// the merkle tree of its batch. The returned steps are ordered from the leaf to the root.
+func ComputeOutGoingOperationMerkleProof(hasher hashing.Hasher, operationsHashes [][]byte, index int) ([]*OutGoingOperationProofStep, error) {
+	if index < 0 || index >= len(operationsHashes) {
+		return nil, fmt.Errorf("%w, index = %d, num operations = %d", ErrInvalidOutGoingOperationIndex, index, len(operationsHashes))
+	}
+
+	proof := make([]*OutGoingOperationProofStep, 0)
+	level := computeMerkleLeaves(hasher, operationsHashes)
+	for len(level) > 1 {
+		siblingIndex := index ^ 1
+		if siblingIndex < len(level) {
+			proof = append(proof, &OutGoingOperationProofStep{
+				Hash:   level[siblingIndex],
+				IsLeft: siblingIndex < index,
+			})
+		}
+
+		level = computeMerkleParentLevel(hasher, level)
+		index /= 2
+	}
+
+	return proof, nil
+}
+
+// VerifyOutGoingOperationMerkleProof checks that the provided outgoing operation hash is included in the batch with the
+// provided merkle root, using the provided inclusion path
+func VerifyOutGoingOperationMerkleProof(
+	hasher hashing.Hasher,
+	operationHash []byte,
+	proof []*OutGoingOperationProofStep,
+	merkleRoot []byte,
+) bool {
+	node := computeMerkleLeaf(hasher, operationHash)
+	for _, step := range proof {
+		if step.IsLeft {
+			node = computeMerkleNode(hasher, step.Hash, node)
+			continue
+		}
+
+		node = computeMerkleNode(hasher, node, step.Hash)
+	}
+
+	return bytes.Equal(node, merkleRoot)
+}
+
+func computeMerkleLeaves(hasher hashing.Hasher, operationsHashes [][]byte) [][]byte {
+	leaves := make([][]byte, 0, len(operationsHashes))
+	for _, operationHash := range operationsHashes {
+		leaves = append(leaves, computeMerkleLeaf(hasher, operationHash))
+	}
+
+	return leaves
+}
+
+func computeMerkleParentLevel(hasher hashing.Hasher, level [][]byte) [][]byte {
+	parentLevel := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			parentLevel = append(parentLevel, level[i])
+			continue
+		}
+
+		parentLevel = append(parentLevel, computeMerkleNode(hasher, level[i], level[i+1]))
+	}
+
+	return parentLevel
+}
+
+func computeMerkleLeaf(hasher hashing.Hasher, operationHash []byte) []byte {
+	leaf := make([]byte, 0, len(operationHash)+1)
+	leaf = append(leaf, merkleLeafPrefix)
+	leaf = append(leaf, operationHash...)
+
+	return hasher.Compute(string(leaf))
+}
+
+func computeMerkleNode(hasher hashing.Hasher, left []byte, right []byte) []byte {
+	node := make([]byte, 0, len(left)+len(right)+1)
+	node = append(node, merkleNodePrefix)
+	node = append(node, left...)
+	node = append(node, right...)
+
+	return hasher.Compute(string(node))
+}
@@ -0,0 +1,133 @@
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/stretchr/testify/require"
+)
+
+func createOperationsHashes(numOperations int) [][]byte {
+	hasher := sha256.NewSha256()
+	operationsHashes := make([][]byte, 0, numOperations)
+	for i := 0; i < numOperations; i++ {
+		operationsHashes = append(operationsHashes, hasher.Compute(fmt.Sprintf("operation%d", i)))
+	}
+
+	return operationsHashes
+}
+
+func TestComputeOutGoingOperationsMerkleRoot(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.NewSha256()
+	leaf := func(hash []byte) []byte {
+		return hasher.Compute(string(append([]byte{0}, hash...)))
+	}
+	node := func(left []byte, right []byte) []byte {
+		return hasher.Compute(string(append(append([]byte{1}, left...), right...)))
+	}
+
+	t.Run("no operations should error", func(t *testing.T) {
+		t.Parallel()
+
+		root, err := common.ComputeOutGoingOperationsMerkleRoot(hasher, nil)
+		require.Equal(t, common.ErrNoOutGoingOperations, err)
+		require.Nil(t, root)
+	})
+	t.Run("one operation, should work", func(t *testing.T) {
+		t.Parallel()
+
+		opsHashes := createOperationsHashes(1)
+		root, err := common.ComputeOutGoingOperationsMerkleRoot(hasher, opsHashes)
+		require.Nil(t, err)
+		require.Equal(t, leaf(opsHashes[0]), root)
+	})
+	t.Run("odd number of operations should promote the last node", func(t *testing.T) {
+		t.Parallel()
+
+		opsHashes := createOperationsHashes(5)
+		root, err := common.ComputeOutGoingOperationsMerkleRoot(hasher, opsHashes)
+		require.Nil(t, err)
+
+		left := node(node(leaf(opsHashes[0]), leaf(opsHashes[1])), node(leaf(opsHashes[2]), leaf(opsHashes[3])))
+		require.Equal(t, node(left, leaf(opsHashes[4])), root)
+	})
+}
+
+func TestComputeOutGoingOperationMerkleProof(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.NewSha256()
+
+	t.Run("invalid index should error", func(t *testing.T) {
+		t.Parallel()
+
+		opsHashes := createOperationsHashes(3)
+		proof, err := common.ComputeOutGoingOperationMerkleProof(hasher, opsHashes, 3)
+		require.True(t, errors.Is(err, common.ErrInvalidOutGoingOperationIndex))
+		require.Nil(t, proof)
+
+		proof, err = common.ComputeOutGoingOperationMerkleProof(hasher, opsHashes, -1)
+		require.True(t, errors.Is(err, common.ErrInvalidOutGoingOperationIndex))
+		require.Nil(t, proof)
+	})
+	t.Run("one operation should have an empty proof", func(t *testing.T) {
+		t.Parallel()
+
+		opsHashes := createOperationsHashes(1)
+		proof, err := common.ComputeOutGoingOperationMerkleProof(hasher, opsHashes, 0)
+		require.Nil(t, err)
+		require.Empty(t, proof)
+	})
+	t.Run("proofs of all operations should verify against the root", func(t *testing.T) {
+		t.Parallel()
+
+		for numOps := 1; numOps <= 17; numOps++ {
+			opsHashes := createOperationsHashes(numOps)
+			root, err := common.ComputeOutGoingOperationsMerkleRoot(hasher, opsHashes)
+			require.Nil(t, err)
+
+			for idx := 0; idx < numOps; idx++ {
+				proof, errProof := common.ComputeOutGoingOperationMerkleProof(hasher, opsHashes, idx)
+				require.Nil(t, errProof)
+				require.True(t, common.VerifyOutGoingOperationMerkleProof(hasher, opsHashes[idx], proof, root),
+					"num operations = %d, index = %d", numOps, idx)
+			}
+		}
+	})
+}
+
+func TestVerifyOutGoingOperationMerkleProof(t *testing.T) {
+	t.Parallel()
+
+	hasher := sha256.NewSha256()
+	opsHashes := createOperationsHashes(4)
+	root, _ := common.ComputeOutGoingOperationsMerkleRoot(hasher, opsHashes)
+	proof, _ := common.ComputeOutGoingOperationMerkleProof(hasher, opsHashes, 2)
+
+	t.Run("valid proof should verify", func(t *testing.T) {
+		t.Parallel()
+
+		require.True(t, common.VerifyOutGoingOperationMerkleProof(hasher, opsHashes[2], proof, root))
+	})
+	t.Run("other operation should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		require.False(t, common.VerifyOutGoingOperationMerkleProof(hasher, opsHashes[3], proof, root))
+	})
+	t.Run("other root should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		require.False(t, common.VerifyOutGoingOperationMerkleProof(hasher, opsHashes[2], proof, opsHashes[0]))
+	})
+	t.Run("inner node as operation should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		innerNodeProof := proof[1:]
+		require.False(t, common.VerifyOutGoingOperationMerkleProof(hasher, proof[0].Hash, innerNodeProof, root))
+	})
+}
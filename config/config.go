@@ -228,6 +228,10 @@ type Config struct {
 	PeersRatingConfig   PeersRatingConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 	Redundancy          RedundancyConfig
+	SlashingProtection  SlashingProtectionConfig
+	EquivocationProofs  EquivocationProofsConfig
+	RemoteSigner        RemoteSignerConfig
+	ManagedKeysReload   ManagedKeysReloadConfig
 
 	// TODO: (RaduChis): When we have separate factories to pass configs from node runners,
 	// we need to remove this from here
@@ -546,9 +550,15 @@ type ProcessDebugConfig struct {
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	AdminAuth   ApiAdminAuthConfig
 	APIPackages map[string]APIPackageConfig
 }
 
+// ApiAdminAuthConfig holds the configuration related to the authentication of the admin API requests
+type ApiAdminAuthConfig struct {
+	AdminTokenFile string
+}
+
 // ApiLoggingConfig holds the configuration related to API requests logging
 type ApiLoggingConfig struct {
 	LoggingEnabled          bool
@@ -614,6 +624,7 @@ type ConfigurationPathsHolder struct {
 	SmartContracts           string
 	ValidatorKey             string
 	AllValidatorKeys         string
+	ValidatorKeysPassphrase  string
 	Epoch                    string
 	RoundActivation          string
 	P2pKey                   string
@@ -644,3 +655,38 @@ type PoolsCleanersConfig struct {
 type RedundancyConfig struct {
 	MaxRoundsOfInactivityAccepted int
 }
+
+// SlashingProtectionConfig represents the config options of the journal which prevents the consensus keys from signing
+// conflicting data
+type SlashingProtectionConfig struct {
+	Enabled     bool
+	JournalFile string
+	ImportFile  string
+	ExportFile  string
+}
+
+// EquivocationProofsConfig represents the config options for detecting and keeping the proofs of the validators which
+// sent conflicting consensus messages in the same round
+type EquivocationProofsConfig struct {
+	Enabled         bool
+	ProofsDirectory string
+}
+
+// RemoteSignerConfig represents the config options of the remote signing service which holds the BLS key of the node
+type RemoteSignerConfig struct {
+	Enabled               bool
+	URL                   string
+	PublicKey             string
+	TimeoutInMilliseconds uint32
+	CertificateFile       string
+	KeyFile               string
+	CACertificateFile     string
+}
+
+// ManagedKeysReloadConfig represents the config options for adding and removing managed keys while the node is running
+type ManagedKeysReloadConfig struct {
+	Enabled                    bool
+	WatchAllValidatorsKeysFile bool
+	FileCheckIntervalInSeconds uint32
+	RoundCheckIntervalInMillis uint32
+}
@@ -4,7 +4,10 @@ package config
 type SovereignConfig struct {
 	ExtendedShardHdrNonceHashStorage StorageConfig
 	ExtendedShardHeaderStorage       StorageConfig
+	OutGoingOperationsStorage        StorageConfig
+	IncomingHeadersQueueStorage      StorageConfig
 	MainChainNotarization            MainChainNotarization    `toml:"MainChainNotarization"`
+	IncomingChains                   []IncomingChain          `toml:"IncomingChains"`
 	OutgoingSubscribedEvents         OutgoingSubscribedEvents `toml:"OutgoingSubscribedEvents"`
 	OutGoingBridge                   OutGoingBridge           `toml:"OutGoingBridge"`
 	NotifierConfig                   NotifierConfig           `toml:"NotifierConfig"`
@@ -14,21 +17,126 @@ type SovereignConfig struct {
 
 // OutgoingSubscribedEvents holds config for outgoing subscribed events
 type OutgoingSubscribedEvents struct {
-	TimeToWaitForUnconfirmedOutGoingOperationInSeconds uint32            `toml:"TimeToWaitForUnconfirmedOutGoingOperationInSeconds"`
-	SubscribedEvents                                   []SubscribedEvent `toml:"SubscribedEvents"`
+	TimeToWaitForUnconfirmedOutGoingOperationInSeconds uint32                  `toml:"TimeToWaitForUnconfirmedOutGoingOperationInSeconds"`
+	SubscribedEvents                                   []SubscribedEvent       `toml:"SubscribedEvents"`
+	Batch                                              OutGoingOperationsBatch `toml:"Batch"`
+	Retry                                              OutGoingOperationsRetry `toml:"Retry"`
+	Destinations                                       []OutGoingDestination   `toml:"Destinations"`
+	RateLimits                                         BridgeRateLimits        `toml:"RateLimits"`
+	ValidatorSetRotation                               ValidatorSetRotation    `toml:"ValidatorSetRotation"`
+}
+
+// ValidatorSetRotation holds config for notifying the main chain about the sovereign validators set of each new epoch.
+// The notification is activated by the ValidatorSetRotationEnableEpoch, hence the config should be the same on all the nodes
+type ValidatorSetRotation struct {
+	Address  string `toml:"Address"`
+	GasLimit uint64 `toml:"GasLimit"`
+}
+
+// BridgeRateLimits holds the volume caps enforced, per epoch, on the tokens bridged in one direction. Each token is
+// capped separately, in its smallest denomination. The global volume is the sum of the amounts of all the configured
+// tokens, each normalised to whole tokens through its decimals, hence it is expressed in whole tokens. Tokens which are
+// not configured are not counted in the global volume. Volumes are base 10 integers and an empty or zero volume means
+// there is no limit. The state of the limits is saved in the system account, hence the config should be the same on all
+// the nodes
+type BridgeRateLimits struct {
+	Enabled           bool               `toml:"Enabled"`
+	GlobalVolumeLimit string             `toml:"GlobalVolumeLimit"`
+	TokenVolumeLimits []TokenVolumeLimit `toml:"TokenVolumeLimits"`
+}
+
+// TokenVolumeLimit holds the volume cap, per epoch, of a bridged token, in its smallest denomination, together with
+// the number of decimals used to normalise its amounts in the global volume
+type TokenVolumeLimit struct {
+	Identifier  string `toml:"Identifier"`
+	VolumeLimit string `toml:"VolumeLimit"`
+	Decimals    uint32 `toml:"Decimals"`
+}
+
+// OutGoingDestination holds config for a named main chain destination (bridge contract) to which subscribed outgoing
+// events are routed. Operations of each destination are batched, signed and confirmed independently of the other
+// destinations and are sent through the destination's own bridge client. An empty codec means the default codec is
+// used, while an empty certificate means the default outgoing bridge certificate is used
+type OutGoingDestination struct {
+	Name             string                    `toml:"Name"`
+	ChainID          string                    `toml:"ChainID"`
+	Codec            string                    `toml:"Codec"`
+	SubscribedEvents []SubscribedEvent         `toml:"SubscribedEvents"`
+	Bridge           OutGoingBridge            `toml:"Bridge"`
+	Certificate      OutGoingBridgeCertificate `toml:"Certificate"`
+}
+
+// OutGoingOperationsBatch holds config for splitting the outgoing operations from a block into multiple batches, each
+// of them being signed and bridged separately
+type OutGoingOperationsBatch struct {
+	MaxSizeInBytes       uint64 `toml:"MaxSizeInBytes"`
+	MaxGasLimit          uint64 `toml:"MaxGasLimit"`
+	GasLimitPerOperation uint64 `toml:"GasLimitPerOperation"`
+	GasLimitPerDataByte  uint64 `toml:"GasLimitPerDataByte"`
+}
+
+// OutGoingOperationsRetry holds config for resending unconfirmed outgoing operations with exponential backoff and for
+// moving the ones which can not be confirmed to a dead-letter store
+type OutGoingOperationsRetry struct {
+	MaxTimeToWaitInSeconds uint32 `toml:"MaxTimeToWaitInSeconds"`
+	MaxSendAttempts        uint32 `toml:"MaxSendAttempts"`
 }
 
 // MainChainNotarization defines necessary data to start main chain notarization on a sovereign shard
 type MainChainNotarization struct {
-	MainChainNotarizationStartRound uint64 `toml:"MainChainNotarizationStartRound"`
+	MainChainID                     string                               `toml:"MainChainID"`
+	MainChainNotarizationStartRound uint64                               `toml:"MainChainNotarizationStartRound"`
+	ConfirmationDepth               uint64                               `toml:"ConfirmationDepth"`
+	HeaderSignatureVerification     MainChainHeaderSignatureVerification `toml:"HeaderSignatureVerification"`
+}
+
+// MainChainHeaderSignatureVerification holds the config for verifying that the main chain headers wrapped in the
+// extended shard headers are signed by the main chain validators
+type MainChainHeaderSignatureVerification struct {
+	Enabled               bool               `toml:"Enabled"`
+	ConsensusGroupSize    int                `toml:"ConsensusGroupSize"`
+	NodesRegistryFilePath string             `toml:"NodesRegistryFilePath"`
+	StartRating           uint32             `toml:"StartRating"`
+	SelectionChances      []*SelectionChance `toml:"SelectionChances"`
+}
+
+// IncomingChain holds config for an additional chain (e.g. another sovereign chain) from which incoming headers are
+// received. Headers of each incoming chain are tracked and cross notarized independently of the main chain headers
+type IncomingChain struct {
+	ChainID                          string                                   `toml:"ChainID"`
+	NotarizationStartRound           uint64                                   `toml:"NotarizationStartRound"`
+	ConfirmationDepth                uint64                                   `toml:"ConfirmationDepth"`
+	NotifierConfig                   NotifierConfig                           `toml:"NotifierConfig"`
+	ExtendedShardHdrNonceHashStorage StorageConfig                            `toml:"ExtendedShardHdrNonceHashStorage"`
+	HeaderSignatureVerification      IncomingChainHeaderSignatureVerification `toml:"HeaderSignatureVerification"`
+}
+
+// IncomingChainHeaderSignatureVerification holds the config for verifying that the headers of an incoming sovereign
+// chain are signed by its validators, used whenever the main chain header signatures are verified
+type IncomingChainHeaderSignatureVerification struct {
+	ConsensusGroupSize    int                `toml:"ConsensusGroupSize"`
+	NodesRegistryFilePath string             `toml:"NodesRegistryFilePath"`
+	StartRating           uint32             `toml:"StartRating"`
+	SelectionChances      []*SelectionChance `toml:"SelectionChances"`
 }
 
 // OutGoingBridge holds config for grpc client to send outgoing bridge txs
 type OutGoingBridge struct {
+	Enabled            bool               `toml:"Enabled"`
+	GRPCHost           string             `toml:"GRPCHost"`
+	GRPCPort           string             `toml:"GRPCPort"`
+	Hasher             string             `toml:"Hasher"`
+	NativeTokensPrefix string             `toml:"NativeTokensPrefix"`
+	NativeTokensUnlock NativeTokensUnlock `toml:"NativeTokensUnlock"`
+}
+
+// NativeTokensUnlock holds the config of the call which unlocks, from the sovereign safe contract, the native tokens of
+// an outgoing operation which failed on the main chain
+type NativeTokensUnlock struct {
 	Enabled  bool   `toml:"Enabled"`
-	GRPCHost string `toml:"GRPCHost"`
-	GRPCPort string `toml:"GRPCPort"`
-	Hasher   string `toml:"Hasher"`
+	Address  string `toml:"Address"`
+	Function string `toml:"Function"`
+	GasLimit uint64 `toml:"GasLimit"`
 }
 
 // OutGoingBridgeCertificate holds config for outgoing bridge certificate paths
@@ -39,9 +147,19 @@ type OutGoingBridgeCertificate struct {
 
 // NotifierConfig holds sovereign notifier configuration
 type NotifierConfig struct {
-	Enabled          bool              `toml:"Enabled"`
-	SubscribedEvents []SubscribedEvent `toml:"SubscribedEvents"`
-	WebSocketConfig  WebSocketConfig   `toml:"WebSocket"`
+	Enabled                  bool                     `toml:"Enabled"`
+	SubscribedEvents         []SubscribedEvent        `toml:"SubscribedEvents"`
+	WebSocketConfig          WebSocketConfig          `toml:"WebSocket"`
+	RateLimits               BridgeRateLimits         `toml:"RateLimits"`
+	ExecutedBridgeOpCallback ExecutedBridgeOpCallback `toml:"ExecutedBridgeOpCallback"`
+}
+
+// ExecutedBridgeOpCallback holds the config for the callback scr which notifies the sovereign contract that initiated an
+// outgoing bridge operation about the execution status of the operation on the main chain
+type ExecutedBridgeOpCallback struct {
+	Enabled  bool   `toml:"Enabled"`
+	Function string `toml:"Function"`
+	GasLimit uint64 `toml:"GasLimit"`
 }
 
 // SubscribedEvent holds subscribed events config
@@ -10,4 +10,11 @@ type SovereignEpochConfig struct {
 type SovereignEnableEpochs struct{}
 
 // SovereignChainSpecificEnableEpochs will hold the configuration for sovereign chain specific activation epochs
-type SovereignChainSpecificEnableEpochs struct{}
+type SovereignChainSpecificEnableEpochs struct {
+	OutGoingOperationsBatchesEnableEpoch    uint32
+	BridgePauseEnableEpoch                  uint32
+	ValidatorSetRotationEnableEpoch         uint32
+	OutGoingOperationsMerkleRootEnableEpoch uint32
+	EquivocationProofsEnableEpoch           uint32
+	IncomingEventsProofsEnableEpoch         uint32
+}
@@ -1067,10 +1067,24 @@ func TestSovereignEnableEpochConfig(t *testing.T) {
 [SovereignEnableEpochs]
 
 [SovereignChainSpecificEnableEpochs]
+    OutGoingOperationsBatchesEnableEpoch = 1
+    BridgePauseEnableEpoch = 2
+    ValidatorSetRotationEnableEpoch = 3
+    OutGoingOperationsMerkleRootEnableEpoch = 4
+    EquivocationProofsEnableEpoch = 5
+    IncomingEventsProofsEnableEpoch = 6
 `
 
 	expectedCfg := SovereignEpochConfig{
 		SovereignEnableEpochs: SovereignEnableEpochs{},
+		SovereignChainSpecificEnableEpochs: SovereignChainSpecificEnableEpochs{
+			OutGoingOperationsBatchesEnableEpoch:    1,
+			BridgePauseEnableEpoch:                  2,
+			ValidatorSetRotationEnableEpoch:         3,
+			OutGoingOperationsMerkleRootEnableEpoch: 4,
+			EquivocationProofsEnableEpoch:           5,
+			IncomingEventsProofsEnableEpoch:         6,
+		},
 	}
 
 	cfg := SovereignEpochConfig{}
@@ -0,0 +1,33 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+type disabledEquivocationDetector struct {
+}
+
+// NewDisabledEquivocationDetector returns a new instance of disabledEquivocationDetector
+func NewDisabledEquivocationDetector() *disabledEquivocationDetector {
+	return &disabledEquivocationDetector{}
+}
+
+// CheckProposal does nothing
+func (ded *disabledEquivocationDetector) CheckProposal(_ *consensus.Message, _ p2p.MessageP2P) {
+}
+
+// CheckSignature does nothing
+func (ded *disabledEquivocationDetector) CheckSignature(_ *consensus.Message, _ p2p.MessageP2P) {
+}
+
+// GetProofs returns an empty slice
+func (ded *disabledEquivocationDetector) GetProofs() []*equivocation.Proof {
+	return make([]*equivocation.Proof, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ded *disabledEquivocationDetector) IsInterfaceNil() bool {
+	return ded == nil
+}
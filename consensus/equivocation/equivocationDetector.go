@@ -0,0 +1,271 @@
+package equivocation
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/p2p"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("consensus/equivocation")
+
+// maxRoundsToKeep is the number of rounds, before the highest one received, for which the messages are kept
+const maxRoundsToKeep = 10
+
+// ArgsEquivocationDetector holds the arguments needed to create an equivocation detector
+type ArgsEquivocationDetector struct {
+	Hasher               hashing.Hasher
+	PeerSignatureHandler crypto.PeerSignatureHandler
+	SignatureVerifier    SignatureVerifier
+	ProofsDirectory      string
+}
+
+type receivedMessage struct {
+	cnsMsg *consensus.Message
+	p2pMsg p2p.MessageP2P
+}
+
+type roundMessages struct {
+	proposals  map[string]*receivedMessage
+	signatures map[string]*receivedMessage
+	headers    map[string][]byte
+}
+
+type equivocationDetector struct {
+	hasher               hashing.Hasher
+	peerSignatureHandler crypto.PeerSignatureHandler
+	signatureVerifier    SignatureVerifier
+	proofsDirectory      string
+
+	mut              sync.RWMutex
+	rounds           map[int64]*roundMessages
+	highestRound     int64
+	proofs           []*Proof
+	proofIdentifiers map[string]struct{}
+}
+
+// NewEquivocationDetector creates a component which detects the validators sending conflicting consensus messages in
+// the same round: a leader proposing different headers or a validator signing different headers. Each equivocation is
+// kept as a proof, persisted in the proofs directory
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs, err := readProofs(args.ProofsDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	ed := &equivocationDetector{
+		hasher:               args.Hasher,
+		peerSignatureHandler: args.PeerSignatureHandler,
+		signatureVerifier:    args.SignatureVerifier,
+		proofsDirectory:      args.ProofsDirectory,
+		rounds:               make(map[int64]*roundMessages),
+		proofs:               proofs,
+		proofIdentifiers:     make(map[string]struct{}, len(proofs)),
+	}
+	for _, proof := range proofs {
+		ed.proofIdentifiers[proof.identifier()] = struct{}{}
+	}
+
+	log.Debug("loaded equivocation proofs", "directory", args.ProofsDirectory, "num proofs", len(proofs))
+
+	return ed, nil
+}
+
+func checkArgs(args ArgsEquivocationDetector) error {
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.PeerSignatureHandler) {
+		return ErrNilPeerSignatureHandler
+	}
+	if check.IfNil(args.SignatureVerifier) {
+		return ErrNilSignatureVerifier
+	}
+	if len(args.ProofsDirectory) == 0 {
+		return ErrEmptyProofsDirectory
+	}
+
+	return nil
+}
+
+// CheckProposal checks if the leader already proposed a different header in the same round
+func (ed *equivocationDetector) CheckProposal(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P) {
+	ed.checkMessage(cnsMsg, p2pMsg, DoubleProposal)
+}
+
+// CheckSignature checks if the validator already signed a different header in the same round
+func (ed *equivocationDetector) CheckSignature(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P) {
+	ed.checkMessage(cnsMsg, p2pMsg, DoubleSignature)
+}
+
+func (ed *equivocationDetector) checkMessage(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P, proofType ProofType) {
+	if cnsMsg == nil || check.IfNil(p2pMsg) || len(cnsMsg.PubKey) == 0 || len(cnsMsg.HeaderHash) == 0 {
+		return
+	}
+
+	ed.mut.Lock()
+	defer ed.mut.Unlock()
+
+	messages, ok := ed.getOrCreateRoundMessages(cnsMsg.RoundIndex)
+	if !ok {
+		return
+	}
+
+	if proofType == DoubleProposal && ed.isHeaderHashValid(cnsMsg) {
+		messages.headers[string(cnsMsg.HeaderHash)] = cnsMsg.Header
+	}
+
+	messagesOfType := messages.signatures
+	if proofType == DoubleProposal {
+		messagesOfType = messages.proposals
+	}
+
+	received := &receivedMessage{
+		cnsMsg: cnsMsg,
+		p2pMsg: p2pMsg,
+	}
+	pubKey := string(cnsMsg.PubKey)
+	previous, found := messagesOfType[pubKey]
+	if !found {
+		messagesOfType[pubKey] = received
+		return
+	}
+	if bytes.Equal(previous.cnsMsg.HeaderHash, cnsMsg.HeaderHash) {
+		return
+	}
+
+	// the messages are only verified on conflict, as anyone could have sent a message on behalf of the validator
+	err := ed.verifyMessage(received, proofType)
+	if err != nil {
+		log.Debug("equivocationDetector: invalid conflicting message", "type", proofType, "pk", cnsMsg.PubKey, "error", err)
+		return
+	}
+	err = ed.verifyMessage(previous, proofType)
+	if err != nil {
+		log.Debug("equivocationDetector: invalid previous message", "type", proofType, "pk", cnsMsg.PubKey, "error", err)
+		messagesOfType[pubKey] = received
+		return
+	}
+
+	ed.addProof(proofType, previous, received, messages.headers)
+}
+
+func (ed *equivocationDetector) getOrCreateRoundMessages(round int64) (*roundMessages, bool) {
+	if round < ed.highestRound-maxRoundsToKeep {
+		return nil, false
+	}
+
+	if round > ed.highestRound {
+		ed.highestRound = round
+		for oldRound := range ed.rounds {
+			if oldRound < round-maxRoundsToKeep {
+				delete(ed.rounds, oldRound)
+			}
+		}
+	}
+
+	messages, found := ed.rounds[round]
+	if !found {
+		messages = &roundMessages{
+			proposals:  make(map[string]*receivedMessage),
+			signatures: make(map[string]*receivedMessage),
+			headers:    make(map[string][]byte),
+		}
+		ed.rounds[round] = messages
+	}
+
+	return messages, true
+}
+
+func (ed *equivocationDetector) isHeaderHashValid(cnsMsg *consensus.Message) bool {
+	return len(cnsMsg.Header) > 0 && bytes.Equal(ed.hasher.Compute(string(cnsMsg.Header)), cnsMsg.HeaderHash)
+}
+
+func (ed *equivocationDetector) verifyMessage(received *receivedMessage, proofType ProofType) error {
+	cnsMsg := received.cnsMsg
+	originator := core.PeerID(cnsMsg.OriginatorPid)
+	if originator != received.p2pMsg.Peer() {
+		return ErrOriginatorMismatch
+	}
+
+	err := ed.peerSignatureHandler.VerifyPeerSignature(cnsMsg.PubKey, originator, cnsMsg.Signature)
+	if err != nil {
+		return err
+	}
+
+	if proofType == DoubleProposal {
+		if !ed.isHeaderHashValid(cnsMsg) {
+			return ErrWrongHeaderHash
+		}
+
+		return nil
+	}
+
+	return ed.signatureVerifier.Verify(cnsMsg.HeaderHash, cnsMsg.SignatureShare, cnsMsg.PubKey)
+}
+
+func (ed *equivocationDetector) addProof(proofType ProofType, first *receivedMessage, second *receivedMessage, headers map[string][]byte) {
+	hexPubKey := hex.EncodeToString(first.cnsMsg.PubKey)
+	round := first.cnsMsg.RoundIndex
+	identifier := createProofIdentifier(proofType, round, hexPubKey)
+	_, exists := ed.proofIdentifiers[identifier]
+	if exists {
+		return
+	}
+
+	proof := &Proof{
+		Type:          proofType,
+		PubKey:        hexPubKey,
+		Round:         round,
+		Timestamp:     time.Now().Unix(),
+		FirstMessage:  newSignedMessage(first.cnsMsg, first.p2pMsg, headers[string(first.cnsMsg.HeaderHash)]),
+		SecondMessage: newSignedMessage(second.cnsMsg, second.p2pMsg, headers[string(second.cnsMsg.HeaderHash)]),
+	}
+	proof.TransactionData = createTransactionData(proof)
+
+	ed.proofs = append(ed.proofs, proof)
+	ed.proofIdentifiers[identifier] = struct{}{}
+
+	log.Warn("equivocation detected",
+		"type", proofType,
+		"pk", first.cnsMsg.PubKey,
+		"round", round,
+		"first header hash", first.cnsMsg.HeaderHash,
+		"second header hash", second.cnsMsg.HeaderHash,
+		"submittable", len(proof.TransactionData) > 0,
+	)
+
+	err := writeProof(ed.proofsDirectory, proof)
+	if err != nil {
+		log.Error("equivocationDetector: could not persist the proof", "proof", identifier, "error", err)
+	}
+}
+
+// GetProofs returns all the equivocation proofs, including the ones detected before the node restarted
+func (ed *equivocationDetector) GetProofs() []*Proof {
+	ed.mut.RLock()
+	defer ed.mut.RUnlock()
+
+	proofs := make([]*Proof, len(ed.proofs))
+	copy(proofs, ed.proofs)
+
+	return proofs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
@@ -0,0 +1,295 @@
+package equivocation
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pubKey     = []byte("pubKey")
+	originator = core.PeerID("originator")
+	header1    = []byte("header1")
+	header2    = []byte("header2")
+)
+
+func createMockArgsEquivocationDetector(t *testing.T) ArgsEquivocationDetector {
+	return ArgsEquivocationDetector{
+		Hasher:               &hashingMocks.HasherMock{},
+		PeerSignatureHandler: &cryptoMocks.PeerSignatureHandlerStub{},
+		SignatureVerifier:    &testscommon.MessageSignVerifierMock{},
+		ProofsDirectory:      filepath.Join(t.TempDir(), "equivocationProofs"),
+	}
+}
+
+func createMessages(args ArgsEquivocationDetector, round int64, header []byte, share []byte) (*consensus.Message, *p2pmocks.P2PMessageMock) {
+	cnsMsg := &consensus.Message{
+		HeaderHash:     args.Hasher.Compute(string(header)),
+		Header:         header,
+		SignatureShare: share,
+		PubKey:         pubKey,
+		Signature:      []byte("peer signature"),
+		RoundIndex:     round,
+		OriginatorPid:  originator.Bytes(),
+	}
+	p2pMsg := &p2pmocks.P2PMessageMock{
+		DataField:      []byte("data"),
+		PeerField:      originator,
+		SignatureField: []byte("p2p signature"),
+		TopicField:     "consensus",
+	}
+
+	return cnsMsg, p2pMsg
+}
+
+func TestNewEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hasher, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		args.Hasher = nil
+		ed, err := NewEquivocationDetector(args)
+		require.Equal(t, ErrNilHasher, err)
+		require.Nil(t, ed)
+	})
+	t.Run("nil peer signature handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		args.PeerSignatureHandler = nil
+		ed, err := NewEquivocationDetector(args)
+		require.Equal(t, ErrNilPeerSignatureHandler, err)
+		require.Nil(t, ed)
+	})
+	t.Run("nil signature verifier, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		args.SignatureVerifier = nil
+		ed, err := NewEquivocationDetector(args)
+		require.Equal(t, ErrNilSignatureVerifier, err)
+		require.Nil(t, ed)
+	})
+	t.Run("empty proofs directory, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		args.ProofsDirectory = ""
+		ed, err := NewEquivocationDetector(args)
+		require.Equal(t, ErrEmptyProofsDirectory, err)
+		require.Nil(t, ed)
+	})
+	t.Run("corrupted proof file, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		require.Nil(t, os.MkdirAll(args.ProofsDirectory, os.ModePerm))
+		require.Nil(t, os.WriteFile(filepath.Join(args.ProofsDirectory, "proof.json"), []byte("not a proof"), 0600))
+
+		ed, err := NewEquivocationDetector(args)
+		require.NotNil(t, err)
+		require.Nil(t, ed)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ed, err := NewEquivocationDetector(createMockArgsEquivocationDetector(t))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(ed))
+		require.Empty(t, ed.GetProofs())
+	})
+}
+
+func TestEquivocationDetector_CheckProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same header proposed twice, should not create a proof", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		ed.CheckProposal(createMessages(args, 10, header1, nil))
+		ed.CheckProposal(createMessages(args, 10, header1, nil))
+		require.Empty(t, ed.GetProofs())
+	})
+	t.Run("different headers in different rounds, should not create a proof", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		ed.CheckProposal(createMessages(args, 10, header1, nil))
+		ed.CheckProposal(createMessages(args, 11, header2, nil))
+		require.Empty(t, ed.GetProofs())
+	})
+	t.Run("conflicting message with wrong header hash, should not create a proof", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		ed.CheckProposal(createMessages(args, 10, header1, nil))
+		cnsMsg, p2pMsg := createMessages(args, 10, header2, nil)
+		cnsMsg.HeaderHash = []byte("wrong hash")
+		ed.CheckProposal(cnsMsg, p2pMsg)
+		require.Empty(t, ed.GetProofs())
+	})
+	t.Run("conflicting message sent by another peer, should not create a proof", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		ed.CheckProposal(createMessages(args, 10, header1, nil))
+		cnsMsg, p2pMsg := createMessages(args, 10, header2, nil)
+		p2pMsg.PeerField = "other peer"
+		ed.CheckProposal(cnsMsg, p2pMsg)
+		require.Empty(t, ed.GetProofs())
+	})
+	t.Run("invalid peer signature on the first message, should replace it", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		args.PeerSignatureHandler = &cryptoMocks.PeerSignatureHandlerStub{
+			VerifyPeerSignatureCalled: func(pk []byte, pid core.PeerID, signature []byte) error {
+				if string(signature) == "forged" {
+					return errors.New("invalid peer signature")
+				}
+				return nil
+			},
+		}
+		ed, _ := NewEquivocationDetector(args)
+
+		forgedMsg, p2pMsg := createMessages(args, 10, header1, nil)
+		forgedMsg.Signature = []byte("forged")
+		ed.CheckProposal(forgedMsg, p2pMsg)
+		ed.CheckProposal(createMessages(args, 10, header2, nil))
+		require.Empty(t, ed.GetProofs())
+
+		// the valid message replaced the forged one, so a third header is detected
+		ed.CheckProposal(createMessages(args, 10, []byte("header3"), nil))
+		require.Len(t, ed.GetProofs(), 1)
+	})
+	t.Run("different headers in the same round, should create a proof without transaction data", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		ed.CheckProposal(createMessages(args, 10, header1, nil))
+		ed.CheckProposal(createMessages(args, 10, header2, nil))
+		ed.CheckProposal(createMessages(args, 10, []byte("header3"), nil))
+
+		proofs := ed.GetProofs()
+		require.Len(t, proofs, 1)
+		require.Equal(t, DoubleProposal, proofs[0].Type)
+		require.Equal(t, int64(10), proofs[0].Round)
+		require.Empty(t, proofs[0].TransactionData)
+	})
+}
+
+func TestEquivocationDetector_CheckSignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid signature share on the conflicting message, should not create a proof", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		args.SignatureVerifier = &testscommon.MessageSignVerifierMock{
+			VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+				if string(signedMessage) == "share2" {
+					return errors.New("invalid signature share")
+				}
+				return nil
+			},
+		}
+		ed, _ := NewEquivocationDetector(args)
+
+		ed.CheckSignature(createMessages(args, 10, nil, []byte("share1")))
+		cnsMsg, p2pMsg := createMessages(args, 10, nil, []byte("share2"))
+		cnsMsg.HeaderHash = args.Hasher.Compute(string(header2))
+		ed.CheckSignature(cnsMsg, p2pMsg)
+		require.Empty(t, ed.GetProofs())
+	})
+	t.Run("signed headers not proposed, should create a proof without transaction data", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		firstMsg, firstP2PMsg := createMessages(args, 10, header1, []byte("share1"))
+		firstMsg.Header = nil
+		ed.CheckSignature(firstMsg, firstP2PMsg)
+		secondMsg, secondP2PMsg := createMessages(args, 10, header2, []byte("share2"))
+		secondMsg.Header = nil
+		ed.CheckSignature(secondMsg, secondP2PMsg)
+
+		proofs := ed.GetProofs()
+		require.Len(t, proofs, 1)
+		require.Equal(t, DoubleSignature, proofs[0].Type)
+		require.Empty(t, proofs[0].TransactionData)
+	})
+	t.Run("signed headers proposed, should create a submittable proof which survives a restart", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		// a malicious leader proposes both headers, which are then signed by the same validator
+		leaderMsg, leaderP2PMsg := createMessages(args, 10, header1, nil)
+		leaderMsg.PubKey = []byte("leader")
+		ed.CheckProposal(leaderMsg, leaderP2PMsg)
+		leaderMsg, leaderP2PMsg = createMessages(args, 10, header2, nil)
+		leaderMsg.PubKey = []byte("leader")
+		ed.CheckProposal(leaderMsg, leaderP2PMsg)
+
+		firstMsg, firstP2PMsg := createMessages(args, 10, header1, []byte("share1"))
+		firstMsg.Header = nil
+		ed.CheckSignature(firstMsg, firstP2PMsg)
+		secondMsg, secondP2PMsg := createMessages(args, 10, header2, []byte("share2"))
+		secondMsg.Header = nil
+		ed.CheckSignature(secondMsg, secondP2PMsg)
+
+		proofs := ed.GetProofs()
+		require.Len(t, proofs, 2)
+		require.Equal(t, DoubleProposal, proofs[0].Type)
+		require.Equal(t, DoubleSignature, proofs[1].Type)
+		expectedTxData := "submitEquivocationProof@7075624b6579@68656164657231@736861726531@68656164657232@736861726532"
+		require.Equal(t, expectedTxData, proofs[1].TransactionData)
+
+		restartedDetector, err := NewEquivocationDetector(args)
+		require.Nil(t, err)
+		require.Len(t, restartedDetector.GetProofs(), 2)
+
+		// the same equivocation is not reported twice
+		restartedDetector.CheckSignature(firstMsg, firstP2PMsg)
+		restartedDetector.CheckSignature(secondMsg, secondP2PMsg)
+		require.Len(t, restartedDetector.GetProofs(), 2)
+	})
+	t.Run("messages older than the kept rounds, should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEquivocationDetector(t)
+		ed, _ := NewEquivocationDetector(args)
+
+		ed.CheckSignature(createMessages(args, 10, header1, []byte("share1")))
+		ed.CheckSignature(createMessages(args, 10+maxRoundsToKeep+1, header1, []byte("share1")))
+		require.Len(t, ed.rounds, 1)
+
+		ed.CheckSignature(createMessages(args, 10, header2, []byte("share2")))
+		require.Empty(t, ed.GetProofs())
+	})
+}
@@ -0,0 +1,21 @@
+package equivocation
+
+import "errors"
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPeerSignatureHandler signals that a nil peer signature handler was provided
+var ErrNilPeerSignatureHandler = errors.New("nil peer signature handler")
+
+// ErrNilSignatureVerifier signals that a nil signature verifier was provided
+var ErrNilSignatureVerifier = errors.New("nil signature verifier")
+
+// ErrEmptyProofsDirectory signals that an empty proofs directory was provided
+var ErrEmptyProofsDirectory = errors.New("empty equivocation proofs directory")
+
+// ErrOriginatorMismatch signals that the originator of the consensus message does not match the p2p message sender
+var ErrOriginatorMismatch = errors.New("consensus message originator mismatch")
+
+// ErrWrongHeaderHash signals that the proposed header does not match its hash
+var ErrWrongHeaderHash = errors.New("wrong header hash")
@@ -0,0 +1,7 @@
+package equivocation
+
+// SignatureVerifier defines the component able to verify the signature of a message, given the signer public key
+type SignatureVerifier interface {
+	Verify(message []byte, signedMessage []byte, pubKey []byte) error
+	IsInterfaceNil() bool
+}
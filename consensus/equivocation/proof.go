@@ -0,0 +1,177 @@
+package equivocation
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+// SubmitProofFunction is the validator system smart contract function which accepts double signature proofs
+const SubmitProofFunction = "submitEquivocationProof"
+
+const proofFileExtension = ".json"
+
+// ProofType defines the kind of equivocation a proof was built for
+type ProofType string
+
+const (
+	// DoubleProposal is the proof type of a leader which proposed two different headers in the same round
+	DoubleProposal ProofType = "doubleProposal"
+	// DoubleSignature is the proof type of a validator which signed two different headers in the same round
+	DoubleSignature ProofType = "doubleSignature"
+)
+
+// SignedMessage is one of the two conflicting consensus messages of a proof, along with the p2p envelope in which it
+// was received. The double proposals can only be verified using the envelope, which is signed with the p2p key of the
+// originator, while the double signatures are verified using the signature shares and the headers. All the binary
+// fields are hex encoded
+type SignedMessage struct {
+	HeaderHash       string `json:"headerHash"`
+	Header           string `json:"header,omitempty"`
+	SignatureShare   string `json:"signatureShare,omitempty"`
+	ConsensusMessage string `json:"consensusMessage"`
+	P2PFrom          string `json:"p2pFrom"`
+	P2PPayload       string `json:"p2pPayload"`
+	P2PSeqNo         string `json:"p2pSeqNo"`
+	P2PTopic         string `json:"p2pTopic"`
+	P2PSignature     string `json:"p2pSignature"`
+	P2PKey           string `json:"p2pKey,omitempty"`
+}
+
+// Proof holds two conflicting consensus messages sent by the same validator in the same round. TransactionData is
+// only set for the double signatures whose headers are both known, being the data field of a transaction which submits
+// the proof to the validator system smart contract
+type Proof struct {
+	Type            ProofType      `json:"type"`
+	PubKey          string         `json:"pubKey"`
+	Round           int64          `json:"round"`
+	Timestamp       int64          `json:"timestamp"`
+	FirstMessage    *SignedMessage `json:"firstMessage"`
+	SecondMessage   *SignedMessage `json:"secondMessage"`
+	TransactionData string         `json:"transactionData,omitempty"`
+}
+
+func newSignedMessage(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P, header []byte) *SignedMessage {
+	return &SignedMessage{
+		HeaderHash:       hex.EncodeToString(cnsMsg.HeaderHash),
+		Header:           hex.EncodeToString(header),
+		SignatureShare:   hex.EncodeToString(cnsMsg.SignatureShare),
+		ConsensusMessage: hex.EncodeToString(p2pMsg.Data()),
+		P2PFrom:          hex.EncodeToString(p2pMsg.From()),
+		P2PPayload:       hex.EncodeToString(p2pMsg.Payload()),
+		P2PSeqNo:         hex.EncodeToString(p2pMsg.SeqNo()),
+		P2PTopic:         p2pMsg.Topic(),
+		P2PSignature:     hex.EncodeToString(p2pMsg.Signature()),
+		P2PKey:           hex.EncodeToString(p2pMsg.Key()),
+	}
+}
+
+func (p *Proof) identifier() string {
+	return createProofIdentifier(p.Type, p.Round, p.PubKey)
+}
+
+func createProofIdentifier(proofType ProofType, round int64, hexPubKey string) string {
+	return fmt.Sprintf("%s_%d_%s", proofType, round, hexPubKey)
+}
+
+// createTransactionData returns the data field of the transaction which submits a double signature proof, in the
+// form submitEquivocationProof@pubKey@firstHeader@firstSignatureShare@secondHeader@secondSignatureShare
+func createTransactionData(proof *Proof) string {
+	if proof.Type != DoubleSignature {
+		return ""
+	}
+	if len(proof.FirstMessage.Header) == 0 || len(proof.SecondMessage.Header) == 0 {
+		return ""
+	}
+
+	return strings.Join([]string{
+		SubmitProofFunction,
+		proof.PubKey,
+		proof.FirstMessage.Header,
+		proof.FirstMessage.SignatureShare,
+		proof.SecondMessage.Header,
+		proof.SecondMessage.SignatureShare,
+	}, "@")
+}
+
+func readProofs(directory string) ([]*Proof, error) {
+	entries, err := os.ReadDir(directory)
+	if os.IsNotExist(err) {
+		return make([]*Proof, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([]*Proof, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != proofFileExtension {
+			continue
+		}
+
+		filePath := filepath.Join(directory, entry.Name())
+		buff, errRead := os.ReadFile(filePath)
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		proof := &Proof{}
+		errRead = json.Unmarshal(buff, proof)
+		if errRead != nil {
+			return nil, fmt.Errorf("%w while reading equivocation proof %s", errRead, filePath)
+		}
+
+		proofs = append(proofs, proof)
+	}
+
+	sort.SliceStable(proofs, func(i, j int) bool {
+		return proofs[i].Round < proofs[j].Round
+	})
+
+	return proofs, nil
+}
+
+// writeProof writes the proof in a temporary file which replaces the destination only after it was synced to disk,
+// so a crash in the middle of the write can not leave a truncated proof behind
+func writeProof(directory string, proof *Proof) error {
+	buff, err := json.MarshalIndent(proof, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(directory, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	filePath := filepath.Join(directory, proof.identifier()+proofFileExtension)
+	tmpFilePath := filePath + ".tmp"
+	file, err := os.OpenFile(tmpFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilePath, filePath)
+}
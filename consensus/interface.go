@@ -209,6 +209,22 @@ type SigningHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SigningJournal defines the behaviour of a component which keeps track of the data signed by each consensus key,
+// refusing to sign data which conflicts with what the key already signed
+type SigningJournal interface {
+	CheckAndRecord(pubKey []byte, round int64, epoch uint32, headerHash []byte) error
+	CheckAndRecordExtraData(identifier string, pubKey []byte, round int64, epoch uint32, dataHash []byte) error
+	IsInterfaceNil() bool
+}
+
+// EquivocationDetector defines the behaviour of a component which detects the validators sending conflicting consensus
+// messages in the same round
+type EquivocationDetector interface {
+	CheckProposal(cnsMsg *Message, p2pMsg p2p.MessageP2P)
+	CheckSignature(cnsMsg *Message, p2pMsg p2p.MessageP2P)
+	IsInterfaceNil() bool
+}
+
 // KeysHandler defines the operations implemented by a component that will manage all keys,
 // including the single signer keys or the set of multi-keys
 type KeysHandler interface {
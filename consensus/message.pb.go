@@ -28,24 +28,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Message defines the data needed by spos to communicate between nodes over network in all subrounds
 type Message struct {
-	HeaderHash                        []byte `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
-	SignatureShare                    []byte `protobuf:"bytes,2,opt,name=SignatureShare,proto3" json:"SignatureShare,omitempty"`
-	Body                              []byte `protobuf:"bytes,3,opt,name=Body,proto3" json:"Body,omitempty"`
-	Header                            []byte `protobuf:"bytes,4,opt,name=Header,proto3" json:"Header,omitempty"`
-	PubKey                            []byte `protobuf:"bytes,5,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
-	Signature                         []byte `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
-	MsgType                           int64  `protobuf:"varint,7,opt,name=MsgType,proto3" json:"MsgType,omitempty"`
-	RoundIndex                        int64  `protobuf:"varint,8,opt,name=RoundIndex,proto3" json:"RoundIndex,omitempty"`
-	ChainID                           []byte `protobuf:"bytes,9,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
-	PubKeysBitmap                     []byte `protobuf:"bytes,10,opt,name=PubKeysBitmap,proto3" json:"PubKeysBitmap,omitempty"`
-	AggregateSignature                []byte `protobuf:"bytes,11,opt,name=AggregateSignature,proto3" json:"AggregateSignature,omitempty"`
-	LeaderSignature                   []byte `protobuf:"bytes,12,opt,name=LeaderSignature,proto3" json:"LeaderSignature,omitempty"`
-	OriginatorPid                     []byte `protobuf:"bytes,13,opt,name=OriginatorPid,proto3" json:"OriginatorPid,omitempty"`
-	InvalidSigners                    []byte `protobuf:"bytes,14,opt,name=InvalidSigners,proto3" json:"InvalidSigners,omitempty"`
-	ProcessedHeaderHash               []byte `protobuf:"bytes,15,opt,name=ProcessedHeaderHash,proto3" json:"ProcessedHeaderHash,omitempty"`
-	SignatureShareOutGoingTxData      []byte `protobuf:"bytes,16,opt,name=SignatureShareOutGoingTxData,proto3" json:"SignatureShareOutGoingTxData,omitempty"`
-	AggregatedSignatureOutGoingTxData []byte `protobuf:"bytes,17,opt,name=AggregatedSignatureOutGoingTxData,proto3" json:"AggregatedSignatureOutGoingTxData,omitempty"`
-	LeaderSignatureOutGoingTxData     []byte `protobuf:"bytes,18,opt,name=LeaderSignatureOutGoingTxData,proto3" json:"LeaderSignatureOutGoingTxData,omitempty"`
+	HeaderHash                         []byte   `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	SignatureShare                     []byte   `protobuf:"bytes,2,opt,name=SignatureShare,proto3" json:"SignatureShare,omitempty"`
+	Body                               []byte   `protobuf:"bytes,3,opt,name=Body,proto3" json:"Body,omitempty"`
+	Header                             []byte   `protobuf:"bytes,4,opt,name=Header,proto3" json:"Header,omitempty"`
+	PubKey                             []byte   `protobuf:"bytes,5,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	Signature                          []byte   `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	MsgType                            int64    `protobuf:"varint,7,opt,name=MsgType,proto3" json:"MsgType,omitempty"`
+	RoundIndex                         int64    `protobuf:"varint,8,opt,name=RoundIndex,proto3" json:"RoundIndex,omitempty"`
+	ChainID                            []byte   `protobuf:"bytes,9,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	PubKeysBitmap                      []byte   `protobuf:"bytes,10,opt,name=PubKeysBitmap,proto3" json:"PubKeysBitmap,omitempty"`
+	AggregateSignature                 []byte   `protobuf:"bytes,11,opt,name=AggregateSignature,proto3" json:"AggregateSignature,omitempty"`
+	LeaderSignature                    []byte   `protobuf:"bytes,12,opt,name=LeaderSignature,proto3" json:"LeaderSignature,omitempty"`
+	OriginatorPid                      []byte   `protobuf:"bytes,13,opt,name=OriginatorPid,proto3" json:"OriginatorPid,omitempty"`
+	InvalidSigners                     []byte   `protobuf:"bytes,14,opt,name=InvalidSigners,proto3" json:"InvalidSigners,omitempty"`
+	ProcessedHeaderHash                []byte   `protobuf:"bytes,15,opt,name=ProcessedHeaderHash,proto3" json:"ProcessedHeaderHash,omitempty"`
+	SignatureShareOutGoingTxData       []byte   `protobuf:"bytes,16,opt,name=SignatureShareOutGoingTxData,proto3" json:"SignatureShareOutGoingTxData,omitempty"`
+	AggregatedSignatureOutGoingTxData  []byte   `protobuf:"bytes,17,opt,name=AggregatedSignatureOutGoingTxData,proto3" json:"AggregatedSignatureOutGoingTxData,omitempty"`
+	LeaderSignatureOutGoingTxData      []byte   `protobuf:"bytes,18,opt,name=LeaderSignatureOutGoingTxData,proto3" json:"LeaderSignatureOutGoingTxData,omitempty"`
+	SignatureSharesOutGoingTxData      [][]byte `protobuf:"bytes,19,rep,name=SignatureSharesOutGoingTxData,proto3" json:"SignatureSharesOutGoingTxData,omitempty"`
+	AggregatedSignaturesOutGoingTxData [][]byte `protobuf:"bytes,20,rep,name=AggregatedSignaturesOutGoingTxData,proto3" json:"AggregatedSignaturesOutGoingTxData,omitempty"`
+	LeaderSignaturesOutGoingTxData     [][]byte `protobuf:"bytes,21,rep,name=LeaderSignaturesOutGoingTxData,proto3" json:"LeaderSignaturesOutGoingTxData,omitempty"`
 }
 
 func (m *Message) Reset()      { *m = Message{} }
@@ -202,6 +205,27 @@ func (m *Message) GetLeaderSignatureOutGoingTxData() []byte {
 	return nil
 }
 
+func (m *Message) GetSignatureSharesOutGoingTxData() [][]byte {
+	if m != nil {
+		return m.SignatureSharesOutGoingTxData
+	}
+	return nil
+}
+
+func (m *Message) GetAggregatedSignaturesOutGoingTxData() [][]byte {
+	if m != nil {
+		return m.AggregatedSignaturesOutGoingTxData
+	}
+	return nil
+}
+
+func (m *Message) GetLeaderSignaturesOutGoingTxData() [][]byte {
+	if m != nil {
+		return m.LeaderSignaturesOutGoingTxData
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Message)(nil), "proto.Message")
 }
@@ -209,36 +233,38 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0x13, 0x3d,
-	0x14, 0xc5, 0xc7, 0x5f, 0x9b, 0xe4, 0xcb, 0xa5, 0x69, 0xe1, 0x22, 0x21, 0x0b, 0x15, 0xab, 0x20,
-	0x84, 0xb2, 0x21, 0x45, 0xe2, 0x09, 0x48, 0x23, 0xd1, 0x88, 0x56, 0x8d, 0xd2, 0xae, 0xd8, 0x39,
-	0x19, 0xe3, 0x58, 0x22, 0x76, 0x64, 0xcf, 0xa0, 0x66, 0xc7, 0x23, 0xf0, 0x18, 0x3c, 0x0a, 0xcb,
-	0x2c, 0xb3, 0x83, 0x4c, 0x36, 0x2c, 0xfb, 0x08, 0x68, 0xee, 0xd0, 0xfc, 0x19, 0x55, 0x65, 0x15,
-	0xdf, 0xdf, 0x39, 0xf7, 0xdc, 0xd8, 0x57, 0x03, 0x8d, 0xb1, 0x0a, 0x41, 0x6a, 0xd5, 0x9a, 0x78,
-	0x97, 0x38, 0xac, 0xd0, 0xcf, 0xd3, 0xd7, 0xda, 0x24, 0xa3, 0x74, 0xd0, 0x1a, 0xba, 0xf1, 0xb1,
-	0x76, 0xda, 0x1d, 0x13, 0x1e, 0xa4, 0x9f, 0xa8, 0xa2, 0x82, 0x4e, 0x45, 0xd7, 0x8b, 0x9f, 0x15,
-	0xa8, 0x9d, 0x17, 0x39, 0x28, 0x00, 0x4e, 0x95, 0x8c, 0x95, 0x3f, 0x95, 0x61, 0xc4, 0xd9, 0x11,
-	0x6b, 0xee, 0xf5, 0x37, 0x08, 0xbe, 0x82, 0xfd, 0x4b, 0xa3, 0xad, 0x4c, 0x52, 0xaf, 0x2e, 0x47,
-	0xd2, 0x2b, 0xfe, 0x1f, 0x79, 0x4a, 0x14, 0x11, 0x76, 0xdb, 0x2e, 0x9e, 0xf2, 0x1d, 0x52, 0xe9,
-	0x8c, 0x4f, 0xa0, 0x5a, 0x24, 0xf1, 0x5d, 0xa2, 0x7f, 0xab, 0x9c, 0xf7, 0xd2, 0xc1, 0x07, 0x35,
-	0xe5, 0x95, 0x82, 0x17, 0x15, 0x1e, 0x42, 0x7d, 0x95, 0xca, 0xab, 0x24, 0xad, 0x01, 0x72, 0xa8,
-	0x9d, 0x07, 0x7d, 0x35, 0x9d, 0x28, 0x5e, 0x3b, 0x62, 0xcd, 0x9d, 0xfe, 0x6d, 0x99, 0xdf, 0xa1,
-	0xef, 0x52, 0x1b, 0x77, 0x6d, 0xac, 0xae, 0xf9, 0xff, 0x24, 0x6e, 0x90, 0xbc, 0xf3, 0x64, 0x24,
-	0x8d, 0xed, 0x76, 0x78, 0x9d, 0x52, 0x6f, 0x4b, 0x7c, 0x09, 0x8d, 0x62, 0x76, 0x68, 0x9b, 0x64,
-	0x2c, 0x27, 0x1c, 0x48, 0xdf, 0x86, 0xd8, 0x02, 0x7c, 0xa7, 0xb5, 0x57, 0x5a, 0x26, 0x6a, 0xfd,
-	0x07, 0x1f, 0x90, 0xf5, 0x0e, 0x05, 0x9b, 0x70, 0x70, 0x46, 0x37, 0x5d, 0x9b, 0xf7, 0xc8, 0x5c,
-	0xc6, 0xf9, 0xfc, 0x0b, 0x6f, 0xb4, 0xb1, 0x32, 0x71, 0xbe, 0x67, 0x62, 0xde, 0x28, 0xe6, 0x6f,
-	0xc1, 0x7c, 0x07, 0x5d, 0xfb, 0x45, 0x7e, 0x36, 0x71, 0xde, 0xa9, 0x7c, 0xe0, 0xfb, 0xc5, 0x0e,
-	0xb6, 0x29, 0xbe, 0x81, 0xc7, 0x3d, 0xef, 0x86, 0x2a, 0x04, 0x15, 0x6f, 0x2c, 0xf5, 0x80, 0xcc,
-	0x77, 0x49, 0xd8, 0x86, 0xc3, 0xed, 0x3d, 0x5e, 0xa4, 0xc9, 0x7b, 0x67, 0xac, 0xbe, 0xba, 0xee,
-	0xc8, 0x44, 0xf2, 0x87, 0xd4, 0x7a, 0xaf, 0x07, 0xcf, 0xe0, 0xf9, 0xea, 0x0d, 0xe2, 0x95, 0xb3,
-	0x14, 0xf4, 0x88, 0x82, 0xfe, 0x6d, 0xc4, 0x0e, 0x3c, 0x2b, 0x3d, 0x52, 0x29, 0x09, 0x29, 0xe9,
-	0x7e, 0x53, 0xfb, 0x64, 0xb6, 0x10, 0xd1, 0x7c, 0x21, 0xa2, 0x9b, 0x85, 0x60, 0x5f, 0x33, 0xc1,
-	0xbe, 0x67, 0x82, 0xfd, 0xc8, 0x04, 0x9b, 0x65, 0x82, 0xcd, 0x33, 0xc1, 0x7e, 0x65, 0x82, 0xfd,
-	0xce, 0x44, 0x74, 0x93, 0x09, 0xf6, 0x6d, 0x29, 0xa2, 0xd9, 0x52, 0x44, 0xf3, 0xa5, 0x88, 0x3e,
-	0xd6, 0x87, 0xce, 0x06, 0x65, 0x43, 0x1a, 0x06, 0x55, 0xfa, 0x5a, 0xde, 0xfe, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0xd6, 0x17, 0x78, 0x74, 0x03, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0xa4, 0x4d, 0xc8, 0xd0, 0xb4, 0x30, 0x05, 0xb4, 0x42, 0x65, 0x15, 0x2a, 0x84,
+	0x72, 0x21, 0x45, 0xe2, 0x09, 0x48, 0x23, 0x68, 0x44, 0x4b, 0xa3, 0xb4, 0x27, 0x6e, 0x9b, 0x78,
+	0xd9, 0x58, 0x22, 0xde, 0xc8, 0x6b, 0xa3, 0xe6, 0xc6, 0x23, 0xf0, 0x18, 0xbc, 0x04, 0x77, 0x8e,
+	0x39, 0xe6, 0x48, 0x9c, 0x0b, 0xc7, 0x3e, 0x02, 0xf2, 0x98, 0xe6, 0xcf, 0x2a, 0x4a, 0x7b, 0xf2,
+	0xce, 0x37, 0xdf, 0xfc, 0x66, 0x76, 0x47, 0x32, 0x54, 0x06, 0xca, 0x5a, 0xa9, 0x55, 0x7d, 0x18,
+	0x99, 0xd8, 0xe0, 0x36, 0x7d, 0x9e, 0xbd, 0xd6, 0x41, 0xdc, 0x4f, 0xba, 0xf5, 0x9e, 0x19, 0x1c,
+	0x69, 0xa3, 0xcd, 0x11, 0xc9, 0xdd, 0xe4, 0x0b, 0x45, 0x14, 0xd0, 0x29, 0xaf, 0x3a, 0xfc, 0x55,
+	0x82, 0xd2, 0x59, 0xce, 0x41, 0x01, 0x70, 0xa2, 0xa4, 0xaf, 0xa2, 0x13, 0x69, 0xfb, 0x9c, 0x55,
+	0x59, 0x6d, 0xa7, 0xb3, 0xa4, 0xe0, 0x2b, 0xd8, 0xbd, 0x08, 0x74, 0x28, 0xe3, 0x24, 0x52, 0x17,
+	0x7d, 0x19, 0x29, 0x7e, 0x8f, 0x3c, 0x8e, 0x8a, 0x08, 0x5b, 0x0d, 0xe3, 0x8f, 0x78, 0x81, 0xb2,
+	0x74, 0xc6, 0xa7, 0x50, 0xcc, 0x49, 0x7c, 0x8b, 0xd4, 0xff, 0x51, 0xa6, 0xb7, 0x93, 0xee, 0x47,
+	0x35, 0xe2, 0xdb, 0xb9, 0x9e, 0x47, 0x78, 0x00, 0xe5, 0x39, 0x95, 0x17, 0x29, 0xb5, 0x10, 0x90,
+	0x43, 0xe9, 0xcc, 0xea, 0xcb, 0xd1, 0x50, 0xf1, 0x52, 0x95, 0xd5, 0x0a, 0x9d, 0x9b, 0x30, 0xbb,
+	0x43, 0xc7, 0x24, 0xa1, 0xdf, 0x0a, 0x7d, 0x75, 0xc5, 0xef, 0x53, 0x72, 0x49, 0xc9, 0x2a, 0x8f,
+	0xfb, 0x32, 0x08, 0x5b, 0x4d, 0x5e, 0x26, 0xea, 0x4d, 0x88, 0x2f, 0xa1, 0x92, 0xf7, 0xb6, 0x8d,
+	0x20, 0x1e, 0xc8, 0x21, 0x07, 0xca, 0xaf, 0x8a, 0x58, 0x07, 0x7c, 0xa7, 0x75, 0xa4, 0xb4, 0x8c,
+	0xd5, 0x62, 0xc0, 0x07, 0x64, 0x5d, 0x93, 0xc1, 0x1a, 0xec, 0x9d, 0xd2, 0x4d, 0x17, 0xe6, 0x1d,
+	0x32, 0xbb, 0x72, 0xd6, 0xff, 0x3c, 0x0a, 0x74, 0x10, 0xca, 0xd8, 0x44, 0xed, 0xc0, 0xe7, 0x95,
+	0xbc, 0xff, 0x8a, 0x98, 0xed, 0xa0, 0x15, 0x7e, 0x93, 0x5f, 0x03, 0x3f, 0xab, 0x54, 0x91, 0xe5,
+	0xbb, 0xf9, 0x0e, 0x56, 0x55, 0x7c, 0x03, 0xfb, 0xed, 0xc8, 0xf4, 0x94, 0xb5, 0xca, 0x5f, 0x5a,
+	0xea, 0x1e, 0x99, 0xd7, 0xa5, 0xb0, 0x01, 0x07, 0xab, 0x7b, 0x3c, 0x4f, 0xe2, 0x0f, 0x26, 0x08,
+	0xf5, 0xe5, 0x55, 0x53, 0xc6, 0x92, 0x3f, 0xa4, 0xd2, 0x8d, 0x1e, 0x3c, 0x85, 0x17, 0xf3, 0x37,
+	0xf0, 0xe7, 0x4e, 0x07, 0xf4, 0x88, 0x40, 0xb7, 0x1b, 0xb1, 0x09, 0xcf, 0x9d, 0x47, 0x72, 0x48,
+	0x48, 0xa4, 0xcd, 0xa6, 0x8c, 0xb2, 0x3a, 0xb3, 0x75, 0x28, 0xfb, 0xd5, 0x42, 0x46, 0xd9, 0x68,
+	0xc2, 0x4f, 0x70, 0xb8, 0x66, 0x60, 0x17, 0xf5, 0x98, 0x50, 0x77, 0x70, 0xe2, 0x7b, 0x10, 0xce,
+	0xd8, 0x2e, 0xeb, 0x09, 0xb1, 0x6e, 0x71, 0x35, 0x8e, 0xc7, 0x53, 0xe1, 0x4d, 0xa6, 0xc2, 0xbb,
+	0x9e, 0x0a, 0xf6, 0x3d, 0x15, 0xec, 0x67, 0x2a, 0xd8, 0xef, 0x54, 0xb0, 0x71, 0x2a, 0xd8, 0x24,
+	0x15, 0xec, 0x4f, 0x2a, 0xd8, 0xdf, 0x54, 0x78, 0xd7, 0xa9, 0x60, 0x3f, 0x66, 0xc2, 0x1b, 0xcf,
+	0x84, 0x37, 0x99, 0x09, 0xef, 0x73, 0xb9, 0x67, 0x42, 0xab, 0x42, 0x9b, 0xd8, 0x6e, 0x91, 0xfe,
+	0x05, 0x6f, 0xff, 0x0d, 0x00, 0x46, 0x51, 0x74, 0xdc, 0x52, 0x04, 0x00, 0x00,
 }
 
 func (this *Message) Equal(that interface{}) bool {
@@ -314,13 +340,37 @@ func (this *Message) Equal(that interface{}) bool {
 	if !bytes.Equal(this.LeaderSignatureOutGoingTxData, that1.LeaderSignatureOutGoingTxData) {
 		return false
 	}
+	if len(this.SignatureSharesOutGoingTxData) != len(that1.SignatureSharesOutGoingTxData) {
+		return false
+	}
+	for i := range this.SignatureSharesOutGoingTxData {
+		if !bytes.Equal(this.SignatureSharesOutGoingTxData[i], that1.SignatureSharesOutGoingTxData[i]) {
+			return false
+		}
+	}
+	if len(this.AggregatedSignaturesOutGoingTxData) != len(that1.AggregatedSignaturesOutGoingTxData) {
+		return false
+	}
+	for i := range this.AggregatedSignaturesOutGoingTxData {
+		if !bytes.Equal(this.AggregatedSignaturesOutGoingTxData[i], that1.AggregatedSignaturesOutGoingTxData[i]) {
+			return false
+		}
+	}
+	if len(this.LeaderSignaturesOutGoingTxData) != len(that1.LeaderSignaturesOutGoingTxData) {
+		return false
+	}
+	for i := range this.LeaderSignaturesOutGoingTxData {
+		if !bytes.Equal(this.LeaderSignaturesOutGoingTxData[i], that1.LeaderSignaturesOutGoingTxData[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Message) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 22)
+	s := make([]string, 0, 25)
 	s = append(s, "&consensus.Message{")
 	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
 	s = append(s, "SignatureShare: "+fmt.Sprintf("%#v", this.SignatureShare)+",\n")
@@ -340,6 +390,9 @@ func (this *Message) GoString() string {
 	s = append(s, "SignatureShareOutGoingTxData: "+fmt.Sprintf("%#v", this.SignatureShareOutGoingTxData)+",\n")
 	s = append(s, "AggregatedSignatureOutGoingTxData: "+fmt.Sprintf("%#v", this.AggregatedSignatureOutGoingTxData)+",\n")
 	s = append(s, "LeaderSignatureOutGoingTxData: "+fmt.Sprintf("%#v", this.LeaderSignatureOutGoingTxData)+",\n")
+	s = append(s, "SignatureSharesOutGoingTxData: "+fmt.Sprintf("%#v", this.SignatureSharesOutGoingTxData)+",\n")
+	s = append(s, "AggregatedSignaturesOutGoingTxData: "+fmt.Sprintf("%#v", this.AggregatedSignaturesOutGoingTxData)+",\n")
+	s = append(s, "LeaderSignaturesOutGoingTxData: "+fmt.Sprintf("%#v", this.LeaderSignaturesOutGoingTxData)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -371,6 +424,39 @@ func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LeaderSignaturesOutGoingTxData) > 0 {
+		for iNdEx := len(m.LeaderSignaturesOutGoingTxData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaderSignaturesOutGoingTxData[iNdEx])
+			copy(dAtA[i:], m.LeaderSignaturesOutGoingTxData[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.LeaderSignaturesOutGoingTxData[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.AggregatedSignaturesOutGoingTxData) > 0 {
+		for iNdEx := len(m.AggregatedSignaturesOutGoingTxData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AggregatedSignaturesOutGoingTxData[iNdEx])
+			copy(dAtA[i:], m.AggregatedSignaturesOutGoingTxData[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.AggregatedSignaturesOutGoingTxData[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.SignatureSharesOutGoingTxData) > 0 {
+		for iNdEx := len(m.SignatureSharesOutGoingTxData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SignatureSharesOutGoingTxData[iNdEx])
+			copy(dAtA[i:], m.SignatureSharesOutGoingTxData[iNdEx])
+			i = encodeVarintMessage(dAtA, i, uint64(len(m.SignatureSharesOutGoingTxData[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if len(m.LeaderSignatureOutGoingTxData) > 0 {
 		i -= len(m.LeaderSignatureOutGoingTxData)
 		copy(dAtA[i:], m.LeaderSignatureOutGoingTxData)
@@ -589,6 +675,24 @@ func (m *Message) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovMessage(uint64(l))
 	}
+	if len(m.SignatureSharesOutGoingTxData) > 0 {
+		for _, b := range m.SignatureSharesOutGoingTxData {
+			l = len(b)
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.AggregatedSignaturesOutGoingTxData) > 0 {
+		for _, b := range m.AggregatedSignaturesOutGoingTxData {
+			l = len(b)
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
+	if len(m.LeaderSignaturesOutGoingTxData) > 0 {
+		for _, b := range m.LeaderSignaturesOutGoingTxData {
+			l = len(b)
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -621,6 +725,9 @@ func (this *Message) String() string {
 		`SignatureShareOutGoingTxData:` + fmt.Sprintf("%v", this.SignatureShareOutGoingTxData) + `,`,
 		`AggregatedSignatureOutGoingTxData:` + fmt.Sprintf("%v", this.AggregatedSignatureOutGoingTxData) + `,`,
 		`LeaderSignatureOutGoingTxData:` + fmt.Sprintf("%v", this.LeaderSignatureOutGoingTxData) + `,`,
+		`SignatureSharesOutGoingTxData:` + fmt.Sprintf("%v", this.SignatureSharesOutGoingTxData) + `,`,
+		`AggregatedSignaturesOutGoingTxData:` + fmt.Sprintf("%v", this.AggregatedSignaturesOutGoingTxData) + `,`,
+		`LeaderSignaturesOutGoingTxData:` + fmt.Sprintf("%v", this.LeaderSignaturesOutGoingTxData) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1244,6 +1351,102 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				m.LeaderSignatureOutGoingTxData = []byte{}
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureSharesOutGoingTxData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureSharesOutGoingTxData = append(m.SignatureSharesOutGoingTxData, make([]byte, postIndex-iNdEx))
+			copy(m.SignatureSharesOutGoingTxData[len(m.SignatureSharesOutGoingTxData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignaturesOutGoingTxData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignaturesOutGoingTxData = append(m.AggregatedSignaturesOutGoingTxData, make([]byte, postIndex-iNdEx))
+			copy(m.AggregatedSignaturesOutGoingTxData[len(m.AggregatedSignaturesOutGoingTxData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderSignaturesOutGoingTxData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaderSignaturesOutGoingTxData = append(m.LeaderSignaturesOutGoingTxData, make([]byte, postIndex-iNdEx))
+			copy(m.LeaderSignaturesOutGoingTxData[len(m.LeaderSignaturesOutGoingTxData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
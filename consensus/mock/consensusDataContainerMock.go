@@ -38,6 +38,7 @@ type ConsensusCoreMock struct {
 	messageSigningHandler   consensus.P2PSigningHandler
 	peerBlacklistHandler    consensus.PeerBlacklistHandler
 	signingHandler          consensus.SigningHandler
+	signingJournal          consensus.SigningJournal
 }
 
 // GetAntiFloodHandler -
@@ -240,6 +241,16 @@ func (ccm *ConsensusCoreMock) SetSigningHandler(signingHandler consensus.Signing
 	ccm.signingHandler = signingHandler
 }
 
+// SigningJournal -
+func (ccm *ConsensusCoreMock) SigningJournal() consensus.SigningJournal {
+	return ccm.signingJournal
+}
+
+// SetSigningJournal -
+func (ccm *ConsensusCoreMock) SetSigningJournal(signingJournal consensus.SigningJournal) {
+	ccm.signingJournal = signingJournal
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
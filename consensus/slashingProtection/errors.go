@@ -0,0 +1,27 @@
+package slashingProtection
+
+import "errors"
+
+// ErrEmptyJournalFilePath signals that an empty journal file path was provided
+var ErrEmptyJournalFilePath = errors.New("empty signing journal file path")
+
+// ErrEmptyPublicKey signals that an empty public key was provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrEmptyDataHash signals that an empty signed data hash was provided
+var ErrEmptyDataHash = errors.New("empty data hash")
+
+// ErrEmptyIdentifier signals that an empty extra signed data identifier was provided
+var ErrEmptyIdentifier = errors.New("empty extra signed data identifier")
+
+// ErrConflictingSigningData signals that the key already signed different data in the same round
+var ErrConflictingSigningData = errors.New("conflicting signing data")
+
+// ErrSigningOlderRound signals that the key already signed data in a later round
+var ErrSigningOlderRound = errors.New("signing data from an older round")
+
+// ErrSigningOlderEpoch signals that the key already signed data in a later epoch
+var ErrSigningOlderEpoch = errors.New("signing data from an older epoch")
+
+// ErrUnsupportedJournalVersion signals that the journal file was written in an unsupported format version
+var ErrUnsupportedJournalVersion = errors.New("unsupported signing journal version")
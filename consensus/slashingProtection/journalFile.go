@@ -0,0 +1,223 @@
+package slashingProtection
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const journalVersion = 1
+
+// maxJournalEntryLength bounds the length of a journal line, which holds a single signed data entry
+const maxJournalEntryLength = 1 << 20
+
+// recordKey identifies the data signed by a consensus key. The consensus headers are recorded with an empty
+// identifier, while the extra data signed along with them (e.g. outgoing operations) is recorded under the identifier
+// of its extra signer
+type recordKey struct {
+	identifier string
+	pubKey     string
+}
+
+// signedData holds the last data signed by a consensus key
+type signedData struct {
+	round    int64
+	epoch    uint32
+	dataHash []byte
+}
+
+// JournalEntry is the serialized form of the last data signed by a consensus key. Both the public key and the data
+// hash are hex encoded, so the same file can be moved between the main and the backup machines
+type JournalEntry struct {
+	Version    int    `json:"version"`
+	Identifier string `json:"identifier,omitempty"`
+	PublicKey  string `json:"publicKey"`
+	Round      int64  `json:"round"`
+	Epoch      uint32 `json:"epoch"`
+	DataHash   string `json:"dataHash"`
+}
+
+func newJournalEntry(key recordKey, record *signedData) *JournalEntry {
+	return &JournalEntry{
+		Version:    journalVersion,
+		Identifier: key.identifier,
+		PublicKey:  hex.EncodeToString([]byte(key.pubKey)),
+		Round:      record.round,
+		Epoch:      record.epoch,
+		DataHash:   hex.EncodeToString(record.dataHash),
+	}
+}
+
+func (entry *JournalEntry) toRecord() (recordKey, *signedData, error) {
+	if entry.Version != journalVersion {
+		return recordKey{}, nil, fmt.Errorf("%w: %d", ErrUnsupportedJournalVersion, entry.Version)
+	}
+
+	pubKey, err := hex.DecodeString(entry.PublicKey)
+	if err != nil {
+		return recordKey{}, nil, fmt.Errorf("%w for public key %s", err, entry.PublicKey)
+	}
+	dataHash, err := hex.DecodeString(entry.DataHash)
+	if err != nil {
+		return recordKey{}, nil, fmt.Errorf("%w for data hash of public key %s", err, entry.PublicKey)
+	}
+
+	key := recordKey{
+		identifier: entry.Identifier,
+		pubKey:     string(pubKey),
+	}
+	record := &signedData{
+		round:    entry.Round,
+		epoch:    entry.Epoch,
+		dataHash: dataHash,
+	}
+
+	return key, record, nil
+}
+
+func marshalJournalEntry(key recordKey, record *signedData) ([]byte, error) {
+	buff, err := json.Marshal(newJournalEntry(key, record))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buff, '\n'), nil
+}
+
+// readJournalFile replays the entries of the journal, one per line, the later entries of a key replacing the earlier
+// ones. A malformed last line is the trace of an append interrupted by a crash, before its data was signed, so it is
+// ignored. A malformed line followed by other entries means the journal is corrupted
+func readJournalFile(filePath string) (map[recordKey]*signedData, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	records := make(map[recordKey]*signedData)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxJournalEntryLength)
+
+	lineIndex := 0
+	var lastLineErr error
+	for scanner.Scan() {
+		lineIndex++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		if lastLineErr != nil {
+			return nil, lastLineErr
+		}
+
+		entry := &JournalEntry{}
+		err = json.Unmarshal(line, entry)
+		if err != nil {
+			lastLineErr = fmt.Errorf("%w while reading line %d of signing journal %s", err, lineIndex, filePath)
+			continue
+		}
+
+		key, record, errConvert := entry.toRecord()
+		if errConvert != nil {
+			return nil, fmt.Errorf("%w while reading line %d of signing journal %s", errConvert, lineIndex, filePath)
+		}
+
+		records[key] = record
+	}
+	err = scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading signing journal %s", err, filePath)
+	}
+
+	if lastLineErr != nil {
+		log.Warn("signing journal ends with an incomplete entry, ignoring it", "error", lastLineErr)
+	}
+
+	return records, nil
+}
+
+// appendJournalEntry appends the entry of a single key at the end of the journal. If required, the entry is synced
+// to disk before returning
+func appendJournalEntry(filePath string, key recordKey, record *signedData, shouldSync bool) error {
+	buff, err := marshalJournalEntry(key, record)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(buff)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	if shouldSync {
+		err = file.Sync()
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	return file.Close()
+}
+
+// writeJournalFile writes a single entry for each key in a temporary file which replaces the destination only after
+// it was synced to disk, so a crash in the middle of the write can not leave a truncated journal behind
+func writeJournalFile(filePath string, records map[recordKey]*signedData) error {
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tmpFilePath := filePath + ".tmp"
+	file, err := os.OpenFile(tmpFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	for key, record := range records {
+		buff, errMarshal := marshalJournalEntry(key, record)
+		if errMarshal != nil {
+			_ = file.Close()
+			return errMarshal
+		}
+
+		_, err = writer.Write(buff)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Sync()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilePath, filePath)
+}
@@ -0,0 +1,260 @@
+package slashingProtection
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("consensus/slashingProtection")
+
+// maxJournalEntriesPerKey is the average number of entries per key after which the journal is compacted to a single
+// entry per key
+const maxJournalEntriesPerKey = 100
+
+// ArgsSigningJournal holds the arguments needed to create a signing journal
+type ArgsSigningJournal struct {
+	FilePath       string
+	ExportFilePath string
+}
+
+type signingJournal struct {
+	filePath       string
+	exportFilePath string
+
+	mut        sync.Mutex
+	records    map[recordKey]*signedData
+	numEntries int
+}
+
+// NewSigningJournal creates a durable journal of the last data signed by each consensus key. The journal is loaded
+// from the provided file, if it exists, and every newly signed round is appended to it before the signature is created
+func NewSigningJournal(args ArgsSigningJournal) (*signingJournal, error) {
+	if len(args.FilePath) == 0 {
+		return nil, ErrEmptyJournalFilePath
+	}
+
+	records, err := readJournalFile(args.FilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		log.Debug("signing journal not found, starting with an empty one", "file", args.FilePath)
+		return &signingJournal{
+			filePath:       args.FilePath,
+			exportFilePath: args.ExportFilePath,
+			records:        make(map[recordKey]*signedData),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("loaded signing journal", "file", args.FilePath, "num records", len(records))
+
+	sj := &signingJournal{
+		filePath:       args.FilePath,
+		exportFilePath: args.ExportFilePath,
+		records:        records,
+	}
+
+	// the loaded journal is compacted, which also drops an incomplete last entry before anything is appended after it
+	err = sj.persist()
+	if err != nil {
+		return nil, err
+	}
+
+	return sj, nil
+}
+
+// CheckAndRecord returns an error if signing the provided header hash with the provided key could conflict with
+// something already signed by the key: a different header in the same round, or anything from an older round or epoch.
+// Otherwise, the signed data is recorded and persisted before returning, so the caller can safely create the signature
+func (sj *signingJournal) CheckAndRecord(pubKey []byte, round int64, epoch uint32, headerHash []byte) error {
+	return sj.checkAndRecord("", pubKey, round, epoch, headerHash)
+}
+
+// CheckAndRecordExtraData does the same checks as CheckAndRecord for the extra data signed along with the headers,
+// e.g. the outgoing operations. The extra data of each identifier is checked independently of the headers
+func (sj *signingJournal) CheckAndRecordExtraData(identifier string, pubKey []byte, round int64, epoch uint32, dataHash []byte) error {
+	if len(identifier) == 0 {
+		return ErrEmptyIdentifier
+	}
+
+	return sj.checkAndRecord(identifier, pubKey, round, epoch, dataHash)
+}
+
+func (sj *signingJournal) checkAndRecord(identifier string, pubKey []byte, round int64, epoch uint32, dataHash []byte) error {
+	if len(pubKey) == 0 {
+		return ErrEmptyPublicKey
+	}
+	if len(dataHash) == 0 {
+		return ErrEmptyDataHash
+	}
+
+	sj.mut.Lock()
+	defer sj.mut.Unlock()
+
+	key := recordKey{
+		identifier: identifier,
+		pubKey:     string(pubKey),
+	}
+	lastSigned, found := sj.records[key]
+	if found {
+		err := checkSigningData(lastSigned, round, epoch, dataHash)
+		if err != nil {
+			log.Warn("signingJournal.CheckAndRecord: refused to sign",
+				"identifier", identifier,
+				"pk", pubKey,
+				"round", round,
+				"epoch", epoch,
+				"data hash", dataHash,
+				"last signed round", lastSigned.round,
+				"last signed epoch", lastSigned.epoch,
+				"last signed data hash", lastSigned.dataHash,
+				"error", err,
+			)
+			return err
+		}
+		if lastSigned.round == round {
+			return nil
+		}
+	}
+
+	record := &signedData{
+		round:    round,
+		epoch:    epoch,
+		dataHash: append([]byte(nil), dataHash...),
+	}
+
+	// the key must not sign something which could not be recorded
+	err := appendJournalEntry(sj.filePath, key, record, true)
+	if err != nil {
+		return fmt.Errorf("%w while persisting the signing journal", err)
+	}
+
+	sj.records[key] = record
+	sj.numEntries++
+	sj.exportEntry(key, record)
+	sj.compactIfNeeded()
+
+	return nil
+}
+
+// exportEntry mirrors the newly recorded entry in the export file, if configured. This is a best effort for the
+// backup machines, so it is not synced to disk and its errors are only logged
+func (sj *signingJournal) exportEntry(key recordKey, record *signedData) {
+	if len(sj.exportFilePath) == 0 {
+		return
+	}
+
+	err := appendJournalEntry(sj.exportFilePath, key, record, false)
+	if err != nil {
+		log.Warn("signingJournal: could not export the signing journal", "file", sj.exportFilePath, "error", err)
+	}
+}
+
+func (sj *signingJournal) compactIfNeeded() {
+	if sj.numEntries <= maxJournalEntriesPerKey*len(sj.records) {
+		return
+	}
+
+	// the appended entry is already durable, so failing to compact only postpones it
+	err := sj.persist()
+	if err != nil {
+		log.Warn("signingJournal: could not compact the signing journal", "error", err)
+	}
+}
+
+func checkSigningData(lastSigned *signedData, round int64, epoch uint32, dataHash []byte) error {
+	if epoch < lastSigned.epoch {
+		return fmt.Errorf("%w: epoch %d, last signed epoch %d", ErrSigningOlderEpoch, epoch, lastSigned.epoch)
+	}
+	if round < lastSigned.round {
+		return fmt.Errorf("%w: round %d, last signed round %d", ErrSigningOlderRound, round, lastSigned.round)
+	}
+	if round == lastSigned.round && !bytes.Equal(dataHash, lastSigned.dataHash) {
+		return fmt.Errorf("%w in round %d", ErrConflictingSigningData, round)
+	}
+
+	return nil
+}
+
+// persist rewrites the journal file with a single entry per key and, if configured, mirrors it to the export file.
+// Only failing to write the journal file itself is an error, the export being a best effort for the backup machines
+func (sj *signingJournal) persist() error {
+	err := writeJournalFile(sj.filePath, sj.records)
+	if err != nil {
+		return fmt.Errorf("%w while persisting the signing journal", err)
+	}
+	sj.numEntries = len(sj.records)
+
+	if len(sj.exportFilePath) == 0 {
+		return nil
+	}
+
+	err = writeJournalFile(sj.exportFilePath, sj.records)
+	if err != nil {
+		log.Warn("signingJournal: could not export the signing journal", "file", sj.exportFilePath, "error", err)
+	}
+
+	return nil
+}
+
+// Import merges the journal exported by another machine into the current one. For each key, the most advanced of the
+// two records is kept. If both machines signed different headers in the same round, the local record is kept
+func (sj *signingJournal) Import(filePath string) error {
+	importedRecords, err := readJournalFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	sj.mut.Lock()
+	defer sj.mut.Unlock()
+
+	numImported := 0
+	for key, imported := range importedRecords {
+		local, found := sj.records[key]
+		if !found || isMoreAdvanced(imported, local) {
+			sj.records[key] = imported
+			numImported++
+			continue
+		}
+
+		if imported.round == local.round && !bytes.Equal(imported.dataHash, local.dataHash) {
+			log.Warn("signingJournal.Import: key signed different data in the same round",
+				"identifier", key.identifier,
+				"pk", []byte(key.pubKey),
+				"round", local.round,
+				"local data hash", local.dataHash,
+				"imported data hash", imported.dataHash,
+			)
+		}
+	}
+
+	log.Info("imported signing journal", "file", filePath, "num records", len(importedRecords), "num updated", numImported)
+
+	return sj.persist()
+}
+
+func isMoreAdvanced(record *signedData, other *signedData) bool {
+	if record.epoch != other.epoch {
+		return record.epoch > other.epoch
+	}
+
+	return record.round > other.round
+}
+
+// Export writes the journal in the provided file, in the format accepted by Import
+func (sj *signingJournal) Export(filePath string) error {
+	sj.mut.Lock()
+	defer sj.mut.Unlock()
+
+	return writeJournalFile(filePath, sj.records)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sj *signingJournal) IsInterfaceNil() bool {
+	return sj == nil
+}
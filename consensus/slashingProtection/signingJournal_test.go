@@ -0,0 +1,290 @@
+package slashingProtection
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pubKey1 = []byte("pubKey1")
+	pubKey2 = []byte("pubKey2")
+	hash1   = []byte("hash1")
+	hash2   = []byte("hash2")
+)
+
+func createArgsSigningJournal(t *testing.T) ArgsSigningJournal {
+	return ArgsSigningJournal{
+		FilePath: filepath.Join(t.TempDir(), "slashingProtection", "journal.log"),
+	}
+}
+
+func TestNewSigningJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		sj, err := NewSigningJournal(ArgsSigningJournal{})
+		require.Equal(t, ErrEmptyJournalFilePath, err)
+		require.Nil(t, sj)
+	})
+	t.Run("corrupted journal file, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		require.Nil(t, os.MkdirAll(filepath.Dir(args.FilePath), os.ModePerm))
+		require.Nil(t, os.WriteFile(args.FilePath, []byte("not a\njournal\n"), 0600))
+
+		sj, err := NewSigningJournal(args)
+		require.NotNil(t, err)
+		require.Nil(t, sj)
+	})
+	t.Run("unsupported version, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		require.Nil(t, os.MkdirAll(filepath.Dir(args.FilePath), os.ModePerm))
+		buff, _ := json.Marshal(&JournalEntry{Version: journalVersion + 1})
+		require.Nil(t, os.WriteFile(args.FilePath, buff, 0600))
+
+		sj, err := NewSigningJournal(args)
+		require.ErrorIs(t, err, ErrUnsupportedJournalVersion)
+		require.Nil(t, sj)
+	})
+	t.Run("corrupted entry followed by other entries, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		sj, _ := NewSigningJournal(args)
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		appendToFile(t, args.FilePath, "{\"version\":1,\"publicKey\"\n")
+		require.Nil(t, sj.CheckAndRecord(pubKey2, 10, 1, hash1))
+
+		sj, err := NewSigningJournal(args)
+		require.NotNil(t, err)
+		require.Nil(t, sj)
+	})
+	t.Run("incomplete last entry, should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		sj, _ := NewSigningJournal(args)
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		appendToFile(t, args.FilePath, "{\"version\":1,\"publicKey\"")
+
+		sj, err := NewSigningJournal(args)
+		require.Nil(t, err)
+		require.Len(t, sj.records, 1)
+
+		// the compacted journal accepts new entries after the dropped one
+		require.Nil(t, sj.CheckAndRecord(pubKey2, 10, 1, hash1))
+		sj, err = NewSigningJournal(args)
+		require.Nil(t, err)
+		require.Len(t, sj.records, 2)
+	})
+	t.Run("missing journal file, should start empty", func(t *testing.T) {
+		t.Parallel()
+
+		sj, err := NewSigningJournal(createArgsSigningJournal(t))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(sj))
+		require.Empty(t, sj.records)
+	})
+}
+
+func TestSigningJournal_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty public key or header hash, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		sj, _ := NewSigningJournal(createArgsSigningJournal(t))
+
+		err := sj.CheckAndRecord(nil, 1, 0, hash1)
+		require.Equal(t, ErrEmptyPublicKey, err)
+
+		err = sj.CheckAndRecord(pubKey1, 1, 0, nil)
+		require.Equal(t, ErrEmptyDataHash, err)
+	})
+	t.Run("same data in the same round, should allow signing again", func(t *testing.T) {
+		t.Parallel()
+
+		sj, _ := NewSigningJournal(createArgsSigningJournal(t))
+
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+	})
+	t.Run("conflicting data in the same round, should refuse", func(t *testing.T) {
+		t.Parallel()
+
+		sj, _ := NewSigningJournal(createArgsSigningJournal(t))
+
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		err := sj.CheckAndRecord(pubKey1, 10, 1, hash2)
+		require.ErrorIs(t, err, ErrConflictingSigningData)
+
+		// other keys are not affected
+		require.Nil(t, sj.CheckAndRecord(pubKey2, 10, 1, hash2))
+	})
+	t.Run("older round or epoch, should refuse", func(t *testing.T) {
+		t.Parallel()
+
+		sj, _ := NewSigningJournal(createArgsSigningJournal(t))
+
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+
+		err := sj.CheckAndRecord(pubKey1, 9, 1, hash2)
+		require.ErrorIs(t, err, ErrSigningOlderRound)
+
+		err = sj.CheckAndRecord(pubKey1, 11, 0, hash2)
+		require.ErrorIs(t, err, ErrSigningOlderEpoch)
+
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 11, 1, hash2))
+	})
+	t.Run("should survive a restart", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		sj, _ := NewSigningJournal(args)
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+
+		restartedJournal, err := NewSigningJournal(args)
+		require.Nil(t, err)
+
+		err = restartedJournal.CheckAndRecord(pubKey1, 10, 1, hash2)
+		require.ErrorIs(t, err, ErrConflictingSigningData)
+		require.Nil(t, restartedJournal.CheckAndRecord(pubKey1, 10, 1, hash1))
+	})
+	t.Run("persist error, should refuse and not record", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		sj, _ := NewSigningJournal(args)
+		// a directory in place of the journal file makes the append fail
+		require.Nil(t, os.MkdirAll(filepath.Join(args.FilePath, "dir"), os.ModePerm))
+
+		err := sj.CheckAndRecord(pubKey1, 10, 1, hash1)
+		require.NotNil(t, err)
+		require.Empty(t, sj.records)
+	})
+	t.Run("should mirror the journal in the export file", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		args.ExportFilePath = filepath.Join(t.TempDir(), "export.log")
+		sj, _ := NewSigningJournal(args)
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 11, 1, hash2))
+
+		exportedRecords, err := readJournalFile(args.ExportFilePath)
+		require.Nil(t, err)
+		require.Equal(t, sj.records, exportedRecords)
+	})
+	t.Run("should append a single entry for each newly signed round", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		sj, _ := NewSigningJournal(args)
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		require.Nil(t, sj.CheckAndRecord(pubKey2, 10, 1, hash1))
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 11, 1, hash2))
+
+		require.Equal(t, 3, countLines(t, args.FilePath))
+	})
+	t.Run("should compact the journal after too many entries", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		sj, _ := NewSigningJournal(args)
+		for round := int64(1); round <= maxJournalEntriesPerKey; round++ {
+			require.Nil(t, sj.CheckAndRecord(pubKey1, round, 1, hash1))
+		}
+		require.Equal(t, maxJournalEntriesPerKey, countLines(t, args.FilePath))
+
+		require.Nil(t, sj.CheckAndRecord(pubKey1, maxJournalEntriesPerKey+1, 1, hash1))
+		require.Equal(t, 1, countLines(t, args.FilePath))
+
+		restartedJournal, err := NewSigningJournal(args)
+		require.Nil(t, err)
+		err = restartedJournal.CheckAndRecord(pubKey1, maxJournalEntriesPerKey, 1, hash1)
+		require.ErrorIs(t, err, ErrSigningOlderRound)
+	})
+}
+
+func TestSigningJournal_CheckAndRecordExtraData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty identifier, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		sj, _ := NewSigningJournal(createArgsSigningJournal(t))
+
+		err := sj.CheckAndRecordExtraData("", pubKey1, 1, 0, hash1)
+		require.Equal(t, ErrEmptyIdentifier, err)
+	})
+	t.Run("extra data should be checked independently of the headers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsSigningJournal(t)
+		sj, _ := NewSigningJournal(args)
+
+		require.Nil(t, sj.CheckAndRecord(pubKey1, 10, 1, hash1))
+		require.Nil(t, sj.CheckAndRecordExtraData("outGoing", pubKey1, 10, 1, hash2))
+		require.Nil(t, sj.CheckAndRecordExtraData("other", pubKey1, 10, 1, hash1))
+
+		err := sj.CheckAndRecordExtraData("outGoing", pubKey1, 10, 1, hash1)
+		require.ErrorIs(t, err, ErrConflictingSigningData)
+
+		restartedJournal, err := NewSigningJournal(args)
+		require.Nil(t, err)
+		err = restartedJournal.CheckAndRecordExtraData("outGoing", pubKey1, 10, 1, hash1)
+		require.ErrorIs(t, err, ErrConflictingSigningData)
+		require.Nil(t, restartedJournal.CheckAndRecord(pubKey1, 10, 1, hash1))
+	})
+}
+
+func TestSigningJournal_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	mainJournal, _ := NewSigningJournal(createArgsSigningJournal(t))
+	require.Nil(t, mainJournal.CheckAndRecord(pubKey1, 10, 1, hash1))
+	require.Nil(t, mainJournal.CheckAndRecord(pubKey2, 5, 1, hash1))
+
+	backupJournal, _ := NewSigningJournal(createArgsSigningJournal(t))
+	require.Nil(t, backupJournal.CheckAndRecord(pubKey2, 7, 1, hash2))
+
+	exportFilePath := filepath.Join(t.TempDir(), "export.log")
+	require.Nil(t, mainJournal.Export(exportFilePath))
+	require.Nil(t, backupJournal.Import(exportFilePath))
+
+	// the imported key can not sign anything else in the round signed on the main machine
+	err := backupJournal.CheckAndRecord(pubKey1, 10, 1, hash2)
+	require.ErrorIs(t, err, ErrConflictingSigningData)
+
+	// the local record of the second key is more advanced, so it is kept
+	err = backupJournal.CheckAndRecord(pubKey2, 7, 1, hash1)
+	require.ErrorIs(t, err, ErrConflictingSigningData)
+	require.Nil(t, backupJournal.CheckAndRecord(pubKey2, 7, 1, hash2))
+}
+
+func appendToFile(t *testing.T, filePath string, content string) {
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_APPEND, 0600)
+	require.Nil(t, err)
+	_, err = file.WriteString(content)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+}
+
+func countLines(t *testing.T, filePath string) int {
+	buff, err := os.ReadFile(filePath)
+	require.Nil(t, err)
+
+	return bytes.Count(buff, []byte("\n"))
+}
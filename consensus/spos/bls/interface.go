@@ -62,12 +62,17 @@ type BridgeOperationsHandler interface {
 	IsInterfaceNil() bool
 }
 
+// BridgePauseHandler provides the bridge pause flags set through governance
+type BridgePauseHandler interface {
+	IsOutGoingPaused() bool
+	IsInterfaceNil() bool
+}
+
 // OutGoingOperationsPool defines the behavior of a timed cache for outgoing operations
 type OutGoingOperationsPool interface {
-	Add(data *sovereign.BridgeOutGoingData)
 	Get(hash []byte) *sovereign.BridgeOutGoingData
-	Delete(hash []byte)
+	SetSignatures(hash []byte, leaderSignature []byte, aggregatedSignature []byte) error
 	GetUnconfirmedOperations() []*sovereign.BridgeOutGoingData
-	ResetTimer(hashes [][]byte)
+	GetDeadLetteredOperations() []*sovereign.BridgeOutGoingData
 	IsInterfaceNil() bool
 }
@@ -5,9 +5,11 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
@@ -18,6 +20,7 @@ type sovereignSubRoundEnd struct {
 	*subroundEndRoundV2
 	outGoingOperationsPool OutGoingOperationsPool
 	bridgeOpHandler        BridgeOperationsHandler
+	bridgePauseHandler     BridgePauseHandler
 }
 
 // NewSovereignSubRoundEndRound creates a new sovereign end subround
@@ -25,6 +28,7 @@ func NewSovereignSubRoundEndRound(
 	subRoundEnd *subroundEndRoundV2,
 	outGoingOperationsPool OutGoingOperationsPool,
 	bridgeOpHandler BridgeOperationsHandler,
+	bridgePauseHandler BridgePauseHandler,
 ) (*sovereignSubRoundEnd, error) {
 	if check.IfNil(subRoundEnd) {
 		return nil, spos.ErrNilSubround
@@ -35,11 +39,15 @@ func NewSovereignSubRoundEndRound(
 	if check.IfNil(bridgeOpHandler) {
 		return nil, errors.ErrNilBridgeOpHandler
 	}
+	if check.IfNil(bridgePauseHandler) {
+		return nil, errors.ErrNilBridgePauseHandler
+	}
 
 	sr := &sovereignSubRoundEnd{
 		subroundEndRoundV2:     subRoundEnd,
 		outGoingOperationsPool: outGoingOperationsPool,
 		bridgeOpHandler:        bridgeOpHandler,
+		bridgePauseHandler:     bridgePauseHandler,
 	}
 
 	sr.Job = sr.doSovereignEndRoundJob
@@ -52,8 +60,6 @@ func (sr *sovereignSubRoundEnd) receivedBlockHeaderFinalInfo(ctx context.Context
 		return false
 	}
 
-	// TODO: MX-15502 once we have ZKProofs included in blocks for leaders which have resent the unconfirmed
-	// outgoing operation we should also call resetOutGoingOpTimer here for consensus participants
 	return sr.updateOutGoingPoolIfNeeded(cnsDta) == nil
 }
 
@@ -69,24 +75,20 @@ func (sr *sovereignSubRoundEnd) updateOutGoingPoolIfNeeded(cnsDta *consensus.Mes
 		return nil
 	}
 
-	err := outGoingMBHeader.SetAggregatedSignatureOutGoingOperations(cnsDta.AggregatedSignatureOutGoingTxData)
-	if err != nil {
-		log.Error("sovereignSubRoundEnd.updateOutGoingPoolIfNeeded.SetAggregatedSignatureOutGoingOperations", "error", err)
-		return err
-	}
-
-	err = outGoingMBHeader.SetLeaderSignatureOutGoingOperations(cnsDta.LeaderSignatureOutGoingTxData)
+	err := setOutGoingTxDataSignaturesInHeader(sr.Header, cnsDta)
 	if err != nil {
-		log.Error("sovereignSubRoundEnd.updateOutGoingPoolIfNeeded.SetLeaderSignatureOutGoingOperations", "error", err)
+		log.Error("sovereignSubRoundEnd.updateOutGoingPoolIfNeeded.setOutGoingTxDataSignaturesInHeader", "error", err)
 		return err
 	}
 
 	log.Debug("step 3.1: block header final info has been received with outgoing mb",
 		"LeaderSignatureOutGoingTxData", cnsDta.LeaderSignatureOutGoingTxData,
 		"AggregatedSignatureOutGoingTxData", cnsDta.AggregatedSignatureOutGoingTxData,
+		"LeaderSignaturesOutGoingTxData", cnsDta.LeaderSignaturesOutGoingTxData,
+		"AggregatedSignaturesOutGoingTxData", cnsDta.AggregatedSignaturesOutGoingTxData,
 	)
 
-	_, err = sr.updateBridgeDataWithSignatures(outGoingMBHeader)
+	_, err = sr.updateBridgeDataWithSignatures(sr.Header)
 	if err != nil {
 		log.Error("sovereignSubRoundEnd.updateOutGoingPoolIfNeeded.updateBridgeDataWithSignatures", "error", err)
 		return err
@@ -113,13 +115,13 @@ func (sr *sovereignSubRoundEnd) doSovereignEndRoundJob(ctx context.Context) bool
 		return true
 	}
 
-	currBridgeData, err := sr.updateBridgeDataWithSignatures(outGoingMBHeader)
+	currBridgeData, err := sr.updateBridgeDataWithSignatures(sr.Header)
 	if err != nil {
 		log.Error("sovereignSubRoundEnd.doSovereignEndRoundJob.updateBridgeDataWithSignatures", "error", err)
 		return false
 	}
 
-	if !sr.isSelfLeader() {
+	if !sr.isSelfLeader() || sr.isOutGoingBridgePaused() {
 		return true
 	}
 
@@ -130,75 +132,112 @@ func (sr *sovereignSubRoundEnd) doSovereignEndRoundJob(ctx context.Context) bool
 }
 
 func (sr *sovereignSubRoundEnd) sendUnconfirmedOperationsIfFound(ctx context.Context) {
-	if !sr.isSelfLeader() {
+	if !sr.isSelfLeader() || sr.isOutGoingBridgePaused() {
 		return
 	}
 
-	unconfirmedOperations := sr.outGoingOperationsPool.GetUnconfirmedOperations()
+	unconfirmedOperations := sr.getUnconfirmedOperations()
 	if len(unconfirmedOperations) == 0 {
 		return
 	}
 
-	log.Debug("found unconfirmed operations", "num unconfirmed operations", len(unconfirmedOperations))
 	go sr.sendOutGoingOperations(ctx, unconfirmedOperations)
 }
 
-func (sr *sovereignSubRoundEnd) updateBridgeDataWithSignatures(
-	outGoingMBHeader data.OutGoingMiniBlockHeaderHandler,
-) (*sovereign.BridgeOutGoingData, error) {
-	hash := outGoingMBHeader.GetOutGoingOperationsHash()
-	currBridgeData := sr.outGoingOperationsPool.Get(hash)
-	if currBridgeData == nil {
-		return nil, fmt.Errorf("%w in sovereignSubRoundEnd.updateBridgeDataWithSignatures for hash: %s",
-			errors.ErrOutGoingOperationsNotFound, hex.EncodeToString(hash))
+// getUnconfirmedOperations returns the unconfirmed operations to be resent, as found by the block processor when the
+// current block was committed, and updates the retry metrics
+func (sr *sovereignSubRoundEnd) getUnconfirmedOperations() []*sovereign.BridgeOutGoingData {
+	unconfirmedOperations := sr.outGoingOperationsPool.GetUnconfirmedOperations()
+	numDeadLetteredOperations := len(sr.outGoingOperationsPool.GetDeadLetteredOperations())
+	sr.AppStatusHandler().SetUInt64Value(common.MetricNumDeadLetteredOutGoingOperations, uint64(numDeadLetteredOperations))
+	if len(unconfirmedOperations) == 0 {
+		return nil
+	}
+
+	log.Debug("found unconfirmed operations",
+		"num unconfirmed operations", len(unconfirmedOperations),
+		"num dead-lettered operations", numDeadLetteredOperations,
+	)
+	sr.AppStatusHandler().AddUint64(common.MetricNumOutGoingOperationsRetries, uint64(len(unconfirmedOperations)))
+
+	return unconfirmedOperations
+}
+
+// updateBridgeDataWithSignatures sets the signatures from the header for each outgoing operations batch from the pool
+// and returns all the batches of the current block
+func (sr *sovereignSubRoundEnd) updateBridgeDataWithSignatures(header data.HeaderHandler) ([]*sovereign.BridgeOutGoingData, error) {
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
+	if err != nil {
+		return nil, err
 	}
 
-	currBridgeData.LeaderSignature = outGoingMBHeader.GetLeaderSignatureOutGoingOperations()
-	currBridgeData.AggregatedSignature = outGoingMBHeader.GetAggregatedSignatureOutGoingOperations()
+	numBatches := len(batches)
+	currBridgeData := make([]*sovereign.BridgeOutGoingData, 0, numBatches)
+	for _, batch := range batches {
+		err = sr.outGoingOperationsPool.SetSignatures(batch.Hash, batch.LeaderSignature, batch.AggregatedSignature)
+		if err != nil {
+			return nil, fmt.Errorf("%w in sovereignSubRoundEnd.updateBridgeDataWithSignatures for hash: %s",
+				errors.ErrOutGoingOperationsNotFound, hex.EncodeToString(batch.Hash))
+		}
+
+		batchBridgeData := sr.outGoingOperationsPool.Get(batch.Hash)
+		currBridgeData = append(currBridgeData, batchBridgeData)
+	}
 
-	sr.outGoingOperationsPool.Delete(hash)
-	sr.outGoingOperationsPool.Add(currBridgeData)
 	return currBridgeData, nil
 }
 
+// isOutGoingBridgePaused returns true if the outgoing bridge was paused through governance. While paused, the signed
+// outgoing operations are kept in the pool, without counting any send attempt, and are resent once the bridge is resumed
+// and their time to wait passes.
+func (sr *sovereignSubRoundEnd) isOutGoingBridgePaused() bool {
+	if !sr.bridgePauseHandler.IsOutGoingPaused() {
+		return false
+	}
+
+	log.Debug("sovereignSubRoundEnd: outgoing bridge is paused, outgoing operations will not be sent")
+	return true
+}
+
 func (sr *sovereignSubRoundEnd) isSelfLeader() bool {
 	return sr.IsSelfLeaderInCurrentRound() || sr.IsMultiKeyLeaderInCurrentRound()
 }
 
-func (sr *sovereignSubRoundEnd) getAllOutGoingOperations(currentOperations *sovereign.BridgeOutGoingData) []*sovereign.BridgeOutGoingData {
+func (sr *sovereignSubRoundEnd) getAllOutGoingOperations(currentOperations []*sovereign.BridgeOutGoingData) []*sovereign.BridgeOutGoingData {
+	currentHashes := make(map[string]struct{}, len(currentOperations))
+	for _, currentOperation := range currentOperations {
+		log.Debug("current outgoing operations", "hash", currentOperation.Hash)
+		currentHashes[string(currentOperation.Hash)] = struct{}{}
+	}
+
+	// current operations are already part of the unconfirmed ones if they are due for resend, skip them to avoid
+	// sending the same batch twice
 	outGoingOperations := make([]*sovereign.BridgeOutGoingData, 0)
-	unconfirmedOperations := sr.outGoingOperationsPool.GetUnconfirmedOperations()
-	if len(unconfirmedOperations) != 0 {
-		log.Debug("found unconfirmed operations", "num unconfirmed operations", len(unconfirmedOperations))
-		outGoingOperations = append(unconfirmedOperations, outGoingOperations...)
+	for _, unconfirmedOperation := range sr.getUnconfirmedOperations() {
+		_, isCurrentOperation := currentHashes[string(unconfirmedOperation.Hash)]
+		if !isCurrentOperation {
+			outGoingOperations = append(outGoingOperations, unconfirmedOperation)
+		}
 	}
 
-	log.Debug("current outgoing operations", "hash", currentOperations.Hash)
-	return append(outGoingOperations, currentOperations)
+	return append(outGoingOperations, currentOperations...)
 }
 
 func (sr *sovereignSubRoundEnd) sendOutGoingOperations(ctx context.Context, data []*sovereign.BridgeOutGoingData) {
+	// failed attempts also count towards the max send attempts, since the send attempts are accounted by all the nodes
+	// when blocks are committed, regardless of the outcome of sending
 	resp, err := sr.bridgeOpHandler.Send(ctx, &sovereign.BridgeOperations{
 		Data: data,
 	})
 	if err != nil {
 		log.Error("sovereignSubRoundEnd.doSovereignEndRoundJob.bridgeOpHandler.Send", "error", err)
+		sr.AppStatusHandler().Increment(common.MetricNumOutGoingOperationsSendFailures)
 		return
 	}
 
-	sr.resetOutGoingOpTimer(data)
 	log.Debug("sent outgoing operations", "hashes", resp.TxHashes)
 }
 
-func (sr *sovereignSubRoundEnd) resetOutGoingOpTimer(data []*sovereign.BridgeOutGoingData) {
-	hashes := make([][]byte, len(data))
-	for idx, dta := range data {
-		hashes[idx] = dta.Hash
-	}
-
-	sr.outGoingOperationsPool.ResetTimer(hashes)
-}
-
 // IsInterfaceNil checks if the underlying pointer is nil
 func (sr *sovereignSubRoundEnd) IsInterfaceNil() bool {
 	return sr == nil
@@ -10,12 +10,14 @@ import (
 type sovereignSubRoundEndCreator struct {
 	outGoingOperationsPool OutGoingOperationsPool
 	bridgeOpHandler        BridgeOperationsHandler
+	bridgePauseHandler     BridgePauseHandler
 }
 
 // NewSovereignSubRoundEndCreator creates a new sovereign subround end factory
 func NewSovereignSubRoundEndCreator(
 	outGoingOperationsPool OutGoingOperationsPool,
 	bridgeOpHandler BridgeOperationsHandler,
+	bridgePauseHandler BridgePauseHandler,
 ) (*sovereignSubRoundEndCreator, error) {
 	if check.IfNil(outGoingOperationsPool) {
 		return nil, errors.ErrNilOutGoingOperationsPool
@@ -23,10 +25,14 @@ func NewSovereignSubRoundEndCreator(
 	if check.IfNil(bridgeOpHandler) {
 		return nil, errors.ErrNilBridgeOpHandler
 	}
+	if check.IfNil(bridgePauseHandler) {
+		return nil, errors.ErrNilBridgePauseHandler
+	}
 
 	return &sovereignSubRoundEndCreator{
 		outGoingOperationsPool: outGoingOperationsPool,
 		bridgeOpHandler:        bridgeOpHandler,
+		bridgePauseHandler:     bridgePauseHandler,
 	}, nil
 }
 
@@ -45,6 +51,7 @@ func (c *sovereignSubRoundEndCreator) CreateAndAddSubRoundEnd(
 		subroundEndV2Instance,
 		c.outGoingOperationsPool,
 		c.bridgeOpHandler,
+		c.bridgePauseHandler,
 	)
 	if err != nil {
 		return err
@@ -19,17 +19,22 @@ func TestNewSovereignSubRoundEndV2Creator(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil outgoing operations pool, should return error", func(t *testing.T) {
-		creator, err := bls.NewSovereignSubRoundEndCreator(nil, &sovereign.BridgeOperationsHandlerMock{})
+		creator, err := bls.NewSovereignSubRoundEndCreator(nil, &sovereign.BridgeOperationsHandlerMock{}, &sovereign.BridgePauseHandlerMock{})
 		require.Nil(t, creator)
 		require.Equal(t, errors.ErrNilOutGoingOperationsPool, err)
 	})
 	t.Run("nil bridge op handler, should return error", func(t *testing.T) {
-		creator, err := bls.NewSovereignSubRoundEndCreator(&sovereign.OutGoingOperationsPoolMock{}, nil)
+		creator, err := bls.NewSovereignSubRoundEndCreator(&sovereign.OutGoingOperationsPoolMock{}, nil, &sovereign.BridgePauseHandlerMock{})
 		require.Nil(t, creator)
 		require.Equal(t, errors.ErrNilBridgeOpHandler, err)
 	})
+	t.Run("nil bridge pause handler, should return error", func(t *testing.T) {
+		creator, err := bls.NewSovereignSubRoundEndCreator(&sovereign.OutGoingOperationsPoolMock{}, &sovereign.BridgeOperationsHandlerMock{}, nil)
+		require.Nil(t, creator)
+		require.Equal(t, errors.ErrNilBridgePauseHandler, err)
+	})
 	t.Run("should work", func(t *testing.T) {
-		creator, err := bls.NewSovereignSubRoundEndCreator(&sovereign.OutGoingOperationsPoolMock{}, &sovereign.BridgeOperationsHandlerMock{})
+		creator, err := bls.NewSovereignSubRoundEndCreator(&sovereign.OutGoingOperationsPoolMock{}, &sovereign.BridgeOperationsHandlerMock{}, &sovereign.BridgePauseHandlerMock{})
 		require.Nil(t, err)
 		require.NotNil(t, creator)
 		require.False(t, creator.IsInterfaceNil())
@@ -65,7 +70,7 @@ func TestSovereignSubRoundEndV2Creator_CreateAndAddSubRoundEnd(t *testing.T) {
 
 	sr := initSubroundEndRound(&statusHandler.AppStatusHandlerStub{})
 
-	creator, _ := bls.NewSovereignSubRoundEndCreator(&sovereign.OutGoingOperationsPoolMock{}, &sovereign.BridgeOperationsHandlerMock{})
+	creator, _ := bls.NewSovereignSubRoundEndCreator(&sovereign.OutGoingOperationsPoolMock{}, &sovereign.BridgeOperationsHandlerMock{}, &sovereign.BridgePauseHandlerMock{})
 	err := creator.CreateAndAddSubRoundEnd(sr, workerHandler, consensusCore)
 	require.Nil(t, err)
 	require.Equal(t, 2, addReceivedMessageCallCt)
@@ -2,149 +2,248 @@ package bls
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 )
 
 type sovereignSubRoundEndOutGoingTxData struct {
 	signingHandler consensus.SigningHandler
+
+	mutBatchesSigningHandlers sync.RWMutex
+	batchesSigningHandlers    []consensus.SigningHandler
 }
 
 // NewSovereignSubRoundEndOutGoingTxData creates a new signer for sovereign outgoing tx data in end sub round
-func NewSovereignSubRoundEndOutGoingTxData(
-	signingHandler consensus.SigningHandler,
-) (*sovereignSubRoundEndOutGoingTxData, error) {
+func NewSovereignSubRoundEndOutGoingTxData(signingHandler consensus.SigningHandler) (*sovereignSubRoundEndOutGoingTxData, error) {
 	if check.IfNil(signingHandler) {
 		return nil, spos.ErrNilSigningHandler
 	}
 
 	return &sovereignSubRoundEndOutGoingTxData{
-		signingHandler: signingHandler,
+		signingHandler:         signingHandler,
+		batchesSigningHandlers: make([]consensus.SigningHandler, 0),
 	}, nil
 }
 
-// VerifyAggregatedSignatures verifies outgoing tx aggregated signatures from provided header
+// VerifyAggregatedSignatures verifies the aggregated signature of each outgoing operations batch from provided header
 func (sr *sovereignSubRoundEndOutGoingTxData) VerifyAggregatedSignatures(bitmap []byte, header data.HeaderHandler) error {
 	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
 	if !castOk {
 		return fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.SetAggregatedSignatureInHeader", errors.ErrWrongTypeAssertion)
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
+	if check.IfNil(sovHeader.GetOutGoingMiniBlockHeaderHandler()) {
 		return nil
 	}
 
-	return sr.signingHandler.Verify(outGoingMb.GetOutGoingOperationsHash(), bitmap, header.GetEpoch())
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
+	if err != nil {
+		return err
+	}
+
+	sr.mutBatchesSigningHandlers.RLock()
+	batchesSigningHandlers := sr.batchesSigningHandlers
+	sr.mutBatchesSigningHandlers.RUnlock()
+
+	if len(batchesSigningHandlers) != len(batches) {
+		return fmt.Errorf("%w, num batches = %d, num aggregated batches = %d",
+			errors.ErrOutGoingOperationsBatchesNotAggregated, len(batches), len(batchesSigningHandlers))
+	}
+
+	for idx, batch := range batches {
+		err = batchesSigningHandlers[idx].Verify(batch.Hash, bitmap, header.GetEpoch())
+		if err != nil {
+			return fmt.Errorf("%w for outgoing operations batch index = %d", err, idx)
+		}
+	}
+
+	return nil
 }
 
-// AggregateAndSetSignatures aggregates and sets signatures for outgoing tx data
+// AggregateAndSetSignatures aggregates and sets signatures for outgoing tx data. The signatures of each outgoing
+// operations batch are aggregated separately and marshalled together, in batches order.
 func (sr *sovereignSubRoundEndOutGoingTxData) AggregateAndSetSignatures(bitmap []byte, header data.HeaderHandler) ([]byte, error) {
 	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
 	if !castOk {
 		return nil, fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.SetAggregatedSignatureInHeader", errors.ErrWrongTypeAssertion)
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
+	if check.IfNil(sovHeader.GetOutGoingMiniBlockHeaderHandler()) {
 		return nil, nil
 	}
 
-	sig, err := sr.signingHandler.AggregateSigs(bitmap, header.GetEpoch())
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
 	if err != nil {
 		return nil, err
 	}
 
-	err = sr.signingHandler.SetAggregatedSig(sig)
+	return sr.aggregateBatchesSignatures(bitmap, header.GetEpoch(), len(batches))
+}
+
+// aggregateBatchesSignatures splits the stored signature shares of each signer for every batch and aggregates them using
+// a separate signing handler for each batch
+func (sr *sovereignSubRoundEndOutGoingTxData) aggregateBatchesSignatures(bitmap []byte, epoch uint32, numBatches int) ([]byte, error) {
+	batchesSigningHandlers := make([]consensus.SigningHandler, numBatches)
+	for idx := range batchesSigningHandlers {
+		batchesSigningHandlers[idx] = sr.signingHandler.ShallowClone()
+	}
+
+	for index := 0; index < len(bitmap)*8; index++ {
+		if !isIndexInBitmap(index, bitmap) {
+			continue
+		}
+
+		sigShares, err := sr.signingHandler.SignatureShare(uint16(index))
+		if err != nil {
+			return nil, err
+		}
+
+		batchesSigShares, err := outgoingBatches.GetOutGoingOperationsSignatures(sigShares, numBatches)
+		if err != nil {
+			return nil, err
+		}
+
+		for batchIdx, sigShare := range batchesSigShares {
+			err = batchesSigningHandlers[batchIdx].StoreSignatureShare(uint16(index), sigShare)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	batchesAggregatedSigs := make([][]byte, 0, numBatches)
+	for _, batchSigningHandler := range batchesSigningHandlers {
+		sig, err := batchSigningHandler.AggregateSigs(bitmap, epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		err = batchSigningHandler.SetAggregatedSig(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		batchesAggregatedSigs = append(batchesAggregatedSigs, sig)
+	}
+
+	aggregatedSigs, err := outgoingBatches.MarshalOutGoingOperationsSignatures(batchesAggregatedSigs)
 	if err != nil {
 		return nil, err
 	}
 
-	return sig, nil
+	sr.mutBatchesSigningHandlers.Lock()
+	sr.batchesSigningHandlers = batchesSigningHandlers
+	sr.mutBatchesSigningHandlers.Unlock()
+
+	return aggregatedSigs, nil
 }
 
-// SetAggregatedSignatureInHeader sets aggregated signature for outgoing tx in header
+func isIndexInBitmap(index int, bitmap []byte) bool {
+	return bitmap[index/8]&(1<<uint8(index%8)) != 0
+}
+
+// SetAggregatedSignatureInHeader sets the aggregated signature of each outgoing operations batch in header, from the
+// provided aggregated signatures marshalled in batches order
 func (sr *sovereignSubRoundEndOutGoingTxData) SetAggregatedSignatureInHeader(header data.HeaderHandler, aggregatedSig []byte) error {
 	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
 	if !castOk {
 		return fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.SetAggregatedSignatureInHeader", errors.ErrWrongTypeAssertion)
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
+	if check.IfNil(sovHeader.GetOutGoingMiniBlockHeaderHandler()) {
 		return nil
 	}
 
-	err := outGoingMb.SetAggregatedSignatureOutGoingOperations(aggregatedSig)
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
 	if err != nil {
 		return err
 	}
 
-	return sovHeader.SetOutGoingMiniBlockHeaderHandler(outGoingMb)
+	batchesAggregatedSigs, err := outgoingBatches.GetOutGoingOperationsSignatures(aggregatedSig, len(batches))
+	if err != nil {
+		return err
+	}
+
+	return outgoingBatches.SetAggregatedSignatures(header, batchesAggregatedSigs)
 }
 
-// SignAndSetLeaderSignature signs and sets leader signature for outgoing tx in header
+// SignAndSetLeaderSignature signs and sets leader signature for outgoing tx in header. The leader signs each outgoing
+// operations batch hash along with its aggregated signature.
 func (sr *sovereignSubRoundEndOutGoingTxData) SignAndSetLeaderSignature(header data.HeaderHandler, leaderPubKey []byte) error {
 	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
 	if !castOk {
-		return fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.SetAggregatedSignatureInHeader", errors.ErrWrongTypeAssertion)
+		return fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.SignAndSetLeaderSignature", errors.ErrWrongTypeAssertion)
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
+	if check.IfNil(sovHeader.GetOutGoingMiniBlockHeaderHandler()) {
 		return nil
 	}
 
-	leaderMsgToSign := append(
-		outGoingMb.GetOutGoingOperationsHash(),
-		outGoingMb.GetAggregatedSignatureOutGoingOperations()...)
-
-	leaderSig, err := sr.signingHandler.CreateSignatureForPublicKey(leaderMsgToSign, leaderPubKey)
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
 	if err != nil {
 		return err
 	}
 
-	err = outGoingMb.SetLeaderSignatureOutGoingOperations(leaderSig)
-	if err != nil {
-		return err
+	batchesLeaderSigs := make([][]byte, 0, len(batches))
+	for idx, batch := range batches {
+		leaderMsgToSign := append(
+			append([]byte{}, batch.Hash...),
+			batch.AggregatedSignature...)
+
+		batchLeaderSig, errSign := sr.signingHandler.CreateSignatureForPublicKey(leaderMsgToSign, leaderPubKey)
+		if errSign != nil {
+			return fmt.Errorf("%w for outgoing operations batch index = %d", errSign, idx)
+		}
+
+		batchesLeaderSigs = append(batchesLeaderSigs, batchLeaderSig)
 	}
 
-	return sovHeader.SetOutGoingMiniBlockHeaderHandler(outGoingMb)
+	return outgoingBatches.SetLeaderSignatures(header, batchesLeaderSigs)
 }
 
-// SetConsensusDataInHeader sets aggregated and leader signature in header with provided data from consensus message
+// SetConsensusDataInHeader sets aggregated and leader signature in header with provided data from consensus message.
+// Headers holding their outgoing operations batches in the reserved field receive the signatures in the repeated
+// fields of the message, in batches order, while legacy headers receive them in the single signature fields.
 func (sr *sovereignSubRoundEndOutGoingTxData) SetConsensusDataInHeader(header data.HeaderHandler, cnsMsg *consensus.Message) error {
 	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
 	if !castOk {
 		return fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.SetConsensusDataInHeader", errors.ErrWrongTypeAssertion)
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
+	if check.IfNil(sovHeader.GetOutGoingMiniBlockHeaderHandler()) {
 		return nil
 	}
 
-	err := outGoingMb.SetAggregatedSignatureOutGoingOperations(cnsMsg.AggregatedSignatureOutGoingTxData)
-	if err != nil {
-		return err
+	return setOutGoingTxDataSignaturesInHeader(header, cnsMsg)
+}
+
+func setOutGoingTxDataSignaturesInHeader(header data.HeaderHandler, cnsMsg *consensus.Message) error {
+	aggregatedSigs := [][]byte{cnsMsg.AggregatedSignatureOutGoingTxData}
+	leaderSigs := [][]byte{cnsMsg.LeaderSignatureOutGoingTxData}
+	if outgoingBatches.HasOutGoingOperationsBatches(header) {
+		aggregatedSigs = cnsMsg.AggregatedSignaturesOutGoingTxData
+		leaderSigs = cnsMsg.LeaderSignaturesOutGoingTxData
 	}
-	err = outGoingMb.SetLeaderSignatureOutGoingOperations(cnsMsg.LeaderSignatureOutGoingTxData)
+
+	err := outgoingBatches.SetAggregatedSignatures(header, aggregatedSigs)
 	if err != nil {
 		return err
 	}
 
-	return sovHeader.SetOutGoingMiniBlockHeaderHandler(outGoingMb)
+	return outgoingBatches.SetLeaderSignatures(header, leaderSigs)
 }
 
 // AddLeaderAndAggregatedSignatures adds aggregated and leader signature in consensus message with provided data from header
 func (sr *sovereignSubRoundEndOutGoingTxData) AddLeaderAndAggregatedSignatures(header data.HeaderHandler, cnsMsg *consensus.Message) error {
 	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
 	if !castOk {
-		return fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.SetConsensusDataInHeader", errors.ErrWrongTypeAssertion)
+		return fmt.Errorf("%w in sovereignSubRoundEndOutGoingTxData.AddLeaderAndAggregatedSignatures", errors.ErrWrongTypeAssertion)
 	}
 
 	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
@@ -152,12 +251,32 @@ func (sr *sovereignSubRoundEndOutGoingTxData) AddLeaderAndAggregatedSignatures(h
 		return nil
 	}
 
-	cnsMsg.AggregatedSignatureOutGoingTxData = outGoingMb.GetAggregatedSignatureOutGoingOperations()
-	cnsMsg.LeaderSignatureOutGoingTxData = outGoingMb.GetLeaderSignatureOutGoingOperations()
+	if !outgoingBatches.HasOutGoingOperationsBatches(header) {
+		cnsMsg.AggregatedSignatureOutGoingTxData = outGoingMb.GetAggregatedSignatureOutGoingOperations()
+		cnsMsg.LeaderSignatureOutGoingTxData = outGoingMb.GetLeaderSignatureOutGoingOperations()
+
+		log.Debug("sovereignSubRoundEndOutGoingTxData.AddLeaderAndAggregatedSignatures",
+			"AggregatedSignatureOutGoingTxData", cnsMsg.AggregatedSignatureOutGoingTxData,
+			"LeaderSignatureOutGoingTxData", cnsMsg.LeaderSignatureOutGoingTxData)
+
+		return nil
+	}
+
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
+	if err != nil {
+		return err
+	}
+
+	cnsMsg.AggregatedSignaturesOutGoingTxData = make([][]byte, 0, len(batches))
+	cnsMsg.LeaderSignaturesOutGoingTxData = make([][]byte, 0, len(batches))
+	for _, batch := range batches {
+		cnsMsg.AggregatedSignaturesOutGoingTxData = append(cnsMsg.AggregatedSignaturesOutGoingTxData, batch.AggregatedSignature)
+		cnsMsg.LeaderSignaturesOutGoingTxData = append(cnsMsg.LeaderSignaturesOutGoingTxData, batch.LeaderSignature)
+	}
 
 	log.Debug("sovereignSubRoundEndOutGoingTxData.AddLeaderAndAggregatedSignatures",
-		"AggregatedSignatureOutGoingTxData", cnsMsg.AggregatedSignatureOutGoingTxData,
-		"LeaderSignatureOutGoingTxData", cnsMsg.LeaderSignatureOutGoingTxData)
+		"AggregatedSignaturesOutGoingTxData", cnsMsg.AggregatedSignaturesOutGoingTxData,
+		"LeaderSignaturesOutGoingTxData", cnsMsg.LeaderSignaturesOutGoingTxData)
 
 	return nil
 }
@@ -1,6 +1,7 @@
 package bls
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -8,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 	cnsTest "github.com/multiversx/mx-chain-go/testscommon/consensus"
 	"github.com/stretchr/testify/require"
 )
@@ -44,7 +46,7 @@ func TestSovereignSubRoundEndOutGoingTxData_VerifyAggregatedSignatures(t *testin
 
 	verifyCalledCt := 0
 	expectedBitMap := []byte{0x3}
-	signingHandler := &cnsTest.SigningHandlerStub{
+	batchSigningHandler := &cnsTest.SigningHandlerStub{
 		VerifyCalled: func(msg []byte, bitmap []byte, epoch uint32) error {
 			require.Equal(t, expectedBitMap, bitmap)
 			require.Equal(t, outGoingOpHash, msg)
@@ -54,6 +56,14 @@ func TestSovereignSubRoundEndOutGoingTxData_VerifyAggregatedSignatures(t *testin
 			return nil
 		},
 	}
+	signingHandler := &cnsTest.SigningHandlerStub{
+		ShallowCloneCalled: func() consensus.SigningHandler {
+			return batchSigningHandler
+		},
+		SignatureShareCalled: func(index uint16) ([]byte, error) {
+			return createOutGoingOperationsSignatures([]byte("sigShare")), nil
+		},
+	}
 	sovSigHandler, _ := NewSovereignSubRoundEndOutGoingTxData(signingHandler)
 
 	t.Run("invalid header type, should return error", func(t *testing.T) {
@@ -69,8 +79,17 @@ func TestSovereignSubRoundEndOutGoingTxData_VerifyAggregatedSignatures(t *testin
 		require.Zero(t, verifyCalledCt)
 	})
 
-	t.Run("should create sig share", func(t *testing.T) {
+	t.Run("signatures not aggregated, should return error", func(t *testing.T) {
 		err := sovSigHandler.VerifyAggregatedSignatures(expectedBitMap, sovHdr)
+		require.ErrorIs(t, err, errors.ErrOutGoingOperationsBatchesNotAggregated)
+		require.Zero(t, verifyCalledCt)
+	})
+
+	t.Run("should verify aggregated sig", func(t *testing.T) {
+		_, err := sovSigHandler.AggregateAndSetSignatures(expectedBitMap, sovHdr)
+		require.Nil(t, err)
+
+		err = sovSigHandler.VerifyAggregatedSignatures(expectedBitMap, sovHdr)
 		require.Nil(t, err)
 		require.Equal(t, 1, verifyCalledCt)
 	})
@@ -90,11 +109,16 @@ func TestSovereignSubRoundEndOutGoingTxData_AggregateSignatures(t *testing.T) {
 			Epoch: expectedEpoch,
 		},
 		OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
-			OutGoingOperationsHash: []byte("hash"),
+			OutGoingOperationsHash: []byte("outGoingOpHash"),
 		},
 	}
 
-	signingHandler := &cnsTest.SigningHandlerStub{
+	storedSigShares := make(map[uint16][]byte)
+	batchSigningHandler := &cnsTest.SigningHandlerStub{
+		StoreSignatureShareCalled: func(index uint16, sig []byte) error {
+			storedSigShares[index] = sig
+			return nil
+		},
 		AggregateSigsCalled: func(bitmap []byte, epoch uint32) ([]byte, error) {
 			require.Equal(t, expectedBitMap, bitmap)
 			require.Equal(t, expectedEpoch, epoch)
@@ -110,10 +134,136 @@ func TestSovereignSubRoundEndOutGoingTxData_AggregateSignatures(t *testing.T) {
 			return nil
 		},
 	}
+	signingHandler := &cnsTest.SigningHandlerStub{
+		ShallowCloneCalled: func() consensus.SigningHandler {
+			return batchSigningHandler
+		},
+		SignatureShareCalled: func(index uint16) ([]byte, error) {
+			return createOutGoingOperationsSignatures([]byte(fmt.Sprintf("sigShare%d", index))), nil
+		},
+	}
 	sovSigHandler, _ := NewSovereignSubRoundEndOutGoingTxData(signingHandler)
 	result, err := sovSigHandler.AggregateAndSetSignatures(expectedBitMap, sovHdr)
 	require.Nil(t, err)
-	require.Equal(t, aggregatedSig, result)
+	require.Equal(t, createOutGoingOperationsSignatures(aggregatedSig), result)
+	require.Equal(t, 1, aggregateSigsCalledCt)
+	require.Equal(t, 1, setAggregateSigsCalledCt)
+	require.Equal(t, map[uint16][]byte{0: []byte("sigShare0"), 1: []byte("sigShare1")}, storedSigShares)
+}
+
+func TestSovereignSubRoundEndOutGoingTxData_MultipleBatches(t *testing.T) {
+	t.Parallel()
+
+	batch1Hash := []byte("outGoingOpHas1")
+	batch2Hash := []byte("outGoingOpHas2")
+	sovHdr := createSovereignHeaderWithBatches(4, batch1Hash, batch2Hash)
+	bitmap := []byte{0x5}
+	sigShares := map[uint16][]byte{
+		0: createOutGoingOperationsSignatures([]byte("sig0_b1"), []byte("sig0_b2")),
+		2: createOutGoingOperationsSignatures([]byte("sig2_b1"), []byte("sig2_b2")),
+	}
+
+	batchesSigningHandlers := make([]*cnsTest.SigningHandlerStub, 0)
+	batchesStoredShares := make([]map[uint16][]byte, 0)
+	verifiedMessages := make([][]byte, 0)
+	createBatchSigningHandler := func() consensus.SigningHandler {
+		batchIdx := len(batchesSigningHandlers)
+		storedShares := make(map[uint16][]byte)
+		batchesStoredShares = append(batchesStoredShares, storedShares)
+
+		handler := &cnsTest.SigningHandlerStub{
+			StoreSignatureShareCalled: func(index uint16, sig []byte) error {
+				storedShares[index] = sig
+				return nil
+			},
+			AggregateSigsCalled: func(bitmap []byte, epoch uint32) ([]byte, error) {
+				require.Equal(t, sovHdr.GetEpoch(), epoch)
+				return []byte(fmt.Sprintf("aggSig_b%d", batchIdx+1)), nil
+			},
+			VerifyCalled: func(msg []byte, bitmap []byte, epoch uint32) error {
+				verifiedMessages = append(verifiedMessages, msg)
+				return nil
+			},
+		}
+		batchesSigningHandlers = append(batchesSigningHandlers, handler)
+		return handler
+	}
+
+	leaderSignedMessages := make([][]byte, 0)
+	signingHandler := &cnsTest.SigningHandlerStub{
+		ShallowCloneCalled: createBatchSigningHandler,
+		SignatureShareCalled: func(index uint16) ([]byte, error) {
+			return sigShares[index], nil
+		},
+		AggregateSigsCalled: func(bitmap []byte, epoch uint32) ([]byte, error) {
+			require.Fail(t, "should not aggregate all batches signatures together")
+			return nil, nil
+		},
+		VerifyCalled: func(msg []byte, bitmap []byte, epoch uint32) error {
+			require.Fail(t, "should not verify all batches signatures together")
+			return nil
+		},
+		CreateSignatureForPublicKeyCalled: func(message []byte, publicKeyBytes []byte) ([]byte, error) {
+			leaderSignedMessages = append(leaderSignedMessages, message)
+			return []byte(fmt.Sprintf("leaderSig_b%d", len(leaderSignedMessages))), nil
+		},
+	}
+	sovSigHandler, _ := NewSovereignSubRoundEndOutGoingTxData(signingHandler)
+
+	err := sovSigHandler.VerifyAggregatedSignatures(bitmap, sovHdr)
+	require.ErrorIs(t, err, errors.ErrOutGoingOperationsBatchesNotAggregated)
+
+	aggregatedSigs, err := sovSigHandler.AggregateAndSetSignatures(bitmap, sovHdr)
+	require.Nil(t, err)
+	require.Equal(t, createOutGoingOperationsSignatures([]byte("aggSig_b1"), []byte("aggSig_b2")), aggregatedSigs)
+	require.Equal(t, []map[uint16][]byte{
+		{0: []byte("sig0_b1"), 2: []byte("sig2_b1")},
+		{0: []byte("sig0_b2"), 2: []byte("sig2_b2")},
+	}, batchesStoredShares)
+
+	err = sovSigHandler.VerifyAggregatedSignatures(bitmap, sovHdr)
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{batch1Hash, batch2Hash}, verifiedMessages)
+
+	err = sovSigHandler.SetAggregatedSignatureInHeader(sovHdr, aggregatedSigs)
+	require.Nil(t, err)
+
+	err = sovSigHandler.SignAndSetLeaderSignature(sovHdr, []byte("leaderPubKey"))
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{
+		append(append([]byte{}, batch1Hash...), []byte("aggSig_b1")...),
+		append(append([]byte{}, batch2Hash...), []byte("aggSig_b2")...),
+	}, leaderSignedMessages)
+	expectedBatches := []*outgoingBatches.OutGoingOperationsBatch{
+		{Hash: batch1Hash, AggregatedSignature: []byte("aggSig_b1"), LeaderSignature: []byte("leaderSig_b1")},
+		{Hash: batch2Hash, AggregatedSignature: []byte("aggSig_b2"), LeaderSignature: []byte("leaderSig_b2")},
+	}
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(sovHdr)
+	require.Nil(t, err)
+	require.Equal(t, expectedBatches, batches)
+	require.Empty(t, sovHdr.GetOutGoingMiniBlockHeaderHandler().GetAggregatedSignatureOutGoingOperations())
+	require.Empty(t, sovHdr.GetOutGoingMiniBlockHeaderHandler().GetLeaderSignatureOutGoingOperations())
+
+	cnsMsg := &consensus.Message{}
+	err = sovSigHandler.AddLeaderAndAggregatedSignatures(sovHdr, cnsMsg)
+	require.Nil(t, err)
+	require.Equal(t, &consensus.Message{
+		AggregatedSignaturesOutGoingTxData: [][]byte{[]byte("aggSig_b1"), []byte("aggSig_b2")},
+		LeaderSignaturesOutGoingTxData:     [][]byte{[]byte("leaderSig_b1"), []byte("leaderSig_b2")},
+	}, cnsMsg)
+
+	receivedHdr := createSovereignHeaderWithBatches(4, batch1Hash, batch2Hash)
+	err = sovSigHandler.SetConsensusDataInHeader(receivedHdr, cnsMsg)
+	require.Nil(t, err)
+	batches, err = outgoingBatches.GetOutGoingOperationsBatches(receivedHdr)
+	require.Nil(t, err)
+	require.Equal(t, expectedBatches, batches)
+
+	err = sovSigHandler.SetConsensusDataInHeader(receivedHdr, &consensus.Message{
+		AggregatedSignatureOutGoingTxData: []byte("aggSig_b1"),
+		LeaderSignatureOutGoingTxData:     []byte("leaderSig_b1"),
+	})
+	require.ErrorIs(t, err, outgoingBatches.ErrInvalidOutGoingOperationsSignatures)
 }
 
 func TestSovereignSubRoundEndOutGoingTxData_SeAggregatedSignatureInHeader(t *testing.T) {
@@ -133,20 +283,26 @@ func TestSovereignSubRoundEndOutGoingTxData_SeAggregatedSignatureInHeader(t *tes
 	sovSigHandler, _ := NewSovereignSubRoundEndOutGoingTxData(&cnsTest.SigningHandlerStub{})
 
 	t.Run("invalid header type, should return error", func(t *testing.T) {
-		err := sovSigHandler.SetAggregatedSignatureInHeader(sovHdr.Header, aggregatedSig)
+		err := sovSigHandler.SetAggregatedSignatureInHeader(sovHdr.Header, createOutGoingOperationsSignatures(aggregatedSig))
 		require.ErrorIs(t, err, errors.ErrWrongTypeAssertion)
 	})
 
 	t.Run("no outgoing mini block header", func(t *testing.T) {
 		sovHdrCopy := *sovHdr
 		sovHdrCopy.OutGoingMiniBlockHeader = nil
-		err := sovSigHandler.SetAggregatedSignatureInHeader(&sovHdrCopy, aggregatedSig)
+		err := sovSigHandler.SetAggregatedSignatureInHeader(&sovHdrCopy, createOutGoingOperationsSignatures(aggregatedSig))
 		require.Nil(t, err)
 		require.True(t, check.IfNil(sovHdrCopy.OutGoingMiniBlockHeader))
 	})
 
+	t.Run("invalid aggregated signatures, should return error", func(t *testing.T) {
+		err := sovSigHandler.SetAggregatedSignatureInHeader(sovHdr, createOutGoingOperationsSignatures(aggregatedSig, aggregatedSig))
+		require.ErrorIs(t, err, outgoingBatches.ErrInvalidOutGoingOperationsSignatures)
+		require.Empty(t, sovHdr.OutGoingMiniBlockHeader.AggregatedSignatureOutGoingOperations)
+	})
+
 	t.Run("should add sig share", func(t *testing.T) {
-		err := sovSigHandler.SetAggregatedSignatureInHeader(sovHdr, aggregatedSig)
+		err := sovSigHandler.SetAggregatedSignatureInHeader(sovHdr, createOutGoingOperationsSignatures(aggregatedSig))
 		require.Nil(t, err)
 		require.Equal(t, &block.SovereignChainHeader{
 			Header: &block.Header{
@@ -182,7 +338,7 @@ func TestSovereignSubRoundEndOutGoingTxData_SignAndSetLeaderSignature(t *testing
 	signingHandler := &cnsTest.SigningHandlerStub{
 		CreateSignatureForPublicKeyCalled: func(message []byte, publicKeyBytes []byte) ([]byte, error) {
 			require.Equal(t, expectedLeaderPubKey, publicKeyBytes)
-			require.Equal(t, append(outGoingOpHash, aggregatedSig...), message)
+			require.Equal(t, append(append([]byte{}, outGoingOpHash...), aggregatedSig...), message)
 
 			verifyCalledCt++
 			return expectedLeaderSig, nil
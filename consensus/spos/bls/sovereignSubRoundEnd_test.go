@@ -2,6 +2,7 @@ package bls_test
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	sovCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/mock"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
@@ -28,6 +30,15 @@ type sovEndRoundHandler interface {
 	GetInternalHeader() data.HeaderHandler
 }
 
+func createOutGoingMiniBlockHeader(outGoingDataHash []byte, aggregatedSig []byte, leaderSig []byte) *block.OutGoingMiniBlockHeader {
+	return &block.OutGoingMiniBlockHeader{
+		Hash:                                  outGoingDataHash,
+		OutGoingOperationsHash:                outGoingDataHash,
+		AggregatedSignatureOutGoingOperations: aggregatedSig,
+		LeaderSignatureOutGoingOperations:     leaderSig,
+	}
+}
+
 func createSovSubRoundEndWithSelfLeader(
 	pool sovereignBlock.OutGoingOperationsPool,
 	bridgeHandler bls.BridgeOperationsHandler,
@@ -36,7 +47,7 @@ func createSovSubRoundEndWithSelfLeader(
 	container := mock.InitConsensusCore()
 	sr := *initSubroundEndRoundWithContainer(container, &statusHandler.AppStatusHandlerStub{}, &enableEpochsHandlerMock.EnableEpochsHandlerStub{})
 	srV2, _ := bls.NewSubroundEndRoundV2(&sr)
-	sovEndRound, _ := bls.NewSovereignSubRoundEndRound(srV2, pool, bridgeHandler)
+	sovEndRound, _ := bls.NewSovereignSubRoundEndRound(srV2, pool, bridgeHandler, &sovereign.BridgePauseHandlerMock{})
 
 	sovEndRound.SetSelfPubKey("A")
 	sovEndRound.SetThreshold(bls.SrEndRound, 1)
@@ -54,7 +65,7 @@ func createSovSubRoundEndWithParticipant(
 	container := mock.InitConsensusCore()
 	sr := *initSubroundEndRoundWithContainer(container, &statusHandler.AppStatusHandlerStub{}, &enableEpochsHandlerMock.EnableEpochsHandlerStub{})
 	srV2, _ := bls.NewSubroundEndRoundV2(&sr)
-	sovEndRound, _ := bls.NewSovereignSubRoundEndRound(srV2, pool, bridgeHandler)
+	sovEndRound, _ := bls.NewSovereignSubRoundEndRound(srV2, pool, bridgeHandler, &sovereign.BridgePauseHandlerMock{})
 
 	sovEndRound.SetSelfPubKey("*")
 	sovEndRound.SetThreshold(bls.SrEndRound, 1)
@@ -80,6 +91,7 @@ func TestNewSovereignSubRoundEndRound(t *testing.T) {
 			nil,
 			&sovereign.OutGoingOperationsPoolMock{},
 			&sovereign.BridgeOperationsHandlerMock{},
+			&sovereign.BridgePauseHandlerMock{},
 		)
 		require.Equal(t, spos.ErrNilSubround, err)
 		require.Nil(t, sovEndRound)
@@ -89,6 +101,7 @@ func TestNewSovereignSubRoundEndRound(t *testing.T) {
 			srV2,
 			nil,
 			&sovereign.BridgeOperationsHandlerMock{},
+			&sovereign.BridgePauseHandlerMock{},
 		)
 		require.Equal(t, errors.ErrNilOutGoingOperationsPool, err)
 		require.Nil(t, sovEndRound)
@@ -98,15 +111,27 @@ func TestNewSovereignSubRoundEndRound(t *testing.T) {
 			srV2,
 			&sovereign.OutGoingOperationsPoolMock{},
 			nil,
+			&sovereign.BridgePauseHandlerMock{},
 		)
 		require.Equal(t, errors.ErrNilBridgeOpHandler, err)
 		require.Nil(t, sovEndRound)
 	})
+	t.Run("nil bridge pause handler, should return error", func(t *testing.T) {
+		sovEndRound, err := bls.NewSovereignSubRoundEndRound(
+			srV2,
+			&sovereign.OutGoingOperationsPoolMock{},
+			&sovereign.BridgeOperationsHandlerMock{},
+			nil,
+		)
+		require.Equal(t, errors.ErrNilBridgePauseHandler, err)
+		require.Nil(t, sovEndRound)
+	})
 	t.Run("should work", func(t *testing.T) {
 		sovEndRound, err := bls.NewSovereignSubRoundEndRound(
 			srV2,
 			&sovereign.OutGoingOperationsPoolMock{},
 			&sovereign.BridgeOperationsHandlerMock{},
+			&sovereign.BridgePauseHandlerMock{},
 		)
 		require.Nil(t, err)
 		require.False(t, sovEndRound.IsInterfaceNil())
@@ -146,12 +171,7 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 			OutGoingMiniBlockHeader: nil,
 		}
 
-		wasResetTimerCalled := false
-		pool := &sovereign.OutGoingOperationsPoolMock{
-			ResetTimerCalled: func(hashes [][]byte) {
-				wasResetTimerCalled = true
-			},
-		}
+		pool := &sovereign.OutGoingOperationsPoolMock{}
 		sovEndRound := createSovSubRoundEndWithSelfLeader(pool, bridgeHandler, sovHdr)
 		ctx := context.Background()
 		success := sovEndRound.DoSovereignEndRoundJob(ctx)
@@ -164,7 +184,6 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 
 		require.True(t, success)
 		require.False(t, wasDataSent)
-		require.False(t, wasResetTimerCalled)
 	})
 
 	t.Run("outgoing operations found", func(t *testing.T) {
@@ -176,10 +195,17 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 		aggregatedSig := []byte("aggregatedSig")
 		leaderSig := []byte("leaderSig")
 		getCallCt := 0
-		wasResetTimerCalled := false
+		wasSetSignaturesCalled := false
 		wg := sync.WaitGroup{}
-		wg.Add(2)
+		wg.Add(1)
 		pool := &sovereign.OutGoingOperationsPoolMock{
+			SetSignaturesCalled: func(hash []byte, leaderSignature []byte, aggregatedSignature []byte) error {
+				require.Equal(t, outGoingDataHash, hash)
+				require.Equal(t, leaderSig, leaderSignature)
+				require.Equal(t, aggregatedSig, aggregatedSignature)
+				wasSetSignaturesCalled = true
+				return nil
+			},
 			GetCalled: func(hash []byte) *sovCore.BridgeOutGoingData {
 				require.Equal(t, outGoingDataHash, hash)
 
@@ -197,6 +223,8 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 								Data: outGoingOpData,
 							},
 						},
+						AggregatedSignature: aggregatedSig,
+						LeaderSignature:     leaderSig,
 					}
 				default:
 					require.Fail(t, "should not call get from pool anymore")
@@ -204,30 +232,6 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 
 				return nil
 			},
-			DeleteCalled: func(hash []byte) {
-				require.Equal(t, outGoingDataHash, hash)
-			},
-			AddCalled: func(data *sovCore.BridgeOutGoingData) {
-				require.Equal(t, &sovCore.BridgeOutGoingData{
-					Hash: outGoingDataHash,
-					OutGoingOperations: []*sovCore.OutGoingOperation{
-						{
-							Hash: outGoingOpHash,
-							Data: outGoingOpData,
-						},
-					},
-					AggregatedSignature: aggregatedSig,
-					LeaderSignature:     leaderSig,
-				}, data)
-			},
-			ResetTimerCalled: func(hashes [][]byte) {
-				defer func() {
-					wg.Done()
-				}()
-
-				require.Equal(t, [][]byte{outGoingDataHash}, hashes)
-				wasResetTimerCalled = true
-			},
 		}
 
 		wasDataSent := false
@@ -263,18 +267,14 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 			Header: &block.Header{
 				Nonce: 4,
 			},
-			OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
-				OutGoingOperationsHash:                outGoingDataHash,
-				AggregatedSignatureOutGoingOperations: aggregatedSig,
-				LeaderSignatureOutGoingOperations:     leaderSig,
-			},
+			OutGoingMiniBlockHeader: createOutGoingMiniBlockHeader(outGoingDataHash, aggregatedSig, leaderSig),
 		}
 		sovEndRound := createSovSubRoundEndWithSelfLeader(pool, bridgeHandler, sovHdr)
 		success := sovEndRound.DoSovereignEndRoundJob(currCtx)
 		wg.Wait()
 		require.True(t, success)
 		require.True(t, wasDataSent)
-		require.True(t, wasResetTimerCalled)
+		require.True(t, wasSetSignaturesCalled)
 		require.Equal(t, 1, getCallCt)
 	})
 
@@ -287,7 +287,7 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 		aggregatedSig := []byte("aggregatedSig")
 		leaderSig := []byte("leaderSig")
 		wg := sync.WaitGroup{}
-		wg.Add(2)
+		wg.Add(1)
 		currentBridgeOutGoingData := &sovCore.BridgeOutGoingData{
 			Hash: outGoingDataHash,
 			OutGoingOperations: []*sovCore.OutGoingOperation{
@@ -310,21 +310,12 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 			},
 		}
 
-		wasResetTimerCalled := false
 		pool := &sovereign.OutGoingOperationsPoolMock{
 			GetCalled: func(hash []byte) *sovCore.BridgeOutGoingData {
 				return currentBridgeOutGoingData
 			},
 			GetUnconfirmedOperationsCalled: func() []*sovCore.BridgeOutGoingData {
-				return []*sovCore.BridgeOutGoingData{unconfirmedBridgeOutGoingData}
-			},
-			ResetTimerCalled: func(hashes [][]byte) {
-				defer func() {
-					wg.Done()
-				}()
-
-				require.Equal(t, [][]byte{unconfirmedBridgeOutGoingData.Hash, currentBridgeOutGoingData.Hash}, hashes)
-				wasResetTimerCalled = true
+				return []*sovCore.BridgeOutGoingData{unconfirmedBridgeOutGoingData, currentBridgeOutGoingData}
 			},
 		}
 
@@ -353,11 +344,7 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 			Header: &block.Header{
 				Nonce: 4,
 			},
-			OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
-				OutGoingOperationsHash:                outGoingDataHash,
-				AggregatedSignatureOutGoingOperations: aggregatedSig,
-				LeaderSignatureOutGoingOperations:     leaderSig,
-			},
+			OutGoingMiniBlockHeader: createOutGoingMiniBlockHeader(outGoingDataHash, aggregatedSig, leaderSig),
 		}
 		sovEndRound := createSovSubRoundEndWithSelfLeader(pool, bridgeHandler, sovHdr)
 		success := sovEndRound.DoSovereignEndRoundJob(currCtx)
@@ -365,14 +352,13 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 		wg.Wait()
 		require.True(t, success)
 		require.True(t, wasDataSent)
-		require.True(t, wasResetTimerCalled)
 	})
 
 	t.Run("no outgoing operations in current block, but found unconfirmed operations, leader should send them", func(t *testing.T) {
 		t.Parallel()
 
 		unconfirmedBridgeOutGoingData := &sovCore.BridgeOutGoingData{
-			Hash: []byte("hashOfHashes"),
+			Hash: []byte("hash"),
 			OutGoingOperations: []*sovCore.OutGoingOperation{
 				{
 					Hash: []byte("hashOp1"),
@@ -381,21 +367,12 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 			},
 		}
 
-		wasResetTimerCalled := false
 		wg := sync.WaitGroup{}
-		wg.Add(2)
+		wg.Add(1)
 		pool := &sovereign.OutGoingOperationsPoolMock{
 			GetUnconfirmedOperationsCalled: func() []*sovCore.BridgeOutGoingData {
 				return []*sovCore.BridgeOutGoingData{unconfirmedBridgeOutGoingData}
 			},
-			ResetTimerCalled: func(hashes [][]byte) {
-				defer func() {
-					wg.Done()
-				}()
-
-				wasResetTimerCalled = true
-				require.Equal(t, [][]byte{unconfirmedBridgeOutGoingData.Hash}, hashes)
-			},
 		}
 
 		wasDataSent := false
@@ -430,14 +407,13 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 		wg.Wait()
 		require.True(t, success)
 		require.True(t, wasDataSent)
-		require.True(t, wasResetTimerCalled)
 	})
 
 	t.Run("leader keeps resending previous unconfirmed operations, should keep signatures in pool", func(t *testing.T) {
 		t.Parallel()
 
 		unconfirmedBridgeOutGoingData := &sovCore.BridgeOutGoingData{
-			Hash: []byte("hashOfHashes"),
+			Hash: []byte("hash"),
 			OutGoingOperations: []*sovCore.OutGoingOperation{
 				{
 					Hash: []byte("hashOp1"),
@@ -458,7 +434,7 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 		bridgeData := &sovCore.BridgeOperations{
 			Data: []*sovCore.BridgeOutGoingData{
 				{
-					Hash: []byte("hashOfHashes"),
+					Hash: []byte("hash"),
 					OutGoingOperations: []*sovCore.OutGoingOperation{
 						{
 							Hash: []byte("hashOp1"),
@@ -489,18 +465,14 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 			Header: &block.Header{
 				Nonce: 4,
 			},
-			OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
-				Hash:                                  []byte("hashOfHashes"),
-				OutGoingOperationsHash:                []byte("hashOfHashes"),
-				AggregatedSignatureOutGoingOperations: []byte("aggregatedSig"),
-				LeaderSignatureOutGoingOperations:     []byte("leaderSig"),
-			},
+			OutGoingMiniBlockHeader: createOutGoingMiniBlockHeader([]byte("hash"), []byte("aggregatedSig"), []byte("leaderSig")),
 		}
 		sovEndRound := createSovSubRoundEndWithSelfLeader(pool, bridgeHandler, sovHdr)
-		time.Sleep(expiryTime)
 
-		// Before having confirmed signed header, we have in pool data with no signatures
-		require.Equal(t, []*sovCore.BridgeOutGoingData{unconfirmedBridgeOutGoingData}, pool.GetUnconfirmedOperations())
+		// the block which created the operations is committed
+		now := time.Now()
+		pool.ProcessUnconfirmedOperations(now)
+		require.Empty(t, pool.GetUnconfirmedOperations())
 
 		for i := 1; i <= 3; i++ {
 			success := false
@@ -512,9 +484,9 @@ func TestSovereignSubRoundEnd_DoEndJobByLeader(t *testing.T) {
 			require.True(t, wasDataSent)
 			require.Equal(t, i, sendDataCalledCt)
 
-			time.Sleep(expiryTime)
-
-			// Simulate next rounds with no further outgoing operations
+			// Simulate next committed blocks with no further outgoing operations
+			now = now.Add(2 * expiryTime)
+			pool.ProcessUnconfirmedOperations(now)
 			sovHdr.OutGoingMiniBlockHeader = nil
 			wg.Add(1)
 
@@ -535,7 +507,7 @@ func TestSovereignSubRoundEnd_DoEndJobByParticipant(t *testing.T) {
 		outGoingOpData := []byte("bridgeOp")
 		aggregatedSig := []byte("aggregatedSig")
 		leaderSig := []byte("leaderSig")
-		wasResetTimerCalled := false
+		wasSetSignaturesCalled := false
 		bridgeOutGoingData := &sovCore.BridgeOutGoingData{
 			Hash: outGoingDataHash,
 			OutGoingOperations: []*sovCore.OutGoingOperation{
@@ -549,6 +521,13 @@ func TestSovereignSubRoundEnd_DoEndJobByParticipant(t *testing.T) {
 		getCallCt := 0
 		getUnconfirmedCalled := 0
 		pool := &sovereign.OutGoingOperationsPoolMock{
+			SetSignaturesCalled: func(hash []byte, leaderSignature []byte, aggregatedSignature []byte) error {
+				require.Equal(t, outGoingDataHash, hash)
+				require.Equal(t, leaderSig, leaderSignature)
+				require.Equal(t, aggregatedSig, aggregatedSignature)
+				wasSetSignaturesCalled = true
+				return nil
+			},
 			GetCalled: func(hash []byte) *sovCore.BridgeOutGoingData {
 				require.Equal(t, outGoingDataHash, hash)
 
@@ -573,29 +552,10 @@ func TestSovereignSubRoundEnd_DoEndJobByParticipant(t *testing.T) {
 
 				return nil
 			},
-			DeleteCalled: func(hash []byte) {
-				require.Equal(t, outGoingDataHash, hash)
-			},
-			AddCalled: func(data *sovCore.BridgeOutGoingData) {
-				require.Equal(t, &sovCore.BridgeOutGoingData{
-					Hash: outGoingDataHash,
-					OutGoingOperations: []*sovCore.OutGoingOperation{
-						{
-							Hash: outGoingOpHash,
-							Data: outGoingOpData,
-						},
-					},
-					AggregatedSignature: aggregatedSig,
-					LeaderSignature:     leaderSig,
-				}, data)
-			},
 			GetUnconfirmedOperationsCalled: func() []*sovCore.BridgeOutGoingData {
 				getUnconfirmedCalled++
 				return make([]*sovCore.BridgeOutGoingData, 1)
 			},
-			ResetTimerCalled: func(hashes [][]byte) {
-				wasResetTimerCalled = true
-			},
 		}
 
 		wasDataSent := false
@@ -620,11 +580,7 @@ func TestSovereignSubRoundEnd_DoEndJobByParticipant(t *testing.T) {
 			Header: &block.Header{
 				Nonce: 4,
 			},
-			OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
-				OutGoingOperationsHash:                outGoingDataHash,
-				AggregatedSignatureOutGoingOperations: aggregatedSig,
-				LeaderSignatureOutGoingOperations:     leaderSig,
-			},
+			OutGoingMiniBlockHeader: createOutGoingMiniBlockHeader(outGoingDataHash, aggregatedSig, leaderSig),
 		}
 		sovEndRound := createSovSubRoundEndWithParticipant(pool, bridgeHandler, sovHdr)
 		success := sovEndRound.DoSovereignEndRoundJob(currCtx)
@@ -637,7 +593,7 @@ func TestSovereignSubRoundEnd_DoEndJobByParticipant(t *testing.T) {
 
 		require.True(t, success)
 		require.False(t, wasDataSent)
-		require.False(t, wasResetTimerCalled)
+		require.True(t, wasSetSignaturesCalled)
 		require.Equal(t, 1, getCallCt)
 		require.Equal(t, 0, getUnconfirmedCalled)
 	})
@@ -646,15 +602,11 @@ func TestSovereignSubRoundEnd_DoEndJobByParticipant(t *testing.T) {
 		t.Parallel()
 
 		getUnconfirmedCalled := 0
-		wasResetTimerCalled := false
 		pool := &sovereign.OutGoingOperationsPoolMock{
 			GetUnconfirmedOperationsCalled: func() []*sovCore.BridgeOutGoingData {
 				getUnconfirmedCalled++
 				return make([]*sovCore.BridgeOutGoingData, 1)
 			},
-			ResetTimerCalled: func(hashes [][]byte) {
-				wasResetTimerCalled = true
-			},
 		}
 
 		wasDataSent := false
@@ -685,7 +637,6 @@ func TestSovereignSubRoundEnd_DoEndJobByParticipant(t *testing.T) {
 
 		require.True(t, success)
 		require.False(t, wasDataSent)
-		require.False(t, wasResetTimerCalled)
 		require.Zero(t, getUnconfirmedCalled)
 	})
 }
@@ -694,7 +645,7 @@ func TestSovereignSubRoundEnd_ReceivedBlockHeaderFinalInfo(t *testing.T) {
 	t.Parallel()
 
 	outGoingData := &sovCore.BridgeOutGoingData{
-		Hash: []byte("hashOfHashes"),
+		Hash: []byte("hash"),
 		OutGoingOperations: []*sovCore.OutGoingOperation{
 			{
 				Hash: []byte("hashOp1"),
@@ -723,22 +674,20 @@ func TestSovereignSubRoundEnd_ReceivedBlockHeaderFinalInfo(t *testing.T) {
 		Header: &block.Header{
 			Nonce: 4,
 		},
-		OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
-			Hash:                   []byte("hashOfHashes"),
-			OutGoingOperationsHash: []byte("hashOfHashes"),
-		},
+		OutGoingMiniBlockHeader: createOutGoingMiniBlockHeader([]byte("hash"), nil, nil),
 	}
 
 	sovEndRound := createSovSubRoundEndWithParticipant(pool, bridgeHandler, sovHdr)
 
 	aggregatedSig := []byte("aggregatedSigOutGoing")
 	leaderSig := []byte("leaderSigOutGoing")
+	signedOutGoingMb := createOutGoingMiniBlockHeader([]byte("hash"), aggregatedSig, leaderSig)
 	cnsData := consensus.Message{
 		HeaderHash:                        []byte("X"),
 		PubKey:                            []byte("A"),
 		InvalidSigners:                    []byte("invalidSignersData"),
-		AggregatedSignatureOutGoingTxData: aggregatedSig,
-		LeaderSignatureOutGoingTxData:     leaderSig,
+		AggregatedSignatureOutGoingTxData: signedOutGoingMb.AggregatedSignatureOutGoingOperations,
+		LeaderSignatureOutGoingTxData:     signedOutGoingMb.LeaderSignatureOutGoingOperations,
 	}
 
 	// Participant should not send any data
@@ -748,13 +697,13 @@ func TestSovereignSubRoundEnd_ReceivedBlockHeaderFinalInfo(t *testing.T) {
 
 	// Header's outgoing mb is updated with signatures from consensus message
 	outGoingMb := sovEndRound.GetInternalHeader().(data.SovereignChainHeaderHandler).GetOutGoingMiniBlockHeaderHandler()
-	require.Equal(t, leaderSig, outGoingMb.GetLeaderSignatureOutGoingOperations())
-	require.Equal(t, aggregatedSig, outGoingMb.GetAggregatedSignatureOutGoingOperations())
+	require.Equal(t, signedOutGoingMb.LeaderSignatureOutGoingOperations, outGoingMb.GetLeaderSignatureOutGoingOperations())
+	require.Equal(t, signedOutGoingMb.AggregatedSignatureOutGoingOperations, outGoingMb.GetAggregatedSignatureOutGoingOperations())
 
 	// Internal outgoing pool is updated with signatures as well
-	updatedPoolData := pool.Get([]byte("hashOfHashes"))
+	updatedPoolData := pool.Get([]byte("hash"))
 	require.Equal(t, &sovCore.BridgeOutGoingData{
-		Hash: []byte("hashOfHashes"),
+		Hash: []byte("hash"),
 		OutGoingOperations: []*sovCore.OutGoingOperation{
 			{
 				Hash: []byte("hashOp1"),
@@ -765,3 +714,171 @@ func TestSovereignSubRoundEnd_ReceivedBlockHeaderFinalInfo(t *testing.T) {
 		LeaderSignature:     leaderSig,
 	}, updatedPoolData)
 }
+
+func TestSovereignSubRoundEnd_DoEndJobByLeaderWithRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	unconfirmedBridgeOutGoingData := &sovCore.BridgeOutGoingData{
+		Hash: []byte("hash"),
+		OutGoingOperations: []*sovCore.OutGoingOperation{
+			{
+				Hash: []byte("hashOp1"),
+				Data: []byte("bridgeOp1"),
+			},
+		},
+	}
+
+	expiryTime := time.Millisecond * 100
+	maxSendAttempts := 3
+	pool := sovereignBlock.NewOutGoingOperationPoolWithRetryPolicy(expiryTime, sovereignBlock.RetryPolicy{
+		MaxSendAttempts: uint32(maxSendAttempts),
+	})
+	pool.Add(unconfirmedBridgeOutGoingData)
+
+	errSend := fmt.Errorf("send error")
+	sendDataCalledCt := 0
+	mutSend := sync.Mutex{}
+	bridgeHandler := &sovereign.BridgeOperationsHandlerMock{
+		SendCalled: func(ctx context.Context, data *sovCore.BridgeOperations) (*sovCore.BridgeOperationsResponse, error) {
+			mutSend.Lock()
+			sendDataCalledCt++
+			mutSend.Unlock()
+
+			return nil, errSend
+		},
+	}
+
+	sovHdr := &block.SovereignChainHeader{
+		Header: &block.Header{
+			Nonce: 4,
+		},
+	}
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	appStatusHandler.SetUInt64Value(common.MetricNumOutGoingOperationsRetries, 0)
+	appStatusHandler.SetUInt64Value(common.MetricNumOutGoingOperationsSendFailures, 0)
+	appStatusHandler.SetUInt64Value(common.MetricNumDeadLetteredOutGoingOperations, 0)
+	container := mock.InitConsensusCore()
+	sr := *initSubroundEndRoundWithContainer(container, appStatusHandler, &enableEpochsHandlerMock.EnableEpochsHandlerStub{})
+	srV2, _ := bls.NewSubroundEndRoundV2(&sr)
+	sovEndRound, _ := bls.NewSovereignSubRoundEndRound(srV2, pool, bridgeHandler, &sovereign.BridgePauseHandlerMock{})
+	sovEndRound.SetSelfPubKey("A")
+	sovEndRound.SetThreshold(bls.SrEndRound, 1)
+	_ = sovEndRound.SetJobDone(sovEndRound.ConsensusGroup()[0], bls.SrSignature, true)
+	sovEndRound.Header = sovHdr
+
+	// the first send attempt is counted when the block which created the operations is committed, the committed blocks
+	// being simulated by processing the unconfirmed operations in the pool
+	now := time.Now()
+	pool.ProcessUnconfirmedOperations(now)
+	numResendAttempts := maxSendAttempts - 1
+	for i := 1; i <= numResendAttempts; i++ {
+		now = now.Add(2 * expiryTime)
+		pool.ProcessUnconfirmedOperations(now)
+
+		success := sovEndRound.DoSovereignEndRoundJob(context.Background())
+		require.True(t, success)
+
+		expectedNumAttempts := uint64(i)
+		require.Eventually(t, func() bool {
+			return appStatusHandler.GetUint64(common.MetricNumOutGoingOperationsSendFailures) == expectedNumAttempts
+		}, time.Second, time.Millisecond*10)
+		require.Equal(t, expectedNumAttempts, appStatusHandler.GetUint64(common.MetricNumOutGoingOperationsRetries))
+	}
+
+	// failed send attempts are retried after the time to wait, until the max send attempts is reached
+	pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+	success := sovEndRound.DoSovereignEndRoundJob(context.Background())
+	require.True(t, success)
+
+	require.Equal(t, []*sovCore.BridgeOutGoingData{unconfirmedBridgeOutGoingData}, pool.GetDeadLetteredOperations())
+	require.Equal(t, uint64(1), appStatusHandler.GetUint64(common.MetricNumDeadLetteredOutGoingOperations))
+	require.Equal(t, uint64(numResendAttempts), appStatusHandler.GetUint64(common.MetricNumOutGoingOperationsRetries))
+
+	time.Sleep(time.Millisecond * 100)
+	mutSend.Lock()
+	require.Equal(t, numResendAttempts, sendDataCalledCt)
+	mutSend.Unlock()
+
+	// re-queued dead-lettered operations should be resent by the leader after the next committed block
+	err := pool.RequeueDeadLetteredOperations(unconfirmedBridgeOutGoingData.Hash)
+	require.Nil(t, err)
+	require.Empty(t, pool.GetDeadLetteredOperations())
+
+	pool.ProcessUnconfirmedOperations(now.Add(4 * expiryTime))
+	success = sovEndRound.DoSovereignEndRoundJob(context.Background())
+	require.True(t, success)
+
+	require.Eventually(t, func() bool {
+		mutSend.Lock()
+		defer mutSend.Unlock()
+
+		return sendDataCalledCt == numResendAttempts+1
+	}, time.Second, time.Millisecond*10)
+	require.Equal(t, uint64(0), appStatusHandler.GetUint64(common.MetricNumDeadLetteredOutGoingOperations))
+}
+
+func TestSovereignSubRoundEnd_DoEndJobByLeaderWithOutGoingBridgePaused(t *testing.T) {
+	t.Parallel()
+
+	outGoingDataHash := []byte("hash")
+	aggregatedSig := []byte("aggregatedSig")
+	leaderSig := []byte("leaderSig")
+	wasSignedDataAdded := false
+	pool := &sovereign.OutGoingOperationsPoolMock{
+		GetCalled: func(hash []byte) *sovCore.BridgeOutGoingData {
+			return &sovCore.BridgeOutGoingData{
+				Hash: outGoingDataHash,
+			}
+		},
+		SetSignaturesCalled: func(_ []byte, leaderSignature []byte, aggregatedSignature []byte) error {
+			require.Equal(t, aggregatedSig, aggregatedSignature)
+			require.Equal(t, leaderSig, leaderSignature)
+			wasSignedDataAdded = true
+			return nil
+		},
+		GetUnconfirmedOperationsCalled: func() []*sovCore.BridgeOutGoingData {
+			require.Fail(t, "should not search unconfirmed operations while the outgoing bridge is paused")
+			return nil
+		},
+	}
+	bridgeHandler := &sovereign.BridgeOperationsHandlerMock{
+		SendCalled: func(_ context.Context, _ *sovCore.BridgeOperations) (*sovCore.BridgeOperationsResponse, error) {
+			require.Fail(t, "should not send while the outgoing bridge is paused")
+			return nil, nil
+		},
+	}
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundEndRoundWithContainer(container, &statusHandler.AppStatusHandlerStub{}, &enableEpochsHandlerMock.EnableEpochsHandlerStub{})
+	srV2, _ := bls.NewSubroundEndRoundV2(&sr)
+	sovEndRound, _ := bls.NewSovereignSubRoundEndRound(srV2, pool, bridgeHandler, &sovereign.BridgePauseHandlerMock{
+		IsOutGoingPausedCalled: func() bool {
+			return true
+		},
+	})
+	sovEndRound.SetSelfPubKey("A")
+	sovEndRound.SetThreshold(bls.SrEndRound, 1)
+	_ = sovEndRound.SetJobDone(sovEndRound.ConsensusGroup()[0], bls.SrSignature, true)
+
+	// no outgoing operations in the current block, unconfirmed operations should not be resent
+	sovEndRound.Header = &block.SovereignChainHeader{
+		Header: &block.Header{
+			Nonce: 4,
+		},
+	}
+	success := sovEndRound.DoSovereignEndRoundJob(context.Background())
+	require.True(t, success)
+
+	// outgoing operations in the current block should be signed and kept in the pool, without being sent
+	sovEndRound.Header = &block.SovereignChainHeader{
+		Header: &block.Header{
+			Nonce: 5,
+		},
+		OutGoingMiniBlockHeader: createOutGoingMiniBlockHeader(outGoingDataHash, aggregatedSig, leaderSig),
+	}
+	success = sovEndRound.DoSovereignEndRoundJob(context.Background())
+	require.True(t, success)
+	require.True(t, wasSignedDataAdded)
+
+	time.Sleep(time.Millisecond * 100)
+}
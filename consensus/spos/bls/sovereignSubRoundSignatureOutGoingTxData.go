@@ -8,24 +8,42 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 )
 
 type sovereignSubRoundSignatureOutGoingTxData struct {
-	signingHandler consensus.SigningHandler
+	signingHandler     consensus.SigningHandler
+	bridgePauseHandler BridgePauseHandler
+	signingJournal     consensus.SigningJournal
 }
 
 // NewSovereignSubRoundSignatureOutGoingTxData creates a new signer for sovereign outgoing tx data in signature sub round
-func NewSovereignSubRoundSignatureOutGoingTxData(signingHandler consensus.SigningHandler) (*sovereignSubRoundSignatureOutGoingTxData, error) {
+func NewSovereignSubRoundSignatureOutGoingTxData(
+	signingHandler consensus.SigningHandler,
+	bridgePauseHandler BridgePauseHandler,
+	signingJournal consensus.SigningJournal,
+) (*sovereignSubRoundSignatureOutGoingTxData, error) {
 	if check.IfNil(signingHandler) {
 		return nil, spos.ErrNilSigningHandler
 	}
+	if check.IfNil(bridgePauseHandler) {
+		return nil, errors.ErrNilBridgePauseHandler
+	}
+	if check.IfNil(signingJournal) {
+		return nil, spos.ErrNilSigningJournal
+	}
 
 	return &sovereignSubRoundSignatureOutGoingTxData{
-		signingHandler: signingHandler,
+		signingHandler:     signingHandler,
+		bridgePauseHandler: bridgePauseHandler,
+		signingJournal:     signingJournal,
 	}, nil
 }
 
-// CreateSignatureShare creates a signature share for outgoing tx hash, if exists
+// CreateSignatureShare creates a signature share for each outgoing operations batch hash, if exists. The signature
+// shares are marshalled together, in batches order, and stored as a single share for the provided index. While the
+// outgoing bridge is paused, only the validator set rotation operation of an epoch start block is signed. The signed
+// batches hashes are recorded in the signing journal before signing, as the headers are.
 func (sr *sovereignSubRoundSignatureOutGoingTxData) CreateSignatureShare(
 	header data.HeaderHandler,
 	selfIndex uint16,
@@ -36,26 +54,88 @@ func (sr *sovereignSubRoundSignatureOutGoingTxData) CreateSignatureShare(
 		return nil, fmt.Errorf("%w in sovereignSubRoundSignatureOutGoingTxData.CreateSignatureShare", errors.ErrWrongTypeAssertion)
 	}
 
-	outGoingMBHeader := sovChainHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMBHeader) {
+	if check.IfNil(sovChainHeader.GetOutGoingMiniBlockHeaderHandler()) {
 		return make([]byte, 0), nil
 	}
+	if sr.bridgePauseHandler.IsOutGoingPaused() && !header.IsStartOfEpochBlock() {
+		return nil, errors.ErrOutGoingBridgePaused
+	}
+
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sr.recordInSigningJournal(header, selfPubKey, batches)
+	if err != nil {
+		return nil, err
+	}
+
+	batchesSigShares := make([][]byte, 0, len(batches))
+	for _, batch := range batches {
+		sigShare, errCreate := sr.signingHandler.ShallowClone().CreateSignatureShareForPublicKey(batch.Hash, selfIndex, header.GetEpoch(), selfPubKey)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		batchesSigShares = append(batchesSigShares, sigShare)
+	}
+
+	sigShares, err := outgoingBatches.MarshalOutGoingOperationsSignatures(batchesSigShares)
+	if err != nil {
+		return nil, err
+	}
+
+	// all batches signature shares are stored together, they will be split for each batch when aggregating signatures
+	err = sr.signingHandler.StoreSignatureShare(selfIndex, sigShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigShares, nil
+}
+
+func (sr *sovereignSubRoundSignatureOutGoingTxData) recordInSigningJournal(
+	header data.HeaderHandler,
+	selfPubKey []byte,
+	batches []*outgoingBatches.OutGoingOperationsBatch,
+) error {
+	if len(batches) == 0 {
+		return nil
+	}
+
+	batchesHashes := make([]byte, 0, len(batches)*len(batches[0].Hash))
+	for _, batch := range batches {
+		batchesHashes = append(batchesHashes, batch.Hash...)
+	}
 
-	return sr.signingHandler.CreateSignatureShareForPublicKey(
-		outGoingMBHeader.GetOutGoingOperationsHash(),
-		selfIndex,
-		header.GetEpoch(),
-		selfPubKey)
+	return sr.signingJournal.CheckAndRecordExtraData(sr.Identifier(), selfPubKey, int64(header.GetRound()), header.GetEpoch(), batchesHashes)
 }
 
-// AddSigShareToConsensusMessage adds the provided sig share for outgoing tx data to the consensus message
+// AddSigShareToConsensusMessage adds the provided sig share for outgoing tx data to the consensus message. A single
+// signature share is sent in the legacy field, while the signature shares of multiple batches are sent in the
+// repeated field, in batches order.
 func (sr *sovereignSubRoundSignatureOutGoingTxData) AddSigShareToConsensusMessage(sigShare []byte, cnsMsg *consensus.Message) error {
 	if cnsMsg == nil {
 		return errors.ErrNilConsensusMessage
 	}
 
-	if len(sigShare) != 0 {
-		cnsMsg.SignatureShareOutGoingTxData = sigShare
+	if len(sigShare) == 0 {
+		return nil
+	}
+
+	batchesSigShares := &outgoingBatches.OutGoingOperationsSignatures{}
+	err := batchesSigShares.Unmarshal(sigShare)
+	if err != nil {
+		return err
+	}
+
+	switch len(batchesSigShares.Signatures) {
+	case 0:
+	case 1:
+		cnsMsg.SignatureShareOutGoingTxData = batchesSigShares.Signatures[0]
+	default:
+		cnsMsg.SignatureSharesOutGoingTxData = batchesSigShares.Signatures
 	}
 
 	return nil
@@ -67,11 +147,21 @@ func (sr *sovereignSubRoundSignatureOutGoingTxData) StoreSignatureShare(index ui
 		return errors.ErrNilConsensusMessage
 	}
 
-	if len(cnsMsg.SignatureShareOutGoingTxData) == 0 {
-		return nil
+	batchesSigShares := cnsMsg.SignatureSharesOutGoingTxData
+	if len(batchesSigShares) == 0 {
+		if len(cnsMsg.SignatureShareOutGoingTxData) == 0 {
+			return nil
+		}
+
+		batchesSigShares = [][]byte{cnsMsg.SignatureShareOutGoingTxData}
+	}
+
+	sigShares, err := outgoingBatches.MarshalOutGoingOperationsSignatures(batchesSigShares)
+	if err != nil {
+		return err
 	}
 
-	return sr.signingHandler.StoreSignatureShare(index, cnsMsg.SignatureShareOutGoingTxData)
+	return sr.signingHandler.StoreSignatureShare(index, sigShares)
 }
 
 // Identifier returns the unique id of the signer
@@ -6,23 +6,62 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 	cnsTest "github.com/multiversx/mx-chain-go/testscommon/consensus"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/require"
 )
 
+func createSovereignHeaderWithBatches(epoch uint32, batchesHashes ...[]byte) *block.SovereignChainHeader {
+	batches := make([]*outgoingBatches.OutGoingOperationsBatch, 0, len(batchesHashes))
+	for _, batchHash := range batchesHashes {
+		batches = append(batches, &outgoingBatches.OutGoingOperationsBatch{Hash: batchHash})
+	}
+
+	sovHdr := &block.SovereignChainHeader{
+		Header: &block.Header{
+			Epoch: epoch,
+		},
+		OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
+			OutGoingOperationsHash: []byte("hashOfBatches"),
+		},
+	}
+	_ = outgoingBatches.SetOutGoingOperationsBatches(sovHdr, batches)
+
+	return sovHdr
+}
+
+func createOutGoingOperationsSignatures(signatures ...[]byte) []byte {
+	outGoingOperationsSignatures, _ := outgoingBatches.MarshalOutGoingOperationsSignatures(signatures)
+	return outGoingOperationsSignatures
+}
+
 func TestNewSovereignSubRoundSignatureOutGoingTxData(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil signing handler, should return error", func(t *testing.T) {
-		sovSigHandler, err := NewSovereignSubRoundSignatureOutGoingTxData(nil)
+		sovSigHandler, err := NewSovereignSubRoundSignatureOutGoingTxData(nil, &sovereign.BridgePauseHandlerMock{}, &cnsTest.SigningJournalStub{})
 		require.Equal(t, spos.ErrNilSigningHandler, err)
 		require.True(t, check.IfNil(sovSigHandler))
 	})
 
+	t.Run("nil bridge pause handler, should return error", func(t *testing.T) {
+		sovSigHandler, err := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{}, nil, &cnsTest.SigningJournalStub{})
+		require.Equal(t, errors.ErrNilBridgePauseHandler, err)
+		require.True(t, check.IfNil(sovSigHandler))
+	})
+
+	t.Run("nil signing journal, should return error", func(t *testing.T) {
+		sovSigHandler, err := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{}, &sovereign.BridgePauseHandlerMock{}, nil)
+		require.Equal(t, spos.ErrNilSigningJournal, err)
+		require.True(t, check.IfNil(sovSigHandler))
+	})
+
 	t.Run("should work", func(t *testing.T) {
-		sovSigHandler, err := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{})
+		sovSigHandler, err := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{}, &sovereign.BridgePauseHandlerMock{}, &cnsTest.SigningJournalStub{})
 		require.Nil(t, err)
 		require.False(t, sovSigHandler.IsInterfaceNil())
 	})
@@ -46,7 +85,7 @@ func TestSovereignSubRoundSignatureOutGoingTxData_CreateSignatureShare(t *testin
 
 	expectedSigShare := []byte("sigShare")
 	createSigShareCt := 0
-	signingHandler := &cnsTest.SigningHandlerStub{
+	clonedSigningHandler := &cnsTest.SigningHandlerStub{
 		CreateSignatureShareForPublicKeyCalled: func(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
 			require.Equal(t, outGoingOpHash, message)
 			require.Equal(t, selfIndex, index)
@@ -57,7 +96,18 @@ func TestSovereignSubRoundSignatureOutGoingTxData_CreateSignatureShare(t *testin
 			return expectedSigShare, nil
 		},
 	}
-	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(signingHandler)
+	signingHandler := &cnsTest.SigningHandlerStub{
+		ShallowCloneCalled: func() consensus.SigningHandler {
+			return clonedSigningHandler
+		},
+	}
+	isOutGoingPaused := false
+	bridgePauseHandler := &sovereign.BridgePauseHandlerMock{
+		IsOutGoingPausedCalled: func() bool {
+			return isOutGoingPaused
+		},
+	}
+	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(signingHandler, bridgePauseHandler, &cnsTest.SigningJournalStub{})
 
 	t.Run("invalid header type, should return error", func(t *testing.T) {
 		sigShare, err := sovSigHandler.CreateSignatureShare(sovHdr.Header, selfIndex, selfPubKey)
@@ -74,67 +124,230 @@ func TestSovereignSubRoundSignatureOutGoingTxData_CreateSignatureShare(t *testin
 		require.Nil(t, err)
 	})
 
+	t.Run("invalid outgoing operations batches, should return error", func(t *testing.T) {
+		sovHdrCopy := *sovHdr
+		sovHdrCopy.Header = &block.Header{
+			Reserved: []byte("reserved"),
+		}
+
+		sigShare, err := sovSigHandler.CreateSignatureShare(&sovHdrCopy, selfIndex, selfPubKey)
+		require.Nil(t, sigShare)
+		require.ErrorIs(t, err, outgoingBatches.ErrInvalidOutGoingOperationsBatches)
+	})
+
 	t.Run("should create sig share", func(t *testing.T) {
 		sigShare, err := sovSigHandler.CreateSignatureShare(sovHdr, selfIndex, selfPubKey)
-		require.Equal(t, expectedSigShare, sigShare)
+		require.Equal(t, createOutGoingOperationsSignatures(expectedSigShare), sigShare)
+		require.Nil(t, err)
+		require.Equal(t, 1, createSigShareCt)
+	})
+
+	t.Run("outgoing bridge paused, should not sign", func(t *testing.T) {
+		isOutGoingPaused = true
+		defer func() {
+			isOutGoingPaused = false
+		}()
+
+		sigShare, err := sovSigHandler.CreateSignatureShare(sovHdr, selfIndex, selfPubKey)
+		require.Nil(t, sigShare)
+		require.Equal(t, errors.ErrOutGoingBridgePaused, err)
+
+		sovHdrCopy := *sovHdr
+		sovHdrCopy.OutGoingMiniBlockHeader = nil
+		sigShare, err = sovSigHandler.CreateSignatureShare(&sovHdrCopy, selfIndex, selfPubKey)
+		require.Empty(t, sigShare)
+		require.Nil(t, err)
+	})
+
+	t.Run("outgoing bridge paused, should sign the validator set rotation of an epoch start block", func(t *testing.T) {
+		isOutGoingPaused = true
+		defer func() {
+			isOutGoingPaused = false
+		}()
+
+		sovHdrCopy := *sovHdr
+		sovHdrCopy.IsStartOfEpoch = true
+		createSigShareCt = 0
+		sigShare, err := sovSigHandler.CreateSignatureShare(&sovHdrCopy, selfIndex, selfPubKey)
+		require.Equal(t, createOutGoingOperationsSignatures(expectedSigShare), sigShare)
 		require.Nil(t, err)
 		require.Equal(t, 1, createSigShareCt)
 	})
 }
 
-func TestSovereignSubRoundSignatureOutGoingTxData_AddSigShareToConsensusMessage(t *testing.T) {
+func TestSovereignSubRoundSignatureOutGoingTxData_CreateSignatureShareMultipleBatches(t *testing.T) {
 	t.Parallel()
 
-	cnsMsg := &consensus.Message{
-		SignatureShare: []byte("sigShare"),
+	batch1Hash := []byte("batch1Hash")
+	batch2Hash := []byte("batch2Hash")
+	sovHdr := createSovereignHeaderWithBatches(3, batch1Hash, batch2Hash)
+	selfPubKey := []byte("pubKey")
+	selfIndex := uint16(4)
+
+	signedHashes := make([][]byte, 0)
+	clonedSigningHandler := &cnsTest.SigningHandlerStub{
+		CreateSignatureShareForPublicKeyCalled: func(message []byte, index uint16, epoch uint32, publicKeyBytes []byte) ([]byte, error) {
+			require.Equal(t, selfIndex, index)
+			require.Equal(t, selfPubKey, publicKeyBytes)
+			require.Equal(t, sovHdr.GetEpoch(), epoch)
+
+			signedHashes = append(signedHashes, message)
+			return append([]byte("sig_"), message...), nil
+		},
 	}
 
-	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{})
+	expectedSigShares := createOutGoingOperationsSignatures([]byte("sig_batch1Hash"), []byte("sig_batch2Hash"))
+	wasSigStored := false
+	signingHandler := &cnsTest.SigningHandlerStub{
+		ShallowCloneCalled: func() consensus.SigningHandler {
+			return clonedSigningHandler
+		},
+		StoreSignatureShareCalled: func(index uint16, sig []byte) error {
+			require.Equal(t, selfIndex, index)
+			require.Equal(t, expectedSigShares, sig)
 
-	err := sovSigHandler.AddSigShareToConsensusMessage([]byte("sigShareOutGoingTxData"), nil)
-	require.Equal(t, errors.ErrNilConsensusMessage, err)
+			wasSigStored = true
+			return nil
+		},
+	}
+	wasRecorded := false
+	signingJournal := &cnsTest.SigningJournalStub{
+		CheckAndRecordExtraDataCalled: func(identifier string, pubKey []byte, round int64, epoch uint32, dataHash []byte) error {
+			require.Equal(t, "sovereignSubRoundSignatureOutGoingTxData", identifier)
+			require.Equal(t, selfPubKey, pubKey)
+			require.Equal(t, int64(sovHdr.GetRound()), round)
+			require.Equal(t, sovHdr.GetEpoch(), epoch)
+			require.Equal(t, append(append([]byte(nil), batch1Hash...), batch2Hash...), dataHash)
+			require.Empty(t, signedHashes)
 
-	err = sovSigHandler.AddSigShareToConsensusMessage([]byte("sigShareOutGoingTxData"), cnsMsg)
+			wasRecorded = true
+			return nil
+		},
+	}
+	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(signingHandler, &sovereign.BridgePauseHandlerMock{}, signingJournal)
+
+	sigShares, err := sovSigHandler.CreateSignatureShare(sovHdr, selfIndex, selfPubKey)
 	require.Nil(t, err)
-	require.Equal(t, &consensus.Message{
-		SignatureShare:               []byte("sigShare"),
-		SignatureShareOutGoingTxData: []byte("sigShareOutGoingTxData"),
-	}, cnsMsg)
+	require.Equal(t, expectedSigShares, sigShares)
+	require.Equal(t, [][]byte{batch1Hash, batch2Hash}, signedHashes)
+	require.True(t, wasSigStored)
+	require.True(t, wasRecorded)
 }
 
-func TestSovereignSubRoundSignatureOutGoingTxData_StoreSignatureShare(t *testing.T) {
+func TestSovereignSubRoundSignatureOutGoingTxData_CreateSignatureShareRefusedBySigningJournal(t *testing.T) {
 	t.Parallel()
 
-	cnsMsg := &consensus.Message{
-		SignatureShare:               []byte("sigShare"),
-		SignatureShareOutGoingTxData: []byte("sigShareOutGoingTxData"),
+	sovHdr := createSovereignHeaderWithBatches(3, []byte("batch1Hash"))
+	clonedSigningHandler := &cnsTest.SigningHandlerStub{
+		CreateSignatureShareForPublicKeyCalled: func(_ []byte, _ uint16, _ uint32, _ []byte) ([]byte, error) {
+			require.Fail(t, "should not sign")
+			return nil, nil
+		},
+	}
+	signingHandler := &cnsTest.SigningHandlerStub{
+		ShallowCloneCalled: func() consensus.SigningHandler {
+			return clonedSigningHandler
+		},
 	}
+	expectedErr := slashingProtection.ErrConflictingSigningData
+	signingJournal := &cnsTest.SigningJournalStub{
+		CheckAndRecordExtraDataCalled: func(_ string, _ []byte, _ int64, _ uint32, _ []byte) error {
+			return expectedErr
+		},
+	}
+	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(signingHandler, &sovereign.BridgePauseHandlerMock{}, signingJournal)
+
+	sigShares, err := sovSigHandler.CreateSignatureShare(sovHdr, 4, []byte("pubKey"))
+	require.Nil(t, sigShares)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestSovereignSubRoundSignatureOutGoingTxData_AddSigShareToConsensusMessage(t *testing.T) {
+	t.Parallel()
+
+	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{}, &sovereign.BridgePauseHandlerMock{}, &cnsTest.SigningJournalStub{})
+
+	t.Run("nil consensus message, should return error", func(t *testing.T) {
+		err := sovSigHandler.AddSigShareToConsensusMessage(createOutGoingOperationsSignatures([]byte("sigShareOutGoingTxData")), nil)
+		require.Equal(t, errors.ErrNilConsensusMessage, err)
+	})
+
+	t.Run("invalid sig share, should return error", func(t *testing.T) {
+		err := sovSigHandler.AddSigShareToConsensusMessage([]byte("sigShareOutGoingTxData"), &consensus.Message{})
+		require.NotNil(t, err)
+	})
+
+	t.Run("single batch should add the sig share in the legacy field", func(t *testing.T) {
+		cnsMsg := &consensus.Message{
+			SignatureShare: []byte("sigShare"),
+		}
+
+		err := sovSigHandler.AddSigShareToConsensusMessage(createOutGoingOperationsSignatures([]byte("sigShareOutGoingTxData")), cnsMsg)
+		require.Nil(t, err)
+		require.Equal(t, &consensus.Message{
+			SignatureShare:               []byte("sigShare"),
+			SignatureShareOutGoingTxData: []byte("sigShareOutGoingTxData"),
+		}, cnsMsg)
+	})
+
+	t.Run("multiple batches should add the sig shares in the repeated field", func(t *testing.T) {
+		cnsMsg := &consensus.Message{
+			SignatureShare: []byte("sigShare"),
+		}
+
+		err := sovSigHandler.AddSigShareToConsensusMessage(createOutGoingOperationsSignatures([]byte("sigShare1"), []byte("sigShare2")), cnsMsg)
+		require.Nil(t, err)
+		require.Equal(t, &consensus.Message{
+			SignatureShare:                []byte("sigShare"),
+			SignatureSharesOutGoingTxData: [][]byte{[]byte("sigShare1"), []byte("sigShare2")},
+		}, cnsMsg)
+	})
+}
+
+func TestSovereignSubRoundSignatureOutGoingTxData_StoreSignatureShare(t *testing.T) {
+	t.Parallel()
 
 	expectedIdx := uint16(4)
-	wasSigStored := false
+	storedSigShares := make([][]byte, 0)
 	signHandler := &cnsTest.SigningHandlerStub{
 		StoreSignatureShareCalled: func(index uint16, sig []byte) error {
 			require.Equal(t, expectedIdx, index)
-			require.Equal(t, cnsMsg.SignatureShareOutGoingTxData, sig)
 
-			wasSigStored = true
+			storedSigShares = append(storedSigShares, sig)
 			return nil
 		},
 	}
 
-	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(signHandler)
+	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(signHandler, &sovereign.BridgePauseHandlerMock{}, &cnsTest.SigningJournalStub{})
 
 	err := sovSigHandler.StoreSignatureShare(expectedIdx, nil)
 	require.Equal(t, errors.ErrNilConsensusMessage, err)
 
-	err = sovSigHandler.StoreSignatureShare(expectedIdx, cnsMsg)
+	err = sovSigHandler.StoreSignatureShare(expectedIdx, &consensus.Message{SignatureShare: []byte("sigShare")})
 	require.Nil(t, err)
-	require.True(t, wasSigStored)
+	require.Empty(t, storedSigShares)
+
+	err = sovSigHandler.StoreSignatureShare(expectedIdx, &consensus.Message{
+		SignatureShare:               []byte("sigShare"),
+		SignatureShareOutGoingTxData: []byte("sigShareOutGoingTxData"),
+	})
+	require.Nil(t, err)
+
+	err = sovSigHandler.StoreSignatureShare(expectedIdx, &consensus.Message{
+		SignatureShare:                []byte("sigShare"),
+		SignatureSharesOutGoingTxData: [][]byte{[]byte("sigShare1"), []byte("sigShare2")},
+	})
+	require.Nil(t, err)
+
+	require.Equal(t, [][]byte{
+		createOutGoingOperationsSignatures([]byte("sigShareOutGoingTxData")),
+		createOutGoingOperationsSignatures([]byte("sigShare1"), []byte("sigShare2")),
+	}, storedSigShares)
 }
 
 func TestSovereignSubRoundSignatureOutGoingTxData_Identifier(t *testing.T) {
 	t.Parallel()
 
-	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{})
+	sovSigHandler, _ := NewSovereignSubRoundSignatureOutGoingTxData(&cnsTest.SigningHandlerStub{}, &sovereign.BridgePauseHandlerMock{}, &cnsTest.SigningJournalStub{})
 	require.Equal(t, "sovereignSubRoundSignatureOutGoingTxData", sovSigHandler.Identifier())
 }
@@ -180,6 +180,20 @@ func (sr *subroundBlock) sendBlock(header data.HeaderHandler, body data.BodyHand
 		return false
 	}
 
+	leader, err := sr.GetLeader()
+	if err != nil {
+		log.Debug("sendBlock.GetLeader", "error", err.Error())
+		return false
+	}
+
+	// the leader must not propose a different block in a round for which it already proposed or signed one
+	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
+	err = sr.SigningJournal().CheckAndRecord([]byte(leader), sr.RoundHandler().Index(), header.GetEpoch(), headerHash)
+	if err != nil {
+		log.Debug("sendBlock.SigningJournal.CheckAndRecord", "error", err.Error())
+		return false
+	}
+
 	if sr.couldBeSentTogether(marshalizedBody, marshalizedHeader) {
 		return sr.sendHeaderAndBlockBody(header, body, marshalizedBody, marshalizedHeader)
 	}
@@ -903,6 +903,11 @@ func (sr *subroundEndRound) signBlockHeader() ([]byte, []byte, error) {
 	}
 
 	leaderPubKey := []byte(leader)
+	err = sr.SigningJournal().CheckAndRecord(leaderPubKey, sr.RoundHandler().Index(), sr.Header.GetEpoch(), sr.GetData())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	leaderSignature, err := sr.SigningHandler().CreateSignatureForPublicKey(marshalizedHdr, leaderPubKey)
 	if err != nil {
 		return nil, nil, err
@@ -99,8 +99,14 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 			return false
 		}
 
-		processedHeaderHash := sr.getMessageToSignFunc()
 		selfPubKey := []byte(sr.SelfPubKey())
+		err = sr.SigningJournal().CheckAndRecord(selfPubKey, sr.RoundHandler().Index(), sr.Header.GetEpoch(), sr.GetData())
+		if err != nil {
+			log.Debug("doSignatureJob.SigningJournal.CheckAndRecord", "error", err.Error())
+			return false
+		}
+
+		processedHeaderHash := sr.getMessageToSignFunc()
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			processedHeaderHash,
 			uint16(selfIndex),
@@ -426,6 +432,12 @@ func (sr *subroundSignature) doSignatureJobForManagedKeys() bool {
 			continue
 		}
 
+		err = sr.SigningJournal().CheckAndRecord(pkBytes, sr.RoundHandler().Index(), sr.Header.GetEpoch(), sr.GetData())
+		if err != nil {
+			log.Debug("doSignatureJobForManagedKeys.SigningJournal.CheckAndRecord", "pk", pkBytes, "error", err.Error())
+			continue
+		}
+
 		processedHeaderHash := sr.getMessageToSignFunc()
 		signatureShare, err := sr.SigningHandler().CreateSignatureShareForPublicKey(
 			processedHeaderHash,
@@ -35,6 +35,7 @@ func initConsensusDataContainer() *ConsensusCore {
 	peerBlacklistHandler := &mock.PeerBlacklistHandlerStub{}
 	multiSignerContainer := cryptoMocks.NewMultiSignerContainerMock(multiSignerMock)
 	signingHandler := &consensusMocks.SigningHandlerStub{}
+	signingJournal := &consensusMocks.SigningJournalStub{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -58,6 +59,7 @@ func initConsensusDataContainer() *ConsensusCore {
 		messageSigningHandler:   messageSigningHandler,
 		peerBlacklistHandler:    peerBlacklistHandler,
 		signingHandler:          signingHandler,
+		signingJournal:          signingJournal,
 	}
 }
 
@@ -259,6 +261,17 @@ func TestConsensusContainerValidator_ValidateNilSignatureHandlerShouldFail(t *te
 	assert.Equal(t, ErrNilSigningHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilSigningJournalShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.signingJournal = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilSigningJournal, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +38,7 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		MessageSigningHandler:         consensusCoreMock.MessageSigningHandler(),
 		PeerBlacklistHandler:          consensusCoreMock.PeerBlacklistHandler(),
 		SigningHandler:                consensusCoreMock.SigningHandler(),
+		SigningJournal:                consensusCoreMock.SigningJournal(),
 	}
 	return args
 }
@@ -334,6 +335,20 @@ func TestConsensusCore_WithNilPeerBlacklistHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPeerBlacklistHandler, err)
 }
 
+func TestConsensusCore_WithNilSigningJournalShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.SigningJournal = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilSigningJournal, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -235,6 +235,12 @@ var ErrNilSignatureOnP2PMessage = errors.New("nil signature on the p2p message")
 // ErrNilSigningHandler signals that provided signing handler is nil
 var ErrNilSigningHandler = errors.New("nil signing handler")
 
+// ErrNilSigningJournal signals that a nil signing journal has been provided
+var ErrNilSigningJournal = errors.New("nil signing journal")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
 // ErrNilKeysHandler signals that a nil keys handler was provided
 var ErrNilKeysHandler = errors.New("nil keys handler")
 
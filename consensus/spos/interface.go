@@ -65,6 +65,8 @@ type ConsensusCoreHandler interface {
 	PeerBlacklistHandler() consensus.PeerBlacklistHandler
 	// SigningHandler returns the signing handler component
 	SigningHandler() consensus.SigningHandler
+	// SigningJournal returns the component which keeps track of the data signed by each consensus key
+	SigningJournal() consensus.SigningJournal
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
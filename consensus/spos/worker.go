@@ -81,6 +81,7 @@ type Worker struct {
 	peerBlacklistHandler      consensus.PeerBlacklistHandler
 	closer                    core.SafeCloser
 	enableEpochHandler        common.EnableEpochsHandler
+	equivocationDetector      consensus.EquivocationDetector
 }
 
 // WorkerArgs holds the consensus worker arguments
@@ -111,6 +112,7 @@ type WorkerArgs struct {
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
 	PeerBlacklistHandler     consensus.PeerBlacklistHandler
 	EnableEpochHandler       common.EnableEpochsHandler
+	EquivocationDetector     consensus.EquivocationDetector
 }
 
 // NewWorker creates a new Worker object
@@ -161,6 +163,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		peerBlacklistHandler:     args.PeerBlacklistHandler,
 		closer:                   closing.NewSafeChanCloser(),
 		enableEpochHandler:       args.EnableEpochHandler,
+		equivocationDetector:     args.EquivocationDetector,
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidatorObj
@@ -264,6 +267,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.EnableEpochHandler) {
 		return ErrNilEnableEpochHandler
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
 
 	return nil
 }
@@ -407,6 +413,7 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	)
 
 	err = wrk.consensusMessageValidator.checkConsensusMessageValidity(cnsMsg, message.Peer())
+	wrk.checkEquivocation(cnsMsg, message, err)
 	if err != nil {
 		return err
 	}
@@ -445,6 +452,26 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	return nil
 }
 
+// checkEquivocation passes the message to the equivocation detector. The messages over the limit of their type are
+// also passed, as a validator sending conflicting messages in the same round reaches exactly this limit
+func (wrk *Worker) checkEquivocation(cnsMsg *consensus.Message, message p2p.MessageP2P, validityErr error) {
+	if validityErr != nil && !errors.Is(validityErr, ErrMessageTypeLimitReached) {
+		return
+	}
+
+	msgType := consensus.MessageType(cnsMsg.MsgType)
+	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
+	isMessageWithBlockBodyAndHeader := wrk.consensusService.IsMessageWithBlockBodyAndHeader(msgType)
+	if isMessageWithBlockHeader || isMessageWithBlockBodyAndHeader {
+		wrk.equivocationDetector.CheckProposal(cnsMsg, message)
+		return
+	}
+
+	if wrk.consensusService.IsMessageWithSignature(msgType) {
+		wrk.equivocationDetector.CheckSignature(cnsMsg, message)
+	}
+}
+
 func (wrk *Worker) shouldBlacklistPeer(err error) bool {
 	if err == nil ||
 		errors.Is(err, ErrMessageForPastRound) ||
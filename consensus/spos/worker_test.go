@@ -27,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
@@ -118,6 +119,7 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
 		PeerBlacklistHandler:     &mock.PeerBlacklistHandlerStub{},
 		EnableEpochHandler:       &enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+		EquivocationDetector:     &consensusMocks.EquivocationDetectorStub{},
 	}
 
 	return workerArgs
@@ -405,6 +407,17 @@ func TestNewWorker_NilEnableEpochHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilEnableEpochHandler, err)
 }
 
+func TestNewWorker_NilEquivocationDetectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
 func TestNewWorker_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1085,6 +1098,69 @@ func TestWorker_ProcessReceivedMessageTypeLimitReachedShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
 }
 
+func TestWorker_ProcessReceivedMessageShouldCheckEquivocationEvenIfTypeLimitReached(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	checkedSignatures := make([]*consensus.Message, 0)
+	workerArgs.EquivocationDetector = &consensusMocks.EquivocationDetectorStub{
+		CheckProposalCalled: func(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P) {
+			assert.Fail(t, "should not have been called")
+		},
+		CheckSignatureCalled: func(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P) {
+			checkedSignatures = append(checkedSignatures, cnsMsg)
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	createSignatureMessage := func(headerHash []byte) p2p.MessageP2P {
+		cnsMsg := consensus.NewConsensusMessage(
+			headerHash,
+			signature,
+			nil,
+			nil,
+			[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+			signature,
+			int(bls.MtSignature),
+			0,
+			chainID,
+			nil,
+			nil,
+			nil,
+			currentPid,
+			nil,
+			nil,
+		)
+		buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+		return &p2pmocks.P2PMessageMock{
+			DataField:      buff,
+			PeerField:      currentPid,
+			SignatureField: []byte("signature"),
+		}
+	}
+
+	headerHashes := [][]byte{
+		bytes.Repeat([]byte("a"), HashSize),
+		bytes.Repeat([]byte("b"), HashSize),
+		bytes.Repeat([]byte("c"), HashSize),
+	}
+	maxSignatureMessages := int(workerArgs.ConsensusService.GetMaxNumOfMessageTypeAccepted(bls.MtSignature))
+	for i := 0; i < maxSignatureMessages; i++ {
+		err := wrk.ProcessReceivedMessage(createSignatureMessage(headerHashes[i]), fromConnectedPeerId, &p2pmocks.MessengerStub{})
+		assert.Nil(t, err)
+	}
+
+	lastHeaderHash := headerHashes[maxSignatureMessages]
+	err := wrk.ProcessReceivedMessage(createSignatureMessage(lastHeaderHash), fromConnectedPeerId, &p2pmocks.MessengerStub{})
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	require.Len(t, checkedSignatures, maxSignatureMessages+1)
+	for i, cnsMsg := range checkedSignatures {
+		assert.Equal(t, headerHashes[i], cnsMsg.HeaderHash)
+	}
+}
+
 func TestWorker_ProcessReceivedMessageInvalidSignatureShouldErr(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
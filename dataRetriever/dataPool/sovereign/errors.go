@@ -5,3 +5,7 @@ import "errors"
 var errHashOfHashesNotFound = errors.New("hash of hashes in bridge operations pool not found")
 
 var errHashOfBridgeOpNotFound = errors.New("hash of bridge operation not found in pool")
+
+var errNilStorer = errors.New("nil storer provided")
+
+var errDeadLetteredOperationsNotFound = errors.New("dead-lettered outgoing operations not found in pool")
@@ -1,16 +1,30 @@
 package sovereign
 
 import (
+	"time"
+
 	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 // OutGoingOperationsPool defines the behavior of a timed cache for outgoing operations
 type OutGoingOperationsPool interface {
 	Add(data *sovereignCore.BridgeOutGoingData)
+	AddWithDestination(data *sovereignCore.BridgeOutGoingData, destination string)
 	Get(hash []byte) *sovereignCore.BridgeOutGoingData
+	GetByOperationHash(hash []byte) *sovereignCore.BridgeOutGoingData
+	GetDestination(hash []byte) string
+	SetSignatures(hash []byte, leaderSignature []byte, aggregatedSignature []byte) error
 	Delete(hash []byte)
 	GetUnconfirmedOperations() []*sovereignCore.BridgeOutGoingData
-	ResetTimer(hashes [][]byte)
+	ProcessUnconfirmedOperations(currentTime time.Time)
+	GetDeadLetteredOperations() []*sovereignCore.BridgeOutGoingData
+	GetPendingOperations() map[string][]*sovereignCore.BridgeOutGoingData
+	RequeueDeadLetteredOperations(hash []byte) error
+	DropDeadLetteredOperations(hash []byte) error
 	ConfirmOperation(hashOfHashes []byte, hash []byte) error
+	SetStorer(storer storage.Storer, marshaller marshal.Marshalizer) error
 	IsInterfaceNil() bool
 }
@@ -8,15 +8,44 @@ import (
 	"sync"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/disabled"
 )
 
 var log = logger.GetOrCreate("outgoing-operations-pool")
 
 type cacheEntry struct {
-	data     *sovereign.BridgeOutGoingData
-	expireAt time.Time
+	data         *sovereign.BridgeOutGoingData
+	destination  string
+	isScheduled  bool
+	expireAt     time.Time
+	sendAttempts uint32
+	dueForResend bool
+}
+
+// storedEntry is the persisted form of an outgoing operations entry, which also holds the destination of the operations,
+// the number of send attempts and whether the operations were moved to the dead-letter store
+type storedEntry struct {
+	Destination  string
+	Data         *sovereign.BridgeOutGoingData
+	SendAttempts uint32
+	DeadLettered bool
+}
+
+// RetryPolicy defines how unconfirmed outgoing operations are resent
+type RetryPolicy struct {
+	// MaxTimeToWait is the upper limit of the time to wait for the confirmation of an operation, which doubles after
+	// each send attempt. If it is not greater than the initial time to wait, the time to wait is not increased.
+	MaxTimeToWait time.Duration
+	// MaxSendAttempts is the number of send attempts after which unconfirmed operations are moved to the dead-letter
+	// store. If set to 0, operations are resent until confirmed.
+	MaxSendAttempts uint32
 }
 
 // This is a cache which stores outgoing txs data at their specified hash.
@@ -24,30 +53,150 @@ type cacheEntry struct {
 // is received that the outgoing operation has been sent to main chain.
 // An unconfirmed operation is a tx data operation which has been stored in cache for longer than the time to wait for
 // unconfirmed outgoing operations.
-// The leader of the next round should check if there are any unconfirmed operations and try to resend them.
+// Unconfirmed operations are processed by every node when a block is committed, against the block timestamp, so that all
+// the nodes agree on the send attempts of each operation, regardless of which of them was the leader. The first send
+// attempt is made by the leader of the block which created the operations, after which the time to wait starts. Each
+// expired operation is due to be resent by the leader of the next block, counting one more send attempt, and the time to
+// wait for its confirmation doubles after each send attempt, up to the max time to wait from the retry policy.
+// Operations still unconfirmed after the max number of send attempts are moved to a dead-letter store, from which they
+// are no longer resent, until they are re-queued or dropped.
+// Once a storer is set, every entry is also persisted, so that unconfirmed operations are not lost after a node restart.
 type outGoingOperationsPool struct {
-	mutex   sync.RWMutex
-	timeout time.Duration
-	cache   map[string]*cacheEntry
+	mutex       sync.RWMutex
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	cache       map[string]*cacheEntry
+	deadLetter  map[string]*cacheEntry
+	storer      storage.Storer
+	marshaller  marshal.Marshalizer
 }
 
-// NewOutGoingOperationPool creates a new outgoing operation pool able to store data with an expiry time
+// NewOutGoingOperationPool creates a new outgoing operation pool able to store data with an expiry time. Unconfirmed
+// operations are resent after the same expiry time, until confirmed.
 func NewOutGoingOperationPool(expiryTime time.Duration) *outGoingOperationsPool {
-	log.Debug("NewOutGoingOperationPool", "time to wait for unconfirmed outgoing operations", expiryTime)
+	return NewOutGoingOperationPoolWithRetryPolicy(expiryTime, RetryPolicy{})
+}
+
+// NewOutGoingOperationPoolWithRetryPolicy creates a new outgoing operation pool able to store data with an expiry time,
+// which resends unconfirmed operations based on the provided retry policy
+func NewOutGoingOperationPoolWithRetryPolicy(expiryTime time.Duration, retryPolicy RetryPolicy) *outGoingOperationsPool {
+	log.Debug("NewOutGoingOperationPool",
+		"time to wait for unconfirmed outgoing operations", expiryTime,
+		"max time to wait for unconfirmed outgoing operations", retryPolicy.MaxTimeToWait,
+		"max send attempts", retryPolicy.MaxSendAttempts,
+	)
 
 	return &outGoingOperationsPool{
-		timeout: expiryTime,
-		cache:   map[string]*cacheEntry{},
+		timeout:     expiryTime,
+		retryPolicy: retryPolicy,
+		cache:       map[string]*cacheEntry{},
+		deadLetter:  map[string]*cacheEntry{},
+		storer:      disabled.NewStorer(),
+	}
+}
+
+// SetStorer sets the storer in which outgoing operations are persisted and reloads all the operations previously saved
+// in it. Reloaded operations will be considered unconfirmed once their time to wait passes, starting from the first
+// committed block, so that a leader will try to resend them. Operations which are already in the pool are also saved in
+// the new storer.
+func (op *outGoingOperationsPool) SetStorer(storer storage.Storer, marshaller marshal.Marshalizer) error {
+	if check.IfNil(storer) {
+		return errNilStorer
+	}
+	if check.IfNil(marshaller) {
+		return core.ErrNilMarshalizer
+	}
+
+	op.mutex.Lock()
+	defer op.mutex.Unlock()
+
+	op.storer = storer
+	op.marshaller = marshaller
+
+	for _, entry := range op.cache {
+		op.saveInStorer(entry, false)
+	}
+	for _, entry := range op.deadLetter {
+		op.saveInStorer(entry, true)
+	}
+
+	numLoadedOperations := 0
+	numLoadedDeadLetteredOperations := 0
+	storer.RangeKeys(func(key []byte, val []byte) bool {
+		if op.exists(string(key)) {
+			return true
+		}
+
+		entry, deadLettered, err := unmarshalStoredEntry(marshaller, val)
+		if err != nil {
+			log.Error("outGoingOperationsPool.SetStorer: could not unmarshal stored outgoing operations",
+				"hash", hex.EncodeToString(key), "error", err)
+			return true
+		}
+
+		if deadLettered {
+			op.deadLetter[string(key)] = entry
+			numLoadedDeadLetteredOperations++
+			return true
+		}
+
+		op.cache[string(key)] = entry
+		numLoadedOperations++
+		return true
+	})
+
+	log.Debug("outGoingOperationsPool.SetStorer",
+		"num loaded outgoing operations", numLoadedOperations,
+		"num loaded dead-lettered outgoing operations", numLoadedDeadLetteredOperations,
+	)
+	return nil
+}
+
+// unmarshalStoredEntry unmarshalls a persisted entry and returns whether it was dead-lettered. Entries persisted before
+// destinations were introduced only hold the outgoing operations, hence they are loaded for the default destination.
+func unmarshalStoredEntry(marshaller marshal.Marshalizer, buff []byte) (*cacheEntry, bool, error) {
+	stored := &storedEntry{}
+	err := marshaller.Unmarshal(stored, buff)
+	if err == nil && stored.Data != nil {
+		return &cacheEntry{
+			data:         stored.Data,
+			destination:  stored.Destination,
+			sendAttempts: stored.SendAttempts,
+		}, stored.DeadLettered, nil
+	}
+
+	data := &sovereign.BridgeOutGoingData{}
+	err = marshaller.Unmarshal(data, buff)
+	if err != nil {
+		return nil, false, err
 	}
+
+	return &cacheEntry{
+		data: data,
+	}, false, nil
 }
 
-// Add adds the outgoing txs data at the specified hash in the internal cache
+func (op *outGoingOperationsPool) exists(hash string) bool {
+	_, existsInCache := op.cache[hash]
+	_, existsInDeadLetter := op.deadLetter[hash]
+
+	return existsInCache || existsInDeadLetter
+}
+
+// Add adds the outgoing txs data at the specified hash in the internal cache, for the default destination
 func (op *outGoingOperationsPool) Add(data *sovereign.BridgeOutGoingData) {
+	op.AddWithDestination(data, "")
+}
+
+// AddWithDestination adds the outgoing txs data at the specified hash in the internal cache, for the provided
+// destination. An empty destination stands for the default destination.
+func (op *outGoingOperationsPool) AddWithDestination(data *sovereign.BridgeOutGoingData, destination string) {
 	if data == nil {
 		return
 	}
 
-	log.Debug("outGoingOperationsPool.Add",
+	log.Debug("outGoingOperationsPool.AddWithDestination",
+		"destination", destination,
 		"hash", data.Hash,
 		"aggregated sig", data.AggregatedSignature,
 		"leader sig", data.LeaderSignature,
@@ -58,14 +207,17 @@ func (op *outGoingOperationsPool) Add(data *sovereign.BridgeOutGoingData) {
 	op.mutex.Lock()
 	defer op.mutex.Unlock()
 
-	if _, exists := op.cache[hashStr]; exists {
+	if op.exists(hashStr) {
 		return
 	}
 
-	op.cache[hashStr] = &cacheEntry{
-		data:     data,
-		expireAt: time.Now().Add(op.timeout),
+	entry := &cacheEntry{
+		data:         data,
+		destination:  destination,
+		sendAttempts: 1,
 	}
+	op.cache[hashStr] = entry
+	op.saveInStorer(entry, false)
 }
 
 // Get returns the outgoing txs data at the specified hash
@@ -80,6 +232,53 @@ func (op *outGoingOperationsPool) Get(hash []byte) *sovereign.BridgeOutGoingData
 	return nil
 }
 
+// GetDestination returns the destination of the outgoing txs data at the specified hash. An empty destination is
+// returned for the default destination or if the hash is not found.
+func (op *outGoingOperationsPool) GetDestination(hash []byte) string {
+	op.mutex.RLock()
+	defer op.mutex.RUnlock()
+
+	if cachedEntry, exists := op.cache[string(hash)]; exists {
+		return cachedEntry.destination
+	}
+
+	return ""
+}
+
+// GetByOperationHash returns the outgoing txs data which contains the outgoing operation with the specified hash
+func (op *outGoingOperationsPool) GetByOperationHash(hash []byte) *sovereign.BridgeOutGoingData {
+	op.mutex.RLock()
+	defer op.mutex.RUnlock()
+
+	for _, cachedEntry := range op.cache {
+		for _, outGoingOp := range cachedEntry.data.OutGoingOperations {
+			if bytes.Equal(outGoingOp.Hash, hash) {
+				return cachedEntry.data
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSignatures sets the leader and aggregated signatures of the outgoing txs data at the specified hash, without
+// changing its send attempts or its time to wait
+func (op *outGoingOperationsPool) SetSignatures(hash []byte, leaderSignature []byte, aggregatedSignature []byte) error {
+	op.mutex.Lock()
+	defer op.mutex.Unlock()
+
+	cachedEntry, exists := op.cache[string(hash)]
+	if !exists {
+		return fmt.Errorf("%w, hash: %s", errHashOfHashesNotFound, hex.EncodeToString(hash))
+	}
+
+	cachedEntry.data.LeaderSignature = leaderSignature
+	cachedEntry.data.AggregatedSignature = aggregatedSignature
+	op.saveInStorer(cachedEntry, false)
+
+	return nil
+}
+
 // Delete removes the outgoing tx data at the specified hash
 func (op *outGoingOperationsPool) Delete(hash []byte) {
 	log.Debug("outGoingOperationsPool.Delete", "hash", hash)
@@ -88,15 +287,22 @@ func (op *outGoingOperationsPool) Delete(hash []byte) {
 	defer op.mutex.Unlock()
 
 	delete(op.cache, string(hash))
+	op.removeFromStorer(hash)
 }
 
 // ConfirmOperation will confirm the bridge op hash by deleting the entry in the internal cache(while keeping the order).
-// If there are no more operations under the parent hash(hashOfHashes), the whole cached entry will be deleted
+// If there are no more operations under the parent hash(hashOfHashes), the whole cached entry will be deleted.
+// Dead-lettered operations can also be confirmed, in case they were eventually executed on the main chain.
 func (op *outGoingOperationsPool) ConfirmOperation(hashOfHashes []byte, hash []byte) error {
 	op.mutex.Lock()
 	defer op.mutex.Unlock()
 
 	cachedEntry, found := op.cache[string(hashOfHashes)]
+	deadLettered := false
+	if !found {
+		cachedEntry, found = op.deadLetter[string(hashOfHashes)]
+		deadLettered = found
+	}
 	if !found {
 		return fmt.Errorf("%w, hashOfHashes: %s, bridgeOpHash: %s",
 			errHashOfHashesNotFound, hex.EncodeToString(hashOfHashes), hex.EncodeToString(hash))
@@ -109,12 +315,49 @@ func (op *outGoingOperationsPool) ConfirmOperation(hashOfHashes []byte, hash []b
 
 	if len(cachedEntry.data.OutGoingOperations) == 0 {
 		delete(op.cache, string(hashOfHashes))
+		delete(op.deadLetter, string(hashOfHashes))
+		op.removeFromStorer(hashOfHashes)
+	} else {
+		op.saveInStorer(cachedEntry, deadLettered)
 	}
 
 	log.Debug("outGoingOperationsPool.ConfirmOperation", "hashOfHashes", hashOfHashes, "hash", hash)
 	return nil
 }
 
+func (op *outGoingOperationsPool) saveInStorer(entry *cacheEntry, deadLettered bool) {
+	if check.IfNil(op.marshaller) {
+		return
+	}
+
+	data := entry.data
+	dataBytes, err := op.marshaller.Marshal(&storedEntry{
+		Destination:  entry.destination,
+		Data:         data,
+		SendAttempts: entry.sendAttempts,
+		DeadLettered: deadLettered,
+	})
+	if err != nil {
+		log.Error("outGoingOperationsPool.saveInStorer: could not marshal outgoing operations",
+			"hash", hex.EncodeToString(data.Hash), "error", err)
+		return
+	}
+
+	err = op.storer.Put(data.Hash, dataBytes)
+	if err != nil {
+		log.Error("outGoingOperationsPool.saveInStorer: could not save outgoing operations",
+			"hash", hex.EncodeToString(data.Hash), "error", err)
+	}
+}
+
+func (op *outGoingOperationsPool) removeFromStorer(hash []byte) {
+	err := op.storer.Remove(hash)
+	if err != nil {
+		log.Error("outGoingOperationsPool.removeFromStorer: could not remove outgoing operations",
+			"hash", hex.EncodeToString(hash), "error", err)
+	}
+}
+
 func confirmOutGoingBridgeOpHash(cachedEntry *cacheEntry, hash []byte) error {
 	cacheData := cachedEntry.data
 	for idx, outGoingOp := range cacheData.OutGoingOperations {
@@ -132,46 +375,170 @@ func removeElement(slice []*sovereign.OutGoingOperation, index int) []*sovereign
 	return slice[:len(slice)-1]
 }
 
-// GetUnconfirmedOperations returns a list of unconfirmed operations.
-// An unconfirmed operation is a tx data operation which has been stored in cache for longer
-// than the time to wait for unconfirmed outgoing operations.
-// Returned list is sorted based on expiry time.
+// GetUnconfirmedOperations returns the list of unconfirmed operations which are due to be resent, as found by the
+// last processing of the unconfirmed operations. It does not change the pool.
+// Returned list is sorted by hash.
 func (op *outGoingOperationsPool) GetUnconfirmedOperations() []*sovereign.BridgeOutGoingData {
-	expiredEntries := make([]cacheEntry, 0)
+	op.mutex.RLock()
+	ret := make([]*sovereign.BridgeOutGoingData, 0)
+	for _, entry := range op.cache {
+		if entry.dueForResend {
+			ret = append(ret, entry.data)
+		}
+	}
+	op.mutex.RUnlock()
+
+	sort.Slice(ret, func(i, j int) bool {
+		return bytes.Compare(ret[i].Hash, ret[j].Hash) < 0
+	})
 
+	return ret
+}
+
+// ProcessUnconfirmedOperations should be called for each committed block, with the block timestamp. It starts the time
+// to wait for the newly added operations and finds the unconfirmed operations, which have been stored for longer than
+// their time to wait. Each unconfirmed operation is either marked as due to be resent, counting one more send attempt,
+// or moved to the dead-letter store, if it already reached the max number of send attempts.
+func (op *outGoingOperationsPool) ProcessUnconfirmedOperations(currentTime time.Time) {
 	op.mutex.Lock()
-	for _, entry := range op.cache {
-		if time.Now().After(entry.expireAt) {
-			expiredEntries = append(expiredEntries, *entry)
+	defer op.mutex.Unlock()
+
+	for hash, entry := range op.cache {
+		entry.dueForResend = false
+		if !entry.isScheduled {
+			entry.isScheduled = true
+			entry.expireAt = currentTime.Add(op.computeTimeToWait(entry.sendAttempts))
+			continue
+		}
+		if !currentTime.After(entry.expireAt) {
+			continue
+		}
+
+		if op.reachedMaxSendAttempts(entry) {
+			op.moveToDeadLetter(hash, entry)
+			continue
+		}
+
+		entry.sendAttempts++
+		entry.expireAt = currentTime.Add(op.computeTimeToWait(entry.sendAttempts))
+		entry.dueForResend = true
+		op.saveInStorer(entry, false)
+	}
+}
+
+func (op *outGoingOperationsPool) reachedMaxSendAttempts(entry *cacheEntry) bool {
+	return op.retryPolicy.MaxSendAttempts > 0 && entry.sendAttempts >= op.retryPolicy.MaxSendAttempts
+}
+
+func (op *outGoingOperationsPool) moveToDeadLetter(hash string, entry *cacheEntry) {
+	log.Warn("outGoingOperationsPool: outgoing operations were not confirmed after max send attempts, moving them to dead-letter",
+		"hash", hex.EncodeToString(entry.data.Hash),
+		"destination", entry.destination,
+		"send attempts", entry.sendAttempts,
+	)
+
+	delete(op.cache, hash)
+	op.deadLetter[hash] = entry
+	op.saveInStorer(entry, true)
+}
+
+// computeTimeToWait returns the time to wait for the confirmation of an operation after the provided number of send
+// attempts, which is the initial time to wait doubled after each attempt, capped at the max time to wait
+func (op *outGoingOperationsPool) computeTimeToWait(sendAttempts uint32) time.Duration {
+	timeToWait := op.timeout
+	if op.retryPolicy.MaxTimeToWait <= op.timeout {
+		return timeToWait
+	}
+
+	for i := uint32(1); i < sendAttempts; i++ {
+		timeToWait *= 2
+		if timeToWait >= op.retryPolicy.MaxTimeToWait {
+			return op.retryPolicy.MaxTimeToWait
 		}
 	}
-	op.mutex.Unlock()
 
-	sort.Slice(expiredEntries, func(i, j int) bool {
-		return expiredEntries[i].expireAt.Before(expiredEntries[j].expireAt)
+	return timeToWait
+}
+
+// GetDeadLetteredOperations returns all the outgoing operations which were moved to the dead-letter store, sorted by hash
+func (op *outGoingOperationsPool) GetDeadLetteredOperations() []*sovereign.BridgeOutGoingData {
+	op.mutex.RLock()
+	ret := make([]*sovereign.BridgeOutGoingData, 0, len(op.deadLetter))
+	for _, entry := range op.deadLetter {
+		ret = append(ret, entry.data)
+	}
+	op.mutex.RUnlock()
+
+	sort.Slice(ret, func(i, j int) bool {
+		return bytes.Compare(ret[i].Hash, ret[j].Hash) < 0
 	})
 
-	ret := make([]*sovereign.BridgeOutGoingData, len(expiredEntries))
-	for i, entry := range expiredEntries {
-		ret[i] = entry.data
+	return ret
+}
+
+// GetPendingOperations returns all the outgoing operations which are not yet confirmed, including the dead-lettered
+// ones, grouped by their destination and sorted by hash
+func (op *outGoingOperationsPool) GetPendingOperations() map[string][]*sovereign.BridgeOutGoingData {
+	ret := make(map[string][]*sovereign.BridgeOutGoingData)
+
+	op.mutex.RLock()
+	for _, entry := range op.cache {
+		ret[entry.destination] = append(ret[entry.destination], entry.data)
+	}
+	for _, entry := range op.deadLetter {
+		ret[entry.destination] = append(ret[entry.destination], entry.data)
+	}
+	op.mutex.RUnlock()
+
+	for _, operations := range ret {
+		sort.Slice(operations, func(i, j int) bool {
+			return bytes.Compare(operations[i].Hash, operations[j].Hash) < 0
+		})
 	}
 
 	return ret
 }
 
-// ResetTimer will reset the internal expiry timer for the provided outgoing operations hashes
-func (op *outGoingOperationsPool) ResetTimer(hashes [][]byte) {
+// RequeueDeadLetteredOperations moves the dead-lettered outgoing operations with the provided hash back to the pool,
+// with their send attempts reset, so that they are due to be resent after the next committed block.
+// The change is local to this node, it is not agreed through consensus: the operations are resent only by the blocks
+// proposed by this node, while the other nodes keep them dead-lettered, unless they are also re-queued there.
+func (op *outGoingOperationsPool) RequeueDeadLetteredOperations(hash []byte) error {
 	op.mutex.Lock()
 	defer op.mutex.Unlock()
 
-	for _, hash := range hashes {
-		cachedEntry, exists := op.cache[string(hash)]
-		if !exists {
-			log.Error("outGoingOperationsPool.ResetTimer error", "hash not found", hash)
-			continue
-		}
-		cachedEntry.expireAt = time.Now().Add(op.timeout)
+	entry, found := op.deadLetter[string(hash)]
+	if !found {
+		return fmt.Errorf("%w, hash: %s", errDeadLetteredOperationsNotFound, hex.EncodeToString(hash))
 	}
+
+	entry.sendAttempts = 0
+	entry.isScheduled = true
+	entry.expireAt = time.Time{}
+	entry.dueForResend = false
+	delete(op.deadLetter, string(hash))
+	op.cache[string(hash)] = entry
+	op.saveInStorer(entry, false)
+
+	log.Debug("outGoingOperationsPool.RequeueDeadLetteredOperations", "hash", hash)
+	return nil
+}
+
+// DropDeadLetteredOperations permanently removes the dead-lettered outgoing operations with the provided hash.
+// The change is local to this node, it is not agreed through consensus.
+func (op *outGoingOperationsPool) DropDeadLetteredOperations(hash []byte) error {
+	op.mutex.Lock()
+	defer op.mutex.Unlock()
+
+	if _, found := op.deadLetter[string(hash)]; !found {
+		return fmt.Errorf("%w, hash: %s", errDeadLetteredOperationsNotFound, hex.EncodeToString(hash))
+	}
+
+	delete(op.deadLetter, string(hash))
+	op.removeFromStorer(hash)
+
+	log.Debug("outGoingOperationsPool.DropDeadLetteredOperations", "hash", hash)
+	return nil
 }
 
 // IsInterfaceNil checks if the underlying pointer is nil
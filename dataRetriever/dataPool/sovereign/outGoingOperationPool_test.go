@@ -8,10 +8,22 @@ import (
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
 )
 
+func createPersistentOutGoingOperationPool(timeToWait time.Duration, retryPolicy RetryPolicy, storer storage.Storer) *outGoingOperationsPool {
+	pool := NewOutGoingOperationPoolWithRetryPolicy(timeToWait, retryPolicy)
+	_ = pool.SetStorer(storer, &marshal.JsonMarshalizer{})
+
+	return pool
+}
+
 func TestNewOutGoingOperationPool(t *testing.T) {
 	t.Parallel()
 
@@ -106,6 +118,111 @@ func TestOutGoingOperationsPool_Add_Get_Delete(t *testing.T) {
 	require.Equal(t, bridgeData3, pool.Get(outGoingOperationsHash3))
 }
 
+func TestOutGoingOperationsPool_AddWithDestination(t *testing.T) {
+	t.Parallel()
+
+	pool := NewOutGoingOperationPool(time.Second)
+
+	bridgeData1 := &sovereign.BridgeOutGoingData{
+		Hash: []byte("hashOfHashes1"),
+	}
+	bridgeData2 := &sovereign.BridgeOutGoingData{
+		Hash: []byte("hashOfHashes2"),
+	}
+
+	pool.Add(bridgeData1)
+	pool.AddWithDestination(bridgeData2, "messaging")
+	pool.AddWithDestination(nil, "messaging")
+
+	require.Equal(t, bridgeData1, pool.Get(bridgeData1.Hash))
+	require.Equal(t, bridgeData2, pool.Get(bridgeData2.Hash))
+	require.Empty(t, pool.GetDestination(bridgeData1.Hash))
+	require.Equal(t, "messaging", pool.GetDestination(bridgeData2.Hash))
+	require.Empty(t, pool.GetDestination([]byte("missing hash")))
+
+	// adding an existing hash should not change its destination
+	pool.AddWithDestination(bridgeData1, "messaging")
+	require.Empty(t, pool.GetDestination(bridgeData1.Hash))
+}
+
+func TestOutGoingOperationsPool_GetPendingOperations(t *testing.T) {
+	t.Parallel()
+
+	expiryTime := time.Millisecond * 10
+	pool := NewOutGoingOperationPoolWithRetryPolicy(expiryTime, RetryPolicy{
+		MaxSendAttempts: 1,
+	})
+	require.Empty(t, pool.GetPendingOperations())
+
+	bridgeData1 := &sovereign.BridgeOutGoingData{
+		Hash: []byte("hashOfHashes1"),
+	}
+	bridgeData2 := &sovereign.BridgeOutGoingData{
+		Hash: []byte("hashOfHashes2"),
+	}
+	bridgeData3 := &sovereign.BridgeOutGoingData{
+		Hash: []byte("hashOfHashes3"),
+	}
+
+	now := time.Now()
+	pool.AddWithDestination(bridgeData3, "messaging")
+	pool.ProcessUnconfirmedOperations(now)
+	pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+	require.Empty(t, pool.GetUnconfirmedOperations())
+	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData3}, pool.GetDeadLetteredOperations())
+
+	pool.AddWithDestination(bridgeData2, "messaging")
+	pool.Add(bridgeData1)
+
+	expectedPendingOperations := map[string][]*sovereign.BridgeOutGoingData{
+		"":          {bridgeData1},
+		"messaging": {bridgeData2, bridgeData3},
+	}
+	require.Equal(t, expectedPendingOperations, pool.GetPendingOperations())
+}
+
+func TestOutGoingOperationsPool_GetByOperationHash(t *testing.T) {
+	t.Parallel()
+
+	pool := NewOutGoingOperationPool(time.Second)
+
+	bridgeData1 := &sovereign.BridgeOutGoingData{
+		Hash: []byte("h11h22"),
+		OutGoingOperations: []*sovereign.OutGoingOperation{
+			{
+				Hash: []byte("h1"),
+				Data: []byte("d1"),
+			},
+			{
+				Hash: []byte("h2"),
+				Data: []byte("d2"),
+			},
+		},
+	}
+	bridgeData2 := &sovereign.BridgeOutGoingData{
+		Hash: []byte("h33"),
+		OutGoingOperations: []*sovereign.OutGoingOperation{
+			{
+				Hash: []byte("h3"),
+				Data: []byte("d3"),
+			},
+		},
+	}
+
+	pool.Add(bridgeData1)
+	pool.Add(bridgeData2)
+	require.Equal(t, bridgeData1, pool.GetByOperationHash([]byte("h1")))
+	require.Equal(t, bridgeData1, pool.GetByOperationHash([]byte("h2")))
+	require.Equal(t, bridgeData2, pool.GetByOperationHash([]byte("h3")))
+	require.Nil(t, pool.GetByOperationHash([]byte("h4")))
+	require.Nil(t, pool.GetByOperationHash([]byte("h33")))
+
+	err := pool.ConfirmOperation([]byte("h11h22"), []byte("h1"))
+	require.Nil(t, err)
+	require.Nil(t, pool.GetByOperationHash([]byte("h1")))
+	require.Equal(t, bridgeData1, pool.GetByOperationHash([]byte("h2")))
+}
+
 func TestOutGoingOperationsPool_GetUnconfirmedOperations(t *testing.T) {
 	t.Parallel()
 
@@ -151,16 +268,24 @@ func TestOutGoingOperationsPool_GetUnconfirmedOperations(t *testing.T) {
 		},
 	}
 
+	now := time.Now()
 	pool.Add(bridgeData1)
 	pool.Add(bridgeData2)
+	pool.ProcessUnconfirmedOperations(now)
 	require.Empty(t, pool.GetUnconfirmedOperations())
 
-	time.Sleep(expiryTime)
 	pool.Add(bridgeData3)
+	pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
 	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData1, bridgeData2}, pool.GetUnconfirmedOperations())
+	// getting the unconfirmed operations should not change the pool
+	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData1, bridgeData2}, pool.GetUnconfirmedOperations())
+
+	pool.ProcessUnconfirmedOperations(now.Add(4 * expiryTime))
+	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData3, bridgeData1, bridgeData2}, pool.GetUnconfirmedOperations())
 
-	time.Sleep(expiryTime)
-	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData1, bridgeData2, bridgeData3}, pool.GetUnconfirmedOperations())
+	// operations are due to be resent only until the next processing
+	pool.ProcessUnconfirmedOperations(now.Add(4 * expiryTime))
+	require.Empty(t, pool.GetUnconfirmedOperations())
 }
 
 func TestOutGoingOperationsPool_ConfirmOperation(t *testing.T) {
@@ -316,7 +441,7 @@ func TestOutGoingOperationsPool_ConcurrentOperations(t *testing.T) {
 			case 3:
 				_ = pool.GetUnconfirmedOperations()
 			case 4:
-				pool.ResetTimer([][]byte{hash})
+				pool.ProcessUnconfirmedOperations(time.Now())
 			default:
 				require.Fail(t, "should not get another operation")
 			}
@@ -329,57 +454,437 @@ func TestOutGoingOperationsPool_ConcurrentOperations(t *testing.T) {
 	wg.Wait()
 }
 
-func TestOutGoingOperationsPool_ResetTimer(t *testing.T) {
+func TestOutGoingOperationsPool_SetSignatures(t *testing.T) {
 	t.Parallel()
 
 	expiryTime := time.Millisecond * 100
-	pool := NewOutGoingOperationPool(expiryTime)
+	pool := NewOutGoingOperationPoolWithRetryPolicy(expiryTime, RetryPolicy{})
 
-	hash1 := []byte("h1")
-	hash2 := []byte("h2")
+	bridgeData := &sovereign.BridgeOutGoingData{
+		Hash: []byte("hashOfHashes"),
+	}
+	err := pool.SetSignatures(bridgeData.Hash, []byte("leaderSig"), []byte("aggregatedSig"))
+	require.ErrorIs(t, err, errHashOfHashesNotFound)
 
-	data1 := []byte("d1")
-	data2 := []byte("d2")
+	now := time.Now()
+	pool.AddWithDestination(bridgeData, "messaging")
+	pool.ProcessUnconfirmedOperations(now)
 
-	outGoingOperationsHash1 := []byte("h11h22")
-	bridgeData1 := &sovereign.BridgeOutGoingData{
-		Hash: outGoingOperationsHash1,
-		OutGoingOperations: []*sovereign.OutGoingOperation{
-			{
-				Hash: hash1,
-				Data: data1,
-			},
-		},
-	}
-	outGoingOperationsHash2 := []byte("h33")
-	bridgeData2 := &sovereign.BridgeOutGoingData{
-		Hash: outGoingOperationsHash2,
-		OutGoingOperations: []*sovereign.OutGoingOperation{
-			{
-				Hash: hash2,
-				Data: data2,
-			},
-		},
+	err = pool.SetSignatures(bridgeData.Hash, []byte("leaderSig"), []byte("aggregatedSig"))
+	require.Nil(t, err)
+
+	expectedBridgeData := &sovereign.BridgeOutGoingData{
+		Hash:                []byte("hashOfHashes"),
+		LeaderSignature:     []byte("leaderSig"),
+		AggregatedSignature: []byte("aggregatedSig"),
 	}
-	pool.Add(bridgeData1)
-	pool.Add(bridgeData2)
-	require.Empty(t, pool.GetUnconfirmedOperations())
+	require.Equal(t, expectedBridgeData, pool.Get(bridgeData.Hash))
+	require.Equal(t, "messaging", pool.GetDestination(bridgeData.Hash))
 
-	time.Sleep(expiryTime)
-	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData1, bridgeData2}, pool.GetUnconfirmedOperations())
+	// setting the signatures should not restart the time to wait
+	pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+	require.Equal(t, []*sovereign.BridgeOutGoingData{expectedBridgeData}, pool.GetUnconfirmedOperations())
+}
+
+func TestOutGoingOperationsPool_ComputeTimeToWait(t *testing.T) {
+	t.Parallel()
 
-	pool.ResetTimer([][]byte{outGoingOperationsHash1})
-	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData2}, pool.GetUnconfirmedOperations())
+	t.Run("no max time to wait, should not increase the time to wait", func(t *testing.T) {
+		t.Parallel()
+
+		pool := NewOutGoingOperationPool(time.Second)
+		for sendAttempts := uint32(0); sendAttempts < 5; sendAttempts++ {
+			require.Equal(t, time.Second, pool.computeTimeToWait(sendAttempts))
+		}
+	})
+
+	t.Run("should double the time to wait up to the max time to wait", func(t *testing.T) {
+		t.Parallel()
+
+		pool := createPersistentOutGoingOperationPool(time.Second, RetryPolicy{
+			MaxTimeToWait: time.Second * 10,
+		}, testscommon.CreateMemUnit())
+		require.Equal(t, time.Second, pool.computeTimeToWait(0))
+		require.Equal(t, time.Second, pool.computeTimeToWait(1))
+		require.Equal(t, time.Second*2, pool.computeTimeToWait(2))
+		require.Equal(t, time.Second*4, pool.computeTimeToWait(3))
+		require.Equal(t, time.Second*8, pool.computeTimeToWait(4))
+		require.Equal(t, time.Second*10, pool.computeTimeToWait(5))
+		require.Equal(t, time.Second*10, pool.computeTimeToWait(1000))
+	})
+}
+
+func TestOutGoingOperationsPool_ProcessUnconfirmedOperationsWithBackoff(t *testing.T) {
+	t.Parallel()
 
-	pool.ResetTimer([][]byte{[]byte("hashNotFound")})
-	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData2}, pool.GetUnconfirmedOperations())
+	expiryTime := time.Millisecond * 100
+	pool := NewOutGoingOperationPoolWithRetryPolicy(expiryTime, RetryPolicy{
+		MaxTimeToWait: expiryTime * 4,
+	})
 
-	pool.ResetTimer([][]byte{outGoingOperationsHash2})
+	bridgeData := &sovereign.BridgeOutGoingData{
+		Hash: []byte("hashOfHashes"),
+	}
+	now := time.Now()
+	pool.Add(bridgeData)
+
+	// first attempt, made when the operations were created, should wait the initial time to wait
+	pool.ProcessUnconfirmedOperations(now)
+	pool.ProcessUnconfirmedOperations(now.Add(expiryTime))
 	require.Empty(t, pool.GetUnconfirmedOperations())
+	now = now.Add(expiryTime + time.Millisecond)
+	pool.ProcessUnconfirmedOperations(now)
+	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
 
-	time.Sleep(expiryTime)
-	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData1, bridgeData2}, pool.GetUnconfirmedOperations())
+	// second attempt, should wait double the initial time to wait
+	pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+	require.Empty(t, pool.GetUnconfirmedOperations())
+	now = now.Add(2*expiryTime + time.Millisecond)
+	pool.ProcessUnconfirmedOperations(now)
+	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
 
-	pool.ResetTimer([][]byte{outGoingOperationsHash1, []byte("hashNotFound"), outGoingOperationsHash2})
+	// third attempt, should wait four times the initial time to wait, which is the max time to wait
+	pool.ProcessUnconfirmedOperations(now.Add(4 * expiryTime))
 	require.Empty(t, pool.GetUnconfirmedOperations())
+	pool.ProcessUnconfirmedOperations(now.Add(4*expiryTime + time.Millisecond))
+	require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
+}
+
+func TestOutGoingOperationsPool_DeadLetter(t *testing.T) {
+	t.Parallel()
+
+	createBridgeData := func() *sovereign.BridgeOutGoingData {
+		return &sovereign.BridgeOutGoingData{
+			Hash: []byte("hashOfHashes"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{
+				{
+					Hash: []byte("h1"),
+					Data: []byte("d1"),
+				},
+				{
+					Hash: []byte("h2"),
+					Data: []byte("d2"),
+				},
+			},
+		}
+	}
+	expiryTime := time.Millisecond * 10
+	createPoolWithDeadLetteredData := func(bridgeData *sovereign.BridgeOutGoingData, storer storage.Storer) *outGoingOperationsPool {
+		pool := createPersistentOutGoingOperationPool(expiryTime, RetryPolicy{
+			MaxSendAttempts: 3,
+		}, storer)
+		pool.Add(bridgeData)
+
+		// the first send attempt is made when the operations are created, followed by two resend attempts
+		now := time.Now()
+		pool.ProcessUnconfirmedOperations(now)
+		for i := 0; i < 2; i++ {
+			now = now.Add(2 * expiryTime)
+			pool.ProcessUnconfirmedOperations(now)
+			require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
+		}
+
+		pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+		require.Empty(t, pool.GetUnconfirmedOperations())
+		return pool
+	}
+
+	t.Run("should move operations to dead-letter after max send attempts", func(t *testing.T) {
+		t.Parallel()
+
+		bridgeData := createBridgeData()
+		pool := createPoolWithDeadLetteredData(bridgeData, testscommon.CreateMemUnit())
+
+		require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetDeadLetteredOperations())
+		require.Nil(t, pool.Get(bridgeData.Hash))
+
+		// adding the same operations again should not move them out of the dead-letter
+		pool.Add(bridgeData)
+		require.Nil(t, pool.Get(bridgeData.Hash))
+	})
+
+	t.Run("no max send attempts, should never dead-letter operations", func(t *testing.T) {
+		t.Parallel()
+
+		bridgeData := createBridgeData()
+		pool := NewOutGoingOperationPool(expiryTime)
+		pool.Add(bridgeData)
+
+		now := time.Now()
+		pool.ProcessUnconfirmedOperations(now)
+		for i := 0; i < 10; i++ {
+			now = now.Add(2 * expiryTime)
+			pool.ProcessUnconfirmedOperations(now)
+			require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
+		}
+		require.Empty(t, pool.GetDeadLetteredOperations())
+	})
+
+	t.Run("requeue should move operations back to the pool with reset send attempts", func(t *testing.T) {
+		t.Parallel()
+
+		bridgeData := createBridgeData()
+		pool := createPoolWithDeadLetteredData(bridgeData, testscommon.CreateMemUnit())
+
+		err := pool.RequeueDeadLetteredOperations([]byte("hashNotFound"))
+		require.ErrorIs(t, err, errDeadLetteredOperationsNotFound)
+
+		err = pool.RequeueDeadLetteredOperations(bridgeData.Hash)
+		require.Nil(t, err)
+		require.Empty(t, pool.GetDeadLetteredOperations())
+		require.Equal(t, bridgeData, pool.Get(bridgeData.Hash))
+
+		// re-queued operations should be resent after the next committed block
+		now := time.Now()
+		require.Empty(t, pool.GetUnconfirmedOperations())
+		pool.ProcessUnconfirmedOperations(now)
+		require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
+
+		// with reset send attempts, the operations should be resent twice more before being dead-lettered again
+		pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+		require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
+		pool.ProcessUnconfirmedOperations(now.Add(4 * expiryTime))
+		require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetUnconfirmedOperations())
+		pool.ProcessUnconfirmedOperations(now.Add(6 * expiryTime))
+		require.Empty(t, pool.GetUnconfirmedOperations())
+		require.Equal(t, []*sovereign.BridgeOutGoingData{bridgeData}, pool.GetDeadLetteredOperations())
+	})
+
+	t.Run("drop should remove operations", func(t *testing.T) {
+		t.Parallel()
+
+		bridgeData := createBridgeData()
+		pool := createPoolWithDeadLetteredData(bridgeData, testscommon.CreateMemUnit())
+
+		err := pool.DropDeadLetteredOperations([]byte("hashNotFound"))
+		require.ErrorIs(t, err, errDeadLetteredOperationsNotFound)
+
+		err = pool.DropDeadLetteredOperations(bridgeData.Hash)
+		require.Nil(t, err)
+		require.Empty(t, pool.GetDeadLetteredOperations())
+		require.Nil(t, pool.Get(bridgeData.Hash))
+	})
+
+	t.Run("should confirm dead-lettered operations", func(t *testing.T) {
+		t.Parallel()
+
+		bridgeData := createBridgeData()
+		pool := createPoolWithDeadLetteredData(bridgeData, testscommon.CreateMemUnit())
+
+		err := pool.ConfirmOperation(bridgeData.Hash, []byte("h1"))
+		require.Nil(t, err)
+		require.Len(t, pool.GetDeadLetteredOperations(), 1)
+
+		err = pool.ConfirmOperation(bridgeData.Hash, []byte("h2"))
+		require.Nil(t, err)
+		require.Empty(t, pool.GetDeadLetteredOperations())
+	})
+
+	t.Run("should persist dead-lettered operations and send attempts", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.CreateMemUnit()
+
+		deadLetteredData := createBridgeData()
+		pool := createPoolWithDeadLetteredData(deadLetteredData, storer)
+
+		retriedData := &sovereign.BridgeOutGoingData{
+			Hash: []byte("retriedHash"),
+		}
+		now := time.Now()
+		pool.Add(retriedData)
+		pool.ProcessUnconfirmedOperations(now)
+		pool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+		require.Equal(t, []*sovereign.BridgeOutGoingData{retriedData}, pool.GetUnconfirmedOperations())
+
+		reloadedPool := NewOutGoingOperationPoolWithRetryPolicy(expiryTime, RetryPolicy{
+			MaxSendAttempts: 3,
+		})
+		err := reloadedPool.SetStorer(storer, &marshal.JsonMarshalizer{})
+		require.Nil(t, err)
+		require.Equal(t, []*sovereign.BridgeOutGoingData{deadLetteredData}, reloadedPool.GetDeadLetteredOperations())
+
+		// one more failed attempt after the restart should dead-letter the retried operations
+		now = time.Now()
+		reloadedPool.ProcessUnconfirmedOperations(now)
+		require.Empty(t, reloadedPool.GetUnconfirmedOperations())
+		reloadedPool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+		require.Equal(t, []*sovereign.BridgeOutGoingData{retriedData}, reloadedPool.GetUnconfirmedOperations())
+		reloadedPool.ProcessUnconfirmedOperations(now.Add(4 * expiryTime))
+		require.Empty(t, reloadedPool.GetUnconfirmedOperations())
+		require.Len(t, reloadedPool.GetDeadLetteredOperations(), 2)
+	})
+}
+
+func TestOutGoingOperationsPool_SetStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		pool := NewOutGoingOperationPool(time.Second)
+		err := pool.SetStorer(nil, &marshal.JsonMarshalizer{})
+		require.Equal(t, errNilStorer, err)
+	})
+
+	t.Run("nil marshaller, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		pool := NewOutGoingOperationPool(time.Second)
+		err := pool.SetStorer(testscommon.CreateMemUnit(), nil)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+
+	t.Run("should persist operations and reload them in a new pool", func(t *testing.T) {
+		t.Parallel()
+
+		expiryTime := time.Millisecond * 100
+		marshaller := &marshal.JsonMarshalizer{}
+		storer := testscommon.CreateMemUnit()
+
+		bridgeData1 := &sovereign.BridgeOutGoingData{
+			Hash: []byte("hashOfHashes1"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{
+				{
+					Hash: []byte("h1"),
+					Data: []byte("d1"),
+				},
+				{
+					Hash: []byte("h2"),
+					Data: []byte("d2"),
+				},
+			},
+			AggregatedSignature: []byte("aggregatedSig1"),
+			LeaderSignature:     []byte("leaderSig1"),
+		}
+		bridgeData2 := &sovereign.BridgeOutGoingData{
+			Hash: []byte("hashOfHashes2"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{
+				{
+					Hash: []byte("h3"),
+					Data: []byte("d3"),
+				},
+			},
+		}
+		bridgeData3 := &sovereign.BridgeOutGoingData{
+			Hash: []byte("hashOfHashes3"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{
+				{
+					Hash: []byte("h4"),
+					Data: []byte("d4"),
+				},
+			},
+		}
+
+		pool := NewOutGoingOperationPool(expiryTime)
+		pool.Add(bridgeData1)
+
+		// operations added before setting the storer should also be saved
+		err := pool.SetStorer(storer, marshaller)
+		require.Nil(t, err)
+		require.Nil(t, storer.Has(bridgeData1.Hash))
+
+		pool.Add(bridgeData2)
+		pool.Add(bridgeData3)
+		require.Nil(t, storer.Has(bridgeData2.Hash))
+		require.Nil(t, storer.Has(bridgeData3.Hash))
+
+		err = pool.ConfirmOperation(bridgeData1.Hash, []byte("h1"))
+		require.Nil(t, err)
+		err = pool.ConfirmOperation(bridgeData2.Hash, []byte("h3"))
+		require.Nil(t, err)
+		require.NotNil(t, storer.Has(bridgeData2.Hash))
+
+		pool.Delete(bridgeData3.Hash)
+		require.NotNil(t, storer.Has(bridgeData3.Hash))
+
+		// simulate a node restart
+		reloadedPool := NewOutGoingOperationPool(expiryTime)
+		err = reloadedPool.SetStorer(storer, marshaller)
+		require.Nil(t, err)
+
+		expectedBridgeData1 := &sovereign.BridgeOutGoingData{
+			Hash: []byte("hashOfHashes1"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{
+				{
+					Hash: []byte("h2"),
+					Data: []byte("d2"),
+				},
+			},
+			AggregatedSignature: []byte("aggregatedSig1"),
+			LeaderSignature:     []byte("leaderSig1"),
+		}
+		require.Equal(t, expectedBridgeData1, reloadedPool.Get(bridgeData1.Hash))
+		require.Nil(t, reloadedPool.Get(bridgeData2.Hash))
+		require.Nil(t, reloadedPool.Get(bridgeData3.Hash))
+
+		now := time.Now()
+		reloadedPool.ProcessUnconfirmedOperations(now)
+		require.Empty(t, reloadedPool.GetUnconfirmedOperations())
+		reloadedPool.ProcessUnconfirmedOperations(now.Add(2 * expiryTime))
+		require.Equal(t, []*sovereign.BridgeOutGoingData{expectedBridgeData1}, reloadedPool.GetUnconfirmedOperations())
+	})
+
+	t.Run("should persist the destination of the operations", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.CreateMemUnit()
+
+		bridgeData := &sovereign.BridgeOutGoingData{
+			Hash: []byte("hashOfHashes"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{
+				{
+					Hash: []byte("h1"),
+					Data: []byte("d1"),
+				},
+			},
+		}
+
+		pool := createPersistentOutGoingOperationPool(time.Second, RetryPolicy{}, storer)
+		pool.AddWithDestination(bridgeData, "messaging")
+
+		reloadedPool := NewOutGoingOperationPool(time.Second)
+		err := reloadedPool.SetStorer(storer, &marshal.JsonMarshalizer{})
+		require.Nil(t, err)
+		require.Equal(t, bridgeData, reloadedPool.Get(bridgeData.Hash))
+		require.Equal(t, "messaging", reloadedPool.GetDestination(bridgeData.Hash))
+	})
+
+	t.Run("operations stored without destination should be loaded for the default destination", func(t *testing.T) {
+		t.Parallel()
+
+		marshaller := &marshal.JsonMarshalizer{}
+		storer := testscommon.CreateMemUnit()
+
+		bridgeData := &sovereign.BridgeOutGoingData{
+			Hash: []byte("hashOfHashes"),
+			OutGoingOperations: []*sovereign.OutGoingOperation{
+				{
+					Hash: []byte("h1"),
+					Data: []byte("d1"),
+				},
+			},
+			AggregatedSignature: []byte("aggregatedSig"),
+		}
+		bridgeDataBytes, _ := marshaller.Marshal(bridgeData)
+		_ = storer.Put(bridgeData.Hash, bridgeDataBytes)
+
+		pool := NewOutGoingOperationPool(time.Second)
+		err := pool.SetStorer(storer, marshaller)
+		require.Nil(t, err)
+		require.Equal(t, bridgeData, pool.Get(bridgeData.Hash))
+		require.Empty(t, pool.GetDestination(bridgeData.Hash))
+	})
+
+	t.Run("corrupted stored data should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testscommon.CreateMemUnit()
+		_ = storer.Put([]byte("hash"), []byte("corrupted data"))
+
+		pool := NewOutGoingOperationPool(time.Second)
+		err := pool.SetStorer(storer, &marshal.JsonMarshalizer{})
+		require.Nil(t, err)
+		require.Nil(t, pool.Get([]byte("hash")))
+	})
 }
@@ -12,16 +12,24 @@ import (
 
 type sovereignShardRequestersContainerFactory struct {
 	*shardRequestersContainerFactory
+	incomingChainsHandler dataRetriever.IncomingChainsHandler
 }
 
 // NewSovereignShardRequestersContainerFactory creates a new container filled with topic requesters for sovereign shards
-func NewSovereignShardRequestersContainerFactory(shardReqContainerFactory *shardRequestersContainerFactory) (*sovereignShardRequestersContainerFactory, error) {
+func NewSovereignShardRequestersContainerFactory(
+	shardReqContainerFactory *shardRequestersContainerFactory,
+	incomingChainsHandler dataRetriever.IncomingChainsHandler,
+) (*sovereignShardRequestersContainerFactory, error) {
 	if check.IfNil(shardReqContainerFactory) {
 		return nil, errors.ErrNilShardRequesterContainerFactory
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
 
 	f := &sovereignShardRequestersContainerFactory{
 		shardRequestersContainerFactory: shardReqContainerFactory,
+		incomingChainsHandler:           incomingChainsHandler,
 	}
 
 	f.numIntraShardPeers = f.numTotalPeers
@@ -109,11 +117,24 @@ func (srcf *sovereignShardRequestersContainerFactory) generateMiniBlocksRequeste
 	return srcf.container.Add(identifierMiniBlocks, requester)
 }
 
+// generateExtendedShardHeaderRequesters creates one extended shard header requester for each incoming chain, since
+// headers of each incoming chain are requested by nonce on their own topic
 func (srcf *sovereignShardRequestersContainerFactory) generateExtendedShardHeaderRequesters() error {
+	for _, incomingChainShardID := range srcf.incomingChainsHandler.GetShardIDs() {
+		err := srcf.generateExtendedShardHeaderRequester(incomingChainShardID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (srcf *sovereignShardRequestersContainerFactory) generateExtendedShardHeaderRequester(incomingChainShardID uint32) error {
 	shardC := srcf.shardCoordinator
 	shardID := shardC.SelfId()
 
-	identifierHdr := factory.ExtendedHeaderProofTopic + shardC.CommunicationIdentifier(shardID)
+	identifierHdr := factory.IncomingChainHeaderProofTopic(incomingChainShardID) + shardC.CommunicationIdentifier(shardID)
 	requestSender, err := srcf.createOneRequestSenderWithSpecifiedNumRequests(identifierHdr, EmptyExcludePeersOnTopic, shardID, 0, srcf.numIntraShardPeers)
 	if err != nil {
 		return err
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,19 +23,37 @@ func createSovArgs() requesterscontainer.FactoryArgs {
 	return args
 }
 
+const incomingChainShardID = core.MainChainShardId - 1
+
+func createIncomingChainsHandler() *sovereign.IncomingChainsHandlerMock {
+	return &sovereign.IncomingChainsHandlerMock{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.MainChainShardId, incomingChainShardID}
+		},
+	}
+}
+
 func TestNewSovereignShardRequestersContainerFactory(t *testing.T) {
 	t.Parallel()
 
-	t.Run("should work", func(t *testing.T) {
-		sovShardContainer, err := requesterscontainer.NewSovereignShardRequestersContainerFactory(nil)
+	t.Run("nil shard container, should return error", func(t *testing.T) {
+		sovShardContainer, err := requesterscontainer.NewSovereignShardRequestersContainerFactory(nil, createIncomingChainsHandler())
 		require.Equal(t, errors.ErrNilShardRequesterContainerFactory, err)
 		require.Nil(t, sovShardContainer)
 	})
 
+	t.Run("nil incoming chains handler, should return error", func(t *testing.T) {
+		args := createSovArgs()
+		shardContainer, _ := requesterscontainer.NewShardRequestersContainerFactory(args)
+		sovShardContainer, err := requesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer, nil)
+		require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+		require.Nil(t, sovShardContainer)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		args := createSovArgs()
 		shardContainer, _ := requesterscontainer.NewShardRequestersContainerFactory(args)
-		sovShardContainer, err := requesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer)
+		sovShardContainer, err := requesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer, createIncomingChainsHandler())
 		require.Nil(t, err)
 		require.False(t, sovShardContainer.IsInterfaceNil())
 	})
@@ -45,7 +64,7 @@ func TestSovereignShardRequestersContainerFactory_Create(t *testing.T) {
 
 	args := createSovArgs()
 	shardContainer, _ := requesterscontainer.NewShardRequestersContainerFactory(args)
-	sovShardContainer, _ := requesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer)
+	sovShardContainer, _ := requesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer, createIncomingChainsHandler())
 
 	container, err := sovShardContainer.Create()
 	require.Nil(t, err)
@@ -64,23 +83,24 @@ func TestSovereignShardRequestersContainerFactory_Create(t *testing.T) {
 	numRequesterTrieNodes := 2
 	numRequesterPeerAuth := 1
 	numRequesterValidatorInfo := 1
-	numRequesterExtendedHeader := 1
+	numRequesterExtendedHeader := 2
 	numRequesters := numRequesterTxs + numRequesterHeaders + numRequesterMiniBlocks + numRequesterMetaBlockHeaders +
 		numRequesterSCRs + numRequesterRewardTxs + numRequesterTrieNodes + numRequesterPeerAuth + numRequesterValidatorInfo + numRequesterExtendedHeader
 
-	require.Equal(t, numRequesters, container.Len()) // only one added container for extended header
+	require.Equal(t, numRequesters, container.Len()) // one added container for the extended header of each incoming chain
 
 	sovShardIDStr := fmt.Sprintf("_%d", core.SovereignChainShardId)
 	allKeys := map[string]struct{}{
-		factory.TransactionTopic + sovShardIDStr:         {},
-		factory.UnsignedTransactionTopic + sovShardIDStr: {},
-		factory.ShardBlocksTopic + sovShardIDStr:         {},
-		factory.MiniBlocksTopic + sovShardIDStr:          {},
-		factory.ValidatorTrieNodesTopic + sovShardIDStr:  {},
-		factory.AccountTrieNodesTopic + sovShardIDStr:    {},
-		common.PeerAuthenticationTopic:                   {},
-		common.ValidatorInfoTopic + sovShardIDStr:        {},
-		factory.ExtendedHeaderProofTopic + sovShardIDStr: {},
+		factory.TransactionTopic + sovShardIDStr:                                    {},
+		factory.UnsignedTransactionTopic + sovShardIDStr:                            {},
+		factory.ShardBlocksTopic + sovShardIDStr:                                    {},
+		factory.MiniBlocksTopic + sovShardIDStr:                                     {},
+		factory.ValidatorTrieNodesTopic + sovShardIDStr:                             {},
+		factory.AccountTrieNodesTopic + sovShardIDStr:                               {},
+		common.PeerAuthenticationTopic:                                              {},
+		common.ValidatorInfoTopic + sovShardIDStr:                                   {},
+		factory.ExtendedHeaderProofTopic + sovShardIDStr:                            {},
+		factory.IncomingChainHeaderProofTopic(incomingChainShardID) + sovShardIDStr: {},
 	}
 	iterateFunc := func(key string, requester dataRetriever.Requester) bool {
 		require.False(t, strings.Contains(strings.ToLower(key), "meta"))
@@ -97,7 +117,7 @@ func TestSovereignShardRequestersContainerFactory_NumPeers(t *testing.T) {
 
 	args := createSovArgs()
 	shardContainer, _ := requesterscontainer.NewShardRequestersContainerFactory(args)
-	sovShardContainer, _ := requesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer)
+	sovShardContainer, _ := requesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer, createIncomingChainsHandler())
 
 	require.Equal(t, sovShardContainer.NumCrossShardPeers(), 0)
 	require.Equal(t, int(args.RequesterConfig.NumTotalPeers), sovShardContainer.NumTotalPeers())
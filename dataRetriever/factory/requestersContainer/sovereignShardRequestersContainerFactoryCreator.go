@@ -1,13 +1,25 @@
 package requesterscontainer
 
-import "github.com/multiversx/mx-chain-go/dataRetriever"
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/errors"
+)
 
 type sovereignShardRequestersContainerFactoryCreator struct {
+	incomingChainsHandler dataRetriever.IncomingChainsHandler
 }
 
 // NewSovereignShardRequestersContainerFactoryCreator creates a new sovereign shard requester container factory creator
-func NewSovereignShardRequestersContainerFactoryCreator() *sovereignShardRequestersContainerFactoryCreator {
-	return &sovereignShardRequestersContainerFactoryCreator{}
+func NewSovereignShardRequestersContainerFactoryCreator(incomingChainsHandler dataRetriever.IncomingChainsHandler) (*sovereignShardRequestersContainerFactoryCreator, error) {
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
+
+	return &sovereignShardRequestersContainerFactoryCreator{
+		incomingChainsHandler: incomingChainsHandler,
+	}, nil
 }
 
 // CreateRequesterContainerFactory creates a requester container factory for sovereign shards
@@ -17,7 +29,7 @@ func (f *sovereignShardRequestersContainerFactoryCreator) CreateRequesterContain
 		return nil, err
 	}
 
-	return NewSovereignShardRequestersContainerFactory(shardFactory)
+	return NewSovereignShardRequestersContainerFactory(shardFactory, f.incomingChainsHandler)
 }
 
 // IsInterfaceNil checks if underlying pointer is nil
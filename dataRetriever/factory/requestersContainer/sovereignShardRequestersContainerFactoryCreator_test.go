@@ -4,13 +4,19 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-go/dataRetriever/factory/requestersContainer"
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewSovereignShardRequestersContainerFactoryCreator(t *testing.T) {
 	t.Parallel()
 
-	factory := requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator()
+	factory, err := requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator(nil)
+	require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+	require.Nil(t, factory)
+
+	factory, err = requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator(createIncomingChainsHandler())
+	require.Nil(t, err)
 	require.False(t, factory.IsInterfaceNil())
 	require.Implements(t, new(requesterscontainer.RequesterContainerFactoryCreator), factory)
 }
@@ -18,7 +24,7 @@ func TestNewSovereignShardRequestersContainerFactoryCreator(t *testing.T) {
 func TestSovereignShardRequestersContainerFactoryCreator_CreateRequesterContainerFactory(t *testing.T) {
 	t.Parallel()
 
-	factory := requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator()
+	factory, _ := requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator(createIncomingChainsHandler())
 
 	args := createSovArgs()
 	t.Run("should work", func(t *testing.T) {
@@ -12,16 +12,24 @@ import (
 
 type sovereignShardResolversContainerFactory struct {
 	*shardResolversContainerFactory
+	incomingChainsHandler dataRetriever.IncomingChainsHandler
 }
 
 // NewSovereignShardResolversContainerFactory creates a new sovereign shard resolvers container factory
-func NewSovereignShardResolversContainerFactory(shardContainer *shardResolversContainerFactory) (*sovereignShardResolversContainerFactory, error) {
+func NewSovereignShardResolversContainerFactory(
+	shardContainer *shardResolversContainerFactory,
+	incomingChainsHandler dataRetriever.IncomingChainsHandler,
+) (*sovereignShardResolversContainerFactory, error) {
 	if check.IfNil(shardContainer) {
 		return nil, errors.ErrNilShardResolversContainerFactory
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
 
 	return &sovereignShardResolversContainerFactory{
 		shardResolversContainerFactory: shardContainer,
+		incomingChainsHandler:          incomingChainsHandler,
 	}, nil
 }
 
@@ -79,7 +87,20 @@ func (srcf *sovereignShardResolversContainerFactory) Create() (dataRetriever.Res
 	return srcf.container, nil
 }
 
+// generateSovereignExtendedHeaderResolvers creates one extended shard header resolver for each incoming chain, since
+// headers of each incoming chain are requested by nonce from their own nonce-hash pair data unit
 func (srcf *sovereignShardResolversContainerFactory) generateSovereignExtendedHeaderResolvers() error {
+	for _, shardID := range srcf.incomingChainsHandler.GetShardIDs() {
+		err := srcf.generateSovereignExtendedHeaderResolver(shardID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (srcf *sovereignShardResolversContainerFactory) generateSovereignExtendedHeaderResolver(incomingChainShardID uint32) error {
 	shardC := srcf.shardCoordinator
 
 	hdrStorer, err := srcf.store.GetStorer(dataRetriever.ExtendedShardHeadersUnit)
@@ -87,13 +108,13 @@ func (srcf *sovereignShardResolversContainerFactory) generateSovereignExtendedHe
 		return err
 	}
 
-	identifierHdr := factory.ExtendedHeaderProofTopic + shardC.CommunicationIdentifier(shardC.SelfId())
-	resolverSender, err := srcf.createOneResolverSenderWithSpecifiedNumRequests(identifierHdr, EmptyExcludePeersOnTopic, core.MainChainShardId)
+	identifierHdr := factory.IncomingChainHeaderProofTopic(incomingChainShardID) + shardC.CommunicationIdentifier(shardC.SelfId())
+	resolverSender, err := srcf.createOneResolverSenderWithSpecifiedNumRequests(identifierHdr, EmptyExcludePeersOnTopic, incomingChainShardID)
 	if err != nil {
 		return err
 	}
 
-	hdrNonceStorer, err := srcf.store.GetStorer(dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
+	hdrNonceStorer, err := srcf.store.GetStorer(srcf.incomingChainsHandler.GetNonceHashDataUnit(incomingChainShardID))
 	if err != nil {
 		return err
 	}
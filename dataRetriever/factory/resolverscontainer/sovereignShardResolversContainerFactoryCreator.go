@@ -1,13 +1,25 @@
 package resolverscontainer
 
-import "github.com/multiversx/mx-chain-go/dataRetriever"
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/errors"
+)
 
 type sovereignShardResolversContainerFactoryCreator struct {
+	incomingChainsHandler dataRetriever.IncomingChainsHandler
 }
 
 // NewSovereignShardResolversContainerFactoryCreator creates a new sovereign shard resolvers container factory creator
-func NewSovereignShardResolversContainerFactoryCreator() *sovereignShardResolversContainerFactoryCreator {
-	return &sovereignShardResolversContainerFactoryCreator{}
+func NewSovereignShardResolversContainerFactoryCreator(incomingChainsHandler dataRetriever.IncomingChainsHandler) (*sovereignShardResolversContainerFactoryCreator, error) {
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
+
+	return &sovereignShardResolversContainerFactoryCreator{
+		incomingChainsHandler: incomingChainsHandler,
+	}, nil
 }
 
 // CreateShardResolversContainerFactory creates a shard resolver container factory for sovereign shards
@@ -18,7 +30,7 @@ func (f *sovereignShardResolversContainerFactoryCreator) CreateShardResolversCon
 		return nil, err
 	}
 
-	return NewSovereignShardResolversContainerFactory(shardContainer)
+	return NewSovereignShardResolversContainerFactory(shardContainer, f.incomingChainsHandler)
 }
 
 // IsInterfaceNil checks if the underlying pointer is nil
@@ -5,13 +5,19 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-go/dataRetriever/factory/resolverscontainer"
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewSovereignShardResolversContainerFactoryCreator(t *testing.T) {
 	t.Parallel()
 
-	factory := resolverscontainer.NewSovereignShardResolversContainerFactoryCreator()
+	factory, err := resolverscontainer.NewSovereignShardResolversContainerFactoryCreator(nil)
+	require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+	require.Nil(t, factory)
+
+	factory, err = resolverscontainer.NewSovereignShardResolversContainerFactoryCreator(createIncomingChainsHandler())
+	require.Nil(t, err)
 	require.False(t, factory.IsInterfaceNil())
 	require.Implements(t, new(resolverscontainer.ShardResolversContainerFactoryCreator), factory)
 }
@@ -19,7 +25,7 @@ func TestNewSovereignShardResolversContainerFactoryCreator(t *testing.T) {
 func TestSovereignShardResolversContainerFactoryCreator_CreateShardResolversContainerFactory(t *testing.T) {
 	t.Parallel()
 
-	factory := resolverscontainer.NewSovereignShardResolversContainerFactoryCreator()
+	factory, _ := resolverscontainer.NewSovereignShardResolversContainerFactoryCreator(createIncomingChainsHandler())
 
 	t.Run("nil shard coordinator, should return error", func(t *testing.T) {
 		args := getArgumentsShard()
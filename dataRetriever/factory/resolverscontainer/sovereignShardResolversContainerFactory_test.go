@@ -14,21 +14,39 @@ import (
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/require"
 )
 
+const incomingChainShardID = core.MainChainShardId - 1
+
+func createIncomingChainsHandler() *sovereign.IncomingChainsHandlerMock {
+	return &sovereign.IncomingChainsHandlerMock{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.MainChainShardId, incomingChainShardID}
+		},
+	}
+}
+
 func TestNewSovereignShardResolversContainerFactory(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil arg, should return error", func(t *testing.T) {
-		factory, err := resolverscontainer.NewSovereignShardResolversContainerFactory(nil)
+		factory, err := resolverscontainer.NewSovereignShardResolversContainerFactory(nil, createIncomingChainsHandler())
 		require.Equal(t, errors.ErrNilShardResolversContainerFactory, err)
 		require.Nil(t, factory)
 	})
+	t.Run("nil incoming chains handler, should return error", func(t *testing.T) {
+		args := getArgumentsShard()
+		shardContainer, _ := resolverscontainer.NewShardResolversContainerFactory(args)
+		factory, err := resolverscontainer.NewSovereignShardResolversContainerFactory(shardContainer, nil)
+		require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+		require.Nil(t, factory)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := getArgumentsShard()
 		shardContainer, _ := resolverscontainer.NewShardResolversContainerFactory(args)
-		factory, err := resolverscontainer.NewSovereignShardResolversContainerFactory(shardContainer)
+		factory, err := resolverscontainer.NewSovereignShardResolversContainerFactory(shardContainer, createIncomingChainsHandler())
 		require.Nil(t, err)
 		require.False(t, factory.IsInterfaceNil())
 	})
@@ -56,7 +74,7 @@ func TestSovereignShardResolversContainerFactory_Create(t *testing.T) {
 
 	args.ShardCoordinator = sharding.NewSovereignShardCoordinator()
 	shardContainer, _ := resolverscontainer.NewShardResolversContainerFactory(args)
-	sovContainer, _ := resolverscontainer.NewSovereignShardResolversContainerFactory(shardContainer)
+	sovContainer, _ := resolverscontainer.NewSovereignShardResolversContainerFactory(shardContainer, createIncomingChainsHandler())
 
 	container, _ := sovContainer.Create()
 
@@ -69,7 +87,7 @@ func TestSovereignShardResolversContainerFactory_Create(t *testing.T) {
 	numResolverTrieNodes := 2
 	numResolverPeerAuth := 1
 	numResolverValidatorInfo := 1
-	numResolverExtendedHeader := 1
+	numResolverExtendedHeader := 2
 	totalResolvers := numResolverTxs + numResolverHeaders + numResolverMiniBlocks + numResolverMetaBlockHeaders +
 		numResolverSCRs + numResolverRewardTxs + numResolverTrieNodes + numResolverPeerAuth + numResolverValidatorInfo + numResolverExtendedHeader
 
@@ -79,15 +97,16 @@ func TestSovereignShardResolversContainerFactory_Create(t *testing.T) {
 
 	sovShardIDStr := fmt.Sprintf("_%d", core.SovereignChainShardId)
 	allKeys := map[string]struct{}{
-		factory.TransactionTopic + sovShardIDStr:         {},
-		factory.UnsignedTransactionTopic + sovShardIDStr: {},
-		factory.ShardBlocksTopic + sovShardIDStr:         {},
-		factory.MiniBlocksTopic + sovShardIDStr:          {},
-		factory.ValidatorTrieNodesTopic + sovShardIDStr:  {},
-		factory.AccountTrieNodesTopic + sovShardIDStr:    {},
-		common.PeerAuthenticationTopic:                   {},
-		common.ValidatorInfoTopic + sovShardIDStr:        {},
-		factory.ExtendedHeaderProofTopic + sovShardIDStr: {},
+		factory.TransactionTopic + sovShardIDStr:                                    {},
+		factory.UnsignedTransactionTopic + sovShardIDStr:                            {},
+		factory.ShardBlocksTopic + sovShardIDStr:                                    {},
+		factory.MiniBlocksTopic + sovShardIDStr:                                     {},
+		factory.ValidatorTrieNodesTopic + sovShardIDStr:                             {},
+		factory.AccountTrieNodesTopic + sovShardIDStr:                               {},
+		common.PeerAuthenticationTopic:                                              {},
+		common.ValidatorInfoTopic + sovShardIDStr:                                   {},
+		factory.ExtendedHeaderProofTopic + sovShardIDStr:                            {},
+		factory.IncomingChainHeaderProofTopic(incomingChainShardID) + sovShardIDStr: {},
 	}
 
 	iterateFunc := func(key string, resolver dataRetriever.Resolver) bool {
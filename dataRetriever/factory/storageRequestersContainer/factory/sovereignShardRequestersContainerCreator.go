@@ -1,16 +1,26 @@
 package factory
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	storagerequesterscontainer "github.com/multiversx/mx-chain-go/dataRetriever/factory/storageRequestersContainer"
+	"github.com/multiversx/mx-chain-go/errors"
 )
 
 type sovereignShardRequestersContainerCreator struct {
+	incomingChainsHandler dataRetriever.IncomingChainsHandler
 }
 
 // NewSovereignShardRequestersContainerCreator creates a storage sovereign shard requesters container factory creator
-func NewSovereignShardRequestersContainerCreator() *sovereignShardRequestersContainerCreator {
-	return &sovereignShardRequestersContainerCreator{}
+func NewSovereignShardRequestersContainerCreator(incomingChainsHandler dataRetriever.IncomingChainsHandler) (*sovereignShardRequestersContainerCreator, error) {
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
+
+	return &sovereignShardRequestersContainerCreator{
+		incomingChainsHandler: incomingChainsHandler,
+	}, nil
 }
 
 // CreateShardRequestersContainerFactory creates a storage sovereign shard requesters container factory
@@ -20,7 +30,7 @@ func (f *sovereignShardRequestersContainerCreator) CreateShardRequestersContaine
 		return nil, err
 	}
 
-	return storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(shardFactory)
+	return storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(shardFactory, f.incomingChainsHandler)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -5,12 +5,20 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 )
 
 func TestSovereignShardRequestersContainerCreator_CreateShardRequestersContainerFactory(t *testing.T) {
 	t.Parallel()
 
-	creator := NewSovereignShardRequestersContainerCreator()
+	creator, err := NewSovereignShardRequestersContainerCreator(nil)
+	require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+	require.Nil(t, creator)
+
+	creator, err = NewSovereignShardRequestersContainerCreator(&sovereign.IncomingChainsHandlerMock{})
+	require.Nil(t, err)
 	require.False(t, creator.IsInterfaceNil())
 
 	args := createFactoryArgs()
@@ -12,16 +12,24 @@ import (
 
 type sovereignShardRequestersContainerFactory struct {
 	*shardRequestersContainerFactory
+	incomingChainsHandler dataRetriever.IncomingChainsHandler
 }
 
 // NewSovereignShardRequestersContainerFactory creates a sovereign container filled with topic requesters
-func NewSovereignShardRequestersContainerFactory(baseContainer *shardRequestersContainerFactory) (*sovereignShardRequestersContainerFactory, error) {
+func NewSovereignShardRequestersContainerFactory(
+	baseContainer *shardRequestersContainerFactory,
+	incomingChainsHandler dataRetriever.IncomingChainsHandler,
+) (*sovereignShardRequestersContainerFactory, error) {
 	if check.IfNil(baseContainer) {
 		return nil, errorsMx.ErrNilShardRequesterContainerFactory
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errorsMx.ErrNilIncomingChainsHandler
+	}
 
 	return &sovereignShardRequestersContainerFactory{
 		shardRequestersContainerFactory: baseContainer,
+		incomingChainsHandler:           incomingChainsHandler,
 	}, nil
 }
 
@@ -107,16 +115,29 @@ func (srcf *sovereignShardRequestersContainerFactory) generateMiniBlocksRequeste
 	return srcf.container.AddMultiple(keys, requestersSlice)
 }
 
+// generateExtendedShardHeaderRequesters creates one extended shard header requester for each incoming chain, since
+// headers of each incoming chain are stored by nonce in their own nonce-hash pair data unit
 func (srcf *sovereignShardRequestersContainerFactory) generateExtendedShardHeaderRequesters() error {
+	for _, incomingChainShardID := range srcf.incomingChainsHandler.GetShardIDs() {
+		err := srcf.generateExtendedShardHeaderRequester(incomingChainShardID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (srcf *sovereignShardRequestersContainerFactory) generateExtendedShardHeaderRequester(incomingChainShardID uint32) error {
 	shardC := srcf.shardCoordinator
-	identifierHdr := factory.ExtendedHeaderProofTopic + shardC.CommunicationIdentifier(shardC.SelfId())
+	identifierHdr := factory.IncomingChainHeaderProofTopic(incomingChainShardID) + shardC.CommunicationIdentifier(shardC.SelfId())
 
 	hdrStorer, err := srcf.store.GetStorer(dataRetriever.ExtendedShardHeadersUnit)
 	if err != nil {
 		return err
 	}
 
-	hdrNonceHashDataUnit := dataRetriever.ExtendedShardHeadersNonceHashDataUnit + dataRetriever.UnitType(shardC.SelfId())
+	hdrNonceHashDataUnit := srcf.incomingChainsHandler.GetNonceHashDataUnit(incomingChainShardID)
 	hdrNonceStore, err := srcf.store.GetStorer(hdrNonceHashDataUnit)
 	if err != nil {
 		return err
@@ -11,21 +11,47 @@ import (
 	storagerequesterscontainer "github.com/multiversx/mx-chain-go/dataRetriever/factory/storageRequestersContainer"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
 )
 
+const incomingChainShardID = core.MainChainShardId - 1
+
+func createIncomingChainsHandler() *sovereign.IncomingChainsHandlerMock {
+	return &sovereign.IncomingChainsHandlerMock{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.MainChainShardId, incomingChainShardID}
+		},
+		GetNonceHashDataUnitCalled: func(shardID uint32) dataRetriever.UnitType {
+			if shardID == incomingChainShardID {
+				return dataRetriever.IncomingChainHdrNonceHashDataUnit
+			}
+			return dataRetriever.ExtendedShardHeadersNonceHashDataUnit
+		},
+	}
+}
+
 func TestNewSovereignShardRequestersContainerFactory(t *testing.T) {
 	t.Parallel()
 
 	t.Run("nil input, should fail", func(t *testing.T) {
-		sovContainer, err := storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(nil)
+		sovContainer, err := storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(nil, createIncomingChainsHandler())
 		require.Nil(t, sovContainer)
 		require.Equal(t, errorsMx.ErrNilShardRequesterContainerFactory, err)
 	})
+	t.Run("nil incoming chains handler, should fail", func(t *testing.T) {
+		args := getArgumentsShard()
+		shardContainer, _ := storagerequesterscontainer.NewShardRequestersContainerFactory(args)
+		sovContainer, err := storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer, nil)
+		require.Nil(t, sovContainer)
+		require.Equal(t, errorsMx.ErrNilIncomingChainsHandler, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := getArgumentsShard()
 		shardContainer, _ := storagerequesterscontainer.NewShardRequestersContainerFactory(args)
-		sovContainer, err := storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer)
+		sovContainer, err := storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer, createIncomingChainsHandler())
 		require.NotNil(t, sovContainer)
 		require.Nil(t, err)
 		require.False(t, sovContainer.IsInterfaceNil())
@@ -36,8 +62,15 @@ func TestSovereignShardRequestersContainerFactory_Create(t *testing.T) {
 	t.Parallel()
 
 	args := getArgumentsShard()
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			requestedUnits[unitType] = struct{}{}
+			return &storageStubs.StorerStub{}, nil
+		},
+	}
 	shardContainer, _ := storagerequesterscontainer.NewShardRequestersContainerFactory(args)
-	sovContainer, _ := storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer)
+	sovContainer, _ := storagerequesterscontainer.NewSovereignShardRequestersContainerFactory(shardContainer, createIncomingChainsHandler())
 
 	container, err := sovContainer.Create()
 	require.Nil(t, err)
@@ -50,21 +83,24 @@ func TestSovereignShardRequestersContainerFactory_Create(t *testing.T) {
 	numRequesterMetaBlockHeaders := 0
 	numRequesterPeerAuth := 1
 	numRequesterValidatorInfo := 1
-	numRequesterExtendedHeader := 1
+	numRequesterExtendedHeader := 2
 	numRequesters := numRequesterTxs + numRequesterHeaders + numRequesterMiniBlocks + numRequesterMetaBlockHeaders +
 		numRequesterSCRs + numRequesterRewardTxs + numRequesterPeerAuth + numRequesterValidatorInfo + numRequesterExtendedHeader
 
 	require.Equal(t, numRequesters, container.Len())
+	require.Contains(t, requestedUnits, dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
+	require.Contains(t, requestedUnits, dataRetriever.IncomingChainHdrNonceHashDataUnit)
 
 	sovShardIDStr := fmt.Sprintf("_%d", core.SovereignChainShardId)
 	allKeys := map[string]struct{}{
-		factory.TransactionTopic + sovShardIDStr:         {},
-		factory.UnsignedTransactionTopic + sovShardIDStr: {},
-		factory.ShardBlocksTopic + sovShardIDStr:         {},
-		factory.MiniBlocksTopic + sovShardIDStr:          {},
-		common.PeerAuthenticationTopic:                   {},
-		common.ValidatorInfoTopic + sovShardIDStr:        {},
-		factory.ExtendedHeaderProofTopic + sovShardIDStr: {},
+		factory.TransactionTopic + sovShardIDStr:                                    {},
+		factory.UnsignedTransactionTopic + sovShardIDStr:                            {},
+		factory.ShardBlocksTopic + sovShardIDStr:                                    {},
+		factory.MiniBlocksTopic + sovShardIDStr:                                     {},
+		common.PeerAuthenticationTopic:                                              {},
+		common.ValidatorInfoTopic + sovShardIDStr:                                   {},
+		factory.ExtendedHeaderProofTopic + sovShardIDStr:                            {},
+		factory.IncomingChainHeaderProofTopic(incomingChainShardID) + sovShardIDStr: {},
 	}
 	iterateFunc := func(key string, requester dataRetriever.Requester) bool {
 		require.False(t, strings.Contains(strings.ToLower(key), "meta"))
@@ -358,3 +358,11 @@ type PeerAuthenticationPayloadValidator interface {
 	ValidateTimestamp(payloadTimestamp int64) error
 	IsInterfaceNil() bool
 }
+
+// IncomingChainsHandler defines the chains from which a sovereign chain notarizes incoming headers, each of them
+// tracked under its own shard ID and having its own extended shard headers nonce-hash pair data unit
+type IncomingChainsHandler interface {
+	GetShardIDs() []uint32
+	GetNonceHashDataUnit(shardID uint32) UnitType
+	IsInterfaceNil() bool
+}
@@ -35,29 +35,37 @@ func NewSovereignResolverRequestHandler(resolverRequestHandler *resolverRequestH
 
 // RequestExtendedShardHeaderByNonce method asks for extended shard header from the connected peers by nonce
 func (srrh *sovereignResolverRequestHandler) RequestExtendedShardHeaderByNonce(nonce uint64) {
-	suffix := fmt.Sprintf("%s_%d", sovUniqueHeadersSuffix, srrh.shardID)
+	srrh.RequestIncomingChainHeaderByNonce(core.MainChainShardId, nonce)
+}
+
+// RequestIncomingChainHeaderByNonce method asks for the extended shard header of the incoming chain tracked under the
+// provided shard ID from the connected peers by nonce
+func (srrh *sovereignResolverRequestHandler) RequestIncomingChainHeaderByNonce(incomingChainShardID uint32, nonce uint64) {
+	suffix := srrh.getIncomingChainHeadersSuffix(incomingChainShardID)
 	key := []byte(fmt.Sprintf("%d-%d", srrh.shardID, nonce))
 	if !srrh.testIfRequestIsNeeded(key, suffix) {
 		return
 	}
 
-	log.Debug("RequestExtendedShardHeaderByNonce.getExtendedShardHeaderRequester: requesting extended shard header by nonce from network",
+	log.Debug("RequestIncomingChainHeaderByNonce.getExtendedShardHeaderRequester: requesting extended shard header by nonce from network",
 		"shard", srrh.shardID,
+		"incoming chain shard", incomingChainShardID,
 		"nonce", nonce,
 	)
 
-	requester, err := srrh.getExtendedShardHeaderRequester()
+	requester, err := srrh.getExtendedShardHeaderRequester(incomingChainShardID)
 	if err != nil {
-		log.Error("RequestExtendedShardHeaderByNonce.getExtendedShardHeaderRequester",
+		log.Error("RequestIncomingChainHeaderByNonce.getExtendedShardHeaderRequester",
 			"error", err.Error(),
 			"shard", srrh.shardID,
+			"incoming chain shard", incomingChainShardID,
 		)
 		return
 	}
 
 	headerRequester, ok := requester.(NonceRequester)
 	if !ok {
-		log.Error("sovereignResolverRequestHandler.RequestExtendedShardHeaderByNonce: wrong assertion type when creating header requester")
+		log.Error("sovereignResolverRequestHandler.RequestIncomingChainHeaderByNonce: wrong assertion type when creating header requester")
 		return
 	}
 
@@ -66,9 +74,10 @@ func (srrh *sovereignResolverRequestHandler) RequestExtendedShardHeaderByNonce(n
 	epoch := srrh.getEpoch()
 	err = headerRequester.RequestDataFromNonce(nonce, epoch)
 	if err != nil {
-		log.Debug("RequestExtendedShardHeaderByNonce.RequestDataFromNonce",
+		log.Debug("RequestIncomingChainHeaderByNonce.RequestDataFromNonce",
 			"error", err.Error(),
 			"epoch", epoch,
+			"incoming chain shard", incomingChainShardID,
 			"nonce", nonce,
 		)
 		return
@@ -77,13 +86,24 @@ func (srrh *sovereignResolverRequestHandler) RequestExtendedShardHeaderByNonce(n
 	srrh.addRequestedItems([][]byte{key}, suffix)
 }
 
-func (srrh *sovereignResolverRequestHandler) getExtendedShardHeaderRequester() (dataRetriever.Requester, error) {
-	headerRequester, err := srrh.requestersFinder.IntraShardRequester(factory.ExtendedHeaderProofTopic)
+// getIncomingChainHeadersSuffix returns the requested items suffix of the extended shard headers of the provided
+// incoming chain, such that headers with the same nonce from different incoming chains are requested independently
+func (srrh *sovereignResolverRequestHandler) getIncomingChainHeadersSuffix(incomingChainShardID uint32) string {
+	if incomingChainShardID == core.MainChainShardId {
+		return fmt.Sprintf("%s_%d", sovUniqueHeadersSuffix, srrh.shardID)
+	}
+
+	return fmt.Sprintf("%s_%d", sovUniqueHeadersSuffix, incomingChainShardID)
+}
+
+func (srrh *sovereignResolverRequestHandler) getExtendedShardHeaderRequester(incomingChainShardID uint32) (dataRetriever.Requester, error) {
+	topic := factory.IncomingChainHeaderProofTopic(incomingChainShardID)
+	headerRequester, err := srrh.requestersFinder.IntraShardRequester(topic)
 	if err != nil {
 		log.Warn("extended header proof container not found, available requesters in container",
 			"requesters", srrh.requestersFinder.RequesterKeys(),
 		)
-		return nil, fmt.Errorf("%w, topic: %s", err, factory.ExtendedHeaderProofTopic)
+		return nil, fmt.Errorf("%w, topic: %s", err, topic)
 	}
 
 	return headerRequester, nil
@@ -101,7 +121,7 @@ func (srrh *sovereignResolverRequestHandler) RequestExtendedShardHeader(hash []b
 		"hash", hash,
 	)
 
-	headerRequester, err := srrh.getExtendedShardHeaderRequester()
+	headerRequester, err := srrh.getExtendedShardHeaderRequester(core.MainChainShardId)
 	if err != nil {
 		log.Error("RequestExtendedShardHeader.getExtendedShardHeaderRequester",
 			"error", err.Error(),
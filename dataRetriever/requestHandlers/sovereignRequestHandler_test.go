@@ -103,6 +103,67 @@ func TestSovereignResolverRequestHandler_RequestExtendedShardHeaderByNonce(t *te
 	require.True(t, wasWhiteListed)
 }
 
+func TestSovereignResolverRequestHandler_RequestIncomingChainHeaderByNonce(t *testing.T) {
+	requestedNonce := uint64(4)
+	incomingChainShardID := core.MainChainShardId - 1
+
+	shardID := core.SovereignChainShardId
+	suffix := fmt.Sprintf("%s_%d", sovUniqueHeadersSuffix, incomingChainShardID)
+	expectedKey := fmt.Sprintf("%d-%d", shardID, requestedNonce)
+	expectedFullKey := expectedKey + suffix
+
+	wasWhiteListed := false
+	wasNonceRequested := false
+
+	requestItemHandler := &mock.RequestedItemsHandlerStub{
+		HasCalled: func(key string) bool {
+			require.Equal(t, expectedFullKey, key)
+			return false
+		},
+		AddCalled: func(key string) error {
+			require.Equal(t, expectedFullKey, key)
+			return nil
+		},
+	}
+
+	nonceRequester := &dataRetrieverMocks.NonceRequesterStub{
+		RequestDataFromNonceCalled: func(nonce uint64, epoch uint32) error {
+			require.Equal(t, requestedNonce, nonce)
+
+			wasNonceRequested = true
+			return nil
+		},
+	}
+	requesterFinder := &dataRetrieverMocks.RequestersFinderStub{
+		IntraShardRequesterCalled: func(baseTopic string) (dataRetriever.Requester, error) {
+			require.Equal(t, factory.IncomingChainHeaderProofTopic(incomingChainShardID), baseTopic)
+			return nonceRequester, nil
+		},
+	}
+
+	whiteListAdder := &mock.WhiteListHandlerStub{
+		AddCalled: func(keys [][]byte) {
+			require.Equal(t, keys, [][]byte{[]byte(expectedKey)})
+			wasWhiteListed = true
+		},
+	}
+
+	resolver, _ := NewResolverRequestHandler(
+		requesterFinder,
+		requestItemHandler,
+		whiteListAdder,
+		1,
+		shardID,
+		time.Second,
+	)
+
+	sovResolver, _ := NewSovereignResolverRequestHandler(resolver)
+	sovResolver.RequestIncomingChainHeaderByNonce(incomingChainShardID, requestedNonce)
+
+	require.True(t, wasNonceRequested)
+	require.True(t, wasWhiteListed)
+}
+
 func TestSovereignResolverRequestHandler_RequestExtendedShardHeader(t *testing.T) {
 	requestedHash := []byte("hash")
 
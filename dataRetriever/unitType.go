@@ -53,6 +53,14 @@ const (
 	ExtendedShardHeadersNonceHashDataUnit UnitType = 25
 	// ExtendedShardHeadersUnit is the extended shard headers storage unit identifier
 	ExtendedShardHeadersUnit UnitType = 26
+	// OutGoingOperationsUnit is the unconfirmed outgoing bridge operations storage unit identifier
+	OutGoingOperationsUnit UnitType = 27
+	// IncomingHeadersQueueUnit is the storage unit identifier of the incoming headers waiting for confirmations
+	IncomingHeadersQueueUnit UnitType = 29
+	// IncomingChainHdrNonceHashDataUnit is the extended shard headers nonce-hash pair data unit identifier of the first
+	// additional incoming chain. 40 -> first additional incoming chain, 41 -> second one and so on, up to
+	// MaxNumOfIncomingChains additional incoming chains
+	IncomingChainHdrNonceHashDataUnit UnitType = 40
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -62,6 +70,10 @@ const (
 	//creation
 )
 
+// MaxNumOfIncomingChains is the maximum number of additional incoming chains, each of them having its own
+// extended shard headers nonce-hash pair data unit
+const MaxNumOfIncomingChains = 10
+
 // UnitType is the type for Storage unit identifiers
 type UnitType uint8
 
@@ -118,6 +130,14 @@ func (ut UnitType) String() string {
 		return "ExtendedShardHeadersNonceHashDataUnit"
 	case ExtendedShardHeadersUnit:
 		return "ExtendedShardHeadersUnit"
+	case OutGoingOperationsUnit:
+		return "OutGoingOperationsUnit"
+	case IncomingHeadersQueueUnit:
+		return "IncomingHeadersQueueUnit"
+	}
+
+	if ut >= IncomingChainHdrNonceHashDataUnit && ut < IncomingChainHdrNonceHashDataUnit+MaxNumOfIncomingChains {
+		return fmt.Sprintf("%s%d", "IncomingChainHdrNonceHashDataUnit", ut-IncomingChainHdrNonceHashDataUnit)
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
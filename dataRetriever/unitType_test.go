@@ -56,6 +56,11 @@ func TestUnitType_String(t *testing.T) {
 	ut = ScheduledSCRsUnit
 	require.Equal(t, "ScheduledSCRsUnit", ut.String())
 
+	ut = IncomingChainHdrNonceHashDataUnit + 1
+	require.Equal(t, "IncomingChainHdrNonceHashDataUnit1", ut.String())
+	ut = IncomingChainHdrNonceHashDataUnit + MaxNumOfIncomingChains
+	require.Equal(t, "unknown type 50", ut.String())
+
 	ut = 200
 	require.Equal(t, "ShardHdrNonceHashDataUnit100", ut.String())
 
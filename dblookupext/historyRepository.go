@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/container"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -173,6 +174,8 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	hr.recordIncomingSCRsOriginalTxHashes(body, scrResultsFromPool, epoch)
+
 	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), logs)
 	if err != nil {
 		return err
@@ -186,6 +189,35 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	return nil
 }
 
+// recordIncomingSCRsOriginalTxHashes saves the epoch of the incoming chains transactions which generated the incoming scrs
+// from the provided block, so that the incoming scrs can be looked up by the incoming chain transaction hash
+func (hr *historyRepository) recordIncomingSCRsOriginalTxHashes(body *block.Body, scrResultsFromPool map[string]data.TransactionHandler, epoch uint32) {
+	for _, miniBlock := range body.MiniBlocks {
+		if !isIncomingMiniBlock(miniBlock) {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			scr, ok := scrResultsFromPool[string(txHash)].(*smartContractResult.SmartContractResult)
+			if !ok || len(scr.OriginalTxHash) == 0 {
+				continue
+			}
+
+			err := hr.epochByHashIndex.saveEpochByHash(scr.OriginalTxHash, epoch)
+			if err != nil {
+				logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "recordIncomingSCRsOriginalTxHashes()",
+					"scrHash", txHash, "originalTxHash", scr.OriginalTxHash, "err", err)
+			}
+		}
+	}
+}
+
+// isIncomingMiniBlock returns true for the mini blocks of a sovereign chain block which hold the incoming scrs received
+// from any of the incoming chains, which are tracked under shard IDs different from the sovereign chain's shard ID
+func isIncomingMiniBlock(miniBlock *block.MiniBlock) bool {
+	return miniBlock.ReceiverShardID == core.SovereignChainShardId && miniBlock.SenderShardID != core.SovereignChainShardId
+}
+
 func (hr *historyRepository) putHashByRound(blockHeaderHash []byte, header data.HeaderHandler) error {
 	roundToByteSlice := hr.uint64ByteSliceConverter.ToByteSlice(header.GetRound())
 	return hr.blockHashByRound.Put(roundToByteSlice, blockHeaderHash)
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-go/common/mock"
 	"github.com/multiversx/mx-chain-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/multiversx/mx-chain-go/epochStart/mock"
@@ -152,6 +153,65 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockWithIncomingSCRs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(3)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	mainChainTxHash := []byte("mainChainTxHash")
+	incomingChainTxHash := []byte("incomingChainTxHash")
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes:        [][]byte{[]byte("incomingSCR1"), []byte("incomingSCR2"), []byte("incomingSCR3")},
+				SenderShardID:   core.MainChainShardId,
+				ReceiverShardID: core.SovereignChainShardId,
+				Type:            block.SmartContractResultBlock,
+			},
+			{
+				TxHashes:        [][]byte{[]byte("incomingChainSCR")},
+				SenderShardID:   core.MainChainShardId - 1,
+				ReceiverShardID: core.SovereignChainShardId,
+				Type:            block.SmartContractResultBlock,
+			},
+			{
+				TxHashes:        [][]byte{[]byte("scr")},
+				SenderShardID:   core.SovereignChainShardId,
+				ReceiverShardID: core.SovereignChainShardId,
+				Type:            block.SmartContractResultBlock,
+			},
+		},
+	}
+	scrResultsFromPool := map[string]data.TransactionHandler{
+		"incomingSCR1":     &smartContractResult.SmartContractResult{OriginalTxHash: mainChainTxHash},
+		"incomingSCR2":     &smartContractResult.SmartContractResult{OriginalTxHash: mainChainTxHash},
+		"incomingSCR3":     &smartContractResult.SmartContractResult{},
+		"incomingChainSCR": &smartContractResult.SmartContractResult{OriginalTxHash: incomingChainTxHash},
+		"scr":              &smartContractResult.SmartContractResult{OriginalTxHash: []byte("sovereignTxHash")},
+	}
+
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Epoch: 3}, blockBody, scrResultsFromPool, nil, nil, nil)
+	require.Nil(t, err)
+
+	epoch, err := repo.GetEpochByHash(mainChainTxHash)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), epoch)
+
+	epoch, err = repo.GetEpochByHash(incomingChainTxHash)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), epoch)
+
+	_, err = repo.GetEpochByHash([]byte("sovereignTxHash"))
+	require.NotNil(t, err)
+
+	results, err := repo.GetResultsHashesByTxHash(mainChainTxHash, epoch)
+	require.Nil(t, err)
+	require.Len(t, results.ScResultsHashesAndEpoch, 1)
+	require.ElementsMatch(t, [][]byte{[]byte("incomingSCR1"), []byte("incomingSCR2")}, results.ScResultsHashesAndEpoch[0].ScResultsHashes)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	bootStrapFactory "github.com/multiversx/mx-chain-go/epochStart/bootstrap/factory"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/process/block/sovereign/incomingHeader"
 	"github.com/multiversx/mx-chain-go/process/factory/interceptorscontainer"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -51,7 +52,7 @@ func (sbp *sovereignBootStrapShardProcessor) requestAndProcessForShard(peerMiniB
 		return err
 	}
 
-	sovStorageHandler := newSovereignShardStorageHandler(storageHandlerComponent)
+	sovStorageHandler := newSovereignShardStorageHandler(storageHandlerComponent, sbp.runTypeComponents.IncomingChainsHandler())
 
 	defer sovStorageHandler.CloseStorageService()
 
@@ -162,10 +163,20 @@ func (sbp *sovereignBootStrapShardProcessor) baseSyncHeaders(
 	meta data.MetaHeaderHandler,
 	timeToWaitForRequestedData time.Duration,
 ) (map[string]data.HeaderHandler, error) {
-	hashesToRequest := make([][]byte, 0, 2)
-	shardIds := make([]uint32, 0, 2)
+	sovHeader, castOk := meta.(data.SovereignChainHeaderHandler)
+	if !castOk {
+		return nil, fmt.Errorf("%w in sovereignBootStrapShardProcessor.baseSyncHeaders", epochStart.ErrWrongTypeAssertion)
+	}
+
+	crossChainData, err := sovereign.GetEpochStartCrossChainData(sbp.coreComponentsHolder.InternalMarshalizer(), sovHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	hashesToRequest := make([][]byte, 0, len(crossChainData)+1)
+	shardIds := make([]uint32, 0, len(crossChainData)+1)
 
-	for _, epochStartData := range meta.GetEpochStartHandler().GetLastFinalizedHeaderHandlers() {
+	for _, epochStartData := range crossChainData {
 		hashesToRequest = append(hashesToRequest, epochStartData.GetHeaderHash())
 		shardIds = append(shardIds, epochStartData.GetShardID())
 	}
@@ -176,7 +187,7 @@ func (sbp *sovereignBootStrapShardProcessor) baseSyncHeaders(
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), timeToWaitForRequestedData)
-	err := sbp.headersSyncer.SyncMissingHeadersByHash(shardIds, hashesToRequest, ctx)
+	err = sbp.headersSyncer.SyncMissingHeadersByHash(shardIds, hashesToRequest, ctx)
 	cancel()
 	if err != nil {
 		return nil, err
@@ -269,11 +280,15 @@ func (sbp *sovereignBootStrapShardProcessor) createEpochStartInterceptorsContain
 		return nil, nil, err
 	}
 
-	incomingHeaderProcessor, err := incomingHeader.CreateIncomingHeaderProcessor(
-		sbp.generalConfig.SovereignConfig.NotifierConfig.WebSocketConfig,
+	// storage is not opened while bootstrapping, hence the bridge rate limits state is only kept in memory
+	incomingHeaderProcessor, err := incomingHeader.CreateIncomingHeadersRouter(
+		sbp.generalConfig.SovereignConfig,
+		sbp.coreComponentsHolder.AddressPubKeyConverter(),
 		sbp.dataPool,
-		sbp.generalConfig.SovereignConfig.MainChainNotarization.MainChainNotarizationStartRound,
+		disabled.NewChainStorer(),
 		sbp.runTypeComponents,
+		sbp.coreComponentsHolder.EnableEpochsHandler(),
+		sbp.statusHandler,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -282,6 +297,7 @@ func (sbp *sovereignBootStrapShardProcessor) createEpochStartInterceptorsContain
 	interceptorsContainerFactory, err := interceptorscontainer.NewSovereignShardInterceptorsContainerFactory(interceptorscontainer.ArgsSovereignShardInterceptorsContainerFactory{
 		ShardContainer:           sp,
 		IncomingHeaderSubscriber: incomingHeaderProcessor,
+		IncomingChainsHandler:    sbp.runTypeComponents.IncomingChainsHandler(),
 	})
 	if err != nil {
 		return nil, nil, err
@@ -296,5 +312,5 @@ func (bp *sovereignBootStrapShardProcessor) createCrossHeaderRequester() (update
 		return nil, fmt.Errorf("%w in sovereignBootStrapShardProcessor.createCrossHeaderRequester for extendedHeaderRequester", process.ErrWrongTypeAssertion)
 	}
 
-	return updateSync.NewExtendedHeaderRequester(extendedHeaderRequester)
+	return updateSync.NewExtendedHeaderRequester(extendedHeaderRequester, bp.runTypeComponents.IncomingChainsHandler())
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	factoryInterceptors "github.com/multiversx/mx-chain-go/epochStart/bootstrap/factory"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -139,6 +140,15 @@ func TestBootStrapSovereignShardProcessor_syncHeadersFrom(t *testing.T) {
 			},
 		},
 	}
+	incomingChainShardID := core.MainChainShardId - 1
+	incomingChainHeaderHash := []byte("incomingChainHeaderHash")
+	err := sovereign.SetEpochStartCrossChainData(sovProc.coreComponentsHolder.InternalMarshalizer(), sovHdr, []*block.EpochStartCrossChainData{
+		{
+			ShardID:    incomingChainShardID,
+			HeaderHash: incomingChainHeaderHash,
+		},
+	})
+	require.Nil(t, err)
 
 	syncedHeaders := map[string]data.HeaderHandler{
 		"hash": &block.SovereignChainHeader{},
@@ -146,8 +156,8 @@ func TestBootStrapSovereignShardProcessor_syncHeadersFrom(t *testing.T) {
 	headersSyncedCt := 0
 	sovProc.headersSyncer = &epochStartMocks.HeadersByHashSyncerStub{
 		SyncMissingHeadersByHashCalled: func(shardIDs []uint32, headersHashes [][]byte, ctx context.Context) error {
-			require.Equal(t, []uint32{core.MainChainShardId, core.SovereignChainShardId}, shardIDs)
-			require.Equal(t, [][]byte{lastCrossChainHeaderHash, prevEpochStartHash}, headersHashes)
+			require.Equal(t, []uint32{core.MainChainShardId, incomingChainShardID, core.SovereignChainShardId}, shardIDs)
+			require.Equal(t, [][]byte{lastCrossChainHeaderHash, incomingChainHeaderHash, prevEpochStartHash}, headersHashes)
 			headersSyncedCt++
 			return nil
 		},
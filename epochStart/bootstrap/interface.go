@@ -100,6 +100,7 @@ type RunTypeComponentsHolder interface {
 	DataCodecHandler() sovereign.DataCodecHandler
 	TopicsCheckerHandler() sovereign.TopicsCheckerHandler
 	AccountsCreator() state.AccountFactory
+	IncomingChainsHandler() process.IncomingChainsHandler
 	IsInterfaceNil() bool
 }
 
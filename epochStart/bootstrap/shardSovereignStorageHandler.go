@@ -2,7 +2,6 @@ package bootstrap
 
 import (
 	"encoding/hex"
-	"errors"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -12,16 +11,22 @@ import (
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign"
 )
 
 type sovereignShardStorageHandler struct {
 	*shardStorageHandler
+	incomingChainsHandler process.IncomingChainsHandler
 }
 
 // internal constructor, no need to check for nils
-func newSovereignShardStorageHandler(shardStorageHandler *shardStorageHandler) *sovereignShardStorageHandler {
+func newSovereignShardStorageHandler(
+	shardStorageHandler *shardStorageHandler,
+	incomingChainsHandler process.IncomingChainsHandler,
+) *sovereignShardStorageHandler {
 	return &sovereignShardStorageHandler{
-		shardStorageHandler,
+		shardStorageHandler:   shardStorageHandler,
+		incomingChainsHandler: incomingChainsHandler,
 	}
 }
 
@@ -97,56 +102,71 @@ func (ssh *sovereignShardStorageHandler) saveTriggerRegistry(components *Compone
 	return ssh.baseSaveTriggerRegistry(&triggerReg, sovHeader.GetRound())
 }
 
+// saveLastCrossChainNotarizedHeaders saves the last cross notarized header of each incoming chain recorded in the epoch
+// start sovereign header, so that the tracking of all the incoming chains is restored from them
 func (ssh *sovereignShardStorageHandler) saveLastCrossChainNotarizedHeaders(
 	sovBlock data.MetaHeaderHandler,
 	headers map[string]data.HeaderHandler,
 ) ([]bootstrapStorage.BootstrapHeaderInfo, error) {
 	log.Debug("sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders")
 
-	lastCrossChainNotarizedData, err := getEpochStartShardData(sovBlock, core.MainChainShardId)
-	if errors.Is(err, epochStart.ErrEpochStartDataForShardNotFound) {
-		log.Debug("no cross chain header has been notarized yet")
-		return []bootstrapStorage.BootstrapHeaderInfo{}, nil
-	} else if err != nil {
-		return nil, err
+	sovHeader, castOk := sovBlock.(data.SovereignChainHeaderHandler)
+	if !castOk {
+		return nil, fmt.Errorf("%w in sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders", epochStart.ErrWrongTypeAssertion)
 	}
 
-	lastCrossChainHeaderHash := lastCrossChainNotarizedData.GetHeaderHash()
-	log.Debug("sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders",
-		"hash", lastCrossChainHeaderHash,
-	)
-
-	neededHdr, ok := headers[string(lastCrossChainHeaderHash)]
-	if !ok {
-		return nil, fmt.Errorf("%w in sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders: hash: %s",
-			epochStart.ErrMissingHeader,
-			hex.EncodeToString(lastCrossChainHeaderHash))
+	crossChainData, err := sovereign.GetEpochStartCrossChainData(ssh.marshalizer, sovHeader)
+	if err != nil {
+		return nil, err
+	}
+	if len(crossChainData) == 0 {
+		log.Debug("no cross chain header has been notarized yet")
 	}
 
-	extendedShardHeader, ok := neededHdr.(data.ShardHeaderExtendedHandler)
-	if !ok {
-		return nil, fmt.Errorf("%w in sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders for extended shard header",
-			epochStart.ErrWrongTypeAssertion,
+	crossNotarizedHeaders := make([]bootstrapStorage.BootstrapHeaderInfo, 0, len(crossChainData))
+	for _, lastCrossChainNotarizedData := range crossChainData {
+		lastCrossChainHeaderHash := lastCrossChainNotarizedData.GetHeaderHash()
+		log.Debug("sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders",
+			"shard", lastCrossChainNotarizedData.GetShardID(),
+			"hash", lastCrossChainHeaderHash,
 		)
-	}
 
-	err = ssh.saveExtendedHeaderToStorage(extendedShardHeader, lastCrossChainHeaderHash)
-	if err != nil {
-		return nil, err
+		neededHdr, ok := headers[string(lastCrossChainHeaderHash)]
+		if !ok {
+			return nil, fmt.Errorf("%w in sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders: hash: %s",
+				epochStart.ErrMissingHeader,
+				hex.EncodeToString(lastCrossChainHeaderHash))
+		}
+
+		extendedShardHeader, ok := neededHdr.(data.ShardHeaderExtendedHandler)
+		if !ok {
+			return nil, fmt.Errorf("%w in sovereignShardStorageHandler.saveLastCrossChainNotarizedHeaders for extended shard header",
+				epochStart.ErrWrongTypeAssertion,
+			)
+		}
+
+		nonceHashDataUnit := ssh.incomingChainsHandler.GetNonceHashDataUnit(lastCrossChainNotarizedData.GetShardID())
+		err = ssh.saveExtendedHeaderToStorage(extendedShardHeader, lastCrossChainHeaderHash, nonceHashDataUnit)
+		if err != nil {
+			return nil, err
+		}
+
+		crossNotarizedHeaders = append(crossNotarizedHeaders, bootstrapStorage.BootstrapHeaderInfo{
+			ShardId: lastCrossChainNotarizedData.GetShardID(),
+			Nonce:   lastCrossChainNotarizedData.GetNonce(),
+			Hash:    lastCrossChainHeaderHash,
+			Epoch:   lastCrossChainNotarizedData.GetEpoch(),
+		})
 	}
 
-	crossNotarizedHeaders := make([]bootstrapStorage.BootstrapHeaderInfo, 0)
-	crossNotarizedHeaders = append(crossNotarizedHeaders, bootstrapStorage.BootstrapHeaderInfo{
-		ShardId: core.MainChainShardId,
-		Nonce:   lastCrossChainNotarizedData.GetNonce(),
-		Hash:    lastCrossChainHeaderHash,
-		Epoch:   lastCrossChainNotarizedData.GetEpoch(),
-	})
-
 	return crossNotarizedHeaders, nil
 }
 
-func (bsh *sovereignShardStorageHandler) saveExtendedHeaderToStorage(extendedShardHeader data.HeaderHandler, headerHash []byte) error {
+func (bsh *sovereignShardStorageHandler) saveExtendedHeaderToStorage(
+	extendedShardHeader data.HeaderHandler,
+	headerHash []byte,
+	nonceHashDataUnit dataRetriever.UnitType,
+) error {
 	headerBytes, err := bsh.marshalizer.Marshal(extendedShardHeader)
 	if err != nil {
 		return err
@@ -163,7 +183,7 @@ func (bsh *sovereignShardStorageHandler) saveExtendedHeaderToStorage(extendedSha
 	}
 
 	nonceToByteSlice := bsh.uint64Converter.ToByteSlice(extendedShardHeader.GetNonce())
-	extendedHdrNonceStorage, err := bsh.storageService.GetStorer(dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
+	extendedHdrNonceStorage, err := bsh.storageService.GetStorer(nonceHashDataUnit)
 	if err != nil {
 		return err
 	}
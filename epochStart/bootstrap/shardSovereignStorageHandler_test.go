@@ -9,11 +9,14 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/factory"
+	sovereignMock "github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,7 +44,7 @@ func TestSovereignShardStorageHandler_SaveDataToStorage(t *testing.T) {
 
 	args := createStorageHandlerArgs()
 	shardStorage, _ := NewShardStorageHandler(args)
-	sovShardStorage := newSovereignShardStorageHandler(shardStorage)
+	sovShardStorage := newSovereignShardStorageHandler(shardStorage, &sovereignMock.IncomingChainsHandlerMock{})
 
 	hash1 := []byte("hash1")
 	hash2 := []byte("hash2")
@@ -130,10 +133,25 @@ func TestSovereignShardStorageHandler_SaveDataToStorage(t *testing.T) {
 func TestSovereignShardStorageHandler_SaveDataToStorageCheckLastCrossChainNotarizedDataIsSaved(t *testing.T) {
 	t.Parallel()
 
+	incomingChainShardID := core.MainChainShardId - 1
+	incomingChainNonceHashDataUnit := dataRetriever.IncomingChainHdrNonceHashDataUnit
 	args := createStorageHandlerArgs()
+	args.GeneralConfig.SovereignConfig.IncomingChains = []config.IncomingChain{
+		{
+			ChainID:                          "sov2",
+			ExtendedShardHdrNonceHashStorage: args.GeneralConfig.SovereignConfig.ExtendedShardHdrNonceHashStorage,
+		},
+	}
 	args.AdditionalStorageServiceCreator = factory.NewSovereignAdditionalStorageServiceFactory()
 	shardStorage, _ := NewShardStorageHandler(args)
-	sovShardStorage := newSovereignShardStorageHandler(shardStorage)
+	sovShardStorage := newSovereignShardStorageHandler(shardStorage, &sovereignMock.IncomingChainsHandlerMock{
+		GetNonceHashDataUnitCalled: func(shardID uint32) dataRetriever.UnitType {
+			if shardID == incomingChainShardID {
+				return incomingChainNonceHashDataUnit
+			}
+			return dataRetriever.ExtendedShardHeadersNonceHashDataUnit
+		},
+	})
 
 	hash1 := []byte("hash1")
 	lastFinalizedCrossChainHeaderHash := []byte("lastFinalizedCrossChainHeaderHash")
@@ -162,9 +180,32 @@ func TestSovereignShardStorageHandler_SaveDataToStorageCheckLastCrossChainNotari
 			},
 		},
 	}
+	incomingChainHeaderHash := []byte("incomingChainHeaderHash")
+	incomingChainHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				ChainID: []byte("sov2"),
+				Epoch:   1,
+				Round:   7,
+				Nonce:   6,
+			},
+		},
+	}
+	err := sovereign.SetEpochStartCrossChainData(sovShardStorage.marshalizer, sovHdr, []*block.EpochStartCrossChainData{
+		{
+			ShardID:    incomingChainShardID,
+			Epoch:      1,
+			Round:      7,
+			Nonce:      6,
+			HeaderHash: incomingChainHeaderHash,
+		},
+	})
+	require.Nil(t, err)
+
 	headers := map[string]data.HeaderHandler{
 		string(hash1): sovHdr,
 		string(lastFinalizedCrossChainHeaderHash): lastFinalizedCrossChainHeader,
+		string(incomingChainHeaderHash):           incomingChainHeader,
 	}
 
 	components := &ComponentsNeededForBootstrap{
@@ -175,7 +216,7 @@ func TestSovereignShardStorageHandler_SaveDataToStorageCheckLastCrossChainNotari
 		NodesConfig:         &nodesCoordinator.NodesCoordinatorRegistry{},
 	}
 
-	err := sovShardStorage.SaveDataToStorage(components, components.ShardHeader, false, nil)
+	err = sovShardStorage.SaveDataToStorage(components, components.ShardHeader, false, nil)
 	require.Nil(t, err)
 
 	bootStorer, err := sovShardStorage.storageService.GetStorer(dataRetriever.BootstrapUnit)
@@ -198,6 +239,12 @@ func TestSovereignShardStorageHandler_SaveDataToStorageCheckLastCrossChainNotari
 				Nonce:   lastFinalizedCrossChainHeader.GetNonce(),
 				Hash:    lastFinalizedCrossChainHeaderHash,
 			},
+			{
+				ShardId: incomingChainShardID,
+				Epoch:   incomingChainHeader.GetEpoch(),
+				Nonce:   incomingChainHeader.GetNonce(),
+				Hash:    incomingChainHeaderHash,
+			},
 		},
 		LastSelfNotarizedHeaders: []bootstrapStorage.BootstrapHeaderInfo{
 			{
@@ -232,4 +279,20 @@ func TestSovereignShardStorageHandler_SaveDataToStorageCheckLastCrossChainNotari
 	extendedHdrNonceBytesHash, err := extendedHdrNonceStorer.Get(nonceToBytesKey)
 	require.Nil(t, err)
 	require.Equal(t, lastFinalizedCrossChainHeaderHash, extendedHdrNonceBytesHash)
+
+	extendedHdrBytes, err = extendedHdrStorer.Get(incomingChainHeaderHash)
+	require.Nil(t, err)
+
+	extendedHdrStored = &block.ShardHeaderExtended{}
+	err = sovShardStorage.marshalizer.Unmarshal(extendedHdrStored, extendedHdrBytes)
+	require.Nil(t, err)
+	require.Equal(t, incomingChainHeader, extendedHdrStored)
+
+	incomingChainHdrNonceStorer, err := sovShardStorage.storageService.GetStorer(incomingChainNonceHashDataUnit)
+	require.Nil(t, err)
+
+	nonceToBytesKey = sovShardStorage.uint64Converter.ToByteSlice(incomingChainHeader.GetNonce())
+	extendedHdrNonceBytesHash, err = incomingChainHdrNonceStorer.Get(nonceToBytesKey)
+	require.Nil(t, err)
+	require.Equal(t, incomingChainHeaderHash, extendedHdrNonceBytesHash)
 }
@@ -133,6 +133,9 @@ var ErrNilBootstrapParamsHandler = errors.New("nil bootstrap parameters handler"
 // ErrNilBroadcastMessenger is raised when a valid broadcast messenger is expected but nil used
 var ErrNilBroadcastMessenger = errors.New("broadcast messenger is nil")
 
+// ErrNilEquivocationDetector is raised when a valid equivocation detector is expected but nil used
+var ErrNilEquivocationDetector = errors.New("equivocation detector is nil")
+
 // ErrNilChronologyHandler is raised when a valid chronology handler is expected but nil used
 var ErrNilChronologyHandler = errors.New("chronology handler is nil")
 
@@ -550,6 +553,9 @@ var ErrNilGenesisNodesSetupHandler = errors.New("nil genesis nodes setup handler
 // ErrNilManagedPeersHolder signals that a nil managed peers holder has been provided
 var ErrNilManagedPeersHolder = errors.New("nil managed peers holder")
 
+// ErrNilManagedKeysReloader signals that a nil managed keys reloader has been provided
+var ErrNilManagedKeysReloader = errors.New("nil managed keys reloader")
+
 // ErrNilManagedPeersMonitor signals that a nil managed peers monitor has been provided
 var ErrNilManagedPeersMonitor = errors.New("nil managed peers monitor")
 
@@ -674,6 +680,9 @@ var ErrNilNode = errors.New("nil node")
 // ErrNilOutgoingOperationsFormatter signals that a nil outgoing operations formatter has been provided
 var ErrNilOutgoingOperationsFormatter = errors.New("nil outgoing operations formatter has been provided")
 
+// ErrNilOutgoingOperationsRouter signals that a nil outgoing operations router has been provided
+var ErrNilOutgoingOperationsRouter = errors.New("nil outgoing operations router has been provided")
+
 // ErrNilOutGoingOperationsPool signals that a nil outgoing operations pool has been provided
 var ErrNilOutGoingOperationsPool = errors.New("nil outgoing operations pool has been provided")
 
@@ -704,6 +713,9 @@ var ErrNilConsensusMessage = errors.New("nil consensus message has been provided
 // ErrNilExtraSignersHolder signals that a nil extra signers holder has been provided
 var ErrNilExtraSignersHolder = errors.New("nil extra signer holder has been provided")
 
+// ErrNilSigningJournal signals that a nil signing journal has been provided
+var ErrNilSigningJournal = errors.New("nil signing journal has been provided")
+
 // ErrNilSubRoundEndV2Creator signals that a nil sub round end v2 creator has been provided
 var ErrNilSubRoundEndV2Creator = errors.New("nil sub round end v2 creator has been provided")
 
@@ -713,6 +725,9 @@ var ErrNilBridgeOpHandler = errors.New("nil bridge operation handler has been pr
 // ErrOutGoingOperationsNotFound signals that an outgoing operation could not be found
 var ErrOutGoingOperationsNotFound = errors.New("outgoing operation could not be found")
 
+// ErrOutGoingOperationsBatchesNotAggregated signals that signatures for outgoing operations batches have not been aggregated
+var ErrOutGoingOperationsBatchesNotAggregated = errors.New("outgoing operations batches signatures have not been aggregated")
+
 // ErrInvalidTypeConversion signals that a type conversion has failed
 var ErrInvalidTypeConversion = errors.New("invalid type conversion")
 
@@ -797,6 +812,15 @@ var ErrNilSerializer = errors.New("nil codec serializer")
 // ErrNilTopicsChecker signals that a nil topics checker has been provided
 var ErrNilTopicsChecker = errors.New("nil topics checker")
 
+// ErrNilIncomingChainsHandler signals that a nil incoming chains handler has been provided
+var ErrNilIncomingChainsHandler = errors.New("nil incoming chains handler")
+
+// ErrNilMainChainValidatorsHandler signals that a nil main chain validators handler has been provided
+var ErrNilMainChainValidatorsHandler = errors.New("nil main chain validators handler")
+
+// ErrUnknownIncomingChainID signals that a header with a chain id which does not belong to any incoming chain was provided
+var ErrUnknownIncomingChainID = errors.New("unknown incoming chain id")
+
 // ErrNilOperationsHasher signals that a nil outgoing operations hasher has been provided
 var ErrNilOperationsHasher = errors.New("nil outgoing operations hasher")
 
@@ -877,3 +901,38 @@ var ErrNilOutportDataProviderFactory = errors.New("nil outport data provider fac
 
 // ErrReceivedSovereignEpochStartBlockWithExtendedHeaders signals that an invalid epoch start sovereign block has been received
 var ErrReceivedSovereignEpochStartBlockWithExtendedHeaders = errors.New("received invalid epoch start sovereign block, should not contain any extended headers")
+
+// ErrNilEventsProofVerifier signals that a nil events proof verifier has been provided
+var ErrNilEventsProofVerifier = errors.New("nil events proof verifier")
+
+// ErrInvalidIncomingEventProof signals that an incoming event does not have a valid inclusion proof in its main chain header
+var ErrInvalidIncomingEventProof = errors.New("invalid incoming event proof")
+
+// ErrNilBridgeRateLimiter signals that a nil bridge rate limiter has been provided
+var ErrNilBridgeRateLimiter = errors.New("nil bridge rate limiter")
+
+// ErrNilDepositsVolumeComputer signals that a nil incoming deposits volume computer has been provided
+var ErrNilDepositsVolumeComputer = errors.New("nil deposits volume computer")
+
+// ErrIncomingRateLimitsExceeded signals that a block includes extended shard headers whose incoming deposits exceed the
+// bridge rate limits
+var ErrIncomingRateLimitsExceeded = errors.New("extended shard headers included over the incoming bridge rate limits")
+
+// ErrNilBridgePauseHandler signals that a nil bridge pause handler has been provided
+var ErrNilBridgePauseHandler = errors.New("nil bridge pause handler")
+
+// ErrIncomingBridgePaused signals that a block includes extended shard headers while the incoming bridge is paused
+var ErrIncomingBridgePaused = errors.New("extended shard headers included while the incoming bridge is paused")
+
+// ErrOutGoingBridgePaused signals that outgoing operations were about to be signed while the outgoing bridge is paused
+var ErrOutGoingBridgePaused = errors.New("outgoing operations should not be signed while the outgoing bridge is paused")
+
+// ErrNilEpochStartNodesComputer signals that a nil epoch start nodes computer has been provided
+var ErrNilEpochStartNodesComputer = errors.New("nil epoch start nodes computer")
+
+// ErrNilValidatorSetRotationCreator signals that a nil validator set rotation creator has been provided
+var ErrNilValidatorSetRotationCreator = errors.New("nil validator set rotation creator")
+
+// ErrUnverifiableSovereignChainHeader signals that a header of another sovereign chain was received while the incoming
+// headers signatures are verified, but the validators of its chain are not known
+var ErrUnverifiableSovereignChainHeader = errors.New("sovereign chain header can not be verified without the validators of its chain")
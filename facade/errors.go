@@ -43,3 +43,6 @@ var ErrTooManyAddressesInBulk = errors.New("too many addresses in the bulk reque
 
 // ErrNilStatusMetrics signals that a nil status metrics was provided
 var ErrNilStatusMetrics = errors.New("nil status metrics handler")
+
+// ErrEmptyAdminToken signals that the admin token file does not hold a token
+var ErrEmptyAdminToken = errors.New("empty admin token")
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
@@ -426,6 +427,56 @@ func (inf *initialNodeFacade) GetSCRsByTxHash(_ string, _ string) ([]*transactio
 	return nil, errNodeStarting
 }
 
+// GetIncomingSCRsByMainChainTxHash return a nil slice and error
+func (inf *initialNodeFacade) GetIncomingSCRsByMainChainTxHash(_ string) ([]*transaction.ApiSmartContractResult, error) {
+	return nil, errNodeStarting
+}
+
+// GetUnconfirmedOutGoingOperations returns nil
+func (inf *initialNodeFacade) GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	return nil
+}
+
+// GetOutGoingOperations returns nil and error
+func (inf *initialNodeFacade) GetOutGoingOperations(_ string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetLastCrossNotarizedIncomingHeader returns nil and error
+func (inf *initialNodeFacade) GetLastCrossNotarizedIncomingHeader(_ string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetDeadLetteredOutGoingOperations returns nil
+func (inf *initialNodeFacade) GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	return nil
+}
+
+// RequeueDeadLetteredOutGoingOperations returns error
+func (inf *initialNodeFacade) RequeueDeadLetteredOutGoingOperations(_ string) error {
+	return errNodeStarting
+}
+
+// DropDeadLetteredOutGoingOperations returns error
+func (inf *initialNodeFacade) DropDeadLetteredOutGoingOperations(_ string) error {
+	return errNodeStarting
+}
+
+// IsAdminTokenValid returns false
+func (inf *initialNodeFacade) IsAdminTokenValid(_ string) bool {
+	return false
+}
+
+// GetBridgePauseState returns nil
+func (inf *initialNodeFacade) GetBridgePauseState() *common.BridgePauseStateAPIResponse {
+	return nil
+}
+
+// GetOutGoingOperationProof returns nil and error
+func (inf *initialNodeFacade) GetOutGoingOperationProof(_ string) (*common.OutGoingOperationProofAPIResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetManagedKeysCount returns 0
 func (inf *initialNodeFacade) GetManagedKeysCount() int {
 	return 0
@@ -451,6 +502,21 @@ func (inf *initialNodeFacade) GetWaitingManagedKeys() ([]string, error) {
 	return nil, errNodeStarting
 }
 
+// GetEquivocationProofs returns nil
+func (inf *initialNodeFacade) GetEquivocationProofs() []*equivocation.Proof {
+	return nil
+}
+
+// AddManagedKey returns empty string and error
+func (inf *initialNodeFacade) AddManagedKey(_ string) (string, error) {
+	return "", errNodeStarting
+}
+
+// RemoveManagedKey returns error
+func (inf *initialNodeFacade) RemoveManagedKey(_ string) error {
+	return errNodeStarting
+}
+
 // GetWaitingEpochsLeftForPublicKey returns 0 and error
 func (inf *initialNodeFacade) GetWaitingEpochsLeftForPublicKey(_ string) (uint32, error) {
 	return 0, errNodeStarting
@@ -354,6 +354,38 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Zero(t, left)
 	assert.Equal(t, errNodeStarting, err)
 
+	scrs, err := inf.GetIncomingSCRsByMainChainTxHash("")
+	assert.Nil(t, scrs)
+	assert.Equal(t, errNodeStarting, err)
+
+	batches := inf.GetUnconfirmedOutGoingOperations()
+	assert.Nil(t, batches)
+
+	batch, err := inf.GetOutGoingOperations("")
+	assert.Nil(t, batch)
+	assert.Equal(t, errNodeStarting, err)
+
+	header, err := inf.GetLastCrossNotarizedIncomingHeader("")
+	assert.Nil(t, header)
+	assert.Equal(t, errNodeStarting, err)
+
+	batches = inf.GetDeadLetteredOutGoingOperations()
+	assert.Nil(t, batches)
+
+	err = inf.RequeueDeadLetteredOutGoingOperations("")
+	assert.Equal(t, errNodeStarting, err)
+
+	err = inf.DropDeadLetteredOutGoingOperations("")
+	assert.Equal(t, errNodeStarting, err)
+
+	assert.False(t, inf.IsAdminTokenValid("token"))
+
+	assert.Nil(t, inf.GetBridgePauseState())
+
+	operationProof, err := inf.GetOutGoingOperationProof("")
+	assert.Nil(t, operationProof)
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.NotNil(t, inf)
 }
 
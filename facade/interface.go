@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
@@ -86,6 +87,15 @@ type NodeHandler interface {
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
 
+	// GetEquivocationProofs returns the proofs of the validators which sent conflicting consensus messages
+	GetEquivocationProofs() []*equivocation.Proof
+
+	// AddManagedKey schedules the addition of the provided hex encoded private key as a managed key
+	AddManagedKey(privateKeyHex string) (string, error)
+
+	// RemoveManagedKey schedules the removal of the managed key defined by the provided public key
+	RemoveManagedKey(publicKey string) error
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 
@@ -128,6 +138,15 @@ type ApiResolver interface {
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error)
+	GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error)
+	GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	RequeueDeadLetteredOutGoingOperations(hash string) error
+	DropDeadLetteredOutGoingOperations(hash string) error
+	GetBridgePauseState() *common.BridgePauseStateAPIResponse
+	GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
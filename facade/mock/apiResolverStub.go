@@ -51,6 +51,15 @@ type ApiResolverStub struct {
 	GetWaitingManagedKeysCalled                 func() ([]string, error)
 	GetWaitingEpochsLeftForPublicKeyCalled      func(publicKey string) (uint32, error)
 	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetIncomingSCRsByMainChainTxHashCalled      func(txHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetUnconfirmedOutGoingOperationsCalled      func() []*common.OutGoingOperationsBatchAPIResponse
+	GetOutGoingOperationsCalled                 func(hash string) (*common.OutGoingOperationsBatchAPIResponse, error)
+	GetLastCrossNotarizedIncomingHeaderCalled   func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error)
+	GetDeadLetteredOutGoingOperationsCalled     func() []*common.OutGoingOperationsBatchAPIResponse
+	RequeueDeadLetteredOutGoingOperationsCalled func(hash string) error
+	DropDeadLetteredOutGoingOperationsCalled    func(hash string) error
+	GetBridgePauseStateCalled                   func() *common.BridgePauseStateAPIResponse
+	GetOutGoingOperationProofCalled             func(hash string) (*common.OutGoingOperationProofAPIResponse, error)
 }
 
 // GetSCRsByTxHash -
@@ -62,6 +71,87 @@ func (ars *ApiResolverStub) GetSCRsByTxHash(txHash string, scrHash string) ([]*t
 	return nil, nil
 }
 
+// GetIncomingSCRsByMainChainTxHash -
+func (ars *ApiResolverStub) GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+	if ars.GetIncomingSCRsByMainChainTxHashCalled != nil {
+		return ars.GetIncomingSCRsByMainChainTxHashCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetUnconfirmedOutGoingOperations -
+func (ars *ApiResolverStub) GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	if ars.GetUnconfirmedOutGoingOperationsCalled != nil {
+		return ars.GetUnconfirmedOutGoingOperationsCalled()
+	}
+
+	return nil
+}
+
+// GetOutGoingOperations -
+func (ars *ApiResolverStub) GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+	if ars.GetOutGoingOperationsCalled != nil {
+		return ars.GetOutGoingOperationsCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetLastCrossNotarizedIncomingHeader -
+func (ars *ApiResolverStub) GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+	if ars.GetLastCrossNotarizedIncomingHeaderCalled != nil {
+		return ars.GetLastCrossNotarizedIncomingHeaderCalled(chainID)
+	}
+
+	return nil, nil
+}
+
+// GetDeadLetteredOutGoingOperations -
+func (ars *ApiResolverStub) GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	if ars.GetDeadLetteredOutGoingOperationsCalled != nil {
+		return ars.GetDeadLetteredOutGoingOperationsCalled()
+	}
+
+	return nil
+}
+
+// RequeueDeadLetteredOutGoingOperations -
+func (ars *ApiResolverStub) RequeueDeadLetteredOutGoingOperations(hash string) error {
+	if ars.RequeueDeadLetteredOutGoingOperationsCalled != nil {
+		return ars.RequeueDeadLetteredOutGoingOperationsCalled(hash)
+	}
+
+	return nil
+}
+
+// DropDeadLetteredOutGoingOperations -
+func (ars *ApiResolverStub) DropDeadLetteredOutGoingOperations(hash string) error {
+	if ars.DropDeadLetteredOutGoingOperationsCalled != nil {
+		return ars.DropDeadLetteredOutGoingOperationsCalled(hash)
+	}
+
+	return nil
+}
+
+// GetBridgePauseState -
+func (ars *ApiResolverStub) GetBridgePauseState() *common.BridgePauseStateAPIResponse {
+	if ars.GetBridgePauseStateCalled != nil {
+		return ars.GetBridgePauseStateCalled()
+	}
+
+	return nil
+}
+
+// GetOutGoingOperationProof -
+func (ars *ApiResolverStub) GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+	if ars.GetOutGoingOperationProofCalled != nil {
+		return ars.GetOutGoingOperationProofCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetTransaction -
 func (ars *ApiResolverStub) GetTransaction(hash string, withEvents bool) (*transaction.ApiTransactionResult, error) {
 	if ars.GetTransactionHandler != nil {
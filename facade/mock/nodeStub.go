@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
@@ -32,6 +33,9 @@ type NodeStub struct {
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
+	GetEquivocationProofsCalled                    func() []*equivocation.Proof
+	AddManagedKeyCalled                            func(privateKeyHex string) (string, error)
+	RemoveManagedKeyCalled                         func(publicKey string) error
 	ValidatorStatisticsApiCalled                   func() (map[string]*validator.ValidatorStatistics, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
@@ -217,6 +221,33 @@ func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	return nil
 }
 
+// GetEquivocationProofs -
+func (ns *NodeStub) GetEquivocationProofs() []*equivocation.Proof {
+	if ns.GetEquivocationProofsCalled != nil {
+		return ns.GetEquivocationProofsCalled()
+	}
+
+	return nil
+}
+
+// AddManagedKey -
+func (ns *NodeStub) AddManagedKey(privateKeyHex string) (string, error) {
+	if ns.AddManagedKeyCalled != nil {
+		return ns.AddManagedKeyCalled(privateKeyHex)
+	}
+
+	return "", nil
+}
+
+// RemoveManagedKey -
+func (ns *NodeStub) RemoveManagedKey(publicKey string) error {
+	if ns.RemoveManagedKeyCalled != nil {
+		return ns.RemoveManagedKeyCalled(publicKey)
+	}
+
+	return nil
+}
+
 // ValidatorStatisticsApi -
 func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error) {
 	if ns.ValidatorStatisticsApiCalled != nil {
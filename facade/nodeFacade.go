@@ -3,9 +3,12 @@ package facade
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -20,6 +23,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	"github.com/multiversx/mx-chain-go/errors"
@@ -68,6 +72,7 @@ type nodeFacade struct {
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
 	blockchain             chainData.ChainHandler
+	adminToken             []byte
 }
 
 // NewNodeFacade creates a new Facade with a NodeWrapper
@@ -94,6 +99,10 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.Blockchain) {
 		return nil, ErrNilBlockchain
 	}
+	adminToken, err := loadAdminToken(arg.ApiRoutesConfig.AdminAuth.AdminTokenFile)
+	if err != nil {
+		return nil, err
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -108,11 +117,31 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
 		blockchain:             arg.Blockchain,
+		adminToken:             []byte(adminToken),
 	}
 
 	return nf, nil
 }
 
+func loadAdminToken(adminTokenFile string) (string, error) {
+	if len(adminTokenFile) == 0 {
+		log.Debug("no admin token file provided, the admin endpoints will reject all requests")
+		return "", nil
+	}
+
+	content, err := os.ReadFile(adminTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("%w while reading the admin token file", err)
+	}
+
+	adminToken := strings.TrimSpace(string(content))
+	if len(adminToken) == 0 {
+		return "", fmt.Errorf("%w in file %s", ErrEmptyAdminToken, adminTokenFile)
+	}
+
+	return adminToken, nil
+}
+
 func checkWebserverAntifloodConfig(cfg config.WebServerAntifloodConfig) error {
 	if !cfg.WebServerAntifloodEnabled {
 		return nil
@@ -310,6 +339,62 @@ func (nf *nodeFacade) GetSCRsByTxHash(txHash string, scrHash string) ([]*transac
 	return nf.apiResolver.GetSCRsByTxHash(txHash, scrHash)
 }
 
+// GetIncomingSCRsByMainChainTxHash will return the list of incoming smart contract results generated by a main chain tx hash
+func (nf *nodeFacade) GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+	return nf.apiResolver.GetIncomingSCRsByMainChainTxHash(txHash)
+}
+
+// GetUnconfirmedOutGoingOperations will return all the outgoing operations batches not yet confirmed by the main chain
+func (nf *nodeFacade) GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	return nf.apiResolver.GetUnconfirmedOutGoingOperations()
+}
+
+// GetOutGoingOperations will return the outgoing operations batch by its hash or by one of its operations hash
+func (nf *nodeFacade) GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+	return nf.apiResolver.GetOutGoingOperations(hash)
+}
+
+// GetLastCrossNotarizedIncomingHeader will return the last header of the provided incoming chain notarized by the
+// sovereign chain. An empty chain ID stands for the main chain.
+func (nf *nodeFacade) GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+	return nf.apiResolver.GetLastCrossNotarizedIncomingHeader(chainID)
+}
+
+// GetDeadLetteredOutGoingOperations will return all the outgoing operations batches not confirmed by the main chain after the max send attempts
+func (nf *nodeFacade) GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	return nf.apiResolver.GetDeadLetteredOutGoingOperations()
+}
+
+// RequeueDeadLetteredOutGoingOperations will move the dead-lettered outgoing operations batch back to the pool, to be resent
+func (nf *nodeFacade) RequeueDeadLetteredOutGoingOperations(hash string) error {
+	return nf.apiResolver.RequeueDeadLetteredOutGoingOperations(hash)
+}
+
+// DropDeadLetteredOutGoingOperations will permanently remove the dead-lettered outgoing operations batch
+func (nf *nodeFacade) DropDeadLetteredOutGoingOperations(hash string) error {
+	return nf.apiResolver.DropDeadLetteredOutGoingOperations(hash)
+}
+
+// IsAdminTokenValid returns true if the provided token matches the admin token. It always returns false if no admin
+// token was configured
+func (nf *nodeFacade) IsAdminTokenValid(token string) bool {
+	if len(nf.adminToken) == 0 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(nf.adminToken, []byte(token)) == 1
+}
+
+// GetBridgePauseState will return the current pause state of the incoming and outgoing bridge
+func (nf *nodeFacade) GetBridgePauseState() *common.BridgePauseStateAPIResponse {
+	return nf.apiResolver.GetBridgePauseState()
+}
+
+// GetOutGoingOperationProof will return the merkle inclusion proof of an outgoing operation, along with its sovereign header and batch merkle root
+func (nf *nodeFacade) GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+	return nf.apiResolver.GetOutGoingOperationProof(hash)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
 func (nf *nodeFacade) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
 	return nf.apiResolver.GetTransactionsPool(fields)
@@ -644,6 +729,21 @@ func (nf *nodeFacade) GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32
 	return nf.apiResolver.GetWaitingEpochsLeftForPublicKey(publicKey)
 }
 
+// GetEquivocationProofs returns the proofs of the validators which sent conflicting consensus messages
+func (nf *nodeFacade) GetEquivocationProofs() []*equivocation.Proof {
+	return nf.node.GetEquivocationProofs()
+}
+
+// AddManagedKey schedules the addition of the provided hex encoded private key as a managed key, effective from the next round
+func (nf *nodeFacade) AddManagedKey(privateKeyHex string) (string, error) {
+	return nf.node.AddManagedKey(privateKeyHex)
+}
+
+// RemoveManagedKey schedules the removal of the managed key defined by the provided public key, effective from the next round
+func (nf *nodeFacade) RemoveManagedKey(publicKey string) error {
+	return nf.node.RemoveManagedKey(publicKey)
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
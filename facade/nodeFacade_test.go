@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -22,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/facade/mock"
@@ -183,6 +186,29 @@ func TestNewNodeFacade(t *testing.T) {
 		require.Nil(t, nf)
 		require.Equal(t, ErrNilBlockchain, err)
 	})
+	t.Run("missing admin token file should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArguments()
+		arg.ApiRoutesConfig.AdminAuth.AdminTokenFile = filepath.Join(t.TempDir(), "missing")
+		nf, err := NewNodeFacade(arg)
+
+		require.Nil(t, nf)
+		require.Contains(t, err.Error(), "while reading the admin token file")
+	})
+	t.Run("empty admin token file should error", func(t *testing.T) {
+		t.Parallel()
+
+		adminTokenFile := filepath.Join(t.TempDir(), "adminToken")
+		require.Nil(t, os.WriteFile(adminTokenFile, []byte(" \n"), 0600))
+
+		arg := createMockArguments()
+		arg.ApiRoutesConfig.AdminAuth.AdminTokenFile = adminTokenFile
+		nf, err := NewNodeFacade(arg)
+
+		require.Nil(t, nf)
+		require.ErrorIs(t, err, ErrEmptyAdminToken)
+	})
 
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
@@ -1355,6 +1381,164 @@ func TestNodeFacade_GetWaitingEpochsLeftForPublicKey(t *testing.T) {
 	assert.Equal(t, expectedResult, epochsLeft)
 }
 
+func TestNodeFacade_GetEquivocationProofs(t *testing.T) {
+	t.Parallel()
+
+	providedProofs := []*equivocation.Proof{
+		{
+			Type:   equivocation.DoubleProposal,
+			PubKey: "pk1",
+			Round:  10,
+		},
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetEquivocationProofsCalled: func() []*equivocation.Proof {
+			return providedProofs
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	proofs := nf.GetEquivocationProofs()
+	require.Equal(t, providedProofs, proofs)
+}
+
+func TestNodeFacade_GetOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	providedHash := "hash"
+	expectedBatch := &common.OutGoingOperationsBatchAPIResponse{Hash: providedHash}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetOutGoingOperationsCalled: func(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+			assert.Equal(t, providedHash, hash)
+			return expectedBatch, nil
+		},
+		GetUnconfirmedOutGoingOperationsCalled: func() []*common.OutGoingOperationsBatchAPIResponse {
+			return []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	batch, err := nf.GetOutGoingOperations(providedHash)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBatch, batch)
+	assert.Equal(t, []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}, nf.GetUnconfirmedOutGoingOperations())
+}
+
+func TestNodeFacade_GetLastCrossNotarizedIncomingHeader(t *testing.T) {
+	t.Parallel()
+
+	expectedHeader := &common.CrossNotarizedHeaderAPIResponse{Hash: "hash", Nonce: 10}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetLastCrossNotarizedIncomingHeaderCalled: func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+			assert.Equal(t, "chainID", chainID)
+			return expectedHeader, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	header, err := nf.GetLastCrossNotarizedIncomingHeader("chainID")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHeader, header)
+}
+
+func TestNodeFacade_DeadLetteredOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	expectedBatch := &common.OutGoingOperationsBatchAPIResponse{Hash: "hash", Status: "dead-lettered"}
+	requeuedHash := ""
+	droppedHash := ""
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetDeadLetteredOutGoingOperationsCalled: func() []*common.OutGoingOperationsBatchAPIResponse {
+			return []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}
+		},
+		RequeueDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+			requeuedHash = hash
+			return nil
+		},
+		DropDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+			droppedHash = hash
+			return nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}, nf.GetDeadLetteredOutGoingOperations())
+	assert.NoError(t, nf.RequeueDeadLetteredOutGoingOperations("hash1"))
+	assert.Equal(t, "hash1", requeuedHash)
+	assert.NoError(t, nf.DropDeadLetteredOutGoingOperations("hash2"))
+	assert.Equal(t, "hash2", droppedHash)
+}
+
+func TestNodeFacade_IsAdminTokenValid(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no admin token configured should reject all tokens", func(t *testing.T) {
+		t.Parallel()
+
+		nf, _ := NewNodeFacade(createMockArguments())
+
+		require.False(t, nf.IsAdminTokenValid(""))
+		require.False(t, nf.IsAdminTokenValid("admin token"))
+	})
+	t.Run("should check the token", func(t *testing.T) {
+		t.Parallel()
+
+		adminTokenFile := filepath.Join(t.TempDir(), "adminToken")
+		require.Nil(t, os.WriteFile(adminTokenFile, []byte("admin token\n"), 0600))
+
+		arg := createMockArguments()
+		arg.ApiRoutesConfig.AdminAuth.AdminTokenFile = adminTokenFile
+		nf, err := NewNodeFacade(arg)
+		require.Nil(t, err)
+
+		require.True(t, nf.IsAdminTokenValid("admin token"))
+		require.False(t, nf.IsAdminTokenValid("other token"))
+		require.False(t, nf.IsAdminTokenValid(""))
+	})
+}
+
+func TestNodeFacade_GetBridgePauseState(t *testing.T) {
+	t.Parallel()
+
+	expectedState := &common.BridgePauseStateAPIResponse{IncomingPaused: true}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetBridgePauseStateCalled: func() *common.BridgePauseStateAPIResponse {
+			return expectedState
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, expectedState, nf.GetBridgePauseState())
+}
+
+func TestNodeFacade_GetOutGoingOperationProof(t *testing.T) {
+	t.Parallel()
+
+	expectedProof := &common.OutGoingOperationProofAPIResponse{OperationHash: "opHash"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetOutGoingOperationProofCalled: func(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+			assert.Equal(t, "opHash", hash)
+			return expectedProof, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	proof, err := nf.GetOutGoingOperationProof("opHash")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedProof, proof)
+}
+
 func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	t.Parallel()
 
@@ -2348,6 +2532,44 @@ func TestNodeFacade_GetInternalStartOfEpochMetaBlock(t *testing.T) {
 	require.Equal(t, providedResponse, response)
 }
 
+func TestNodeFacade_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedPrivateKey := "private key"
+	providedPublicKey := "public key"
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		AddManagedKeyCalled: func(privateKeyHex string) (string, error) {
+			assert.Equal(t, providedPrivateKey, privateKeyHex)
+			return providedPublicKey, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	publicKey, err := nf.AddManagedKey(providedPrivateKey)
+	require.NoError(t, err)
+	require.Equal(t, providedPublicKey, publicKey)
+}
+
+func TestNodeFacade_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	providedPublicKey := "public key"
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		RemoveManagedKeyCalled: func(publicKey string) error {
+			assert.Equal(t, providedPublicKey, publicKey)
+			return expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	err := nf.RemoveManagedKey(providedPublicKey)
+	require.Equal(t, expectedErr, err)
+}
+
 func TestNodeFacade_Close(t *testing.T) {
 	t.Parallel()
 
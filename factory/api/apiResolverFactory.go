@@ -29,11 +29,13 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
 	"github.com/multiversx/mx-chain-go/node/external/logs"
+	"github.com/multiversx/mx-chain-go/node/external/sovereignAPI"
 	"github.com/multiversx/mx-chain-go/node/external/timemachine/fee"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts"
 	"github.com/multiversx/mx-chain-go/process"
+	sovereignBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
 	"github.com/multiversx/mx-chain-go/process/smartContract"
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
@@ -279,6 +281,30 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	bridgePauseHandler, err := sovereignBlock.NewBridgePauseHandler(args.StateComponents.AccountsAdapterAPI())
+	if err != nil {
+		return nil, err
+	}
+
+	operationsHashers, err := sovereignBlock.CreateOutGoingOperationsHashers(args.Configs.GeneralConfig.SovereignConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBridgeProcessor, err := sovereignAPI.NewAPIBridgeProcessor(sovereignAPI.ArgAPIBridgeProcessor{
+		OutGoingOperationsPool: args.RunTypeComponents.OutGoingOperationsPoolHandler(),
+		BlockTracker:           args.ProcessComponents.BlockTracker(),
+		IncomingChainsHandler:  args.RunTypeComponents.IncomingChainsHandler(),
+		BridgePauseHandler:     bridgePauseHandler,
+		HistoryRepository:      args.ProcessComponents.HistoryRepository(),
+		StorageService:         args.DataComponents.StorageService(),
+		Marshaller:             args.CoreComponents.InternalMarshalizer(),
+		OperationsHashers:      operationsHashers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.StatusCoreComponents.StatusMetrics(),
@@ -289,6 +315,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		APITransactionHandler:    apiTransactionProcessor,
 		APIBlockHandler:          apiBlockProcessor,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
+		APIBridgeHandler:         apiBridgeProcessor,
 		GenesisNodesSetupHandler: args.CoreComponents.GenesisNodesSetup(),
 		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
 		AccountsParser:           args.ProcessComponents.AccountsParser(),
@@ -482,6 +509,7 @@ func createArgsSCQueryService(args *scQueryElementArgs) (*smartContract.ArgsNewS
 			ShardCoordinator:           args.processComponents.ShardCoordinator(),
 			EnableEpochsHandler:        args.coreComponents.EnableEpochsHandler(),
 			NodesCoordinator:           args.processComponents.NodesCoordinator(),
+			ChainID:                    []byte(args.coreComponents.ChainID()),
 			IsInHistoricalBalancesMode: args.isInHistoricalBalancesMode,
 		}
 
@@ -530,6 +558,7 @@ func createArgsSCQueryService(args *scQueryElementArgs) (*smartContract.ArgsNewS
 			ChanceComputer:      args.coreComponents.Rater(),
 			ShardCoordinator:    args.processComponents.ShardCoordinator(),
 			NodesCoordinator:    args.processComponents.NodesCoordinator(),
+			ChainID:             []byte(args.coreComponents.ChainID()),
 		}
 
 		vmContainer, vmFactory, err = args.runTypeComponents.VmContainerShardFactoryCreator().CreateVmContainerFactory(argsNewVmContainerFactory)
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 	"github.com/multiversx/mx-chain-go/storage"
 )
 
@@ -139,7 +140,7 @@ func (hvh *headerVersionHandler) setInCache(epoch uint32, version string) {
 
 // Verify will check the header's fields such as the chain ID or the software version
 func (hvh *headerVersionHandler) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
+	if !outgoingBatches.IsReservedFieldValid(hdr) {
 		return process.ErrReservedFieldInvalid
 	}
 
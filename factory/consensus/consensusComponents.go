@@ -2,6 +2,7 @@ package consensus
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -15,6 +16,9 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/blacklist"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
+	disabledEquivocation "github.com/multiversx/mx-chain-go/consensus/equivocation/disabled"
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
@@ -57,6 +61,7 @@ type ConsensusComponentsFactoryArgs struct {
 	ConsensusModel        consensus.ConsensusModel
 	ExtraSignersHolder    bls.ExtraSignersHolder
 	SubRoundEndV2Creator  bls.SubRoundEndV2Creator
+	SigningJournal        consensus.SigningJournal
 }
 
 type consensusComponentsFactory struct {
@@ -78,6 +83,7 @@ type consensusComponentsFactory struct {
 
 	extraSignersHolder    bls.ExtraSignersHolder
 	subRoundEndV2Creator  bls.SubRoundEndV2Creator
+	signingJournal        consensus.SigningJournal
 	shardMessengerFactory sposFactory.BroadCastShardMessengerFactoryHandler
 }
 
@@ -87,6 +93,7 @@ type consensusComponents struct {
 	broadcastMessenger   consensus.BroadcastMessenger
 	worker               factory.ConsensusWorker
 	peerBlacklistHandler consensus.PeerBlacklistHandler
+	equivocationDetector factory.EquivocationDetector
 	consensusTopic       string
 	consensusGroupSize   int
 }
@@ -116,6 +123,7 @@ func NewConsensusComponentsFactory(args ConsensusComponentsFactoryArgs) (*consen
 		runTypeComponents:     args.RunTypeComponents,
 		extraSignersHolder:    args.ExtraSignersHolder,
 		subRoundEndV2Creator:  args.SubRoundEndV2Creator,
+		signingJournal:        args.SigningJournal,
 		shardMessengerFactory: args.RunTypeComponents.BroadCastShardMessengerFactoryHandler(),
 	}, nil
 }
@@ -193,6 +201,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.equivocationDetector, err = ccf.createEquivocationDetector()
+	if err != nil {
+		return nil, err
+	}
+
 	workerArgs := &spos.WorkerArgs{
 		ConsensusService:         consensusService,
 		BlockChain:               ccf.dataComponents.Blockchain(),
@@ -220,6 +233,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
 		PeerBlacklistHandler:     cc.peerBlacklistHandler,
 		EnableEpochHandler:       ccf.coreComponents.EnableEpochsHandler(),
+		EquivocationDetector:     cc.equivocationDetector,
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -268,6 +282,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		MessageSigningHandler:         p2pSigningHandler,
 		PeerBlacklistHandler:          cc.peerBlacklistHandler,
 		SigningHandler:                ccf.cryptoComponents.ConsensusSigningHandler(),
+		SigningJournal:                ccf.signingJournal,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -458,6 +473,7 @@ func (ccf *consensusComponentsFactory) createShardStorageAndSyncBootstrapper() (
 
 	argsShardStorageBootstrapper := storageBootstrap.ArgsShardStorageBootstrapper{
 		ArgsBaseStorageBootstrapper: argsBaseStorageBootstrapper,
+		IncomingChainsHandler:       ccf.runTypeComponents.IncomingChainsHandler(),
 	}
 
 	shardStorageBootstrapper, err := ccf.runTypeComponents.BootstrapperFromStorageCreator().CreateBootstrapperFromStorage(argsShardStorageBootstrapper)
@@ -701,6 +717,54 @@ func (ccf *consensusComponentsFactory) createP2pSigningHandler() (consensus.P2PS
 	return p2pFactory.NewMessageVerifier(p2pSignerArgs)
 }
 
+// CreateSigningJournal creates the signing journal described by the provided config, with the relative files paths
+// resolved against the provided working directory. A disabled journal is returned if the slashing protection is not
+// enabled
+func CreateSigningJournal(slashingProtectionConfig config.SlashingProtectionConfig, workingDir string) (consensus.SigningJournal, error) {
+	if !slashingProtectionConfig.Enabled {
+		return disabled.NewDisabledSigningJournal(), nil
+	}
+
+	signingJournal, err := slashingProtection.NewSigningJournal(slashingProtection.ArgsSigningJournal{
+		FilePath:       getSlashingProtectionFilePath(workingDir, slashingProtectionConfig.JournalFile),
+		ExportFilePath: getSlashingProtectionFilePath(workingDir, slashingProtectionConfig.ExportFile),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(slashingProtectionConfig.ImportFile) > 0 {
+		err = signingJournal.Import(getSlashingProtectionFilePath(workingDir, slashingProtectionConfig.ImportFile))
+		if err != nil {
+			return nil, fmt.Errorf("%w while importing the signing journal", err)
+		}
+	}
+
+	return signingJournal, nil
+}
+
+func getSlashingProtectionFilePath(workingDir string, file string) string {
+	if len(file) == 0 || filepath.IsAbs(file) {
+		return file
+	}
+
+	return filepath.Join(workingDir, file)
+}
+
+func (ccf *consensusComponentsFactory) createEquivocationDetector() (factory.EquivocationDetector, error) {
+	equivocationProofsConfig := ccf.config.EquivocationProofs
+	if !equivocationProofsConfig.Enabled {
+		return disabledEquivocation.NewDisabledEquivocationDetector(), nil
+	}
+
+	return equivocation.NewEquivocationDetector(equivocation.ArgsEquivocationDetector{
+		Hasher:               ccf.coreComponents.Hasher(),
+		PeerSignatureHandler: ccf.cryptoComponents.PeerSignatureHandler(),
+		SignatureVerifier:    ccf.cryptoComponents.MessageSignVerifier(),
+		ProofsDirectory:      filepath.Join(ccf.flagsConfig.WorkingDir, equivocationProofsConfig.ProofsDirectory),
+	})
+}
+
 func (ccf *consensusComponentsFactory) addCloserInstances(closers ...update.Closer) error {
 	hardforkTrigger := ccf.processComponents.HardforkTrigger()
 	for _, c := range closers {
@@ -777,6 +841,9 @@ func checkArgs(args ConsensusComponentsFactoryArgs) error {
 	if check.IfNil(args.ExtraSignersHolder) {
 		return errors.ErrNilExtraSignersHolder
 	}
+	if check.IfNil(args.SigningJournal) {
+		return errors.ErrNilSigningJournal
+	}
 	if check.IfNil(args.SubRoundEndV2Creator) {
 		return errors.ErrNilSubRoundEndV2Creator
 	}
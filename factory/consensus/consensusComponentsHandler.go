@@ -113,6 +113,18 @@ func (mcc *managedConsensusComponents) ConsensusGroupSize() (int, error) {
 	return mcc.consensusComponents.consensusGroupSize, nil
 }
 
+// EquivocationDetector returns the consensus equivocation detector
+func (mcc *managedConsensusComponents) EquivocationDetector() factory.EquivocationDetector {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.equivocationDetector
+}
+
 // CheckSubcomponents verifies all subcomponents
 func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	mcc.mutConsensusComponents.RLock()
@@ -130,6 +142,9 @@ func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.broadcastMessenger) {
 		return errors.ErrNilBroadcastMessenger
 	}
+	if check.IfNil(mcc.equivocationDetector) {
+		return errors.ErrNilEquivocationDetector
+	}
 
 	return nil
 }
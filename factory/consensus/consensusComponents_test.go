@@ -3,6 +3,8 @@ package consensus_test
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos/bls"
 	retriever "github.com/multiversx/mx-chain-go/dataRetriever"
@@ -178,6 +181,7 @@ func createMockConsensusComponentsFactoryArgs() consensusComp.ConsensusComponent
 		ConsensusModel:        consensus.ConsensusModelV1,
 		ExtraSignersHolder:    &subRoundsHolder.ExtraSignersHolderMock{},
 		SubRoundEndV2Creator:  bls.NewSubRoundEndV2Creator(),
+		SigningJournal:        &consensusMocks.SigningJournalStub{},
 		RunTypeComponents: &mainFactoryMocks.RunTypeComponentsStub{
 			BootstrapperFromStorageFactory: &factoryMocks.BootstrapperFromStorageFactoryMock{
 				CreateBootstrapperFromStorageCalled: func(args storageBootstrap.ArgsShardStorageBootstrapper) (process.BootstrapperFromStorage, error) {
@@ -447,6 +451,16 @@ func TestNewConsensusComponentsFactory(t *testing.T) {
 		require.Nil(t, ccf)
 		require.Equal(t, errorsMx.ErrNilExtraSignersHolder, err)
 	})
+	t.Run("nil SigningJournal, should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockConsensusComponentsFactoryArgs()
+		args.SigningJournal = nil
+		ccf, err := consensusComp.NewConsensusComponentsFactory(args)
+
+		require.Nil(t, ccf)
+		require.Equal(t, errorsMx.ErrNilSigningJournal, err)
+	})
 	t.Run("nil SubRoundEndV2Creator, should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -1107,3 +1121,51 @@ func TestConsensusComponentsFactory_CreateShardStorageAndSyncBootstrapperShouldW
 		assert.Equal(t, "*sync.SovereignChainShardBootstrap", fmt.Sprintf("%T", cc.BootStrapper()))
 	})
 }
+
+func TestCreateSigningJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("slashing protection disabled, should create a disabled journal", func(t *testing.T) {
+		t.Parallel()
+
+		workingDir := t.TempDir()
+		signingJournal, err := consensusComp.CreateSigningJournal(config.SlashingProtectionConfig{
+			JournalFile: "slashingProtection/journal.log",
+		}, workingDir)
+		require.Nil(t, err)
+		require.Equal(t, "*disabled.signingJournal", fmt.Sprintf("%T", signingJournal))
+
+		require.Nil(t, signingJournal.CheckAndRecord([]byte("pk"), 1, 0, []byte("hash")))
+		_, err = os.Stat(filepath.Join(workingDir, "slashingProtection", "journal.log"))
+		require.True(t, os.IsNotExist(err))
+	})
+	t.Run("missing import file, should error", func(t *testing.T) {
+		t.Parallel()
+
+		signingJournal, err := consensusComp.CreateSigningJournal(config.SlashingProtectionConfig{
+			Enabled:     true,
+			JournalFile: "slashingProtection/journal.log",
+			ImportFile:  "slashingProtection/missing.log",
+		}, t.TempDir())
+		require.NotNil(t, err)
+		require.Nil(t, signingJournal)
+	})
+	t.Run("should resolve the relative files in the working directory", func(t *testing.T) {
+		t.Parallel()
+
+		workingDir := t.TempDir()
+		absoluteExportFile := filepath.Join(t.TempDir(), "export.log")
+		signingJournal, err := consensusComp.CreateSigningJournal(config.SlashingProtectionConfig{
+			Enabled:     true,
+			JournalFile: "slashingProtection/journal.log",
+			ExportFile:  absoluteExportFile,
+		}, workingDir)
+		require.Nil(t, err)
+
+		require.Nil(t, signingJournal.CheckAndRecord([]byte("pk"), 1, 0, []byte("hash")))
+		_, err = os.Stat(filepath.Join(workingDir, "slashingProtection", "journal.log"))
+		require.Nil(t, err)
+		_, err = os.Stat(absoluteExportFile)
+		require.Nil(t, err)
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/update"
 )
 
 const (
@@ -87,4 +89,6 @@ type ArgsExporter struct {
 	NodeOperationMode                common.NodeOperation
 
 	ShardCoordinatorFactory sharding.ShardCoordinatorFactory
+	OutGoingOperationsPool  sovereignBlock.OutGoingOperationsPool
+	IncomingHeadersQueues   update.IncomingHeadersQueuesHandler
 }
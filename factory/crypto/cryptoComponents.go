@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/peerSignatureHandler"
 	"github.com/multiversx/mx-chain-go/genesis/process/disabled"
 	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
@@ -68,6 +70,7 @@ type cryptoComponentsFactory struct {
 	isInImportMode                       bool
 	importModeNoSigCheck                 bool
 	p2pKeyPemFileName                    string
+	coreComponentsHolder                 factory.CoreComponentsHolder
 }
 
 // cryptoParams holds the node public/private key data
@@ -99,6 +102,7 @@ type cryptoComponents struct {
 	consensusSigningHandler consensus.SigningHandler
 	managedPeersHolder      common.ManagedPeersHolder
 	keysHandler             consensus.KeysHandler
+	managedKeysReloader     common.ManagedKeysReloader
 	cryptoParams
 	p2pCryptoParams
 }
@@ -134,6 +138,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 		enableEpochs:                         args.EnableEpochs,
 		p2pKeyPemFileName:                    args.P2pKeyPemFileName,
 		allValidatorKeysPemFileName:          args.AllValidatorKeysPemFileName,
+		coreComponentsHolder:                 args.CoreComponentsHolder,
 	}
 
 	return ccf, nil
@@ -251,6 +256,11 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		return nil, err
 	}
 
+	managedKeysReloader, err := ccf.createManagedKeysReloader(managedPeersHolder, blockSignKeyGen)
+	if err != nil {
+		return nil, err
+	}
+
 	return &cryptoComponents{
 		txSingleSigner:          txSingleSigner,
 		blockSingleSigner:       interceptSingleSigner,
@@ -263,12 +273,41 @@ func (ccf *cryptoComponentsFactory) Create() (*cryptoComponents, error) {
 		consensusSigningHandler: consensusSigningHandler,
 		managedPeersHolder:      managedPeersHolder,
 		keysHandler:             keysHandler,
+		managedKeysReloader:     managedKeysReloader,
 		cryptoParams:            *cp,
 		p2pCryptoParams:         *p2pCryptoParamsInstance,
 		p2pSingleSigner:         p2pSingleSigner,
 	}, nil
 }
 
+func (ccf *cryptoComponentsFactory) createManagedKeysReloader(
+	managedPeersHolder common.ManagedPeersHolder,
+	keygen crypto.KeyGenerator,
+) (common.ManagedKeysReloader, error) {
+	reloadConfig := ccf.config.ManagedKeysReload
+	if !reloadConfig.Enabled {
+		return keysManagement.NewDisabledManagedKeysReloader(), nil
+	}
+	if !managedPeersHolder.IsMultiKeyMode() {
+		log.Warn("the managed keys reload is enabled but the node is not running in multi-key mode, the reload will be disabled")
+		return keysManagement.NewDisabledManagedKeysReloader(), nil
+	}
+
+	argsManagedKeysReloader := keysManagement.ArgsManagedKeysReloader{
+		ManagedPeersHolder:         managedPeersHolder,
+		KeyGenerator:               keygen,
+		KeyLoader:                  ccf.keyLoader,
+		PubKeyConverter:            ccf.validatorPubKeyConverter,
+		RoundHandler:               ccf.coreComponentsHolder.RoundHandler(),
+		AllValidatorsKeysFile:      ccf.allValidatorKeysPemFileName,
+		WatchAllValidatorsKeysFile: reloadConfig.WatchAllValidatorsKeysFile,
+		FileCheckInterval:          time.Duration(reloadConfig.FileCheckIntervalInSeconds) * time.Second,
+		RoundCheckInterval:         time.Duration(reloadConfig.RoundCheckIntervalInMillis) * time.Millisecond,
+	}
+
+	return keysManagement.NewManagedKeysReloader(argsManagedKeysReloader)
+}
+
 func (ccf *cryptoComponentsFactory) createSingleSigner(importModeNoSigCheck bool) (crypto.SingleSigner, error) {
 	if importModeNoSigCheck {
 		log.Warn("using disabled single signer because the node is running in import-db 'turbo mode'")
@@ -333,6 +372,13 @@ func (ccf *cryptoComponentsFactory) createCryptoParams(
 
 		return ccf.generateCryptoParams(keygen, "in import-db mode", make([][]byte, 0))
 	}
+	if ccf.config.RemoteSigner.Enabled {
+		if len(handledPrivateKeys) > 0 {
+			return nil, fmt.Errorf("invalid node configuration: remote signer enabled and allValidatorsKeys.pem file provided")
+		}
+
+		return ccf.createRemoteCryptoParams(keygen)
+	}
 	cp, err := ccf.readCryptoParams(keygen)
 	if err == nil {
 		cp.handledPrivateKeys = handledPrivateKeys
@@ -379,6 +425,61 @@ func (ccf *cryptoComponentsFactory) readCryptoParams(keygen crypto.KeyGenerator)
 	return cp, nil
 }
 
+func (ccf *cryptoComponentsFactory) createRemoteCryptoParams(keygen crypto.KeyGenerator) (*cryptoParams, error) {
+	remoteSignerConfig := ccf.config.RemoteSigner
+	publicKeyBytes, err := ccf.validatorPubKeyConverter.Decode(remoteSignerConfig.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remote signer public key %s", err, remoteSignerConfig.PublicKey)
+	}
+
+	publicKey, err := keygen.PublicKeyFromByteArray(publicKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := remoteSigner.NewClientTLSConfig(
+		remoteSignerConfig.CertificateFile,
+		remoteSignerConfig.KeyFile,
+		remoteSignerConfig.CACertificateFile,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the remote signer TLS files", err)
+	}
+
+	singleSigner, err := ccf.createSingleSigner(false)
+	if err != nil {
+		return nil, err
+	}
+
+	argsRemoteSigner := remoteSigner.ArgsRemoteSigner{
+		URL:           remoteSignerConfig.URL,
+		Timeout:       time.Duration(remoteSignerConfig.TimeoutInMilliseconds) * time.Millisecond,
+		RoundDuration: time.Duration(ccf.coreComponentsHolder.GenesisNodesSetup().GetRoundDuration()) * time.Millisecond,
+		TLSConfig:     tlsConfig,
+		KeyGenerator:  keygen,
+		SingleSigner:  singleSigner,
+	}
+	signer, err := remoteSigner.NewRemoteSigner(argsRemoteSigner)
+	if err != nil {
+		return nil, err
+	}
+
+	cp := &cryptoParams{
+		publicKey:          publicKey,
+		publicKeyBytes:     publicKeyBytes,
+		publicKeyString:    remoteSignerConfig.PublicKey,
+		handledPrivateKeys: make([][]byte, 0),
+	}
+	cp.privateKey, err = remoteSigner.NewRemotePrivateKey(publicKey, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("the node signs with its BLS key using the remote signer", "url", remoteSignerConfig.URL, "public key", remoteSignerConfig.PublicKey)
+
+	return cp, nil
+}
+
 func (ccf *cryptoComponentsFactory) generateCryptoParams(
 	keygen crypto.KeyGenerator,
 	reason string,
@@ -527,5 +628,9 @@ func (ccf *cryptoComponentsFactory) processPrivatePublicKey(keygen crypto.KeyGen
 
 // Close closes all underlying components that need closing
 func (cc *cryptoComponents) Close() error {
+	if !check.IfNil(cc.managedKeysReloader) {
+		return cc.managedKeysReloader.Close()
+	}
+
 	return nil
 }
@@ -123,6 +123,9 @@ func (mcc *managedCryptoComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.cryptoComponents.managedPeersHolder) {
 		return errors.ErrNilManagedPeersHolder
 	}
+	if check.IfNil(mcc.cryptoComponents.managedKeysReloader) {
+		return errors.ErrNilManagedKeysReloader
+	}
 
 	return nil
 }
@@ -371,6 +374,18 @@ func (mcc *managedCryptoComponents) KeysHandler() consensus.KeysHandler {
 	return mcc.cryptoComponents.keysHandler
 }
 
+// ManagedKeysReloader returns the component able to add or remove managed keys while the node is running
+func (mcc *managedCryptoComponents) ManagedKeysReloader() common.ManagedKeysReloader {
+	mcc.mutCryptoComponents.RLock()
+	defer mcc.mutCryptoComponents.RUnlock()
+
+	if mcc.cryptoComponents == nil {
+		return nil
+	}
+
+	return mcc.cryptoComponents.managedKeysReloader
+}
+
 // Clone creates a shallow clone of a managedCryptoComponents
 func (mcc *managedCryptoComponents) Clone() interface{} {
 	cryptoComp := (*cryptoComponents)(nil)
@@ -388,6 +403,7 @@ func (mcc *managedCryptoComponents) Clone() interface{} {
 			consensusSigningHandler: mcc.ConsensusSigningHandler(),
 			managedPeersHolder:      mcc.ManagedPeersHolder(),
 			keysHandler:             mcc.KeysHandler(),
+			managedKeysReloader:     mcc.ManagedKeysReloader(),
 			cryptoParams:            mcc.cryptoParams,
 			p2pCryptoParams:         mcc.p2pCryptoParams,
 		}
@@ -11,6 +11,7 @@ import (
 	cryptoComp "github.com/multiversx/mx-chain-go/factory/crypto"
 	"github.com/multiversx/mx-chain-go/factory/mock"
 	integrationTestsMock "github.com/multiversx/mx-chain-go/integrationTests/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement"
 	componentsMock "github.com/multiversx/mx-chain-go/testscommon/components"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -536,3 +537,133 @@ func createBLSPrivatePublicKeys() ([][]byte, []string) {
 
 	return privateKeys, publicKeys
 }
+
+func TestCryptoComponentsFactory_RemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	_, publicKeys := createBLSPrivatePublicKeys()
+	remoteSignerConfig := config.RemoteSignerConfig{
+		Enabled:               true,
+		URL:                   "https://localhost:9595",
+		PublicKey:             publicKeys[0],
+		TimeoutInMilliseconds: 300,
+		CertificateFile:       "missing.crt",
+		KeyFile:               "missing.key",
+		CACertificateFile:     "missing-ca.crt",
+	}
+
+	t.Run("allValidatorsKeys file provided should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.RemoteSigner = remoteSignerConfig
+		privateKeys, publicKeys := createBLSPrivatePublicKeys()
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return privateKeys, publicKeys, nil
+			},
+		}
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		suite, _ := ccf.GetSuite()
+		cryptoParams, err := ccf.CreateCryptoParams(signing.NewKeyGenerator(suite))
+		require.Nil(t, cryptoParams)
+		require.Contains(t, err.Error(), "remote signer enabled and allValidatorsKeys.pem file provided")
+	})
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.RemoteSigner = remoteSignerConfig
+		args.Config.RemoteSigner.PublicKey = "not a public key"
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		suite, _ := ccf.GetSuite()
+		cryptoParams, err := ccf.CreateCryptoParams(signing.NewKeyGenerator(suite))
+		require.Nil(t, cryptoParams)
+		require.Contains(t, err.Error(), "for the remote signer public key")
+	})
+	t.Run("missing TLS files should error", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.RemoteSigner = remoteSignerConfig
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		suite, _ := ccf.GetSuite()
+		cryptoParams, err := ccf.CreateCryptoParams(signing.NewKeyGenerator(suite))
+		require.Nil(t, cryptoParams)
+		require.Contains(t, err.Error(), "while loading the remote signer TLS files")
+	})
+}
+
+func TestCryptoComponentsFactory_ManagedKeysReload(t *testing.T) {
+	t.Parallel()
+
+	createMultiKeyArgs := func() cryptoComp.CryptoComponentsFactoryArgs {
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		privateKeys, publicKeys := createBLSPrivatePublicKeys()
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadKeyCalled: func(relativePath string, skIndex int) ([]byte, string, error) {
+				return privateKeys[0], publicKeys[0], nil
+			},
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return privateKeys[1:], publicKeys[1:], nil
+			},
+		}
+		args.Config.ManagedKeysReload = config.ManagedKeysReloadConfig{
+			Enabled:                    true,
+			FileCheckIntervalInSeconds: 5,
+			RoundCheckIntervalInMillis: 50,
+		}
+
+		return args
+	}
+
+	t.Run("disabled should create the disabled reloader", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMultiKeyArgs()
+		args.Config.ManagedKeysReload.Enabled = false
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+
+		_, err = cc.GetManagedKeysReloader().AddManagedKey([]byte("private key"))
+		assert.Equal(t, keysManagement.ErrManagedKeysReloadDisabled, err)
+		assert.Nil(t, cc.Close())
+	})
+	t.Run("single key mode should create the disabled reloader", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponents := componentsMock.GetCoreComponents()
+		args := componentsMock.GetCryptoArgs(coreComponents)
+		args.Config.ManagedKeysReload.Enabled = true
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+
+		err = cc.GetManagedKeysReloader().RemoveManagedKey([]byte("public key"))
+		assert.Equal(t, keysManagement.ErrManagedKeysReloadDisabled, err)
+		assert.Nil(t, cc.Close())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMultiKeyArgs()
+		ccf, _ := cryptoComp.NewCryptoComponentsFactory(args)
+
+		cc, err := ccf.Create()
+		require.Nil(t, err)
+
+		err = cc.GetManagedKeysReloader().RemoveManagedKey([]byte("public key"))
+		assert.NotEqual(t, keysManagement.ErrManagedKeysReloadDisabled, err)
+		assert.Nil(t, cc.Close())
+	})
+}
@@ -43,3 +43,8 @@ func (ccf *cryptoComponentsFactory) GetSuite() (crypto.Suite, error) {
 func (cc *cryptoComponents) GetManagedPeersHolder() common.ManagedPeersHolder {
 	return cc.managedPeersHolder
 }
+
+// GetManagedKeysReloader -
+func (cc *cryptoComponents) GetManagedKeysReloader() common.ManagedKeysReloader {
+	return cc.managedKeysReloader
+}
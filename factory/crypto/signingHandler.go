@@ -129,7 +129,7 @@ func (sh *signingHandler) CreateSignatureShareForPublicKey(message []byte, index
 	}
 
 	privateKey := sh.keysHandler.GetHandledPrivateKey(publicKeyBytes)
-	privateKeyBytes, err := privateKey.ToByteArray()
+	sigShareBytes, err := sh.createSignatureShare(privateKey, message, epoch)
 	if err != nil {
 		return nil, err
 	}
@@ -137,25 +137,40 @@ func (sh *signingHandler) CreateSignatureShareForPublicKey(message []byte, index
 	sh.mutSigningData.Lock()
 	defer sh.mutSigningData.Unlock()
 
-	multiSigner, err := sh.multiSignerContainer.GetMultiSigner(epoch)
+	sh.data.sigShares[index] = sigShareBytes
+
+	return sigShareBytes, nil
+}
+
+// createSignatureShare is called without holding the signing data mutex, as the remote signing can take a while
+func (sh *signingHandler) createSignatureShare(privateKey crypto.PrivateKey, message []byte, epoch uint32) ([]byte, error) {
+	remoteKey, isRemoteKey := privateKey.(cryptoCommon.RemotePrivateKey)
+	if isRemoteKey {
+		// a BLS signature share is a single signature over the message, so it can be created by the remote signer
+		return remoteKey.Sign(message)
+	}
+
+	privateKeyBytes, err := privateKey.ToByteArray()
 	if err != nil {
 		return nil, err
 	}
 
-	sigShareBytes, err := multiSigner.CreateSignatureShare(privateKeyBytes, message)
+	multiSigner, err := sh.multiSignerContainer.GetMultiSigner(epoch)
 	if err != nil {
 		return nil, err
 	}
 
-	sh.data.sigShares[index] = sigShareBytes
-
-	return sigShareBytes, nil
+	return multiSigner.CreateSignatureShare(privateKeyBytes, message)
 }
 
 // CreateSignatureForPublicKey returns a signature over a message using the managed private key that was selected based on the provided
 // publicKeyBytes argument
 func (sh *signingHandler) CreateSignatureForPublicKey(message []byte, publicKeyBytes []byte) ([]byte, error) {
 	privateKey := sh.keysHandler.GetHandledPrivateKey(publicKeyBytes)
+	remoteKey, isRemoteKey := privateKey.(cryptoCommon.RemotePrivateKey)
+	if isRemoteKey {
+		return remoteKey.Sign(message)
+	}
 
 	return sh.singleSigner.Sign(privateKey, message)
 }
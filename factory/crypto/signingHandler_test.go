@@ -230,6 +230,60 @@ func TestSigningHandler_CreateSignatureShareForPublicKey(t *testing.T) {
 		require.Equal(t, expectedSigShare, sigShare)
 		assert.True(t, getHandledPrivateKeyCalled)
 	})
+	t.Run("remote private key should sign through the remote signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHandler()
+
+		expectedSigShare := []byte("sigShare")
+		message := []byte("msg1")
+		args.MultiSignerContainer = &cryptoMocks.MultiSignerContainerStub{
+			GetMultiSignerCalled: func(epoch uint32) (crypto.MultiSigner, error) {
+				require.Fail(t, "should not use the local multi signer")
+				return nil, nil
+			},
+		}
+		args.KeysHandler = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+				return &cryptoMocks.RemotePrivateKeyStub{
+					SignCalled: func(providedMessage []byte) ([]byte, error) {
+						assert.Equal(t, message, providedMessage)
+						return expectedSigShare, nil
+					},
+				}
+			},
+		}
+
+		signer, _ := cryptoFactory.NewSigningHandler(args)
+		sigShare, err := signer.CreateSignatureShareForPublicKey(message, selfIndex, epoch, pkBytes)
+		require.Nil(t, err)
+		require.Equal(t, expectedSigShare, sigShare)
+
+		storedSigShare, err := signer.SignatureShare(selfIndex)
+		require.Nil(t, err)
+		require.Equal(t, expectedSigShare, storedSigShare)
+	})
+	t.Run("remote signer failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSigningHandler()
+
+		expectedErr := errors.New("expected error")
+		args.KeysHandler = &testscommon.KeysHandlerStub{
+			GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+				return &cryptoMocks.RemotePrivateKeyStub{
+					SignCalled: func(providedMessage []byte) ([]byte, error) {
+						return nil, expectedErr
+					},
+				}
+			},
+		}
+
+		signer, _ := cryptoFactory.NewSigningHandler(args)
+		sigShare, err := signer.CreateSignatureShareForPublicKey([]byte("msg1"), selfIndex, epoch, pkBytes)
+		require.Nil(t, sigShare)
+		require.Equal(t, expectedErr, err)
+	})
 }
 
 func TestSigningHandler_VerifySignatureShare(t *testing.T) {
@@ -648,6 +702,35 @@ func TestSigningHandler_CreateSignatureForPublicKey(t *testing.T) {
 	assert.True(t, getHandledPrivateKeyCalled)
 }
 
+func TestSigningHandler_CreateSignatureForPublicKeyWithRemotePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSigningHandler()
+	message := []byte("msg1")
+	expectedSig := []byte("signature")
+	args.KeysHandler = &testscommon.KeysHandlerStub{
+		GetHandledPrivateKeyCalled: func(providedPkBytes []byte) crypto.PrivateKey {
+			return &cryptoMocks.RemotePrivateKeyStub{
+				SignCalled: func(providedMessage []byte) ([]byte, error) {
+					assert.Equal(t, message, providedMessage)
+					return expectedSig, nil
+				},
+			}
+		},
+	}
+	args.SingleSigner = &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			require.Fail(t, "should not use the local single signer")
+			return nil, nil
+		},
+	}
+
+	signer, _ := cryptoFactory.NewSigningHandler(args)
+	sig, err := signer.CreateSignatureForPublicKey(message, []byte("public key bytes"))
+	require.Nil(t, err)
+	require.Equal(t, expectedSig, sig)
+}
+
 func TestSigningHandler_VerifySingleSignature(t *testing.T) {
 	t.Parallel()
 
@@ -15,11 +15,31 @@ func (ihp *IncomingHeaderProcessor) AddHeader(_ []byte, _ sovereign.IncomingHead
 	return nil
 }
 
+// RevertHeader does nothing
+func (ihp *IncomingHeaderProcessor) RevertHeader(_ []byte) error {
+	return nil
+}
+
+// FinalizeHeader does nothing
+func (ihp *IncomingHeaderProcessor) FinalizeHeader(_ []byte) error {
+	return nil
+}
+
 // CreateExtendedHeader returns an empty extended shard header
 func (ihp *IncomingHeaderProcessor) CreateExtendedHeader(_ sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error) {
 	return &block.ShardHeaderExtended{Header: &block.HeaderV2{}}, nil
 }
 
+// GetIncomingHeadersQueues returns an empty map
+func (ihp *IncomingHeaderProcessor) GetIncomingHeadersQueues() (map[uint32][]byte, error) {
+	return make(map[uint32][]byte), nil
+}
+
+// SetIncomingHeadersQueues does nothing
+func (ihp *IncomingHeaderProcessor) SetIncomingHeadersQueues(_ map[uint32][]byte) error {
+	return nil
+}
+
 // IsInterfaceNil checks if the underlying pointer is nil
 func (ihp *IncomingHeaderProcessor) IsInterfaceNil() bool {
 	return ihp == nil
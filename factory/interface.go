@@ -22,6 +22,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/enablers"
 	"github.com/multiversx/mx-chain-go/common/statistics"
 	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
@@ -222,6 +223,7 @@ type CryptoComponentsHolder interface {
 	ConsensusSigningHandler() consensus.SigningHandler
 	ManagedPeersHolder() common.ManagedPeersHolder
 	KeysHandler() consensus.KeysHandler
+	ManagedKeysReloader() common.ManagedKeysReloader
 	Clone() interface{}
 	IsInterfaceNil() bool
 }
@@ -457,9 +459,16 @@ type ConsensusComponentsHolder interface {
 	BroadcastMessenger() consensus.BroadcastMessenger
 	ConsensusGroupSize() (int, error)
 	Bootstrapper() process.Bootstrapper
+	EquivocationDetector() EquivocationDetector
 	IsInterfaceNil() bool
 }
 
+// EquivocationDetector defines the consensus equivocation detector, also able to provide the detected proofs
+type EquivocationDetector interface {
+	consensus.EquivocationDetector
+	GetProofs() []*equivocation.Proof
+}
+
 // ConsensusComponentsHandler defines the consensus components handler actions
 type ConsensusComponentsHandler interface {
 	ComponentHandler
@@ -622,6 +631,9 @@ type RunTypeComponentsHolder interface {
 	OutGoingOperationsPoolHandler() sovereignBlock.OutGoingOperationsPool
 	DataCodecHandler() sovereign.DataCodecHandler
 	TopicsCheckerHandler() sovereign.TopicsCheckerHandler
+	IncomingChainsHandler() process.IncomingChainsHandler
+	MainChainValidatorsHandler() process.MainChainValidatorsHandler
+	IncomingChainsValidatorsHandlers() map[string]process.MainChainValidatorsHandler
 	ShardCoordinatorCreator() sharding.ShardCoordinatorFactory
 	NodesCoordinatorWithRaterCreator() nodesCoordinator.NodesCoordinatorWithRaterFactory
 	RequestersContainerFactoryCreator() requesterscontainer.RequesterContainerFactoryCreator
@@ -6,6 +6,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-crypto-go"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/storage"
 )
@@ -91,23 +92,35 @@ func (psh *peerSignatureHandler) VerifyPeerSignature(pk []byte, pid core.PeerID,
 // GetPeerSignature returns the needed signature if it is already cached.
 // Otherwise, the signature will be computed.
 func (psh *peerSignatureHandler) GetPeerSignature(privateKey crypto.PrivateKey, pid []byte) ([]byte, error) {
+	remoteKey, isRemoteKey := privateKey.(cryptoCommon.RemotePrivateKey)
+	if isRemoteKey {
+		// the key material of a remote key is not available, so the public key is used for buffering
+		return psh.getPeerSignature(remoteKey.PublicKeyBytes(), pid, remoteKey.Sign)
+	}
+
 	privateKeyBytes, err := privateKey.ToByteArray()
 	if err != nil {
 		return nil, err
 	}
 
-	retrievedPID, retrievedSig := psh.getBufferedPIDSignature(privateKeyBytes)
+	return psh.getPeerSignature(privateKeyBytes, pid, func(message []byte) ([]byte, error) {
+		return psh.singleSigner.Sign(privateKey, message)
+	})
+}
+
+func (psh *peerSignatureHandler) getPeerSignature(keyBytes []byte, pid []byte, sign func(message []byte) ([]byte, error)) ([]byte, error) {
+	retrievedPID, retrievedSig := psh.getBufferedPIDSignature(keyBytes)
 	isValidPID := len(retrievedPID) > 0 && retrievedPID == core.PeerID(pid)
 	if isValidPID {
 		return retrievedSig, nil
 	}
 
-	signature, err := psh.singleSigner.Sign(privateKey, pid)
+	signature, err := sign(pid)
 	if err != nil {
 		return nil, err
 	}
 
-	psh.bufferPIDSignature(privateKeyBytes, core.PeerID(pid), signature)
+	psh.bufferPIDSignature(keyBytes, core.PeerID(pid), signature)
 	return signature, nil
 }
 
@@ -575,3 +575,55 @@ func TestPeerSignatureHandler_GetPeerSignatureGetFromCache(t *testing.T) {
 	assert.Equal(t, recoveredSig, sig)
 	assert.Nil(t, err)
 }
+
+func TestPeerSignatureHandler_GetPeerSignatureWithRemotePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	publicKeyBytes := []byte("public key")
+	pid := []byte("dummy peer")
+	sig := []byte("signature")
+	numRemoteSignCalls := 0
+	privateKey := &cryptoMocks.RemotePrivateKeyStub{
+		PrivateKeyStub: cryptoMocks.PrivateKeyStub{
+			ToByteArrayStub: func() ([]byte, error) {
+				assert.Fail(t, "should not read the remote key material")
+				return nil, nil
+			},
+		},
+		SignCalled: func(message []byte) ([]byte, error) {
+			assert.Equal(t, pid, message)
+			numRemoteSignCalls++
+			return sig, nil
+		},
+		PublicKeyBytesCalled: func() []byte {
+			return publicKeyBytes
+		},
+	}
+
+	cache := testscommon.NewCacherMock()
+	singleSigner := &cryptoMocks.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.Fail(t, "should not use the local single signer")
+			return nil, nil
+		},
+	}
+
+	peerSigHandler, _ := peerSignatureHandler.NewPeerSignatureHandler(
+		cache,
+		singleSigner,
+		&cryptoMocks.KeyGenStub{},
+	)
+
+	recoveredSig, err := peerSigHandler.GetPeerSignature(privateKey, pid)
+	assert.Equal(t, sig, recoveredSig)
+	assert.Nil(t, err)
+
+	val, ok := cache.Get(publicKeyBytes)
+	assert.True(t, ok)
+	assert.NotNil(t, val)
+
+	recoveredSig, err = peerSigHandler.GetPeerSignature(privateKey, pid)
+	assert.Equal(t, sig, recoveredSig)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numRemoteSignCalls)
+}
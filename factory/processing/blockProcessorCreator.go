@@ -223,6 +223,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		NodesCoordinator:    pcf.nodesCoordinator,
+		ChainID:             []byte(pcf.coreData.ChainID()),
 	}
 
 	vmContainer, vmFactory, err := pcf.runTypeComponents.VmContainerShardFactoryCreator().CreateVmContainerFactory(argsNewVmContainerFactory)
@@ -635,6 +636,7 @@ func (pcf *processComponentsFactory) createArgsMetaBlockProcessor(
 		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		NodesCoordinator:    pcf.nodesCoordinator,
+		ChainID:             []byte(pcf.coreData.ChainID()),
 	}
 
 	vmContainer, vmFactory, err := pcf.runTypeComponents.VmContainerMetaFactoryCreator().CreateVmContainerFactory(argsNewVmContainerFactory)
@@ -883,6 +883,7 @@ func (pcf *processComponentsFactory) generateGenesisHeadersAndApplyInitialBalanc
 		GenesisRound:            pcf.genesisRound,
 		RunTypeComponents:       pcf.runTypeComponents,
 		EnableEpochsFactory:     pcf.enableEpochsFactory,
+		IncomingHeadersQueues:   pcf.getIncomingHeadersQueuesHandler(),
 		DNSV2Addresses:          pcf.config.BuiltInFunctions.DNSV2Addresses,
 		// TODO: We should only pass the whole config instead of passing sub-configs as above
 		Config: pcf.config,
@@ -1837,10 +1838,24 @@ func (pcf *processComponentsFactory) createExportFactoryHandler(
 		TrieSyncerVersion:                pcf.config.TrieSync.TrieSyncerVersion,
 		NodeOperationMode:                nodeOperationMode,
 		ShardCoordinatorFactory:          pcf.runTypeComponents.ShardCoordinatorCreator(),
+		OutGoingOperationsPool:           pcf.runTypeComponents.OutGoingOperationsPoolHandler(),
+		IncomingHeadersQueues:            pcf.getIncomingHeadersQueuesHandler(),
 	}
 	return pcf.runTypeComponents.ExportHandlerFactoryCreator().CreateExportFactoryHandler(argsExporter)
 }
 
+// getIncomingHeadersQueuesHandler returns the handler of the incoming headers waiting for confirmations, which are
+// exported and imported by a sovereign chain hardfork. Incoming header subscribers without such queues are replaced
+// by a disabled handler.
+func (pcf *processComponentsFactory) getIncomingHeadersQueuesHandler() update.IncomingHeadersQueuesHandler {
+	incomingHeadersQueues, ok := pcf.incomingHeaderSubscriber.(update.IncomingHeadersQueuesHandler)
+	if !ok {
+		return &disabled.IncomingHeaderProcessor{}
+	}
+
+	return incomingHeadersQueues
+}
+
 func (pcf *processComponentsFactory) createHardforkTrigger(epochStartTrigger update.EpochHandler) (factory.HardforkTrigger, error) {
 	hardforkConfig := pcf.config.Hardfork
 	selfPubKeyBytes := pcf.crypto.PublicKeyBytes()
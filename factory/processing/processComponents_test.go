@@ -1134,6 +1134,7 @@ func getRunTypeComponents(rt runType.RunTypeComponentsHolder) *mainFactoryMocks.
 		OutGoingOperationsPool:                      rt.OutGoingOperationsPoolHandler(),
 		DataCodec:                                   rt.DataCodecHandler(),
 		TopicsChecker:                               rt.TopicsCheckerHandler(),
+		IncomingChains:                              rt.IncomingChainsHandler(),
 		ShardCoordinatorFactory:                     rt.ShardCoordinatorCreator(),
 		RequestersContainerFactory:                  rt.RequestersContainerFactoryCreator(),
 		InterceptorsContainerFactory:                rt.InterceptorsContainerFactoryCreator(),
@@ -179,6 +179,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorForMeta(
 		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		NodesCoordinator:    pcf.nodesCoordinator,
+		ChainID:             []byte(pcf.coreData.ChainID()),
 	}
 
 	vmContainer, vmFactory, err := pcf.runTypeComponents.VmContainerMetaFactoryCreator().CreateVmContainerFactory(argsNewVmContainerFactory)
@@ -389,6 +390,7 @@ func (pcf *processComponentsFactory) createArgsTxSimulatorProcessorShard(
 		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
 		EnableEpochsHandler: pcf.coreData.EnableEpochsHandler(),
 		NodesCoordinator:    pcf.nodesCoordinator,
+		ChainID:             []byte(pcf.coreData.ChainID()),
 	}
 
 	vmContainer, vmFactory, err := pcf.runTypeComponents.VmContainerShardFactoryCreator().CreateVmContainerFactory(argsNewVmContainerFactory)
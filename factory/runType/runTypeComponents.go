@@ -104,6 +104,9 @@ type runTypeComponents struct {
 	outGoingOperationsPoolHandler           sovereignBlock.OutGoingOperationsPool
 	dataCodecHandler                        sovereign.DataCodecHandler
 	topicsCheckerHandler                    sovereign.TopicsCheckerHandler
+	incomingChainsHandler                   process.IncomingChainsHandler
+	mainChainValidatorsHandler              process.MainChainValidatorsHandler
+	incomingChainsValidatorsHandlers        map[string]process.MainChainValidatorsHandler
 	shardCoordinatorCreator                 sharding.ShardCoordinatorFactory
 	nodesCoordinatorWithRaterFactoryCreator nodesCoord.NodesCoordinatorWithRaterFactory
 	requestersContainerFactoryCreator       requesterscontainer.RequesterContainerFactoryCreator
@@ -232,6 +235,9 @@ func (rcf *runTypeComponentsFactory) Create() (*runTypeComponents, error) {
 		outGoingOperationsPoolHandler:           disabled.NewDisabledOutGoingOperationPool(),
 		dataCodecHandler:                        disabled.NewDisabledDataCodec(),
 		topicsCheckerHandler:                    disabled.NewDisabledTopicsChecker(),
+		incomingChainsHandler:                   disabled.NewDisabledIncomingChainsHandler(),
+		mainChainValidatorsHandler:              disabled.NewDisabledMainChainValidatorsHandler(),
+		incomingChainsValidatorsHandlers:        make(map[string]process.MainChainValidatorsHandler),
 		shardCoordinatorCreator:                 sharding.NewMultiShardCoordinatorFactory(),
 		nodesCoordinatorWithRaterFactoryCreator: nodesCoord.NewIndexHashedNodesCoordinatorWithRaterFactory(),
 		requestersContainerFactoryCreator:       requesterscontainer.NewShardRequestersContainerFactoryCreator(),
@@ -180,6 +180,12 @@ func (mrc *managedRunTypeComponents) CheckSubcomponents() error {
 	if check.IfNil(mrc.topicsCheckerHandler) {
 		return errors.ErrNilTopicsChecker
 	}
+	if check.IfNil(mrc.incomingChainsHandler) {
+		return errors.ErrNilIncomingChainsHandler
+	}
+	if check.IfNil(mrc.mainChainValidatorsHandler) {
+		return errors.ErrNilMainChainValidatorsHandler
+	}
 	if check.IfNil(mrc.shardCoordinatorCreator) {
 		return errors.ErrNilShardCoordinatorFactory
 	}
@@ -559,6 +565,43 @@ func (mrc *managedRunTypeComponents) TopicsCheckerHandler() sovereign.TopicsChec
 	return mrc.runTypeComponents.topicsCheckerHandler
 }
 
+// IncomingChainsHandler returns the incoming chains handler
+func (mrc *managedRunTypeComponents) IncomingChainsHandler() process.IncomingChainsHandler {
+	mrc.mutRunTypeComponents.RLock()
+	defer mrc.mutRunTypeComponents.RUnlock()
+
+	if check.IfNil(mrc.runTypeComponents) {
+		return nil
+	}
+
+	return mrc.runTypeComponents.incomingChainsHandler
+}
+
+// MainChainValidatorsHandler returns the handler which keeps the main chain validators in sync
+func (mrc *managedRunTypeComponents) MainChainValidatorsHandler() process.MainChainValidatorsHandler {
+	mrc.mutRunTypeComponents.RLock()
+	defer mrc.mutRunTypeComponents.RUnlock()
+
+	if check.IfNil(mrc.runTypeComponents) {
+		return nil
+	}
+
+	return mrc.runTypeComponents.mainChainValidatorsHandler
+}
+
+// IncomingChainsValidatorsHandlers returns the handlers which keep the validators of each incoming sovereign chain in
+// sync, mapped by the chain ID
+func (mrc *managedRunTypeComponents) IncomingChainsValidatorsHandlers() map[string]process.MainChainValidatorsHandler {
+	mrc.mutRunTypeComponents.RLock()
+	defer mrc.mutRunTypeComponents.RUnlock()
+
+	if check.IfNil(mrc.runTypeComponents) {
+		return nil
+	}
+
+	return mrc.runTypeComponents.incomingChainsValidatorsHandlers
+}
+
 // ShardCoordinatorCreator returns the shard coordinator factory
 func (mrc *managedRunTypeComponents) ShardCoordinatorCreator() sharding.ShardCoordinatorFactory {
 	mrc.mutRunTypeComponents.RLock()
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"time"
 
+	commonDisabled "github.com/multiversx/mx-chain-go/common/disabled"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/broadcastFactory"
@@ -14,6 +15,7 @@ import (
 	storageRequestFactory "github.com/multiversx/mx-chain-go/dataRetriever/factory/storageRequestersContainer/factory"
 	"github.com/multiversx/mx-chain-go/dataRetriever/requestHandlers"
 	"github.com/multiversx/mx-chain-go/epochStart/bootstrap"
+	"github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	"github.com/multiversx/mx-chain-go/epochStart/metachain"
 	"github.com/multiversx/mx-chain-go/errors"
 	factoryBlock "github.com/multiversx/mx-chain-go/factory/block"
@@ -27,9 +29,11 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
 	trieIteratorsFactory "github.com/multiversx/mx-chain-go/node/trieIterators/factory"
 	outportFactory "github.com/multiversx/mx-chain-go/outport/process/factory"
+	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block"
 	"github.com/multiversx/mx-chain-go/process/block/preprocess"
 	"github.com/multiversx/mx-chain-go/process/block/sovereign"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/incomingHeader"
 	"github.com/multiversx/mx-chain-go/process/coordinator"
 	"github.com/multiversx/mx-chain-go/process/factory/interceptorscontainer"
 	procSovereign "github.com/multiversx/mx-chain-go/process/factory/sovereign"
@@ -50,7 +54,10 @@ import (
 	updateFactory "github.com/multiversx/mx-chain-go/update/factory/creator"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshallerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
 )
 
 type ArgsSovereignRunTypeComponents struct {
@@ -104,7 +111,17 @@ func (rcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, erro
 		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignForkDetectorFactory failed: %w", err)
 	}
 
-	blockTrackerFactory, err := track.NewSovereignBlockTrackerFactory(rtc.blockTrackerCreator)
+	incomingChainsHandler, err := sovereign.NewIncomingChainsHandler(sovereign.ArgsIncomingChainsHandler{
+		MainChainID:                     rcf.sovConfig.MainChainNotarization.MainChainID,
+		MainChainNotarizationStartRound: rcf.sovConfig.MainChainNotarization.MainChainNotarizationStartRound,
+		MainChainConfirmationDepth:      rcf.sovConfig.MainChainNotarization.ConfirmationDepth,
+		IncomingChains:                  rcf.sovConfig.IncomingChains,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewIncomingChainsHandler failed: %w", err)
+	}
+
+	blockTrackerFactory, err := track.NewSovereignBlockTrackerFactory(rtc.blockTrackerCreator, incomingChainsHandler)
 	if err != nil {
 		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignBlockTrackerFactory failed: %w", err)
 	}
@@ -114,7 +131,48 @@ func (rcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, erro
 		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignResolverRequestHandlerFactory failed: %w", err)
 	}
 
-	headerValidatorFactory, err := block.NewSovereignHeaderValidatorFactory(rtc.headerValidatorCreator)
+	requestersContainerFactoryCreator, err := requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator(incomingChainsHandler)
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignShardRequestersContainerFactoryCreator failed: %w", err)
+	}
+
+	interceptorsContainerFactoryCreator, err := interceptorscontainer.NewSovereignShardInterceptorsContainerFactoryCreator(incomingChainsHandler)
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignShardInterceptorsContainerFactoryCreator failed: %w", err)
+	}
+
+	shardResolversContainerFactoryCreator, err := resolverscontainer.NewSovereignShardResolversContainerFactoryCreator(incomingChainsHandler)
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignShardResolversContainerFactoryCreator failed: %w", err)
+	}
+
+	shardRequestersContainerCreatorHandler, err := storageRequestFactory.NewSovereignShardRequestersContainerCreator(incomingChainsHandler)
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignShardRequestersContainerCreator failed: %w", err)
+	}
+
+	mainChainHeaderSigVerifier, mainChainValidatorsHandler, err := rcf.createMainChainHeaderSigVerifier()
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - createMainChainHeaderSigVerifier failed: %w", err)
+	}
+
+	sovereignChainsHeaderSigVerifiers, sovereignChainsValidatorsHandlers, err := rcf.createSovereignChainsHeaderSigVerifiers()
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - createSovereignChainsHeaderSigVerifiers failed: %w", err)
+	}
+
+	mainChainEventsVerifier, err := rcf.createMainChainEventsVerifier()
+	if err != nil {
+		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - createMainChainEventsVerifier failed: %w", err)
+	}
+
+	headerValidatorFactory, err := block.NewSovereignHeaderValidatorFactory(
+		rtc.headerValidatorCreator,
+		mainChainHeaderSigVerifier,
+		mainChainEventsVerifier,
+		sovereignChainsHeaderSigVerifiers,
+		rcf.sovConfig.MainChainNotarization.HeaderSignatureVerification.Enabled,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignHeaderValidatorFactory failed: %w", err)
 	}
@@ -129,7 +187,7 @@ func (rcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, erro
 		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignValidatorStatisticsProcessorFactory failed: %w", err)
 	}
 
-	scProcessorCreator, err := processorV2.NewSovereignSCProcessFactory(rtc.scProcessorCreator)
+	scProcessorCreator, err := processorV2.NewSovereignSCProcessFactory(rtc.scProcessorCreator, rcf.getBridgeSCCallFunctions())
 	if err != nil {
 		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignSCProcessFactory failed: %w", err)
 	}
@@ -186,7 +244,12 @@ func (rcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, erro
 		return nil, fmt.Errorf("sovereignRunTypeComponentsFactory - NewSovereignAccountCreator failed: %w", err)
 	}
 
-	expiryTime := time.Second * time.Duration(rcf.sovConfig.OutgoingSubscribedEvents.TimeToWaitForUnconfirmedOutGoingOperationInSeconds)
+	outgoingConfig := rcf.sovConfig.OutgoingSubscribedEvents
+	expiryTime := time.Second * time.Duration(outgoingConfig.TimeToWaitForUnconfirmedOutGoingOperationInSeconds)
+	retryPolicy := sovereignFactory.RetryPolicy{
+		MaxTimeToWait:   time.Second * time.Duration(outgoingConfig.Retry.MaxTimeToWaitInSeconds),
+		MaxSendAttempts: outgoingConfig.Retry.MaxSendAttempts,
+	}
 
 	sovHeaderSigVerifier, err := headerCheck.NewSovereignHeaderSigVerifier(rcf.cryptoComponents.BlockSigner())
 	if err != nil {
@@ -233,14 +296,14 @@ func (rcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, erro
 		accountsParser:                          sovereignAccountsParser,
 		accountsCreator:                         accountsCreator,
 		vmContextCreator:                        sovVMContextCreator,
-		outGoingOperationsPoolHandler:           sovereignFactory.NewOutGoingOperationPool(expiryTime),
+		outGoingOperationsPoolHandler:           sovereignFactory.NewOutGoingOperationPoolWithRetryPolicy(expiryTime, retryPolicy),
 		dataCodecHandler:                        rcf.dataCodec,
 		topicsCheckerHandler:                    rcf.topicsChecker,
 		shardCoordinatorCreator:                 sharding.NewSovereignShardCoordinatorFactory(),
 		nodesCoordinatorWithRaterFactoryCreator: nodesCoord.NewSovereignIndexHashedNodesCoordinatorWithRaterFactory(),
-		requestersContainerFactoryCreator:       requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator(),
-		interceptorsContainerFactoryCreator:     interceptorscontainer.NewSovereignShardInterceptorsContainerFactoryCreator(),
-		shardResolversContainerFactoryCreator:   resolverscontainer.NewSovereignShardResolversContainerFactoryCreator(),
+		requestersContainerFactoryCreator:       requestersContainerFactoryCreator,
+		interceptorsContainerFactoryCreator:     interceptorsContainerFactoryCreator,
+		shardResolversContainerFactoryCreator:   shardResolversContainerFactoryCreator,
 		txPreProcessorCreator:                   preprocess.NewSovereignTxPreProcessorCreator(),
 		extraHeaderSigVerifierHolder:            rtc.extraHeaderSigVerifierHolder,
 		genesisBlockCreatorFactory:              processComp.NewSovereignGenesisBlockCreatorFactory(),
@@ -259,12 +322,171 @@ func (rcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, erro
 		shardMessengerFactory:                   broadcastFactory.NewSovereignShardChainMessengerFactory(),
 		exportHandlerFactoryCreator:             updateFactory.NewSovereignExportHandlerFactoryCreator(),
 		validatorAccountsSyncerFactoryHandler:   syncerFactory.NewSovereignValidatorAccountsSyncerFactory(),
-		shardRequestersContainerCreatorHandler:  storageRequestFactory.NewSovereignShardRequestersContainerCreator(),
+		shardRequestersContainerCreatorHandler:  shardRequestersContainerCreatorHandler,
 		apiRewardTxHandler:                      apiRewardTxHandler,
 		outportDataProviderFactory:              outportFactory.NewSovereignOutportDataProviderFactory(),
 		delegatedListFactoryHandler:             trieIteratorsFactory.NewSovereignDelegatedListProcessorFactory(),
 		directStakedListFactoryHandler:          trieIteratorsFactory.NewSovereignDirectStakedListProcessorFactory(),
 		totalStakedValueFactoryHandler:          trieIteratorsFactory.NewSovereignTotalStakedValueProcessorFactory(),
 		versionedHeaderFactory:                  versionedHeaderFactory,
+		incomingChainsHandler:                   incomingChainsHandler,
+		mainChainValidatorsHandler:              mainChainValidatorsHandler,
+		incomingChainsValidatorsHandlers:        sovereignChainsValidatorsHandlers,
 	}, nil
 }
+
+// getBridgeSCCallFunctions returns the smart contract functions called by the incoming scrs of the bridge, i.e. the
+// unlock of the sovereign native tokens and the executed bridge operation callbacks of all incoming chains
+func (rcf *sovereignRunTypeComponentsFactory) getBridgeSCCallFunctions() []string {
+	functions := make([]string, 0)
+	unlockConfig := rcf.sovConfig.OutGoingBridge.NativeTokensUnlock
+	if unlockConfig.Enabled {
+		functions = append(functions, unlockConfig.Function)
+	}
+
+	notifierConfigs := []config.NotifierConfig{rcf.sovConfig.NotifierConfig}
+	for _, incomingChain := range rcf.sovConfig.IncomingChains {
+		notifierConfigs = append(notifierConfigs, incomingChain.NotifierConfig)
+	}
+	for _, notifierConfig := range notifierConfigs {
+		if notifierConfig.ExecutedBridgeOpCallback.Enabled {
+			functions = append(functions, notifierConfig.ExecutedBridgeOpCallback.Function)
+		}
+	}
+
+	return functions
+}
+
+// createMainChainHeaderSigVerifier creates the verifier of the main chain header signatures, together with the main
+// chain validators handler, which keeps the main chain nodes coordinator in sync with the main chain epoch start data
+func (rcf *sovereignRunTypeComponentsFactory) createMainChainHeaderSigVerifier() (process.InterceptedHeaderSigVerifier, process.MainChainValidatorsHandler, error) {
+	verificationConfig := rcf.sovConfig.MainChainNotarization.HeaderSignatureVerification
+	if !verificationConfig.Enabled {
+		return disabled.NewHeaderSigVerifier(), commonDisabled.NewDisabledMainChainValidatorsHandler(), nil
+	}
+
+	return rcf.createIncomingChainHeaderSigVerifier(
+		config.IncomingChainHeaderSignatureVerification{
+			ConsensusGroupSize:    verificationConfig.ConsensusGroupSize,
+			NodesRegistryFilePath: verificationConfig.NodesRegistryFilePath,
+			StartRating:           verificationConfig.StartRating,
+			SelectionChances:      verificationConfig.SelectionChances,
+		},
+		headerCheck.NewExtraHeaderSigVerifierHolder(),
+	)
+}
+
+// createSovereignChainsHeaderSigVerifiers creates, for each incoming sovereign chain, the verifier of its header
+// signatures and the handler which keeps its validators in sync with the epoch start data published by its nodes, both
+// mapped by the chain ID. The headers of the incoming sovereign chains are only verified while the main chain header
+// signatures are verified, otherwise no verifiers are created.
+func (rcf *sovereignRunTypeComponentsFactory) createSovereignChainsHeaderSigVerifiers() (
+	map[string]process.InterceptedHeaderSigVerifier,
+	map[string]process.MainChainValidatorsHandler,
+	error,
+) {
+	sigVerifiers := make(map[string]process.InterceptedHeaderSigVerifier)
+	validatorsHandlers := make(map[string]process.MainChainValidatorsHandler)
+	if !rcf.sovConfig.MainChainNotarization.HeaderSignatureVerification.Enabled {
+		return sigVerifiers, validatorsHandlers, nil
+	}
+
+	for _, incomingChain := range rcf.sovConfig.IncomingChains {
+		// same as on the incoming sovereign chain, its header signatures cover the signatures of the outgoing operations
+		extraSigVerifier := headerCheck.NewExtraHeaderSigVerifierHolder()
+		outGoingOperationsSigVerifier, err := headerCheck.NewSovereignHeaderSigVerifier(rcf.cryptoComponents.BlockSigner())
+		if err != nil {
+			return nil, nil, err
+		}
+		err = extraSigVerifier.RegisterExtraHeaderSigVerifier(outGoingOperationsSigVerifier)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		sigVerifier, validatorsHandler, err := rcf.createIncomingChainHeaderSigVerifier(incomingChain.HeaderSignatureVerification, extraSigVerifier)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for incoming chain %s", err, incomingChain.ChainID)
+		}
+
+		sigVerifiers[incomingChain.ChainID] = sigVerifier
+		validatorsHandlers[incomingChain.ChainID] = validatorsHandler
+	}
+
+	return sigVerifiers, validatorsHandlers, nil
+}
+
+func (rcf *sovereignRunTypeComponentsFactory) createIncomingChainHeaderSigVerifier(
+	verificationConfig config.IncomingChainHeaderSignatureVerification,
+	extraSigVerifier headerCheck.ExtraHeaderSigVerifierHolder,
+) (process.InterceptedHeaderSigVerifier, process.MainChainValidatorsHandler, error) {
+	nodesRegistry := &nodesCoord.NodesCoordinatorRegistry{}
+	err := core.LoadJsonFile(nodesRegistry, verificationConfig.NodesRegistryFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chanceComputer, err := nodesCoord.NewSelectionChancesComputer(verificationConfig.SelectionChances)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	incomingChainNodesCoordinator, err := nodesCoord.NewMainChainNodesCoordinator(nodesCoord.ArgsMainChainNodesCoordinator{
+		Hasher:             rcf.coreComponents.Hasher(),
+		ChanceComputer:     chanceComputer,
+		ConsensusGroupSize: verificationConfig.ConsensusGroupSize,
+		StartRating:        verificationConfig.StartRating,
+		NodesRegistry:      nodesRegistry,
+		RegistryFilePath:   verificationConfig.NodesRegistryFilePath,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the multi signer container is configured per sovereign epochs, which are not related to the incoming chain epochs
+	multiSigner, err := rcf.cryptoComponents.GetMultiSigner(0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	incomingChainHeaderSigVerifier, err := headerCheck.NewMainChainHeaderSigVerifier(headerCheck.ArgsMainChainHeaderSigVerifier{
+		Marshaller:        rcf.coreComponents.InternalMarshalizer(),
+		Hasher:            rcf.coreComponents.Hasher(),
+		NodesCoordinator:  incomingChainNodesCoordinator,
+		MultiSigVerifier:  multiSigner,
+		SingleSigVerifier: rcf.cryptoComponents.BlockSigner(),
+		KeyGen:            rcf.cryptoComponents.BlockSignKeyGen(),
+		ExtraSigVerifier:  extraSigVerifier,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return incomingChainHeaderSigVerifier, incomingChainNodesCoordinator, nil
+}
+
+// createMainChainEventsVerifier creates the verifier which binds the incoming events of the extended shard headers to
+// their main chain header. The main chain header signatures are meaningless for the incoming events unless the events
+// are proven against the signed header, hence the events are verified together with the signatures, starting with the
+// IncomingEventsProofsEnableEpoch.
+func (rcf *sovereignRunTypeComponentsFactory) createMainChainEventsVerifier() (process.EventsProofVerifier, error) {
+	if !rcf.sovConfig.MainChainNotarization.HeaderSignatureVerification.Enabled {
+		return commonDisabled.NewDisabledEventsProofVerifier(), nil
+	}
+
+	webSocketConfig := rcf.sovConfig.NotifierConfig.WebSocketConfig
+	marshaller, err := marshallerFactory.NewMarshalizer(webSocketConfig.MarshallerType)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := hasherFactory.NewHasher(webSocketConfig.HasherType)
+	if err != nil {
+		return nil, err
+	}
+
+	return incomingHeader.NewEventsProofVerifier(incomingHeader.ArgsEventsProofVerifier{
+		Marshaller:          marshaller,
+		Hasher:              hasher,
+		DataCodec:           rcf.dataCodec,
+		EnableEpochsHandler: rcf.coreComponents.EnableEpochsHandler(),
+	})
+}
@@ -20,6 +20,12 @@ func createSovRunTypeArgs() runType.ArgsSovereignRunTypeComponents {
 			GenesisConfig: config.GenesisConfig{
 				NativeESDT: "WEGLD-bd4d79",
 			},
+			MainChainNotarization: config.MainChainNotarization{
+				MainChainID: "1",
+			},
+			OutGoingBridge: config.OutGoingBridge{
+				Hasher: "sha256",
+			},
 		},
 		DataCodec:     &sovereign.DataCodecMock{},
 		TopicsChecker: &sovereign.TopicsCheckerMock{},
@@ -60,11 +66,26 @@ func TestNewSovereignRunTypeComponentsFactory(t *testing.T) {
 func TestSovereignRunTypeComponentsFactory_Create(t *testing.T) {
 	t.Parallel()
 
-	srcf, _ := runType.NewSovereignRunTypeComponentsFactory(createSovRunTypeArgs())
+	t.Run("missing main chain nodes registry file should error", func(t *testing.T) {
+		sovArgs := createSovRunTypeArgs()
+		sovArgs.Config.MainChainNotarization.HeaderSignatureVerification = config.MainChainHeaderSignatureVerification{
+			Enabled:               true,
+			ConsensusGroupSize:    1,
+			NodesRegistryFilePath: "missing_file.json",
+		}
+		srcf, _ := runType.NewSovereignRunTypeComponentsFactory(sovArgs)
 
-	rc, err := srcf.Create()
-	require.NoError(t, err)
-	require.NotNil(t, rc)
+		rc, err := srcf.Create()
+		require.Error(t, err)
+		require.Nil(t, rc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		srcf, _ := runType.NewSovereignRunTypeComponentsFactory(createSovRunTypeArgs())
+
+		rc, err := srcf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, rc)
+	})
 }
 
 func TestSovereignRunTypeComponentsFactory_Close(t *testing.T) {
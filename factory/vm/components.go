@@ -38,4 +38,5 @@ type ArgsVmContainerFactory struct {
 	PubkeyConv                 core.PubkeyConverter
 	IsInHistoricalBalancesMode bool
 	NodesCoordinator           vm.NodesCoordinator
+	ChainID                    []byte
 }
@@ -45,6 +45,7 @@ func (vcmf *vmContainerMetaFactory) CreateVmContainerFactory(args ArgsVmContaine
 		EnableEpochsHandler:     args.EnableEpochsHandler,
 		NodesCoordinator:        args.NodesCoordinator,
 		VMContextCreatorHandler: vcmf.vmContextCreatorHandler,
+		ChainID:                 args.ChainID,
 	}
 	vmFactory, err := metachain.NewVMContainerFactory(argsNewVmFactory)
 	if err != nil {
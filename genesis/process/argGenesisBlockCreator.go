@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/enablers"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
 	"github.com/multiversx/mx-chain-go/dblookupext"
 	factoryVm "github.com/multiversx/mx-chain-go/factory/vm"
 	"github.com/multiversx/mx-chain-go/genesis"
@@ -66,6 +67,7 @@ type runTypeComponentsHandler interface {
 	VmContainerMetaFactoryCreator() factoryVm.VmContainerCreator
 	PreProcessorsContainerFactoryCreator() shardData.PreProcessorsContainerFactoryCreator
 	VersionedHeaderFactory() genesis.VersionedHeaderFactory
+	OutGoingOperationsPoolHandler() sovereignBlock.OutGoingOperationsPool
 	IsInterfaceNil() bool
 }
 
@@ -100,6 +102,7 @@ type ArgsGenesisBlockCreator struct {
 	RunTypeComponents       runTypeComponentsHandler
 	Config                  config.Config
 	EnableEpochsFactory     enablers.EnableEpochsFactory
+	IncomingHeadersQueues   update.IncomingHeadersQueuesHandler
 
 	GenesisNodePrice *big.Int
 	GenesisString    string
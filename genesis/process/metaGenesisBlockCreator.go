@@ -367,6 +367,7 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, enableEpoc
 		ShardCoordinator:    arg.ShardCoordinator,
 		EnableEpochsHandler: enableEpochsHandler,
 		NodesCoordinator:    &disabled.NodesCoordinator{},
+		ChainID:             []byte(arg.Core.ChainID()),
 	})
 	if err != nil {
 		return nil, err
@@ -458,6 +458,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		NodesConfigProvider: arg.InitialNodesSetup,
 		MessageSignVerifier: messageSignVerifier,
 		NodesCoordinator:    liteNodesCoordinator,
+		ChainID:             []byte(arg.Core.ChainID()),
 	})
 	if err != nil {
 		return nil, err
@@ -7,20 +7,25 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
 	"github.com/multiversx/mx-chain-go/factory/addressDecoder"
 	"github.com/multiversx/mx-chain-go/genesis"
 	genesisCommon "github.com/multiversx/mx-chain-go/genesis/process/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/update"
 	"github.com/multiversx/mx-chain-go/vm"
 )
 
@@ -50,6 +55,9 @@ func (gbc *sovereignGenesisBlockCreator) CreateGenesisBlocks() (map[uint32]data.
 	if !mustDoGenesisProcess(gbc.arg) {
 		return gbc.createSovereignEmptyGenesisBlocks()
 	}
+	if mustDoHardForkImportProcess(gbc.arg) {
+		return gbc.createSovereignHeadersAfterHardFork()
+	}
 
 	err = gbc.computeSovereignDNSAddresses(gbc.arg.EpochConfig.EnableEpochs)
 	if err != nil {
@@ -203,6 +211,196 @@ func (gbc *sovereignGenesisBlockCreator) createSovereignHeaders(args *headerCrea
 	}, nil
 }
 
+// createSovereignHeadersAfterHardFork creates the sovereign genesis block from the state exported by a hardfork: the
+// imported accounts, the last finalized extended headers of the incoming chains, the incoming headers waiting for
+// confirmations and the outgoing operations not yet confirmed by the main chain
+func (gbc *sovereignGenesisBlockCreator) createSovereignHeadersAfterHardFork() (map[uint32]data.HeaderHandler, error) {
+	importHandler, ok := gbc.arg.importHandler.(update.SovereignImportHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w for sovereign import handler", process.ErrWrongTypeAssertion)
+	}
+	outGoingOperationsPool := gbc.arg.RunTypeComponents.OutGoingOperationsPoolHandler()
+	if check.IfNil(outGoingOperationsPool) {
+		return nil, update.ErrNilOutGoingOperationsPool
+	}
+	if check.IfNil(gbc.arg.IncomingHeadersQueues) {
+		return nil, update.ErrNilIncomingHeadersQueuesHandler
+	}
+
+	err := importHandler.ImportAll()
+	if err != nil {
+		return nil, err
+	}
+
+	importedAccounts := importHandler.GetAccountsDBForShard(core.SovereignChainShardId)
+	if check.IfNil(importedAccounts) {
+		return nil, update.ErrNilAccounts
+	}
+	rootHash, err := importedAccounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	err = gbc.computeSovereignDNSAddresses(gbc.arg.EpochConfig.EnableEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	round, nonce, epoch := getGenesisBlocksRoundNonceEpoch(gbc.arg)
+	genesisBlock := gbc.arg.RunTypeComponents.VersionedHeaderFactory().Create(epoch)
+	err = gbc.setGenesisEmptyBlockData(genesisBlock, round, nonce)
+	if err != nil {
+		return nil, err
+	}
+	err = setRootHash(genesisBlock, rootHash)
+	if err != nil {
+		return nil, err
+	}
+	err = genesisBlock.SetSoftwareVersion(process.SovereignHeaderVersion)
+	if err != nil {
+		return nil, err
+	}
+	err = genesisBlock.SetPrevHash(gbc.arg.Core.Hasher().Compute(gbc.arg.GenesisString))
+	if err != nil {
+		return nil, err
+	}
+
+	err = gbc.arg.Accounts.RecreateTrie(holders.NewDefaultRootHashesHolder(rootHash))
+	if err != nil {
+		return nil, err
+	}
+
+	validatorRootHash, err := gbc.arg.ValidatorAccounts.RootHash()
+	if err != nil {
+		return nil, err
+	}
+	err = gbc.setInitialDataInSovereignHeader(genesisBlock, validatorRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gbc.setLastFinalizedExtendedHeaders(genesisBlock, importHandler.GetLastFinalizedExtendedHeaders())
+	if err != nil {
+		return nil, err
+	}
+
+	err = gbc.arg.IncomingHeadersQueues.SetIncomingHeadersQueues(importHandler.GetIncomingHeadersQueues())
+	if err != nil {
+		return nil, err
+	}
+
+	addPendingOutGoingOperations(outGoingOperationsPool, importHandler.GetPendingOutGoingOperations())
+
+	err = gbc.saveGenesisBlock(genesisBlock)
+	if err != nil {
+		return nil, fmt.Errorf("'%w' while saving genesis block for shard %d", err, core.SovereignChainShardId)
+	}
+	err = saveSovereignGenesisStorage(gbc.arg.Data.StorageService(), gbc.arg.Core.InternalMarshalizer(), genesisBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	gbc.initialIndexingData[core.SovereignChainShardId] = &genesis.IndexingData{
+		DelegationTxs:      make([]data.TransactionHandler, 0),
+		ScrsTxs:            make(map[string]data.TransactionHandler),
+		StakingTxs:         make([]data.TransactionHandler, 0),
+		DeploySystemScTxs:  make([]data.TransactionHandler, 0),
+		DeployInitialScTxs: make([]data.TransactionHandler, 0),
+	}
+
+	log.Info("sovereignGenesisBlockCreator.createSovereignHeadersAfterHardFork",
+		"nonce", genesisBlock.GetNonce(),
+		"round", genesisBlock.GetRound(),
+		"epoch", genesisBlock.GetEpoch(),
+		"root hash", genesisBlock.GetRootHash(),
+		"validator root hash", validatorRootHash,
+	)
+
+	return map[uint32]data.HeaderHandler{
+		core.SovereignChainShardId: genesisBlock,
+	}, nil
+}
+
+// setLastFinalizedExtendedHeaders saves the imported extended headers of all incoming chains and sets the one of the
+// main chain as the last finalized cross chain header of the genesis block, so that the main chain tracking is resumed
+// from it
+func (gbc *sovereignGenesisBlockCreator) setLastFinalizedExtendedHeaders(
+	header data.HeaderHandler,
+	extendedHeaders map[uint32]data.ShardHeaderExtendedHandler,
+) error {
+	var mainChainExtendedHeaderHash []byte
+	for shardID, extendedHeader := range extendedHeaders {
+		extendedHeaderHash, err := gbc.saveExtendedHeader(shardID, extendedHeader)
+		if err != nil {
+			return err
+		}
+
+		if shardID == core.MainChainShardId {
+			mainChainExtendedHeaderHash = extendedHeaderHash
+		}
+	}
+
+	mainChainExtendedHeader, found := extendedHeaders[core.MainChainShardId]
+	if !found {
+		return nil
+	}
+
+	sovereignHeader, castOk := header.(data.SovereignChainHeaderHandler)
+	if !castOk {
+		return process.ErrWrongTypeAssertion
+	}
+
+	return sovereignHeader.SetLastFinalizedCrossChainHeaderHandler(&block.EpochStartCrossChainData{
+		ShardID:    core.MainChainShardId,
+		Epoch:      mainChainExtendedHeader.GetEpoch(),
+		Round:      mainChainExtendedHeader.GetRound(),
+		Nonce:      mainChainExtendedHeader.GetNonce(),
+		HeaderHash: mainChainExtendedHeaderHash,
+	})
+}
+
+func (gbc *sovereignGenesisBlockCreator) saveExtendedHeader(shardID uint32, extendedHeader data.ShardHeaderExtendedHandler) ([]byte, error) {
+	if check.IfNil(extendedHeader) {
+		return nil, process.ErrNilHeaderHandler
+	}
+
+	marshaller := gbc.arg.Core.InternalMarshalizer()
+	extendedHeaderBuff, err := marshaller.Marshal(extendedHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedHeaderHash := gbc.arg.Core.Hasher().Compute(string(extendedHeaderBuff))
+	err = gbc.arg.Data.StorageService().Put(dataRetriever.ExtendedShardHeadersUnit, extendedHeaderHash, extendedHeaderBuff)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("sovereignGenesisBlockCreator.saveExtendedHeader",
+		"shard", shardID,
+		"hash", extendedHeaderHash,
+		"nonce", extendedHeader.GetNonce(),
+		"round", extendedHeader.GetRound(),
+	)
+
+	return extendedHeaderHash, nil
+}
+
+func addPendingOutGoingOperations(
+	outGoingOperationsPool sovereignBlock.OutGoingOperationsPool,
+	pendingOperations map[string][]*sovereign.BridgeOutGoingData,
+) {
+	numOperations := 0
+	for destination, operations := range pendingOperations {
+		for _, operation := range operations {
+			outGoingOperationsPool.AddWithDestination(operation, destination)
+			numOperations++
+		}
+	}
+
+	log.Debug("sovereignGenesisBlockCreator: added imported pending outgoing operations", "num", numOperations)
+}
+
 func (gbc *sovereignGenesisBlockCreator) setInitialDataInSovereignHeader(header data.HeaderHandler, validatorRootHash []byte) error {
 	sovereignHeader, castOk := header.(data.SovereignChainHeaderHandler)
 	if !castOk {
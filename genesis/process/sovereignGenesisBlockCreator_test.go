@@ -26,9 +26,13 @@ import (
 	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	sovereignMocks "github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/multiversx/mx-chain-go/testscommon/state"
 	storageCommon "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/update"
+	updateMock "github.com/multiversx/mx-chain-go/update/mock"
 	"github.com/multiversx/mx-chain-go/vm"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
 
@@ -36,6 +40,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -500,3 +505,213 @@ func retrieveAccValue(t *testing.T, accountsDB stateAcc.AccountsAdapter, address
 
 	return val
 }
+
+func TestSovereignGenesisBlockCreator_CreateGenesisBlocksAfterHardFork(t *testing.T) {
+	t.Parallel()
+
+	createHardForkGenesisBlockCreator := func(t *testing.T) (*sovereignGenesisBlockCreator, stateAcc.AccountsAdapter) {
+		arg := createSovereignMockArgument(t, "testdata/genesisTest1.json", &mock.InitialNodesHandlerStub{}, big.NewInt(22000))
+		arg.ShardCoordinator = sharding.NewSovereignShardCoordinator()
+		arg.DNSV2Addresses = []string{"00000000000000000500761b8c4a25d3979359223208b412285f635e71300102"}
+
+		createAccounts := func() stateAcc.AccountsAdapter {
+			accounts, err := createAccountAdapter(
+				&mock.MarshalizerMock{},
+				&hashingMocks.HasherMock{},
+				arg.RunTypeComponents.AccountsCreator(),
+				arg.TrieStorageManagers[dataRetriever.UserAccountsUnit.String()],
+				&testscommon.PubkeyConverterMock{},
+				&enableEpochsHandlerMock.EnableEpochsHandlerStub{},
+			)
+			require.Nil(t, err)
+			return accounts
+		}
+		arg.Accounts = createAccounts()
+
+		gbc, err := NewGenesisBlockCreator(arg)
+		require.Nil(t, err)
+		sgbc, err := NewSovereignGenesisBlockCreator(gbc)
+		require.Nil(t, err)
+
+		sgbc.arg.StartEpochNum = 4
+		sgbc.arg.HardForkConfig.AfterHardFork = true
+		sgbc.arg.HardForkConfig.StartEpoch = 4
+		sgbc.arg.HardForkConfig.StartRound = 100
+		sgbc.arg.HardForkConfig.StartNonce = 90
+		sgbc.arg.IncomingHeadersQueues = &sovereignMocks.IncomingHeaderSubscriberStub{}
+
+		return sgbc, createAccounts()
+	}
+
+	t.Run("wrong import handler type, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		sgbc, _ := createHardForkGenesisBlockCreator(t)
+		sgbc.arg.importHandler = nil
+
+		blocks, err := sgbc.CreateGenesisBlocks()
+		require.ErrorIs(t, err, process.ErrWrongTypeAssertion)
+		require.Nil(t, blocks)
+	})
+
+	t.Run("nil incoming headers queues handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		sgbc, _ := createHardForkGenesisBlockCreator(t)
+		sgbc.arg.importHandler = &updateMock.ImportHandlerStub{}
+		sgbc.arg.IncomingHeadersQueues = nil
+
+		blocks, err := sgbc.CreateGenesisBlocks()
+		require.Equal(t, update.ErrNilIncomingHeadersQueuesHandler, err)
+		require.Nil(t, blocks)
+	})
+
+	t.Run("import error, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("import error")
+		sgbc, _ := createHardForkGenesisBlockCreator(t)
+		sgbc.arg.importHandler = &updateMock.ImportHandlerStub{
+			ImportAllCalled: func() error {
+				return expectedErr
+			},
+		}
+
+		blocks, err := sgbc.CreateGenesisBlocks()
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, blocks)
+	})
+
+	t.Run("no imported accounts, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		sgbc, _ := createHardForkGenesisBlockCreator(t)
+		sgbc.arg.importHandler = &updateMock.ImportHandlerStub{}
+
+		blocks, err := sgbc.CreateGenesisBlocks()
+		require.Equal(t, update.ErrNilAccounts, err)
+		require.Nil(t, blocks)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sgbc, importedAccounts := createHardForkGenesisBlockCreator(t)
+		acc, err := importedAccounts.LoadAccount(bytes.Repeat([]byte("a"), 32))
+		require.Nil(t, err)
+		err = importedAccounts.SaveAccount(acc)
+		require.Nil(t, err)
+		rootHash, err := importedAccounts.Commit()
+		require.Nil(t, err)
+
+		extendedHeader := &block.ShardHeaderExtended{
+			Header: &block.HeaderV2{
+				Header: &block.Header{
+					Nonce: 10,
+					Round: 11,
+					Epoch: 2,
+				},
+			},
+		}
+		incomingChainShardID := core.MainChainShardId - 1
+		incomingChainHeader := &block.ShardHeaderExtended{
+			Header: &block.HeaderV2{
+				Header: &block.Header{
+					Nonce: 20,
+					Round: 21,
+					Epoch: 2,
+				},
+			},
+		}
+		incomingHeadersQueues := map[uint32][]byte{
+			core.MainChainShardId: []byte("queue"),
+		}
+		pendingOperations := map[string][]*sovereign.BridgeOutGoingData{
+			"": {
+				{Hash: []byte("hashOfHashes1")},
+			},
+			"messaging": {
+				{Hash: []byte("hashOfHashes2")},
+				{Hash: []byte("hashOfHashes3")},
+			},
+		}
+		sgbc.arg.importHandler = &updateMock.ImportHandlerStub{
+			GetAccountsDBForShardCalled: func(shardID uint32) stateAcc.AccountsAdapter {
+				require.Equal(t, core.SovereignChainShardId, shardID)
+				return importedAccounts
+			},
+			GetLastFinalizedExtendedHeadersCalled: func() map[uint32]data.ShardHeaderExtendedHandler {
+				return map[uint32]data.ShardHeaderExtendedHandler{
+					core.MainChainShardId: extendedHeader,
+					incomingChainShardID:  incomingChainHeader,
+				}
+			},
+			GetIncomingHeadersQueuesCalled: func() map[uint32][]byte {
+				return incomingHeadersQueues
+			},
+			GetPendingOutGoingOperationsCalled: func() map[string][]*sovereign.BridgeOutGoingData {
+				return pendingOperations
+			},
+		}
+
+		addedOperations := make(map[string][]*sovereign.BridgeOutGoingData)
+		sgbc.arg.RunTypeComponents.(*genesisMocks.RunTypeComponentsStub).OutGoingOperationsPool = &sovereignMocks.OutGoingOperationsPoolMock{
+			AddWithDestinationCalled: func(data *sovereign.BridgeOutGoingData, destination string) {
+				addedOperations[destination] = append(addedOperations[destination], data)
+			},
+		}
+
+		extendedHeaderBuff, err := sgbc.arg.Core.InternalMarshalizer().Marshal(extendedHeader)
+		require.Nil(t, err)
+		extendedHeaderHash := sgbc.arg.Core.Hasher().Compute(string(extendedHeaderBuff))
+		incomingChainHeaderBuff, err := sgbc.arg.Core.InternalMarshalizer().Marshal(incomingChainHeader)
+		require.Nil(t, err)
+		incomingChainHeaderHash := sgbc.arg.Core.Hasher().Compute(string(incomingChainHeaderBuff))
+		savedExtendedHeaders := make(map[string][]byte)
+		sgbc.arg.Data.(*mock.DataComponentsMock).Storage = &storageCommon.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+				return genericMocks.NewStorerMock(), nil
+			},
+			PutCalled: func(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+				if unitType == dataRetriever.ExtendedShardHeadersUnit {
+					savedExtendedHeaders[string(key)] = value
+				}
+				return nil
+			},
+		}
+
+		var setIncomingHeadersQueues map[uint32][]byte
+		sgbc.arg.IncomingHeadersQueues = &sovereignMocks.IncomingHeaderSubscriberStub{
+			SetIncomingHeadersQueuesCalled: func(queues map[uint32][]byte) error {
+				setIncomingHeadersQueues = queues
+				return nil
+			},
+		}
+
+		blocks, err := sgbc.CreateGenesisBlocks()
+		require.Nil(t, err)
+		require.Len(t, blocks, 1)
+		require.Equal(t, map[string][]byte{
+			string(extendedHeaderHash):      extendedHeaderBuff,
+			string(incomingChainHeaderHash): incomingChainHeaderBuff,
+		}, savedExtendedHeaders)
+		require.Equal(t, incomingHeadersQueues, setIncomingHeadersQueues)
+		require.Equal(t, pendingOperations, addedOperations)
+
+		sovBlock := blocks[core.SovereignChainShardId].(*block.SovereignChainHeader)
+		require.Equal(t, uint64(90), sovBlock.GetNonce())
+		require.Equal(t, uint64(100), sovBlock.GetRound())
+		require.Equal(t, uint32(4), sovBlock.GetEpoch())
+		require.Equal(t, rootHash, sovBlock.GetRootHash())
+		require.Equal(t, process.SovereignHeaderVersion, sovBlock.GetSoftwareVersion())
+		require.True(t, sovBlock.IsStartOfEpochBlock())
+		require.Equal(t, block.EpochStartCrossChainData{
+			ShardID:    core.MainChainShardId,
+			Epoch:      2,
+			Round:      11,
+			Nonce:      10,
+			HeaderHash: extendedHeaderHash,
+		}, sovBlock.EpochStart.LastFinalizedCrossChainHeader)
+		require.NotNil(t, sgbc.GetIndexingData()[core.SovereignChainShardId])
+	})
+}
@@ -178,6 +178,7 @@ func startNodesWithCommitBlock(
 			RunTypeComponents:    n.Node.GetRunTypeComponents(),
 			SubRoundEndV2Creator: bls.NewSubRoundEndV2Creator(),
 			ExtraSignersHolder:   &subRoundsHolder.ExtraSignersHolderMock{},
+			SigningJournal:       &consensusMocks.SigningJournalStub{},
 		}
 
 		consensusFactory, err := consensusComp.NewConsensusComponentsFactory(consensusArgs)
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/validator"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
@@ -118,6 +119,19 @@ type Facade interface {
 	GetEligibleManagedKeys() ([]string, error)
 	GetWaitingManagedKeys() ([]string, error)
 	GetWaitingEpochsLeftForPublicKey(publicKey string) (uint32, error)
+	GetEquivocationProofs() []*equivocation.Proof
+	AddManagedKey(privateKeyHex string) (string, error)
+	RemoveManagedKey(publicKey string) error
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error)
+	GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error)
+	GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	RequeueDeadLetteredOutGoingOperations(hash string) error
+	DropDeadLetteredOutGoingOperations(hash string) error
+	IsAdminTokenValid(token string) bool
+	GetBridgePauseState() *common.BridgePauseStateAPIResponse
+	GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error)
 	IsInterfaceNil() bool
 }
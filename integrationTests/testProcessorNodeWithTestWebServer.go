@@ -13,11 +13,13 @@ import (
 
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common/disabled"
 	"github.com/multiversx/mx-chain-go/config"
 	nodeFacade "github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/node/external/blockAPI"
+	"github.com/multiversx/mx-chain-go/node/external/sovereignAPI"
 	"github.com/multiversx/mx-chain-go/node/external/transactionAPI"
 	"github.com/multiversx/mx-chain-go/node/trieIterators"
 	"github.com/multiversx/mx-chain-go/node/trieIterators/factory"
@@ -29,6 +31,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/genesisMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/multiversx/mx-chain-go/testscommon/state"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts/defaults"
 )
@@ -275,6 +278,17 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 	apiInternalBlockProcessor, err := blockAPI.CreateAPIInternalBlockProcessor(argsBlockAPI)
 	log.LogIfError(err)
 
+	apiBridgeProcessor, err := sovereignAPI.NewAPIBridgeProcessor(sovereignAPI.ArgAPIBridgeProcessor{
+		OutGoingOperationsPool: disabled.NewDisabledOutGoingOperationPool(),
+		BlockTracker:           tpn.BlockTracker,
+		IncomingChainsHandler:  disabled.NewDisabledIncomingChainsHandler(),
+		BridgePauseHandler:     &sovereign.BridgePauseHandlerMock{},
+		HistoryRepository:      tpn.HistoryRepository,
+		StorageService:         tpn.Storage,
+		Marshaller:             TestMarshalizer,
+	})
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           tpn.SCQueryService,
 		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
@@ -285,6 +299,7 @@ func createFacadeComponents(tpn *TestProcessorNode) nodeFacade.ApiResolver {
 		APITransactionHandler:    apiTransactionHandler,
 		APIBlockHandler:          blockAPIHandler,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
+		APIBridgeHandler:         apiBridgeProcessor,
 		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
@@ -0,0 +1,29 @@
+package keysManagement
+
+type disabledManagedKeysReloader struct {
+}
+
+// NewDisabledManagedKeysReloader creates a disabled managed keys reloader instance
+func NewDisabledManagedKeysReloader() *disabledManagedKeysReloader {
+	return &disabledManagedKeysReloader{}
+}
+
+// AddManagedKey returns ErrManagedKeysReloadDisabled
+func (reloader *disabledManagedKeysReloader) AddManagedKey(_ []byte) ([]byte, error) {
+	return nil, ErrManagedKeysReloadDisabled
+}
+
+// RemoveManagedKey returns ErrManagedKeysReloadDisabled
+func (reloader *disabledManagedKeysReloader) RemoveManagedKey(_ []byte) error {
+	return ErrManagedKeysReloadDisabled
+}
+
+// Close does nothing and returns nil
+func (reloader *disabledManagedKeysReloader) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reloader *disabledManagedKeysReloader) IsInterfaceNil() bool {
+	return reloader == nil
+}
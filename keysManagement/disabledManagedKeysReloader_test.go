@@ -0,0 +1,30 @@
+package keysManagement
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledManagedKeysReloader(t *testing.T) {
+	t.Parallel()
+
+	reloader := NewDisabledManagedKeysReloader()
+	assert.False(t, check.IfNil(reloader))
+}
+
+func TestDisabledManagedKeysReloader_MethodsShouldReturnDisabledError(t *testing.T) {
+	t.Parallel()
+
+	reloader := NewDisabledManagedKeysReloader()
+
+	pkBytes, err := reloader.AddManagedKey([]byte("private key"))
+	assert.Equal(t, ErrManagedKeysReloadDisabled, err)
+	assert.Nil(t, pkBytes)
+
+	err = reloader.RemoveManagedKey([]byte("public key"))
+	assert.Equal(t, ErrManagedKeysReloadDisabled, err)
+
+	assert.Nil(t, reloader.Close())
+}
@@ -37,3 +37,21 @@ var ErrNilShardProvider = errors.New("nil shard provider")
 
 // ErrNilEpochProvider signals that a nil epoch provider has been provided
 var ErrNilEpochProvider = errors.New("nil epoch provider")
+
+// ErrNilKeyLoader signals that a nil key loader has been provided
+var ErrNilKeyLoader = errors.New("nil key loader")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilRoundHandler signals that a nil round handler has been provided
+var ErrNilRoundHandler = errors.New("nil round handler")
+
+// ErrNotMultiKeyMode signals that the node is not running in multikey mode
+var ErrNotMultiKeyMode = errors.New("the node is not running in multikey mode")
+
+// ErrCannotRemoveLastManagedKey signals an attempt to remove the last key managed by the node
+var ErrCannotRemoveLastManagedKey = errors.New("can not remove the last managed key")
+
+// ErrManagedKeysReloadDisabled signals that the reload of the managed keys is disabled
+var ErrManagedKeysReloadDisabled = errors.New("managed keys reload is disabled")
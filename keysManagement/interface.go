@@ -18,3 +18,15 @@ type CurrentEpochProvider interface {
 	CurrentEpoch() uint32
 	IsInterfaceNil() bool
 }
+
+// KeyLoader defines a component able to load all the keys from a pem file
+type KeyLoader interface {
+	LoadAllKeys(path string) ([][]byte, []string, error)
+	IsInterfaceNil() bool
+}
+
+// RoundHandler defines a component able to provide the current round index
+type RoundHandler interface {
+	Index() int64
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,271 @@
+package keyStore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// EncryptedKeyFileVersion is the current version of the encrypted key file format
+	EncryptedKeyFileVersion = 1
+
+	encryptedKeyFileKind = "validatorKeys"
+	cipherAES256GCM      = "aes-256-gcm"
+	kdfScrypt            = "scrypt"
+	derivedKeyLength     = 32
+	saltLength           = 32
+	pemPkHeader          = "PRIVATE KEY for "
+
+	// maxScryptMemory bounds the memory, 128 * N * r bytes, needed to unlock a key file, so an altered key file can
+	// not exhaust the memory of the node before its passphrase is checked
+	maxScryptMemory = 1 << 30
+	maxScryptP      = 16
+)
+
+// ScryptParams holds the cost parameters of the scrypt key derivation function
+type ScryptParams struct {
+	N int
+	R int
+	P int
+}
+
+// DefaultScryptParams returns the scrypt cost parameters used for the validator key files. They require 64MB of memory
+// and a fraction of a second to unlock a file, which is only done once, at node start
+func DefaultScryptParams() ScryptParams {
+	return ScryptParams{
+		N: 1 << 16,
+		R: 8,
+		P: 1,
+	}
+}
+
+// EncryptedKeyFile is the JSON representation of an encrypted validator key file, similar to the wallet JSON keystores.
+// The public keys are kept in clear, so the operator can tell which keys a file holds without unlocking it
+type EncryptedKeyFile struct {
+	Version    int           `json:"version"`
+	Kind       string        `json:"kind"`
+	PublicKeys []string      `json:"publicKeys"`
+	Crypto     CryptoSection `json:"crypto"`
+}
+
+// CryptoSection holds the encrypted keys and the parameters needed to decrypt them
+type CryptoSection struct {
+	Cipher       string       `json:"cipher"`
+	CipherParams CipherParams `json:"cipherparams"`
+	Ciphertext   string       `json:"ciphertext"`
+	KDF          string       `json:"kdf"`
+	KDFParams    KDFParams    `json:"kdfparams"`
+}
+
+// CipherParams holds the hex encoded AES-GCM nonce
+type CipherParams struct {
+	Nonce string `json:"nonce"`
+}
+
+// KDFParams holds the scrypt parameters and the hex encoded salt
+type KDFParams struct {
+	DkLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+}
+
+// IsEncryptedKeyFile returns true if the provided file content is an encrypted key file and not a plain PEM file
+func IsEncryptedKeyFile(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("{"))
+}
+
+// EncryptPemData encrypts the PEM data holding the validator keys, as written by the key generator, with a key derived
+// from the passphrase
+func EncryptPemData(pemData []byte, passphrase []byte, params ScryptParams) ([]byte, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	_, publicKeys, err := LoadAllKeysFromPemData(pemData)
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKeys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	salt := make([]byte, saltLength)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key(passphrase, salt, params.N, params.R, params.P, derivedKeyLength)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := createGCM(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile := &EncryptedKeyFile{
+		Version:    EncryptedKeyFileVersion,
+		Kind:       encryptedKeyFileKind,
+		PublicKeys: publicKeys,
+		Crypto: CryptoSection{
+			Cipher:       cipherAES256GCM,
+			CipherParams: CipherParams{Nonce: hex.EncodeToString(nonce)},
+			KDF:          kdfScrypt,
+			KDFParams: KDFParams{
+				DkLen: derivedKeyLength,
+				Salt:  hex.EncodeToString(salt),
+				N:     params.N,
+				R:     params.R,
+				P:     params.P,
+			},
+		},
+	}
+	keyFile.Crypto.Ciphertext = hex.EncodeToString(gcm.Seal(nil, nonce, pemData, additionalData(keyFile)))
+
+	return json.MarshalIndent(keyFile, "", "  ")
+}
+
+// DecryptKeyFile decrypts an encrypted key file, returning the PEM data holding the validator keys
+func DecryptKeyFile(data []byte, passphrase []byte) ([]byte, error) {
+	keyFile := &EncryptedKeyFile{}
+	err := json.Unmarshal(data, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkKeyFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := hex.DecodeString(keyFile.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w for salt", err)
+	}
+	nonce, err := hex.DecodeString(keyFile.Crypto.CipherParams.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w for nonce", err)
+	}
+	ciphertext, err := hex.DecodeString(keyFile.Crypto.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("%w for ciphertext", err)
+	}
+
+	kdfParams := keyFile.Crypto.KDFParams
+	derivedKey, err := scrypt.Key(passphrase, salt, kdfParams.N, kdfParams.R, kdfParams.P, kdfParams.DkLen)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := createGCM(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("%w, invalid nonce length %d", ErrWrongPassphrase, len(nonce))
+	}
+
+	pemData, err := gcm.Open(nil, nonce, ciphertext, additionalData(keyFile))
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+
+	_, publicKeys, err := LoadAllKeysFromPemData(pemData)
+	if err != nil {
+		return nil, err
+	}
+	if strings.Join(publicKeys, ",") != strings.Join(keyFile.PublicKeys, ",") {
+		return nil, ErrPublicKeysMismatch
+	}
+
+	return pemData, nil
+}
+
+func checkKeyFile(keyFile *EncryptedKeyFile) error {
+	if keyFile.Version != EncryptedKeyFileVersion {
+		return fmt.Errorf("%w %d", ErrUnsupportedVersion, keyFile.Version)
+	}
+	if keyFile.Crypto.Cipher != cipherAES256GCM {
+		return fmt.Errorf("%w %s", ErrUnsupportedCipher, keyFile.Crypto.Cipher)
+	}
+	if keyFile.Crypto.KDF != kdfScrypt {
+		return fmt.Errorf("%w %s", ErrUnsupportedKDF, keyFile.Crypto.KDF)
+	}
+	if keyFile.Crypto.KDFParams.DkLen != derivedKeyLength {
+		return fmt.Errorf("%w, invalid derived key length %d", ErrUnsupportedKDF, keyFile.Crypto.KDFParams.DkLen)
+	}
+
+	return checkScryptParams(keyFile.Crypto.KDFParams)
+}
+
+func checkScryptParams(kdfParams KDFParams) error {
+	if kdfParams.N <= 0 || kdfParams.R <= 0 || kdfParams.P <= 0 {
+		return fmt.Errorf("%w, invalid scrypt parameters n=%d, r=%d, p=%d", ErrUnsupportedKDF, kdfParams.N, kdfParams.R, kdfParams.P)
+	}
+	if kdfParams.R > maxScryptMemory/128 || kdfParams.N > maxScryptMemory/(128*kdfParams.R) || kdfParams.P > maxScryptP {
+		return fmt.Errorf("%w, too costly scrypt parameters n=%d, r=%d, p=%d", ErrUnsupportedKDF, kdfParams.N, kdfParams.R, kdfParams.P)
+	}
+
+	return nil
+}
+
+func createGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds the clear fields of the key file to the ciphertext, so they can not be altered unnoticed
+func additionalData(keyFile *EncryptedKeyFile) []byte {
+	return []byte(fmt.Sprintf("%d:%s:%s", keyFile.Version, keyFile.Kind, strings.Join(keyFile.PublicKeys, ",")))
+}
+
+// LoadAllKeysFromPemData returns all the keys found in the PEM data, in the same format as the core key loader does
+func LoadAllKeysFromPemData(pemData []byte) ([][]byte, []string, error) {
+	buff := bytes.TrimSpace(pemData)
+	if len(buff) == 0 {
+		return nil, nil, fmt.Errorf("%w, empty data", ErrInvalidPemData)
+	}
+
+	privateKeys := make([][]byte, 0)
+	publicKeys := make([]string, 0)
+	for len(buff) > 0 {
+		var block *pem.Block
+		block, buff = pem.Decode(buff)
+		if block == nil {
+			return nil, nil, fmt.Errorf("%w, error decoding", ErrInvalidPemData)
+		}
+		buff = bytes.TrimSpace(buff)
+
+		if !strings.HasPrefix(block.Type, pemPkHeader) {
+			return nil, nil, fmt.Errorf("%w, missing '%s' in block type", ErrInvalidPemData, pemPkHeader)
+		}
+
+		privateKeys = append(privateKeys, block.Bytes)
+		publicKeys = append(publicKeys, block.Type[len(pemPkHeader):])
+	}
+
+	return privateKeys, publicKeys, nil
+}
@@ -0,0 +1,30 @@
+package keyStore
+
+import "errors"
+
+// ErrMissingPassphrase signals that no passphrase was provided for unlocking an encrypted key file
+var ErrMissingPassphrase = errors.New("missing passphrase for the encrypted key file")
+
+// ErrEmptyPassphrase signals that an empty passphrase was provided
+var ErrEmptyPassphrase = errors.New("empty passphrase")
+
+// ErrNoKeys signals that no keys were provided for encryption
+var ErrNoKeys = errors.New("no keys provided")
+
+// ErrUnsupportedVersion signals that the encrypted key file has an unsupported version
+var ErrUnsupportedVersion = errors.New("unsupported encrypted key file version")
+
+// ErrUnsupportedCipher signals that the encrypted key file uses an unsupported cipher
+var ErrUnsupportedCipher = errors.New("unsupported cipher")
+
+// ErrUnsupportedKDF signals that the encrypted key file uses an unsupported key derivation function
+var ErrUnsupportedKDF = errors.New("unsupported key derivation function")
+
+// ErrWrongPassphrase signals that the encrypted key file could not be decrypted with the provided passphrase
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted key file")
+
+// ErrPublicKeysMismatch signals that the decrypted keys do not match the public keys listed in the key file
+var ErrPublicKeysMismatch = errors.New("the decrypted keys do not match the listed public keys")
+
+// ErrInvalidPemData signals that the PEM data holding the keys is not valid
+var ErrInvalidPemData = errors.New("invalid PEM data")
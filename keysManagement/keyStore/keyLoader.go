@@ -0,0 +1,139 @@
+package keyStore
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// PassphraseEnvVariable is the environment variable holding the passphrase of the encrypted key files, used when no
+// passphrase file is provided
+const PassphraseEnvVariable = "MX_VALIDATOR_KEYS_PASSPHRASE"
+
+var log = logger.GetOrCreate("keysManagement/keyStore")
+
+type keyLoader struct {
+	passphraseFile string
+}
+
+// NewKeyLoader creates a key loader able to read both the plain PEM key files and the encrypted key files. The
+// passphrase is only read when an encrypted key file is loaded, from the provided file or, if no file is provided,
+// from the PassphraseEnvVariable environment variable
+func NewKeyLoader(passphraseFile string) *keyLoader {
+	return &keyLoader{
+		passphraseFile: passphraseFile,
+	}
+}
+
+// LoadKey loads the key with the given index found in the key file from the given path
+func (kl *keyLoader) LoadKey(path string, skIndex int) ([]byte, string, error) {
+	if skIndex < 0 {
+		return nil, "", core.ErrInvalidIndex
+	}
+
+	pemData, isEncrypted, err := kl.readPemData(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if !isEncrypted {
+		return core.LoadSkPkFromPemFile(path, skIndex)
+	}
+
+	privateKeys, publicKeys, err := LoadAllKeysFromPemData(pemData)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w while reading %s file", err, path)
+	}
+	if skIndex >= len(privateKeys) {
+		return nil, "", fmt.Errorf("%w while reading %s file, invalid index %d", core.ErrInvalidIndex, path, skIndex)
+	}
+
+	return privateKeys[skIndex], publicKeys[skIndex], nil
+}
+
+// LoadAllKeys loads all keys found in the key file for the given path
+func (kl *keyLoader) LoadAllKeys(path string) ([][]byte, []string, error) {
+	pemData, isEncrypted, err := kl.readPemData(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isEncrypted {
+		return core.LoadAllKeysFromPemFile(path)
+	}
+
+	privateKeys, publicKeys, err := LoadAllKeysFromPemData(pemData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w while reading %s file", err, path)
+	}
+
+	return privateKeys, publicKeys, nil
+}
+
+// readPemData returns the decrypted content of the key file, if encrypted. Plain key files are left to the core loader
+func (kl *keyLoader) readPemData(path string) ([]byte, bool, error) {
+	file, err := core.OpenFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w while reading %s file", err, path)
+	}
+	if !IsEncryptedKeyFile(data) {
+		return nil, false, nil
+	}
+
+	passphrase, err := LoadPassphrase(kl.passphraseFile)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w while unlocking %s file", err, path)
+	}
+
+	pemData, err := DecryptKeyFile(data, passphrase)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w while unlocking %s file", err, path)
+	}
+
+	log.Debug("unlocked encrypted key file", "file", path)
+
+	return pemData, true, nil
+}
+
+// LoadPassphrase reads the passphrase from the provided file or, if no file is provided, from the
+// PassphraseEnvVariable environment variable. The trailing new line of the file is not part of the passphrase
+func LoadPassphrase(passphraseFile string) ([]byte, error) {
+	var passphrase []byte
+	if len(passphraseFile) > 0 {
+		fileContent, err := os.ReadFile(passphraseFile)
+		if err != nil {
+			return nil, err
+		}
+
+		passphrase = bytes.TrimRight(fileContent, "\r\n")
+	} else {
+		envPassphrase, found := os.LookupEnv(PassphraseEnvVariable)
+		if !found {
+			return nil, fmt.Errorf("%w, provide a passphrase file or set the %s environment variable",
+				ErrMissingPassphrase, PassphraseEnvVariable)
+		}
+
+		passphrase = []byte(envPassphrase)
+	}
+
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	return passphrase, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (kl *keyLoader) IsInterfaceNil() bool {
+	return kl == nil
+}
@@ -0,0 +1,143 @@
+package keyStore_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement/keyStore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, name string, data []byte) string {
+	fileName := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(fileName, data, core.FileModeUserReadWrite)
+	require.Nil(t, err)
+
+	return fileName
+}
+
+func TestNewKeyLoader(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, check.IfNil(keyStore.NewKeyLoader("")))
+}
+
+func TestKeyLoader_LoadKey(t *testing.T) {
+	t.Parallel()
+
+	plainFile := writeTestFile(t, "validatorKey.pem", []byte(testPemData))
+	encryptedFile := writeTestFile(t, "validatorKey.json", encryptTestPemData(t))
+	passphraseFile := writeTestFile(t, "passphrase", append(testPassphrase, '\n'))
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		sk, pk, err := keyStore.NewKeyLoader("").LoadKey("missing.pem", 0)
+		assert.NotNil(t, err)
+		assert.Nil(t, sk)
+		assert.Empty(t, pk)
+	})
+	t.Run("negative index should error", func(t *testing.T) {
+		t.Parallel()
+
+		sk, pk, err := keyStore.NewKeyLoader(passphraseFile).LoadKey(encryptedFile, -1)
+		assert.Equal(t, core.ErrInvalidIndex, err)
+		assert.Nil(t, sk)
+		assert.Empty(t, pk)
+	})
+	t.Run("plain file should not need a passphrase", func(t *testing.T) {
+		t.Parallel()
+
+		sk, pk, err := keyStore.NewKeyLoader("").LoadKey(plainFile, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("sk111111111111111111111111111111"), sk)
+		assert.Equal(t, "pk1", pk)
+	})
+	t.Run("encrypted file with missing passphrase file should error", func(t *testing.T) {
+		t.Parallel()
+
+		sk, pk, err := keyStore.NewKeyLoader("missing passphrase file").LoadKey(encryptedFile, 0)
+		assert.NotNil(t, err)
+		assert.Nil(t, sk)
+		assert.Empty(t, pk)
+	})
+	t.Run("encrypted file with wrong passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrongPassphraseFile := writeTestFile(t, "passphrase", []byte("wrong passphrase"))
+		sk, pk, err := keyStore.NewKeyLoader(wrongPassphraseFile).LoadKey(encryptedFile, 0)
+		assert.True(t, errors.Is(err, keyStore.ErrWrongPassphrase))
+		assert.Nil(t, sk)
+		assert.Empty(t, pk)
+	})
+	t.Run("encrypted file with index out of bounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		sk, pk, err := keyStore.NewKeyLoader(passphraseFile).LoadKey(encryptedFile, 2)
+		assert.True(t, errors.Is(err, core.ErrInvalidIndex))
+		assert.Nil(t, sk)
+		assert.Empty(t, pk)
+	})
+	t.Run("encrypted file should work", func(t *testing.T) {
+		t.Parallel()
+
+		sk, pk, err := keyStore.NewKeyLoader(passphraseFile).LoadKey(encryptedFile, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("sk111111111111111111111111111111"), sk)
+		assert.Equal(t, "pk1", pk)
+	})
+}
+
+func TestKeyLoader_LoadAllKeys(t *testing.T) {
+	t.Parallel()
+
+	plainFile := writeTestFile(t, "allValidatorsKeys.pem", []byte(testPemData))
+	encryptedFile := writeTestFile(t, "allValidatorsKeys.json", encryptTestPemData(t))
+	passphraseFile := writeTestFile(t, "passphrase", testPassphrase)
+
+	plainPrivateKeys, plainPublicKeys, err := keyStore.NewKeyLoader("").LoadAllKeys(plainFile)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"pk0", "pk1"}, plainPublicKeys)
+
+	privateKeys, publicKeys, err := keyStore.NewKeyLoader(passphraseFile).LoadAllKeys(encryptedFile)
+	require.Nil(t, err)
+	assert.Equal(t, plainPrivateKeys, privateKeys)
+	assert.Equal(t, plainPublicKeys, publicKeys)
+}
+
+func TestLoadPassphrase(t *testing.T) {
+	t.Run("from file should trim the trailing new line", func(t *testing.T) {
+		passphraseFile := writeTestFile(t, "passphrase", []byte("pass phrase \r\n"))
+
+		passphrase, err := keyStore.LoadPassphrase(passphraseFile)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("pass phrase "), passphrase)
+	})
+	t.Run("empty file should error", func(t *testing.T) {
+		passphraseFile := writeTestFile(t, "passphrase", []byte("\n"))
+
+		passphrase, err := keyStore.LoadPassphrase(passphraseFile)
+		assert.Equal(t, keyStore.ErrEmptyPassphrase, err)
+		assert.Nil(t, passphrase)
+	})
+	t.Run("from environment variable", func(t *testing.T) {
+		t.Setenv(keyStore.PassphraseEnvVariable, "env passphrase")
+
+		passphrase, err := keyStore.LoadPassphrase("")
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("env passphrase"), passphrase)
+	})
+	t.Run("missing environment variable should error", func(t *testing.T) {
+		t.Setenv(keyStore.PassphraseEnvVariable, "")
+		_ = os.Unsetenv(keyStore.PassphraseEnvVariable)
+
+		passphrase, err := keyStore.LoadPassphrase("")
+		assert.True(t, errors.Is(err, keyStore.ErrMissingPassphrase))
+		assert.Nil(t, passphrase)
+	})
+}
@@ -0,0 +1,441 @@
+package keysManagement
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+const minCheckInterval = time.Millisecond
+
+// ArgsManagedKeysReloader represents the arguments for the managed keys reloader
+type ArgsManagedKeysReloader struct {
+	ManagedPeersHolder         common.ManagedPeersHolder
+	KeyGenerator               crypto.KeyGenerator
+	KeyLoader                  KeyLoader
+	PubKeyConverter            core.PubkeyConverter
+	RoundHandler               RoundHandler
+	AllValidatorsKeysFile      string
+	WatchAllValidatorsKeysFile bool
+	FileCheckInterval          time.Duration
+	RoundCheckInterval         time.Duration
+}
+
+// pendingChange holds a change of the managed keys which will be applied at the start of the next round.
+// The private key bytes are empty for a removal
+type pendingChange struct {
+	privateKeyBytes []byte
+}
+
+func (change *pendingChange) isRemoval() bool {
+	return len(change.privateKeyBytes) == 0
+}
+
+type managedKeysReloader struct {
+	managedPeersHolder         common.ManagedPeersHolder
+	keyGenerator               crypto.KeyGenerator
+	keyLoader                  KeyLoader
+	pubKeyConverter            core.PubkeyConverter
+	roundHandler               RoundHandler
+	allValidatorsKeysFile      string
+	watchAllValidatorsKeysFile bool
+	fileCheckInterval          time.Duration
+	roundCheckInterval         time.Duration
+
+	mutPendingChanges sync.Mutex
+	pendingChanges    map[string]*pendingChange
+	lastRoundIndex    int64
+	lastFileModTime   time.Time
+	cancelFunc        func()
+}
+
+// NewManagedKeysReloader creates a new instance of a managed keys reloader. The changes of the managed keys, either
+// requested directly or detected in the allValidatorsKeys file, are applied at the start of the next round
+func NewManagedKeysReloader(args ArgsManagedKeysReloader) (*managedKeysReloader, error) {
+	err := checkManagedKeysReloaderArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	reloader := &managedKeysReloader{
+		managedPeersHolder:         args.ManagedPeersHolder,
+		keyGenerator:               args.KeyGenerator,
+		keyLoader:                  args.KeyLoader,
+		pubKeyConverter:            args.PubKeyConverter,
+		roundHandler:               args.RoundHandler,
+		allValidatorsKeysFile:      args.AllValidatorsKeysFile,
+		watchAllValidatorsKeysFile: args.WatchAllValidatorsKeysFile,
+		fileCheckInterval:          args.FileCheckInterval,
+		roundCheckInterval:         args.RoundCheckInterval,
+		pendingChanges:             make(map[string]*pendingChange),
+		lastRoundIndex:             args.RoundHandler.Index(),
+		lastFileModTime:            getFileModTime(args.AllValidatorsKeysFile),
+	}
+
+	var ctx context.Context
+	ctx, reloader.cancelFunc = context.WithCancel(context.Background())
+	go reloader.processLoop(ctx)
+
+	return reloader, nil
+}
+
+func checkManagedKeysReloaderArgs(args ArgsManagedKeysReloader) error {
+	if check.IfNil(args.ManagedPeersHolder) {
+		return ErrNilManagedPeersHolder
+	}
+	if !args.ManagedPeersHolder.IsMultiKeyMode() {
+		return ErrNotMultiKeyMode
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.KeyLoader) {
+		return ErrNilKeyLoader
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.RoundHandler) {
+		return ErrNilRoundHandler
+	}
+	if args.RoundCheckInterval < minCheckInterval {
+		return fmt.Errorf("%w for RoundCheckInterval, minimum %v, got %v", ErrInvalidValue, minCheckInterval, args.RoundCheckInterval)
+	}
+	if !args.WatchAllValidatorsKeysFile {
+		return nil
+	}
+	if len(args.AllValidatorsKeysFile) == 0 {
+		return fmt.Errorf("%w for AllValidatorsKeysFile, empty file name", ErrInvalidValue)
+	}
+	if args.FileCheckInterval < minCheckInterval {
+		return fmt.Errorf("%w for FileCheckInterval, minimum %v, got %v", ErrInvalidValue, minCheckInterval, args.FileCheckInterval)
+	}
+
+	return nil
+}
+
+// AddManagedKey schedules the addition of the provided private key as a managed key, returning its public key.
+// It errors if the key is already managed, or it is already scheduled to be added
+func (reloader *managedKeysReloader) AddManagedKey(privateKeyBytes []byte) ([]byte, error) {
+	privateKey, err := reloader.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	pkBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	reloader.mutPendingChanges.Lock()
+	defer reloader.mutPendingChanges.Unlock()
+
+	err = reloader.scheduleAddition(pkBytes, privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return pkBytes, nil
+}
+
+func (reloader *managedKeysReloader) scheduleAddition(pkBytes []byte, privateKeyBytes []byte) error {
+	if reloader.isKeyManaged(pkBytes) {
+		return fmt.Errorf("%w for public key %s", ErrDuplicatedKey, hex.EncodeToString(pkBytes))
+	}
+
+	if reloader.managedPeersHolder.IsKeyRegistered(pkBytes) {
+		// the key was scheduled to be removed, so it will just stay managed
+		delete(reloader.pendingChanges, string(pkBytes))
+	} else {
+		reloader.pendingChanges[string(pkBytes)] = &pendingChange{
+			privateKeyBytes: privateKeyBytes,
+		}
+	}
+
+	log.Info("scheduled the addition of a managed key", "public key", hex.EncodeToString(pkBytes))
+
+	return nil
+}
+
+// RemoveManagedKey schedules the removal of the managed key defined by the provided public key.
+// It errors if the key is not managed, or it is the last managed key
+func (reloader *managedKeysReloader) RemoveManagedKey(pkBytes []byte) error {
+	reloader.mutPendingChanges.Lock()
+	defer reloader.mutPendingChanges.Unlock()
+
+	return reloader.scheduleRemoval(pkBytes)
+}
+
+func (reloader *managedKeysReloader) scheduleRemoval(pkBytes []byte) error {
+	if !reloader.isKeyManaged(pkBytes) {
+		return fmt.Errorf("%w for public key %s", ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+	if reloader.computeNumManagedKeys() <= 1 {
+		return fmt.Errorf("%w, public key %s", ErrCannotRemoveLastManagedKey, hex.EncodeToString(pkBytes))
+	}
+
+	if reloader.managedPeersHolder.IsKeyRegistered(pkBytes) {
+		reloader.pendingChanges[string(pkBytes)] = &pendingChange{}
+	} else {
+		// the key was scheduled to be added, so it will just not be added
+		delete(reloader.pendingChanges, string(pkBytes))
+	}
+
+	log.Info("scheduled the removal of a managed key", "public key", hex.EncodeToString(pkBytes))
+
+	return nil
+}
+
+// isKeyManaged returns true if the key will be managed after the pending changes are applied
+func (reloader *managedKeysReloader) isKeyManaged(pkBytes []byte) bool {
+	change, found := reloader.pendingChanges[string(pkBytes)]
+	if found {
+		return !change.isRemoval()
+	}
+
+	return reloader.managedPeersHolder.IsKeyRegistered(pkBytes)
+}
+
+// computeNumManagedKeys returns the number of keys which will be managed after the pending changes are applied
+func (reloader *managedKeysReloader) computeNumManagedKeys() int {
+	numManagedKeys := len(reloader.managedPeersHolder.GetLoadedKeysByCurrentNode())
+	for _, change := range reloader.pendingChanges {
+		if change.isRemoval() {
+			numManagedKeys--
+			continue
+		}
+
+		numManagedKeys++
+	}
+
+	return numManagedKeys
+}
+
+func (reloader *managedKeysReloader) processLoop(ctx context.Context) {
+	roundTicker := time.NewTicker(reloader.roundCheckInterval)
+	defer roundTicker.Stop()
+
+	var fileCheckChan <-chan time.Time
+	if reloader.watchAllValidatorsKeysFile {
+		fileTicker := time.NewTicker(reloader.fileCheckInterval)
+		defer fileTicker.Stop()
+
+		fileCheckChan = fileTicker.C
+	}
+
+	for {
+		select {
+		case <-roundTicker.C:
+			reloader.checkNewRound()
+		case <-fileCheckChan:
+			reloader.checkAllValidatorsKeysFile()
+		case <-ctx.Done():
+			log.Debug("closing managedKeysReloader.processLoop go routine")
+			return
+		}
+	}
+}
+
+func (reloader *managedKeysReloader) checkNewRound() {
+	roundIndex := reloader.roundHandler.Index()
+
+	reloader.mutPendingChanges.Lock()
+	defer reloader.mutPendingChanges.Unlock()
+
+	if roundIndex == reloader.lastRoundIndex {
+		return
+	}
+
+	reloader.lastRoundIndex = roundIndex
+	reloader.applyPendingChanges(roundIndex)
+}
+
+func (reloader *managedKeysReloader) applyPendingChanges(roundIndex int64) {
+	if len(reloader.pendingChanges) == 0 {
+		return
+	}
+
+	additions, removals := reloader.sortPendingChanges()
+	reloader.pendingChanges = make(map[string]*pendingChange)
+
+	// the additions are applied first, so the node never remains without managed keys
+	for _, pk := range additions {
+		err := reloader.managedPeersHolder.AddManagedPeer(pk.privateKeyBytes)
+		if err != nil {
+			log.Error("could not add managed key", "public key", pk.hexPublicKey, "round", roundIndex, "error", err)
+			continue
+		}
+
+		log.Info("added managed key", "public key", pk.hexPublicKey, "round", roundIndex)
+	}
+
+	for _, pk := range removals {
+		if len(reloader.managedPeersHolder.GetLoadedKeysByCurrentNode()) <= 1 {
+			log.Error("could not remove managed key", "public key", pk.hexPublicKey, "round", roundIndex, "error", ErrCannotRemoveLastManagedKey)
+			continue
+		}
+
+		err := reloader.managedPeersHolder.RemoveManagedPeer(pk.pkBytes)
+		if err != nil {
+			log.Error("could not remove managed key", "public key", pk.hexPublicKey, "round", roundIndex, "error", err)
+			continue
+		}
+
+		log.Info("removed managed key", "public key", pk.hexPublicKey, "round", roundIndex)
+	}
+}
+
+type scheduledKey struct {
+	pkBytes         []byte
+	hexPublicKey    string
+	privateKeyBytes []byte
+}
+
+func (reloader *managedKeysReloader) sortPendingChanges() ([]scheduledKey, []scheduledKey) {
+	additions := make([]scheduledKey, 0, len(reloader.pendingChanges))
+	removals := make([]scheduledKey, 0, len(reloader.pendingChanges))
+	for pk, change := range reloader.pendingChanges {
+		key := scheduledKey{
+			pkBytes:         []byte(pk),
+			hexPublicKey:    hex.EncodeToString([]byte(pk)),
+			privateKeyBytes: change.privateKeyBytes,
+		}
+		if change.isRemoval() {
+			removals = append(removals, key)
+			continue
+		}
+
+		additions = append(additions, key)
+	}
+
+	sortScheduledKeys(additions)
+	sortScheduledKeys(removals)
+
+	return additions, removals
+}
+
+func sortScheduledKeys(keys []scheduledKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].pkBytes, keys[j].pkBytes) < 0
+	})
+}
+
+func (reloader *managedKeysReloader) checkAllValidatorsKeysFile() {
+	modTime := getFileModTime(reloader.allValidatorsKeysFile)
+	if modTime.Equal(reloader.lastFileModTime) {
+		return
+	}
+	reloader.lastFileModTime = modTime
+
+	keys, err := reloader.loadAllValidatorsKeys()
+	if err != nil {
+		log.Error("could not reload the allValidatorsKeys file, the managed keys remain unchanged",
+			"file", reloader.allValidatorsKeysFile, "error", err)
+		return
+	}
+	if len(keys) == 0 {
+		log.Error("the allValidatorsKeys file does not contain any key, the managed keys remain unchanged",
+			"file", reloader.allValidatorsKeysFile)
+		return
+	}
+
+	reloader.mutPendingChanges.Lock()
+	defer reloader.mutPendingChanges.Unlock()
+
+	reloader.scheduleFileChanges(keys)
+}
+
+// loadAllValidatorsKeys returns the private keys bytes from the allValidatorsKeys file, mapped by the public keys bytes
+func (reloader *managedKeysReloader) loadAllValidatorsKeys() (map[string][]byte, error) {
+	privateKeys, publicKeys, err := reloader.keyLoader.LoadAllKeys(reloader.allValidatorsKeysFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(privateKeys) != len(publicKeys) {
+		return nil, fmt.Errorf("%w, mismatch number of private and public keys", ErrInvalidKey)
+	}
+
+	keys := make(map[string][]byte, len(privateKeys))
+	for i, pkString := range publicKeys {
+		privateKeyBytes, errDecode := hex.DecodeString(string(privateKeys[i]))
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded secret key, key index %d", errDecode, i)
+		}
+
+		pkBytes, errDecode := reloader.pubKeyConverter.Decode(pkString)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for encoded public key %s, key index %d", errDecode, pkString, i)
+		}
+
+		privateKey, errKey := reloader.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+		if errKey != nil {
+			return nil, fmt.Errorf("%w secret key, key index %d", errKey, i)
+		}
+
+		generatedPkBytes, errKey := privateKey.GeneratePublic().ToByteArray()
+		if errKey != nil {
+			return nil, fmt.Errorf("%w while generating public key bytes, key index %d", errKey, i)
+		}
+		if !bytes.Equal(generatedPkBytes, pkBytes) {
+			return nil, fmt.Errorf("%w, public keys mismatch for %s, key index %d", ErrInvalidKey, pkString, i)
+		}
+
+		keys[string(pkBytes)] = privateKeyBytes
+	}
+
+	return keys, nil
+}
+
+// scheduleFileChanges makes the managed keys match the keys from the allValidatorsKeys file
+func (reloader *managedKeysReloader) scheduleFileChanges(keys map[string][]byte) {
+	reloader.pendingChanges = make(map[string]*pendingChange)
+
+	for _, pkBytes := range reloader.managedPeersHolder.GetLoadedKeysByCurrentNode() {
+		_, found := keys[string(pkBytes)]
+		if !found {
+			reloader.pendingChanges[string(pkBytes)] = &pendingChange{}
+		}
+	}
+
+	for pk, privateKeyBytes := range keys {
+		if !reloader.managedPeersHolder.IsKeyRegistered([]byte(pk)) {
+			reloader.pendingChanges[pk] = &pendingChange{
+				privateKeyBytes: privateKeyBytes,
+			}
+		}
+	}
+
+	log.Info("the allValidatorsKeys file changed, the managed keys will be updated at the start of the next round",
+		"file", reloader.allValidatorsKeysFile, "num keys in file", len(keys), "num changes", len(reloader.pendingChanges))
+}
+
+func getFileModTime(file string) time.Time {
+	info, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
+
+// Close stops the processing go routine. The pending changes are dropped
+func (reloader *managedKeysReloader) Close() error {
+	reloader.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reloader *managedKeysReloader) IsInterfaceNil() bool {
+	return reloader == nil
+}
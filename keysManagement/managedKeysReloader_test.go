@@ -0,0 +1,433 @@
+package keysManagement_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/factory/mock"
+	"github.com/multiversx/mx-chain-go/keysManagement"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const timeToApplyChanges = 200 * time.Millisecond
+
+var (
+	skBytes2     = []byte("private key 2")
+	pkBytes2     = []byte("public key 2")
+	keysFileName = "allValidatorsKeys.pem"
+)
+
+func createMockArgsManagedKeysReloader(tb testing.TB, holderKeys ...[]byte) keysManagement.ArgsManagedKeysReloader {
+	holder, _ := keysManagement.NewManagedPeersHolder(createMockArgsManagedPeersHolder())
+	for _, skBytes := range holderKeys {
+		err := holder.AddManagedPeer(skBytes)
+		require.Nil(tb, err)
+	}
+
+	return keysManagement.ArgsManagedKeysReloader{
+		ManagedPeersHolder:         holder,
+		KeyGenerator:               createMockKeyGenerator(),
+		KeyLoader:                  &mock.KeyLoaderStub{},
+		PubKeyConverter:            testscommon.NewPubkeyConverterMock(32),
+		RoundHandler:               &testscommon.RoundHandlerMock{},
+		AllValidatorsKeysFile:      filepath.Join(tb.TempDir(), keysFileName),
+		WatchAllValidatorsKeysFile: false,
+		FileCheckInterval:          time.Millisecond,
+		RoundCheckInterval:         time.Millisecond,
+	}
+}
+
+func createKeyLoaderForKeys(mutKeys *sync.RWMutex, skBytes *[][]byte) *mock.KeyLoaderStub {
+	return &mock.KeyLoaderStub{
+		LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+			mutKeys.RLock()
+			defer mutKeys.RUnlock()
+
+			privateKeys := make([][]byte, 0, len(*skBytes))
+			publicKeys := make([]string, 0, len(*skBytes))
+			for _, sk := range *skBytes {
+				privateKeys = append(privateKeys, []byte(hex.EncodeToString(sk)))
+				pk, _ := createMockKeyGenerator().PrivateKeyFromByteArray(sk)
+				pkBytes, _ := pk.GeneratePublic().ToByteArray()
+				publicKeys = append(publicKeys, hex.EncodeToString(pkBytes))
+			}
+
+			return privateKeys, publicKeys, nil
+		},
+	}
+}
+
+func createRoundHandler() (*testscommon.RoundHandlerMock, func()) {
+	roundIndex := int64(0)
+	roundHandler := &testscommon.RoundHandlerMock{
+		IndexCalled: func() int64 {
+			return atomic.LoadInt64(&roundIndex)
+		},
+	}
+
+	return roundHandler, func() {
+		atomic.AddInt64(&roundIndex, 1)
+	}
+}
+
+func touchFile(tb testing.TB, file string, modTime time.Time) {
+	err := os.WriteFile(file, []byte("keys"), 0600)
+	require.Nil(tb, err)
+
+	err = os.Chtimes(file, modTime, modTime)
+	require.Nil(tb, err)
+}
+
+func TestNewManagedKeysReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil managed peers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.ManagedPeersHolder = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.Equal(t, keysManagement.ErrNilManagedPeersHolder, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("node not in multikey mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t)
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.Equal(t, keysManagement.ErrNotMultiKeyMode, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.KeyGenerator = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.Equal(t, keysManagement.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil key loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.KeyLoader = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.Equal(t, keysManagement.ErrNilKeyLoader, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil public key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.PubKeyConverter = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.Equal(t, keysManagement.ErrNilPubKeyConverter, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.RoundHandler = nil
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.Equal(t, keysManagement.ErrNilRoundHandler, err)
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("invalid round check interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.RoundCheckInterval = time.Microsecond
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+		assert.Contains(t, err.Error(), "RoundCheckInterval")
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("watch file with empty file name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.WatchAllValidatorsKeysFile = true
+		args.AllValidatorsKeysFile = ""
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+		assert.Contains(t, err.Error(), "AllValidatorsKeysFile")
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("watch file with invalid file check interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.WatchAllValidatorsKeysFile = true
+		args.FileCheckInterval = 0
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.ErrorIs(t, err, keysManagement.ErrInvalidValue)
+		assert.Contains(t, err.Error(), "FileCheckInterval")
+		assert.True(t, check.IfNil(reloader))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		reloader, err := keysManagement.NewManagedKeysReloader(args)
+
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(reloader))
+		assert.Nil(t, reloader.Close())
+	})
+}
+
+func TestManagedKeysReloader_AddManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		args.KeyGenerator = &cryptoMocks.KeyGenStub{
+			PrivateKeyFromByteArrayStub: func(b []byte) (crypto.PrivateKey, error) {
+				return nil, expectedErr
+			},
+		}
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		pkBytes, err := reloader.AddManagedKey(skBytes1)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, pkBytes)
+	})
+	t.Run("already managed key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		pkBytes, err := reloader.AddManagedKey(skBytes0)
+		assert.ErrorIs(t, err, keysManagement.ErrDuplicatedKey)
+		assert.Nil(t, pkBytes)
+	})
+	t.Run("already scheduled key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		_, err := reloader.AddManagedKey(skBytes1)
+		assert.Nil(t, err)
+
+		_, err = reloader.AddManagedKey(skBytes1)
+		assert.ErrorIs(t, err, keysManagement.ErrDuplicatedKey)
+	})
+	t.Run("should add the key at the start of the next round", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		roundHandler, incrementRound := createRoundHandler()
+		args.RoundHandler = roundHandler
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		pkBytes, err := reloader.AddManagedKey(skBytes1)
+		assert.Nil(t, err)
+		assert.Equal(t, pkBytes1, pkBytes)
+
+		time.Sleep(timeToApplyChanges)
+		assert.False(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+
+		incrementRound()
+		time.Sleep(timeToApplyChanges)
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+		assert.True(t, args.ManagedPeersHolder.IsPidManagedByCurrentNode(pid))
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+	t.Run("key scheduled for removal should remain managed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0, skBytes1)
+		roundHandler, incrementRound := createRoundHandler()
+		args.RoundHandler = roundHandler
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		err := reloader.RemoveManagedKey(pkBytes1)
+		assert.Nil(t, err)
+		_, err = reloader.AddManagedKey(skBytes1)
+		assert.Nil(t, err)
+
+		incrementRound()
+		time.Sleep(timeToApplyChanges)
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+}
+
+func TestManagedKeysReloader_RemoveManagedKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0, skBytes1)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		err := reloader.RemoveManagedKey(pkBytes2)
+		assert.ErrorIs(t, err, keysManagement.ErrMissingPublicKeyDefinition)
+	})
+	t.Run("last managed key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0, skBytes1)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		err := reloader.RemoveManagedKey(pkBytes0)
+		assert.Nil(t, err)
+
+		err = reloader.RemoveManagedKey(pkBytes1)
+		assert.ErrorIs(t, err, keysManagement.ErrCannotRemoveLastManagedKey)
+	})
+	t.Run("should remove the key at the start of the next round", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0, skBytes1)
+		roundHandler, incrementRound := createRoundHandler()
+		args.RoundHandler = roundHandler
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		err := reloader.RemoveManagedKey(pkBytes1)
+		assert.Nil(t, err)
+
+		time.Sleep(timeToApplyChanges)
+		assert.True(t, args.ManagedPeersHolder.IsKeyRegistered(pkBytes1))
+
+		incrementRound()
+		time.Sleep(timeToApplyChanges)
+		assert.Equal(t, [][]byte{pkBytes0}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+		_, err = args.ManagedPeersHolder.GetPrivateKey(pkBytes1)
+		assert.ErrorIs(t, err, keysManagement.ErrMissingPublicKeyDefinition)
+	})
+	t.Run("key scheduled for addition should not be added", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0)
+		roundHandler, incrementRound := createRoundHandler()
+		args.RoundHandler = roundHandler
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		_, err := reloader.AddManagedKey(skBytes1)
+		assert.Nil(t, err)
+		err = reloader.RemoveManagedKey(pkBytes1)
+		assert.Nil(t, err)
+
+		incrementRound()
+		time.Sleep(timeToApplyChanges)
+		assert.Equal(t, [][]byte{pkBytes0}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+}
+
+func TestManagedKeysReloader_WatchAllValidatorsKeysFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("changed file should update the managed keys at the start of the next round", func(t *testing.T) {
+		t.Parallel()
+
+		mutKeys := &sync.RWMutex{}
+		fileKeys := [][]byte{skBytes0, skBytes1}
+		args := createMockArgsManagedKeysReloader(t, fileKeys...)
+		roundHandler, incrementRound := createRoundHandler()
+		args.RoundHandler = roundHandler
+		args.KeyLoader = createKeyLoaderForKeys(mutKeys, &fileKeys)
+		args.WatchAllValidatorsKeysFile = true
+		startTime := time.Now().Add(-time.Hour)
+		touchFile(t, args.AllValidatorsKeysFile, startTime)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		mutKeys.Lock()
+		fileKeys = [][]byte{skBytes1, skBytes2}
+		mutKeys.Unlock()
+
+		time.Sleep(timeToApplyChanges)
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+
+		touchFile(t, args.AllValidatorsKeysFile, startTime.Add(time.Minute))
+		time.Sleep(timeToApplyChanges)
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+
+		incrementRound()
+		time.Sleep(timeToApplyChanges)
+		assert.Equal(t, [][]byte{pkBytes1, pkBytes2}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+	t.Run("invalid file should not change the managed keys", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsManagedKeysReloader(t, skBytes0, skBytes1)
+		roundHandler, incrementRound := createRoundHandler()
+		args.RoundHandler = roundHandler
+		args.KeyLoader = &mock.KeyLoaderStub{
+			LoadAllKeysCalled: func(path string) ([][]byte, []string, error) {
+				return [][]byte{[]byte(hex.EncodeToString(skBytes2))}, []string{hex.EncodeToString(pkBytes1)}, nil
+			},
+		}
+		args.WatchAllValidatorsKeysFile = true
+		startTime := time.Now().Add(-time.Hour)
+		touchFile(t, args.AllValidatorsKeysFile, startTime)
+		reloader, _ := keysManagement.NewManagedKeysReloader(args)
+		defer func() {
+			_ = reloader.Close()
+		}()
+
+		touchFile(t, args.AllValidatorsKeysFile, startTime.Add(time.Minute))
+		time.Sleep(timeToApplyChanges)
+
+		incrementRound()
+		time.Sleep(timeToApplyChanges)
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, args.ManagedPeersHolder.GetLoadedKeysByCurrentNode())
+	})
+}
@@ -193,6 +193,31 @@ func (holder *managedPeersHolder) AddManagedPeer(privateKeyBytes []byte) error {
 	return nil
 }
 
+// RemoveManagedPeer will remove the managed peer defined by the provided public key bytes, along with its p2p identity.
+// It errors if the public key is not managed by the struct
+func (holder *managedPeersHolder) RemoveManagedPeer(pkBytes []byte) error {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	pInfo, found := holder.data[string(pkBytes)]
+	if !found {
+		return fmt.Errorf("%w in RemoveManagedPeer for public key %s",
+			ErrMissingPublicKeyDefinition, hex.EncodeToString(pkBytes))
+	}
+
+	delete(holder.data, string(pkBytes))
+	delete(holder.pids, pInfo.pid)
+
+	log.Debug("removed key definition",
+		"hex public key", hex.EncodeToString(pkBytes),
+		"pid", pInfo.pid.Pretty(),
+		"machine ID", pInfo.machineID,
+		"name", pInfo.nodeName,
+		"identity", pInfo.nodeIdentity)
+
+	return nil
+}
+
 func (holder *managedPeersHolder) getPeerInfo(pkBytes []byte) *peerInfo {
 	holder.mut.RLock()
 	defer holder.mut.RUnlock()
@@ -351,6 +351,50 @@ func TestManagedPeersHolder_AddManagedPeer(t *testing.T) {
 	})
 }
 
+func TestManagedPeersHolder_RemoveManagedPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("public key not added should error", func(t *testing.T) {
+		args := createMockArgsManagedPeersHolder()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		err := holder.RemoveManagedPeer(pkBytes0)
+
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+	})
+	t.Run("should remove the key and its p2p identity", func(t *testing.T) {
+		args := createMockArgsManagedPeersHolder()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		_ = holder.AddManagedPeer(skBytes0)
+		assert.True(t, holder.IsPidManagedByCurrentNode(pid))
+
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+
+		assert.False(t, holder.IsKeyRegistered(pkBytes0))
+		assert.False(t, holder.IsPidManagedByCurrentNode(pid))
+		assert.False(t, holder.IsMultiKeyMode())
+		_, _, err = holder.GetP2PIdentity(pkBytes0)
+		assert.True(t, errors.Is(err, keysManagement.ErrMissingPublicKeyDefinition))
+	})
+	t.Run("removed key can be added again", func(t *testing.T) {
+		args := createMockArgsManagedPeersHolder()
+
+		holder, _ := keysManagement.NewManagedPeersHolder(args)
+		_ = holder.AddManagedPeer(skBytes0)
+		_ = holder.AddManagedPeer(skBytes1)
+
+		err := holder.RemoveManagedPeer(pkBytes0)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{pkBytes1}, holder.GetLoadedKeysByCurrentNode())
+
+		err = holder.AddManagedPeer(skBytes0)
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{pkBytes0, pkBytes1}, holder.GetLoadedKeysByCurrentNode())
+	})
+}
+
 func TestManagedPeersHolder_GetPrivateKey(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,42 @@
+package remoteSigner
+
+import "errors"
+
+// ErrEmptyURL signals that an empty remote signer URL was provided
+var ErrEmptyURL = errors.New("empty remote signer URL")
+
+// ErrInvalidTimeout signals that an invalid signing timeout was provided
+var ErrInvalidTimeout = errors.New("invalid remote signing timeout")
+
+// ErrNilTLSConfig signals that a nil TLS config was provided
+var ErrNilTLSConfig = errors.New("nil TLS config")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilSingleSigner signals that a nil single signer was provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilRemoteSigner signals that a nil remote signer was provided
+var ErrNilRemoteSigner = errors.New("nil remote signer")
+
+// ErrNilPublicKey signals that a nil public key was provided
+var ErrNilPublicKey = errors.New("nil public key")
+
+// ErrPrivateKeyNotExportable signals an attempt to read the key material of a key held by the remote signer
+var ErrPrivateKeyNotExportable = errors.New("the private key is held by the remote signer and can not be exported")
+
+// ErrRemoteSigningFailed signals that the remote signer did not sign the message
+var ErrRemoteSigningFailed = errors.New("remote signing failed")
+
+// ErrInvalidRemoteSignature signals that the signature returned by the remote signer is not valid
+var ErrInvalidRemoteSignature = errors.New("invalid signature returned by the remote signer")
+
+// ErrNoPrivateKeys signals that no private keys were provided to the signer server
+var ErrNoPrivateKeys = errors.New("no private keys provided")
+
+// ErrUnknownPublicKey signals that the signer server does not hold the private key of the requested public key
+var ErrUnknownPublicKey = errors.New("unknown public key")
+
+// ErrInvalidCACertificate signals that the CA certificate file does not contain any valid certificate
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
@@ -0,0 +1,19 @@
+package remoteSigner
+
+// SignEndpoint is the endpoint of the remote signer which signs a message with one of the held BLS keys
+const SignEndpoint = "/sign"
+
+// maxMessageSize limits the size of the requests and responses exchanged with the remote signer
+const maxMessageSize = 1 << 16
+
+// SignRequest is the request sent to the remote signer. All the fields are hex encoded
+type SignRequest struct {
+	PublicKey string `json:"publicKey"`
+	Message   string `json:"message"`
+}
+
+// SignResponse is the response of the remote signer, holding either the hex encoded signature or the error
+type SignResponse struct {
+	Signature string `json:"signature,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
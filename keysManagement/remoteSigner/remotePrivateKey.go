@@ -0,0 +1,70 @@
+package remoteSigner
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+)
+
+type remotePrivateKey struct {
+	publicKey      crypto.PublicKey
+	publicKeyBytes []byte
+	signer         cryptoCommon.RemoteSigner
+}
+
+// NewRemotePrivateKey creates a handle of the private key held by the remote signer for the provided public key. The
+// handle can be used wherever a private key is expected, but only signs through the remote signer
+func NewRemotePrivateKey(publicKey crypto.PublicKey, signer cryptoCommon.RemoteSigner) (*remotePrivateKey, error) {
+	if check.IfNil(publicKey) {
+		return nil, ErrNilPublicKey
+	}
+	if check.IfNil(signer) {
+		return nil, ErrNilRemoteSigner
+	}
+
+	publicKeyBytes, err := publicKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &remotePrivateKey{
+		publicKey:      publicKey,
+		publicKeyBytes: publicKeyBytes,
+		signer:         signer,
+	}, nil
+}
+
+// Sign signs the message using the remote signer
+func (key *remotePrivateKey) Sign(message []byte) ([]byte, error) {
+	return key.signer.Sign(key.publicKeyBytes, message)
+}
+
+// PublicKeyBytes returns the bytes of the public key, which identify the key on the remote signer
+func (key *remotePrivateKey) PublicKeyBytes() []byte {
+	return key.publicKeyBytes
+}
+
+// ToByteArray returns an error, as the key material is only known by the remote signer
+func (key *remotePrivateKey) ToByteArray() ([]byte, error) {
+	return nil, ErrPrivateKeyNotExportable
+}
+
+// GeneratePublic returns the public key of the remote key
+func (key *remotePrivateKey) GeneratePublic() crypto.PublicKey {
+	return key.publicKey
+}
+
+// Suite returns the suite of the remote key
+func (key *remotePrivateKey) Suite() crypto.Suite {
+	return key.publicKey.Suite()
+}
+
+// Scalar returns nil, as the key material is only known by the remote signer
+func (key *remotePrivateKey) Scalar() crypto.Scalar {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (key *remotePrivateKey) IsInterfaceNil() bool {
+	return key == nil
+}
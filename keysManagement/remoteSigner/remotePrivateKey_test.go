@@ -0,0 +1,69 @@
+package remoteSigner_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRemotePrivateKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := remoteSigner.NewRemotePrivateKey(nil, &cryptoMocks.RemoteSignerStub{})
+		assert.Equal(t, remoteSigner.ErrNilPublicKey, err)
+		assert.True(t, check.IfNil(key))
+	})
+	t.Run("nil remote signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := remoteSigner.NewRemotePrivateKey(&cryptoMocks.PublicKeyStub{}, nil)
+		assert.Equal(t, remoteSigner.ErrNilRemoteSigner, err)
+		assert.True(t, check.IfNil(key))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := remoteSigner.NewRemotePrivateKey(&cryptoMocks.PublicKeyStub{}, &cryptoMocks.RemoteSignerStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(key))
+	})
+}
+
+func TestRemotePrivateKey_Methods(t *testing.T) {
+	t.Parallel()
+
+	publicKeyBytes := []byte("public key")
+	publicKey := &cryptoMocks.PublicKeyStub{
+		ToByteArrayStub: func() ([]byte, error) {
+			return publicKeyBytes, nil
+		},
+	}
+	signer := &cryptoMocks.RemoteSignerStub{
+		SignCalled: func(pk []byte, message []byte) ([]byte, error) {
+			assert.Equal(t, publicKeyBytes, pk)
+			return append([]byte("signature of "), message...), nil
+		},
+	}
+	key, err := remoteSigner.NewRemotePrivateKey(publicKey, signer)
+	require.Nil(t, err)
+
+	signature, err := key.Sign(testMessage)
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("signature of "), testMessage...), signature)
+
+	keyBytes, err := key.ToByteArray()
+	assert.Equal(t, remoteSigner.ErrPrivateKeyNotExportable, err)
+	assert.Nil(t, keyBytes)
+
+	assert.Equal(t, publicKeyBytes, key.PublicKeyBytes())
+	assert.True(t, key.GeneratePublic() == publicKey)
+	assert.Nil(t, key.Scalar())
+	assert.NotNil(t, key.Suite())
+}
@@ -0,0 +1,157 @@
+package remoteSigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("keysManagement/remoteSigner")
+
+// maxTimeoutRoundFraction is the maximum fraction of the round duration a signing request can take. The shortest
+// subround lasts 20% of the round, so a signing request can take at most half of it, leaving time for the rest of the
+// subround work
+const maxTimeoutRoundFraction = 0.1
+
+// ArgsRemoteSigner holds the arguments needed to create a remote signer
+type ArgsRemoteSigner struct {
+	URL           string
+	Timeout       time.Duration
+	RoundDuration time.Duration
+	TLSConfig     *tls.Config
+	KeyGenerator  crypto.KeyGenerator
+	SingleSigner  crypto.SingleSigner
+}
+
+type remoteSigner struct {
+	signURL      string
+	timeout      time.Duration
+	httpClient   *http.Client
+	keyGenerator crypto.KeyGenerator
+	singleSigner crypto.SingleSigner
+}
+
+// NewRemoteSigner creates a client of a remote signing service, reached over HTTPS with mutual TLS authentication.
+// Each signature is verified before being returned, so a faulty signer can not make the node broadcast invalid data
+func NewRemoteSigner(args ArgsRemoteSigner) (*remoteSigner, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteSigner{
+		signURL: strings.TrimSuffix(args.URL, "/") + SignEndpoint,
+		timeout: args.Timeout,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: args.TLSConfig,
+			},
+			Timeout: args.Timeout,
+		},
+		keyGenerator: args.KeyGenerator,
+		singleSigner: args.SingleSigner,
+	}, nil
+}
+
+func checkArgs(args ArgsRemoteSigner) error {
+	if len(args.URL) == 0 {
+		return ErrEmptyURL
+	}
+	maxTimeout := time.Duration(float64(args.RoundDuration) * maxTimeoutRoundFraction)
+	if args.Timeout <= 0 || args.Timeout > maxTimeout {
+		return fmt.Errorf("%w, provided %v, maximum allowed for a round of %v is %v",
+			ErrInvalidTimeout, args.Timeout, args.RoundDuration, maxTimeout)
+	}
+	if args.TLSConfig == nil {
+		return ErrNilTLSConfig
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return ErrNilKeyGenerator
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+
+	return nil
+}
+
+// Sign requests the remote signer a BLS signature over the message, with the private key of the provided public key
+func (rs *remoteSigner) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	pk, err := rs.keyGenerator.PublicKeyFromByteArray(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	signature, err := rs.requestSignature(publicKey, message)
+	log.Trace("remoteSigner.Sign", "pk", publicKey, "duration", time.Since(startTime), "error", err)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rs.singleSigner.Verify(pk, message, signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRemoteSignature, err)
+	}
+
+	return signature, nil
+}
+
+func (rs *remoteSigner) requestSignature(publicKey []byte, message []byte) ([]byte, error) {
+	requestBytes, err := json.Marshal(&SignRequest{
+		PublicKey: hex.EncodeToString(publicKey),
+		Message:   hex.EncodeToString(message),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, rs.signURL, bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := rs.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRemoteSigningFailed, err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	responseBytes, err := io.ReadAll(io.LimitReader(response.Body, maxMessageSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRemoteSigningFailed, err)
+	}
+
+	signResponse := &SignResponse{}
+	err = json.Unmarshal(responseBytes, signResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w: status %s, %v", ErrRemoteSigningFailed, response.Status, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %s, %s", ErrRemoteSigningFailed, response.Status, signResponse.Error)
+	}
+
+	return hex.DecodeString(signResponse.Signature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *remoteSigner) IsInterfaceNil() bool {
+	return rs == nil
+}
@@ -0,0 +1,248 @@
+package remoteSigner_test
+
+import (
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	cryptoCommon "github.com/multiversx/mx-chain-go/common/crypto"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 300 * time.Millisecond
+const testRoundDuration = 6 * time.Second
+
+var testMessage = []byte("message to be signed")
+
+func createMockArgsRemoteSigner() remoteSigner.ArgsRemoteSigner {
+	return remoteSigner.ArgsRemoteSigner{
+		URL:           "https://localhost:9595",
+		Timeout:       testTimeout,
+		RoundDuration: testRoundDuration,
+		TLSConfig:     &tls.Config{},
+		KeyGenerator:  &cryptoMocks.KeyGenStub{},
+		SingleSigner:  &cryptoMocks.SingleSignerStub{},
+	}
+}
+
+func createBLSKeyGenerator() crypto.KeyGenerator {
+	return signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+}
+
+func startTestServer(t *testing.T, certificates testCertificates, handler http.Handler) *httptest.Server {
+	serverTLSConfig, err := remoteSigner.NewServerTLSConfig(certificates.serverCertificateFile, certificates.serverKeyFile, certificates.caCertificateFile)
+	require.Nil(t, err)
+
+	server := httptest.NewUnstartedServer(handler)
+	server.TLS = serverTLSConfig
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func startTestSignerServer(t *testing.T, certificates testCertificates, privateKeys ...crypto.PrivateKey) *httptest.Server {
+	handler, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+		PrivateKeys:  privateKeys,
+		SingleSigner: &mclSig.BlsSingleSigner{},
+	})
+	require.Nil(t, err)
+
+	return startTestServer(t, certificates, handler)
+}
+
+func createTestRemoteSigner(t *testing.T, certificates testCertificates, url string) cryptoCommon.RemoteSigner {
+	clientTLSConfig, err := remoteSigner.NewClientTLSConfig(certificates.clientCertificateFile, certificates.clientKeyFile, certificates.caCertificateFile)
+	require.Nil(t, err)
+
+	args := createMockArgsRemoteSigner()
+	args.URL = url
+	args.TLSConfig = clientTLSConfig
+	args.KeyGenerator = createBLSKeyGenerator()
+	args.SingleSigner = &mclSig.BlsSingleSigner{}
+	signer, err := remoteSigner.NewRemoteSigner(args)
+	require.Nil(t, err)
+
+	return signer
+}
+
+func TestNewRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigner()
+		args.URL = ""
+		signer, err := remoteSigner.NewRemoteSigner(args)
+		assert.Equal(t, remoteSigner.ErrEmptyURL, err)
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("zero timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigner()
+		args.Timeout = 0
+		signer, err := remoteSigner.NewRemoteSigner(args)
+		assert.True(t, errors.Is(err, remoteSigner.ErrInvalidTimeout))
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("timeout not fitting the subround deadlines should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigner()
+		args.Timeout = testRoundDuration/10 + time.Millisecond
+		signer, err := remoteSigner.NewRemoteSigner(args)
+		assert.True(t, errors.Is(err, remoteSigner.ErrInvalidTimeout))
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("nil TLS config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigner()
+		args.TLSConfig = nil
+		signer, err := remoteSigner.NewRemoteSigner(args)
+		assert.Equal(t, remoteSigner.ErrNilTLSConfig, err)
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigner()
+		args.KeyGenerator = nil
+		signer, err := remoteSigner.NewRemoteSigner(args)
+		assert.Equal(t, remoteSigner.ErrNilKeyGenerator, err)
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRemoteSigner()
+		args.SingleSigner = nil
+		signer, err := remoteSigner.NewRemoteSigner(args)
+		assert.Equal(t, remoteSigner.ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(signer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		signer, err := remoteSigner.NewRemoteSigner(createMockArgsRemoteSigner())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(signer))
+	})
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	certificates := createTestCertificates(t)
+	keyGenerator := createBLSKeyGenerator()
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		signer := createTestRemoteSigner(t, certificates, "https://localhost:9595")
+		signature, err := signer.Sign([]byte("invalid public key"), testMessage)
+		assert.NotNil(t, err)
+		assert.Nil(t, signature)
+	})
+	t.Run("should sign with the key held by the signer server", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, publicKey := keyGenerator.GeneratePair()
+		publicKeyBytes, _ := publicKey.ToByteArray()
+		server := startTestSignerServer(t, certificates, privateKey)
+
+		signer := createTestRemoteSigner(t, certificates, server.URL)
+		signature, err := signer.Sign(publicKeyBytes, testMessage)
+		require.Nil(t, err)
+
+		expectedSignature, _ := (&mclSig.BlsSingleSigner{}).Sign(privateKey, testMessage)
+		assert.Equal(t, expectedSignature, signature)
+	})
+	t.Run("unknown public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, _ := keyGenerator.GeneratePair()
+		_, otherPublicKey := keyGenerator.GeneratePair()
+		otherPublicKeyBytes, _ := otherPublicKey.ToByteArray()
+		server := startTestSignerServer(t, certificates, privateKey)
+
+		signer := createTestRemoteSigner(t, certificates, server.URL)
+		signature, err := signer.Sign(otherPublicKeyBytes, testMessage)
+		assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigningFailed))
+		assert.True(t, strings.Contains(err.Error(), remoteSigner.ErrUnknownPublicKey.Error()))
+		assert.Nil(t, signature)
+	})
+	t.Run("invalid signature from the signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherPrivateKey, _ := keyGenerator.GeneratePair()
+		_, publicKey := keyGenerator.GeneratePair()
+		publicKeyBytes, _ := publicKey.ToByteArray()
+		otherSignature, _ := (&mclSig.BlsSingleSigner{}).Sign(otherPrivateKey, testMessage)
+		server := startTestServer(t, certificates, http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(`{"signature":"` + hex.EncodeToString(otherSignature) + `"}`))
+		}))
+
+		signer := createTestRemoteSigner(t, certificates, server.URL)
+		signature, err := signer.Sign(publicKeyBytes, testMessage)
+		assert.True(t, errors.Is(err, remoteSigner.ErrInvalidRemoteSignature))
+		assert.Nil(t, signature)
+	})
+	t.Run("slow signer should time out", func(t *testing.T) {
+		t.Parallel()
+
+		_, publicKey := keyGenerator.GeneratePair()
+		publicKeyBytes, _ := publicKey.ToByteArray()
+		server := startTestServer(t, certificates, http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+			select {
+			case <-request.Context().Done():
+			case <-time.After(testTimeout * 10):
+			}
+		}))
+
+		signer := createTestRemoteSigner(t, certificates, server.URL)
+		startTime := time.Now()
+		signature, err := signer.Sign(publicKeyBytes, testMessage)
+		assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigningFailed))
+		assert.Nil(t, signature)
+		assert.Less(t, time.Since(startTime), testTimeout*5)
+	})
+	t.Run("client without certificate should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, publicKey := keyGenerator.GeneratePair()
+		publicKeyBytes, _ := publicKey.ToByteArray()
+		server := startTestSignerServer(t, certificates, privateKey)
+
+		clientTLSConfig, err := remoteSigner.NewClientTLSConfig(certificates.clientCertificateFile, certificates.clientKeyFile, certificates.caCertificateFile)
+		require.Nil(t, err)
+		clientTLSConfig.Certificates = nil
+
+		args := createMockArgsRemoteSigner()
+		args.URL = server.URL
+		args.TLSConfig = clientTLSConfig
+		args.KeyGenerator = keyGenerator
+		args.SingleSigner = &mclSig.BlsSingleSigner{}
+		signer, err := remoteSigner.NewRemoteSigner(args)
+		require.Nil(t, err)
+
+		signature, err := signer.Sign(publicKeyBytes, testMessage)
+		assert.True(t, errors.Is(err, remoteSigner.ErrRemoteSigningFailed))
+		assert.Nil(t, signature)
+	})
+}
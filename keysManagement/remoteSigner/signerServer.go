@@ -0,0 +1,116 @@
+package remoteSigner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// ArgsSignerServer holds the arguments needed to create a signer server
+type ArgsSignerServer struct {
+	PrivateKeys  []crypto.PrivateKey
+	SingleSigner crypto.SingleSigner
+}
+
+type signerServer struct {
+	privateKeys  map[string]crypto.PrivateKey
+	singleSigner crypto.SingleSigner
+}
+
+// NewSignerServer creates the HTTP handler of a signing service holding the provided BLS keys. It is the reference
+// implementation of the protocol used by the remote signer
+func NewSignerServer(args ArgsSignerServer) (*signerServer, error) {
+	if len(args.PrivateKeys) == 0 {
+		return nil, ErrNoPrivateKeys
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+
+	privateKeys := make(map[string]crypto.PrivateKey, len(args.PrivateKeys))
+	for _, privateKey := range args.PrivateKeys {
+		publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+
+		privateKeys[string(publicKeyBytes)] = privateKey
+	}
+
+	return &signerServer{
+		privateKeys:  privateKeys,
+		singleSigner: args.SingleSigner,
+	}, nil
+}
+
+// ServeHTTP handles the signing requests
+func (server *signerServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.URL.Path != SignEndpoint {
+		writeResponse(writer, http.StatusNotFound, &SignResponse{Error: "unknown endpoint"})
+		return
+	}
+	if request.Method != http.MethodPost {
+		writeResponse(writer, http.StatusMethodNotAllowed, &SignResponse{Error: "method not allowed"})
+		return
+	}
+
+	signature, err := server.sign(request)
+	if err != nil {
+		writeResponse(writer, http.StatusBadRequest, &SignResponse{Error: err.Error()})
+		return
+	}
+
+	writeResponse(writer, http.StatusOK, &SignResponse{Signature: hex.EncodeToString(signature)})
+}
+
+func (server *signerServer) sign(request *http.Request) ([]byte, error) {
+	requestBytes, err := io.ReadAll(io.LimitReader(request.Body, maxMessageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	signRequest := &SignRequest{}
+	err = json.Unmarshal(requestBytes, signRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := hex.DecodeString(signRequest.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for public key", err)
+	}
+	message, err := hex.DecodeString(signRequest.Message)
+	if err != nil {
+		return nil, fmt.Errorf("%w for message", err)
+	}
+
+	privateKey, found := server.privateKeys[string(publicKey)]
+	if !found {
+		return nil, fmt.Errorf("%w %s", ErrUnknownPublicKey, signRequest.PublicKey)
+	}
+
+	signature, err := server.singleSigner.Sign(privateKey, message)
+	log.Debug("signerServer: signed message", "pk", publicKey, "error", err)
+
+	return signature, err
+}
+
+func writeResponse(writer http.ResponseWriter, statusCode int, response *SignResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+
+	err := json.NewEncoder(writer).Encode(response)
+	if err != nil {
+		log.Debug("signerServer: could not write the response", "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *signerServer) IsInterfaceNil() bool {
+	return server == nil
+}
@@ -0,0 +1,133 @@
+package remoteSigner_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	mclSig "github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveSignRequest(t *testing.T, handler http.Handler, method string, path string, body []byte) (int, *remoteSigner.SignResponse) {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(method, path, bytes.NewReader(body)))
+
+	response := &remoteSigner.SignResponse{}
+	err := json.Unmarshal(recorder.Body.Bytes(), response)
+	require.Nil(t, err)
+
+	return recorder.Code, response
+}
+
+func TestNewSignerServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no private keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+			SingleSigner: &cryptoMocks.SingleSignerStub{},
+		})
+		assert.Equal(t, remoteSigner.ErrNoPrivateKeys, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+			PrivateKeys: []crypto.PrivateKey{&cryptoMocks.PrivateKeyStub{}},
+		})
+		assert.Equal(t, remoteSigner.ErrNilSingleSigner, err)
+		assert.True(t, check.IfNil(server))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+			PrivateKeys:  []crypto.PrivateKey{&cryptoMocks.PrivateKeyStub{}},
+			SingleSigner: &cryptoMocks.SingleSignerStub{},
+		})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(server))
+	})
+}
+
+func TestSignerServer_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	privateKey, publicKey := createBLSKeyGenerator().GeneratePair()
+	publicKeyBytes, _ := publicKey.ToByteArray()
+	server, err := remoteSigner.NewSignerServer(remoteSigner.ArgsSignerServer{
+		PrivateKeys:  []crypto.PrivateKey{privateKey},
+		SingleSigner: &mclSig.BlsSingleSigner{},
+	})
+	require.Nil(t, err)
+
+	validRequest, _ := json.Marshal(&remoteSigner.SignRequest{
+		PublicKey: hex.EncodeToString(publicKeyBytes),
+		Message:   hex.EncodeToString(testMessage),
+	})
+
+	t.Run("unknown endpoint should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		statusCode, response := serveSignRequest(t, server, http.MethodPost, "/unknown", validRequest)
+		assert.Equal(t, http.StatusNotFound, statusCode)
+		assert.NotEmpty(t, response.Error)
+	})
+	t.Run("wrong method should return method not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		statusCode, response := serveSignRequest(t, server, http.MethodGet, remoteSigner.SignEndpoint, nil)
+		assert.Equal(t, http.StatusMethodNotAllowed, statusCode)
+		assert.NotEmpty(t, response.Error)
+	})
+	t.Run("malformed request should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		statusCode, response := serveSignRequest(t, server, http.MethodPost, remoteSigner.SignEndpoint, []byte("not json"))
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.NotEmpty(t, response.Error)
+	})
+	t.Run("invalid hex message should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		request, _ := json.Marshal(&remoteSigner.SignRequest{
+			PublicKey: hex.EncodeToString(publicKeyBytes),
+			Message:   "not hex",
+		})
+		statusCode, response := serveSignRequest(t, server, http.MethodPost, remoteSigner.SignEndpoint, request)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.Contains(t, response.Error, "message")
+	})
+	t.Run("unknown public key should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		request, _ := json.Marshal(&remoteSigner.SignRequest{
+			PublicKey: hex.EncodeToString([]byte("unknown")),
+			Message:   hex.EncodeToString(testMessage),
+		})
+		statusCode, response := serveSignRequest(t, server, http.MethodPost, remoteSigner.SignEndpoint, request)
+		assert.Equal(t, http.StatusBadRequest, statusCode)
+		assert.Contains(t, response.Error, remoteSigner.ErrUnknownPublicKey.Error())
+	})
+	t.Run("should sign", func(t *testing.T) {
+		t.Parallel()
+
+		statusCode, response := serveSignRequest(t, server, http.MethodPost, remoteSigner.SignEndpoint, validRequest)
+		assert.Equal(t, http.StatusOK, statusCode)
+		assert.Empty(t, response.Error)
+
+		expectedSignature, _ := (&mclSig.BlsSingleSigner{}).Sign(privateKey, testMessage)
+		assert.Equal(t, hex.EncodeToString(expectedSignature), response.Signature)
+	})
+}
@@ -0,0 +1,95 @@
+package remoteSigner_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificates struct {
+	caCertificateFile     string
+	serverCertificateFile string
+	serverKeyFile         string
+	clientCertificateFile string
+	clientKeyFile         string
+}
+
+func createTestCertificates(t *testing.T) testCertificates {
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caCertificateBytes, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.Nil(t, err)
+	caCertificate, err := x509.ParseCertificate(caCertificateBytes)
+	require.Nil(t, err)
+
+	certificates := testCertificates{
+		caCertificateFile:     filepath.Join(dir, "ca.crt"),
+		serverCertificateFile: filepath.Join(dir, "server.crt"),
+		serverKeyFile:         filepath.Join(dir, "server.key"),
+		clientCertificateFile: filepath.Join(dir, "client.crt"),
+		clientKeyFile:         filepath.Join(dir, "client.key"),
+	}
+	writePemFile(t, certificates.caCertificateFile, "CERTIFICATE", caCertificateBytes)
+	createSignedCertificate(t, caCertificate, caKey, 2, x509.ExtKeyUsageServerAuth, certificates.serverCertificateFile, certificates.serverKeyFile)
+	createSignedCertificate(t, caCertificate, caKey, 3, x509.ExtKeyUsageClientAuth, certificates.clientCertificateFile, certificates.clientKeyFile)
+
+	return certificates
+}
+
+func createSignedCertificate(
+	t *testing.T,
+	caCertificate *x509.Certificate,
+	caKey *ecdsa.PrivateKey,
+	serialNumber int64,
+	extKeyUsage x509.ExtKeyUsage,
+	certificateFile string,
+	keyFile string,
+) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{extKeyUsage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:     []string{"localhost"},
+	}
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, caCertificate, &key.PublicKey, caKey)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	writePemFile(t, certificateFile, "CERTIFICATE", certificateBytes)
+	writePemFile(t, keyFile, "EC PRIVATE KEY", keyBytes)
+}
+
+func writePemFile(t *testing.T, fileName string, blockType string, bytes []byte) {
+	err := os.WriteFile(fileName, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes}), 0600)
+	require.Nil(t, err)
+}
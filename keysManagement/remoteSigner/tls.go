@@ -0,0 +1,57 @@
+package remoteSigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+)
+
+// NewClientTLSConfig creates the TLS config used by the node to connect to the remote signer. The node authenticates
+// with its own certificate and only accepts a remote signer certificate issued by the provided CA
+func NewClientTLSConfig(certificateFile string, keyFile string, caCertificateFile string) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(certificateFile, keyFile, caCertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewServerTLSConfig creates the TLS config of a signer server, which only accepts the clients having a certificate
+// issued by the provided CA
+func NewServerTLSConfig(certificateFile string, keyFile string, caCertificateFile string) (*tls.Config, error) {
+	certificate, caPool, err := loadCertificates(certificateFile, keyFile, caCertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadCertificates(certificateFile string, keyFile string, caCertificateFile string) (tls.Certificate, *x509.CertPool, error) {
+	certificate, err := tls.LoadX509KeyPair(certificateFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	caCertificate, err := os.ReadFile(caCertificateFile)
+	if err != nil {
+		return tls.Certificate{}, nil, err
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCertificate) {
+		return tls.Certificate{}, nil, ErrInvalidCACertificate
+	}
+
+	return certificate, caPool, nil
+}
@@ -0,0 +1,75 @@
+package remoteSigner_test
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/keysManagement/remoteSigner"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewClientTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	certificates := createTestCertificates(t)
+
+	t.Run("missing certificate file should error", func(t *testing.T) {
+		t.Parallel()
+
+		config, err := remoteSigner.NewClientTLSConfig("missing.crt", certificates.clientKeyFile, certificates.caCertificateFile)
+		assert.NotNil(t, err)
+		assert.Nil(t, config)
+	})
+	t.Run("missing CA certificate file should error", func(t *testing.T) {
+		t.Parallel()
+
+		config, err := remoteSigner.NewClientTLSConfig(certificates.clientCertificateFile, certificates.clientKeyFile, "missing.crt")
+		assert.NotNil(t, err)
+		assert.Nil(t, config)
+	})
+	t.Run("invalid CA certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidCAFile := filepath.Join(t.TempDir(), "ca.crt")
+		err := os.WriteFile(invalidCAFile, []byte("not a certificate"), 0600)
+		require.Nil(t, err)
+
+		config, err := remoteSigner.NewClientTLSConfig(certificates.clientCertificateFile, certificates.clientKeyFile, invalidCAFile)
+		assert.Equal(t, remoteSigner.ErrInvalidCACertificate, err)
+		assert.Nil(t, config)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		config, err := remoteSigner.NewClientTLSConfig(certificates.clientCertificateFile, certificates.clientKeyFile, certificates.caCertificateFile)
+		assert.Nil(t, err)
+		assert.Len(t, config.Certificates, 1)
+		assert.NotNil(t, config.RootCAs)
+	})
+}
+
+func TestNewServerTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	certificates := createTestCertificates(t)
+
+	t.Run("missing key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		config, err := remoteSigner.NewServerTLSConfig(certificates.serverCertificateFile, "missing.key", certificates.caCertificateFile)
+		assert.NotNil(t, err)
+		assert.Nil(t, config)
+	})
+	t.Run("should work and require client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		config, err := remoteSigner.NewServerTLSConfig(certificates.serverCertificateFile, certificates.serverKeyFile, certificates.caCertificateFile)
+		assert.Nil(t, err)
+		assert.Len(t, config.Certificates, 1)
+		assert.NotNil(t, config.ClientCAs)
+		assert.Equal(t, tls.RequireAndVerifyClientCert, config.ClientAuth)
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
 	logger "github.com/multiversx/mx-chain-logger-go"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/factory"
@@ -66,7 +67,7 @@ type ArgsChainSimulator struct {
 	AlterConfigsFunction           func(cfg *config.Configs)
 	VmQueryDelayAfterStartInMs     uint64
 	CreateRunTypeCoreComponents    func() (factory.RunTypeCoreComponentsHolder, error)
-	CreateIncomingHeaderSubscriber func(config config.WebSocketConfig, dataPool dataRetriever.PoolsHolder, mainChainNotarizationStartRound uint64, runTypeComponents factory.RunTypeComponentsHolder) (processing.IncomingHeaderSubscriber, error)
+	CreateIncomingHeaderSubscriber func(config config.SovereignConfig, addressPubKeyConverter core.PubkeyConverter, dataPool dataRetriever.PoolsHolder, storageService dataRetriever.StorageService, runTypeComponents factory.RunTypeComponentsHolder, enableEpochsHandler common.EnableEpochsHandler, appStatusHandler core.AppStatusHandler) (processing.IncomingHeaderSubscriber, error)
 	CreateRunTypeComponents        func(args runType.ArgsRunTypeComponents) (factory.RunTypeComponentsHolder, error)
 	NodeFactory                    node.NodeFactory
 	ChainProcessorFactory          ChainHandlerFactory
@@ -130,7 +131,7 @@ func setSimulatorRunTypeArguments(args *ArgsChainSimulator) {
 		}
 	}
 	if args.CreateIncomingHeaderSubscriber == nil {
-		args.CreateIncomingHeaderSubscriber = func(_ config.WebSocketConfig, _ dataRetriever.PoolsHolder, _ uint64, _ factory.RunTypeComponentsHolder) (processing.IncomingHeaderSubscriber, error) {
+		args.CreateIncomingHeaderSubscriber = func(_ config.SovereignConfig, _ core.PubkeyConverter, _ dataRetriever.PoolsHolder, _ dataRetriever.StorageService, _ factory.RunTypeComponentsHolder, _ common.EnableEpochsHandler, _ core.AppStatusHandler) (processing.IncomingHeaderSubscriber, error) {
 			return &sovereign.IncomingHeaderSubscriberStub{}, nil
 		}
 	}
@@ -138,6 +138,7 @@ func (node *testOnlyProcessingNode) createHttpServer(configs config.Configs) err
 		Facade:          node.facadeHandler,
 		ApiConfig:       *configs.ApiRoutesConfig,
 		AntiFloodConfig: configs.GeneralConfig.WebServerAntiflood,
+		ChainRunType:    node.getChainRunType(),
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -155,6 +156,16 @@ func (node *testOnlyProcessingNode) createHttpServer(configs config.Configs) err
 	return nil
 }
 
+// getChainRunType returns the sovereign chain run type for the nodes of a sovereign chain, which run in the sovereign
+// chain shard
+func (node *testOnlyProcessingNode) getChainRunType() common.ChainRunType {
+	if node.BootstrapComponentsHolder.ShardCoordinator().SelfId() == core.SovereignChainShardId {
+		return common.ChainRunTypeSovereign
+	}
+
+	return common.ChainRunTypeRegular
+}
+
 func (node *testOnlyProcessingNode) createMetrics(configs config.Configs) error {
 	err := metrics.InitMetrics(
 		node.StatusCoreComponents.AppStatusHandler(),
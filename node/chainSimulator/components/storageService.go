@@ -31,6 +31,13 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ExtendedShardHeadersUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ExtendedShardHeadersNonceHashDataUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.OutGoingOperationsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.IncomingHeadersQueueUnit, CreateMemUnit())
+
+	for i := 0; i < dataRetriever.MaxNumOfIncomingChains; i++ {
+		incomingChainHdrNonceHashDataUnit := dataRetriever.IncomingChainHdrNonceHashDataUnit + dataRetriever.UnitType(i)
+		store.AddStorer(incomingChainHdrNonceHashDataUnit, CreateMemUnit())
+	}
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
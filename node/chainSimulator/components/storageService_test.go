@@ -40,8 +40,13 @@ func TestCreateStore(t *testing.T) {
 		dataRetriever.ShardHdrNonceHashDataUnit,
 		dataRetriever.ExtendedShardHeadersUnit,
 		dataRetriever.ExtendedShardHeadersNonceHashDataUnit,
+		dataRetriever.OutGoingOperationsUnit,
+		dataRetriever.IncomingHeadersQueueUnit,
 		dataRetriever.UnitType(101), // shard 2
 	}
+	for idx := 0; idx < dataRetriever.MaxNumOfIncomingChains; idx++ {
+		expectedUnits = append(expectedUnits, dataRetriever.IncomingChainHdrNonceHashDataUnit+dataRetriever.UnitType(idx))
+	}
 
 	all := store.GetAllStorers()
 	require.Equal(t, len(expectedUnits), len(all))
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/endProcess"
 
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
@@ -38,7 +39,7 @@ type ArgsTestOnlyProcessingNode struct {
 	Configs                        config.Configs
 	APIInterface                   APIConfigurator
 	CreateRunTypeCoreComponents    func() (factory.RunTypeCoreComponentsHolder, error)
-	CreateIncomingHeaderSubscriber func(config config.WebSocketConfig, dataPool dataRetriever.PoolsHolder, mainChainNotarizationStartRound uint64, runTypeComponents factory.RunTypeComponentsHolder) (process.IncomingHeaderSubscriber, error)
+	CreateIncomingHeaderSubscriber func(config config.SovereignConfig, addressPubKeyConverter core.PubkeyConverter, dataPool dataRetriever.PoolsHolder, storageService dataRetriever.StorageService, runTypeComponents factory.RunTypeComponentsHolder, enableEpochsHandler common.EnableEpochsHandler, appStatusHandler core.AppStatusHandler) (process.IncomingHeaderSubscriber, error)
 	CreateRunTypeComponents        func(args runType.ArgsRunTypeComponents) (factory.RunTypeComponentsHolder, error)
 	NodeFactory                    node.NodeFactory
 
@@ -227,10 +228,13 @@ func NewTestOnlyProcessingNode(args ArgsTestOnlyProcessingNode) (*testOnlyProces
 	}
 
 	instance.IncomingHeaderSubscriber, err = args.CreateIncomingHeaderSubscriber(
-		args.Configs.GeneralConfig.SovereignConfig.NotifierConfig.WebSocketConfig,
+		args.Configs.GeneralConfig.SovereignConfig,
+		instance.CoreComponentsHolder.AddressPubKeyConverter(),
 		instance.DataComponentsHolder.Datapool(),
-		args.Configs.GeneralConfig.SovereignConfig.MainChainNotarization.MainChainNotarizationStartRound,
+		instance.DataComponentsHolder.StorageService(),
 		instance.RunTypeComponents,
+		instance.CoreComponentsHolder.EnableEpochsHandler(),
+		instance.StatusCoreComponents.AppStatusHandler(),
 	)
 	if err != nil {
 		return nil, err
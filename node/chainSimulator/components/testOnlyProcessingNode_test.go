@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/endProcess"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
@@ -64,7 +65,7 @@ func createMockArgsTestOnlyProcessingNode(t *testing.T) ArgsTestOnlyProcessingNo
 		CreateRunTypeCoreComponents: func() (mainFactory.RunTypeCoreComponentsHolder, error) {
 			return createRunTypeCoreComponents()
 		},
-		CreateIncomingHeaderSubscriber: func(config config.WebSocketConfig, dataPool dataRetriever.PoolsHolder, mainChainNotarizationStartRound uint64, runTypeComponents mainFactory.RunTypeComponentsHolder) (process.IncomingHeaderSubscriber, error) {
+		CreateIncomingHeaderSubscriber: func(config config.SovereignConfig, addressPubKeyConverter core.PubkeyConverter, dataPool dataRetriever.PoolsHolder, storageService dataRetriever.StorageService, runTypeComponents mainFactory.RunTypeComponentsHolder, enableEpochsHandler common.EnableEpochsHandler, appStatusHandler core.AppStatusHandler) (process.IncomingHeaderSubscriber, error) {
 			return &sovereign.IncomingHeaderSubscriberStub{}, nil
 		},
 		CreateRunTypeComponents: func(args runType.ArgsRunTypeComponents) (mainFactory.RunTypeComponentsHolder, error) {
@@ -144,3 +144,6 @@ var ErrNilNodeRunner = errors.New("nil node runner")
 
 // ErrEmptyNativeEsdt signals that an empty native esdt token has been provided
 var ErrEmptyNativeEsdt = errors.New("empty native esdt token")
+
+// ErrNilManagedKeysReloader signals that a nil managed keys reloader has been provided
+var ErrNilManagedKeysReloader = errors.New("nil managed keys reloader")
@@ -26,6 +26,9 @@ var ErrNilAPITransactionHandler = errors.New("nil api transaction handler")
 // ErrNilAPIBlockHandler signals that a nil api block handler has been provided
 var ErrNilAPIBlockHandler = errors.New("nil api block handler")
 
+// ErrNilAPIBridgeHandler signals that a nil api bridge handler has been provided
+var ErrNilAPIBridgeHandler = errors.New("nil api bridge handler")
+
 // ErrNilAPIInternalBlockHandler signals that a nil api internal block handler has been provided
 var ErrNilAPIInternalBlockHandler = errors.New("nil api internal block handler")
 
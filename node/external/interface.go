@@ -62,6 +62,7 @@ type DelegatedListHandler interface {
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetSCRsByTxHash(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
@@ -71,3 +72,16 @@ type APITransactionHandler interface {
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	IsInterfaceNil() bool
 }
+
+// APIBridgeHandler defines what an API sovereign bridge handler should be able to do
+type APIBridgeHandler interface {
+	GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error)
+	GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error)
+	GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse
+	RequeueDeadLetteredOutGoingOperations(hash string) error
+	DropDeadLetteredOutGoingOperations(hash string) error
+	GetBridgePauseState() *common.BridgePauseStateAPIResponse
+	GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error)
+	IsInterfaceNil() bool
+}
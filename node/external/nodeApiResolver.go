@@ -35,6 +35,7 @@ type ArgNodeApiResolver struct {
 	APITransactionHandler    APITransactionHandler
 	APIBlockHandler          blockAPI.APIBlockHandler
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
+	APIBridgeHandler         APIBridgeHandler
 	GenesisNodesSetupHandler sharding.GenesisNodesSetupHandler
 	ValidatorPubKeyConverter core.PubkeyConverter
 	AccountsParser           genesis.AccountsParser
@@ -56,6 +57,7 @@ type nodeApiResolver struct {
 	apiTransactionHandler    APITransactionHandler
 	apiBlockHandler          blockAPI.APIBlockHandler
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
+	apiBridgeHandler         APIBridgeHandler
 	genesisNodesSetupHandler sharding.GenesisNodesSetupHandler
 	validatorPubKeyConverter core.PubkeyConverter
 	accountsParser           genesis.AccountsParser
@@ -95,6 +97,9 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.APIInternalBlockHandler) {
 		return nil, ErrNilAPIInternalBlockHandler
 	}
+	if check.IfNil(arg.APIBridgeHandler) {
+		return nil, ErrNilAPIBridgeHandler
+	}
 	if check.IfNil(arg.GenesisNodesSetupHandler) {
 		return nil, ErrNilGenesisNodesSetupHandler
 	}
@@ -124,6 +129,7 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		apiBlockHandler:          arg.APIBlockHandler,
 		apiTransactionHandler:    arg.APITransactionHandler,
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
+		apiBridgeHandler:         arg.APIBridgeHandler,
 		genesisNodesSetupHandler: arg.GenesisNodesSetupHandler,
 		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
 		accountsParser:           arg.AccountsParser,
@@ -194,6 +200,52 @@ func (nar *nodeApiResolver) GetSCRsByTxHash(txHash string, scrHash string) ([]*t
 	return nar.apiTransactionHandler.GetSCRsByTxHash(txHash, scrHash)
 }
 
+// GetIncomingSCRsByMainChainTxHash will return the list of incoming smart contract results generated by a main chain tx hash
+func (nar *nodeApiResolver) GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+	return nar.apiTransactionHandler.GetIncomingSCRsByMainChainTxHash(txHash)
+}
+
+// GetUnconfirmedOutGoingOperations will return all the outgoing operations batches not yet confirmed by the main chain
+func (nar *nodeApiResolver) GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	return nar.apiBridgeHandler.GetUnconfirmedOutGoingOperations()
+}
+
+// GetOutGoingOperations will return the outgoing operations batch by its hash or by one of its operations hash
+func (nar *nodeApiResolver) GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+	return nar.apiBridgeHandler.GetOutGoingOperations(hash)
+}
+
+// GetLastCrossNotarizedIncomingHeader will return the last header of the provided incoming chain notarized by the
+// sovereign chain. An empty chain ID stands for the main chain.
+func (nar *nodeApiResolver) GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+	return nar.apiBridgeHandler.GetLastCrossNotarizedIncomingHeader(chainID)
+}
+
+// GetDeadLetteredOutGoingOperations will return all the outgoing operations batches not confirmed by the main chain after the max send attempts
+func (nar *nodeApiResolver) GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	return nar.apiBridgeHandler.GetDeadLetteredOutGoingOperations()
+}
+
+// RequeueDeadLetteredOutGoingOperations will move the dead-lettered outgoing operations batch back to the pool, to be resent
+func (nar *nodeApiResolver) RequeueDeadLetteredOutGoingOperations(hash string) error {
+	return nar.apiBridgeHandler.RequeueDeadLetteredOutGoingOperations(hash)
+}
+
+// DropDeadLetteredOutGoingOperations will permanently remove the dead-lettered outgoing operations batch
+func (nar *nodeApiResolver) DropDeadLetteredOutGoingOperations(hash string) error {
+	return nar.apiBridgeHandler.DropDeadLetteredOutGoingOperations(hash)
+}
+
+// GetBridgePauseState will return the current pause state of the incoming and outgoing bridge
+func (nar *nodeApiResolver) GetBridgePauseState() *common.BridgePauseStateAPIResponse {
+	return nar.apiBridgeHandler.GetBridgePauseState()
+}
+
+// GetOutGoingOperationProof will return the merkle inclusion proof of an outgoing operation, along with its sovereign header and batch merkle root
+func (nar *nodeApiResolver) GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+	return nar.apiBridgeHandler.GetOutGoingOperationProof(hash)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
 func (nar *nodeApiResolver) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
 	return nar.apiTransactionHandler.GetTransactionsPool(fields)
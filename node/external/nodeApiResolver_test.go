@@ -39,6 +39,7 @@ func createMockArgs() external.ArgNodeApiResolver {
 		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
 		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
+		APIBridgeHandler:         &mock.APIBridgeHandlerStub{},
 		GenesisNodesSetupHandler: &genesisMocks.NodesSetupStub{},
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
@@ -136,6 +137,17 @@ func TestNewNodeApiResolver_NilNodesCoordinator(t *testing.T) {
 	assert.Equal(t, external.ErrNilNodesCoordinator, err)
 }
 
+func TestNewNodeApiResolver_NilAPIBridgeHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.APIBridgeHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilAPIBridgeHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -373,6 +385,99 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetIncomingSCRsByMainChainTxHash(t *testing.T) {
+	t.Parallel()
+
+	expectedSCRs := []*transaction.ApiSmartContractResult{{Hash: "scrHash"}}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetIncomingSCRsByMainChainTxHashCalled: func(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+			require.Equal(t, "0101", txHash)
+			return expectedSCRs, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	scrs, err := nar.GetIncomingSCRsByMainChainTxHash("0101")
+	require.Nil(t, err)
+	require.Equal(t, expectedSCRs, scrs)
+}
+
+func TestNodeApiResolver_APIBridgeHandler(t *testing.T) {
+	t.Parallel()
+
+	expectedBatch := &common.OutGoingOperationsBatchAPIResponse{Hash: "batchHash"}
+	expectedHeader := &common.CrossNotarizedHeaderAPIResponse{Hash: "headerHash"}
+	expectedPauseState := &common.BridgePauseStateAPIResponse{OutGoingPaused: true}
+	expectedProof := &common.OutGoingOperationProofAPIResponse{OperationHash: "opHash"}
+	arg := createMockArgs()
+	arg.APIBridgeHandler = &mock.APIBridgeHandlerStub{
+		GetUnconfirmedOutGoingOperationsCalled: func() []*common.OutGoingOperationsBatchAPIResponse {
+			return []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}
+		},
+		GetOutGoingOperationsCalled: func(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+			if hash != "batchHash" {
+				return nil, expectedErr
+			}
+			return expectedBatch, nil
+		},
+		GetLastCrossNotarizedIncomingHeaderCalled: func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+			if chainID != "chainID" {
+				return nil, expectedErr
+			}
+			return expectedHeader, nil
+		},
+		GetDeadLetteredOutGoingOperationsCalled: func() []*common.OutGoingOperationsBatchAPIResponse {
+			return []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}
+		},
+		RequeueDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+			return expectedErr
+		},
+		DropDeadLetteredOutGoingOperationsCalled: func(hash string) error {
+			return expectedErr
+		},
+		GetBridgePauseStateCalled: func() *common.BridgePauseStateAPIResponse {
+			return expectedPauseState
+		},
+		GetOutGoingOperationProofCalled: func(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+			if hash != "opHash" {
+				return nil, expectedErr
+			}
+			return expectedProof, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	require.Equal(t, []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}, nar.GetUnconfirmedOutGoingOperations())
+
+	batch, err := nar.GetOutGoingOperations("batchHash")
+	require.Nil(t, err)
+	require.Equal(t, expectedBatch, batch)
+
+	batch, err = nar.GetOutGoingOperations("otherHash")
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, batch)
+
+	header, err := nar.GetLastCrossNotarizedIncomingHeader("chainID")
+	require.Nil(t, err)
+	require.Equal(t, expectedHeader, header)
+
+	require.Equal(t, []*common.OutGoingOperationsBatchAPIResponse{expectedBatch}, nar.GetDeadLetteredOutGoingOperations())
+	require.Equal(t, expectedErr, nar.RequeueDeadLetteredOutGoingOperations("batchHash"))
+	require.Equal(t, expectedErr, nar.DropDeadLetteredOutGoingOperations("batchHash"))
+	require.Equal(t, expectedPauseState, nar.GetBridgePauseState())
+
+	proof, err := nar.GetOutGoingOperationProof("opHash")
+	require.Nil(t, err)
+	require.Equal(t, expectedProof, proof)
+
+	proof, err = nar.GetOutGoingOperationProof("otherHash")
+	require.Equal(t, expectedErr, err)
+	require.Nil(t, proof)
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
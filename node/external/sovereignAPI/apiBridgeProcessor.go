@@ -0,0 +1,426 @@
+package sovereignAPI
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
+)
+
+const (
+	// outGoingOperationsStatusPending defines the status of outgoing operations which are waiting to be confirmed from
+	// the main chain, within the time to wait for unconfirmed outgoing operations
+	outGoingOperationsStatusPending = "pending"
+
+	// outGoingOperationsStatusUnconfirmed defines the status of outgoing operations which were not confirmed from the main
+	// chain within the time to wait for unconfirmed outgoing operations, and should be resent by the next leader
+	outGoingOperationsStatusUnconfirmed = "unconfirmed"
+
+	// outGoingOperationsStatusDeadLettered defines the status of outgoing operations which were not confirmed from the
+	// main chain after the max send attempts, and are no longer resent until re-queued
+	outGoingOperationsStatusDeadLettered = "dead-lettered"
+)
+
+// ArgAPIBridgeProcessor is the structure used to create a new api bridge processor
+type ArgAPIBridgeProcessor struct {
+	OutGoingOperationsPool OutGoingOperationsPool
+	BlockTracker           CrossNotarizedHeadersTracker
+	IncomingChainsHandler  IncomingChainsHandler
+	BridgePauseHandler     BridgePauseHandler
+	HistoryRepository      MiniBlocksHistoryRepository
+	StorageService         dataRetriever.StorageService
+	Marshaller             marshal.Marshalizer
+	OperationsHashers      []hashing.Hasher
+}
+
+type apiBridgeProcessor struct {
+	outGoingOperationsPool OutGoingOperationsPool
+	blockTracker           CrossNotarizedHeadersTracker
+	incomingChainsHandler  IncomingChainsHandler
+	bridgePauseHandler     BridgePauseHandler
+	historyRepository      MiniBlocksHistoryRepository
+	storageService         dataRetriever.StorageService
+	marshaller             marshal.Marshalizer
+	operationsHashers      []hashing.Hasher
+}
+
+// NewAPIBridgeProcessor creates a new api bridge processor, able to provide the state of the sovereign bridge
+func NewAPIBridgeProcessor(args ArgAPIBridgeProcessor) (*apiBridgeProcessor, error) {
+	if check.IfNil(args.OutGoingOperationsPool) {
+		return nil, ErrNilOutGoingOperationsPool
+	}
+	if check.IfNil(args.BlockTracker) {
+		return nil, ErrNilCrossNotarizedHeadersTracker
+	}
+	if check.IfNil(args.IncomingChainsHandler) {
+		return nil, ErrNilIncomingChainsHandler
+	}
+	if check.IfNil(args.BridgePauseHandler) {
+		return nil, ErrNilBridgePauseHandler
+	}
+	if check.IfNil(args.HistoryRepository) {
+		return nil, ErrNilHistoryRepository
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	for idx, hasher := range args.OperationsHashers {
+		if check.IfNil(hasher) {
+			return nil, fmt.Errorf("%w at index %d", ErrNilOperationsHasher, idx)
+		}
+	}
+
+	return &apiBridgeProcessor{
+		outGoingOperationsPool: args.OutGoingOperationsPool,
+		blockTracker:           args.BlockTracker,
+		incomingChainsHandler:  args.IncomingChainsHandler,
+		bridgePauseHandler:     args.BridgePauseHandler,
+		historyRepository:      args.HistoryRepository,
+		storageService:         args.StorageService,
+		marshaller:             args.Marshaller,
+		operationsHashers:      args.OperationsHashers,
+	}, nil
+}
+
+// GetUnconfirmedOutGoingOperations returns all the outgoing operations which were not confirmed from the main chain
+// within the time to wait for unconfirmed outgoing operations
+func (abp *apiBridgeProcessor) GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	unconfirmedOperations := abp.outGoingOperationsPool.GetUnconfirmedOperations()
+
+	response := make([]*common.OutGoingOperationsBatchAPIResponse, 0, len(unconfirmedOperations))
+	for _, bridgeData := range unconfirmedOperations {
+		response = append(response, createOutGoingOperationsBatchAPIResponse(bridgeData, outGoingOperationsStatusUnconfirmed))
+	}
+
+	return response
+}
+
+// GetOutGoingOperations returns the outgoing operations batch from the pool which has the provided hash, or which
+// contains an outgoing operation with the provided hash. Confirmed operations are no longer found in the pool.
+func (abp *apiBridgeProcessor) GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeData := abp.outGoingOperationsPool.Get(decodedHash)
+	if bridgeData == nil || len(bridgeData.Hash) == 0 {
+		bridgeData = abp.outGoingOperationsPool.GetByOperationHash(decodedHash)
+	}
+	if bridgeData == nil || len(bridgeData.Hash) == 0 {
+		bridgeData = abp.getDeadLetteredOutGoingOperations(decodedHash)
+		if bridgeData != nil {
+			return createOutGoingOperationsBatchAPIResponse(bridgeData, outGoingOperationsStatusDeadLettered), nil
+		}
+	}
+	if bridgeData == nil || len(bridgeData.Hash) == 0 {
+		return nil, fmt.Errorf("%w for hash %s", ErrOutGoingOperationsNotFound, hash)
+	}
+
+	return createOutGoingOperationsBatchAPIResponse(bridgeData, abp.getOutGoingOperationsStatus(bridgeData.Hash)), nil
+}
+
+// getDeadLetteredOutGoingOperations returns the dead-lettered outgoing operations batch which has the provided hash, or
+// which contains an outgoing operation with the provided hash
+func (abp *apiBridgeProcessor) getDeadLetteredOutGoingOperations(hash []byte) *sovereignCore.BridgeOutGoingData {
+	for _, bridgeData := range abp.outGoingOperationsPool.GetDeadLetteredOperations() {
+		if bytes.Equal(bridgeData.Hash, hash) {
+			return bridgeData
+		}
+
+		for _, outGoingOp := range bridgeData.OutGoingOperations {
+			if bytes.Equal(outGoingOp.Hash, hash) {
+				return bridgeData
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetDeadLetteredOutGoingOperations returns all the outgoing operations which were not confirmed from the main chain
+// after the max send attempts
+func (abp *apiBridgeProcessor) GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	deadLetteredOperations := abp.outGoingOperationsPool.GetDeadLetteredOperations()
+
+	response := make([]*common.OutGoingOperationsBatchAPIResponse, 0, len(deadLetteredOperations))
+	for _, bridgeData := range deadLetteredOperations {
+		response = append(response, createOutGoingOperationsBatchAPIResponse(bridgeData, outGoingOperationsStatusDeadLettered))
+	}
+
+	return response
+}
+
+// RequeueDeadLetteredOutGoingOperations moves the dead-lettered outgoing operations batch with the provided hash back to
+// the pool of this node, so that it will be resent when this node is the leader
+func (abp *apiBridgeProcessor) RequeueDeadLetteredOutGoingOperations(hash string) error {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return err
+	}
+
+	return abp.outGoingOperationsPool.RequeueDeadLetteredOperations(decodedHash)
+}
+
+// DropDeadLetteredOutGoingOperations permanently removes the dead-lettered outgoing operations batch with the provided hash
+// from the pool of this node
+func (abp *apiBridgeProcessor) DropDeadLetteredOutGoingOperations(hash string) error {
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return err
+	}
+
+	return abp.outGoingOperationsPool.DropDeadLetteredOperations(decodedHash)
+}
+
+func (abp *apiBridgeProcessor) getOutGoingOperationsStatus(hash []byte) string {
+	for _, unconfirmedOperation := range abp.outGoingOperationsPool.GetUnconfirmedOperations() {
+		if bytes.Equal(unconfirmedOperation.Hash, hash) {
+			return outGoingOperationsStatusUnconfirmed
+		}
+	}
+
+	return outGoingOperationsStatusPending
+}
+
+// GetLastCrossNotarizedIncomingHeader returns the last header of the incoming chain with the provided chain ID which was
+// cross notarized in the sovereign chain. An empty chain ID stands for the main chain.
+func (abp *apiBridgeProcessor) GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+	shardID, found := abp.incomingChainsHandler.GetShardIDForChainID(chainID)
+	if !found {
+		return nil, fmt.Errorf("%w, chain ID = %s", ErrUnknownIncomingChain, chainID)
+	}
+
+	header, hash, err := abp.blockTracker.GetLastCrossNotarizedHeader(shardID)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(header) {
+		return nil, fmt.Errorf("%w, chain ID = %s", ErrNoCrossNotarizedIncomingHeader, chainID)
+	}
+
+	return &common.CrossNotarizedHeaderAPIResponse{
+		ShardID:   shardID,
+		Hash:      hex.EncodeToString(hash),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Epoch:     header.GetEpoch(),
+		Timestamp: header.GetTimeStamp(),
+	}, nil
+}
+
+// GetBridgePauseState returns the current pause state of the incoming and outgoing bridge, as set through governance
+func (abp *apiBridgeProcessor) GetBridgePauseState() *common.BridgePauseStateAPIResponse {
+	return &common.BridgePauseStateAPIResponse{
+		IncomingPaused: abp.bridgePauseHandler.IsIncomingPaused(),
+		OutGoingPaused: abp.bridgePauseHandler.IsOutGoingPaused(),
+	}
+}
+
+// GetOutGoingOperationProof returns the merkle inclusion proof of the outgoing operation with the provided hash, along
+// with the sovereign header which holds it and the merkle root of its batch, which is committed in the outgoing mini
+// block header. The sovereign block is found by the history repository, so the db lookup extensions should be enabled.
+func (abp *apiBridgeProcessor) GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+	if !abp.historyRepository.IsEnabled() {
+		return nil, ErrDBLookExtensionIsNotEnabled
+	}
+	if len(abp.operationsHashers) == 0 {
+		return nil, ErrNoOperationsHashers
+	}
+
+	operationHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlockMetadata, err := abp.historyRepository.GetMiniblockMetadataByTxHash(operationHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for hash %s: %v", ErrOutGoingOperationNotFound, hash, err)
+	}
+	if miniBlockMetadata.DestinationShardID != core.MainChainShardId {
+		return nil, fmt.Errorf("%w for hash %s", ErrOutGoingOperationNotFound, hash)
+	}
+
+	header := &block.SovereignChainHeader{}
+	err = abp.getFromStorer(dataRetriever.BlockHeaderUnit, miniBlockMetadata.HeaderHash, miniBlockMetadata.Epoch, header)
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = abp.getFromStorer(dataRetriever.MiniBlockUnit, miniBlockMetadata.MiniblockHash, miniBlockMetadata.Epoch, miniBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	outGoingMbHeader := header.GetOutGoingMiniBlockHeaderHandler()
+	if check.IfNil(outGoingMbHeader) {
+		return nil, fmt.Errorf("%w for hash %s", ErrOutGoingOperationNotFound, hash)
+	}
+
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(header)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, batchOperationsHashes, operationIndex, err := findOutGoingOperationsBatch(miniBlock.TxHashes, batches, operationHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for hash %s", err, hash)
+	}
+
+	hasher, err := abp.getBatchHasher(batchOperationsHashes, batch.Hash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for hash %s", err, hash)
+	}
+
+	merkleRoot, err := common.ComputeOutGoingOperationsMerkleRoot(hasher, batchOperationsHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := common.ComputeOutGoingOperationMerkleProof(hasher, batchOperationsHashes, operationIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return createOutGoingOperationProofAPIResponse(operationHash, miniBlockMetadata.HeaderHash, header, batch.Hash, merkleRoot, operationIndex, proof), nil
+}
+
+func (abp *apiBridgeProcessor) getFromStorer(unit dataRetriever.UnitType, key []byte, epoch uint32, obj interface{}) error {
+	storer, err := abp.storageService.GetStorer(unit)
+	if err != nil {
+		return err
+	}
+
+	buff, err := storer.GetFromEpoch(key, epoch)
+	if err != nil {
+		return err
+	}
+
+	return abp.marshaller.Unmarshal(obj, buff)
+}
+
+// findOutGoingOperationsBatch splits the operations hashes from the outgoing mini block in batches, by the number of
+// operations of each batch from the header, and returns the batch which holds the provided operation hash, along with
+// the batch operations hashes and the operation index in the batch. Headers created before the outgoing operations
+// batches activation hold all the operations in a single batch.
+func findOutGoingOperationsBatch(
+	operationsHashes [][]byte,
+	batches []*outgoingBatches.OutGoingOperationsBatch,
+	operationHash []byte,
+) (*outgoingBatches.OutGoingOperationsBatch, [][]byte, int, error) {
+	if len(batches) == 1 && batches[0].GetNumOperations() == 0 {
+		batches = []*outgoingBatches.OutGoingOperationsBatch{
+			{
+				Hash:          batches[0].Hash,
+				NumOperations: uint32(len(operationsHashes)),
+			},
+		}
+	}
+
+	startIndex := 0
+	for _, batch := range batches {
+		endIndex := startIndex + int(batch.GetNumOperations())
+		if endIndex > len(operationsHashes) {
+			return nil, nil, 0, ErrOutGoingOperationsBatchNotFound
+		}
+
+		batchOperationsHashes := operationsHashes[startIndex:endIndex]
+		for idx, batchOperationHash := range batchOperationsHashes {
+			if bytes.Equal(batchOperationHash, operationHash) {
+				return batch, batchOperationsHashes, idx, nil
+			}
+		}
+
+		startIndex = endIndex
+	}
+
+	return nil, nil, 0, ErrOutGoingOperationNotFound
+}
+
+// getBatchHasher returns the outgoing operations hasher which builds the provided batch hash over the provided
+// operations hashes, since each batch is hashed with the hasher of its destination. The batch hash is either the merkle
+// root of the operations hashes or, for batches created before the merkle root was signed, the hash over all of them.
+func (abp *apiBridgeProcessor) getBatchHasher(operationsHashes [][]byte, batchHash []byte) (hashing.Hasher, error) {
+	aggregatedOperationsHashes := make([]byte, 0)
+	for _, operationHash := range operationsHashes {
+		aggregatedOperationsHashes = append(aggregatedOperationsHashes, operationHash...)
+	}
+
+	for _, hasher := range abp.operationsHashers {
+		if bytes.Equal(hasher.Compute(string(aggregatedOperationsHashes)), batchHash) {
+			return hasher, nil
+		}
+
+		merkleRoot, err := common.ComputeOutGoingOperationsMerkleRoot(hasher, operationsHashes)
+		if err == nil && bytes.Equal(merkleRoot, batchHash) {
+			return hasher, nil
+		}
+	}
+
+	return nil, ErrOutGoingOperationsBatchNotFound
+}
+
+func createOutGoingOperationProofAPIResponse(
+	operationHash []byte,
+	headerHash []byte,
+	header *block.SovereignChainHeader,
+	batchHash []byte,
+	merkleRoot []byte,
+	operationIndex int,
+	proof []*common.OutGoingOperationProofStep,
+) *common.OutGoingOperationProofAPIResponse {
+	proofSteps := make([]*common.OutGoingOperationProofStepAPIResponse, 0, len(proof))
+	for _, step := range proof {
+		proofSteps = append(proofSteps, &common.OutGoingOperationProofStepAPIResponse{
+			Hash:   hex.EncodeToString(step.Hash),
+			IsLeft: step.IsLeft,
+		})
+	}
+
+	return &common.OutGoingOperationProofAPIResponse{
+		OperationHash:  hex.EncodeToString(operationHash),
+		HeaderHash:     hex.EncodeToString(headerHash),
+		Header:         header,
+		BatchHash:      hex.EncodeToString(batchHash),
+		MerkleRoot:     hex.EncodeToString(merkleRoot),
+		OperationIndex: uint32(operationIndex),
+		Proof:          proofSteps,
+	}
+}
+
+func createOutGoingOperationsBatchAPIResponse(bridgeData *sovereignCore.BridgeOutGoingData, status string) *common.OutGoingOperationsBatchAPIResponse {
+	operations := make([]*common.OutGoingOperationAPIResponse, 0, len(bridgeData.OutGoingOperations))
+	for _, outGoingOp := range bridgeData.OutGoingOperations {
+		operations = append(operations, &common.OutGoingOperationAPIResponse{
+			Hash: hex.EncodeToString(outGoingOp.Hash),
+			Data: hex.EncodeToString(outGoingOp.Data),
+		})
+	}
+
+	return &common.OutGoingOperationsBatchAPIResponse{
+		Hash:                hex.EncodeToString(bridgeData.Hash),
+		Operations:          operations,
+		AggregatedSignature: hex.EncodeToString(bridgeData.AggregatedSignature),
+		LeaderSignature:     hex.EncodeToString(bridgeData.LeaderSignature),
+		Status:              status,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (abp *apiBridgeProcessor) IsInterfaceNil() bool {
+	return abp == nil
+}
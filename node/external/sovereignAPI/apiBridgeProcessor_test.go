@@ -0,0 +1,661 @@
+package sovereignAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dblookupext"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	dblookupextMock "github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createArgs() ArgAPIBridgeProcessor {
+	return ArgAPIBridgeProcessor{
+		OutGoingOperationsPool: &sovereign.OutGoingOperationsPoolMock{},
+		BlockTracker:           &testscommon.BlockTrackerStub{},
+		IncomingChainsHandler:  &sovereign.IncomingChainsHandlerMock{},
+		BridgePauseHandler:     &sovereign.BridgePauseHandlerMock{},
+		HistoryRepository:      &dblookupextMock.HistoryRepositoryStub{},
+		StorageService:         genericMocks.NewChainStorerMock(0),
+		Marshaller:             &marshal.GogoProtoMarshalizer{},
+		OperationsHashers:      []hashing.Hasher{sha256.NewSha256()},
+	}
+}
+
+func createBridgeData(hash string, opsHashes ...string) *sovereignCore.BridgeOutGoingData {
+	ops := make([]*sovereignCore.OutGoingOperation, 0, len(opsHashes))
+	for _, opHash := range opsHashes {
+		ops = append(ops, &sovereignCore.OutGoingOperation{
+			Hash: []byte(opHash),
+			Data: []byte("data_" + opHash),
+		})
+	}
+
+	return &sovereignCore.BridgeOutGoingData{
+		Hash:                []byte(hash),
+		OutGoingOperations:  ops,
+		AggregatedSignature: []byte("aggSig"),
+		LeaderSignature:     []byte("leaderSig"),
+	}
+}
+
+func TestNewAPIBridgeProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil outgoing operations pool should error", func(t *testing.T) {
+		args := createArgs()
+		args.OutGoingOperationsPool = nil
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.Equal(t, ErrNilOutGoingOperationsPool, err)
+		require.Nil(t, abp)
+	})
+	t.Run("nil block tracker should error", func(t *testing.T) {
+		args := createArgs()
+		args.BlockTracker = nil
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.Equal(t, ErrNilCrossNotarizedHeadersTracker, err)
+		require.Nil(t, abp)
+	})
+	t.Run("nil incoming chains handler should error", func(t *testing.T) {
+		args := createArgs()
+		args.IncomingChainsHandler = nil
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.Equal(t, ErrNilIncomingChainsHandler, err)
+		require.Nil(t, abp)
+	})
+	t.Run("nil bridge pause handler should error", func(t *testing.T) {
+		args := createArgs()
+		args.BridgePauseHandler = nil
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.Equal(t, ErrNilBridgePauseHandler, err)
+		require.Nil(t, abp)
+	})
+	t.Run("nil history repository should error", func(t *testing.T) {
+		args := createArgs()
+		args.HistoryRepository = nil
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.Equal(t, ErrNilHistoryRepository, err)
+		require.Nil(t, abp)
+	})
+	t.Run("nil storage service should error", func(t *testing.T) {
+		args := createArgs()
+		args.StorageService = nil
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.Equal(t, ErrNilStorageService, err)
+		require.Nil(t, abp)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		args := createArgs()
+		args.Marshaller = nil
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.Equal(t, ErrNilMarshaller, err)
+		require.Nil(t, abp)
+	})
+	t.Run("nil operations hasher should error", func(t *testing.T) {
+		args := createArgs()
+		args.OperationsHashers = []hashing.Hasher{sha256.NewSha256(), nil}
+
+		abp, err := NewAPIBridgeProcessor(args)
+		require.ErrorIs(t, err, ErrNilOperationsHasher)
+		require.Nil(t, abp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		abp, err := NewAPIBridgeProcessor(createArgs())
+		require.Nil(t, err)
+		require.False(t, abp.IsInterfaceNil())
+	})
+}
+
+func TestApiBridgeProcessor_GetUnconfirmedOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.OutGoingOperationsPool = &sovereign.OutGoingOperationsPoolMock{
+		GetUnconfirmedOperationsCalled: func() []*sovereignCore.BridgeOutGoingData {
+			return []*sovereignCore.BridgeOutGoingData{createBridgeData("batch", "op1", "op2")}
+		},
+	}
+	abp, _ := NewAPIBridgeProcessor(args)
+
+	expectedResponse := []*common.OutGoingOperationsBatchAPIResponse{
+		{
+			Hash: hex.EncodeToString([]byte("batch")),
+			Operations: []*common.OutGoingOperationAPIResponse{
+				{
+					Hash: hex.EncodeToString([]byte("op1")),
+					Data: hex.EncodeToString([]byte("data_op1")),
+				},
+				{
+					Hash: hex.EncodeToString([]byte("op2")),
+					Data: hex.EncodeToString([]byte("data_op2")),
+				},
+			},
+			AggregatedSignature: hex.EncodeToString([]byte("aggSig")),
+			LeaderSignature:     hex.EncodeToString([]byte("leaderSig")),
+			Status:              outGoingOperationsStatusUnconfirmed,
+		},
+	}
+	require.Equal(t, expectedResponse, abp.GetUnconfirmedOutGoingOperations())
+}
+
+func TestApiBridgeProcessor_GetOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	pendingBatch := createBridgeData("batch1", "op1")
+	unconfirmedBatch := createBridgeData("batch2", "op2")
+	pool := &sovereign.OutGoingOperationsPoolMock{
+		GetCalled: func(hash []byte) *sovereignCore.BridgeOutGoingData {
+			switch string(hash) {
+			case "batch1":
+				return pendingBatch
+			case "batch2":
+				return unconfirmedBatch
+			}
+			return nil
+		},
+		GetByOperationHashCalled: func(hash []byte) *sovereignCore.BridgeOutGoingData {
+			switch string(hash) {
+			case "op1":
+				return pendingBatch
+			case "op2":
+				return unconfirmedBatch
+			}
+			return nil
+		},
+		GetUnconfirmedOperationsCalled: func() []*sovereignCore.BridgeOutGoingData {
+			return []*sovereignCore.BridgeOutGoingData{unconfirmedBatch}
+		},
+	}
+	args := createArgs()
+	args.OutGoingOperationsPool = pool
+	abp, _ := NewAPIBridgeProcessor(args)
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		response, err := abp.GetOutGoingOperations("not hex")
+		require.NotNil(t, err)
+		require.Nil(t, response)
+	})
+	t.Run("not found should error", func(t *testing.T) {
+		response, err := abp.GetOutGoingOperations(hex.EncodeToString([]byte("op3")))
+		require.True(t, errors.Is(err, ErrOutGoingOperationsNotFound))
+		require.Nil(t, response)
+	})
+	t.Run("by batch hash should work", func(t *testing.T) {
+		response, err := abp.GetOutGoingOperations(hex.EncodeToString([]byte("batch1")))
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString([]byte("batch1")), response.Hash)
+		require.Equal(t, outGoingOperationsStatusPending, response.Status)
+	})
+	t.Run("by operation hash should work", func(t *testing.T) {
+		response, err := abp.GetOutGoingOperations(hex.EncodeToString([]byte("op1")))
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString([]byte("batch1")), response.Hash)
+		require.Equal(t, outGoingOperationsStatusPending, response.Status)
+
+		response, err = abp.GetOutGoingOperations(hex.EncodeToString([]byte("op2")))
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString([]byte("batch2")), response.Hash)
+		require.Equal(t, outGoingOperationsStatusUnconfirmed, response.Status)
+	})
+}
+
+func TestApiBridgeProcessor_DeadLetteredOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	deadLetteredBatch := createBridgeData("batch", "op1")
+	requeuedHashes := make([][]byte, 0)
+	droppedHashes := make([][]byte, 0)
+	args := createArgs()
+	args.OutGoingOperationsPool = &sovereign.OutGoingOperationsPoolMock{
+		GetDeadLetteredOperationsCalled: func() []*sovereignCore.BridgeOutGoingData {
+			return []*sovereignCore.BridgeOutGoingData{deadLetteredBatch}
+		},
+		RequeueDeadLetteredOperationsCalled: func(hash []byte) error {
+			requeuedHashes = append(requeuedHashes, hash)
+			return nil
+		},
+		DropDeadLetteredOperationsCalled: func(hash []byte) error {
+			droppedHashes = append(droppedHashes, hash)
+			return nil
+		},
+	}
+	abp, _ := NewAPIBridgeProcessor(args)
+
+	t.Run("get dead-lettered operations should work", func(t *testing.T) {
+		response := abp.GetDeadLetteredOutGoingOperations()
+		require.Len(t, response, 1)
+		require.Equal(t, hex.EncodeToString([]byte("batch")), response[0].Hash)
+		require.Equal(t, outGoingOperationsStatusDeadLettered, response[0].Status)
+	})
+	t.Run("get operations should find dead-lettered operations", func(t *testing.T) {
+		response, err := abp.GetOutGoingOperations(hex.EncodeToString([]byte("batch")))
+		require.Nil(t, err)
+		require.Equal(t, outGoingOperationsStatusDeadLettered, response.Status)
+
+		response, err = abp.GetOutGoingOperations(hex.EncodeToString([]byte("op1")))
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString([]byte("batch")), response.Hash)
+		require.Equal(t, outGoingOperationsStatusDeadLettered, response.Status)
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		require.NotNil(t, abp.RequeueDeadLetteredOutGoingOperations("not hex"))
+		require.NotNil(t, abp.DropDeadLetteredOutGoingOperations("not hex"))
+	})
+	t.Run("requeue and drop should work", func(t *testing.T) {
+		err := abp.RequeueDeadLetteredOutGoingOperations(hex.EncodeToString([]byte("batch")))
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("batch")}, requeuedHashes)
+
+		err = abp.DropDeadLetteredOutGoingOperations(hex.EncodeToString([]byte("batch")))
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("batch")}, droppedHashes)
+	})
+}
+
+func TestApiBridgeProcessor_GetLastCrossNotarizedIncomingHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown chain should error", func(t *testing.T) {
+		args := createArgs()
+		args.BlockTracker = &testscommon.BlockTrackerStub{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil, nil
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		response, err := abp.GetLastCrossNotarizedIncomingHeader("unknown")
+		require.ErrorIs(t, err, ErrUnknownIncomingChain)
+		require.Nil(t, response)
+	})
+	t.Run("block tracker error should error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.BlockTracker = &testscommon.BlockTrackerStub{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		response, err := abp.GetLastCrossNotarizedIncomingHeader("")
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, response)
+	})
+	t.Run("nil header should error", func(t *testing.T) {
+		args := createArgs()
+		args.BlockTracker = &testscommon.BlockTrackerStub{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				return nil, nil, nil
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		response, err := abp.GetLastCrossNotarizedIncomingHeader("")
+		require.ErrorIs(t, err, ErrNoCrossNotarizedIncomingHeader)
+		require.Nil(t, response)
+	})
+	t.Run("should work for the main chain", func(t *testing.T) {
+		headerHash := []byte("headerHash")
+		args := createArgs()
+		args.BlockTracker = &testscommon.BlockTrackerStub{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				require.Equal(t, core.MainChainShardId, shardID)
+				return &block.ShardHeaderExtended{
+					Header: &block.HeaderV2{
+						Header: &block.Header{
+							Nonce:     4,
+							Round:     5,
+							Epoch:     1,
+							TimeStamp: 123,
+						},
+					},
+				}, headerHash, nil
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		response, err := abp.GetLastCrossNotarizedIncomingHeader("")
+		require.Nil(t, err)
+		require.Equal(t, &common.CrossNotarizedHeaderAPIResponse{
+			ShardID:   core.MainChainShardId,
+			Hash:      hex.EncodeToString(headerHash),
+			Nonce:     4,
+			Round:     5,
+			Epoch:     1,
+			Timestamp: 123,
+		}, response)
+	})
+	t.Run("should work for an additional incoming chain", func(t *testing.T) {
+		headerHash := []byte("headerHash")
+		incomingChainShardID := core.MainChainShardId - 1
+		args := createArgs()
+		args.IncomingChainsHandler = &sovereign.IncomingChainsHandlerMock{
+			GetShardIDForChainIDCalled: func(chainID string) (uint32, bool) {
+				require.Equal(t, "incomingChain", chainID)
+				return incomingChainShardID, true
+			},
+		}
+		args.BlockTracker = &testscommon.BlockTrackerStub{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				require.Equal(t, incomingChainShardID, shardID)
+				return &block.ShardHeaderExtended{
+					Header: &block.HeaderV2{
+						Header: &block.Header{
+							Nonce: 7,
+						},
+					},
+				}, headerHash, nil
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		response, err := abp.GetLastCrossNotarizedIncomingHeader("incomingChain")
+		require.Nil(t, err)
+		require.Equal(t, &common.CrossNotarizedHeaderAPIResponse{
+			ShardID: incomingChainShardID,
+			Hash:    hex.EncodeToString(headerHash),
+			Nonce:   7,
+		}, response)
+	})
+}
+
+func TestApiBridgeProcessor_GetBridgePauseState(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.BridgePauseHandler = &sovereign.BridgePauseHandlerMock{
+		IsIncomingPausedCalled: func() bool {
+			return false
+		},
+		IsOutGoingPausedCalled: func() bool {
+			return true
+		},
+	}
+	abp, _ := NewAPIBridgeProcessor(args)
+
+	state := abp.GetBridgePauseState()
+	require.Equal(t, &common.BridgePauseStateAPIResponse{
+		IncomingPaused: false,
+		OutGoingPaused: true,
+	}, state)
+}
+
+func TestApiBridgeProcessor_GetOutGoingOperationProof(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	sha256Hasher := sha256.NewSha256()
+	keccakHasher := keccak.NewKeccak()
+
+	// first batch is hashed with keccak (named destination), second batch is hashed with sha256 (default destination)
+	batch1OpsHashes := [][]byte{keccakHasher.Compute("op1"), keccakHasher.Compute("op2")}
+	batch2OpsHashes := [][]byte{sha256Hasher.Compute("op3"), sha256Hasher.Compute("op4"), sha256Hasher.Compute("op5")}
+	batch1Hash := keccakHasher.Compute(string(append(append([]byte{}, batch1OpsHashes[0]...), batch1OpsHashes[1]...)))
+	batch2Hash := sha256Hasher.Compute(string(append(append(append([]byte{}, batch2OpsHashes[0]...), batch2OpsHashes[1]...), batch2OpsHashes[2]...)))
+	batch1MerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(keccakHasher, batch1OpsHashes)
+	batch2MerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(sha256Hasher, batch2OpsHashes)
+
+	outGoingMb := &block.MiniBlock{
+		TxHashes:        append(append([][]byte{}, batch1OpsHashes...), batch2OpsHashes...),
+		ReceiverShardID: core.MainChainShardId,
+	}
+	header := &block.SovereignChainHeader{
+		Header: &block.Header{Nonce: 4, Round: 5, Epoch: 1},
+		OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
+			Hash:                   []byte("outGoingMbHash"),
+			OutGoingOperationsHash: []byte("hashOfBatches"),
+		},
+	}
+	_ = outgoingBatches.SetOutGoingOperationsBatches(header, []*outgoingBatches.OutGoingOperationsBatch{
+		{Hash: batch1Hash, MerkleRoot: batch1MerkleRoot, NumOperations: uint32(len(batch1OpsHashes))},
+		{Hash: batch2Hash, MerkleRoot: batch2MerkleRoot, NumOperations: uint32(len(batch2OpsHashes))},
+	})
+	headerHash := []byte("headerHash")
+	mbMetadata := &dblookupext.MiniblockMetadata{
+		Epoch:              1,
+		HeaderHash:         headerHash,
+		MiniblockHash:      outGoingMb.TxHashes[0],
+		DestinationShardID: core.MainChainShardId,
+	}
+
+	createStorageService := func(header *block.SovereignChainHeader, mb *block.MiniBlock) *genericMocks.ChainStorerMock {
+		storageService := genericMocks.NewChainStorerMock(1)
+		headerBytes, _ := marshaller.Marshal(header)
+		_ = storageService.BlockHeaders.PutInEpoch(headerHash, headerBytes, 1)
+		mbBytes, _ := marshaller.Marshal(mb)
+		_ = storageService.Miniblocks.PutInEpoch(mbMetadata.MiniblockHash, mbBytes, 1)
+
+		return storageService
+	}
+	createProofArgs := func() ArgAPIBridgeProcessor {
+		args := createArgs()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return mbMetadata, nil
+			},
+		}
+		args.StorageService = createStorageService(header, outGoingMb)
+		args.OperationsHashers = []hashing.Hasher{sha256Hasher, keccakHasher}
+
+		return args
+	}
+
+	t.Run("db lookup extensions not enabled should error", func(t *testing.T) {
+		args := createProofArgs()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(batch1OpsHashes[0]))
+		require.Equal(t, ErrDBLookExtensionIsNotEnabled, err)
+		require.Nil(t, proof)
+	})
+	t.Run("no operations hashers should error", func(t *testing.T) {
+		args := createProofArgs()
+		args.OperationsHashers = nil
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(batch1OpsHashes[0]))
+		require.Equal(t, ErrNoOperationsHashers, err)
+		require.Nil(t, proof)
+	})
+	t.Run("invalid hash should error", func(t *testing.T) {
+		abp, _ := NewAPIBridgeProcessor(createProofArgs())
+
+		proof, err := abp.GetOutGoingOperationProof("invalid hash")
+		require.NotNil(t, err)
+		require.Nil(t, proof)
+	})
+	t.Run("operation not recorded should error", func(t *testing.T) {
+		args := createProofArgs()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return nil, errors.New("not found")
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(batch1OpsHashes[0]))
+		require.ErrorIs(t, err, ErrOutGoingOperationNotFound)
+		require.Nil(t, proof)
+	})
+	t.Run("hash of a transaction which is not an outgoing operation should error", func(t *testing.T) {
+		args := createProofArgs()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return &dblookupext.MiniblockMetadata{DestinationShardID: core.SovereignChainShardId}, nil
+			},
+		}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(batch1OpsHashes[0]))
+		require.ErrorIs(t, err, ErrOutGoingOperationNotFound)
+		require.Nil(t, proof)
+	})
+	t.Run("header not found should error", func(t *testing.T) {
+		args := createProofArgs()
+		args.StorageService = genericMocks.NewChainStorerMock(1)
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(batch1OpsHashes[0]))
+		require.NotNil(t, err)
+		require.Nil(t, proof)
+	})
+	t.Run("batch with more operations than the outgoing mini block should error", func(t *testing.T) {
+		invalidHeader := &block.SovereignChainHeader{
+			Header:                  &block.Header{Nonce: 4, Round: 5, Epoch: 1},
+			OutGoingMiniBlockHeader: header.OutGoingMiniBlockHeader,
+		}
+		_ = outgoingBatches.SetOutGoingOperationsBatches(invalidHeader, []*outgoingBatches.OutGoingOperationsBatch{
+			{Hash: batch1Hash, MerkleRoot: batch1MerkleRoot, NumOperations: uint32(len(outGoingMb.TxHashes) + 1)},
+		})
+
+		args := createProofArgs()
+		args.StorageService = createStorageService(invalidHeader, outGoingMb)
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(batch1OpsHashes[0]))
+		require.ErrorIs(t, err, ErrOutGoingOperationsBatchNotFound)
+		require.Nil(t, proof)
+	})
+	t.Run("operations not matching the batches hashes should error", func(t *testing.T) {
+		args := createProofArgs()
+		args.OperationsHashers = []hashing.Hasher{sha256Hasher}
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(batch1OpsHashes[0]))
+		require.ErrorIs(t, err, ErrOutGoingOperationsBatchNotFound)
+		require.Nil(t, proof)
+	})
+	t.Run("operation not in outgoing mini block should error", func(t *testing.T) {
+		abp, _ := NewAPIBridgeProcessor(createProofArgs())
+
+		proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString([]byte("otherOperationHash")))
+		require.ErrorIs(t, err, ErrOutGoingOperationNotFound)
+		require.Nil(t, proof)
+	})
+	t.Run("legacy header should return the proof of each operation", func(t *testing.T) {
+		legacyMb := &block.MiniBlock{
+			TxHashes:        batch2OpsHashes,
+			ReceiverShardID: core.MainChainShardId,
+		}
+		legacyHeader := &block.SovereignChainHeader{
+			Header: &block.Header{Nonce: 4, Round: 5, Epoch: 1},
+			OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
+				Hash:                   []byte("outGoingMbHash"),
+				OutGoingOperationsHash: batch2Hash,
+			},
+		}
+
+		args := createProofArgs()
+		args.StorageService = createStorageService(legacyHeader, legacyMb)
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		for idx, opHash := range batch2OpsHashes {
+			proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(opHash))
+			require.Nil(t, err)
+			require.Equal(t, hex.EncodeToString(batch2Hash), proof.BatchHash)
+			require.Equal(t, hex.EncodeToString(batch2MerkleRoot), proof.MerkleRoot)
+			require.Equal(t, uint32(idx), proof.OperationIndex)
+		}
+	})
+	t.Run("should return the proof of each operation", func(t *testing.T) {
+		abp, _ := NewAPIBridgeProcessor(createProofArgs())
+
+		checkProof := func(opHash []byte, opIndex int, batchHash []byte, merkleRoot []byte, hasher hashing.Hasher) {
+			proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(opHash))
+			require.Nil(t, err)
+			require.Equal(t, hex.EncodeToString(opHash), proof.OperationHash)
+			require.Equal(t, hex.EncodeToString(headerHash), proof.HeaderHash)
+			require.Equal(t, header, proof.Header)
+			require.Equal(t, hex.EncodeToString(batchHash), proof.BatchHash)
+			require.Equal(t, hex.EncodeToString(merkleRoot), proof.MerkleRoot)
+			require.Equal(t, uint32(opIndex), proof.OperationIndex)
+
+			proofSteps := make([]*common.OutGoingOperationProofStep, 0, len(proof.Proof))
+			for _, step := range proof.Proof {
+				stepHash, _ := hex.DecodeString(step.Hash)
+				proofSteps = append(proofSteps, &common.OutGoingOperationProofStep{
+					Hash:   stepHash,
+					IsLeft: step.IsLeft,
+				})
+			}
+			require.True(t, common.VerifyOutGoingOperationMerkleProof(hasher, opHash, proofSteps, merkleRoot))
+		}
+
+		for idx, opHash := range batch1OpsHashes {
+			checkProof(opHash, idx, batch1Hash, batch1MerkleRoot, keccakHasher)
+		}
+		for idx, opHash := range batch2OpsHashes {
+			checkProof(opHash, idx, batch2Hash, batch2MerkleRoot, sha256Hasher)
+		}
+	})
+	t.Run("batches signed by their merkle root should return the proof of each operation", func(t *testing.T) {
+		merkleRootHeader := &block.SovereignChainHeader{
+			Header: &block.Header{Nonce: 4, Round: 5, Epoch: 1},
+			OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
+				Hash:                   []byte("outGoingMbHash"),
+				OutGoingOperationsHash: []byte("hashOfBatches"),
+			},
+		}
+		_ = outgoingBatches.SetOutGoingOperationsBatches(merkleRootHeader, []*outgoingBatches.OutGoingOperationsBatch{
+			{Hash: batch1MerkleRoot, MerkleRoot: batch1MerkleRoot, NumOperations: uint32(len(batch1OpsHashes))},
+			{Hash: batch2MerkleRoot, MerkleRoot: batch2MerkleRoot, NumOperations: uint32(len(batch2OpsHashes))},
+		})
+		args := createProofArgs()
+		args.StorageService = createStorageService(merkleRootHeader, outGoingMb)
+		abp, _ := NewAPIBridgeProcessor(args)
+
+		checkProof := func(opHash []byte, opIndex int, merkleRoot []byte, hasher hashing.Hasher) {
+			proof, err := abp.GetOutGoingOperationProof(hex.EncodeToString(opHash))
+			require.Nil(t, err)
+			require.Equal(t, hex.EncodeToString(merkleRoot), proof.BatchHash)
+			require.Equal(t, hex.EncodeToString(merkleRoot), proof.MerkleRoot)
+			require.Equal(t, uint32(opIndex), proof.OperationIndex)
+
+			proofSteps := make([]*common.OutGoingOperationProofStep, 0, len(proof.Proof))
+			for _, step := range proof.Proof {
+				stepHash, _ := hex.DecodeString(step.Hash)
+				proofSteps = append(proofSteps, &common.OutGoingOperationProofStep{
+					Hash:   stepHash,
+					IsLeft: step.IsLeft,
+				})
+			}
+			require.True(t, common.VerifyOutGoingOperationMerkleProof(hasher, opHash, proofSteps, merkleRoot))
+		}
+
+		for idx, opHash := range batch1OpsHashes {
+			checkProof(opHash, idx, batch1MerkleRoot, keccakHasher)
+		}
+		for idx, opHash := range batch2OpsHashes {
+			checkProof(opHash, idx, batch2MerkleRoot, sha256Hasher)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package sovereignAPI
+
+import "errors"
+
+// ErrNilOutGoingOperationsPool signals that a nil outgoing operations pool has been provided
+var ErrNilOutGoingOperationsPool = errors.New("nil outgoing operations pool")
+
+// ErrNilCrossNotarizedHeadersTracker signals that a nil cross notarized headers tracker has been provided
+var ErrNilCrossNotarizedHeadersTracker = errors.New("nil cross notarized headers tracker")
+
+// ErrOutGoingOperationsNotFound signals that the requested outgoing operations could not be found in the pool
+var ErrOutGoingOperationsNotFound = errors.New("outgoing operations not found")
+
+// ErrNoCrossNotarizedIncomingHeader signals that no header of the requested incoming chain has been cross notarized yet
+var ErrNoCrossNotarizedIncomingHeader = errors.New("no cross notarized incoming header")
+
+// ErrNilIncomingChainsHandler signals that a nil incoming chains handler has been provided
+var ErrNilIncomingChainsHandler = errors.New("nil incoming chains handler")
+
+// ErrUnknownIncomingChain signals that the requested incoming chain is not known
+var ErrUnknownIncomingChain = errors.New("unknown incoming chain")
+
+// ErrNilBridgePauseHandler signals that a nil bridge pause handler has been provided
+var ErrNilBridgePauseHandler = errors.New("nil bridge pause handler")
+
+// ErrNilHistoryRepository signals that a nil history repository has been provided
+var ErrNilHistoryRepository = errors.New("nil history repository")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNoOperationsHashers signals that no outgoing operations hashers have been provided
+var ErrNoOperationsHashers = errors.New("no outgoing operations hashers")
+
+// ErrDBLookExtensionIsNotEnabled signals that the db look extension is not enabled
+var ErrDBLookExtensionIsNotEnabled = errors.New("db look extension is not enabled")
+
+// ErrOutGoingOperationNotFound signals that the requested outgoing operation could not be found in any sovereign block
+var ErrOutGoingOperationNotFound = errors.New("outgoing operation not found")
+
+// ErrOutGoingOperationsBatchNotFound signals that the operations of an outgoing batch could not be matched against its
+// merkle root
+var ErrOutGoingOperationsBatchNotFound = errors.New("outgoing operations batch not found")
+
+// ErrNilOperationsHasher signals that a nil outgoing operations hasher has been provided
+var ErrNilOperationsHasher = errors.New("nil outgoing operations hasher")
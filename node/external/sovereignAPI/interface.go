@@ -0,0 +1,46 @@
+package sovereignAPI
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-go/dblookupext"
+)
+
+// OutGoingOperationsPool defines what an outgoing operations pool should be able to provide to the API
+type OutGoingOperationsPool interface {
+	Get(hash []byte) *sovereignCore.BridgeOutGoingData
+	GetByOperationHash(hash []byte) *sovereignCore.BridgeOutGoingData
+	GetUnconfirmedOperations() []*sovereignCore.BridgeOutGoingData
+	GetDeadLetteredOperations() []*sovereignCore.BridgeOutGoingData
+	RequeueDeadLetteredOperations(hash []byte) error
+	DropDeadLetteredOperations(hash []byte) error
+	IsInterfaceNil() bool
+}
+
+// CrossNotarizedHeadersTracker defines what a tracker of cross notarized headers should be able to provide to the API
+type CrossNotarizedHeadersTracker interface {
+	GetLastCrossNotarizedHeader(shardID uint32) (data.HeaderHandler, []byte, error)
+	IsInterfaceNil() bool
+}
+
+// IncomingChainsHandler defines what a handler of the incoming chains should be able to provide to the API
+type IncomingChainsHandler interface {
+	GetShardIDForChainID(chainID string) (uint32, bool)
+	IsInterfaceNil() bool
+}
+
+// BridgePauseHandler defines what a bridge pause handler should be able to provide to the API
+type BridgePauseHandler interface {
+	IsIncomingPaused() bool
+	IsOutGoingPaused() bool
+	IsInterfaceNil() bool
+}
+
+// MiniBlocksHistoryRepository defines what a history repository should be able to provide to the API, in order to find
+// the sovereign block which holds an outgoing operation
+type MiniBlocksHistoryRepository interface {
+	GetMiniblockMetadataByTxHash(hash []byte) (*dblookupext.MiniblockMetadata, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
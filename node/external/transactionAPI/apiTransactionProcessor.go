@@ -124,7 +124,31 @@ func (atp *apiTransactionProcessor) GetSCRsByTxHash(txHash string, scrHash strin
 		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
 	}
 
-	resultsHashes, err := atp.historyRepository.GetResultsHashesByTxHash(decodedTxHash, miniblockMetadata.Epoch)
+	return atp.getSCRsByOriginalTxHash(decodedTxHash, miniblockMetadata.Epoch)
+}
+
+// GetIncomingSCRsByMainChainTxHash will return the list of incoming smart contract results which were created in the
+// sovereign chain from the events of the provided main chain tx hash
+func (atp *apiTransactionProcessor) GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+	decodedTxHash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !atp.historyRepository.IsEnabled() {
+		return nil, fmt.Errorf("cannot return smat contract results: %w", ErrDBLookExtensionIsNotEnabled)
+	}
+
+	epoch, err := atp.historyRepository.GetEpochByHash(decodedTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+
+	return atp.getSCRsByOriginalTxHash(decodedTxHash, epoch)
+}
+
+func (atp *apiTransactionProcessor) getSCRsByOriginalTxHash(txHash []byte, epoch uint32) ([]*transaction.ApiSmartContractResult, error) {
+	resultsHashes, err := atp.historyRepository.GetResultsHashesByTxHash(txHash, epoch)
 	if err != nil {
 		// It's perfectly normal to have transactions without SCRs.
 		if errors.Is(err, dblookupext.ErrNotFoundInStorage) {
@@ -159,7 +183,10 @@ func (atp *apiTransactionProcessor) GetTransaction(txHash string, withResults bo
 		return nil, err
 	}
 
-	tx.Hash = txHash
+	// the hash is already set when a main chain tx hash was resolved to its incoming scrs
+	if len(tx.Hash) == 0 {
+		tx.Hash = txHash
+	}
 	atp.PopulateComputedFields(tx)
 
 	if withResults {
@@ -533,6 +560,11 @@ func (atp *apiTransactionProcessor) computeTimestampForRound(round uint64) int64
 func (atp *apiTransactionProcessor) lookupHistoricalTransaction(hash []byte, withResults bool) (*transaction.ApiTransactionResult, error) {
 	miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(hash)
 	if err != nil {
+		tx, found, errIncoming := atp.lookupIncomingSCRsByMainChainTxHash(hash)
+		if found {
+			return tx, errIncoming
+		}
+
 		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
 	}
 
@@ -602,6 +634,48 @@ func putMiniblockFieldsInTransaction(tx *transaction.ApiTransactionResult, minib
 	return tx
 }
 
+// lookupIncomingSCRsByMainChainTxHash resolves a main chain tx hash to the incoming scrs created from its events. The first
+// incoming scr is returned as the transaction, while all the incoming scrs created from the main chain tx are returned
+// as its smart contract results
+func (atp *apiTransactionProcessor) lookupIncomingSCRsByMainChainTxHash(hash []byte) (*transaction.ApiTransactionResult, bool, error) {
+	epoch, err := atp.historyRepository.GetEpochByHash(hash)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	resultsHashes, err := atp.historyRepository.GetResultsHashesByTxHash(hash, epoch)
+	if err != nil || resultsHashes == nil {
+		return nil, false, nil
+	}
+
+	incomingSCRs, err := atp.getSCRsByOriginalTxHash(hash, epoch)
+	if err != nil {
+		return nil, true, err
+	}
+	if len(incomingSCRs) == 0 {
+		return nil, false, nil
+	}
+
+	firstSCRHash, err := hex.DecodeString(incomingSCRs[0].Hash)
+	if err != nil {
+		return nil, true, err
+	}
+
+	_, err = atp.historyRepository.GetMiniblockMetadataByTxHash(firstSCRHash)
+	if err != nil {
+		return nil, true, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+
+	tx, err := atp.lookupHistoricalTransaction(firstSCRHash, false)
+	if err != nil {
+		return nil, true, err
+	}
+
+	tx.Hash = incomingSCRs[0].Hash
+	tx.SmartContractResults = incomingSCRs
+	return tx, true, nil
+}
+
 func (atp *apiTransactionProcessor) getTransactionFromStorage(hash []byte) (*transaction.ApiTransactionResult, error) {
 	txBytes, txType, found := atp.getTxBytesFromStorage(hash)
 	if !found {
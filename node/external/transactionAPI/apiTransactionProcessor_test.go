@@ -392,6 +392,190 @@ func TestNode_GetSCRs(t *testing.T) {
 	}, scrs[0])
 }
 
+func TestNode_GetIncomingSCRsByMainChainTxHash(t *testing.T) {
+	t.Parallel()
+
+	scResultHash := []byte("scHash")
+	mainChainTxHash := []byte("mainChainTxHash")
+	incomingEpoch := uint32(2)
+	expectedErr := errors.New("expected error")
+
+	marshalizer := &mock.MarshalizerFake{}
+	scResult := &smartContractResult.SmartContractResult{
+		Nonce:          1,
+		SndAddr:        []byte("snd"),
+		RcvAddr:        []byte("rcv"),
+		OriginalTxHash: mainChainTxHash,
+		Data:           []byte("test"),
+	}
+
+	chainStorer := &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			switch unitType {
+			case dataRetriever.UnsignedTransactionUnit:
+				return &storageStubs.StorerStub{
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						require.Equal(t, incomingEpoch, epoch)
+						return marshalizer.Marshal(scResult)
+					},
+				}, nil
+			default:
+				return nil, storage.ErrKeyNotFound
+			}
+		},
+	}
+
+	historyRepo := &dblookupextMock.HistoryRepositoryStub{
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			if bytes.Equal(hash, mainChainTxHash) {
+				return incomingEpoch, nil
+			}
+			return 0, expectedErr
+		},
+		GetEventsHashesByTxHashCalled: func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			require.Equal(t, mainChainTxHash, hash)
+			require.Equal(t, incomingEpoch, epoch)
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{
+						Epoch:           incomingEpoch,
+						ScResultsHashes: [][]byte{scResultHash},
+					},
+				},
+			}, nil
+		},
+	}
+
+	args := createMockArgAPITransactionProcessor()
+	args.Marshalizer = marshalizer
+	args.HistoryRepository = historyRepo
+	args.StorageService = chainStorer
+	apiTransactionProc, _ := NewAPITransactionProcessor(args)
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		scrs, err := apiTransactionProc.GetIncomingSCRsByMainChainTxHash("not hex")
+		require.NotNil(t, err)
+		require.Nil(t, scrs)
+	})
+	t.Run("unknown main chain tx hash should error", func(t *testing.T) {
+		scrs, err := apiTransactionProc.GetIncomingSCRsByMainChainTxHash(hex.EncodeToString([]byte("unknown")))
+		require.True(t, errors.Is(err, expectedErr))
+		require.Nil(t, scrs)
+	})
+	t.Run("should work", func(t *testing.T) {
+		scrs, err := apiTransactionProc.GetIncomingSCRsByMainChainTxHash(hex.EncodeToString(mainChainTxHash))
+		require.Nil(t, err)
+		require.Equal(t, 1, len(scrs))
+		require.Equal(t, hex.EncodeToString(scResultHash), scrs[0].Hash)
+		require.Equal(t, hex.EncodeToString(mainChainTxHash), scrs[0].OriginalTxHash)
+	})
+}
+
+func TestNode_GetTransactionByMainChainTxHash(t *testing.T) {
+	t.Parallel()
+
+	scResultHash := []byte("scHash")
+	secondSCResultHash := []byte("scHash2")
+	mainChainTxHash := []byte("mainChainTxHash")
+	incomingEpoch := uint32(2)
+
+	marshalizer := &mock.MarshalizerFake{}
+	scResult := &smartContractResult.SmartContractResult{
+		Nonce:          1,
+		Value:          big.NewInt(0),
+		SndAddr:        []byte("snd"),
+		RcvAddr:        []byte("rcv"),
+		OriginalTxHash: mainChainTxHash,
+		PrevTxHash:     mainChainTxHash,
+		Data:           []byte("test"),
+	}
+
+	getStorerWithData := func(key []byte, epoch uint32) ([]byte, error) {
+		if !bytes.Equal(key, scResultHash) && !bytes.Equal(key, secondSCResultHash) {
+			return nil, storage.ErrKeyNotFound
+		}
+		require.Equal(t, incomingEpoch, epoch)
+		return marshalizer.Marshal(scResult)
+	}
+	chainStorer := &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			switch unitType {
+			case dataRetriever.UnsignedTransactionUnit:
+				return &storageStubs.StorerStub{
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						return getStorerWithData(key, epoch)
+					},
+				}, nil
+			default:
+				return &storageStubs.StorerStub{
+					GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+						return nil, storage.ErrKeyNotFound
+					},
+				}, nil
+			}
+		},
+	}
+
+	historyRepo := &dblookupextMock.HistoryRepositoryStub{
+		GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			if bytes.Equal(hash, scResultHash) || bytes.Equal(hash, secondSCResultHash) {
+				return &dblookupext.MiniblockMetadata{
+					Type:          int32(block.SmartContractResultBlock),
+					Epoch:         incomingEpoch,
+					SourceShardID: core.MainChainShardId,
+				}, nil
+			}
+			return nil, storage.ErrKeyNotFound
+		},
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			if bytes.Equal(hash, mainChainTxHash) {
+				return incomingEpoch, nil
+			}
+			return 0, storage.ErrKeyNotFound
+		},
+		GetEventsHashesByTxHashCalled: func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+			require.Equal(t, mainChainTxHash, hash)
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{
+						Epoch:           incomingEpoch,
+						ScResultsHashes: [][]byte{scResultHash, secondSCResultHash},
+					},
+				},
+			}, nil
+		},
+	}
+
+	args := createMockArgAPITransactionProcessor()
+	args.Marshalizer = marshalizer
+	args.HistoryRepository = historyRepo
+	args.StorageService = chainStorer
+	args.DataPool = dataRetrieverMock.NewPoolsHolderMock()
+	apiTransactionProc, _ := NewAPITransactionProcessor(args)
+
+	t.Run("unknown hash should error", func(t *testing.T) {
+		tx, err := apiTransactionProc.GetTransaction(hex.EncodeToString([]byte("unknown")), false)
+		require.True(t, errors.Is(err, storage.ErrKeyNotFound))
+		require.Nil(t, tx)
+	})
+	t.Run("main chain tx hash should return all the incoming scrs", func(t *testing.T) {
+		tx, err := apiTransactionProc.GetTransaction(hex.EncodeToString(mainChainTxHash), false)
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString(scResultHash), tx.Hash)
+		require.Equal(t, hex.EncodeToString(mainChainTxHash), tx.OriginalTransactionHash)
+		require.Equal(t, string(transaction.TxTypeUnsigned), tx.Type)
+		require.Len(t, tx.SmartContractResults, 2)
+		require.Equal(t, hex.EncodeToString(scResultHash), tx.SmartContractResults[0].Hash)
+		require.Equal(t, hex.EncodeToString(secondSCResultHash), tx.SmartContractResults[1].Hash)
+	})
+	t.Run("incoming scr hash should return the incoming scr", func(t *testing.T) {
+		tx, err := apiTransactionProc.GetTransaction(hex.EncodeToString(scResultHash), false)
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString(scResultHash), tx.Hash)
+		require.Equal(t, hex.EncodeToString(mainChainTxHash), tx.OriginalTransactionHash)
+	})
+}
+
 func TestNode_GetTransactionFromStorage(t *testing.T) {
 	t.Parallel()
 
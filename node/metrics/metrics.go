@@ -43,6 +43,13 @@ func InitBaseMetrics(appStatusHandler core.AppStatusHandler) error {
 	appStatusHandler.SetUInt64Value(common.MetricNumShardHeadersFromPool, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricNumShardHeadersProcessed, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricNumTimesInForkChoice, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricNumIncomingHeadersWithInvalidProofs, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricNumRevertedIncomingHeaders, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricNumOutGoingOperationsRetries, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricNumOutGoingOperationsSendFailures, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricNumDeadLetteredOutGoingOperations, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricNumRateLimitedIncomingHeaders, initUint)
+	appStatusHandler.SetUInt64Value(common.MetricNumRateLimitedOutGoingOperations, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricHighestFinalBlock, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricCountConsensusAcceptedBlocks, initUint)
 	appStatusHandler.SetUInt64Value(common.MetricRoundsPassedInCurrentEpoch, initUint)
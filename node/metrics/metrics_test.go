@@ -36,6 +36,13 @@ func TestInitBaseMetrics(t *testing.T) {
 		common.MetricNumShardHeadersFromPool,
 		common.MetricNumShardHeadersProcessed,
 		common.MetricNumTimesInForkChoice,
+		common.MetricNumIncomingHeadersWithInvalidProofs,
+		common.MetricNumRevertedIncomingHeaders,
+		common.MetricNumOutGoingOperationsRetries,
+		common.MetricNumOutGoingOperationsSendFailures,
+		common.MetricNumDeadLetteredOutGoingOperations,
+		common.MetricNumRateLimitedIncomingHeaders,
+		common.MetricNumRateLimitedOutGoingOperations,
 		common.MetricHighestFinalBlock,
 		common.MetricCountConsensusAcceptedBlocks,
 		common.MetricRoundsPassedInCurrentEpoch,
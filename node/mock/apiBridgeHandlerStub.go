@@ -0,0 +1,92 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// APIBridgeHandlerStub -
+type APIBridgeHandlerStub struct {
+	GetUnconfirmedOutGoingOperationsCalled      func() []*common.OutGoingOperationsBatchAPIResponse
+	GetOutGoingOperationsCalled                 func(hash string) (*common.OutGoingOperationsBatchAPIResponse, error)
+	GetLastCrossNotarizedIncomingHeaderCalled   func(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error)
+	GetDeadLetteredOutGoingOperationsCalled     func() []*common.OutGoingOperationsBatchAPIResponse
+	RequeueDeadLetteredOutGoingOperationsCalled func(hash string) error
+	DropDeadLetteredOutGoingOperationsCalled    func(hash string) error
+	GetBridgePauseStateCalled                   func() *common.BridgePauseStateAPIResponse
+	GetOutGoingOperationProofCalled             func(hash string) (*common.OutGoingOperationProofAPIResponse, error)
+}
+
+// GetUnconfirmedOutGoingOperations -
+func (stub *APIBridgeHandlerStub) GetUnconfirmedOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	if stub.GetUnconfirmedOutGoingOperationsCalled != nil {
+		return stub.GetUnconfirmedOutGoingOperationsCalled()
+	}
+
+	return nil
+}
+
+// GetOutGoingOperations -
+func (stub *APIBridgeHandlerStub) GetOutGoingOperations(hash string) (*common.OutGoingOperationsBatchAPIResponse, error) {
+	if stub.GetOutGoingOperationsCalled != nil {
+		return stub.GetOutGoingOperationsCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetLastCrossNotarizedIncomingHeader -
+func (stub *APIBridgeHandlerStub) GetLastCrossNotarizedIncomingHeader(chainID string) (*common.CrossNotarizedHeaderAPIResponse, error) {
+	if stub.GetLastCrossNotarizedIncomingHeaderCalled != nil {
+		return stub.GetLastCrossNotarizedIncomingHeaderCalled(chainID)
+	}
+
+	return nil, nil
+}
+
+// GetDeadLetteredOutGoingOperations -
+func (stub *APIBridgeHandlerStub) GetDeadLetteredOutGoingOperations() []*common.OutGoingOperationsBatchAPIResponse {
+	if stub.GetDeadLetteredOutGoingOperationsCalled != nil {
+		return stub.GetDeadLetteredOutGoingOperationsCalled()
+	}
+
+	return nil
+}
+
+// RequeueDeadLetteredOutGoingOperations -
+func (stub *APIBridgeHandlerStub) RequeueDeadLetteredOutGoingOperations(hash string) error {
+	if stub.RequeueDeadLetteredOutGoingOperationsCalled != nil {
+		return stub.RequeueDeadLetteredOutGoingOperationsCalled(hash)
+	}
+
+	return nil
+}
+
+// DropDeadLetteredOutGoingOperations -
+func (stub *APIBridgeHandlerStub) DropDeadLetteredOutGoingOperations(hash string) error {
+	if stub.DropDeadLetteredOutGoingOperationsCalled != nil {
+		return stub.DropDeadLetteredOutGoingOperationsCalled(hash)
+	}
+
+	return nil
+}
+
+// GetBridgePauseState -
+func (stub *APIBridgeHandlerStub) GetBridgePauseState() *common.BridgePauseStateAPIResponse {
+	if stub.GetBridgePauseStateCalled != nil {
+		return stub.GetBridgePauseStateCalled()
+	}
+
+	return nil
+}
+
+// GetOutGoingOperationProof -
+func (stub *APIBridgeHandlerStub) GetOutGoingOperationProof(hash string) (*common.OutGoingOperationProofAPIResponse, error) {
+	if stub.GetOutGoingOperationProofCalled != nil {
+		return stub.GetOutGoingOperationProofCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *APIBridgeHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -16,6 +16,7 @@ type TransactionAPIHandlerStub struct {
 	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
 	GetSCRsByTxHashCalled                       func(txHash string, scrHash string) ([]*transaction.ApiSmartContractResult, error)
+	GetIncomingSCRsByMainChainTxHashCalled      func(txHash string) ([]*transaction.ApiSmartContractResult, error)
 }
 
 // GetSCRsByTxHash --
@@ -27,6 +28,15 @@ func (tas *TransactionAPIHandlerStub) GetSCRsByTxHash(txHash string, scrHash str
 	return nil, nil
 }
 
+// GetIncomingSCRsByMainChainTxHash -
+func (tas *TransactionAPIHandlerStub) GetIncomingSCRsByMainChainTxHash(txHash string) ([]*transaction.ApiSmartContractResult, error) {
+	if tas.GetIncomingSCRsByMainChainTxHashCalled != nil {
+		return tas.GetIncomingSCRsByMainChainTxHashCalled(txHash)
+	}
+
+	return nil, nil
+}
+
 // GetTransaction -
 func (tas *TransactionAPIHandlerStub) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	if tas.GetTransactionCalled != nil {
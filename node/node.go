@@ -28,6 +28,7 @@ import (
 
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/consensus/equivocation"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/facade"
@@ -1122,6 +1123,69 @@ func (n *Node) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
 	return monitor.GetHeartbeats()
 }
 
+// GetEquivocationProofs returns the proofs of the validators which sent conflicting consensus messages
+func (n *Node) GetEquivocationProofs() []*equivocation.Proof {
+	if check.IfNil(n.consensusComponents) {
+		return make([]*equivocation.Proof, 0)
+	}
+
+	equivocationDetector := n.consensusComponents.EquivocationDetector()
+	if check.IfNil(equivocationDetector) {
+		return make([]*equivocation.Proof, 0)
+	}
+
+	return equivocationDetector.GetProofs()
+}
+
+// AddManagedKey schedules the addition of the provided hex encoded private key as a managed key, effective from the
+// next round. It returns the hex encoded public key
+func (n *Node) AddManagedKey(privateKeyHex string) (string, error) {
+	reloader, err := n.getManagedKeysReloader()
+	if err != nil {
+		return "", err
+	}
+
+	privateKeyBytes, err := hex.DecodeString(privateKeyHex)
+	if err != nil {
+		return "", fmt.Errorf("%w for the private key", err)
+	}
+
+	pkBytes, err := reloader.AddManagedKey(privateKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return n.coreComponents.ValidatorPubKeyConverter().Encode(pkBytes)
+}
+
+// RemoveManagedKey schedules the removal of the managed key defined by the provided public key, effective from the next round
+func (n *Node) RemoveManagedKey(publicKey string) error {
+	reloader, err := n.getManagedKeysReloader()
+	if err != nil {
+		return err
+	}
+
+	pkBytes, err := n.coreComponents.ValidatorPubKeyConverter().Decode(publicKey)
+	if err != nil {
+		return fmt.Errorf("%w for the public key %s", err, publicKey)
+	}
+
+	return reloader.RemoveManagedKey(pkBytes)
+}
+
+func (n *Node) getManagedKeysReloader() (common.ManagedKeysReloader, error) {
+	if check.IfNil(n.cryptoComponents) {
+		return nil, ErrNilCryptoComponents
+	}
+
+	reloader := n.cryptoComponents.ManagedKeysReloader()
+	if check.IfNil(reloader) {
+		return nil, ErrNilManagedKeysReloader
+	}
+
+	return reloader, nil
+}
+
 // ValidatorStatisticsApi will return the statistics for all the validators from the initial nodes pub keys
 func (n *Node) ValidatorStatisticsApi() (map[string]*validator.ValidatorStatistics, error) {
 	return n.processComponents.ValidatorsProvider().GetLatestValidators(), nil
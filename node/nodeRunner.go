@@ -53,6 +53,7 @@ import (
 	"github.com/multiversx/mx-chain-go/factory/statusCore"
 	"github.com/multiversx/mx-chain-go/genesis/parsing"
 	"github.com/multiversx/mx-chain-go/health"
+	"github.com/multiversx/mx-chain-go/keysManagement/keyStore"
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/process"
@@ -820,6 +821,7 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 		Facade:          initialFacade,
 		ApiConfig:       *nr.configs.ApiRoutesConfig,
 		AntiFloodConfig: nr.configs.GeneralConfig.WebServerAntiflood,
+		ChainRunType:    common.ChainRunTypeRegular,
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -917,6 +919,11 @@ func (nr *nodeRunner) CreateManagedConsensusComponents(
 		return nil, err
 	}
 
+	signingJournal, err := consensusComp.CreateSigningJournal(nr.configs.GeneralConfig.SlashingProtection, nr.configs.FlagsConfig.WorkingDir)
+	if err != nil {
+		return nil, err
+	}
+
 	consensusArgs := consensusComp.ConsensusComponentsFactoryArgs{
 		Config:                *nr.configs.GeneralConfig,
 		FlagsConfig:           *nr.configs.FlagsConfig,
@@ -935,6 +942,7 @@ func (nr *nodeRunner) CreateManagedConsensusComponents(
 		RunTypeComponents:     runTypeComponents,
 		ExtraSignersHolder:    bls.NewEmptyExtraSignersHolder(),
 		SubRoundEndV2Creator:  bls.NewSubRoundEndV2Creator(),
+		SigningJournal:        signingJournal,
 	}
 
 	consensusFactory, err := consensusComp.NewConsensusComponentsFactory(consensusArgs)
@@ -1558,7 +1566,7 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 		PrefsConfig:                          *configs.PreferencesConfig,
 		CoreComponentsHolder:                 coreComponents,
 		ActivateBLSPubKeyMessageVerification: configs.SystemSCConfig.StakingSystemSCConfig.ActivateBLSPubKeyMessageVerification,
-		KeyLoader:                            core.NewKeyLoader(),
+		KeyLoader:                            keyStore.NewKeyLoader(configs.ConfigurationPathsHolder.ValidatorKeysPassphrase),
 		ImportModeNoSigCheck:                 configs.ImportDbConfig.ImportDbNoSigCheckFlag,
 		IsInImportMode:                       configs.ImportDbConfig.IsImportDBMode,
 		EnableEpochs:                         configs.EpochConfig.EnableEpochs,
@@ -305,6 +305,8 @@ func initStore() *dataRetriever.ChainStorer {
 	store.AddStorer(dataRetriever.ReceiptsUnit, generateTestUnit())
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, generateTestUnit())
 	store.AddStorer(dataRetriever.ScheduledSCRsUnit, generateTestUnit())
+	store.AddStorer(dataRetriever.OutGoingOperationsUnit, generateTestUnit())
+	store.AddStorer(dataRetriever.IncomingHeadersQueueUnit, generateTestUnit())
 	return store
 }
 
@@ -715,3 +715,43 @@ func (schv *sovereignChainHeaderValidator) CalculateHeaderHash(headerHandler dat
 func (scbp *sovereignChainBlockProcessor) CreateAndSetOutGoingMiniBlock(headerHandler data.HeaderHandler, createdBlockBody *block.Body) error {
 	return scbp.createAndSetOutGoingMiniBlock(headerHandler, createdBlockBody)
 }
+
+// CreateAndSetValidatorSetRotationMiniBlock -
+func (scbp *sovereignChainBlockProcessor) CreateAndSetValidatorSetRotationMiniBlock(header data.HeaderHandler, body *block.Body) error {
+	return scbp.createAndSetValidatorSetRotationMiniBlock(header, body)
+}
+
+// CreateIncomingMiniBlocksDestMe -
+func (scbp *sovereignChainBlockProcessor) CreateIncomingMiniBlocksDestMe(haveTime func() bool) (block.MiniBlockSlice, uint32, error) {
+	createAndProcessInfo, err := scbp.createIncomingMiniBlocksDestMe(haveTime, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return createAndProcessInfo.miniBlocks, createAndProcessInfo.numHdrsAdded, nil
+}
+
+// CheckIncomingRateLimits -
+func (scbp *sovereignChainBlockProcessor) CheckIncomingRateLimits(sovChainHeader data.SovereignChainHeaderHandler, epoch uint32) error {
+	return scbp.checkIncomingRateLimits(sovChainHeader, epoch)
+}
+
+// CheckExtendedShardHeadersValidity -
+func (scbp *sovereignChainBlockProcessor) CheckExtendedShardHeadersValidity(sovChainHeader data.SovereignChainHeaderHandler) error {
+	return scbp.checkExtendedShardHeadersValidity(sovChainHeader)
+}
+
+// RequestExtendedShardHeadersIfNeeded -
+func (scbp *sovereignChainBlockProcessor) RequestExtendedShardHeadersIfNeeded(shardID uint32, hdrsAdded uint32, lastExtendedShardHdr data.HeaderHandler) {
+	scbp.requestExtendedShardHeadersIfNeeded(shardID, hdrsAdded, lastExtendedShardHdr)
+}
+
+// LoadBridgePauseState -
+func (scbp *sovereignChainBlockProcessor) LoadBridgePauseState() {
+	scbp.loadBridgePauseState()
+}
+
+// ProcessUnconfirmedOutGoingOperations -
+func (scbp *sovereignChainBlockProcessor) ProcessUnconfirmedOutGoingOperations(header data.HeaderHandler) {
+	scbp.processUnconfirmedOutGoingOperations(header)
+}
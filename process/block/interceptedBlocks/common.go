@@ -122,13 +122,17 @@ func checkShardData(sd data.ShardDataHandler, coordinator sharding.Coordinator)
 	return nil
 }
 
-func checkMiniBlocksHeaders(mbHeaders []data.MiniBlockHeaderHandler, coordinator sharding.Coordinator, acceptedCrossShardID uint32) error {
+func isMetachainShardID(shardID uint32) bool {
+	return shardID == core.MetachainShardId
+}
+
+func checkMiniBlocksHeaders(mbHeaders []data.MiniBlockHeaderHandler, coordinator sharding.Coordinator, isAcceptedCrossShardID func(shardID uint32) bool) error {
 	for _, mbHeader := range mbHeaders {
 		isWrongSenderShardId := mbHeader.GetSenderShardID() >= coordinator.NumberOfShards() &&
-			mbHeader.GetSenderShardID() != acceptedCrossShardID &&
+			!isAcceptedCrossShardID(mbHeader.GetSenderShardID()) &&
 			mbHeader.GetSenderShardID() != core.AllShardId
 		isWrongDestinationShardId := mbHeader.GetReceiverShardID() >= coordinator.NumberOfShards() &&
-			mbHeader.GetReceiverShardID() != acceptedCrossShardID &&
+			!isAcceptedCrossShardID(mbHeader.GetReceiverShardID()) &&
 			mbHeader.GetReceiverShardID() != core.AllShardId
 		isWrongShardId := isWrongSenderShardId || isWrongDestinationShardId
 		if isWrongShardId {
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"testing"
 
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/stretchr/testify/assert"
@@ -455,8 +454,8 @@ func TestCheckMiniBlocksHeaders_WithNilOrEmptyShouldReturnNil(t *testing.T) {
 
 	shardCoordinator := mock.NewOneShardCoordinatorMock()
 
-	err1 := checkMiniBlocksHeaders(nil, shardCoordinator, core.MainChainShardId)
-	err2 := checkMiniBlocksHeaders(make([]data.MiniBlockHeaderHandler, 0), shardCoordinator, core.MainChainShardId)
+	err1 := checkMiniBlocksHeaders(nil, shardCoordinator, process.IsIncomingChainShardID)
+	err2 := checkMiniBlocksHeaders(make([]data.MiniBlockHeaderHandler, 0), shardCoordinator, process.IsIncomingChainShardID)
 
 	assert.Nil(t, err1)
 	assert.Nil(t, err2)
@@ -475,7 +474,7 @@ func TestCheckMiniBlocksHeaders_WrongMiniblockSenderShardIdShouldErr(t *testing.
 		Type:            0,
 	}
 
-	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, core.MainChainShardId)
+	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, process.IsIncomingChainShardID)
 
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
@@ -493,7 +492,7 @@ func TestCheckMiniBlocksHeaders_WrongMiniblockReceiverShardIdShouldErr(t *testin
 		Type:            0,
 	}
 
-	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, core.MainChainShardId)
+	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, process.IsIncomingChainShardID)
 
 	assert.Equal(t, process.ErrInvalidShardId, err)
 }
@@ -511,7 +510,7 @@ func TestCheckMiniBlocksHeaders_ReservedPopulatedShouldErr(t *testing.T) {
 		Reserved:        []byte("rrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrrr"),
 	}
 
-	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, core.MainChainShardId)
+	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, process.IsIncomingChainShardID)
 
 	assert.Equal(t, process.ErrReservedFieldInvalid, err)
 }
@@ -529,7 +528,7 @@ func TestCheckMiniBlocksHeaders_ReservedPopulatedCorrectly(t *testing.T) {
 		Reserved:        []byte("r"),
 	}
 
-	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, core.MainChainShardId)
+	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, process.IsIncomingChainShardID)
 
 	assert.Nil(t, err)
 }
@@ -546,7 +545,7 @@ func TestCheckMiniBlocksHeaders_OkValsShouldWork(t *testing.T) {
 		Type:            0,
 	}
 
-	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, core.MainChainShardId)
+	err := checkMiniBlocksHeaders([]data.MiniBlockHeaderHandler{&miniblockHeader}, shardCoordinator, process.IsIncomingChainShardID)
 
 	assert.Nil(t, err)
 }
@@ -150,7 +150,7 @@ func (inHdr *InterceptedHeader) integrity() error {
 }
 
 func (inHdr *InterceptedHeader) checkMiniBlocksHeaders(mbHeaders []data.MiniBlockHeaderHandler, coordinator sharding.Coordinator) error {
-	return checkMiniBlocksHeaders(mbHeaders, coordinator, core.MetachainShardId)
+	return checkMiniBlocksHeaders(mbHeaders, coordinator, isMetachainShardID)
 }
 
 // Hash gets the hash of this header
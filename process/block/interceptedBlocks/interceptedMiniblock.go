@@ -82,7 +82,7 @@ func (inMb *InterceptedMiniblock) Miniblock() *block.MiniBlock {
 
 // CheckValidity checks if the received tx block body is valid (not nil fields)
 func (inMb *InterceptedMiniblock) CheckValidity() error {
-	return inMb.integrity(core.MetachainShardId)
+	return inMb.integrity(isMetachainShardID)
 }
 
 // IsForCurrentShard returns true if at least one contained miniblock is for current shard
@@ -91,17 +91,17 @@ func (inMb *InterceptedMiniblock) IsForCurrentShard() bool {
 }
 
 // integrity checks the integrity of the tx block body
-func (inMb *InterceptedMiniblock) integrity(acceptedCrossShardId uint32) error {
+func (inMb *InterceptedMiniblock) integrity(isAcceptedCrossShardID func(shardID uint32) bool) error {
 	miniblock := inMb.miniblock
 
 	receiverNotCurrentShard := miniblock.ReceiverShardID >= inMb.shardCoordinator.NumberOfShards() &&
-		(miniblock.ReceiverShardID != acceptedCrossShardId && miniblock.ReceiverShardID != core.AllShardId)
+		(!isAcceptedCrossShardID(miniblock.ReceiverShardID) && miniblock.ReceiverShardID != core.AllShardId)
 	if receiverNotCurrentShard {
 		return process.ErrInvalidShardId
 	}
 
 	senderNotCurrentShard := miniblock.SenderShardID >= inMb.shardCoordinator.NumberOfShards() &&
-		miniblock.SenderShardID != acceptedCrossShardId
+		!isAcceptedCrossShardID(miniblock.SenderShardID)
 	if senderNotCurrentShard {
 		return process.ErrInvalidShardId
 	}
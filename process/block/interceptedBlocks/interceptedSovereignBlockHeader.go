@@ -1,8 +1,8 @@
 package interceptedBlocks
 
 import (
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 )
 
@@ -26,7 +26,7 @@ func NewSovereignInterceptedBlockHeader(arg *ArgInterceptedBlockHeader) (*interc
 }
 
 func (isbh *interceptedSovereignBlockHeader) checkMiniBlocksHeaders(mbHeaders []data.MiniBlockHeaderHandler, coordinator sharding.Coordinator) error {
-	return checkMiniBlocksHeaders(mbHeaders, coordinator, core.MainChainShardId)
+	return checkMiniBlocksHeaders(mbHeaders, coordinator, process.IsIncomingChainShardID)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -59,6 +59,11 @@ func TestInterceptedSovereignMiniBlock_checkMiniBlocksHeaders(t *testing.T) {
 	err = sovInterceptedBlock.CheckMiniBlocksHeaders(miniBlockHeaders, args.ShardCoordinator)
 	require.Nil(t, err)
 
+	err = miniBlockHeaders[0].SetSenderShardID(core.MainChainShardId - 1)
+	require.Nil(t, err)
+	err = sovInterceptedBlock.CheckMiniBlocksHeaders(miniBlockHeaders, args.ShardCoordinator)
+	require.Nil(t, err)
+
 	err = miniBlockHeaders[0].SetReceiverShardID(core.MetachainShardId)
 	require.Nil(t, err)
 	err = sovInterceptedBlock.CheckMiniBlocksHeaders(miniBlockHeaders, args.ShardCoordinator)
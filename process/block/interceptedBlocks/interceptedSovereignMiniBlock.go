@@ -1,6 +1,6 @@
 package interceptedBlocks
 
-import "github.com/multiversx/mx-chain-core-go/core"
+import "github.com/multiversx/mx-chain-go/process"
 
 type interceptedSovereignMiniBlock struct {
 	*InterceptedMiniblock
@@ -20,7 +20,7 @@ func NewInterceptedSovereignMiniBlock(arg *ArgInterceptedMiniblock) (*intercepte
 
 // CheckValidity checks if the received tx block body is valid (not nil fields)
 func (ismb *interceptedSovereignMiniBlock) CheckValidity() error {
-	return ismb.integrity(core.MainChainShardId)
+	return ismb.integrity(process.IsIncomingChainShardID)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -53,4 +53,8 @@ func TestInterceptedSovereignMiniBlock_CheckValidity(t *testing.T) {
 	sovMBInterceptor = createSovMBInterceptorWithMBInShard(core.MainChainShardId)
 	err = sovMBInterceptor.CheckValidity()
 	require.Nil(t, err)
+
+	sovMBInterceptor = createSovMBInterceptorWithMBInShard(core.MainChainShardId - 1)
+	err = sovMBInterceptor.CheckValidity()
+	require.Nil(t, err)
 }
@@ -65,6 +65,7 @@ type runTypeComponentsHolder interface {
 	OutGoingOperationsPoolHandler() sovereignBlock.OutGoingOperationsPool
 	DataCodecHandler() sovereign.DataCodecHandler
 	TopicsCheckerHandler() sovereign.TopicsCheckerHandler
+	IncomingChainsHandler() process.IncomingChainsHandler
 	IsInterfaceNil() bool
 }
 
@@ -208,7 +208,7 @@ func (irp *intermediateResultsProcessor) VerifyInterMiniBlocks(body *block.Body)
 			continue
 		}
 		// TODO: (sovereign) remove this line once shardCoordinator will return the correct shard id from task: MX-14132
-		if mb.ReceiverShardID == core.SovereignChainShardId && mb.SenderShardID == core.MainChainShardId {
+		if mb.ReceiverShardID == core.SovereignChainShardId && process.IsIncomingChainShardID(mb.SenderShardID) {
 			continue
 		}
 
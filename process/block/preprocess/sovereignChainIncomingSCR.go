@@ -57,7 +57,7 @@ func (scr *sovereignChainIncomingSCR) ProcessBlockTransactions(
 		if miniBlock.ReceiverShardID != core.SovereignChainShardId {
 			continue
 		}
-		if miniBlock.SenderShardID != core.MainChainShardId {
+		if !process.IsIncomingChainShardID(miniBlock.SenderShardID) {
 			continue
 		}
 
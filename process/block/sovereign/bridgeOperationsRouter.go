@@ -0,0 +1,103 @@
+package sovereign
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+// ArgsBridgeOperationsRouter holds the arguments needed to create a bridge operations router
+type ArgsBridgeOperationsRouter struct {
+	// BridgeOpHandlers holds the bridge client of each destination. The default destination has an empty name.
+	BridgeOpHandlers       map[string]BridgeOperationsHandler
+	OutGoingOperationsPool OutGoingOperationsDestinationsHolder
+}
+
+type bridgeOperationsRouter struct {
+	bridgeOpHandlers       map[string]BridgeOperationsHandler
+	outGoingOperationsPool OutGoingOperationsDestinationsHolder
+}
+
+// NewBridgeOperationsRouter creates a bridge operations handler which sends each outgoing operations batch to the bridge
+// client of the destination it was created for
+func NewBridgeOperationsRouter(args ArgsBridgeOperationsRouter) (*bridgeOperationsRouter, error) {
+	if len(args.BridgeOpHandlers) == 0 {
+		return nil, errNoOutGoingDestination
+	}
+	for destination, bridgeOpHandler := range args.BridgeOpHandlers {
+		if check.IfNil(bridgeOpHandler) {
+			return nil, fmt.Errorf("%w for destination %s", errors.ErrNilBridgeOpHandler, destination)
+		}
+	}
+	if check.IfNil(args.OutGoingOperationsPool) {
+		return nil, errors.ErrNilOutGoingOperationsPool
+	}
+
+	return &bridgeOperationsRouter{
+		bridgeOpHandlers:       args.BridgeOpHandlers,
+		outGoingOperationsPool: args.OutGoingOperationsPool,
+	}, nil
+}
+
+// Send groups the outgoing operations batches by their destination and sends each group to its destination bridge
+// client. Batches are sent for all the destinations, even if sending to one of them fails. The returned response holds
+// the tx hashes from all destinations, while the returned error is the last encountered one.
+func (router *bridgeOperationsRouter) Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+	destinations, destinationsData := router.groupByDestination(data)
+
+	response := &sovereign.BridgeOperationsResponse{
+		TxHashes: make([]string, 0),
+	}
+
+	var lastErr error
+	for _, destination := range destinations {
+		bridgeOpHandler, found := router.bridgeOpHandlers[destination]
+		if !found {
+			lastErr = fmt.Errorf("%w: %s", errBridgeOpHandlerNotFound, destination)
+			log.Error("bridgeOperationsRouter.Send", "destination", destination, "error", lastErr)
+			continue
+		}
+
+		resp, err := bridgeOpHandler.Send(ctx, &sovereign.BridgeOperations{
+			Data: destinationsData[destination],
+		})
+		if err != nil {
+			lastErr = fmt.Errorf("%w for destination %s", err, destination)
+			log.Error("bridgeOperationsRouter.Send", "destination", destination, "error", err)
+			continue
+		}
+
+		response.TxHashes = append(response.TxHashes, resp.GetTxHashes()...)
+	}
+
+	return response, lastErr
+}
+
+// groupByDestination returns the destinations in the order of their first batch, together with the batches of each
+func (router *bridgeOperationsRouter) groupByDestination(data *sovereign.BridgeOperations) ([]string, map[string][]*sovereign.BridgeOutGoingData) {
+	destinations := make([]string, 0)
+	destinationsData := make(map[string][]*sovereign.BridgeOutGoingData)
+	for _, bridgeData := range data.GetData() {
+		if bridgeData == nil {
+			continue
+		}
+
+		destination := router.outGoingOperationsPool.GetDestination(bridgeData.Hash)
+		if _, exists := destinationsData[destination]; !exists {
+			destinations = append(destinations, destination)
+		}
+
+		destinationsData[destination] = append(destinationsData[destination], bridgeData)
+	}
+
+	return destinations, destinationsData
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (router *bridgeOperationsRouter) IsInterfaceNil() bool {
+	return router == nil
+}
@@ -0,0 +1,142 @@
+package sovereign
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/stretchr/testify/require"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createBridgeOperationsRouterArgs() ArgsBridgeOperationsRouter {
+	return ArgsBridgeOperationsRouter{
+		BridgeOpHandlers: map[string]BridgeOperationsHandler{
+			"":          &sovTests.BridgeOperationsHandlerMock{},
+			"messaging": &sovTests.BridgeOperationsHandlerMock{},
+		},
+		OutGoingOperationsPool: &sovTests.OutGoingOperationsPoolMock{},
+	}
+}
+
+func TestNewBridgeOperationsRouter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no bridge op handler, should return error", func(t *testing.T) {
+		args := createBridgeOperationsRouterArgs()
+		args.BridgeOpHandlers = nil
+		router, err := NewBridgeOperationsRouter(args)
+		require.Nil(t, router)
+		require.Equal(t, errNoOutGoingDestination, err)
+	})
+
+	t.Run("nil bridge op handler, should return error", func(t *testing.T) {
+		args := createBridgeOperationsRouterArgs()
+		args.BridgeOpHandlers["messaging"] = nil
+		router, err := NewBridgeOperationsRouter(args)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errorsMx.ErrNilBridgeOpHandler)
+	})
+
+	t.Run("nil outgoing operations pool, should return error", func(t *testing.T) {
+		args := createBridgeOperationsRouterArgs()
+		args.OutGoingOperationsPool = nil
+		router, err := NewBridgeOperationsRouter(args)
+		require.Nil(t, router)
+		require.Equal(t, errorsMx.ErrNilOutGoingOperationsPool, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		args := createBridgeOperationsRouterArgs()
+		router, err := NewBridgeOperationsRouter(args)
+		require.Nil(t, err)
+		require.False(t, router.IsInterfaceNil())
+	})
+}
+
+func TestBridgeOperationsRouter_Send(t *testing.T) {
+	t.Parallel()
+
+	tokensData1 := &sovereign.BridgeOutGoingData{Hash: []byte("tokensHash1")}
+	messagesData := &sovereign.BridgeOutGoingData{Hash: []byte("messagesHash")}
+	tokensData2 := &sovereign.BridgeOutGoingData{Hash: []byte("tokensHash2")}
+	unknownData := &sovereign.BridgeOutGoingData{Hash: []byte("unknownHash")}
+
+	destinations := map[string]string{
+		string(tokensData1.Hash):  "",
+		string(messagesData.Hash): "messaging",
+		string(tokensData2.Hash):  "",
+		string(unknownData.Hash):  "unknown",
+	}
+	pool := &sovTests.OutGoingOperationsPoolMock{
+		GetDestinationCalled: func(hash []byte) string {
+			return destinations[string(hash)]
+		},
+	}
+
+	t.Run("should group batches by destination", func(t *testing.T) {
+		sentData := make(map[string][]*sovereign.BridgeOutGoingData)
+		createHandler := func(destination string, txHash string) BridgeOperationsHandler {
+			return &sovTests.BridgeOperationsHandlerMock{
+				SendCalled: func(_ context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+					sentData[destination] = data.Data
+					return &sovereign.BridgeOperationsResponse{TxHashes: []string{txHash}}, nil
+				},
+			}
+		}
+
+		args := createBridgeOperationsRouterArgs()
+		args.BridgeOpHandlers[""] = createHandler("", "txHash1")
+		args.BridgeOpHandlers["messaging"] = createHandler("messaging", "txHash2")
+		args.OutGoingOperationsPool = pool
+		router, _ := NewBridgeOperationsRouter(args)
+
+		resp, err := router.Send(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{tokensData1, messagesData, tokensData2},
+		})
+		require.Nil(t, err)
+		require.Equal(t, []string{"txHash1", "txHash2"}, resp.TxHashes)
+		require.Equal(t, map[string][]*sovereign.BridgeOutGoingData{
+			"":          {tokensData1, tokensData2},
+			"messaging": {messagesData},
+		}, sentData)
+	})
+
+	t.Run("should send to all destinations and return last error", func(t *testing.T) {
+		errSend := errors.New("send error")
+		args := createBridgeOperationsRouterArgs()
+		args.BridgeOpHandlers[""] = &sovTests.BridgeOperationsHandlerMock{
+			SendCalled: func(_ context.Context, _ *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+				return nil, errSend
+			},
+		}
+		args.BridgeOpHandlers["messaging"] = &sovTests.BridgeOperationsHandlerMock{
+			SendCalled: func(_ context.Context, _ *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error) {
+				return &sovereign.BridgeOperationsResponse{TxHashes: []string{"txHash"}}, nil
+			},
+		}
+		args.OutGoingOperationsPool = pool
+		router, _ := NewBridgeOperationsRouter(args)
+
+		resp, err := router.Send(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{tokensData1, messagesData},
+		})
+		require.ErrorIs(t, err, errSend)
+		require.Equal(t, []string{"txHash"}, resp.TxHashes)
+	})
+
+	t.Run("unknown destination, should return error", func(t *testing.T) {
+		args := createBridgeOperationsRouterArgs()
+		args.OutGoingOperationsPool = pool
+		router, _ := NewBridgeOperationsRouter(args)
+
+		resp, err := router.Send(context.Background(), &sovereign.BridgeOperations{
+			Data: []*sovereign.BridgeOutGoingData{unknownData},
+		})
+		require.ErrorIs(t, err, errBridgeOpHandlerNotFound)
+		require.Empty(t, resp.TxHashes)
+	})
+}
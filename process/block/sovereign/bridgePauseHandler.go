@@ -0,0 +1,77 @@
+package sovereign
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/vm"
+)
+
+type bridgePauseHandler struct {
+	accounts state.AccountsAdapter
+}
+
+// NewBridgePauseHandler creates a handler which reads the bridge pause flags set through governance. The flags are
+// stored in the governance system smart contract account, so the provided accounts adapter decides the state from which
+// they are read (e.g. the state of the block being processed or the API state).
+func NewBridgePauseHandler(accounts state.AccountsAdapter) (*bridgePauseHandler, error) {
+	if check.IfNil(accounts) {
+		return nil, errors.ErrNilAccountsAdapter
+	}
+
+	return &bridgePauseHandler{
+		accounts: accounts,
+	}, nil
+}
+
+// IsIncomingPaused returns true if the incoming bridge is paused, in which case no incoming transactions should be
+// included in blocks
+func (bph *bridgePauseHandler) IsIncomingPaused() bool {
+	return bph.isPaused(common.BridgeIncomingPausedKey)
+}
+
+// IsOutGoingPaused returns true if the outgoing bridge is paused, in which case no outgoing operations should be sent
+// to the main chain
+func (bph *bridgePauseHandler) IsOutGoingPaused() bool {
+	return bph.isPaused(common.BridgeOutGoingPausedKey)
+}
+
+// isPaused fails closed: if the pause flag can not be read, the bridge is considered paused, so that a storage error
+// never lets bridge transfers through while governance might have paused them
+func (bph *bridgePauseHandler) isPaused(key string) bool {
+	account, err := bph.accounts.GetExistingAccount(vm.GovernanceSCAddress)
+	if err == state.ErrAccNotFound {
+		return false
+	}
+	if err != nil {
+		log.Error("bridgePauseHandler.isPaused: could not load governance account, considering the bridge paused",
+			"key", key, "error", err)
+		return true
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		log.Error("bridgePauseHandler.isPaused: considering the bridge paused", "key", key, "error", errors.ErrWrongTypeAssertion)
+		return true
+	}
+
+	value, _, err := userAccount.RetrieveValue([]byte(key))
+	if err == state.ErrNilTrie {
+		// the governance account has no data stored yet
+		return false
+	}
+	if err != nil {
+		log.Error("bridgePauseHandler.isPaused: could not retrieve pause flag, considering the bridge paused",
+			"key", key, "error", err)
+		return true
+	}
+
+	return len(value) > 0
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (bph *bridgePauseHandler) IsInterfaceNil() bool {
+	return bph == nil
+}
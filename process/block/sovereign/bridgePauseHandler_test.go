@@ -0,0 +1,113 @@
+package sovereign
+
+import (
+	"errors"
+	"testing"
+
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/common"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/vm"
+)
+
+func TestNewBridgePauseHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter, should return error", func(t *testing.T) {
+		handler, err := NewBridgePauseHandler(nil)
+		require.Equal(t, errorsMx.ErrNilAccountsAdapter, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		handler, err := NewBridgePauseHandler(&stateMock.AccountsStub{})
+		require.Nil(t, err)
+		require.False(t, handler.IsInterfaceNil())
+	})
+}
+
+func TestBridgePauseHandler_IsPaused(t *testing.T) {
+	t.Parallel()
+
+	t.Run("governance account not found, should not be paused", func(t *testing.T) {
+		handler, _ := NewBridgePauseHandler(&stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return nil, state.ErrAccNotFound
+			},
+		})
+		require.False(t, handler.IsIncomingPaused())
+		require.False(t, handler.IsOutGoingPaused())
+	})
+
+	t.Run("error loading the governance account, should fail closed", func(t *testing.T) {
+		handler, _ := NewBridgePauseHandler(&stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return nil, errors.New("local error")
+			},
+		})
+		require.True(t, handler.IsIncomingPaused())
+		require.True(t, handler.IsOutGoingPaused())
+	})
+
+	t.Run("wrong account type, should fail closed", func(t *testing.T) {
+		handler, _ := NewBridgePauseHandler(&stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.BaseAccountMock{}, nil
+			},
+		})
+		require.True(t, handler.IsIncomingPaused())
+		require.True(t, handler.IsOutGoingPaused())
+	})
+
+	t.Run("error retrieving the flags, should fail closed", func(t *testing.T) {
+		handler, _ := NewBridgePauseHandler(&stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{
+					RetrieveValueCalled: func(_ []byte) ([]byte, uint32, error) {
+						return nil, 0, errors.New("local error")
+					},
+				}, nil
+			},
+		})
+		require.True(t, handler.IsIncomingPaused())
+		require.True(t, handler.IsOutGoingPaused())
+	})
+
+	t.Run("governance account without data trie, should not be paused", func(t *testing.T) {
+		handler, _ := NewBridgePauseHandler(&stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{
+					RetrieveValueCalled: func(_ []byte) ([]byte, uint32, error) {
+						return nil, 0, state.ErrNilTrie
+					},
+				}, nil
+			},
+		})
+		require.False(t, handler.IsIncomingPaused())
+		require.False(t, handler.IsOutGoingPaused())
+	})
+
+	t.Run("should read the flags from the governance account", func(t *testing.T) {
+		handler, _ := NewBridgePauseHandler(&stateMock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				require.Equal(t, vm.GovernanceSCAddress, address)
+
+				return &stateMock.UserAccountStub{
+					RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+						if string(key) == common.BridgeIncomingPausedKey {
+							return []byte{1}, 0, nil
+						}
+
+						return nil, 0, nil
+					},
+				}, nil
+			},
+		})
+		require.True(t, handler.IsIncomingPaused())
+		require.False(t, handler.IsOutGoingPaused())
+	})
+}
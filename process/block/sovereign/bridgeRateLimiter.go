@@ -0,0 +1,492 @@
+package sovereign
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// TokenVolume holds the amount of a token moved through the bridge by an operation
+type TokenVolume struct {
+	Identifier []byte
+	Amount     *big.Int
+}
+
+// BridgeOperationVolume holds a bridge operation together with the token volumes it moves. Destination is only set for
+// outgoing operations, so that queued operations can be routed to their destination once they are admitted.
+type BridgeOperationVolume struct {
+	Hash        []byte
+	Data        []byte
+	Destination string
+	Tokens      []*TokenVolume
+}
+
+// RateLimitResult holds the result of checking the bridge operations of a block against the rate limits. Admitted
+// operations are returned in order, starting with the previously queued operations which now fit in the limits.
+type RateLimitResult struct {
+	Admitted []*BridgeOperationVolume
+	Queued   []*BridgeOperationVolume
+}
+
+// ArgsBridgeRateLimiter holds the arguments needed to create a bridge rate limiter
+type ArgsBridgeRateLimiter struct {
+	Config           config.BridgeRateLimits
+	Accounts         state.AccountsAdapter
+	Marshaller       marshal.Marshalizer
+	StateKeyPrefix   string
+	AppStatusHandler core.AppStatusHandler
+	LimitedOpsMetric string
+}
+
+// maxTokenDecimals is the max number of decimals of a token. Amounts are normalised to this number of decimals before
+// being added to the global volume, so that no precision is lost for any of the tokens.
+const maxTokenDecimals = 18
+
+// globalVolumeLimitName is logged instead of a token identifier when the global volume limit is exceeded
+const globalVolumeLimitName = "global"
+
+// rateLimitState is the state of the rate limits of one key, as saved in the system account. The global volume is
+// normalised to the max token decimals.
+type rateLimitState struct {
+	Epoch        uint32
+	GlobalVolume *big.Int
+	TokenVolumes map[string]*big.Int
+	Queued       []*BridgeOperationVolume
+}
+
+type bridgeRateLimiter struct {
+	enabled           bool
+	globalVolumeLimit *big.Int
+	tokenVolumeLimits map[string]*big.Int
+	tokenDecimals     map[string]uint32
+	accounts          state.AccountsAdapter
+	marshaller        marshal.Marshalizer
+	stateKeyPrefix    string
+	appStatusHandler  core.AppStatusHandler
+	limitedOpsMetric  string
+}
+
+// NewBridgeRateLimiter creates a bridge rate limiter which enforces per token volume caps, per epoch, on the bridged
+// operations. Each token is capped separately, in its smallest denomination. The global volume cap applies to the sum of
+// the amounts of all the configured tokens, each normalised to whole tokens through its configured decimals, while the
+// tokens which are not configured are not counted in the global volume. The accumulated
+// volumes and the queued operations are saved in the system account of the provided accounts adapter, which should be
+// the one used to process blocks. This way, the state is part of the state root hash, it follows the reverted blocks
+// and it is synced by the nodes which join the chain, so that all nodes yield the same result.
+func NewBridgeRateLimiter(args ArgsBridgeRateLimiter) (*bridgeRateLimiter, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, errors.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, errors.ErrNilMarshalizer
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, errors.ErrNilAppStatusHandler
+	}
+
+	globalVolumeLimit, err := parseVolumeLimit(args.Config.GlobalVolumeLimit)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the global volume limit", err)
+	}
+	if globalVolumeLimit != nil {
+		globalVolumeLimit.Mul(globalVolumeLimit, computeDecimalsMultiplier(maxTokenDecimals))
+	}
+
+	tokenVolumeLimits := make(map[string]*big.Int, len(args.Config.TokenVolumeLimits))
+	tokenDecimals := make(map[string]uint32, len(args.Config.TokenVolumeLimits))
+	for _, tokenLimit := range args.Config.TokenVolumeLimits {
+		if len(tokenLimit.Identifier) == 0 {
+			return nil, errEmptyRateLimitTokenIdentifier
+		}
+		if _, exists := tokenDecimals[tokenLimit.Identifier]; exists {
+			return nil, fmt.Errorf("%w: %s", errDuplicateRateLimitToken, tokenLimit.Identifier)
+		}
+		if tokenLimit.Decimals > maxTokenDecimals {
+			return nil, fmt.Errorf("%w: %d for token %s", errInvalidRateLimitTokenDecimals, tokenLimit.Decimals, tokenLimit.Identifier)
+		}
+		tokenDecimals[tokenLimit.Identifier] = tokenLimit.Decimals
+
+		tokenVolumeLimit, errParse := parseVolumeLimit(tokenLimit.VolumeLimit)
+		if errParse != nil {
+			return nil, fmt.Errorf("%w for token %s", errParse, tokenLimit.Identifier)
+		}
+		if tokenVolumeLimit != nil {
+			tokenVolumeLimits[tokenLimit.Identifier] = tokenVolumeLimit
+		}
+	}
+
+	log.Debug("NewBridgeRateLimiter",
+		"enabled", args.Config.Enabled,
+		"state key prefix", args.StateKeyPrefix,
+		"global volume limit", args.Config.GlobalVolumeLimit,
+		"num token volume limits", len(tokenVolumeLimits),
+	)
+
+	return &bridgeRateLimiter{
+		enabled:           args.Config.Enabled,
+		globalVolumeLimit: globalVolumeLimit,
+		tokenVolumeLimits: tokenVolumeLimits,
+		tokenDecimals:     tokenDecimals,
+		accounts:          args.Accounts,
+		marshaller:        args.Marshaller,
+		stateKeyPrefix:    args.StateKeyPrefix,
+		appStatusHandler:  args.AppStatusHandler,
+		limitedOpsMetric:  args.LimitedOpsMetric,
+	}, nil
+}
+
+// parseVolumeLimit returns nil for an empty or zero limit, which means there is no limit
+func parseVolumeLimit(limit string) (*big.Int, error) {
+	if len(limit) == 0 {
+		return nil, nil
+	}
+
+	value, ok := big.NewInt(0).SetString(limit, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("%w: %s", errInvalidVolumeLimit, limit)
+	}
+	if value.Sign() == 0 {
+		return nil, nil
+	}
+
+	return value, nil
+}
+
+func computeDecimalsMultiplier(decimals uint32) *big.Int {
+	return big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+}
+
+// CheckOperations checks the bridge operations of a block with the provided epoch against the rate limits, starting
+// with the previously queued operations, and saves the resulting state. The key identifies the source of the
+// operations, each source being limited separately. Operations which exceed the limits are queued, in order, until they
+// fit (e.g. in the next epoch). Once an operation is queued, all the next ones are queued as well, except for the
+// operations which exceed the limits by themselves, which stay queued until the limits are raised, without holding back
+// the next operations. It should only be called while processing a block.
+func (brl *bridgeRateLimiter) CheckOperations(key uint32, epoch uint32, operations []*BridgeOperationVolume) (*RateLimitResult, error) {
+	if !brl.enabled {
+		return &RateLimitResult{
+			Admitted: operations,
+		}, nil
+	}
+
+	state, err := brl.loadState(key, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	numPreviouslyQueued := len(state.Queued)
+	candidates := append(state.Queued, operations...)
+	if len(candidates) == 0 {
+		return &RateLimitResult{}, nil
+	}
+
+	state.Queued = make([]*BridgeOperationVolume, 0)
+	result := &RateLimitResult{
+		Admitted: make([]*BridgeOperationVolume, 0, len(candidates)),
+		Queued:   make([]*BridgeOperationVolume, 0),
+	}
+	numNewlyQueued := 0
+	isQueueBlocked := false
+	for idx, operation := range candidates {
+		_, exceedsLimitsByItself := brl.findExceededLimit([]*BridgeOperationVolume{operation}, newEmptyRateLimitState(epoch))
+		exceededLimit, exceedsLimits := brl.findExceededLimit([]*BridgeOperationVolume{operation}, state)
+		canBeAdmitted := !exceedsLimitsByItself && !isQueueBlocked && !exceedsLimits
+		if canBeAdmitted {
+			brl.addVolumes(operation, state)
+			result.Admitted = append(result.Admitted, operation)
+			continue
+		}
+
+		isQueueBlocked = isQueueBlocked || !exceedsLimitsByItself
+		state.Queued = append(state.Queued, operation)
+		result.Queued = append(result.Queued, operation)
+		if idx < numPreviouslyQueued {
+			continue
+		}
+
+		numNewlyQueued++
+		log.Warn("bridgeRateLimiter: rate limit reached, queued operation",
+			"key", key,
+			"hash", hex.EncodeToString(operation.Hash),
+			"epoch", epoch,
+			"limit", exceededLimit,
+		)
+	}
+	if numNewlyQueued > 0 {
+		brl.appStatusHandler.AddUint64(brl.limitedOpsMetric, uint64(numNewlyQueued))
+	}
+
+	err = brl.saveState(key, state)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// FitsInLimits returns true if all the provided operations fit, together, in the rate limits of the provided key and
+// epoch. A single operation which exceeds the limits by itself fits only if no volume was accumulated yet in the epoch,
+// so that it is not held back forever. The state is not changed.
+func (brl *bridgeRateLimiter) FitsInLimits(key uint32, epoch uint32, operations []*BridgeOperationVolume) (bool, error) {
+	if !brl.enabled {
+		return true, nil
+	}
+
+	state, err := brl.loadState(key, epoch)
+	if err != nil {
+		return false, err
+	}
+
+	if len(operations) == 1 && len(state.TokenVolumes) == 0 && isZeroVolume(state.GlobalVolume) {
+		return true, nil
+	}
+
+	exceededLimit, exceedsLimits := brl.findExceededLimit(operations, state)
+	if exceedsLimits {
+		log.Warn("bridgeRateLimiter: rate limit reached, operations do not fit",
+			"key", key,
+			"epoch", epoch,
+			"num operations", len(operations),
+			"limit", exceededLimit,
+		)
+	}
+
+	return !exceedsLimits, nil
+}
+
+func isZeroVolume(volume *big.Int) bool {
+	return volume == nil || volume.Sign() == 0
+}
+
+// AddOperations adds the volumes of the provided operations to the state of the provided key and epoch, without checking
+// them against the rate limits. It should only be called while processing a block.
+func (brl *bridgeRateLimiter) AddOperations(key uint32, epoch uint32, operations []*BridgeOperationVolume) error {
+	if !brl.enabled || len(operations) == 0 {
+		return nil
+	}
+
+	state, err := brl.loadState(key, epoch)
+	if err != nil {
+		return err
+	}
+
+	for _, operation := range operations {
+		brl.addVolumes(operation, state)
+	}
+
+	return brl.saveState(key, state)
+}
+
+// QueueOperations queues the provided operations after the already queued ones, without checking them against the rate
+// limits, even if the rate limits are disabled. It is used to hold back the operations while the bridge is paused. It
+// should only be called while processing a block.
+func (brl *bridgeRateLimiter) QueueOperations(key uint32, epoch uint32, operations []*BridgeOperationVolume) error {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	state, err := brl.loadState(key, epoch)
+	if err != nil {
+		return err
+	}
+
+	state.Queued = append(state.Queued, operations...)
+
+	return brl.saveState(key, state)
+}
+
+// ReleaseQueuedOperations removes and returns, in order, all the queued operations of the provided key, without checking
+// them against the rate limits. It is used to release the operations held back while the bridge was paused, when the
+// rate limits are disabled. With rate limits enabled, queued operations are only released by CheckOperations, against
+// both the token and the global volume limits. It should only be called while processing a block.
+func (brl *bridgeRateLimiter) ReleaseQueuedOperations(key uint32) ([]*BridgeOperationVolume, error) {
+	savedState, err := brl.getSavedState(key)
+	if err != nil || savedState == nil || len(savedState.Queued) == 0 {
+		return nil, err
+	}
+
+	queued := savedState.Queued
+	savedState.Queued = make([]*BridgeOperationVolume, 0)
+	err = brl.saveState(key, savedState)
+	if err != nil {
+		return nil, err
+	}
+
+	return queued, nil
+}
+
+func (brl *bridgeRateLimiter) createStateKey(key uint32) []byte {
+	return []byte(fmt.Sprintf("%s_%d", brl.stateKeyPrefix, key))
+}
+
+// loadState loads the saved state of the provided key. Accumulated volumes are reset when the epoch changes, while
+// queued operations are kept.
+func (brl *bridgeRateLimiter) loadState(key uint32, epoch uint32) (*rateLimitState, error) {
+	state := newEmptyRateLimitState(epoch)
+
+	savedState, err := brl.getSavedState(key)
+	if err != nil || savedState == nil {
+		return state, err
+	}
+
+	state.Queued = append(state.Queued, savedState.Queued...)
+	if savedState.Epoch != epoch {
+		return state, nil
+	}
+
+	for token, volume := range savedState.TokenVolumes {
+		if volume != nil {
+			state.TokenVolumes[token] = big.NewInt(0).Set(volume)
+		}
+	}
+	if savedState.GlobalVolume != nil {
+		state.GlobalVolume.Set(savedState.GlobalVolume)
+	}
+
+	return state, nil
+}
+
+func newEmptyRateLimitState(epoch uint32) *rateLimitState {
+	return &rateLimitState{
+		Epoch:        epoch,
+		GlobalVolume: big.NewInt(0),
+		TokenVolumes: make(map[string]*big.Int),
+		Queued:       make([]*BridgeOperationVolume, 0),
+	}
+}
+
+func (brl *bridgeRateLimiter) getSavedState(key uint32) (*rateLimitState, error) {
+	account, err := brl.accounts.GetExistingAccount(core.SystemAccountAddress)
+	if err == state.ErrAccNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, errors.ErrWrongTypeAssertion
+	}
+
+	stateBytes, _, err := userAccount.RetrieveValue(brl.createStateKey(key))
+	if err == state.ErrNilTrie {
+		// the system account has no data stored yet
+		return nil, nil
+	}
+	if err != nil || len(stateBytes) == 0 {
+		return nil, err
+	}
+
+	savedState := &rateLimitState{}
+	err = brl.marshaller.Unmarshal(savedState, stateBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the bridge rate limits state of key %d", err, key)
+	}
+
+	return savedState, nil
+}
+
+func (brl *bridgeRateLimiter) saveState(key uint32, rateLimitsState *rateLimitState) error {
+	stateBytes, err := brl.marshaller.Marshal(rateLimitsState)
+	if err != nil {
+		return err
+	}
+
+	account, err := brl.accounts.LoadAccount(core.SystemAccountAddress)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return errors.ErrWrongTypeAssertion
+	}
+
+	err = userAccount.SaveKeyValue(brl.createStateKey(key), stateBytes)
+	if err != nil {
+		return err
+	}
+
+	return brl.accounts.SaveAccount(userAccount)
+}
+
+// findExceededLimit returns the name of the limit exceeded by the provided operations, together, on top of the volumes
+// of the provided state, which is either a token identifier or the global limit. The state is not changed.
+func (brl *bridgeRateLimiter) findExceededLimit(operations []*BridgeOperationVolume, state *rateLimitState) (string, bool) {
+	newState := newEmptyRateLimitState(state.Epoch)
+	newState.GlobalVolume.Set(state.GlobalVolume)
+	for _, operation := range operations {
+		for _, token := range operation.Tokens {
+			tokenID := string(token.Identifier)
+			if _, exists := newState.TokenVolumes[tokenID]; !exists && token.Amount != nil {
+				newState.TokenVolumes[tokenID] = big.NewInt(0).Set(getVolume(state.TokenVolumes, tokenID))
+			}
+		}
+		brl.addVolumes(operation, newState)
+	}
+
+	for tokenID, volume := range newState.TokenVolumes {
+		tokenVolumeLimit, found := brl.tokenVolumeLimits[tokenID]
+		if found && volume.Cmp(tokenVolumeLimit) > 0 {
+			return tokenID, true
+		}
+	}
+
+	if brl.globalVolumeLimit != nil && newState.GlobalVolume.Cmp(brl.globalVolumeLimit) > 0 {
+		return globalVolumeLimitName, true
+	}
+
+	return "", false
+}
+
+func getVolume(volumes map[string]*big.Int, tokenID string) *big.Int {
+	volume, found := volumes[tokenID]
+	if !found {
+		return big.NewInt(0)
+	}
+
+	return volume
+}
+
+// addVolumes adds the token amounts of the provided operation to the token volumes of the provided state and the
+// normalised amounts of the configured tokens to its global volume
+func (brl *bridgeRateLimiter) addVolumes(operation *BridgeOperationVolume, state *rateLimitState) {
+	for _, token := range operation.Tokens {
+		if token.Amount == nil {
+			continue
+		}
+
+		tokenID := string(token.Identifier)
+		if _, exists := state.TokenVolumes[tokenID]; !exists {
+			state.TokenVolumes[tokenID] = big.NewInt(0)
+		}
+		state.TokenVolumes[tokenID].Add(state.TokenVolumes[tokenID], token.Amount)
+
+		decimals, found := brl.tokenDecimals[tokenID]
+		if !found {
+			continue
+		}
+
+		normalisedAmount := big.NewInt(0).Mul(token.Amount, computeDecimalsMultiplier(maxTokenDecimals-decimals))
+		state.GlobalVolume.Add(state.GlobalVolume, normalisedAmount)
+	}
+}
+
+// IsEnabled returns true if the rate limits are enforced
+func (brl *bridgeRateLimiter) IsEnabled() bool {
+	return brl.enabled
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (brl *bridgeRateLimiter) IsInterfaceNil() bool {
+	return brl == nil
+}
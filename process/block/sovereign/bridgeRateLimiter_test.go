@@ -0,0 +1,572 @@
+package sovereign
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+)
+
+// createSystemAccountStorage creates an accounts adapter whose system account saves its data in the returned map
+func createSystemAccountStorage() (*stateMock.AccountsStub, map[string][]byte) {
+	storage := make(map[string][]byte)
+	systemAccount := &stateMock.UserAccountStub{
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return storage[string(key)], 0, nil
+		},
+		SaveKeyValueCalled: func(key []byte, value []byte) error {
+			storage[string(key)] = value
+			return nil
+		},
+	}
+	getSystemAccount := func(address []byte) (vmcommon.AccountHandler, error) {
+		if string(address) != string(core.SystemAccountAddress) {
+			return nil, state.ErrAccNotFound
+		}
+		return systemAccount, nil
+	}
+
+	return &stateMock.AccountsStub{
+		GetExistingAccountCalled: getSystemAccount,
+		LoadAccountCalled:        getSystemAccount,
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			return nil
+		},
+	}, storage
+}
+
+func createArgsBridgeRateLimiter(cfg config.BridgeRateLimits) ArgsBridgeRateLimiter {
+	accounts, _ := createSystemAccountStorage()
+	return ArgsBridgeRateLimiter{
+		Config:           cfg,
+		Accounts:         accounts,
+		Marshaller:       &marshal.JsonMarshalizer{},
+		StateKeyPrefix:   common.BridgeOutGoingRateLimitsKeyPrefix,
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+		LimitedOpsMetric: common.MetricNumRateLimitedOutGoingOperations,
+	}
+}
+
+func createBridgeRateLimiter(cfg config.BridgeRateLimits) *bridgeRateLimiter {
+	rateLimiter, _ := NewBridgeRateLimiter(createArgsBridgeRateLimiter(cfg))
+	return rateLimiter
+}
+
+func createBridgeRateLimiterWithAccounts(cfg config.BridgeRateLimits, accounts state.AccountsAdapter) *bridgeRateLimiter {
+	args := createArgsBridgeRateLimiter(cfg)
+	args.Accounts = accounts
+	rateLimiter, _ := NewBridgeRateLimiter(args)
+	return rateLimiter
+}
+
+func createRateLimitsConfig() config.BridgeRateLimits {
+	return config.BridgeRateLimits{
+		Enabled: true,
+		TokenVolumeLimits: []config.TokenVolumeLimit{
+			{
+				Identifier:  "TKN1",
+				VolumeLimit: "50",
+			},
+			{
+				Identifier:  "TKN2",
+				VolumeLimit: "0",
+			},
+			{
+				Identifier:  "TKN3",
+				VolumeLimit: "20",
+			},
+		},
+	}
+}
+
+func createOperationVolume(hash string, tokenID string, amount int64) *BridgeOperationVolume {
+	return &BridgeOperationVolume{
+		Hash: []byte(hash),
+		Tokens: []*TokenVolume{
+			{
+				Identifier: []byte(tokenID),
+				Amount:     big.NewInt(amount),
+			},
+		},
+	}
+}
+
+func TestNewBridgeRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter, should return error", func(t *testing.T) {
+		args := createArgsBridgeRateLimiter(createRateLimitsConfig())
+		args.Accounts = nil
+		rateLimiter, err := NewBridgeRateLimiter(args)
+		require.Nil(t, rateLimiter)
+		require.Equal(t, errorsMx.ErrNilAccountsAdapter, err)
+	})
+
+	t.Run("nil marshaller, should return error", func(t *testing.T) {
+		args := createArgsBridgeRateLimiter(createRateLimitsConfig())
+		args.Marshaller = nil
+		rateLimiter, err := NewBridgeRateLimiter(args)
+		require.Nil(t, rateLimiter)
+		require.Equal(t, errorsMx.ErrNilMarshalizer, err)
+	})
+
+	t.Run("nil app status handler, should return error", func(t *testing.T) {
+		args := createArgsBridgeRateLimiter(createRateLimitsConfig())
+		args.AppStatusHandler = nil
+		rateLimiter, err := NewBridgeRateLimiter(args)
+		require.Nil(t, rateLimiter)
+		require.Equal(t, errorsMx.ErrNilAppStatusHandler, err)
+	})
+
+	t.Run("empty token identifier, should return error", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.TokenVolumeLimits[1].Identifier = ""
+		rateLimiter, err := NewBridgeRateLimiter(createArgsBridgeRateLimiter(cfg))
+		require.Nil(t, rateLimiter)
+		require.Equal(t, errEmptyRateLimitTokenIdentifier, err)
+	})
+
+	t.Run("duplicate token, should return error", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.TokenVolumeLimits[1].Identifier = "TKN1"
+		rateLimiter, err := NewBridgeRateLimiter(createArgsBridgeRateLimiter(cfg))
+		require.Nil(t, rateLimiter)
+		require.ErrorIs(t, err, errDuplicateRateLimitToken)
+	})
+
+	t.Run("invalid token volume limit, should return error", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.TokenVolumeLimits[0].VolumeLimit = "1.5"
+		rateLimiter, err := NewBridgeRateLimiter(createArgsBridgeRateLimiter(cfg))
+		require.Nil(t, rateLimiter)
+		require.ErrorIs(t, err, errInvalidVolumeLimit)
+	})
+
+	t.Run("negative token volume limit, should return error", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.TokenVolumeLimits[0].VolumeLimit = "-1"
+		rateLimiter, err := NewBridgeRateLimiter(createArgsBridgeRateLimiter(cfg))
+		require.Nil(t, rateLimiter)
+		require.ErrorIs(t, err, errInvalidVolumeLimit)
+	})
+
+	t.Run("invalid global volume limit, should return error", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.GlobalVolumeLimit = "ten"
+		rateLimiter, err := NewBridgeRateLimiter(createArgsBridgeRateLimiter(cfg))
+		require.Nil(t, rateLimiter)
+		require.ErrorIs(t, err, errInvalidVolumeLimit)
+	})
+
+	t.Run("invalid token decimals, should return error", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.TokenVolumeLimits[0].Decimals = maxTokenDecimals + 1
+		rateLimiter, err := NewBridgeRateLimiter(createArgsBridgeRateLimiter(cfg))
+		require.Nil(t, rateLimiter)
+		require.ErrorIs(t, err, errInvalidRateLimitTokenDecimals)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.GlobalVolumeLimit = "2"
+		rateLimiter := createBridgeRateLimiter(cfg)
+		require.False(t, rateLimiter.IsInterfaceNil())
+		require.True(t, rateLimiter.IsEnabled())
+		require.Len(t, rateLimiter.tokenVolumeLimits, 2)
+		require.Len(t, rateLimiter.tokenDecimals, 3)
+		require.Equal(t, big.NewInt(0).Mul(big.NewInt(2), computeDecimalsMultiplier(maxTokenDecimals)), rateLimiter.globalVolumeLimit)
+	})
+}
+
+// createGlobalRateLimitsConfig creates a config with a global volume limit of 1 whole token, without any token limit.
+// TKN1 has 2 decimals and TKN2 has no decimals, while TKN3 is not configured, hence it is not counted.
+func createGlobalRateLimitsConfig() config.BridgeRateLimits {
+	return config.BridgeRateLimits{
+		Enabled:           true,
+		GlobalVolumeLimit: "1",
+		TokenVolumeLimits: []config.TokenVolumeLimit{
+			{
+				Identifier: "TKN1",
+				Decimals:   2,
+			},
+			{
+				Identifier: "TKN2",
+			},
+		},
+	}
+}
+
+func TestBridgeRateLimiter_CheckOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled, should admit all operations without saving any state", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.Enabled = false
+		accounts, storage := createSystemAccountStorage()
+		rateLimiter := createBridgeRateLimiterWithAccounts(cfg, accounts)
+		require.False(t, rateLimiter.IsEnabled())
+
+		operations := []*BridgeOperationVolume{createOperationVolume("op1", "TKN1", 1000)}
+		result, err := rateLimiter.CheckOperations(0, 1, operations)
+		require.Nil(t, err)
+		require.Equal(t, operations, result.Admitted)
+		require.Empty(t, storage)
+	})
+
+	t.Run("no operations, should not save any state", func(t *testing.T) {
+		accounts, storage := createSystemAccountStorage()
+		rateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+
+		result, err := rateLimiter.CheckOperations(0, 1, nil)
+		require.Nil(t, err)
+		require.Empty(t, result.Admitted)
+		require.Empty(t, result.Queued)
+		require.Empty(t, storage)
+	})
+
+	t.Run("excess operations should be queued in order", func(t *testing.T) {
+		numLimited := uint64(0)
+		args := createArgsBridgeRateLimiter(createRateLimitsConfig())
+		args.LimitedOpsMetric = common.MetricNumRateLimitedIncomingHeaders
+		args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+			AddUint64Handler: func(key string, value uint64) {
+				require.Equal(t, common.MetricNumRateLimitedIncomingHeaders, key)
+				numLimited += value
+			},
+		}
+		rateLimiter, _ := NewBridgeRateLimiter(args)
+
+		op1 := createOperationVolume("op1", "TKN1", 30)
+		op2 := createOperationVolume("op2", "TKN1", 30)
+		op3 := createOperationVolume("op3", "TKN2", 10)
+		result, err := rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op1, op2, op3})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1}, result.Admitted)
+		require.Equal(t, []*BridgeOperationVolume{op2, op3}, result.Queued)
+		require.Equal(t, uint64(2), numLimited)
+
+		// queued operations are kept until the limits are reset in a new epoch
+		op4 := createOperationVolume("op4", "TKN2", 10)
+		result, err = rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op4})
+		require.Nil(t, err)
+		require.Empty(t, result.Admitted)
+		require.Len(t, result.Queued, 3)
+		require.Equal(t, uint64(3), numLimited)
+
+		result, err = rateLimiter.CheckOperations(0, 2, nil)
+		require.Nil(t, err)
+		require.Len(t, result.Admitted, 3)
+		require.Equal(t, op2.Hash, result.Admitted[0].Hash)
+		require.Equal(t, op3.Hash, result.Admitted[1].Hash)
+		require.Equal(t, op4.Hash, result.Admitted[2].Hash)
+		require.Empty(t, result.Queued)
+		require.Equal(t, uint64(3), numLimited)
+	})
+
+	t.Run("each token should be capped separately", func(t *testing.T) {
+		rateLimiter := createBridgeRateLimiter(createRateLimitsConfig())
+
+		op1 := createOperationVolume("op1", "TKN1", 50)
+		op2 := createOperationVolume("op2", "TKN3", 20)
+		op3 := createOperationVolume("op3", "TKN2", 1000)
+		result, err := rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op1, op2, op3})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1, op2, op3}, result.Admitted)
+		require.Empty(t, result.Queued)
+	})
+
+	t.Run("global volume limit should cap the normalised amounts of all the configured tokens together", func(t *testing.T) {
+		rateLimiter := createBridgeRateLimiter(createGlobalRateLimitsConfig())
+
+		op1 := createOperationVolume("op1", "TKN1", 60)
+		op2 := createOperationVolume("op2", "TKN3", 1000)
+		op3 := createOperationVolume("op3", "TKN1", 40)
+		op4 := createOperationVolume("op4", "TKN2", 1)
+		result, err := rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op1, op2, op3, op4})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1, op2, op3}, result.Admitted)
+		require.Equal(t, []*BridgeOperationVolume{op4}, result.Queued)
+
+		// queued operations should be released against the global volume limit, once it is reset in a new epoch
+		op5 := createOperationVolume("op5", "TKN1", 1)
+		result, err = rateLimiter.CheckOperations(0, 2, []*BridgeOperationVolume{op5})
+		require.Nil(t, err)
+		require.Len(t, result.Admitted, 1)
+		require.Equal(t, op4.Hash, result.Admitted[0].Hash)
+		require.Equal(t, []*BridgeOperationVolume{op5}, result.Queued)
+	})
+
+	t.Run("operation exceeding the limits by itself should stay queued without holding back the next ones", func(t *testing.T) {
+		accounts, _ := createSystemAccountStorage()
+		rateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+
+		op1 := createOperationVolume("op1", "TKN1", 51)
+		op2 := createOperationVolume("op2", "TKN1", 10)
+		result, err := rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op1, op2})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op2}, result.Admitted)
+		require.Equal(t, []*BridgeOperationVolume{op1}, result.Queued)
+
+		result, err = rateLimiter.CheckOperations(0, 2, nil)
+		require.Nil(t, err)
+		require.Empty(t, result.Admitted)
+		require.Len(t, result.Queued, 1)
+
+		// once the limits are raised, the queued operation is admitted
+		cfg := createRateLimitsConfig()
+		cfg.TokenVolumeLimits[0].VolumeLimit = "60"
+		rateLimiter = createBridgeRateLimiterWithAccounts(cfg, accounts)
+		result, err = rateLimiter.CheckOperations(0, 2, nil)
+		require.Nil(t, err)
+		require.Len(t, result.Admitted, 1)
+		require.Equal(t, op1.Hash, result.Admitted[0].Hash)
+		require.Empty(t, result.Queued)
+	})
+
+	t.Run("keys should be limited separately", func(t *testing.T) {
+		rateLimiter := createBridgeRateLimiter(createRateLimitsConfig())
+
+		op1 := createOperationVolume("op1", "TKN1", 50)
+		result, err := rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op1})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1}, result.Admitted)
+
+		op2 := createOperationVolume("op2", "TKN1", 50)
+		result, err = rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op2})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op2}, result.Queued)
+
+		result, err = rateLimiter.CheckOperations(1, 1, []*BridgeOperationVolume{op2})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op2}, result.Admitted)
+	})
+
+	t.Run("state should be saved in the system account", func(t *testing.T) {
+		accounts, storage := createSystemAccountStorage()
+		rateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+
+		op1 := createOperationVolume("op1", "TKN1", 30)
+		op2 := createOperationVolume("op2", "TKN1", 30)
+		result, err := rateLimiter.CheckOperations(7, 1, []*BridgeOperationVolume{op1, op2})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1}, result.Admitted)
+		require.Len(t, storage, 1)
+		require.Contains(t, storage, common.BridgeOutGoingRateLimitsKeyPrefix+"_7")
+
+		// another node, processing on top of the same state, yields the same result
+		otherRateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+		op3 := createOperationVolume("op3", "TKN1", 10)
+		result, err = otherRateLimiter.CheckOperations(7, 1, []*BridgeOperationVolume{op3})
+		require.Nil(t, err)
+		require.Empty(t, result.Admitted)
+		require.Len(t, result.Queued, 2)
+		require.Equal(t, op2.Hash, result.Queued[0].Hash)
+		require.Equal(t, op3.Hash, result.Queued[1].Hash)
+
+		result, err = otherRateLimiter.CheckOperations(7, 2, nil)
+		require.Nil(t, err)
+		require.Len(t, result.Admitted, 2)
+		require.Equal(t, op2.Hash, result.Admitted[0].Hash)
+		require.Equal(t, big.NewInt(30), result.Admitted[0].Tokens[0].Amount)
+		require.Equal(t, op3.Hash, result.Admitted[1].Hash)
+	})
+
+	t.Run("missing system account should start from an empty state", func(t *testing.T) {
+		accounts, _ := createSystemAccountStorage()
+		accounts.GetExistingAccountCalled = func(_ []byte) (vmcommon.AccountHandler, error) {
+			return nil, state.ErrAccNotFound
+		}
+		rateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+
+		op1 := createOperationVolume("op1", "TKN1", 30)
+		result, err := rateLimiter.CheckOperations(0, 1, []*BridgeOperationVolume{op1})
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1}, result.Admitted)
+	})
+}
+
+func TestBridgeRateLimiter_FitsInLimitsAndAddOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled, should fit without saving any state", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.Enabled = false
+		accounts, storage := createSystemAccountStorage()
+		rateLimiter := createBridgeRateLimiterWithAccounts(cfg, accounts)
+
+		operations := []*BridgeOperationVolume{createOperationVolume("op1", "TKN1", 1000)}
+		fits, err := rateLimiter.FitsInLimits(0, 1, operations)
+		require.Nil(t, err)
+		require.True(t, fits)
+
+		err = rateLimiter.AddOperations(0, 1, operations)
+		require.Nil(t, err)
+		require.Empty(t, storage)
+	})
+
+	t.Run("should check the operations together, on top of the saved volumes", func(t *testing.T) {
+		accounts, storage := createSystemAccountStorage()
+		rateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+
+		op1 := createOperationVolume("op1", "TKN1", 30)
+		op2 := createOperationVolume("op2", "TKN1", 30)
+		fits, err := rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op1, op2})
+		require.Nil(t, err)
+		require.False(t, fits)
+		require.Empty(t, storage)
+
+		err = rateLimiter.AddOperations(0, 1, []*BridgeOperationVolume{op1})
+		require.Nil(t, err)
+
+		fits, err = rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op2})
+		require.Nil(t, err)
+		require.False(t, fits)
+
+		op3 := createOperationVolume("op3", "TKN1", 20)
+		fits, err = rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op3})
+		require.Nil(t, err)
+		require.True(t, fits)
+
+		// volumes are reset in a new epoch
+		fits, err = rateLimiter.FitsInLimits(0, 2, []*BridgeOperationVolume{op2})
+		require.Nil(t, err)
+		require.True(t, fits)
+	})
+
+	t.Run("should check the global volume limit", func(t *testing.T) {
+		rateLimiter := createBridgeRateLimiter(createGlobalRateLimitsConfig())
+
+		op1 := createOperationVolume("op1", "TKN1", 99)
+		op2 := createOperationVolume("op2", "TKN2", 1)
+		fits, err := rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op1, op2})
+		require.Nil(t, err)
+		require.False(t, fits)
+
+		err = rateLimiter.AddOperations(0, 1, []*BridgeOperationVolume{op1})
+		require.Nil(t, err)
+
+		fits, err = rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op2})
+		require.Nil(t, err)
+		require.False(t, fits)
+
+		op3 := createOperationVolume("op3", "TKN1", 1)
+		fits, err = rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op3})
+		require.Nil(t, err)
+		require.True(t, fits)
+	})
+
+	t.Run("single operation exceeding the limits by itself should only fit without accumulated volumes", func(t *testing.T) {
+		rateLimiter := createBridgeRateLimiter(createRateLimitsConfig())
+
+		op1 := createOperationVolume("op1", "TKN1", 100)
+		fits, err := rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op1})
+		require.Nil(t, err)
+		require.True(t, fits)
+
+		op2 := createOperationVolume("op2", "TKN1", 1)
+		fits, err = rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op2, op1})
+		require.Nil(t, err)
+		require.False(t, fits)
+
+		err = rateLimiter.AddOperations(0, 1, []*BridgeOperationVolume{op2})
+		require.Nil(t, err)
+
+		fits, err = rateLimiter.FitsInLimits(0, 1, []*BridgeOperationVolume{op1})
+		require.Nil(t, err)
+		require.False(t, fits)
+	})
+}
+
+func TestBridgeRateLimiter_QueueAndReleaseOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled, should queue and release the operations in order", func(t *testing.T) {
+		cfg := createRateLimitsConfig()
+		cfg.Enabled = false
+		accounts, storage := createSystemAccountStorage()
+		rateLimiter := createBridgeRateLimiterWithAccounts(cfg, accounts)
+
+		released, err := rateLimiter.ReleaseQueuedOperations(0)
+		require.Nil(t, err)
+		require.Empty(t, released)
+		require.Empty(t, storage)
+
+		op1 := createOperationVolume("op1", "TKN1", 1000)
+		op2 := createOperationVolume("op2", "TKN1", 1)
+		err = rateLimiter.QueueOperations(0, 1, []*BridgeOperationVolume{op1})
+		require.Nil(t, err)
+		err = rateLimiter.QueueOperations(0, 1, []*BridgeOperationVolume{op2})
+		require.Nil(t, err)
+
+		released, err = rateLimiter.ReleaseQueuedOperations(1)
+		require.Nil(t, err)
+		require.Empty(t, released)
+
+		released, err = rateLimiter.ReleaseQueuedOperations(0)
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1, op2}, released)
+
+		released, err = rateLimiter.ReleaseQueuedOperations(0)
+		require.Nil(t, err)
+		require.Empty(t, released)
+	})
+
+	t.Run("enabled, queued operations should be checked against the limits", func(t *testing.T) {
+		rateLimiter := createBridgeRateLimiter(createRateLimitsConfig())
+
+		op1 := createOperationVolume("op1", "TKN1", 30)
+		op2 := createOperationVolume("op2", "TKN1", 30)
+		err := rateLimiter.QueueOperations(0, 1, []*BridgeOperationVolume{op1, op2})
+		require.Nil(t, err)
+
+		result, err := rateLimiter.CheckOperations(0, 1, nil)
+		require.Nil(t, err)
+		require.Equal(t, []*BridgeOperationVolume{op1}, result.Admitted)
+		require.Equal(t, []*BridgeOperationVolume{op2}, result.Queued)
+	})
+
+	t.Run("system account without data trie, should release nothing", func(t *testing.T) {
+		accounts := &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return &stateMock.UserAccountStub{
+					RetrieveValueCalled: func(_ []byte) ([]byte, uint32, error) {
+						return nil, 0, state.ErrNilTrie
+					},
+				}, nil
+			},
+		}
+		rateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+
+		released, err := rateLimiter.ReleaseQueuedOperations(0)
+		require.Nil(t, err)
+		require.Empty(t, released)
+	})
+
+	t.Run("account error, should return error", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		accounts := &stateMock.AccountsStub{
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return nil, expectedErr
+			},
+		}
+		rateLimiter := createBridgeRateLimiterWithAccounts(createRateLimitsConfig(), accounts)
+
+		err := rateLimiter.QueueOperations(0, 1, []*BridgeOperationVolume{createOperationVolume("op1", "TKN1", 1)})
+		require.Equal(t, expectedErr, err)
+
+		released, err := rateLimiter.ReleaseQueuedOperations(0)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, released)
+	})
+}
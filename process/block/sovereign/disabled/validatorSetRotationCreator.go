@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-go/process/block/sovereign"
+)
+
+type validatorSetRotationCreator struct {
+}
+
+// NewDisabledValidatorSetRotationCreator creates a disabled validator set rotation creator
+func NewDisabledValidatorSetRotationCreator() *validatorSetRotationCreator {
+	return &validatorSetRotationCreator{}
+}
+
+// CreateValidatorSetRotationOperation returns nil
+func (creator *validatorSetRotationCreator) CreateValidatorSetRotationOperation(_ data.HeaderHandler, _ data.BodyHandler) (*sovereign.OutGoingDestinationBatches, error) {
+	return nil, nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (creator *validatorSetRotationCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
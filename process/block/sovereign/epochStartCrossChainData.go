@@ -0,0 +1,107 @@
+package sovereign
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// SetEpochStartCrossChainData sets the epoch start data of the last cross notarized header of each incoming chain in the
+// provided sovereign chain header. The epoch start of the sovereign chain header can only hold the data of the main
+// chain, so the data of the other incoming chains is kept in the reserved field of the header, as a marshalled batch.
+func SetEpochStartCrossChainData(
+	marshaller marshal.Marshalizer,
+	header *block.SovereignChainHeader,
+	crossChainData []*block.EpochStartCrossChainData,
+) error {
+	if check.IfNil(marshaller) {
+		return core.ErrNilMarshalizer
+	}
+	if header == nil || header.Header == nil {
+		return data.ErrNilHeader
+	}
+
+	incomingChainsData := &batch.Batch{}
+	for _, chainData := range crossChainData {
+		if chainData.GetShardID() == core.MainChainShardId {
+			err := header.SetLastFinalizedCrossChainHeaderHandler(chainData)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		chainDataBytes, err := marshaller.Marshal(chainData)
+		if err != nil {
+			return err
+		}
+
+		incomingChainsData.Data = append(incomingChainsData.Data, chainDataBytes)
+	}
+
+	if len(incomingChainsData.Data) == 0 {
+		header.Header.Reserved = nil
+		return nil
+	}
+
+	reserved, err := marshaller.Marshal(incomingChainsData)
+	if err != nil {
+		return err
+	}
+
+	header.Header.Reserved = reserved
+	return nil
+}
+
+// GetEpochStartCrossChainData returns the epoch start data of the last cross notarized header of each incoming chain
+// from the provided sovereign chain header, starting with the main chain. Incoming chains which had no cross notarized
+// header at the epoch start are not returned.
+func GetEpochStartCrossChainData(
+	marshaller marshal.Marshalizer,
+	header data.SovereignChainHeaderHandler,
+) ([]*block.EpochStartCrossChainData, error) {
+	if check.IfNil(marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(header) {
+		return nil, data.ErrNilHeader
+	}
+
+	crossChainData := make([]*block.EpochStartCrossChainData, 0)
+	mainChainData := header.GetLastFinalizedCrossChainHeaderHandler()
+	if mainChainData != nil && len(mainChainData.GetHeaderHash()) != 0 {
+		crossChainData = append(crossChainData, &block.EpochStartCrossChainData{
+			ShardID:    core.MainChainShardId,
+			Epoch:      mainChainData.GetEpoch(),
+			Round:      mainChainData.GetRound(),
+			Nonce:      mainChainData.GetNonce(),
+			HeaderHash: mainChainData.GetHeaderHash(),
+		})
+	}
+
+	if len(header.GetReserved()) == 0 {
+		return crossChainData, nil
+	}
+
+	incomingChainsData := &batch.Batch{}
+	err := marshaller.Unmarshal(incomingChainsData, header.GetReserved())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, chainDataBytes := range incomingChainsData.Data {
+		chainData := &block.EpochStartCrossChainData{}
+		err = marshaller.Unmarshal(chainData, chainDataBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		crossChainData = append(crossChainData, chainData)
+	}
+
+	return crossChainData, nil
+}
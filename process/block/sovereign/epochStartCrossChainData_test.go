@@ -0,0 +1,110 @@
+package sovereign
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetEpochStartCrossChainData(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	mainChainData := &block.EpochStartCrossChainData{
+		ShardID:    core.MainChainShardId,
+		Epoch:      1,
+		Round:      10,
+		Nonce:      9,
+		HeaderHash: []byte("mainChainHeaderHash"),
+	}
+	incomingChainData := &block.EpochStartCrossChainData{
+		ShardID:    GetIncomingChainShardID(0),
+		Epoch:      2,
+		Round:      20,
+		Nonce:      19,
+		HeaderHash: []byte("incomingChainHeaderHash"),
+	}
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := SetEpochStartCrossChainData(nil, createSovereignChainHeader(), []*block.EpochStartCrossChainData{mainChainData})
+		require.Equal(t, core.ErrNilMarshalizer, err)
+
+		crossChainData, err := GetEpochStartCrossChainData(nil, createSovereignChainHeader())
+		require.Nil(t, crossChainData)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := SetEpochStartCrossChainData(marshaller, nil, []*block.EpochStartCrossChainData{mainChainData})
+		require.Equal(t, data.ErrNilHeader, err)
+
+		err = SetEpochStartCrossChainData(marshaller, &block.SovereignChainHeader{}, []*block.EpochStartCrossChainData{mainChainData})
+		require.Equal(t, data.ErrNilHeader, err)
+
+		crossChainData, err := GetEpochStartCrossChainData(marshaller, nil)
+		require.Nil(t, crossChainData)
+		require.Equal(t, data.ErrNilHeader, err)
+	})
+	t.Run("invalid reserved field should error", func(t *testing.T) {
+		t.Parallel()
+
+		crossChainData, err := GetEpochStartCrossChainData(marshaller, createSovereignChainHeader())
+		require.Nil(t, crossChainData)
+		require.NotNil(t, err)
+	})
+	t.Run("main chain only should not use the reserved field", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignChainHeader()
+		err := SetEpochStartCrossChainData(marshaller, header, []*block.EpochStartCrossChainData{mainChainData})
+		require.Nil(t, err)
+		require.Equal(t, *mainChainData, header.EpochStart.LastFinalizedCrossChainHeader)
+		require.Nil(t, header.GetReserved())
+
+		crossChainData, err := GetEpochStartCrossChainData(marshaller, header)
+		require.Nil(t, err)
+		require.Equal(t, []*block.EpochStartCrossChainData{mainChainData}, crossChainData)
+	})
+	t.Run("no cross chain data should return empty", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignChainHeader()
+		err := SetEpochStartCrossChainData(marshaller, header, nil)
+		require.Nil(t, err)
+
+		crossChainData, err := GetEpochStartCrossChainData(marshaller, header)
+		require.Nil(t, err)
+		require.Empty(t, crossChainData)
+	})
+	t.Run("should set and get the data of all incoming chains", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignChainHeader()
+		err := SetEpochStartCrossChainData(marshaller, header, []*block.EpochStartCrossChainData{mainChainData, incomingChainData})
+		require.Nil(t, err)
+		require.Equal(t, *mainChainData, header.EpochStart.LastFinalizedCrossChainHeader)
+		require.NotEmpty(t, header.GetReserved())
+
+		crossChainData, err := GetEpochStartCrossChainData(marshaller, header)
+		require.Nil(t, err)
+		require.Equal(t, []*block.EpochStartCrossChainData{mainChainData, incomingChainData}, crossChainData)
+	})
+	t.Run("no main chain header notarized should only get the other incoming chains", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignChainHeader()
+		err := SetEpochStartCrossChainData(marshaller, header, []*block.EpochStartCrossChainData{incomingChainData})
+		require.Nil(t, err)
+
+		crossChainData, err := GetEpochStartCrossChainData(marshaller, header)
+		require.Nil(t, err)
+		require.Equal(t, []*block.EpochStartCrossChainData{incomingChainData}, crossChainData)
+	})
+}
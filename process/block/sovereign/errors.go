@@ -8,4 +8,42 @@ var errNoSubscribedIdentifier = errors.New("no subscribed identifier provided")
 
 var errNoSubscribedEvent = errors.New("no subscribed event provided")
 
+var errEmptyMessageCall = errors.New("outgoing message operation without contract call")
+
 var errDuplicateSubscribedAddresses = errors.New("duplicate subscribed addresses provided")
+
+var errNoOutGoingDestination = errors.New("no outgoing destination provided")
+
+var errDuplicateOutGoingDestination = errors.New("duplicate outgoing destination provided")
+
+var errInvalidDestinationHasherSize = errors.New("invalid outgoing destination hasher size")
+
+var errDuplicateSubscribedEvent = errors.New("subscribed event is routed to multiple outgoing destinations")
+
+var errEmptyOutGoingDestinationName = errors.New("empty outgoing destination name provided")
+
+var errBridgeOpHandlerNotFound = errors.New("bridge operations handler not found for destination")
+
+var errTooManyIncomingChains = errors.New("too many incoming chains provided")
+
+var errEmptyIncomingChainID = errors.New("empty incoming chain id provided")
+
+var errDuplicateIncomingChainID = errors.New("duplicate incoming chain id provided")
+
+var errUnknownDestinationChainID = errors.New("outgoing destination chain id is not an incoming chain")
+
+var errUnknownOutGoingCodec = errors.New("unknown outgoing destination codec")
+
+var errInvalidVolumeLimit = errors.New("invalid bridge rate limit volume provided")
+
+var errEmptyRateLimitTokenIdentifier = errors.New("empty bridge rate limit token identifier provided")
+
+var errInvalidRateLimitTokenDecimals = errors.New("invalid bridge rate limit token decimals provided")
+
+var errDuplicateRateLimitToken = errors.New("duplicate bridge rate limit token provided")
+
+var errNoValidatorsForNextEpoch = errors.New("no eligible validators computed for the next epoch")
+
+var errEmptyValidatorSetRotationReceiver = errors.New("empty validator set rotation receiver provided")
+
+var errSovereignHeaderForMainChain = errors.New("sovereign chain header received with the main chain id")
@@ -0,0 +1,199 @@
+package sovereign
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+// IncomingChain holds the local tracking data of a chain from which incoming headers are notarized
+type IncomingChain struct {
+	ChainID                string
+	ShardID                uint32
+	NotarizationStartRound uint64
+	ConfirmationDepth      uint64
+	NonceHashDataUnit      dataRetriever.UnitType
+}
+
+// ArgsIncomingChainsHandler holds the arguments needed to create an incoming chains handler
+type ArgsIncomingChainsHandler struct {
+	MainChainID                     string
+	MainChainNotarizationStartRound uint64
+	MainChainConfirmationDepth      uint64
+	IncomingChains                  []config.IncomingChain
+}
+
+type incomingChainsHandler struct {
+	chains           []IncomingChain
+	chainsByShardID  map[uint32]IncomingChain
+	shardIDByChainID map[string]uint32
+	isMainChainIDSet bool
+}
+
+// NewIncomingChainsHandler creates the handler of the chains from which incoming headers are notarized. The main chain
+// is always tracked under core.MainChainShardId, while each additional incoming chain is tracked under its own shard
+// ID, derived from its position in config, and has its own extended shard headers nonce-hash pair data unit.
+// Extended shard headers are mapped to their incoming chain by the chain ID of the wrapped header. Headers with an
+// unknown chain ID are rejected, unless no main chain ID is provided, in which case they are mapped to the main chain,
+// as they were before multiple incoming chains were supported.
+func NewIncomingChainsHandler(args ArgsIncomingChainsHandler) (*incomingChainsHandler, error) {
+	if len(args.MainChainID) == 0 {
+		log.Warn("NewIncomingChainsHandler: no main chain id provided, headers with an unknown chain id will be " +
+			"mapped to the main chain")
+	}
+	if len(args.IncomingChains) > dataRetriever.MaxNumOfIncomingChains {
+		return nil, fmt.Errorf("%w, num incoming chains = %d, max num incoming chains = %d",
+			errTooManyIncomingChains, len(args.IncomingChains), dataRetriever.MaxNumOfIncomingChains)
+	}
+
+	mainChain := IncomingChain{
+		ChainID:                args.MainChainID,
+		ShardID:                core.MainChainShardId,
+		NotarizationStartRound: args.MainChainNotarizationStartRound,
+		ConfirmationDepth:      args.MainChainConfirmationDepth,
+		NonceHashDataUnit:      dataRetriever.ExtendedShardHeadersNonceHashDataUnit,
+	}
+
+	ich := &incomingChainsHandler{
+		chains:           []IncomingChain{mainChain},
+		chainsByShardID:  map[uint32]IncomingChain{mainChain.ShardID: mainChain},
+		shardIDByChainID: make(map[string]uint32),
+		isMainChainIDSet: len(args.MainChainID) > 0,
+	}
+	if ich.isMainChainIDSet {
+		ich.shardIDByChainID[mainChain.ChainID] = mainChain.ShardID
+	}
+
+	for idx, chainConfig := range args.IncomingChains {
+		if len(chainConfig.ChainID) == 0 {
+			return nil, fmt.Errorf("%w at index = %d", errEmptyIncomingChainID, idx)
+		}
+		if _, exists := ich.shardIDByChainID[chainConfig.ChainID]; exists {
+			return nil, fmt.Errorf("%w: %s", errDuplicateIncomingChainID, chainConfig.ChainID)
+		}
+
+		chain := IncomingChain{
+			ChainID:                chainConfig.ChainID,
+			ShardID:                GetIncomingChainShardID(idx),
+			NotarizationStartRound: chainConfig.NotarizationStartRound,
+			ConfirmationDepth:      chainConfig.ConfirmationDepth,
+			NonceHashDataUnit:      dataRetriever.IncomingChainHdrNonceHashDataUnit + dataRetriever.UnitType(idx),
+		}
+
+		ich.chains = append(ich.chains, chain)
+		ich.chainsByShardID[chain.ShardID] = chain
+		ich.shardIDByChainID[chain.ChainID] = chain.ShardID
+	}
+
+	return ich, nil
+}
+
+// GetIncomingChainShardID returns the shard ID under which the additional incoming chain with the provided config index
+// is tracked
+func GetIncomingChainShardID(index int) uint32 {
+	return core.MainChainShardId - 1 - uint32(index)
+}
+
+// GetIncomingChains returns all the incoming chains, starting with the main chain
+func (ich *incomingChainsHandler) GetIncomingChains() []IncomingChain {
+	chains := make([]IncomingChain, len(ich.chains))
+	copy(chains, ich.chains)
+
+	return chains
+}
+
+// GetShardIDs returns the shard IDs of all incoming chains, starting with the main chain
+func (ich *incomingChainsHandler) GetShardIDs() []uint32 {
+	shardIDs := make([]uint32, 0, len(ich.chains))
+	for _, chain := range ich.chains {
+		shardIDs = append(shardIDs, chain.ShardID)
+	}
+
+	return shardIDs
+}
+
+// GetShardIDForHeader returns the shard ID of the incoming chain from which the provided header was received. Headers
+// with a chain ID which does not belong to any of the incoming chains are rejected, as well as the wrapped sovereign
+// chain headers claiming to belong to the main chain. If no main chain ID was provided, the headers with an unknown
+// chain ID are mapped to the main chain.
+func (ich *incomingChainsHandler) GetShardIDForHeader(header data.HeaderHandler) (uint32, error) {
+	if check.IfNil(header) {
+		return 0, data.ErrNilHeader
+	}
+
+	shardID, found := ich.shardIDByChainID[string(header.GetChainID())]
+	if !found && ich.isMainChainIDSet {
+		return 0, fmt.Errorf("%w: %s", errors.ErrUnknownIncomingChainID, header.GetChainID())
+	}
+	if !found {
+		shardID = core.MainChainShardId
+	}
+	if shardID == core.MainChainShardId && isSovereignChainHeaderEnvelope(header) {
+		return 0, fmt.Errorf("%w: %s", errSovereignHeaderForMainChain, header.GetChainID())
+	}
+
+	return shardID, nil
+}
+
+func isSovereignChainHeaderEnvelope(header data.HeaderHandler) bool {
+	switch castedHeader := header.(type) {
+	case *block.ShardHeaderExtended:
+		return IsSovereignChainHeaderEnvelope(castedHeader.Header)
+	case *block.HeaderV2:
+		return IsSovereignChainHeaderEnvelope(castedHeader)
+	default:
+		return false
+	}
+}
+
+// GetShardIDForChainID returns the shard ID under which the incoming chain with the provided chain ID is tracked and
+// whether the chain is known. An empty chain ID, as well as the configured main chain ID, stand for the main chain.
+func (ich *incomingChainsHandler) GetShardIDForChainID(chainID string) (uint32, bool) {
+	if len(chainID) == 0 {
+		return core.MainChainShardId, true
+	}
+
+	shardID, found := ich.shardIDByChainID[chainID]
+	return shardID, found
+}
+
+// GetNotarizationStartRound returns the round from which headers of the incoming chain with the provided shard ID are
+// notarized
+func (ich *incomingChainsHandler) GetNotarizationStartRound(shardID uint32) uint64 {
+	return ich.chainsByShardID[shardID].NotarizationStartRound
+}
+
+// GetConfirmationDepth returns the number of headers which have to be received on top of a header of the incoming chain
+// with the provided shard ID, before the header becomes executable
+func (ich *incomingChainsHandler) GetConfirmationDepth(shardID uint32) uint64 {
+	return ich.chainsByShardID[shardID].ConfirmationDepth
+}
+
+// GetNonceHashDataUnit returns the extended shard headers nonce-hash pair data unit of the incoming chain with the
+// provided shard ID
+func (ich *incomingChainsHandler) GetNonceHashDataUnit(shardID uint32) dataRetriever.UnitType {
+	chain, found := ich.chainsByShardID[shardID]
+	if !found {
+		return dataRetriever.ExtendedShardHeadersNonceHashDataUnit
+	}
+
+	return chain.NonceHashDataUnit
+}
+
+// IsIncomingChainShard returns true if the provided shard ID belongs to an incoming chain
+func (ich *incomingChainsHandler) IsIncomingChainShard(shardID uint32) bool {
+	_, found := ich.chainsByShardID[shardID]
+	return found
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (ich *incomingChainsHandler) IsInterfaceNil() bool {
+	return ich == nil
+}
@@ -0,0 +1,209 @@
+package sovereign
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+)
+
+func createArgsIncomingChainsHandler() ArgsIncomingChainsHandler {
+	return ArgsIncomingChainsHandler{
+		MainChainID:                     "1",
+		MainChainNotarizationStartRound: 10,
+		MainChainConfirmationDepth:      1,
+		IncomingChains: []config.IncomingChain{
+			{
+				ChainID:                "sov2",
+				NotarizationStartRound: 20,
+				ConfirmationDepth:      2,
+			},
+			{
+				ChainID:                "sov3",
+				NotarizationStartRound: 30,
+			},
+		},
+	}
+}
+
+func TestNewIncomingChainsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty main chain id, should map unknown chain ids to the main chain", func(t *testing.T) {
+		args := createArgsIncomingChainsHandler()
+		args.MainChainID = ""
+
+		handler, err := NewIncomingChainsHandler(args)
+		require.Nil(t, err)
+
+		shardID, err := handler.GetShardIDForHeader(&block.HeaderV2{Header: &block.Header{ChainID: []byte("unknown")}})
+		require.Nil(t, err)
+		require.Equal(t, core.MainChainShardId, shardID)
+		shardID, err = handler.GetShardIDForHeader(&block.HeaderV2{Header: &block.Header{ChainID: []byte("sov2")}})
+		require.Nil(t, err)
+		require.Equal(t, GetIncomingChainShardID(0), shardID)
+
+		sovereignHeader, err := WrapSovereignChainHeader(&marshallerMock.MarshalizerMock{}, &block.SovereignChainHeader{
+			Header: &block.Header{ChainID: []byte("unknown")},
+		})
+		require.Nil(t, err)
+		_, err = handler.GetShardIDForHeader(sovereignHeader)
+		require.ErrorIs(t, err, errSovereignHeaderForMainChain)
+	})
+
+	t.Run("too many incoming chains, should return error", func(t *testing.T) {
+		args := createArgsIncomingChainsHandler()
+		args.IncomingChains = make([]config.IncomingChain, dataRetriever.MaxNumOfIncomingChains+1)
+		for idx := range args.IncomingChains {
+			args.IncomingChains[idx].ChainID = fmt.Sprintf("chain%d", idx)
+		}
+
+		handler, err := NewIncomingChainsHandler(args)
+		require.Nil(t, handler)
+		require.ErrorIs(t, err, errTooManyIncomingChains)
+	})
+
+	t.Run("empty chain id, should return error", func(t *testing.T) {
+		args := createArgsIncomingChainsHandler()
+		args.IncomingChains[1].ChainID = ""
+
+		handler, err := NewIncomingChainsHandler(args)
+		require.Nil(t, handler)
+		require.ErrorIs(t, err, errEmptyIncomingChainID)
+	})
+
+	t.Run("duplicate chain id, should return error", func(t *testing.T) {
+		args := createArgsIncomingChainsHandler()
+		args.IncomingChains[1].ChainID = args.IncomingChains[0].ChainID
+
+		handler, err := NewIncomingChainsHandler(args)
+		require.Nil(t, handler)
+		require.ErrorIs(t, err, errDuplicateIncomingChainID)
+	})
+
+	t.Run("incoming chain id same as main chain id, should return error", func(t *testing.T) {
+		args := createArgsIncomingChainsHandler()
+		args.IncomingChains[0].ChainID = args.MainChainID
+
+		handler, err := NewIncomingChainsHandler(args)
+		require.Nil(t, handler)
+		require.ErrorIs(t, err, errDuplicateIncomingChainID)
+	})
+
+	t.Run("no additional incoming chains, should work", func(t *testing.T) {
+		handler, err := NewIncomingChainsHandler(ArgsIncomingChainsHandler{MainChainID: "1"})
+		require.Nil(t, err)
+		require.False(t, handler.IsInterfaceNil())
+		require.Equal(t, []uint32{core.MainChainShardId}, handler.GetShardIDs())
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		handler, err := NewIncomingChainsHandler(createArgsIncomingChainsHandler())
+		require.Nil(t, err)
+		require.False(t, handler.IsInterfaceNil())
+
+		expectedChains := []IncomingChain{
+			{
+				ChainID:                "1",
+				ShardID:                core.MainChainShardId,
+				NotarizationStartRound: 10,
+				ConfirmationDepth:      1,
+				NonceHashDataUnit:      dataRetriever.ExtendedShardHeadersNonceHashDataUnit,
+			},
+			{
+				ChainID:                "sov2",
+				ShardID:                core.MainChainShardId - 1,
+				NotarizationStartRound: 20,
+				ConfirmationDepth:      2,
+				NonceHashDataUnit:      dataRetriever.IncomingChainHdrNonceHashDataUnit,
+			},
+			{
+				ChainID:                "sov3",
+				ShardID:                core.MainChainShardId - 2,
+				NotarizationStartRound: 30,
+				NonceHashDataUnit:      dataRetriever.IncomingChainHdrNonceHashDataUnit + 1,
+			},
+		}
+		require.Equal(t, expectedChains, handler.GetIncomingChains())
+	})
+}
+
+func TestIncomingChainsHandler_Getters(t *testing.T) {
+	t.Parallel()
+
+	handler, _ := NewIncomingChainsHandler(createArgsIncomingChainsHandler())
+	sov2ShardID := GetIncomingChainShardID(0)
+	sov3ShardID := GetIncomingChainShardID(1)
+
+	require.Equal(t, []uint32{core.MainChainShardId, sov2ShardID, sov3ShardID}, handler.GetShardIDs())
+
+	_, err := handler.GetShardIDForHeader(nil)
+	require.Equal(t, data.ErrNilHeader, err)
+	_, err = handler.GetShardIDForHeader(&block.HeaderV2{Header: &block.Header{ChainID: []byte("unknown")}})
+	require.ErrorIs(t, err, errorsMx.ErrUnknownIncomingChainID)
+	_, err = handler.GetShardIDForHeader(&block.HeaderV2{Header: &block.Header{}})
+	require.ErrorIs(t, err, errorsMx.ErrUnknownIncomingChainID)
+
+	shardID, err := handler.GetShardIDForHeader(&block.HeaderV2{Header: &block.Header{ChainID: []byte("1")}})
+	require.Nil(t, err)
+	require.Equal(t, core.MainChainShardId, shardID)
+	shardID, err = handler.GetShardIDForHeader(&block.HeaderV2{Header: &block.Header{ChainID: []byte("sov2")}})
+	require.Nil(t, err)
+	require.Equal(t, sov2ShardID, shardID)
+	shardID, err = handler.GetShardIDForHeader(&block.ShardHeaderExtended{
+		Header: &block.HeaderV2{Header: &block.Header{ChainID: []byte("sov3")}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, sov3ShardID, shardID)
+
+	sovereignHeader, err := WrapSovereignChainHeader(&marshallerMock.MarshalizerMock{}, &block.SovereignChainHeader{
+		Header: &block.Header{ChainID: []byte("1")},
+	})
+	require.Nil(t, err)
+	_, err = handler.GetShardIDForHeader(&block.ShardHeaderExtended{Header: sovereignHeader})
+	require.ErrorIs(t, err, errSovereignHeaderForMainChain)
+	sovereignHeader, err = WrapSovereignChainHeader(&marshallerMock.MarshalizerMock{}, &block.SovereignChainHeader{
+		Header: &block.Header{ChainID: []byte("sov2")},
+	})
+	require.Nil(t, err)
+	shardID, err = handler.GetShardIDForHeader(&block.ShardHeaderExtended{Header: sovereignHeader})
+	require.Nil(t, err)
+	require.Equal(t, sov2ShardID, shardID)
+
+	shardID, found := handler.GetShardIDForChainID("")
+	require.True(t, found)
+	require.Equal(t, core.MainChainShardId, shardID)
+	shardID, found = handler.GetShardIDForChainID("1")
+	require.True(t, found)
+	require.Equal(t, core.MainChainShardId, shardID)
+	shardID, found = handler.GetShardIDForChainID("sov3")
+	require.True(t, found)
+	require.Equal(t, sov3ShardID, shardID)
+	_, found = handler.GetShardIDForChainID("unknown")
+	require.False(t, found)
+
+	require.Equal(t, uint64(10), handler.GetNotarizationStartRound(core.MainChainShardId))
+	require.Equal(t, uint64(30), handler.GetNotarizationStartRound(sov3ShardID))
+	require.Zero(t, handler.GetNotarizationStartRound(core.SovereignChainShardId))
+
+	require.Equal(t, uint64(1), handler.GetConfirmationDepth(core.MainChainShardId))
+	require.Equal(t, uint64(2), handler.GetConfirmationDepth(sov2ShardID))
+	require.Zero(t, handler.GetConfirmationDepth(sov3ShardID))
+
+	require.Equal(t, dataRetriever.ExtendedShardHeadersNonceHashDataUnit, handler.GetNonceHashDataUnit(core.MainChainShardId))
+	require.Equal(t, dataRetriever.IncomingChainHdrNonceHashDataUnit, handler.GetNonceHashDataUnit(sov2ShardID))
+	require.Equal(t, dataRetriever.ExtendedShardHeadersNonceHashDataUnit, handler.GetNonceHashDataUnit(core.SovereignChainShardId))
+
+	require.True(t, handler.IsIncomingChainShard(core.MainChainShardId))
+	require.True(t, handler.IsIncomingChainShard(sov3ShardID))
+	require.False(t, handler.IsIncomingChainShard(core.SovereignChainShardId))
+	require.False(t, handler.IsIncomingChainShard(GetIncomingChainShardID(2)))
+}
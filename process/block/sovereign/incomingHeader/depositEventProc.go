@@ -13,12 +13,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 
 	"github.com/multiversx/mx-chain-go/common"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 )
 
 type eventData struct {
 	nonce                uint64
+	sender               []byte
 	functionCallWithArgs []byte
 	gasLimit             uint64
+	messageCall          []byte
 }
 
 type depositEventProc struct {
@@ -28,8 +31,13 @@ type depositEventProc struct {
 	topicsChecker TopicsChecker
 }
 
-// ProcessEvent will process incoming token deposit events and return an incoming scr info
-func (dep *depositEventProc) ProcessEvent(event data.EventHandler) (*EventResult, error) {
+// ProcessEvent will process incoming token deposit and message events and return an incoming scr info. Message events
+// carry no tokens, only a contract call for the receiver. Since the incoming scrs are sent from the ESDT system SC
+// address, the main chain sender of a message is set as the original sender of its scr and is appended as the last
+// argument of the contract call, so that the receiver can authenticate it. If the main chain transaction which
+// generated the event is known, it will be set as the original tx hash of the incoming scr, while the index of the
+// event in that transaction's logs will be saved in the scr's return message.
+func (dep *depositEventProc) ProcessEvent(event data.EventHandler, txInfo *EventTxInfo) (*EventResult, error) {
 	topics := event.GetTopics()
 	err := dep.topicsChecker.CheckValidity(topics)
 	if err != nil {
@@ -41,32 +49,43 @@ func (dep *depositEventProc) ProcessEvent(event data.EventHandler) (*EventResult
 		return nil, err
 	}
 
-	scrData, err := dep.createSCRData(topics)
+	scrData, tokens, err := dep.createSCRData(topics, receivedEventData)
 	if err != nil {
 		return nil, err
 	}
 
-	scrData = append(scrData, receivedEventData.functionCallWithArgs...)
 	scr := &smartContractResult.SmartContractResult{
-		Nonce:          receivedEventData.nonce,
-		OriginalTxHash: nil, // TODO:  Implement this in MX-14321 task
-		RcvAddr:        topics[1],
-		SndAddr:        core.ESDTSCAddress,
-		Data:           scrData,
-		Value:          big.NewInt(0),
-		GasLimit:       receivedEventData.gasLimit,
+		Nonce:    receivedEventData.nonce,
+		RcvAddr:  topics[1],
+		SndAddr:  core.ESDTSCAddress,
+		Data:     scrData,
+		Value:    big.NewInt(0),
+		GasLimit: receivedEventData.gasLimit,
 	}
+	if isMessageEvent(topics) {
+		scr.OriginalSender = receivedEventData.sender
+	}
+	setEventTxInfo(scr, txInfo)
 
 	hash, err := core.CalculateHash(dep.marshaller, dep.hasher, scr)
 	if err != nil {
 		return nil, err
 	}
 
+	if txInfo != nil {
+		log.Trace("depositEventProc.ProcessEvent: created incoming scr",
+			"hash", hash,
+			"main chain tx hash", txInfo.TxHash,
+			"event index", txInfo.EventIndex,
+		)
+	}
+
 	return &EventResult{
 		SCR: &SCRInfo{
 			SCR:  scr,
 			Hash: hash,
 		},
+		Tokens: tokens,
 	}, nil
 }
 
@@ -79,8 +98,10 @@ func (dep *depositEventProc) createEventData(data []byte) (*eventData, error) {
 	gasLimit, functionCallWithArgs := extractSCTransferInfo(evData.TransferData)
 	return &eventData{
 		nonce:                evData.Nonce,
+		sender:               evData.Sender,
 		functionCallWithArgs: functionCallWithArgs,
 		gasLimit:             gasLimit,
+		messageCall:          extractMessageCall(evData.TransferData),
 	}, nil
 }
 
@@ -98,6 +119,16 @@ func extractSCTransferInfo(transferData *sovereign.TransferData) (uint64, []byte
 	return gasLimit, functionCallWithArgs
 }
 
+// extractMessageCall creates the contract call data of a message, which is not preceded by any token transfer
+func extractMessageCall(transferData *sovereign.TransferData) []byte {
+	if transferData == nil || len(transferData.Function) == 0 {
+		return make([]byte, 0)
+	}
+
+	messageCall := append([]byte{}, transferData.Function...)
+	return append(messageCall, extractArguments(transferData.Args)...)
+}
+
 func extractArguments(arguments [][]byte) []byte {
 	if len(arguments) == 0 {
 		return make([]byte, 0)
@@ -112,18 +143,54 @@ func extractArguments(arguments [][]byte) []byte {
 	return args
 }
 
-func (dep *depositEventProc) createSCRData(topics [][]byte) ([]byte, error) {
+// isMessageEvent returns true for the events which carry no token topics, only a contract call
+func isMessageEvent(topics [][]byte) bool {
+	return len(topics) == tokensIndex
+}
+
+// createSCRData creates the data of the incoming scr, together with the transferred token volumes. Deposits create a
+// multi transfer followed by the optional contract call, while messages only create the contract call, having the
+// main chain sender as last argument.
+func (dep *depositEventProc) createSCRData(topics [][]byte, evData *eventData) ([]byte, []*sovBlock.TokenVolume, error) {
+	if isMessageEvent(topics) {
+		if len(evData.messageCall) == 0 {
+			return nil, nil, errEmptyMessageCall
+		}
+		if len(evData.sender) == 0 {
+			return nil, nil, errEmptyMessageSender
+		}
+
+		messageCall := append([]byte{}, evData.messageCall...)
+		messageCall = append(messageCall, extractArguments([][]byte{evData.sender})...)
+		return messageCall, make([]*sovBlock.TokenVolume, 0), nil
+	}
+
+	scrData, tokens, err := dep.createMultiTransferData(topics)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return append(scrData, evData.functionCallWithArgs...), tokens, nil
+}
+
+// createMultiTransferData creates the multi transfer data of the incoming scr, together with the transferred token volumes
+func (dep *depositEventProc) createMultiTransferData(topics [][]byte) ([]byte, []*sovBlock.TokenVolume, error) {
 	numTokensToTransfer := len(topics[tokensIndex:]) / numTransferTopics
 	numTokensToTransferBytes := big.NewInt(int64(numTokensToTransfer)).Bytes()
 
 	ret := []byte(core.BuiltInFunctionMultiESDTNFTTransfer +
 		"@" + hex.EncodeToString(numTokensToTransferBytes))
 
+	tokens := make([]*sovBlock.TokenVolume, 0, numTokensToTransfer)
 	for idx := tokensIndex; idx < len(topics); idx += numTransferTopics {
-		tokenData, err := dep.getTokenDataBytes(topics[idx+1], topics[idx+2])
+		tokenData, amount, err := dep.getTokenDataBytes(topics[idx+1], topics[idx+2])
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		tokens = append(tokens, &sovBlock.TokenVolume{
+			Identifier: topics[idx],
+			Amount:     amount,
+		})
 
 		transfer := []byte("@" +
 			hex.EncodeToString(topics[idx]) + // tokenID
@@ -133,24 +200,33 @@ func (dep *depositEventProc) createSCRData(topics [][]byte) ([]byte, error) {
 		ret = append(ret, transfer...)
 	}
 
-	return ret, nil
+	return ret, tokens, nil
 }
 
-func (dep *depositEventProc) getTokenDataBytes(tokenNonce []byte, tokenData []byte) ([]byte, error) {
+func (dep *depositEventProc) getTokenDataBytes(tokenNonce []byte, tokenData []byte) ([]byte, *big.Int, error) {
 	esdtTokenData, err := dep.dataCodec.DeserializeTokenData(tokenData)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if esdtTokenData.TokenType == core.Fungible {
-		return esdtTokenData.Amount.Bytes(), nil
+		return esdtTokenData.Amount.Bytes(), esdtTokenData.Amount, nil
 	}
 
 	nonce, err := common.ByteSliceToUint64(tokenNonce)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	digitalTokenBytes, err := createDigitalTokenBytes(dep.marshaller, nonce, esdtTokenData)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	return digitalTokenBytes, esdtTokenData.Amount, nil
+}
+
+func createDigitalTokenBytes(marshaller marshal.Marshalizer, nonce uint64, esdtTokenData *sovereign.EsdtTokenData) ([]byte, error) {
 	digitalToken := &esdt.ESDigitalToken{
 		Type:  uint32(esdtTokenData.TokenType),
 		Value: esdtTokenData.Amount,
@@ -165,7 +241,7 @@ func (dep *depositEventProc) getTokenDataBytes(tokenNonce []byte, tokenData []by
 		},
 	}
 
-	return dep.marshaller.Marshal(digitalToken)
+	return marshaller.Marshal(digitalToken)
 }
 
 // IsInterfaceNil checks if the underlying pointer is nil
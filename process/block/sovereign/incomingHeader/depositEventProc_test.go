@@ -6,9 +6,12 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/stretchr/testify/require"
 
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
 )
@@ -42,6 +45,7 @@ func TestDepositEventProc_createEventData(t *testing.T) {
 		require.Nil(t, err)
 		require.Equal(t, &eventData{
 			functionCallWithArgs: make([]byte, 0),
+			messageCall:          make([]byte, 0),
 		}, ret)
 	})
 
@@ -73,6 +77,7 @@ func TestDepositEventProc_createEventData(t *testing.T) {
 		expectedArgs := append([]byte("@"), hex.EncodeToString(func1)...)
 		require.Equal(t, &eventData{
 			functionCallWithArgs: expectedArgs,
+			messageCall:          func1,
 		}, ret)
 	})
 
@@ -110,6 +115,7 @@ func TestDepositEventProc_createEventData(t *testing.T) {
 		expectedArgs = append(expectedArgs, "@"+hex.EncodeToString(arg2)...)
 		require.Equal(t, &eventData{
 			functionCallWithArgs: expectedArgs,
+			messageCall:          []byte(string(func1) + "@" + hex.EncodeToString(arg1) + "@" + hex.EncodeToString(arg2)),
 		}, ret)
 	})
 }
@@ -137,6 +143,7 @@ func TestDepositEventProc_createSCRData(t *testing.T) {
 			return &sovereign.EsdtTokenData{
 				TokenType: core.NonFungible,
 				Royalties: big.NewInt(0),
+				Amount:    big.NewInt(1),
 			}, nil
 		},
 	}
@@ -153,8 +160,9 @@ func TestDepositEventProc_createSCRData(t *testing.T) {
 		topicsChecker: args.TopicsChecker,
 	}
 
-	ret, err := handler.createSCRData(topics)
+	ret, tokens, err := handler.createSCRData(topics, &eventData{})
 	require.Nil(t, err)
+	require.Equal(t, []*sovBlock.TokenVolume{{Identifier: nft, Amount: big.NewInt(1)}}, tokens)
 
 	expectedSCR := []byte(core.BuiltInFunctionMultiESDTNFTTransfer + "@01")
 	expectedSCR = append(expectedSCR, "@"+hex.EncodeToString(nft)...)
@@ -162,3 +170,96 @@ func TestDepositEventProc_createSCRData(t *testing.T) {
 	expectedSCR = append(expectedSCR, "@"+hex.EncodeToString(nftData)...)
 	require.Equal(t, expectedSCR, ret)
 }
+
+func TestDepositEventProc_ProcessMessageEvent(t *testing.T) {
+	t.Parallel()
+
+	receiver := []byte("rcv")
+	topics := [][]byte{[]byte(topicIDDepositIncomingTransfer), receiver}
+
+	t.Run("message without contract call should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeEventDataCalled: func(_ []byte) (*sovereign.EventData, error) {
+				return &sovereign.EventData{Nonce: 1}, nil
+			},
+		}
+		handler := &depositEventProc{
+			marshaller:    args.Marshaller,
+			hasher:        args.Hasher,
+			dataCodec:     args.DataCodec,
+			topicsChecker: args.TopicsChecker,
+		}
+
+		res, err := handler.ProcessEvent(&transaction.Event{Topics: topics}, nil)
+		require.Equal(t, errEmptyMessageCall, err)
+		require.Nil(t, res)
+	})
+	t.Run("message without sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeEventDataCalled: func(_ []byte) (*sovereign.EventData, error) {
+				return &sovereign.EventData{
+					Nonce: 1,
+					TransferData: &sovereign.TransferData{
+						GasLimit: 5000,
+						Function: []byte("func1"),
+					},
+				}, nil
+			},
+		}
+		handler := &depositEventProc{
+			marshaller:    args.Marshaller,
+			hasher:        args.Hasher,
+			dataCodec:     args.DataCodec,
+			topicsChecker: args.TopicsChecker,
+		}
+
+		res, err := handler.ProcessEvent(&transaction.Event{Topics: topics}, nil)
+		require.Equal(t, errEmptyMessageSender, err)
+		require.Nil(t, res)
+	})
+	t.Run("should create contract call scr with the original sender", func(t *testing.T) {
+		t.Parallel()
+
+		arg1 := []byte("arg1")
+		sender := []byte("sender")
+		args := createArgs()
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeEventDataCalled: func(_ []byte) (*sovereign.EventData, error) {
+				return &sovereign.EventData{
+					Nonce:  4,
+					Sender: sender,
+					TransferData: &sovereign.TransferData{
+						GasLimit: 5000,
+						Function: []byte("func1"),
+						Args:     [][]byte{arg1},
+					},
+				}, nil
+			},
+		}
+		handler := &depositEventProc{
+			marshaller:    args.Marshaller,
+			hasher:        args.Hasher,
+			dataCodec:     args.DataCodec,
+			topicsChecker: args.TopicsChecker,
+		}
+
+		res, err := handler.ProcessEvent(&transaction.Event{Topics: topics}, nil)
+		require.Nil(t, err)
+		require.Empty(t, res.Tokens)
+		require.Equal(t, &smartContractResult.SmartContractResult{
+			Nonce:          4,
+			RcvAddr:        receiver,
+			SndAddr:        core.ESDTSCAddress,
+			OriginalSender: sender,
+			Data:           []byte("func1@" + hex.EncodeToString(arg1) + "@" + hex.EncodeToString(sender)),
+			Value:          big.NewInt(0),
+			GasLimit:       5000,
+		}, res.SCR.SCR)
+	})
+}
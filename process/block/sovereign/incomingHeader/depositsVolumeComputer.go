@@ -0,0 +1,80 @@
+package incomingHeader
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
+)
+
+// ArgsDepositsVolumeComputer holds the arguments needed to create a deposits volume computer
+type ArgsDepositsVolumeComputer struct {
+	Marshaller    marshal.Marshalizer
+	Hasher        hashing.Hasher
+	DataCodec     SovereignDataCodec
+	TopicsChecker TopicsChecker
+}
+
+type depositsVolumeComputer struct {
+	depositProc *depositEventProc
+}
+
+// NewDepositsVolumeComputer creates a computer of the token volumes moved by the incoming deposits of an extended shard
+// header. The deposits are decoded the same way as when the incoming scrs are created, so that an invalid deposit fails
+// the computation the same way it rejects the incoming header.
+func NewDepositsVolumeComputer(args ArgsDepositsVolumeComputer) (*depositsVolumeComputer, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if check.IfNil(args.DataCodec) {
+		return nil, errors.ErrNilDataCodec
+	}
+	if check.IfNil(args.TopicsChecker) {
+		return nil, errors.ErrNilTopicsChecker
+	}
+
+	return &depositsVolumeComputer{
+		depositProc: &depositEventProc{
+			marshaller:    args.Marshaller,
+			hasher:        args.Hasher,
+			dataCodec:     args.DataCodec,
+			topicsChecker: args.TopicsChecker,
+		},
+	}, nil
+}
+
+// ComputeDepositsVolumes returns the token volumes moved by all the deposits from the incoming events of the provided
+// extended shard header
+func (dvc *depositsVolumeComputer) ComputeDepositsVolumes(header data.ShardHeaderExtendedHandler) ([]*sovBlock.TokenVolume, error) {
+	if check.IfNil(header) {
+		return nil, data.ErrNilHeader
+	}
+
+	tokens := make([]*sovBlock.TokenVolume, 0)
+	for _, event := range header.GetIncomingEventHandlers() {
+		if string(event.GetIdentifier()) != eventIDDepositIncomingTransfer {
+			continue
+		}
+
+		res, err := dvc.depositProc.ProcessEvent(event, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, res.Tokens...)
+	}
+
+	return tokens, nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (dvc *depositsVolumeComputer) IsInterfaceNil() bool {
+	return dvc == nil
+}
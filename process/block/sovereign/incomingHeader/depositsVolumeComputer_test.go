@@ -0,0 +1,154 @@
+package incomingHeader
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createDepositsVolumeComputerArgs() ArgsDepositsVolumeComputer {
+	return ArgsDepositsVolumeComputer{
+		Marshaller: &marshallerMock.MarshalizerMock{},
+		Hasher:     &hashingMocks.HasherMock{},
+		DataCodec: &sovTests.DataCodecMock{
+			DeserializeTokenDataCalled: func(data []byte) (*sovereign.EsdtTokenData, error) {
+				amount, _ := big.NewInt(0).SetString(string(data), 10)
+				return &sovereign.EsdtTokenData{
+					TokenType: core.Fungible,
+					Amount:    amount,
+				}, nil
+			},
+		},
+		TopicsChecker: &sovTests.TopicsCheckerMock{
+			CheckValidityCalled: func(topics [][]byte) error {
+				if len(topics)%3 != 2 {
+					return errInvalidNumTopicsIncomingEvent
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func TestNewDepositsVolumeComputer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller, should return error", func(t *testing.T) {
+		args := createDepositsVolumeComputerArgs()
+		args.Marshaller = nil
+
+		computer, err := NewDepositsVolumeComputer(args)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+		require.Nil(t, computer)
+	})
+
+	t.Run("nil hasher, should return error", func(t *testing.T) {
+		args := createDepositsVolumeComputerArgs()
+		args.Hasher = nil
+
+		computer, err := NewDepositsVolumeComputer(args)
+		require.Equal(t, core.ErrNilHasher, err)
+		require.Nil(t, computer)
+	})
+
+	t.Run("nil data codec, should return error", func(t *testing.T) {
+		args := createDepositsVolumeComputerArgs()
+		args.DataCodec = nil
+
+		computer, err := NewDepositsVolumeComputer(args)
+		require.Equal(t, errorsMx.ErrNilDataCodec, err)
+		require.Nil(t, computer)
+	})
+
+	t.Run("nil topics checker, should return error", func(t *testing.T) {
+		args := createDepositsVolumeComputerArgs()
+		args.TopicsChecker = nil
+
+		computer, err := NewDepositsVolumeComputer(args)
+		require.Equal(t, errorsMx.ErrNilTopicsChecker, err)
+		require.Nil(t, computer)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		computer, err := NewDepositsVolumeComputer(createDepositsVolumeComputerArgs())
+		require.Nil(t, err)
+		require.False(t, computer.IsInterfaceNil())
+	})
+}
+
+func TestDepositsVolumeComputer_ComputeDepositsVolumes(t *testing.T) {
+	t.Parallel()
+
+	createExtendedHeader := func(events ...*transaction.Event) data.ShardHeaderExtendedHandler {
+		return &block.ShardHeaderExtended{
+			Header:         &block.HeaderV2{Header: &block.Header{}},
+			IncomingEvents: events,
+		}
+	}
+
+	t.Run("nil header, should return error", func(t *testing.T) {
+		computer, _ := NewDepositsVolumeComputer(createDepositsVolumeComputerArgs())
+
+		tokens, err := computer.ComputeDepositsVolumes(nil)
+		require.Equal(t, data.ErrNilHeader, err)
+		require.Nil(t, tokens)
+	})
+
+	t.Run("invalid deposit, should return error", func(t *testing.T) {
+		computer, _ := NewDepositsVolumeComputer(createDepositsVolumeComputerArgs())
+
+		header := createExtendedHeader(
+			&transaction.Event{
+				Identifier: []byte(eventIDDepositIncomingTransfer),
+				Topics: [][]byte{[]byte(topicIDDepositIncomingTransfer), []byte("addr"),
+					[]byte("token1"), []byte("nonce"), []byte("100"),
+					[]byte("token2"), []byte("nonce"), []byte("5"),
+				},
+			},
+			&transaction.Event{
+				Identifier: []byte(eventIDExecutedOutGoingBridgeOp),
+				Topics:     [][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hashOfHashes"), []byte("hash")},
+			},
+			&transaction.Event{
+				Identifier: []byte(eventIDDepositIncomingTransfer),
+				Topics:     [][]byte{[]byte(topicIDDepositIncomingTransfer), []byte("addr"), []byte("token1"), []byte("nonce")},
+			},
+		)
+
+		tokens, err := computer.ComputeDepositsVolumes(header)
+		require.ErrorIs(t, err, errInvalidNumTopicsIncomingEvent)
+		require.Nil(t, tokens)
+	})
+
+	t.Run("error while processing a deposit, should return error", func(t *testing.T) {
+		args := createDepositsVolumeComputerArgs()
+		expectedErr := errors.New("expected error")
+		args.Marshaller = &marshallerMock.MarshalizerStub{
+			MarshalCalled: func(_ interface{}) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		computer, _ := NewDepositsVolumeComputer(args)
+
+		header := createExtendedHeader(&transaction.Event{
+			Identifier: []byte(eventIDDepositIncomingTransfer),
+			Topics:     [][]byte{[]byte(topicIDDepositIncomingTransfer), []byte("addr"), []byte("token1"), []byte("nonce"), []byte("100")},
+		})
+
+		tokens, err := computer.ComputeDepositsVolumes(header)
+		require.ErrorIs(t, err, expectedErr)
+		require.Nil(t, tokens)
+	})
+}
@@ -2,15 +2,21 @@ package incomingHeader
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 )
 
 const (
-	minTopicsInTransferEvent  = 5
-	numTransferTopics         = 3
-	numExecutedBridgeOpTopics = 3
-	tokensIndex               = 2
-	hashOfHashesIndex         = 1
-	hashOfOperationIndex      = 2
+	minTopicsInDepositEvent             = 2
+	numTransferTopics                   = 3
+	numExecutedBridgeOpTopics           = 3
+	numExecutedBridgeOpTopicsWithStatus = 4
+	tokensIndex                         = 2
+	hashOfHashesIndex                   = 1
+	hashOfOperationIndex                = 2
+	executionStatusIndex                = 3
+	numExecuteBridgeOpsArgs             = 2
+	numTransferDataArgs                 = 3
 )
 
 const (
@@ -19,6 +25,11 @@ const (
 
 	topicIDConfirmedOutGoingOperation = "executedBridgeOp"
 	topicIDDepositIncomingTransfer    = "deposit"
+
+	depositFunction          = "deposit"
+	executeBridgeOpsFunction = "executeBridgeOps"
+
+	returnOkData = "@6f6b"
 )
 
 // SCRInfo holds an incoming scr that is created based on an incoming cross chain event and its hash
@@ -27,14 +38,81 @@ type SCRInfo struct {
 	Hash []byte
 }
 
-// ConfirmedBridgeOp holds the hashes for a bridge operations that are confirmed from the main chain
+// EventTxInfo holds the main chain transaction hash which generated an incoming cross chain event, together with the
+// index of the event in that transaction's logs
+type EventTxInfo struct {
+	TxHash     []byte
+	EventIndex uint32
+}
+
+// BridgeOpExecutionStatus is the execution status on the main chain of a confirmed bridge operation
+type BridgeOpExecutionStatus uint8
+
+const (
+	// BridgeOpStatusUnknown is the status of the operations confirmed by legacy events, which carry no execution status
+	BridgeOpStatusUnknown BridgeOpExecutionStatus = iota
+	// BridgeOpStatusSuccessful is the status of the operations successfully executed on the main chain
+	BridgeOpStatusSuccessful
+	// BridgeOpStatusFailed is the status of the operations which failed on the main chain
+	BridgeOpStatusFailed
+)
+
+// ConfirmedBridgeOp holds the hashes for a bridge operations that are confirmed from the main chain, together with
+// the execution status of the operation on the main chain
 type ConfirmedBridgeOp struct {
 	HashOfHashes []byte
 	Hash         []byte
+	Status       BridgeOpExecutionStatus
 }
 
-// EventResult holds the result of processing an incoming cross chain event
+// NativeTokensUnlock holds the call which unlocks, from the sovereign safe contract, the sovereign native tokens of a
+// failed outgoing bridge operation. The native tokens are not refunded if the Address is empty.
+type NativeTokensUnlock struct {
+	Address  []byte
+	Function string
+	GasLimit uint64
+}
+
+// EventResult holds the result of processing an incoming cross chain event. Tokens holds the volumes bridged by the
+// event's scr, which are counted by the deposits volume computer against the incoming rate limits. ExecutedBridgeOpSCRs
+// holds the refund and callback scrs created for a confirmed outgoing bridge operation, which are not rate limited.
 type EventResult struct {
-	SCR               *SCRInfo
-	ConfirmedBridgeOp *ConfirmedBridgeOp
+	SCR                  *SCRInfo
+	ConfirmedBridgeOp    *ConfirmedBridgeOp
+	Tokens               []*sovBlock.TokenVolume
+	ExecutedBridgeOpSCRs []*SCRInfo
+}
+
+// EventSourceType is the type of the main chain transaction which generated an incoming event
+type EventSourceType uint8
+
+const (
+	// EventSourceTransaction is the type of the events generated by user transactions
+	EventSourceTransaction EventSourceType = iota
+	// EventSourceSmartContractResult is the type of the events generated by smart contract results, such as the cross
+	// shard calls to the bridge contract or the calls made to it through other contracts
+	EventSourceSmartContractResult
+)
+
+// EventMetadata holds the metadata attached by the notifier to an incoming event, which is carried inside the event
+// itself, so that it is part of the extended header received by every node. TxHash and EventIndex identify the main
+// chain transaction or smart contract result which generated the event and the index of the event in its logs. The
+// marshalled source and the marshalled main chain mini block which includes it are the proof that the event was
+// generated in the event's main chain header. Each source and mini block is only attached to the first event of a header
+// which references it, the next ones only carrying their hashes. ReceiptsMiniBlockHashes holds the hashes of the mini
+// blocks created inside the main chain shard, which are committed by the receipts hash of the main chain header instead
+// of its mini block headers. They are only attached to the first event whose mini block is one of them. The marshalled
+// execution result is the smart contract result which returns ok to the sender of the source, proving its successful
+// execution, which is only attached to the first event of the source, together with the mini block which includes it.
+type EventMetadata struct {
+	TxHash                       []byte          `json:"txHash"`
+	EventIndex                   uint32          `json:"eventIndex"`
+	SourceType                   EventSourceType `json:"sourceType,omitempty"`
+	Source                       []byte          `json:"source,omitempty"`
+	MiniBlock                    []byte          `json:"miniBlock,omitempty"`
+	MiniBlockHash                []byte          `json:"miniBlockHash,omitempty"`
+	ReceiptsMiniBlockHashes      [][]byte        `json:"receiptsMiniBlockHashes,omitempty"`
+	ExecutionResult              []byte          `json:"executionResult,omitempty"`
+	ExecutionResultMiniBlock     []byte          `json:"executionResultMiniBlock,omitempty"`
+	ExecutionResultMiniBlockHash []byte          `json:"executionResultMiniBlockHash,omitempty"`
 }
@@ -1,11 +1,26 @@
 package incomingHeader
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var errNilHeadersPool = errors.New("nil headers pool provided")
 
 var errNilTxPool = errors.New("nil tx pool provided")
 
+var errUnknownIncomingChainShard = errors.New("unknown incoming chain shard provided")
+
+var errHeaderFromOtherIncomingChain = errors.New("received header from another incoming chain")
+
+var errNilIncomingHeadersQueueStorer = errors.New("nil incoming headers queue storer provided")
+
+var errNilExtendedHeadersStorer = errors.New("nil extended headers storer provided")
+
+var errRevertIncludedIncomingHeader = errors.New("can not revert incoming header whose extended header was already included in a committed block")
+
+var errIncomingHeaderHandlerNotFound = errors.New("incoming header handler not found for incoming chain")
+
 var errInvalidHeaderType = errors.New("incoming header is not of type HeaderV2")
 
 var errInvalidEventType = errors.New("incoming event is not of type transaction event")
@@ -17,3 +32,56 @@ var errInvalidIncomingEventIdentifier = errors.New("received invalid/unknown inc
 var errInvalidIncomingTopicIdentifier = errors.New("received invalid/unknown incoming topic identifier")
 
 var errNilIncomingEventHandler = errors.New("nil incoming event handler provided")
+
+var errNilEventMetadata = errors.New("nil incoming event metadata")
+
+var errInvalidEventMetadata = errors.New("received invalid metadata in incoming event")
+
+var errInvalidEventContent = errors.New("incoming event content does not match its main chain transaction")
+
+var errDuplicatedIncomingEvent = errors.New("incoming event source already backs another event of the header")
+
+var errUnsuccessfulEventSource = errors.New("incoming event source has no proven successful execution")
+
+var errUnknownEventSourceType = errors.New("unknown incoming event source type")
+
+var errInvalidReceiverAddress = errors.New("received invalid receiver address in incoming event")
+
+var errInvalidTokenIdentifier = errors.New("received invalid token identifier in incoming event")
+
+var errInvalidTokenNonce = errors.New("received invalid token nonce in incoming event")
+
+var errInvalidTokenData = errors.New("received invalid token data in incoming event")
+
+var errEmptyMessageCall = errors.New("received message event without contract call in incoming event")
+
+var errEmptyMessageSender = errors.New("received message event without sender in incoming event")
+
+var errInvalidExecutionStatus = errors.New("received invalid execution status in executed bridge operation event")
+
+var errInvalidExecutedBridgeOpData = errors.New("received invalid operation data in executed bridge operation event")
+
+var errEmptyNativeTokensUnlockFunction = errors.New("empty native tokens unlock function")
+
+// ErrInvalidTopic is an error-compatible struct holding the index of the event topic which failed validation
+type ErrInvalidTopic struct {
+	TopicIndex int
+	Err        error
+}
+
+func newErrInvalidTopic(topicIndex int, err error) *ErrInvalidTopic {
+	return &ErrInvalidTopic{
+		TopicIndex: topicIndex,
+		Err:        err,
+	}
+}
+
+// Error returns the error as string
+func (e *ErrInvalidTopic) Error() string {
+	return fmt.Sprintf("%s at topic index = %d", e.Err.Error(), e.TopicIndex)
+}
+
+// Unwrap returns the underlying validation error
+func (e *ErrInvalidTopic) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,70 @@
+package incomingHeader
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// eventMetadataPrefix marks the additional data entry of an incoming event which holds the event's metadata. The
+// metadata is always appended as the last additional data entry of the event.
+var eventMetadataPrefix = []byte("sovereignEventMetadata@")
+
+var eventMetadataMarshaller = &marshal.JsonMarshalizer{}
+
+// AttachEventMetadata returns a copy of the provided event, which also carries the provided metadata as its last
+// additional data entry. The provided event is not changed.
+func AttachEventMetadata(event *transaction.Event, metadata *EventMetadata) (*transaction.Event, error) {
+	if event == nil {
+		return nil, errInvalidEventType
+	}
+	if metadata == nil {
+		return nil, errNilEventMetadata
+	}
+
+	metadataBytes, err := eventMetadataMarshaller.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalData := make([][]byte, 0, len(event.AdditionalData)+1)
+	additionalData = append(additionalData, event.AdditionalData...)
+	additionalData = append(additionalData, append(append([]byte{}, eventMetadataPrefix...), metadataBytes...))
+
+	return &transaction.Event{
+		Address:        event.Address,
+		Identifier:     event.Identifier,
+		Topics:         event.Topics,
+		Data:           event.Data,
+		AdditionalData: additionalData,
+	}, nil
+}
+
+// getEventMetadata returns the metadata carried by the provided event, or nil if the event carries no metadata
+func getEventMetadata(event data.EventHandler) (*EventMetadata, error) {
+	eventWithAdditionalData, castOk := event.(eventWithAdditionalDataHandler)
+	if !castOk {
+		return nil, nil
+	}
+
+	additionalData := eventWithAdditionalData.GetAdditionalData()
+	if len(additionalData) == 0 {
+		return nil, nil
+	}
+
+	lastEntry := additionalData[len(additionalData)-1]
+	if !bytes.HasPrefix(lastEntry, eventMetadataPrefix) {
+		return nil, nil
+	}
+
+	metadata := &EventMetadata{}
+	err := eventMetadataMarshaller.Unmarshal(metadata, lastEntry[len(eventMetadataPrefix):])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidEventMetadata, err)
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,83 @@
+package incomingHeader
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachEventMetadata(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil event, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		event, err := AttachEventMetadata(nil, &EventMetadata{})
+		require.Nil(t, event)
+		require.Equal(t, errInvalidEventType, err)
+	})
+
+	t.Run("nil metadata, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		event, err := AttachEventMetadata(&transaction.Event{}, nil)
+		require.Nil(t, event)
+		require.Equal(t, errNilEventMetadata, err)
+	})
+
+	t.Run("should work and not change the provided event", func(t *testing.T) {
+		t.Parallel()
+
+		event := &transaction.Event{
+			Address:        []byte("addr"),
+			Identifier:     []byte("deposit"),
+			Topics:         [][]byte{[]byte("topic")},
+			Data:           []byte("data"),
+			AdditionalData: [][]byte{[]byte("additionalData")},
+		}
+		metadata := &EventMetadata{
+			TxHash:                  []byte("tx0"),
+			SourceType:              EventSourceSmartContractResult,
+			Source:                  []byte("scr"),
+			MiniBlock:               []byte("miniBlock"),
+			ReceiptsMiniBlockHashes: [][]byte{[]byte("miniBlockHash")},
+		}
+
+		eventWithMetadata, err := AttachEventMetadata(event, metadata)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("additionalData")}, event.AdditionalData)
+		require.Len(t, eventWithMetadata.AdditionalData, 2)
+		require.Equal(t, event.Data, eventWithMetadata.Data)
+		require.Equal(t, event.Topics, eventWithMetadata.Topics)
+
+		eventMetadata, err := getEventMetadata(eventWithMetadata)
+		require.Nil(t, err)
+		require.Equal(t, metadata, eventMetadata)
+	})
+}
+
+func TestGetEventMetadata(t *testing.T) {
+	t.Parallel()
+
+	t.Run("event without metadata, should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		metadata, err := getEventMetadata(&transaction.Event{})
+		require.Nil(t, err)
+		require.Nil(t, metadata)
+
+		metadata, err = getEventMetadata(&transaction.Event{AdditionalData: [][]byte{[]byte("additionalData")}})
+		require.Nil(t, err)
+		require.Nil(t, metadata)
+	})
+
+	t.Run("invalid metadata, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		metadataBytes := append(append([]byte{}, eventMetadataPrefix...), []byte("invalid")...)
+		metadata, err := getEventMetadata(&transaction.Event{AdditionalData: [][]byte{metadataBytes}})
+		require.Nil(t, metadata)
+		require.ErrorIs(t, err, errInvalidEventMetadata)
+	})
+}
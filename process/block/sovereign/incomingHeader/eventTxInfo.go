@@ -0,0 +1,18 @@
+package incomingHeader
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+)
+
+// setEventTxInfo links the incoming scr to the main chain transaction which generated its event. The index of the event
+// is not saved in the scr, it remains in the event metadata kept in the additional data of the incoming events, which
+// are persisted with the extended header. Incoming scrs created from different events of the same transaction still
+// have different hashes, since each deposit and each bridge operation carries its own nonce.
+func setEventTxInfo(scr *smartContractResult.SmartContractResult, txInfo *EventTxInfo) {
+	if txInfo == nil {
+		return
+	}
+
+	scr.OriginalTxHash = txInfo.TxHash
+	scr.PrevTxHash = txInfo.TxHash
+}
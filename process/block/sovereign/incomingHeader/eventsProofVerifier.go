@@ -0,0 +1,642 @@
+package incomingHeader
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// ArgsEventsProofVerifier holds the arguments needed to create an events proof verifier
+type ArgsEventsProofVerifier struct {
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	DataCodec           SovereignDataCodec
+	EnableEpochsHandler common.EnableEpochsHandler
+}
+
+type eventsProofVerifier struct {
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	dataCodec           SovereignDataCodec
+	enableEpochsHandler common.EnableEpochsHandler
+	callArgsParser      process.CallArgumentsParser
+	esdtTransferParser  vmcommon.ESDTTransferParser
+}
+
+// expectedTokenTransfer holds a token transfer, as it is made by a main chain transaction
+type expectedTokenTransfer struct {
+	identifier []byte
+	nonce      uint64
+	amount     []byte
+}
+
+// headerProofs holds the proofs already verified for the events of one main chain header. Each source, execution result
+// and mini block is only attached to the first event of the header which references it, the next events only carrying
+// its hash. Each log entry of a source backs a single event, while each source backs a single deposit or executed bridge
+// operation event of each kind.
+type headerProofs struct {
+	header            data.HeaderHandler
+	miniBlockHashes   map[string]struct{}
+	provenMiniBlocks  map[string]*block.MiniBlock
+	provenSources     map[string]data.TransactionHandler
+	executedSources   map[string]struct{}
+	usedLogEntries    map[string]struct{}
+	usedSources       map[string]struct{}
+	lastDepositNonces map[string]uint64
+}
+
+// NewEventsProofVerifier creates a verifier which proves each incoming event against the main chain header it was
+// received with, starting with the IncomingEventsProofsEnableEpoch. Each event should carry, in its metadata, the main
+// chain transaction or smart contract result which generated it and the mini block which includes that source. The mini
+// block hash should be found either in the mini block headers of the main chain header, or in the list of the mini
+// blocks created inside the shard, whose hash is the receipts hash of the main chain header.
+// Main chain headers do not commit to the logs of their transactions, hence the content of deposit and executed bridge
+// operation events is proven against their committed source: the called contract, the receiver, the sender, the
+// transfer data and the transferred tokens of the event should match the ones from the source. The successful execution
+// of their source is proven by the smart contract result which returns ok to the source's sender, committed in the same
+// header. Each log entry of a source can only back one event of the header and each source can only back one deposit or
+// executed bridge operation event of each kind, while the deposit nonces of each contract should increase within the
+// header, so that a deposit can not be replayed under another event index or nonce. The main chain header commitments
+// are only trusted if the main chain header signatures are also verified (see
+// MainChainNotarization.HeaderSignatureVerification).
+func NewEventsProofVerifier(args ArgsEventsProofVerifier) (*eventsProofVerifier, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if check.IfNil(args.DataCodec) {
+		return nil, errors.ErrNilDataCodec
+	}
+	if check.IfNil(args.EnableEpochsHandler) {
+		return nil, errors.ErrNilEnableEpochsHandler
+	}
+
+	esdtTransferParser, err := parsers.NewESDTTransferParser(args.Marshaller)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventsProofVerifier{
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		dataCodec:           args.DataCodec,
+		enableEpochsHandler: args.EnableEpochsHandler,
+		callArgsParser:      parsers.NewCallArgsParser(),
+		esdtTransferParser:  esdtTransferParser,
+	}, nil
+}
+
+// VerifyEventsProofs will verify that every incoming event from the provided header was generated by a transaction or
+// smart contract result committed in the header, and that the content of the event matches its source. Nothing is
+// verified before the IncomingEventsProofsEnableEpoch.
+func (epv *eventsProofVerifier) VerifyEventsProofs(header sovereign.IncomingHeaderHandler) error {
+	if !epv.enableEpochsHandler.IsFlagEnabled(common.SovereignIncomingEventsProofsFlag) {
+		return nil
+	}
+
+	events := header.GetIncomingEventHandlers()
+	if len(events) == 0 {
+		return nil
+	}
+
+	proofs := newHeaderProofs(header.GetHeaderHandler())
+	for idx, event := range events {
+		err := epv.verifyEventProof(event, proofs)
+		if err != nil {
+			return fmt.Errorf("%w, event idx = %d", err, idx)
+		}
+	}
+
+	return nil
+}
+
+func newHeaderProofs(header data.HeaderHandler) *headerProofs {
+	miniBlockHashes := make(map[string]struct{})
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		miniBlockHashes[string(miniBlockHeader.GetHash())] = struct{}{}
+	}
+
+	return &headerProofs{
+		header:            header,
+		miniBlockHashes:   miniBlockHashes,
+		provenMiniBlocks:  make(map[string]*block.MiniBlock),
+		provenSources:     make(map[string]data.TransactionHandler),
+		executedSources:   make(map[string]struct{}),
+		usedLogEntries:    make(map[string]struct{}),
+		usedSources:       make(map[string]struct{}),
+		lastDepositNonces: make(map[string]uint64),
+	}
+}
+
+func (epv *eventsProofVerifier) verifyEventProof(event data.EventHandler, proofs *headerProofs) error {
+	metadata, err := getEventMetadata(event)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrInvalidIncomingEventProof, err)
+	}
+	if metadata == nil || len(metadata.TxHash) == 0 {
+		return fmt.Errorf("%w: no transaction proof", errors.ErrInvalidIncomingEventProof)
+	}
+
+	err = useLogEntry(metadata, proofs)
+	if err != nil {
+		return err
+	}
+
+	source, err := epv.getProvenSource(metadata, proofs)
+	if err != nil {
+		return err
+	}
+
+	err = epv.verifyReceiptsMiniBlocks(metadata, proofs)
+	if err != nil {
+		return err
+	}
+
+	miniBlock, miniBlockHash, err := epv.getProvenMiniBlock(metadata.MiniBlock, metadata.MiniBlockHash, proofs)
+	if err != nil {
+		return err
+	}
+	if !isTxInMiniBlock(metadata.TxHash, miniBlock) {
+		return fmt.Errorf("%w: tx %s not found in mini block %s",
+			errors.ErrInvalidIncomingEventProof,
+			hex.EncodeToString(metadata.TxHash),
+			hex.EncodeToString(miniBlockHash),
+		)
+	}
+	if !isSourceOfMiniBlockType(source, miniBlock.Type) {
+		return fmt.Errorf("%w: tx %s does not have the type of the transactions from mini block %s",
+			errors.ErrInvalidIncomingEventProof,
+			hex.EncodeToString(metadata.TxHash),
+			hex.EncodeToString(miniBlockHash),
+		)
+	}
+
+	err = epv.verifyExecutionResult(metadata, source, proofs)
+	if err != nil {
+		return err
+	}
+
+	err = epv.verifyEventContent(event, metadata, source, proofs)
+	if err != nil {
+		return fmt.Errorf("%w: %v, tx: %s", errors.ErrInvalidIncomingEventProof, err, hex.EncodeToString(metadata.TxHash))
+	}
+
+	return nil
+}
+
+// useLogEntry marks the log entry of the event's source as used, each log entry backing a single event of the header
+func useLogEntry(metadata *EventMetadata, proofs *headerProofs) error {
+	logEntryKey := string(metadata.TxHash) + "@" + strconv.FormatUint(uint64(metadata.EventIndex), 10)
+	_, isUsed := proofs.usedLogEntries[logEntryKey]
+	if isUsed {
+		return fmt.Errorf("%w: %v, tx: %s, event index: %d",
+			errors.ErrInvalidIncomingEventProof,
+			errDuplicatedIncomingEvent,
+			hex.EncodeToString(metadata.TxHash),
+			metadata.EventIndex,
+		)
+	}
+
+	proofs.usedLogEntries[logEntryKey] = struct{}{}
+	return nil
+}
+
+// getProvenSource returns the transaction or smart contract result attached to the event, after checking that it
+// matches the event's tx hash. Events whose source was already attached to a previous event of the header only carry
+// the tx hash.
+func (epv *eventsProofVerifier) getProvenSource(metadata *EventMetadata, proofs *headerProofs) (data.TransactionHandler, error) {
+	if len(metadata.Source) == 0 {
+		source, found := proofs.provenSources[string(metadata.TxHash)]
+		if !found {
+			return nil, fmt.Errorf("%w: no transaction proof", errors.ErrInvalidIncomingEventProof)
+		}
+
+		return source, nil
+	}
+
+	source, err := epv.unmarshalSource(metadata.SourceType, metadata.Source)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrInvalidIncomingEventProof, err)
+	}
+
+	txHash, err := core.CalculateHash(epv.marshaller, epv.hasher, source)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(txHash, metadata.TxHash) {
+		return nil, fmt.Errorf("%w: transaction hash mismatch, computed: %s, provided: %s",
+			errors.ErrInvalidIncomingEventProof,
+			hex.EncodeToString(txHash),
+			hex.EncodeToString(metadata.TxHash),
+		)
+	}
+
+	proofs.provenSources[string(txHash)] = source
+	return source, nil
+}
+
+func (epv *eventsProofVerifier) unmarshalSource(sourceType EventSourceType, sourceBytes []byte) (data.TransactionHandler, error) {
+	switch sourceType {
+	case EventSourceTransaction:
+		tx := &transaction.Transaction{}
+		err := epv.marshaller.Unmarshal(tx, sourceBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return tx, nil
+	case EventSourceSmartContractResult:
+		scr := &smartContractResult.SmartContractResult{}
+		err := epv.marshaller.Unmarshal(scr, sourceBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return scr, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", errUnknownEventSourceType, sourceType)
+	}
+}
+
+// verifyExecutionResult checks the smart contract result attached to the event, which proves the successful execution
+// of the event's source: it should be generated by the source, return ok to the source's sender and be included in a
+// smart contract results mini block committed in the main chain header. Events whose source execution was already
+// proven by a previous event of the header carry no execution result.
+func (epv *eventsProofVerifier) verifyExecutionResult(metadata *EventMetadata, source data.TransactionHandler, proofs *headerProofs) error {
+	if len(metadata.ExecutionResult) == 0 {
+		return nil
+	}
+
+	result := &smartContractResult.SmartContractResult{}
+	err := epv.marshaller.Unmarshal(result, metadata.ExecutionResult)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errors.ErrInvalidIncomingEventProof, err)
+	}
+	if !bytes.Equal(result.PrevTxHash, metadata.TxHash) || !bytes.Equal(result.RcvAddr, source.GetSndAddr()) {
+		return fmt.Errorf("%w: execution result was not generated for tx %s",
+			errors.ErrInvalidIncomingEventProof,
+			hex.EncodeToString(metadata.TxHash),
+		)
+	}
+	if !IsReturnOkData(result.Data) {
+		return fmt.Errorf("%w: %v, tx: %s",
+			errors.ErrInvalidIncomingEventProof,
+			errUnsuccessfulEventSource,
+			hex.EncodeToString(metadata.TxHash),
+		)
+	}
+
+	miniBlock, miniBlockHash, err := epv.getProvenMiniBlock(metadata.ExecutionResultMiniBlock, metadata.ExecutionResultMiniBlockHash, proofs)
+	if err != nil {
+		return err
+	}
+
+	resultHash, err := core.CalculateHash(epv.marshaller, epv.hasher, result)
+	if err != nil {
+		return err
+	}
+	if miniBlock.Type != block.SmartContractResultBlock || !isTxInMiniBlock(resultHash, miniBlock) {
+		return fmt.Errorf("%w: execution result %s not found in mini block %s",
+			errors.ErrInvalidIncomingEventProof,
+			hex.EncodeToString(resultHash),
+			hex.EncodeToString(miniBlockHash),
+		)
+	}
+
+	proofs.executedSources[string(metadata.TxHash)] = struct{}{}
+	return nil
+}
+
+// IsReturnOkData returns true if the data of a smart contract result holds the ok return code of a contract call
+func IsReturnOkData(data []byte) bool {
+	return bytes.Equal(data, []byte(returnOkData)) || bytes.HasPrefix(data, []byte(returnOkData+"@"))
+}
+
+// verifyReceiptsMiniBlocks checks the hashes of the mini blocks created inside the shard, attached to the event, against
+// the receipts hash of the main chain header. The receipts hash is computed by the main chain over the hashes of these
+// mini blocks, which are then accepted as committed in the header.
+func (epv *eventsProofVerifier) verifyReceiptsMiniBlocks(metadata *EventMetadata, proofs *headerProofs) error {
+	if len(metadata.ReceiptsMiniBlockHashes) == 0 {
+		return nil
+	}
+
+	receiptsHash, err := core.CalculateHash(epv.marshaller, epv.hasher, &batch.Batch{Data: metadata.ReceiptsMiniBlockHashes})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(receiptsHash, proofs.header.GetReceiptsHash()) {
+		return fmt.Errorf("%w: receipts hash mismatch, computed: %s, header: %s",
+			errors.ErrInvalidIncomingEventProof,
+			hex.EncodeToString(receiptsHash),
+			hex.EncodeToString(proofs.header.GetReceiptsHash()),
+		)
+	}
+
+	for _, miniBlockHash := range metadata.ReceiptsMiniBlockHashes {
+		proofs.miniBlockHashes[string(miniBlockHash)] = struct{}{}
+	}
+
+	return nil
+}
+
+// getProvenMiniBlock returns the attached mini block, after checking that it is committed in the main chain header.
+// Events whose mini block was already attached to a previous event of the header only reference its hash.
+func (epv *eventsProofVerifier) getProvenMiniBlock(miniBlockBytes []byte, miniBlockHash []byte, proofs *headerProofs) (*block.MiniBlock, []byte, error) {
+	if len(miniBlockBytes) == 0 {
+		miniBlock, found := proofs.provenMiniBlocks[string(miniBlockHash)]
+		if !found {
+			return nil, nil, fmt.Errorf("%w: no mini block proof", errors.ErrInvalidIncomingEventProof)
+		}
+
+		return miniBlock, miniBlockHash, nil
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err := epv.marshaller.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", errors.ErrInvalidIncomingEventProof, err)
+	}
+
+	miniBlockHash, err = core.CalculateHash(epv.marshaller, epv.hasher, miniBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, found := proofs.miniBlockHashes[string(miniBlockHash)]
+	if !found {
+		return nil, nil, fmt.Errorf("%w: mini block %s not found in main chain header",
+			errors.ErrInvalidIncomingEventProof,
+			hex.EncodeToString(miniBlockHash),
+		)
+	}
+
+	proofs.provenMiniBlocks[string(miniBlockHash)] = miniBlock
+	return miniBlock, miniBlockHash, nil
+}
+
+func isTxInMiniBlock(txHash []byte, miniBlock *block.MiniBlock) bool {
+	for _, miniBlockTxHash := range miniBlock.TxHashes {
+		if bytes.Equal(miniBlockTxHash, txHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isSourceOfMiniBlockType returns true if the source has the type of the transactions included in mini blocks of the
+// provided type
+func isSourceOfMiniBlockType(source data.TransactionHandler, miniBlockType block.Type) bool {
+	switch source.(type) {
+	case *transaction.Transaction:
+		return miniBlockType == block.TxBlock || miniBlockType == block.InvalidBlock
+	case *smartContractResult.SmartContractResult:
+		return miniBlockType == block.SmartContractResultBlock
+	default:
+		return false
+	}
+}
+
+// verifyEventContent checks the content of deposit and executed bridge operation events against the successfully executed
+// main chain transaction or smart contract result which generated them. The content of other events (handled by extra
+// registered event processors) is not checked, only their source is proven.
+func (epv *eventsProofVerifier) verifyEventContent(
+	event data.EventHandler,
+	metadata *EventMetadata,
+	tx data.TransactionHandler,
+	proofs *headerProofs,
+) error {
+	topics := event.GetTopics()
+	if len(topics) == 0 {
+		return errInvalidNumTopicsIncomingEvent
+	}
+
+	identifier := string(event.GetIdentifier())
+	if identifier != eventIDDepositIncomingTransfer && identifier != eventIDExecutedOutGoingBridgeOp {
+		return nil
+	}
+
+	sourceKey := string(metadata.TxHash) + "@" + identifier + "@" + string(topics[0])
+	_, isUsed := proofs.usedSources[sourceKey]
+	if isUsed {
+		return errDuplicatedIncomingEvent
+	}
+	_, isExecuted := proofs.executedSources[string(metadata.TxHash)]
+	if !isExecuted {
+		return errUnsuccessfulEventSource
+	}
+	proofs.usedSources[sourceKey] = struct{}{}
+
+	if identifier == eventIDDepositIncomingTransfer {
+		return epv.verifyDepositEvent(event, tx, proofs)
+	}
+
+	return epv.verifyExecutedBridgeOpEvent(event, tx)
+}
+
+// verifyDepositEvent checks that the deposit event was generated by a transaction or smart contract result which calls
+// the deposit endpoint of the event's contract for the event's receiver, with the event's transfer data, transferring
+// the same tokens. The deposit nonces of each contract should increase within the header.
+func (epv *eventsProofVerifier) verifyDepositEvent(event data.EventHandler, tx data.TransactionHandler, proofs *headerProofs) error {
+	function, args, err := epv.callArgsParser.ParseData(string(tx.GetData()))
+	if err != nil {
+		return err
+	}
+
+	contract, callFunction, callArgs := tx.GetRcvAddr(), function, args
+	expectedTokens := make([]*expectedTokenTransfer, 0)
+	parsedTransfers, err := epv.esdtTransferParser.ParseESDTTransfers(tx.GetSndAddr(), tx.GetRcvAddr(), function, args)
+	if err == nil {
+		contract, callFunction, callArgs = parsedTransfers.RcvAddr, parsedTransfers.CallFunction, parsedTransfers.CallArgs
+		for _, transfer := range parsedTransfers.ESDTTransfers {
+			expectedTokens = append(expectedTokens, &expectedTokenTransfer{
+				identifier: transfer.ESDTTokenName,
+				nonce:      transfer.ESDTTokenNonce,
+				amount:     transfer.ESDTValue.Bytes(),
+			})
+		}
+	}
+
+	if !bytes.Equal(contract, event.GetAddress()) {
+		return fmt.Errorf("%w: contract mismatch", errInvalidEventContent)
+	}
+	if callFunction != depositFunction || len(callArgs) == 0 {
+		return fmt.Errorf("%w: transaction does not call %s", errInvalidEventContent, depositFunction)
+	}
+
+	topics := event.GetTopics()
+	if len(topics) < tokensIndex || !bytes.Equal(topics[1], callArgs[0]) {
+		return fmt.Errorf("%w: receiver mismatch", errInvalidEventContent)
+	}
+
+	evData, err := epv.dataCodec.DeserializeEventData(event.GetData())
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(evData.Sender, tx.GetSndAddr()) {
+		return fmt.Errorf("%w: sender mismatch", errInvalidEventContent)
+	}
+
+	err = epv.verifyTransferredTokens(topics, expectedTokens)
+	if err != nil {
+		return err
+	}
+	if !isTransferDataOfCall(evData.TransferData, callArgs[1:]) {
+		return fmt.Errorf("%w: transfer data mismatch", errInvalidEventContent)
+	}
+
+	lastNonce, found := proofs.lastDepositNonces[string(contract)]
+	if found && evData.Nonce <= lastNonce {
+		return fmt.Errorf("%w: deposit nonce %d not greater than the previous one %d", errInvalidEventContent, evData.Nonce, lastNonce)
+	}
+	proofs.lastDepositNonces[string(contract)] = evData.Nonce
+
+	return nil
+}
+
+// isTransferDataOfCall returns true if the transfer data matches the optional transfer data arguments of the deposit
+// call: the gas limit, the function and the nested encoded list of the function arguments, which can be omitted if empty
+func isTransferDataOfCall(transferData *sovereign.TransferData, transferDataArgs [][]byte) bool {
+	if transferData == nil {
+		return len(transferDataArgs) == 0
+	}
+	if len(transferDataArgs) != numTransferDataArgs-1 && len(transferDataArgs) != numTransferDataArgs {
+		return false
+	}
+
+	gasLimit, err := common.ByteSliceToUint64(transferDataArgs[0])
+	if err != nil || gasLimit != transferData.GasLimit || !bytes.Equal(transferDataArgs[1], transferData.Function) {
+		return false
+	}
+
+	encodedArgs := make([]byte, 0)
+	if len(transferDataArgs) == numTransferDataArgs {
+		encodedArgs = transferDataArgs[2]
+	}
+
+	return bytes.Equal(encodedArgs, encodeNestedArgs(transferData.Args))
+}
+
+// encodeNestedArgs encodes the arguments as a list of nested encoded buffers, each one prefixed by its length
+func encodeNestedArgs(args [][]byte) []byte {
+	encodedArgs := make([]byte, 0)
+	for _, arg := range args {
+		encodedArgs = binary.BigEndian.AppendUint32(encodedArgs, uint32(len(arg)))
+		encodedArgs = append(encodedArgs, arg...)
+	}
+
+	return encodedArgs
+}
+
+// verifyExecutedBridgeOpEvent checks that the event was generated by a transaction or smart contract result which
+// executes the operation of the event on the event's contract. Deposit topics of these events are checked against the
+// tokens of the executed operation, which are sent back to the operation's sender.
+func (epv *eventsProofVerifier) verifyExecutedBridgeOpEvent(event data.EventHandler, tx data.TransactionHandler) error {
+	function, args, err := epv.callArgsParser.ParseData(string(tx.GetData()))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(tx.GetRcvAddr(), event.GetAddress()) {
+		return fmt.Errorf("%w: contract mismatch", errInvalidEventContent)
+	}
+	if function != executeBridgeOpsFunction || len(args) != numExecuteBridgeOpsArgs {
+		return fmt.Errorf("%w: transaction does not call %s", errInvalidEventContent, executeBridgeOpsFunction)
+	}
+
+	topics := event.GetTopics()
+	switch string(topics[0]) {
+	case topicIDConfirmedOutGoingOperation:
+		if len(topics) <= hashOfHashesIndex || !bytes.Equal(topics[hashOfHashesIndex], args[0]) {
+			return fmt.Errorf("%w: hash of hashes mismatch", errInvalidEventContent)
+		}
+		if len(event.GetData()) != 0 && !bytes.Equal(event.GetData(), args[1]) {
+			return fmt.Errorf("%w: operation mismatch", errInvalidEventContent)
+		}
+
+		return nil
+	case topicIDDepositIncomingTransfer:
+		operation, errDecode := epv.dataCodec.DeserializeOperation(args[1])
+		if errDecode != nil {
+			return errDecode
+		}
+		if operation.Data == nil || len(topics) < tokensIndex || !bytes.Equal(topics[1], operation.Data.Sender) {
+			return fmt.Errorf("%w: receiver mismatch", errInvalidEventContent)
+		}
+
+		expectedTokens := make([]*expectedTokenTransfer, 0, len(operation.Tokens))
+		for _, token := range operation.Tokens {
+			if token.Data.Amount == nil {
+				return errInvalidTokenData
+			}
+
+			expectedTokens = append(expectedTokens, &expectedTokenTransfer{
+				identifier: token.Identifier,
+				nonce:      token.Nonce,
+				amount:     token.Data.Amount.Bytes(),
+			})
+		}
+
+		return epv.verifyTransferredTokens(topics, expectedTokens)
+	default:
+		return errInvalidIncomingTopicIdentifier
+	}
+}
+
+// verifyTransferredTokens checks that the token topics of a deposit event hold, in order, the expected token transfers
+func (epv *eventsProofVerifier) verifyTransferredTokens(topics [][]byte, expectedTokens []*expectedTokenTransfer) error {
+	tokenTopics := topics[tokensIndex:]
+	if len(tokenTopics) != len(expectedTokens)*numTransferTopics {
+		return fmt.Errorf("%w: number of transferred tokens mismatch", errInvalidEventContent)
+	}
+
+	for idx, expectedToken := range expectedTokens {
+		tokenIdx := idx * numTransferTopics
+		if !bytes.Equal(tokenTopics[tokenIdx], expectedToken.identifier) {
+			return fmt.Errorf("%w: token identifier mismatch at index %d", errInvalidEventContent, idx)
+		}
+
+		nonce, err := common.ByteSliceToUint64(tokenTopics[tokenIdx+1])
+		if err != nil {
+			return err
+		}
+		if nonce != expectedToken.nonce {
+			return fmt.Errorf("%w: token nonce mismatch at index %d", errInvalidEventContent, idx)
+		}
+
+		tokenData, err := epv.dataCodec.DeserializeTokenData(tokenTopics[tokenIdx+2])
+		if err != nil {
+			return err
+		}
+		if tokenData.Amount == nil || !bytes.Equal(tokenData.Amount.Bytes(), expectedToken.amount) {
+			return fmt.Errorf("%w: token amount mismatch at index %d", errInvalidEventContent, idx)
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (epv *eventsProofVerifier) IsInterfaceNil() bool {
+	return epv == nil
+}
@@ -0,0 +1,897 @@
+package incomingHeader
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/batch"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/common"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+var (
+	esdtSafeAddress    = []byte("esdtSafeAddress_________________")
+	depositorAddress   = []byte("depositorAddress________________")
+	relayerAddress     = []byte("relayerAddress__________________")
+	depositorContract  = []byte("depositorContract_______________")
+	sovReceiverAddress = []byte("sovReceiverAddress______________")
+	proofHashOfHashes  = []byte("hashOfHashes")
+	proofOperation     = []byte("operation")
+	proofTransferData  = &sovereign.TransferData{
+		GasLimit: 100000,
+		Function: []byte("func"),
+		Args:     [][]byte{[]byte("arg1"), []byte("arg2")},
+	}
+)
+
+// createProofEventData creates the data of a deposit event, as decoded by the proofs data codec
+func createProofEventData(nonce uint64, sender []byte, transferData *sovereign.TransferData) []byte {
+	eventDataBytes, _ := json.Marshal(&sovereign.EventData{
+		Nonce:        nonce,
+		Sender:       sender,
+		TransferData: transferData,
+	})
+	return eventDataBytes
+}
+
+// createProofsDataCodec creates a data codec which decodes event data from json, token data as the amount and the proofs
+// operation as an operation of the deposit address
+func createProofsDataCodec() *sovTests.DataCodecMock {
+	return &sovTests.DataCodecMock{
+		DeserializeEventDataCalled: func(data []byte) (*sovereign.EventData, error) {
+			eventData := &sovereign.EventData{}
+			err := json.Unmarshal(data, eventData)
+			return eventData, err
+		},
+		DeserializeTokenDataCalled: func(data []byte) (*sovereign.EsdtTokenData, error) {
+			return &sovereign.EsdtTokenData{Amount: big.NewInt(0).SetBytes(data)}, nil
+		},
+		DeserializeOperationCalled: func(data []byte) (*sovereign.Operation, error) {
+			return &sovereign.Operation{
+				Tokens: []sovereign.EsdtToken{
+					{Identifier: []byte("SOVTKN-123456"), Data: sovereign.EsdtTokenData{Amount: big.NewInt(7)}},
+				},
+				Data: &sovereign.EventData{Sender: sovReceiverAddress},
+			}, nil
+		},
+	}
+}
+
+func createProofTransactions() []*transaction.Transaction {
+	return []*transaction.Transaction{
+		{
+			Nonce:   1,
+			SndAddr: depositorAddress,
+			RcvAddr: depositorAddress,
+			Data: []byte(core.BuiltInFunctionMultiESDTNFTTransfer +
+				"@" + hex.EncodeToString(esdtSafeAddress) +
+				"@02" +
+				"@" + hex.EncodeToString([]byte("TKN-123456")) + "@@0a" +
+				"@" + hex.EncodeToString([]byte("NFT-123456")) + "@05@01" +
+				"@" + hex.EncodeToString([]byte(depositFunction)) +
+				"@" + hex.EncodeToString(sovReceiverAddress)),
+		},
+		{
+			Nonce:   2,
+			SndAddr: depositorAddress,
+			RcvAddr: esdtSafeAddress,
+			Data: []byte(depositFunction +
+				"@" + hex.EncodeToString(sovReceiverAddress) +
+				"@" + hex.EncodeToString(big.NewInt(100000).Bytes()) +
+				"@" + hex.EncodeToString([]byte("func")) +
+				"@" + hex.EncodeToString(encodeNestedArgs(proofTransferData.Args))),
+		},
+		{
+			Nonce:   3,
+			SndAddr: relayerAddress,
+			RcvAddr: esdtSafeAddress,
+			Data: []byte(executeBridgeOpsFunction +
+				"@" + hex.EncodeToString(proofHashOfHashes) +
+				"@" + hex.EncodeToString(proofOperation)),
+		},
+	}
+}
+
+func createEventsForProofs() []*transaction.Event {
+	return []*transaction.Event{
+		{
+			Address:    esdtSafeAddress,
+			Identifier: []byte(eventIDDepositIncomingTransfer),
+			Topics: [][]byte{
+				[]byte(topicIDDepositIncomingTransfer), sovReceiverAddress,
+				[]byte("TKN-123456"), nil, {0x0a},
+				[]byte("NFT-123456"), {0x05}, {0x01},
+			},
+			Data: createProofEventData(1, depositorAddress, nil),
+		},
+		{
+			Address:    esdtSafeAddress,
+			Identifier: []byte(eventIDDepositIncomingTransfer),
+			Topics:     [][]byte{[]byte(topicIDDepositIncomingTransfer), sovReceiverAddress},
+			Data:       createProofEventData(2, depositorAddress, proofTransferData),
+		},
+		{
+			Address:    esdtSafeAddress,
+			Identifier: []byte(eventIDExecutedOutGoingBridgeOp),
+			Topics:     [][]byte{[]byte(topicIDConfirmedOutGoingOperation), proofHashOfHashes, []byte("opHash"), {0x01}},
+			Data:       proofOperation,
+		},
+		{
+			Address:    esdtSafeAddress,
+			Identifier: []byte(eventIDExecutedOutGoingBridgeOp),
+			Topics: [][]byte{
+				[]byte(topicIDDepositIncomingTransfer), sovReceiverAddress,
+				[]byte("SOVTKN-123456"), nil, {0x07},
+			},
+		},
+		{
+			Address:    esdtSafeAddress,
+			Identifier: []byte(eventIDDepositIncomingTransfer),
+			Topics:     [][]byte{[]byte(topicIDDepositIncomingTransfer), sovReceiverAddress},
+			Data:       createProofEventData(3, depositorContract, nil),
+		},
+	}
+}
+
+// createProofSCR creates a smart contract result which deposits on behalf of a contract, being included in a mini block
+// created inside the shard
+func createProofSCR() *smartContractResult.SmartContractResult {
+	return &smartContractResult.SmartContractResult{
+		Nonce:   4,
+		SndAddr: depositorContract,
+		RcvAddr: esdtSafeAddress,
+		Data:    []byte(depositFunction + "@" + hex.EncodeToString(sovReceiverAddress)),
+	}
+}
+
+// createProofExecutionResults creates the smart contract results which return ok to the senders of the proofs sources
+func createProofExecutionResults(sourceHashes [][]byte) []*smartContractResult.SmartContractResult {
+	senders := [][]byte{depositorAddress, depositorAddress, relayerAddress, depositorContract}
+	results := make([]*smartContractResult.SmartContractResult, 0, len(sourceHashes))
+	for idx, sourceHash := range sourceHashes {
+		results = append(results, &smartContractResult.SmartContractResult{
+			RcvAddr:    senders[idx],
+			PrevTxHash: sourceHash,
+			Data:       []byte(returnOkData),
+		})
+	}
+
+	return results
+}
+
+func marshalForTest(t *testing.T, verifier *eventsProofVerifier, obj interface{}) []byte {
+	objBytes, err := verifier.marshaller.Marshal(obj)
+	require.Nil(t, err)
+
+	return objBytes
+}
+
+func hashForTest(t *testing.T, verifier *eventsProofVerifier, obj interface{}) []byte {
+	hash, err := core.CalculateHash(verifier.marshaller, verifier.hasher, obj)
+	require.Nil(t, err)
+
+	return hash
+}
+
+func createHeaderWithProofs(t *testing.T, verifier *eventsProofVerifier) *sovereign.IncomingHeader {
+	txs := createProofTransactions()
+	txHashes := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		txHashes = append(txHashes, hashForTest(t, verifier, tx))
+	}
+	scr := createProofSCR()
+	scrHash := hashForTest(t, verifier, scr)
+
+	results := createProofExecutionResults(append(append([][]byte{}, txHashes...), scrHash))
+	resultHashes := make([][]byte, 0, len(results))
+	for _, result := range results {
+		resultHashes = append(resultHashes, hashForTest(t, verifier, result))
+	}
+
+	miniBlocks := []*block.MiniBlock{
+		{TxHashes: [][]byte{txHashes[1], txHashes[0]}},
+		{TxHashes: [][]byte{txHashes[2]}, SenderShardID: 1},
+		{TxHashes: resultHashes, Type: block.SmartContractResultBlock},
+	}
+	miniBlockHashes := make([][]byte, 0, len(miniBlocks))
+	miniBlockHeaders := make([]block.MiniBlockHeader, 0, len(miniBlocks))
+	for _, miniBlock := range miniBlocks {
+		miniBlockHash := hashForTest(t, verifier, miniBlock)
+		miniBlockHashes = append(miniBlockHashes, miniBlockHash)
+		miniBlockHeaders = append(miniBlockHeaders, block.MiniBlockHeader{Hash: miniBlockHash})
+	}
+
+	receiptsMiniBlocks := []*block.MiniBlock{
+		{TxHashes: [][]byte{scrHash}, Type: block.SmartContractResultBlock},
+		{TxHashes: [][]byte{[]byte("receipt")}, Type: block.ReceiptBlock},
+	}
+	receiptsMiniBlockHashes := [][]byte{
+		hashForTest(t, verifier, receiptsMiniBlocks[0]),
+		hashForTest(t, verifier, receiptsMiniBlocks[1]),
+	}
+
+	events := createEventsForProofs()
+	eventsMetadata := []*EventMetadata{
+		{
+			TxHash:                   txHashes[0],
+			Source:                   marshalForTest(t, verifier, txs[0]),
+			MiniBlock:                marshalForTest(t, verifier, miniBlocks[0]),
+			ExecutionResult:          marshalForTest(t, verifier, results[0]),
+			ExecutionResultMiniBlock: marshalForTest(t, verifier, miniBlocks[2]),
+		},
+		{
+			TxHash:                       txHashes[1],
+			Source:                       marshalForTest(t, verifier, txs[1]),
+			MiniBlockHash:                miniBlockHashes[0],
+			ExecutionResult:              marshalForTest(t, verifier, results[1]),
+			ExecutionResultMiniBlockHash: miniBlockHashes[2],
+		},
+		{
+			TxHash:                       txHashes[2],
+			Source:                       marshalForTest(t, verifier, txs[2]),
+			MiniBlock:                    marshalForTest(t, verifier, miniBlocks[1]),
+			ExecutionResult:              marshalForTest(t, verifier, results[2]),
+			ExecutionResultMiniBlockHash: miniBlockHashes[2],
+		},
+		{TxHash: txHashes[2], EventIndex: 1, MiniBlockHash: miniBlockHashes[1]},
+		{
+			TxHash:                       scrHash,
+			SourceType:                   EventSourceSmartContractResult,
+			Source:                       marshalForTest(t, verifier, scr),
+			MiniBlock:                    marshalForTest(t, verifier, receiptsMiniBlocks[0]),
+			ReceiptsMiniBlockHashes:      receiptsMiniBlockHashes,
+			ExecutionResult:              marshalForTest(t, verifier, results[3]),
+			ExecutionResultMiniBlockHash: miniBlockHashes[2],
+		},
+	}
+	eventsWithMetadata := make([]*transaction.Event, 0, len(events))
+	for idx, event := range events {
+		eventWithMetadata, err := AttachEventMetadata(event, eventsMetadata[idx])
+		require.Nil(t, err)
+
+		eventsWithMetadata = append(eventsWithMetadata, eventWithMetadata)
+	}
+
+	return &sovereign.IncomingHeader{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				MiniBlockHeaders: miniBlockHeaders,
+				ReceiptsHash:     hashForTest(t, verifier, &batch.Batch{Data: receiptsMiniBlockHashes}),
+			},
+		},
+		IncomingEvents: eventsWithMetadata,
+	}
+}
+
+func getEventMetadataForTest(t *testing.T, header *sovereign.IncomingHeader, idx int) *EventMetadata {
+	metadata, err := getEventMetadata(header.IncomingEvents[idx])
+	require.Nil(t, err)
+	require.NotNil(t, metadata)
+
+	return metadata
+}
+
+func setEventMetadata(t *testing.T, header *sovereign.IncomingHeader, idx int, metadata *EventMetadata) {
+	setEventWithMetadata(t, header, idx, createEventsForProofs()[idx], metadata)
+}
+
+func setEventWithMetadata(t *testing.T, header *sovereign.IncomingHeader, idx int, event *transaction.Event, metadata *EventMetadata) {
+	eventWithMetadata, err := AttachEventMetadata(event, metadata)
+	require.Nil(t, err)
+
+	header.IncomingEvents[idx] = eventWithMetadata
+}
+
+func createTestEventsProofVerifier() *eventsProofVerifier {
+	verifier, _ := NewEventsProofVerifier(ArgsEventsProofVerifier{
+		Marshaller:          &marshal.GogoProtoMarshalizer{},
+		Hasher:              sha256.NewSha256(),
+		DataCodec:           createProofsDataCodec(),
+		EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.SovereignIncomingEventsProofsFlag),
+	})
+	return verifier
+}
+
+func TestNewEventsProofVerifier(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func() ArgsEventsProofVerifier {
+		return ArgsEventsProofVerifier{
+			Marshaller:          &marshallerMock.MarshalizerMock{},
+			Hasher:              &hashingMocks.HasherMock{},
+			DataCodec:           &sovTests.DataCodecMock{},
+			EnableEpochsHandler: enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+		}
+	}
+
+	t.Run("nil marshaller, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.Marshaller = nil
+		verifier, err := NewEventsProofVerifier(args)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+		require.Nil(t, verifier)
+	})
+
+	t.Run("nil hasher, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.Hasher = nil
+		verifier, err := NewEventsProofVerifier(args)
+		require.Equal(t, core.ErrNilHasher, err)
+		require.Nil(t, verifier)
+	})
+
+	t.Run("nil data codec, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.DataCodec = nil
+		verifier, err := NewEventsProofVerifier(args)
+		require.Equal(t, errorsMx.ErrNilDataCodec, err)
+		require.Nil(t, verifier)
+	})
+
+	t.Run("nil enable epochs handler, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.EnableEpochsHandler = nil
+		verifier, err := NewEventsProofVerifier(args)
+		require.Equal(t, errorsMx.ErrNilEnableEpochsHandler, err)
+		require.Nil(t, verifier)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		verifier, err := NewEventsProofVerifier(createArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(verifier))
+	})
+}
+
+func TestEventsProofVerifier_VerifyEventsProofs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not enabled, should not verify", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		verifier.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub()
+		header := createHeaderWithProofs(t, verifier)
+		header.IncomingEvents = createEventsForProofs()
+
+		err := verifier.VerifyEventsProofs(header)
+		require.Nil(t, err)
+	})
+
+	t.Run("no events, should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		err := verifier.VerifyEventsProofs(&sovereign.IncomingHeader{Header: &block.HeaderV2{}})
+		require.Nil(t, err)
+	})
+
+	t.Run("events without metadata, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		header.IncomingEvents = createEventsForProofs()
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "event idx = 0")
+	})
+
+	t.Run("invalid metadata, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		header.IncomingEvents[1].AdditionalData = [][]byte{append(append([]byte{}, eventMetadataPrefix...), []byte("invalid")...)}
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("nil transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		metadata.Source = nil
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "no transaction proof")
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("transaction not matching its hash, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		tx := createProofTransactions()[1]
+		tx.Data = []byte(depositFunction + "@" + hex.EncodeToString(depositorAddress))
+		metadata.Source = marshalForTest(t, verifier, tx)
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "transaction hash mismatch")
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("source referenced before being attached, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		header.IncomingEvents[2], header.IncomingEvents[3] = header.IncomingEvents[3], header.IncomingEvents[2]
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "no transaction proof")
+		require.ErrorContains(t, err, "event idx = 2")
+	})
+
+	t.Run("unknown source type, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		metadata.SourceType = 2
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, errUnknownEventSourceType.Error())
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("source with a different type, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		metadata.SourceType = EventSourceSmartContractResult
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("no mini block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		metadata.MiniBlockHash = nil
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "no mini block proof")
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("mini block referenced before being attached, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		header.IncomingEvents[0], header.IncomingEvents[1] = header.IncomingEvents[1], header.IncomingEvents[0]
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "no mini block proof")
+		require.ErrorContains(t, err, "event idx = 0")
+	})
+
+	t.Run("mini block not committed in header, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 2)
+		miniBlock := &block.MiniBlock{}
+		require.Nil(t, verifier.marshaller.Unmarshal(miniBlock, metadata.MiniBlock))
+		miniBlock.TxHashes = append(miniBlock.TxHashes, []byte("tx3"))
+		metadata.MiniBlock = marshalForTest(t, verifier, miniBlock)
+		setEventMetadata(t, header, 2, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "not found in main chain header")
+		require.ErrorContains(t, err, "event idx = 2")
+	})
+
+	t.Run("tx not included in mini block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 3)
+		metadata.MiniBlockHash = getEventMetadataForTest(t, header, 1).MiniBlockHash
+		setEventMetadata(t, header, 3, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "not found in mini block")
+		require.ErrorContains(t, err, "event idx = 3")
+	})
+
+	t.Run("receipts mini blocks not matching the receipts hash, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 4)
+		metadata.ReceiptsMiniBlockHashes = metadata.ReceiptsMiniBlockHashes[:1]
+		setEventMetadata(t, header, 4, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "receipts hash mismatch")
+		require.ErrorContains(t, err, "event idx = 4")
+	})
+
+	t.Run("mini block created inside the shard without receipts proof, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 4)
+		metadata.ReceiptsMiniBlockHashes = nil
+		setEventMetadata(t, header, 4, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "not found in main chain header")
+		require.ErrorContains(t, err, "event idx = 4")
+	})
+
+	t.Run("deposit event with different content than its smart contract result, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 4)
+
+		event := createEventsForProofs()[4]
+		event.Data = createProofEventData(3, depositorAddress, nil)
+		setEventWithMetadata(t, header, 4, event, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "sender mismatch")
+		require.ErrorContains(t, err, "event idx = 4")
+	})
+
+	t.Run("deposit event with different content than its transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 0)
+
+		event := createEventsForProofs()[0]
+		event.Address = []byte("otherContract")
+		setEventWithMetadata(t, header, 0, event, metadata)
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "contract mismatch")
+
+		event = createEventsForProofs()[0]
+		event.Topics[1] = []byte("otherReceiver")
+		setEventWithMetadata(t, header, 0, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "receiver mismatch")
+
+		event = createEventsForProofs()[0]
+		event.Data = createProofEventData(1, []byte("otherSender"), nil)
+		setEventWithMetadata(t, header, 0, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "sender mismatch")
+
+		event = createEventsForProofs()[0]
+		event.Topics = event.Topics[:5]
+		setEventWithMetadata(t, header, 0, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "number of transferred tokens mismatch")
+
+		event = createEventsForProofs()[0]
+		event.Topics[5] = []byte("OTHER-123456")
+		setEventWithMetadata(t, header, 0, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "token identifier mismatch at index 1")
+
+		event = createEventsForProofs()[0]
+		event.Topics[6] = []byte{0x06}
+		setEventWithMetadata(t, header, 0, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "token nonce mismatch at index 1")
+
+		event = createEventsForProofs()[0]
+		event.Topics[4] = []byte{0x0b}
+		setEventWithMetadata(t, header, 0, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "token amount mismatch at index 0")
+		require.ErrorContains(t, err, "event idx = 0")
+	})
+
+	t.Run("message event from a transaction with tokens, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 0)
+		setEventWithMetadata(t, header, 0, createEventsForProofs()[1], metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "number of transferred tokens mismatch")
+	})
+
+	t.Run("deposit event from a transaction which does not deposit, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 2)
+		setEventWithMetadata(t, header, 2, createEventsForProofs()[1], metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "transaction does not call deposit")
+	})
+
+	t.Run("executed bridge operation event with different content than its transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 2)
+
+		event := createEventsForProofs()[2]
+		event.Topics[hashOfHashesIndex] = []byte("otherHashOfHashes")
+		setEventWithMetadata(t, header, 2, event, metadata)
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "hash of hashes mismatch")
+
+		event = createEventsForProofs()[2]
+		event.Data = []byte("otherOperation")
+		setEventWithMetadata(t, header, 2, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "operation mismatch")
+
+		event = createEventsForProofs()[2]
+		event.Address = []byte("otherContract")
+		setEventWithMetadata(t, header, 2, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "contract mismatch")
+		require.ErrorContains(t, err, "event idx = 2")
+	})
+
+	t.Run("executed bridge operation deposit with different tokens than the operation, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 3)
+
+		event := createEventsForProofs()[3]
+		event.Topics[4] = []byte{0x08}
+		setEventWithMetadata(t, header, 3, event, metadata)
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "token amount mismatch at index 0")
+
+		event = createEventsForProofs()[3]
+		event.Topics[1] = depositorAddress
+		setEventWithMetadata(t, header, 3, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "receiver mismatch")
+		require.ErrorContains(t, err, "event idx = 3")
+	})
+
+	t.Run("deposit event with different transfer data than its transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+
+		event := createEventsForProofs()[1]
+		event.Data = createProofEventData(2, depositorAddress, &sovereign.TransferData{
+			GasLimit: proofTransferData.GasLimit + 1,
+			Function: proofTransferData.Function,
+			Args:     proofTransferData.Args,
+		})
+		setEventWithMetadata(t, header, 1, event, metadata)
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "transfer data mismatch")
+
+		event.Data = createProofEventData(2, depositorAddress, &sovereign.TransferData{
+			GasLimit: proofTransferData.GasLimit,
+			Function: proofTransferData.Function,
+			Args:     proofTransferData.Args[:1],
+		})
+		setEventWithMetadata(t, header, 1, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "transfer data mismatch")
+
+		event.Data = createProofEventData(2, depositorAddress, nil)
+		setEventWithMetadata(t, header, 1, event, metadata)
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorContains(t, err, "transfer data mismatch")
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("deposit event with a nonce not greater than the previous deposit, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 4)
+
+		event := createEventsForProofs()[4]
+		event.Data = createProofEventData(2, depositorContract, nil)
+		setEventWithMetadata(t, header, 4, event, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "deposit nonce 2 not greater than the previous one 2")
+		require.ErrorContains(t, err, "event idx = 4")
+	})
+
+	t.Run("duplicated deposit event under another event index and nonce, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+
+		event := createEventsForProofs()[1]
+		event.Data = createProofEventData(4, depositorAddress, proofTransferData)
+		duplicatedEvent, err := AttachEventMetadata(event, &EventMetadata{
+			TxHash:        metadata.TxHash,
+			EventIndex:    3,
+			MiniBlockHash: metadata.MiniBlockHash,
+		})
+		require.Nil(t, err)
+		header.IncomingEvents = append(header.IncomingEvents, duplicatedEvent)
+
+		err = verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, errDuplicatedIncomingEvent.Error())
+		require.ErrorContains(t, err, "event idx = 5")
+	})
+
+	t.Run("duplicated log entry, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 3)
+		metadata.EventIndex = 0
+		setEventMetadata(t, header, 3, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, errDuplicatedIncomingEvent.Error())
+		require.ErrorContains(t, err, "event idx = 3")
+	})
+
+	t.Run("deposit event from a failed transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 0)
+		result := createProofExecutionResults([][]byte{metadata.TxHash})[0]
+		result.Data = []byte("@" + hex.EncodeToString([]byte("user error")))
+		metadata.ExecutionResult = marshalForTest(t, verifier, result)
+		setEventMetadata(t, header, 0, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, errUnsuccessfulEventSource.Error())
+		require.ErrorContains(t, err, "event idx = 0")
+	})
+
+	t.Run("deposit event without execution result, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		metadata.ExecutionResult = nil
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, errUnsuccessfulEventSource.Error())
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("execution result of another transaction, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		metadata.ExecutionResult = getEventMetadataForTest(t, header, 0).ExecutionResult
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "execution result was not generated")
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("execution result not included in mini block, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+		result := createProofExecutionResults([][]byte{metadata.TxHash})[0]
+		result.Data = []byte(returnOkData + "@01")
+		metadata.ExecutionResult = marshalForTest(t, verifier, result)
+		setEventMetadata(t, header, 1, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.ErrorContains(t, err, "not found in mini block")
+		require.ErrorContains(t, err, "event idx = 1")
+	})
+
+	t.Run("other events only have their transaction proven, should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+		metadata := getEventMetadataForTest(t, header, 1)
+
+		event := createEventsForProofs()[1]
+		event.Identifier = []byte("customEvent")
+		event.Address = []byte("otherContract")
+		setEventWithMetadata(t, header, 1, event, metadata)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.Nil(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestEventsProofVerifier()
+		header := createHeaderWithProofs(t, verifier)
+
+		err := verifier.VerifyEventsProofs(header)
+		require.Nil(t, err)
+	})
+}
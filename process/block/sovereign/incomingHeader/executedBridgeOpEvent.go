@@ -1,50 +1,350 @@
 package incomingHeader
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
+	"math/big"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 )
 
 type executedBridgeOpEventProc struct {
-	depositEventProc IncomingEventHandler
+	depositEventProc   IncomingEventHandler
+	marshaller         marshal.Marshalizer
+	hasher             hashing.Hasher
+	operationsHashers  []hashing.Hasher
+	dataCodec          SovereignDataCodec
+	callbackConfig     config.ExecutedBridgeOpCallback
+	nativeTokensPrefix string
+	nativeTokensUnlock NativeTokensUnlock
 }
 
-// ProcessEvent will process events related to confirmed outgoing bridge operations to main chain
-func (eep *executedBridgeOpEventProc) ProcessEvent(event data.EventHandler) (*EventResult, error) {
+// ProcessEvent will process events related to confirmed outgoing bridge operations to main chain. If the operation
+// failed on the main chain, refund scrs are created for the original sender of the operation. If enabled, a callback
+// scr notifies the originating sovereign contract about the execution status of the operation. The operation carried
+// by the event is only trusted if it hashes to the confirmed operation hash.
+func (eep *executedBridgeOpEventProc) ProcessEvent(event data.EventHandler, txInfo *EventTxInfo) (*EventResult, error) {
 	topics := event.GetTopics()
 	if len(topics) == 0 {
 		return nil, fmt.Errorf("%w for event id: %s", errInvalidNumTopicsIncomingEvent, eventIDExecutedOutGoingBridgeOp)
 	}
 
-	var confirmedOp *ConfirmedBridgeOp
-	var err error
 	switch string(topics[0]) {
 	case topicIDDepositIncomingTransfer:
-		return eep.depositEventProc.ProcessEvent(event)
+		return eep.depositEventProc.ProcessEvent(event, txInfo)
 	case topicIDConfirmedOutGoingOperation:
-		confirmedOp, err = getConfirmedBridgeOperation(topics)
+		return eep.processConfirmedBridgeOperation(event, txInfo)
 	default:
 		return nil, errInvalidIncomingTopicIdentifier
 	}
+}
 
+func (eep *executedBridgeOpEventProc) processConfirmedBridgeOperation(event data.EventHandler, txInfo *EventTxInfo) (*EventResult, error) {
+	topics := event.GetTopics()
+	confirmedOp, err := getConfirmedBridgeOperation(topics)
 	if err != nil {
 		return nil, err
 	}
 
-	return &EventResult{
+	result := &EventResult{
 		ConfirmedBridgeOp: confirmedOp,
-	}, nil
+	}
+
+	// legacy events carry no execution status nor the operation, in which case nothing is refunded nor called back
+	if confirmedOp.Status == BridgeOpStatusUnknown {
+		return result, nil
+	}
+
+	err = eep.checkOperationHash(event.GetData(), confirmedOp.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := eep.dataCodec.DeserializeOperation(event.GetData())
+	if err != nil {
+		return nil, err
+	}
+	if operation.Data == nil {
+		return nil, errInvalidExecutedBridgeOpData
+	}
+
+	if confirmedOp.Status == BridgeOpStatusFailed {
+		log.Debug("executedBridgeOpEventProc.ProcessEvent: outgoing bridge operation failed on main chain",
+			"hash", confirmedOp.Hash,
+			"hash of hashes", confirmedOp.HashOfHashes,
+			"sender", operation.Data.Sender,
+		)
+
+		refundSCRs, errRefund := eep.createRefundSCRs(operation, confirmedOp.Hash, txInfo)
+		if errRefund != nil {
+			return nil, errRefund
+		}
+		result.ExecutedBridgeOpSCRs = append(result.ExecutedBridgeOpSCRs, refundSCRs...)
+	}
+
+	callbackSCR, err := eep.createCallbackSCR(operation, confirmedOp, topics[executionStatusIndex], txInfo)
+	if err != nil {
+		return nil, err
+	}
+	if callbackSCR != nil {
+		result.ExecutedBridgeOpSCRs = append(result.ExecutedBridgeOpSCRs, callbackSCR)
+	}
+
+	return result, nil
 }
 
 func getConfirmedBridgeOperation(topics [][]byte) (*ConfirmedBridgeOp, error) {
-	if len(topics) != numExecutedBridgeOpTopics {
+	if len(topics) != numExecutedBridgeOpTopics && len(topics) != numExecutedBridgeOpTopicsWithStatus {
 		return nil, fmt.Errorf("%w for %s; num topics = %d", errInvalidNumTopicsIncomingEvent, eventIDExecutedOutGoingBridgeOp, len(topics))
 	}
 
+	status := BridgeOpStatusUnknown
+	if len(topics) == numExecutedBridgeOpTopicsWithStatus {
+		var err error
+		status, err = getExecutionStatus(topics[executionStatusIndex])
+		if err != nil {
+			return nil, newErrInvalidTopic(executionStatusIndex, err)
+		}
+	}
+
 	return &ConfirmedBridgeOp{
 		HashOfHashes: topics[hashOfHashesIndex],
 		Hash:         topics[hashOfOperationIndex],
+		Status:       status,
+	}, nil
+}
+
+// getExecutionStatus decodes the top encoded boolean execution status of an operation
+func getExecutionStatus(status []byte) (BridgeOpExecutionStatus, error) {
+	switch {
+	case len(status) == 0:
+		return BridgeOpStatusFailed, nil
+	case len(status) == 1 && status[0] == 1:
+		return BridgeOpStatusSuccessful, nil
+	default:
+		return BridgeOpStatusUnknown, errInvalidExecutionStatus
+	}
+}
+
+// checkOperationHash checks that the operation data carried by the event is the confirmed operation, by hashing it with
+// the hashers of the outgoing operations, so that the refund and callback scrs can not be shaped by the event data
+func (eep *executedBridgeOpEventProc) checkOperationHash(operationData []byte, confirmedOpHash []byte) error {
+	for _, operationsHasher := range eep.operationsHashers {
+		if bytes.Equal(operationsHasher.Compute(string(operationData)), confirmedOpHash) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w, operation data does not match the confirmed operation hash %s",
+		errInvalidExecutedBridgeOpData, hex.EncodeToString(confirmedOpHash))
+}
+
+// createRefundSCRs creates the scrs which refund the tokens of a failed operation to its original sender. The tokens
+// bridged from the main chain are minted back to the sender, while the sovereign native tokens, which are still locked
+// in the sovereign safe contract, are unlocked by calling the contract, if enabled. Each refund scr carries the hash of
+// the operation as its previous tx hash, so that the operation is not refunded twice when the scrs are executed.
+func (eep *executedBridgeOpEventProc) createRefundSCRs(operation *sovereign.Operation, operationHash []byte, txInfo *EventTxInfo) ([]*SCRInfo, error) {
+	mintedTokens := make([]sovereign.EsdtToken, 0, len(operation.Tokens))
+	nativeTokens := make([]sovereign.EsdtToken, 0)
+	for _, token := range operation.Tokens {
+		if eep.isNativeToken(token.Identifier) {
+			nativeTokens = append(nativeTokens, token)
+			continue
+		}
+
+		mintedTokens = append(mintedTokens, token)
+	}
+
+	refundSCRs := make([]*SCRInfo, 0, 2)
+	if len(mintedTokens) != 0 {
+		refundSCR, err := eep.createMintRefundSCR(operation, operationHash, mintedTokens, txInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		refundSCRs = append(refundSCRs, refundSCR)
+	}
+	if len(nativeTokens) != 0 {
+		unlockSCR, err := eep.createUnlockRefundSCR(operation, operationHash, nativeTokens, txInfo)
+		if err != nil {
+			return nil, err
+		}
+		if unlockSCR != nil {
+			refundSCRs = append(refundSCRs, unlockSCR)
+		}
+	}
+
+	return refundSCRs, nil
+}
+
+func (eep *executedBridgeOpEventProc) createMintRefundSCR(
+	operation *sovereign.Operation,
+	operationHash []byte,
+	tokens []sovereign.EsdtToken,
+	txInfo *EventTxInfo,
+) (*SCRInfo, error) {
+	numTokensBytes := big.NewInt(int64(len(tokens))).Bytes()
+	scrData := []byte(core.BuiltInFunctionMultiESDTNFTTransfer +
+		"@" + hex.EncodeToString(numTokensBytes))
+
+	for _, token := range tokens {
+		tokenData, err := eep.getRefundedTokenDataBytes(token)
+		if err != nil {
+			return nil, err
+		}
+
+		transfer := []byte("@" +
+			hex.EncodeToString(token.Identifier) + // tokenID
+			"@" + hex.EncodeToString(big.NewInt(0).SetUint64(token.Nonce).Bytes()) + // nonce
+			"@" + hex.EncodeToString(tokenData)) // value/tokenData
+
+		scrData = append(scrData, transfer...)
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:   operation.Data.Nonce,
+		RcvAddr: operation.Data.Sender,
+		SndAddr: core.ESDTSCAddress,
+		Data:    scrData,
+		Value:   big.NewInt(0),
+	}
+
+	return eep.createRefundSCRInfo(scr, operationHash, txInfo)
+}
+
+// createUnlockRefundSCR creates the scr which calls the sovereign safe contract to unlock the sovereign native tokens
+// of a failed operation to its original sender, with the arguments <operation hash>, <sender>, followed by the
+// identifier, nonce and amount of each token
+func (eep *executedBridgeOpEventProc) createUnlockRefundSCR(
+	operation *sovereign.Operation,
+	operationHash []byte,
+	tokens []sovereign.EsdtToken,
+	txInfo *EventTxInfo,
+) (*SCRInfo, error) {
+	if len(eep.nativeTokensUnlock.Address) == 0 {
+		log.Debug("executedBridgeOpEventProc.createUnlockRefundSCR: native tokens are not refunded, being locked in the safe",
+			"num tokens", len(tokens),
+			"sender", operation.Data.Sender,
+		)
+		return nil, nil
+	}
+
+	scrData := []byte(eep.nativeTokensUnlock.Function +
+		"@" + hex.EncodeToString(operationHash) +
+		"@" + hex.EncodeToString(operation.Data.Sender))
+
+	for _, token := range tokens {
+		if token.Data.Amount == nil {
+			return nil, errInvalidTokenData
+		}
+
+		unlock := []byte("@" +
+			hex.EncodeToString(token.Identifier) + // tokenID
+			"@" + hex.EncodeToString(big.NewInt(0).SetUint64(token.Nonce).Bytes()) + // nonce
+			"@" + hex.EncodeToString(token.Data.Amount.Bytes())) // amount
+
+		scrData = append(scrData, unlock...)
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    operation.Data.Nonce,
+		RcvAddr:  eep.nativeTokensUnlock.Address,
+		SndAddr:  core.ESDTSCAddress,
+		Data:     scrData,
+		Value:    big.NewInt(0),
+		GasLimit: eep.nativeTokensUnlock.GasLimit,
+	}
+
+	return eep.createRefundSCRInfo(scr, operationHash, txInfo)
+}
+
+// isNativeToken returns true if the token was issued on the sovereign chain, i.e. it has the sovereign tokens prefix
+func (eep *executedBridgeOpEventProc) isNativeToken(tokenID []byte) bool {
+	if len(eep.nativeTokensPrefix) == 0 {
+		return false
+	}
+
+	tokenPrefix, hasPrefix := esdt.IsValidPrefixedToken(string(tokenID))
+	return hasPrefix && tokenPrefix == eep.nativeTokensPrefix
+}
+
+func (eep *executedBridgeOpEventProc) getRefundedTokenDataBytes(token sovereign.EsdtToken) ([]byte, error) {
+	if token.Data.Amount == nil {
+		return nil, errInvalidTokenData
+	}
+	if token.Data.TokenType == core.Fungible {
+		return token.Data.Amount.Bytes(), nil
+	}
+	if token.Data.Royalties == nil {
+		return nil, errInvalidTokenData
+	}
+
+	return createDigitalTokenBytes(eep.marshaller, token.Nonce, &token.Data)
+}
+
+// createCallbackSCR creates the scr which notifies the originating sovereign contract about the execution status
+func (eep *executedBridgeOpEventProc) createCallbackSCR(
+	operation *sovereign.Operation,
+	confirmedOp *ConfirmedBridgeOp,
+	status []byte,
+	txInfo *EventTxInfo,
+) (*SCRInfo, error) {
+	if !eep.callbackConfig.Enabled || !core.IsSmartContractAddress(operation.Data.Sender) {
+		return nil, nil
+	}
+
+	scrData := []byte(eep.callbackConfig.Function +
+		"@" + hex.EncodeToString(confirmedOp.Hash) +
+		"@" + hex.EncodeToString(status))
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:    operation.Data.Nonce,
+		RcvAddr:  operation.Data.Sender,
+		SndAddr:  core.ESDTSCAddress,
+		Data:     scrData,
+		Value:    big.NewInt(0),
+		GasLimit: eep.callbackConfig.GasLimit,
+	}
+
+	return eep.createSCRInfo(scr, txInfo)
+}
+
+func (eep *executedBridgeOpEventProc) createRefundSCRInfo(
+	scr *smartContractResult.SmartContractResult,
+	operationHash []byte,
+	txInfo *EventTxInfo,
+) (*SCRInfo, error) {
+	setEventTxInfo(scr, txInfo)
+	common.SetRefundedBridgeOperationHash(scr, operationHash)
+
+	return eep.hashSCR(scr)
+}
+
+func (eep *executedBridgeOpEventProc) createSCRInfo(scr *smartContractResult.SmartContractResult, txInfo *EventTxInfo) (*SCRInfo, error) {
+	setEventTxInfo(scr, txInfo)
+
+	return eep.hashSCR(scr)
+}
+
+func (eep *executedBridgeOpEventProc) hashSCR(scr *smartContractResult.SmartContractResult) (*SCRInfo, error) {
+	hash, err := core.CalculateHash(eep.marshaller, eep.hasher, scr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SCRInfo{
+		SCR:  scr,
+		Hash: hash,
 	}, nil
 }
 
@@ -10,19 +10,28 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 
 	"github.com/multiversx/mx-chain-go/process"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 )
 
 type extendedHeaderProcessor struct {
+	shardID     uint32
 	headersPool HeadersPool
 	txPool      TransactionPool
 	marshaller  marshal.Marshalizer
 	hasher      hashing.Hasher
 }
 
-func createExtendedHeader(incomingHeader sovereign.IncomingHeaderHandler, scrs []*SCRInfo) (*block.ShardHeaderExtended, error) {
-	headerV2, castOk := incomingHeader.GetHeaderHandler().(*block.HeaderV2)
-	if !castOk {
-		return nil, errInvalidHeaderType
+// createExtendedHeader creates the extended shard header of an incoming header received from the incoming chain
+// tracked under the provided shard ID
+func createExtendedHeader(
+	incomingHeader sovereign.IncomingHeaderHandler,
+	scrs []*SCRInfo,
+	shardID uint32,
+	marshaller marshal.Marshalizer,
+) (*block.ShardHeaderExtended, error) {
+	headerV2, err := getHeaderV2(incomingHeader.GetHeaderHandler(), marshaller)
+	if err != nil {
+		return nil, err
 	}
 	events, err := getEvents(incomingHeader.GetIncomingEventHandlers())
 	if err != nil {
@@ -31,11 +40,24 @@ func createExtendedHeader(incomingHeader sovereign.IncomingHeaderHandler, scrs [
 
 	return &block.ShardHeaderExtended{
 		Header:             headerV2,
-		IncomingMiniBlocks: createIncomingMb(scrs),
+		IncomingMiniBlocks: createIncomingMb(scrs, shardID),
 		IncomingEvents:     events,
 	}, nil
 }
 
+// getHeaderV2 returns the header v2 held by the extended shard header of the provided incoming header. The headers of
+// the incoming sovereign chains are wrapped in a header v2.
+func getHeaderV2(header data.HeaderHandler, marshaller marshal.Marshalizer) (*block.HeaderV2, error) {
+	switch castedHeader := header.(type) {
+	case *block.HeaderV2:
+		return castedHeader, nil
+	case *block.SovereignChainHeader:
+		return sovBlock.WrapSovereignChainHeader(marshaller, castedHeader)
+	default:
+		return nil, errInvalidHeaderType
+	}
+}
+
 func getEvents(events []data.EventHandler) ([]*transaction.Event, error) {
 	ret := make([]*transaction.Event, len(events))
 
@@ -51,7 +73,7 @@ func getEvents(events []data.EventHandler) ([]*transaction.Event, error) {
 	return ret, nil
 }
 
-func createIncomingMb(scrs []*SCRInfo) []*block.MiniBlock {
+func createIncomingMb(scrs []*SCRInfo, shardID uint32) []*block.MiniBlock {
 	if len(scrs) == 0 {
 		return make([]*block.MiniBlock, 0)
 	}
@@ -65,16 +87,16 @@ func createIncomingMb(scrs []*SCRInfo) []*block.MiniBlock {
 		{
 			TxHashes:        scrHashes,
 			ReceiverShardID: core.SovereignChainShardId,
-			SenderShardID:   core.MainChainShardId,
+			SenderShardID:   shardID,
 			Type:            block.SmartContractResultBlock,
 		},
 	}
 }
 
 func (ehp *extendedHeaderProcessor) addPreGenesisExtendedHeaderToPool(incomingHeader sovereign.IncomingHeaderHandler) error {
-	headerV2, castOk := incomingHeader.GetHeaderHandler().(*block.HeaderV2)
-	if !castOk {
-		return errInvalidHeaderType
+	headerV2, err := getHeaderV2(incomingHeader.GetHeaderHandler(), ehp.marshaller)
+	if err != nil {
+		return err
 	}
 
 	extendedHeader := &block.ShardHeaderExtended{
@@ -83,22 +105,32 @@ func (ehp *extendedHeaderProcessor) addPreGenesisExtendedHeaderToPool(incomingHe
 		IncomingEvents:     []*transaction.Event{},
 	}
 
-	return ehp.addExtendedHeaderAndSCRsToPool(extendedHeader, make([]*SCRInfo, 0))
+	_, err = ehp.addExtendedHeaderAndSCRsToPool(extendedHeader, make([]*SCRInfo, 0))
+	return err
 }
 
-func (ehp *extendedHeaderProcessor) addExtendedHeaderAndSCRsToPool(extendedHeader data.ShardHeaderExtendedHandler, scrs []*SCRInfo) error {
+func (ehp *extendedHeaderProcessor) addExtendedHeaderAndSCRsToPool(extendedHeader data.ShardHeaderExtendedHandler, scrs []*SCRInfo) ([]byte, error) {
 	extendedHeaderHash, err := core.CalculateHash(ehp.marshaller, ehp.hasher, extendedHeader)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ehp.addSCRsToPool(scrs)
-	ehp.headersPool.AddHeaderInShard(extendedHeaderHash, extendedHeader, core.MainChainShardId)
-	return nil
+	ehp.headersPool.AddHeaderInShard(extendedHeaderHash, extendedHeader, ehp.shardID)
+	return extendedHeaderHash, nil
+}
+
+func (ehp *extendedHeaderProcessor) removeExtendedHeaderAndSCRsFromPool(extendedHeaderHash []byte, scrHashes [][]byte) {
+	cacheID := process.ShardCacherIdentifier(ehp.shardID, core.SovereignChainShardId)
+
+	for _, scrHash := range scrHashes {
+		ehp.txPool.RemoveData(scrHash, cacheID)
+	}
+	ehp.headersPool.RemoveHeaderByHash(extendedHeaderHash)
 }
 
 func (ehp *extendedHeaderProcessor) addSCRsToPool(scrs []*SCRInfo) {
-	cacheID := process.ShardCacherIdentifier(core.MainChainShardId, core.SovereignChainShardId)
+	cacheID := process.ShardCacherIdentifier(ehp.shardID, core.SovereignChainShardId)
 
 	for _, scrData := range scrs {
 		ehp.txPool.AddData(scrData.Hash, scrData.SCR, scrData.SCR.Size(), cacheID)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 )
 
 type eventsResult struct {
@@ -29,7 +30,8 @@ func (iep *incomingEventsProcessor) registerProcessor(event string, proc Incomin
 	return nil
 }
 
-func (iep *incomingEventsProcessor) processIncomingEvents(events []data.EventHandler) (*eventsResult, error) {
+func (iep *incomingEventsProcessor) processIncomingEvents(header sovereign.IncomingHeaderHandler) (*eventsResult, error) {
+	events := header.GetIncomingEventHandlers()
 	scrs := make([]*SCRInfo, 0, len(events))
 	confirmedBridgeOps := make([]*ConfirmedBridgeOp, 0, len(events))
 
@@ -38,10 +40,15 @@ func (iep *incomingEventsProcessor) processIncomingEvents(events []data.EventHan
 		handler, found := iep.handlers[string(event.GetIdentifier())]
 		iep.mut.RUnlock()
 		if !found {
-			return nil, errInvalidIncomingEventIdentifier
+			return nil, fmt.Errorf("%w: %s, event idx = %d", errInvalidIncomingEventIdentifier, event.GetIdentifier(), idx)
 		}
 
-		res, err := handler.ProcessEvent(event)
+		txInfo, err := getEventTxInfo(event)
+		if err != nil {
+			return nil, fmt.Errorf("%w, event idx = %d", err, idx)
+		}
+
+		res, err := handler.ProcessEvent(event, txInfo)
 		if err != nil {
 			return nil, fmt.Errorf("%w, event idx = %d", err, idx)
 		}
@@ -49,6 +56,7 @@ func (iep *incomingEventsProcessor) processIncomingEvents(events []data.EventHan
 		if res.SCR != nil {
 			scrs = append(scrs, res.SCR)
 		}
+		scrs = append(scrs, res.ExecutedBridgeOpSCRs...)
 		if res.ConfirmedBridgeOp != nil {
 			confirmedBridgeOps = append(confirmedBridgeOps, res.ConfirmedBridgeOp)
 		}
@@ -59,3 +67,20 @@ func (iep *incomingEventsProcessor) processIncomingEvents(events []data.EventHan
 		confirmedBridgeOps: confirmedBridgeOps,
 	}, nil
 }
+
+// getEventTxInfo returns the main chain transaction which generated the event, as carried by the event's metadata.
+// Events which do not carry any metadata are still accepted, in which case a nil info is returned.
+func getEventTxInfo(event data.EventHandler) (*EventTxInfo, error) {
+	metadata, err := getEventMetadata(event)
+	if err != nil {
+		return nil, err
+	}
+	if metadata == nil || len(metadata.TxHash) == 0 {
+		return nil, nil
+	}
+
+	return &EventTxInfo{
+		TxHash:     metadata.TxHash,
+		EventIndex: metadata.EventIndex,
+	}, nil
+}
@@ -1,43 +1,173 @@
 package incomingHeader
 
 import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
 	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
 	marshallerFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
-	"github.com/multiversx/mx-chain-go/process"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 )
 
-// CreateIncomingHeaderProcessor creates the incoming header processor
+// CreateIncomingHeadersRouter creates the incoming headers router, with one incoming header processor for the main
+// chain and one for each of the configured incoming chains. Each processor is created from its chain's own notifier
+// config and is bound to the shard ID under which its chain is tracked.
+func CreateIncomingHeadersRouter(
+	sovConfig config.SovereignConfig,
+	addressPubKeyConverter core.PubkeyConverter,
+	dataPool dataRetriever.PoolsHolder,
+	storageService dataRetriever.StorageService,
+	runTypeComponents RunTypeComponentsHolder,
+	enableEpochsHandler common.EnableEpochsHandler,
+	appStatusHandler core.AppStatusHandler,
+) (IncomingHeadersRouter, error) {
+	if check.IfNil(runTypeComponents) {
+		return nil, errorsMx.ErrNilRunTypeComponents
+	}
+	if check.IfNil(storageService) {
+		return nil, errorsMx.ErrNilStorageService
+	}
+
+	incomingChainsHandler := runTypeComponents.IncomingChainsHandler()
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errorsMx.ErrNilIncomingChainsHandler
+	}
+
+	operationsHashers, err := sovBlock.CreateOutGoingOperationsHashers(sovConfig)
+	if err != nil {
+		return nil, err
+	}
+	nativeTokensPrefix := sovConfig.OutGoingBridge.NativeTokensPrefix
+	nativeTokensUnlock, err := createNativeTokensUnlock(sovConfig.OutGoingBridge.NativeTokensUnlock, addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	mainChainHeaderProc, err := CreateIncomingHeaderProcessor(core.MainChainShardId, sovConfig.NotifierConfig, operationsHashers, nativeTokensPrefix, nativeTokensUnlock, dataPool, storageService, runTypeComponents, enableEpochsHandler, appStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	incomingHeaderHandlers := map[uint32]IncomingHeaderHandler{
+		core.MainChainShardId: mainChainHeaderProc,
+	}
+	for _, incomingChain := range sovConfig.IncomingChains {
+		shardID, found := incomingChainsHandler.GetShardIDForChainID(incomingChain.ChainID)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", errorsMx.ErrUnknownIncomingChainID, incomingChain.ChainID)
+		}
+
+		incomingHeaderHandlers[shardID], err = CreateIncomingHeaderProcessor(shardID, incomingChain.NotifierConfig, operationsHashers, nativeTokensPrefix, nativeTokensUnlock, dataPool, storageService, runTypeComponents, enableEpochsHandler, appStatusHandler)
+		if err != nil {
+			return nil, fmt.Errorf("%w for incoming chain: %s", err, incomingChain.ChainID)
+		}
+	}
+
+	return NewIncomingHeadersRouter(ArgsIncomingHeadersRouter{
+		IncomingHeaderHandlers: incomingHeaderHandlers,
+		IncomingChainsHandler:  incomingChainsHandler,
+	})
+}
+
+// createNativeTokensUnlock creates the call which unlocks the native tokens of the refunded operations from the
+// sovereign safe contract, if enabled
+func createNativeTokensUnlock(unlockConfig config.NativeTokensUnlock, addressPubKeyConverter core.PubkeyConverter) (NativeTokensUnlock, error) {
+	if !unlockConfig.Enabled {
+		return NativeTokensUnlock{}, nil
+	}
+	if check.IfNil(addressPubKeyConverter) {
+		return NativeTokensUnlock{}, errorsMx.ErrNilPubKeyConverter
+	}
+	if len(unlockConfig.Function) == 0 {
+		return NativeTokensUnlock{}, errEmptyNativeTokensUnlockFunction
+	}
+
+	address, err := addressPubKeyConverter.Decode(unlockConfig.Address)
+	if err != nil {
+		return NativeTokensUnlock{}, fmt.Errorf("%w for the native tokens unlock address", err)
+	}
+
+	return NativeTokensUnlock{
+		Address:  address,
+		Function: unlockConfig.Function,
+		GasLimit: unlockConfig.GasLimit,
+	}, nil
+}
+
+// CreateIncomingHeaderProcessor creates the incoming header processor of the incoming chain tracked under the provided
+// shard ID. Its queue of incoming headers waiting for confirmations is saved in its storer from the provided storage
+// service. The operations of the executed bridge operation events are checked against the provided outgoing operations
+// hashers, while the native tokens, having the provided prefix, are not minted back by refunds, but unlocked by the
+// provided native tokens unlock call. The incoming events
+// are proven against their main chain header starting with the IncomingEventsProofsEnableEpoch.
 func CreateIncomingHeaderProcessor(
-	config config.WebSocketConfig,
+	shardID uint32,
+	config config.NotifierConfig,
+	operationsHashers []hashing.Hasher,
+	nativeTokensPrefix string,
+	nativeTokensUnlock NativeTokensUnlock,
 	dataPool dataRetriever.PoolsHolder,
-	mainChainNotarizationStartRound uint64,
+	storageService dataRetriever.StorageService,
 	runTypeComponents RunTypeComponentsHolder,
-) (process.IncomingHeaderSubscriber, error) {
+	enableEpochsHandler common.EnableEpochsHandler,
+	appStatusHandler core.AppStatusHandler,
+) (IncomingHeaderHandler, error) {
 	if check.IfNil(runTypeComponents) {
 		return nil, errorsMx.ErrNilRunTypeComponents
 	}
-	marshaller, err := marshallerFactory.NewMarshalizer(config.MarshallerType)
+	if check.IfNil(storageService) {
+		return nil, errorsMx.ErrNilStorageService
+	}
+	marshaller, err := marshallerFactory.NewMarshalizer(config.WebSocketConfig.MarshallerType)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := hasherFactory.NewHasher(config.WebSocketConfig.HasherType)
+	if err != nil {
+		return nil, err
+	}
+	eventsProofVerifier, err := NewEventsProofVerifier(ArgsEventsProofVerifier{
+		Marshaller:          marshaller,
+		Hasher:              hasher,
+		DataCodec:           runTypeComponents.DataCodecHandler(),
+		EnableEpochsHandler: enableEpochsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+	incomingHeadersQueueStorer, err := storageService.GetStorer(dataRetriever.IncomingHeadersQueueUnit)
 	if err != nil {
 		return nil, err
 	}
-	hasher, err := hasherFactory.NewHasher(config.HasherType)
+	extendedHeadersStorer, err := storageService.GetStorer(dataRetriever.ExtendedShardHeadersUnit)
 	if err != nil {
 		return nil, err
 	}
 
 	argsIncomingHeaderHandler := ArgsIncomingHeaderProcessor{
-		HeadersPool:                     dataPool.Headers(),
-		TxPool:                          dataPool.UnsignedTransactions(),
-		Marshaller:                      marshaller,
-		Hasher:                          hasher,
-		MainChainNotarizationStartRound: mainChainNotarizationStartRound,
-		OutGoingOperationsPool:          runTypeComponents.OutGoingOperationsPoolHandler(),
-		DataCodec:                       runTypeComponents.DataCodecHandler(),
-		TopicsChecker:                   runTypeComponents.TopicsCheckerHandler(),
+		ShardID:                    shardID,
+		HeadersPool:                dataPool.Headers(),
+		TxPool:                     dataPool.UnsignedTransactions(),
+		Marshaller:                 marshaller,
+		Hasher:                     hasher,
+		IncomingChainsHandler:      runTypeComponents.IncomingChainsHandler(),
+		OutGoingOperationsPool:     runTypeComponents.OutGoingOperationsPoolHandler(),
+		DataCodec:                  runTypeComponents.DataCodecHandler(),
+		TopicsChecker:              runTypeComponents.TopicsCheckerHandler(),
+		EventsProofVerifier:        eventsProofVerifier,
+		AppStatusHandler:           appStatusHandler,
+		ExecutedOpCallback:         config.ExecutedBridgeOpCallback,
+		OperationsHashers:          operationsHashers,
+		NativeTokensPrefix:         nativeTokensPrefix,
+		NativeTokensUnlock:         nativeTokensUnlock,
+		IncomingHeadersQueueStorer: incomingHeadersQueueStorer,
+		ExtendedHeadersStorer:      extendedHeadersStorer,
 	}
 
 	return NewIncomingHeaderProcessor(argsIncomingHeaderHandler)
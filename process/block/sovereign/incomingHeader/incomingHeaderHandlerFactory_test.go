@@ -1,24 +1,45 @@
 package incomingHeader
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+
 	"github.com/multiversx/mx-chain-go/config"
 	retriever "github.com/multiversx/mx-chain-go/dataRetriever"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/pool"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
 )
 
-func createWSCfg() config.WebSocketConfig {
-	return config.WebSocketConfig{
-		MarshallerType: "json",
-		HasherType:     "keccak",
+func createNotifierCfg() config.NotifierConfig {
+	return config.NotifierConfig{
+		WebSocketConfig: config.WebSocketConfig{
+			MarshallerType: "json",
+			HasherType:     "keccak",
+		},
 	}
 }
 
+func createOperationsHashers() []hashing.Hasher {
+	return []hashing.Hasher{&hashingMocks.HasherMock{}}
+}
+
 func TestCreateIncomingHeaderProcessor(t *testing.T) {
 	t.Parallel()
 
@@ -31,51 +52,449 @@ func TestCreateIncomingHeaderProcessor(t *testing.T) {
 
 	t.Run("nil run type comps, should not work", func(t *testing.T) {
 		headerProc, err := CreateIncomingHeaderProcessor(
-			createWSCfg(),
+			core.MainChainShardId,
+			createNotifierCfg(),
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
 			headersPool,
-			11,
+			genericMocks.NewChainStorerMock(0),
 			nil,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
 		)
 		require.Equal(t, errorsMx.ErrNilRunTypeComponents, err)
 		require.Nil(t, headerProc)
 	})
 
+	t.Run("nil storage service, should not work", func(t *testing.T) {
+		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
+			createNotifierCfg(),
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
+			headersPool,
+			nil,
+			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errorsMx.ErrNilStorageService, err)
+		require.Nil(t, headerProc)
+	})
+
+	t.Run("missing incoming headers queue storer, should not work", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
+			createNotifierCfg(),
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
+			headersPool,
+			&storageStubs.ChainStorerStub{
+				GetStorerCalled: func(unitType retriever.UnitType) (storage.Storer, error) {
+					if unitType == retriever.IncomingHeadersQueueUnit {
+						return nil, expectedErr
+					}
+					return genericMocks.NewStorerMock(), nil
+				},
+			},
+			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, headerProc)
+	})
+
+	t.Run("missing extended headers storer, should not work", func(t *testing.T) {
+		expectedErr := errors.New("expected error")
+		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
+			createNotifierCfg(),
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
+			headersPool,
+			&storageStubs.ChainStorerStub{
+				GetStorerCalled: func(unitType retriever.UnitType) (storage.Storer, error) {
+					if unitType == retriever.ExtendedShardHeadersUnit {
+						return nil, expectedErr
+					}
+					return genericMocks.NewStorerMock(), nil
+				},
+			},
+			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, expectedErr, err)
+		require.Nil(t, headerProc)
+	})
+
 	t.Run("invalid marshaller, should not work", func(t *testing.T) {
-		cfg := createWSCfg()
-		cfg.MarshallerType = ""
+		cfg := createNotifierCfg()
+		cfg.WebSocketConfig.MarshallerType = ""
 
 		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
 			cfg,
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
 			headersPool,
-			11,
+			genericMocks.NewChainStorerMock(0),
 			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
 		)
 		require.NotNil(t, err)
 		require.Nil(t, headerProc)
 	})
 
 	t.Run("invalid hasher, should not work", func(t *testing.T) {
-		cfg := createWSCfg()
-		cfg.HasherType = ""
+		cfg := createNotifierCfg()
+		cfg.WebSocketConfig.HasherType = ""
 
 		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
 			cfg,
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
 			headersPool,
-			11,
+			genericMocks.NewChainStorerMock(0),
 			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
 		)
 		require.NotNil(t, err)
 		require.Nil(t, headerProc)
 	})
 
+	t.Run("no operations hashers, should not work", func(t *testing.T) {
+		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
+			createNotifierCfg(),
+			nil,
+			"",
+			NativeTokensUnlock{},
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errorsMx.ErrNilOperationsHasher, err)
+		require.Nil(t, headerProc)
+	})
+
+	t.Run("nil app status handler, should not work", func(t *testing.T) {
+		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
+			createNotifierCfg(),
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			nil,
+		)
+		require.Equal(t, errorsMx.ErrNilAppStatusHandler, err)
+		require.Nil(t, headerProc)
+	})
+
+	t.Run("nil enable epochs handler, should not work", func(t *testing.T) {
+		headerProc, err := CreateIncomingHeaderProcessor(
+			core.MainChainShardId,
+			createNotifierCfg(),
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			runTypeComps,
+			nil,
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errorsMx.ErrNilEnableEpochsHandler, err)
+		require.Nil(t, headerProc)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		headerProc, err := CreateIncomingHeaderProcessor(
-			createWSCfg(),
+			core.MainChainShardId,
+			createNotifierCfg(),
+			createOperationsHashers(),
+			"",
+			NativeTokensUnlock{},
 			headersPool,
-			11,
+			genericMocks.NewChainStorerMock(0),
 			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
 		)
 		require.Nil(t, err)
 		require.False(t, headerProc.IsInterfaceNil())
 	})
 }
+
+func TestCreateIncomingHeadersRouter(t *testing.T) {
+	t.Parallel()
+
+	headersPool := &dataRetriever.PoolsHolderStub{
+		HeadersCalled: func() retriever.HeadersPool {
+			return &pool.HeadersPoolStub{}
+		},
+	}
+	createSovConfig := func() config.SovereignConfig {
+		incomingChainCfg := createNotifierCfg()
+		incomingChainCfg.WebSocketConfig.MarshallerType = "gogo protobuf"
+		incomingChainCfg.WebSocketConfig.HasherType = "blake2b"
+
+		return config.SovereignConfig{
+			OutGoingBridge: config.OutGoingBridge{
+				Hasher: "sha256",
+			},
+			NotifierConfig: createNotifierCfg(),
+			IncomingChains: []config.IncomingChain{
+				{
+					ChainID:        "sov1",
+					NotifierConfig: incomingChainCfg,
+				},
+			},
+		}
+	}
+	createRunTypeComps := func(sovConfig config.SovereignConfig) *mock.RunTypeComponentsStub {
+		incomingChainsHandler, err := sovBlock.NewIncomingChainsHandler(sovBlock.ArgsIncomingChainsHandler{
+			MainChainID:    "1",
+			IncomingChains: sovConfig.IncomingChains,
+		})
+		require.Nil(t, err)
+
+		runTypeComps := mock.NewRunTypeComponentsStub()
+		runTypeComps.IncomingChains = incomingChainsHandler
+		return runTypeComps
+	}
+
+	t.Run("nil run type comps, should not work", func(t *testing.T) {
+		router, err := CreateIncomingHeadersRouter(
+			createSovConfig(),
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			nil,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errorsMx.ErrNilRunTypeComponents, err)
+		require.Nil(t, router)
+	})
+
+	t.Run("nil storage service, should not work", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			nil,
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errorsMx.ErrNilStorageService, err)
+		require.Nil(t, router)
+	})
+
+	t.Run("nil incoming chains handler, should not work", func(t *testing.T) {
+		runTypeComps := mock.NewRunTypeComponentsStub()
+		runTypeComps.IncomingChains = nil
+
+		router, err := CreateIncomingHeadersRouter(
+			createSovConfig(),
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errorsMx.ErrNilIncomingChainsHandler, err)
+		require.Nil(t, router)
+	})
+
+	t.Run("unknown incoming chain, should not work", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		runTypeComps := createRunTypeComps(sovConfig)
+		sovConfig.IncomingChains[0].ChainID = "sov2"
+
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			runTypeComps,
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.ErrorIs(t, err, errorsMx.ErrUnknownIncomingChainID)
+		require.Nil(t, router)
+	})
+
+	t.Run("invalid outgoing operations hasher, should not work", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		sovConfig.OutGoingBridge.Hasher = "invalid"
+
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.NotNil(t, err)
+		require.Nil(t, router)
+	})
+
+	t.Run("invalid incoming chain notifier config, should not work", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		sovConfig.IncomingChains[0].NotifierConfig.WebSocketConfig.HasherType = ""
+
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "sov1")
+		require.Nil(t, router)
+	})
+
+	t.Run("nil address pub key converter with native tokens unlock, should not work", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		sovConfig.OutGoingBridge.NativeTokensUnlock = config.NativeTokensUnlock{
+			Enabled:  true,
+			Address:  "0102",
+			Function: "unlock",
+		}
+
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			nil,
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errorsMx.ErrNilPubKeyConverter, err)
+		require.Nil(t, router)
+	})
+
+	t.Run("invalid native tokens unlock config, should not work", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		sovConfig.OutGoingBridge.NativeTokensUnlock = config.NativeTokensUnlock{
+			Enabled: true,
+			Address: "0102",
+		}
+
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Equal(t, errEmptyNativeTokensUnlockFunction, err)
+		require.Nil(t, router)
+
+		sovConfig.OutGoingBridge.NativeTokensUnlock.Function = "unlock"
+		sovConfig.OutGoingBridge.NativeTokensUnlock.Address = "invalid address"
+		router, err = CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "native tokens unlock address")
+		require.Nil(t, router)
+	})
+
+	t.Run("should create the native tokens unlock for each chain", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		sovConfig.OutGoingBridge.NativeTokensUnlock = config.NativeTokensUnlock{
+			Enabled:  true,
+			Address:  "0102",
+			Function: "unlock",
+			GasLimit: 100,
+		}
+
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Nil(t, err)
+
+		expectedUnlock := NativeTokensUnlock{
+			Address:  []byte{1, 2},
+			Function: "unlock",
+			GasLimit: 100,
+		}
+		for _, shardID := range []uint32{core.MainChainShardId, sovBlock.GetIncomingChainShardID(0)} {
+			headerProc, errGet := router.GetIncomingHeaderHandler(shardID)
+			require.Nil(t, errGet)
+
+			executedOpProc := headerProc.(*incomingHeaderProcessor).eventsProc.handlers[eventIDExecutedOutGoingBridgeOp]
+			require.Equal(t, expectedUnlock, executedOpProc.(*executedBridgeOpEventProc).nativeTokensUnlock)
+		}
+	})
+
+	t.Run("should work with one incoming header processor for each chain", func(t *testing.T) {
+		sovConfig := createSovConfig()
+		router, err := CreateIncomingHeadersRouter(
+			sovConfig,
+			testscommon.NewPubkeyConverterMock(32),
+			headersPool,
+			genericMocks.NewChainStorerMock(0),
+			createRunTypeComps(sovConfig),
+			enableEpochsHandlerMock.NewEnableEpochsHandlerStub(),
+			&statusHandler.AppStatusHandlerStub{},
+		)
+		require.Nil(t, err)
+		require.False(t, router.IsInterfaceNil())
+
+		mainChainHeaderProc, err := router.GetIncomingHeaderHandler(core.MainChainShardId)
+		require.Nil(t, err)
+		require.Equal(t, core.MainChainShardId, mainChainHeaderProc.(*incomingHeaderProcessor).shardID)
+		require.Equal(t, "*marshal.JsonMarshalizer", fmt.Sprintf("%T", mainChainHeaderProc.(*incomingHeaderProcessor).marshaller))
+
+		incomingChainHeaderProc, err := router.GetIncomingHeaderHandler(sovBlock.GetIncomingChainShardID(0))
+		require.Nil(t, err)
+		require.Equal(t, sovBlock.GetIncomingChainShardID(0), incomingChainHeaderProc.(*incomingHeaderProcessor).shardID)
+		require.Equal(t, "*marshal.GogoProtoMarshalizer", fmt.Sprintf("%T", incomingChainHeaderProc.(*incomingHeaderProcessor).marshaller))
+
+		err = router.AddHeader([]byte("hash"), &sovereign.IncomingHeader{Header: &block.HeaderV2{Header: &block.Header{ChainID: []byte("sov3")}}})
+		require.ErrorIs(t, err, errorsMx.ErrUnknownIncomingChainID)
+	})
+}
@@ -2,6 +2,8 @@ package incomingHeader
 
 import (
 	"encoding/hex"
+	"fmt"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -11,35 +13,65 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
 
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
 	"github.com/multiversx/mx-chain-go/errors"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 var log = logger.GetOrCreate("headerSubscriber")
 
 // ArgsIncomingHeaderProcessor is a struct placeholder for args needed to create a new incoming header processor
 type ArgsIncomingHeaderProcessor struct {
-	HeadersPool                     HeadersPool
-	OutGoingOperationsPool          sovereignBlock.OutGoingOperationsPool
-	TxPool                          TransactionPool
-	Marshaller                      marshal.Marshalizer
-	Hasher                          hashing.Hasher
-	MainChainNotarizationStartRound uint64
-	DataCodec                       SovereignDataCodec
-	TopicsChecker                   TopicsChecker
+	// ShardID is the shard ID under which the incoming chain, whose headers are processed, is tracked
+	ShardID                uint32
+	HeadersPool            HeadersPool
+	OutGoingOperationsPool sovereignBlock.OutGoingOperationsPool
+	TxPool                 TransactionPool
+	Marshaller             marshal.Marshalizer
+	Hasher                 hashing.Hasher
+	IncomingChainsHandler  IncomingChainsHandler
+	DataCodec              SovereignDataCodec
+	TopicsChecker          TopicsChecker
+	EventsProofVerifier    EventsProofVerifier
+	AppStatusHandler       core.AppStatusHandler
+	ExecutedOpCallback     config.ExecutedBridgeOpCallback
+	// OperationsHashers are the hashers of the outgoing operations, used to check the operations of the executed bridge
+	// operation events against their confirmed hashes
+	OperationsHashers []hashing.Hasher
+	// NativeTokensPrefix is the prefix of the sovereign native tokens, which are not minted back by refunds
+	NativeTokensPrefix string
+	// NativeTokensUnlock is the call which unlocks the sovereign native tokens of the refunded operations
+	NativeTokensUnlock NativeTokensUnlock
+	// IncomingHeadersQueueStorer is where the incoming headers waiting for confirmations are saved
+	IncomingHeadersQueueStorer storage.Storer
+	// ExtendedHeadersStorer is where the extended headers included in committed sovereign blocks are saved
+	ExtendedHeadersStorer storage.Storer
 }
 
 type incomingHeaderProcessor struct {
-	eventsProc         *incomingEventsProcessor
-	extendedHeaderProc *extendedHeaderProcessor
+	shardID             uint32
+	eventsProc          *incomingEventsProcessor
+	extendedHeaderProc  *extendedHeaderProcessor
+	eventsProofVerifier EventsProofVerifier
+	appStatusHandler    core.AppStatusHandler
 
-	outGoingPool                    sovereignBlock.OutGoingOperationsPool
-	mainChainNotarizationStartRound uint64
+	outGoingPool          sovereignBlock.OutGoingOperationsPool
+	incomingChainsHandler IncomingChainsHandler
+	marshaller            marshal.Marshalizer
+	hasher                hashing.Hasher
+
+	mutQueue              sync.Mutex
+	queue                 *incomingHeadersQueue
+	extendedHeadersStorer storage.Storer
 }
 
 // NewIncomingHeaderProcessor creates an incoming header processor which should be able to receive incoming headers and events
-// from a chain to local sovereign chain. This handler will validate the events(using proofs in the future) and create
-// incoming miniblocks and transaction(which will be added in pool) to be executed in sovereign shard.
+// from a chain to local sovereign chain. This handler will validate the events(using the provided events proof verifier)
+// and create incoming miniblocks and transaction(which will be added in pool) to be executed in sovereign shard.
+// Each incoming chain has its own processor, which rejects the headers of any other chain.
 func NewIncomingHeaderProcessor(args ArgsIncomingHeaderProcessor) (*incomingHeaderProcessor, error) {
 	if check.IfNil(args.HeadersPool) {
 		return nil, errNilHeadersPool
@@ -62,6 +94,28 @@ func NewIncomingHeaderProcessor(args ArgsIncomingHeaderProcessor) (*incomingHead
 	if check.IfNil(args.TopicsChecker) {
 		return nil, errors.ErrNilTopicsChecker
 	}
+	if check.IfNil(args.EventsProofVerifier) {
+		return nil, errors.ErrNilEventsProofVerifier
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, errors.ErrNilAppStatusHandler
+	}
+	if check.IfNil(args.IncomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
+	if !args.IncomingChainsHandler.IsIncomingChainShard(args.ShardID) {
+		return nil, fmt.Errorf("%w: %d", errUnknownIncomingChainShard, args.ShardID)
+	}
+	if check.IfNil(args.IncomingHeadersQueueStorer) {
+		return nil, errNilIncomingHeadersQueueStorer
+	}
+	if check.IfNil(args.ExtendedHeadersStorer) {
+		return nil, errNilExtendedHeadersStorer
+	}
+	err := checkOperationsHashers(args.OperationsHashers)
+	if err != nil {
+		return nil, err
+	}
 
 	depositProc := &depositEventProc{
 		marshaller:    args.Marshaller,
@@ -71,13 +125,20 @@ func NewIncomingHeaderProcessor(args ArgsIncomingHeaderProcessor) (*incomingHead
 	}
 
 	executedOpProc := &executedBridgeOpEventProc{
-		depositEventProc: depositProc,
+		depositEventProc:   depositProc,
+		marshaller:         args.Marshaller,
+		hasher:             args.Hasher,
+		operationsHashers:  args.OperationsHashers,
+		dataCodec:          args.DataCodec,
+		callbackConfig:     args.ExecutedOpCallback,
+		nativeTokensPrefix: args.NativeTokensPrefix,
+		nativeTokensUnlock: args.NativeTokensUnlock,
 	}
 
 	eventsProc := &incomingEventsProcessor{
 		handlers: make(map[string]IncomingEventHandler),
 	}
-	err := eventsProc.registerProcessor(eventIDDepositIncomingTransfer, depositProc)
+	err = eventsProc.registerProcessor(eventIDDepositIncomingTransfer, depositProc)
 	if err != nil {
 		return nil, nil
 	}
@@ -87,23 +148,57 @@ func NewIncomingHeaderProcessor(args ArgsIncomingHeaderProcessor) (*incomingHead
 	}
 
 	extendedHearProc := &extendedHeaderProcessor{
+		shardID:     args.ShardID,
 		headersPool: args.HeadersPool,
 		txPool:      args.TxPool,
 		marshaller:  args.Marshaller,
 		hasher:      args.Hasher,
 	}
 
-	log.Debug("NewIncomingHeaderProcessor", "starting round to notarize main chain headers", args.MainChainNotarizationStartRound)
+	queue := newIncomingHeadersQueue(args.IncomingHeadersQueueStorer, args.Marshaller)
+	err = queue.load(args.ShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("NewIncomingHeaderProcessor", "shard", args.ShardID,
+		"starting round to notarize incoming chain headers", args.IncomingChainsHandler.GetNotarizationStartRound(args.ShardID),
+		"confirmation depth", args.IncomingChainsHandler.GetConfirmationDepth(args.ShardID),
+		"num loaded pending headers", queue.numPending(args.ShardID))
 
 	return &incomingHeaderProcessor{
-		eventsProc:                      eventsProc,
-		extendedHeaderProc:              extendedHearProc,
-		outGoingPool:                    args.OutGoingOperationsPool,
-		mainChainNotarizationStartRound: args.MainChainNotarizationStartRound,
+		shardID:               args.ShardID,
+		eventsProc:            eventsProc,
+		extendedHeaderProc:    extendedHearProc,
+		eventsProofVerifier:   args.EventsProofVerifier,
+		appStatusHandler:      args.AppStatusHandler,
+		outGoingPool:          args.OutGoingOperationsPool,
+		incomingChainsHandler: args.IncomingChainsHandler,
+		marshaller:            args.Marshaller,
+		hasher:                args.Hasher,
+		queue:                 queue,
+		extendedHeadersStorer: args.ExtendedHeadersStorer,
 	}, nil
 }
 
-// AddHeader will receive the incoming header, validate it, create incoming mbs and transactions and add them to pool
+func checkOperationsHashers(operationsHashers []hashing.Hasher) error {
+	if len(operationsHashers) == 0 {
+		return errors.ErrNilOperationsHasher
+	}
+	for idx, operationsHasher := range operationsHashers {
+		if check.IfNil(operationsHasher) {
+			return fmt.Errorf("%w at index = %d", errors.ErrNilOperationsHasher, idx)
+		}
+	}
+
+	return nil
+}
+
+// AddHeader will receive the incoming header, validate it, create incoming mbs and transactions and add them to pool.
+// For incoming headers received from the notifier, the extended header and its scrs are only added to pool once the
+// incoming header has enough confirmations on top of it (as configured for its incoming chain) or once it is finalized
+// by the notifier. Extended headers received from the network were already confirmed by the sovereign leader which
+// proposed them, hence they are added to pool right away, together with their pending ancestors.
 func (ihp *incomingHeaderProcessor) AddHeader(headerHash []byte, header sovereign.IncomingHeaderHandler) error {
 	if check.IfNil(header) || check.IfNil(header.GetHeaderHandler()) {
 		return data.ErrNilHeader
@@ -114,39 +209,230 @@ func (ihp *incomingHeaderProcessor) AddHeader(headerHash []byte, header sovereig
 		"nonce", header.GetHeaderHandler().GetNonce(),
 		"round", header.GetHeaderHandler().GetRound(),
 	)
+	err := ihp.checkIncomingChain(header.GetHeaderHandler())
+	if err != nil {
+		return err
+	}
+
 	round := header.GetHeaderHandler().GetRound()
+	shardID := ihp.shardID
+	notarizationStartRound := ihp.incomingChainsHandler.GetNotarizationStartRound(shardID)
 
 	// pre-genesis header, needed to track/link genesis header on top of this one. Every node with an enabled notifier
-	// will validate that the next genesis header with round == mainChainNotarizationStartRound is on top of pre-genesis header.
+	// will validate that the next genesis header with round == notarizationStartRound is on top of pre-genesis header.
 	// just save internal header to tracker, no need to process anything from it
-	if round == ihp.mainChainNotarizationStartRound-1 {
-		log.Debug("received pre-genesis header", "round", header.GetHeaderHandler().GetRound())
+	if round == notarizationStartRound-1 {
+		log.Debug("received pre-genesis header", "shard", shardID, "round", header.GetHeaderHandler().GetRound())
 		return ihp.extendedHeaderProc.addPreGenesisExtendedHeaderToPool(header)
 	}
 
-	if round < ihp.mainChainNotarizationStartRound {
-		log.Debug("do not notarize incoming header, round lower than main chain notarization start round",
+	if round < notarizationStartRound {
+		log.Debug("do not notarize incoming header, round lower than incoming chain notarization start round",
+			"shard", shardID,
 			"round", round,
-			"start round", ihp.mainChainNotarizationStartRound)
+			"start round", notarizationStartRound)
 		return nil
 	}
 
-	res, err := ihp.eventsProc.processIncomingEvents(header.GetIncomingEventHandlers())
+	err = ihp.verifyEventsProofs(header)
+	if err != nil {
+		return fmt.Errorf("incomingHeaderProcessor.AddHeader rejected header with hash %s: %w", hex.EncodeToString(headerHash), err)
+	}
+
+	res, err := ihp.eventsProc.processIncomingEvents(header)
+	if err != nil {
+		return err
+	}
+
+	extendedHeader, err := createExtendedHeader(header, res.scrs, ihp.shardID, ihp.marshaller)
+	if err != nil {
+		return err
+	}
+
+	innerHeaderHash, err := sovBlock.CalculateIncomingHeaderHash(ihp.marshaller, ihp.hasher, header.GetHeaderHandler())
 	if err != nil {
 		return err
 	}
 
-	extendedHeader, err := createExtendedHeader(header, res.scrs)
+	pendingHeader := &pendingIncomingHeader{
+		hash:               innerHeaderHash,
+		nonce:              header.GetHeaderHandler().GetNonce(),
+		isFinal:            isExtendedHeader(header),
+		extendedHeader:     extendedHeader,
+		scrs:               res.scrs,
+		confirmedBridgeOps: res.confirmedBridgeOps,
+	}
+
+	return ihp.addPendingHeader(shardID, pendingHeader)
+}
+
+// checkIncomingChain checks that the provided header belongs to the incoming chain this processor is bound to
+func (ihp *incomingHeaderProcessor) checkIncomingChain(header data.HeaderHandler) error {
+	shardID, err := ihp.incomingChainsHandler.GetShardIDForHeader(header)
 	if err != nil {
 		return err
 	}
+	if shardID != ihp.shardID {
+		return fmt.Errorf("%w, header chain id: %s, header shard: %d, incoming chain shard: %d",
+			errHeaderFromOtherIncomingChain, header.GetChainID(), shardID, ihp.shardID)
+	}
+
+	return nil
+}
+
+func (ihp *incomingHeaderProcessor) verifyEventsProofs(header sovereign.IncomingHeaderHandler) error {
+	err := ihp.eventsProofVerifier.VerifyEventsProofs(header)
+	if err != nil {
+		ihp.appStatusHandler.Increment(common.MetricNumIncomingHeadersWithInvalidProofs)
+		return err
+	}
+
+	return nil
+}
+
+// isExtendedHeader returns true if the incoming header is an extended header received from the network, instead of an
+// incoming header received from the notifier
+func isExtendedHeader(header sovereign.IncomingHeaderHandler) bool {
+	_, isExtended := header.(data.ShardHeaderExtendedHandler)
+	return isExtended
+}
+
+func (ihp *incomingHeaderProcessor) addPendingHeader(shardID uint32, pendingHeader *pendingIncomingHeader) error {
+	ihp.mutQueue.Lock()
+	defer ihp.mutQueue.Unlock()
+
+	confirmationDepth := ihp.incomingChainsHandler.GetConfirmationDepth(shardID)
+	executableHeaders, numDropped := ihp.queue.add(shardID, pendingHeader, confirmationDepth)
+	if numDropped > 0 {
+		log.Debug("incomingHeaderProcessor.AddHeader dropped pending incoming headers replaced by fork",
+			"shard", shardID,
+			"nonce", pendingHeader.nonce,
+			"num dropped", numDropped,
+		)
+		ihp.appStatusHandler.AddUint64(common.MetricNumRevertedIncomingHeaders, uint64(numDropped))
+	}
+
+	err := ihp.releaseHeaders(shardID, executableHeaders)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("incomingHeaderProcessor.AddHeader",
+		"shard", shardID,
+		"num released", len(executableHeaders),
+		"num pending", ihp.queue.numPending(shardID),
+	)
+
+	return nil
+}
+
+// releaseHeaders adds the executable headers to pools and saves the updated queue, even if one of them could not be
+// added, so that the saved queue does not hold the headers which were already released
+func (ihp *incomingHeaderProcessor) releaseHeaders(shardID uint32, executableHeaders []*pendingIncomingHeader) error {
+	defer ihp.queue.save(shardID)
+
+	for _, executableHeader := range executableHeaders {
+		err := ihp.releaseHeader(shardID, executableHeader)
+		if err != nil {
+			return err
+		}
+	}
 
-	err = ihp.extendedHeaderProc.addExtendedHeaderAndSCRsToPool(extendedHeader, res.scrs)
+	return nil
+}
+
+// FinalizeHeader will mark a previously received incoming header as final, as notified by the source chain. The header
+// and all its pending ancestors become executable, hence their extended headers and scrs are added to pools. Unknown
+// headers or headers which were already added to pools are ignored.
+func (ihp *incomingHeaderProcessor) FinalizeHeader(headerHash []byte) error {
+	ihp.mutQueue.Lock()
+	defer ihp.mutQueue.Unlock()
+
+	shardID, executableHeaders, found := ihp.queue.finalize(headerHash, ihp.incomingChainsHandler.GetConfirmationDepth(ihp.shardID))
+	if !found {
+		log.Trace("incomingHeaderProcessor.FinalizeHeader received unknown or already released incoming header",
+			"hash", hex.EncodeToString(headerHash))
+		return nil
+	}
+
+	log.Debug("incomingHeaderProcessor.FinalizeHeader",
+		"hash", hex.EncodeToString(headerHash),
+		"shard", shardID,
+		"num released", len(executableHeaders),
+	)
+
+	return ihp.releaseHeaders(shardID, executableHeaders)
+}
+
+func (ihp *incomingHeaderProcessor) releaseHeader(shardID uint32, header *pendingIncomingHeader) error {
+	extendedHeaderHash, err := ihp.extendedHeaderProc.addExtendedHeaderAndSCRsToPool(header.extendedHeader, header.scrs)
 	if err != nil {
 		return err
 	}
 
-	ihp.addConfirmedBridgeOpsToPool(res.confirmedBridgeOps)
+	ihp.addConfirmedBridgeOpsToPool(header.confirmedBridgeOps)
+
+	scrHashes := make([][]byte, 0, len(header.scrs))
+	for _, scr := range header.scrs {
+		scrHashes = append(scrHashes, scr.Hash)
+	}
+	ihp.queue.addReleased(shardID, &releasedIncomingHeader{
+		hash:               header.hash,
+		nonce:              header.nonce,
+		extendedHeaderHash: extendedHeaderHash,
+		scrHashes:          scrHashes,
+	})
+
+	return nil
+}
+
+// RevertHeader will revert a previously received incoming header. If the header is still waiting for confirmations, it
+// is dropped together with all pending headers on top of it. If it was already added in pools, its extended header and
+// scrs are evicted from pools. A header whose extended header was already included in a committed sovereign block can
+// not be reverted anymore, hence an error is returned. Unknown headers are ignored.
+func (ihp *incomingHeaderProcessor) RevertHeader(headerHash []byte) error {
+	ihp.mutQueue.Lock()
+	defer ihp.mutQueue.Unlock()
+
+	numRemoved := ihp.queue.removePending(headerHash)
+	if numRemoved > 0 {
+		log.Debug("incomingHeaderProcessor.RevertHeader dropped pending incoming headers",
+			"hash", hex.EncodeToString(headerHash),
+			"num dropped", numRemoved,
+		)
+		ihp.appStatusHandler.AddUint64(common.MetricNumRevertedIncomingHeaders, uint64(numRemoved))
+		ihp.queue.save(ihp.shardID)
+		return nil
+	}
+
+	releasedHeader, found := ihp.queue.getReleased(headerHash)
+	if !found {
+		log.Debug("incomingHeaderProcessor.RevertHeader received unknown incoming header", "hash", hex.EncodeToString(headerHash))
+		return nil
+	}
+
+	err := ihp.extendedHeadersStorer.Has(releasedHeader.extendedHeaderHash)
+	if err == nil {
+		log.Error("incomingHeaderProcessor.RevertHeader can not revert incoming header already included in a committed block",
+			"hash", hex.EncodeToString(headerHash),
+			"nonce", releasedHeader.nonce,
+			"extended header hash", hex.EncodeToString(releasedHeader.extendedHeaderHash),
+		)
+		return fmt.Errorf("%w, hash: %s, nonce: %d", errRevertIncludedIncomingHeader, hex.EncodeToString(headerHash), releasedHeader.nonce)
+	}
+
+	ihp.queue.removeReleased(headerHash)
+	ihp.queue.save(ihp.shardID)
+
+	log.Warn("incomingHeaderProcessor.RevertHeader reverted incoming header which was already added in pools",
+		"hash", hex.EncodeToString(headerHash),
+		"nonce", releasedHeader.nonce,
+		"extended header hash", hex.EncodeToString(releasedHeader.extendedHeaderHash),
+		"num scrs", len(releasedHeader.scrHashes),
+	)
+	ihp.extendedHeaderProc.removeExtendedHeaderAndSCRsFromPool(releasedHeader.extendedHeaderHash, releasedHeader.scrHashes)
+	ihp.appStatusHandler.Increment(common.MetricNumRevertedIncomingHeaders)
+
 	return nil
 }
 
@@ -168,12 +454,28 @@ func (ihp *incomingHeaderProcessor) addConfirmedBridgeOpsToPool(ops []*Confirmed
 
 // CreateExtendedHeader will create an extended shard header with incoming scrs and mbs from the events of the received header
 func (ihp *incomingHeaderProcessor) CreateExtendedHeader(header sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error) {
-	res, err := ihp.eventsProc.processIncomingEvents(header.GetIncomingEventHandlers())
+	if check.IfNil(header) || check.IfNil(header.GetHeaderHandler()) {
+		return nil, data.ErrNilHeader
+	}
+
+	err := ihp.checkIncomingChain(header.GetHeaderHandler())
+	if err != nil {
+		return nil, err
+	}
+
+	// invalid proofs of extended headers received from other nodes are not counted in the metric, which only counts the
+	// incoming headers received from the notifier
+	err = ihp.eventsProofVerifier.VerifyEventsProofs(header)
 	if err != nil {
 		return nil, err
 	}
 
-	return createExtendedHeader(header, res.scrs)
+	res, err := ihp.eventsProc.processIncomingEvents(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return createExtendedHeader(header, res.scrs, ihp.shardID, ihp.marshaller)
 }
 
 // RegisterEventHandler will register an extra incoming event processor. For the registered processor, a subscription
@@ -182,6 +484,33 @@ func (ihp *incomingHeaderProcessor) RegisterEventHandler(event string, proc Inco
 	return ihp.eventsProc.registerProcessor(event, proc)
 }
 
+// GetIncomingHeadersQueue returns the persisted form of the queue of incoming headers waiting for confirmations, so
+// that it can be exported by a hardfork
+func (ihp *incomingHeaderProcessor) GetIncomingHeadersQueue() ([]byte, error) {
+	ihp.mutQueue.Lock()
+	defer ihp.mutQueue.Unlock()
+
+	return ihp.queue.marshal(ihp.shardID)
+}
+
+// SetIncomingHeadersQueue replaces the queue of incoming headers waiting for confirmations with the provided persisted
+// form, as imported after a hardfork, and saves it
+func (ihp *incomingHeaderProcessor) SetIncomingHeadersQueue(queue []byte) error {
+	ihp.mutQueue.Lock()
+	defer ihp.mutQueue.Unlock()
+
+	err := ihp.queue.unmarshal(ihp.shardID, queue)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("incomingHeaderProcessor.SetIncomingHeadersQueue", "shard", ihp.shardID,
+		"num pending headers", ihp.queue.numPending(ihp.shardID))
+	ihp.queue.save(ihp.shardID)
+
+	return nil
+}
+
 // IsInterfaceNil checks if the underlying pointer is nil
 func (ihp *incomingHeaderProcessor) IsInterfaceNil() bool {
 	return ihp == nil
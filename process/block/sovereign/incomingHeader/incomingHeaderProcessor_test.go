@@ -9,13 +9,17 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -24,11 +28,14 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/stretchr/testify/require"
 )
 
 func createArgs() ArgsIncomingHeaderProcessor {
 	return ArgsIncomingHeaderProcessor{
+		ShardID:                core.MainChainShardId,
 		HeadersPool:            &mock.HeadersCacherStub{},
 		TxPool:                 &testscommon.ShardedDataStub{},
 		Marshaller:             &marshallerMock.MarshalizerMock{},
@@ -41,14 +48,21 @@ func createArgs() ArgsIncomingHeaderProcessor {
 				}, nil
 			},
 		},
-		TopicsChecker: &sovTests.TopicsCheckerMock{},
+		TopicsChecker:              &sovTests.TopicsCheckerMock{},
+		EventsProofVerifier:        &sovTests.EventsProofVerifierMock{},
+		AppStatusHandler:           &statusHandler.AppStatusHandlerStub{},
+		IncomingChainsHandler:      &sovTests.IncomingChainsHandlerMock{},
+		OperationsHashers:          []hashing.Hasher{&hashingMocks.HasherMock{}},
+		IncomingHeadersQueueStorer: testscommon.CreateMemUnit(),
+		ExtendedHeadersStorer:      testscommon.CreateMemUnit(),
 	}
 }
 
-func requireErrorIsInvalidNumTopics(t *testing.T, err error, idx int, numTopics int) {
-	require.True(t, strings.Contains(err.Error(), errInvalidNumTopicsIncomingEvent.Error()))
-	require.True(t, strings.Contains(err.Error(), fmt.Sprintf("%d", idx)))
-	require.True(t, strings.Contains(err.Error(), fmt.Sprintf("%d", numTopics)))
+func requireInvalidEventError(t *testing.T, handler *incomingHeaderProcessor, incomingHeader sovereign.IncomingHeaderHandler, expectedErr error) {
+	res, err := handler.eventsProc.processIncomingEvents(incomingHeader)
+	require.ErrorIs(t, err, expectedErr)
+	require.ErrorContains(t, err, "event idx = 0")
+	require.Nil(t, res)
 }
 
 func createIncomingHeadersWithIncrementalRound(numRounds uint64) []sovereign.IncomingHeaderHandler {
@@ -140,6 +154,87 @@ func TestNewIncomingHeaderHandler(t *testing.T) {
 		require.Nil(t, handler)
 	})
 
+	t.Run("nil events proof verifier, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.EventsProofVerifier = nil
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.Equal(t, errorsMx.ErrNilEventsProofVerifier, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("nil app status handler, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.AppStatusHandler = nil
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.Equal(t, errorsMx.ErrNilAppStatusHandler, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("nil incoming chains handler, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.IncomingChainsHandler = nil
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.Equal(t, errorsMx.ErrNilIncomingChainsHandler, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("nil incoming headers queue storer, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.IncomingHeadersQueueStorer = nil
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.Equal(t, errNilIncomingHeadersQueueStorer, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("nil extended headers storer, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.ExtendedHeadersStorer = nil
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.Equal(t, errNilExtendedHeadersStorer, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("no operations hashers, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.OperationsHashers = nil
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.Equal(t, errorsMx.ErrNilOperationsHasher, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("nil operations hasher, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.OperationsHashers = append(args.OperationsHashers, nil)
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.ErrorIs(t, err, errorsMx.ErrNilOperationsHasher)
+		require.Nil(t, handler)
+	})
+
+	t.Run("invalid saved incoming headers queue, should return error", func(t *testing.T) {
+		args := createArgs()
+		_ = args.IncomingHeadersQueueStorer.Put(createQueueStorageKey(args.ShardID), []byte("invalid"))
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.NotNil(t, err)
+		require.Nil(t, handler)
+	})
+
+	t.Run("unknown incoming chain shard, should return error", func(t *testing.T) {
+		args := createArgs()
+		args.ShardID = 0
+
+		handler, err := NewIncomingHeaderProcessor(args)
+		require.ErrorIs(t, err, errUnknownIncomingChainShard)
+		require.Nil(t, handler)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		args := createArgs()
 		handler, err := NewIncomingHeaderProcessor(args)
@@ -175,7 +270,12 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		startRound := uint64(11)
 
 		args := createArgs()
-		args.MainChainNotarizationStartRound = startRound
+		args.IncomingChainsHandler = &sovTests.IncomingChainsHandlerMock{
+			GetNotarizationStartRoundCalled: func(shardID uint32) uint64 {
+				require.Equal(t, core.MainChainShardId, shardID)
+				return startRound
+			},
+		}
 		wasHeaderAddedCt := 0
 		args.HeadersPool = &mock.HeadersCacherStub{
 			AddHeaderInShardCalled: func(headerHash []byte, header data.HeaderHandler, shardID uint32) {
@@ -224,6 +324,37 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		require.Equal(t, errInvalidHeaderType, err)
 	})
 
+	t.Run("sovereign chain header, should wrap it in the extended header", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Marshaller = &marshal.GogoProtoMarshalizer{}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		sovHeader := &block.SovereignChainHeader{
+			Header: &block.Header{
+				ChainID: []byte("sov2"),
+				Round:   4,
+			},
+		}
+		incomingHeader := &sovTests.IncomingHeaderStub{
+			GetHeaderHandlerCalled: func() data.HeaderHandler {
+				return sovHeader
+			},
+		}
+		extendedHeader, err := handler.CreateExtendedHeader(incomingHeader)
+		require.Nil(t, err)
+
+		headerV2, castOk := extendedHeader.GetHeaderHandler().(*block.HeaderV2)
+		require.True(t, castOk)
+		require.Equal(t, sovHeader.Header, headerV2.Header)
+
+		unwrappedHeader, isEnvelope, err := sovBlock.UnwrapSovereignChainHeader(args.Marshaller, headerV2)
+		require.Nil(t, err)
+		require.True(t, isEnvelope)
+		require.Equal(t, sovHeader, unwrappedHeader)
+	})
+
 	t.Run("cannot compute extended header hash, should return error", func(t *testing.T) {
 		t.Parallel()
 
@@ -241,6 +372,41 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		require.Equal(t, errMarshaller, err)
 	})
 
+	t.Run("invalid events proofs, should return error and not add anything to pool", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.EventsProofVerifier = &sovTests.EventsProofVerifierMock{
+			VerifyEventsProofsCalled: func(header sovereign.IncomingHeaderHandler) error {
+				return errorsMx.ErrInvalidIncomingEventProof
+			},
+		}
+		args.HeadersPool = &mock.HeadersCacherStub{
+			AddHeaderInShardCalled: func(headerHash []byte, header data.HeaderHandler, shardID uint32) {
+				require.Fail(t, "should not add header to pool")
+			},
+		}
+		args.TxPool = &testscommon.ShardedDataStub{
+			AddDataCalled: func(key []byte, data interface{}, sizeInBytes int, cacheID string) {
+				require.Fail(t, "should not add scr to pool")
+			},
+		}
+		wasMetricIncremented := false
+		args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				require.Equal(t, common.MetricNumIncomingHeadersWithInvalidProofs, key)
+				wasMetricIncremented = true
+			},
+		}
+
+		handler, _ := NewIncomingHeaderProcessor(args)
+		headers := createIncomingHeadersWithIncrementalRound(1)
+
+		err := handler.AddHeader([]byte("hash"), headers[1])
+		require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+		require.True(t, wasMetricIncremented)
+	})
+
 	t.Run("invalid num topics in deposit event, should return error", func(t *testing.T) {
 		t.Parallel()
 
@@ -268,7 +434,7 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		require.ErrorContains(t, err, errNumTopics.Error())
 	})
 
-	t.Run("invalid num topics in executed ops event, should return error", func(t *testing.T) {
+	t.Run("invalid executed ops event, should return error", func(t *testing.T) {
 		t.Parallel()
 
 		errNumTopics := fmt.Errorf("invalid num topics")
@@ -299,31 +465,28 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		}
 
 		handler, _ := NewIncomingHeaderProcessor(args)
-
-		err := handler.AddHeader([]byte("hash"), incomingHeader)
-		require.ErrorContains(t, err, errInvalidNumTopicsIncomingEvent.Error())
+		requireInvalidEventError(t, handler, incomingHeader, errInvalidNumTopicsIncomingEvent)
 
 		incomingHeader.IncomingEvents[0] = &transaction.Event{Topics: [][]byte{[]byte(topicIDDepositIncomingTransfer)}, Identifier: []byte(eventIDExecutedOutGoingBridgeOp)}
-		err = handler.AddHeader([]byte("hash"), incomingHeader)
+		err := handler.AddHeader([]byte("hash"), incomingHeader)
 		require.ErrorContains(t, err, errNumTopics.Error())
 
 		incomingHeader.IncomingEvents[0] = &transaction.Event{Topics: [][]byte{[]byte(topicIDConfirmedOutGoingOperation)}, Identifier: []byte(eventIDExecutedOutGoingBridgeOp)}
-		err = handler.AddHeader([]byte("hash"), incomingHeader)
-		requireErrorIsInvalidNumTopics(t, err, 0, 1)
+		requireInvalidEventError(t, handler, incomingHeader, errInvalidNumTopicsIncomingEvent)
 
 		incomingHeader.IncomingEvents[0] = &transaction.Event{Topics: [][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hash")}, Identifier: []byte(eventIDExecutedOutGoingBridgeOp)}
-		err = handler.AddHeader([]byte("hash"), incomingHeader)
-		requireErrorIsInvalidNumTopics(t, err, 0, 2)
+		requireInvalidEventError(t, handler, incomingHeader, errInvalidNumTopicsIncomingEvent)
 
-		incomingHeader.IncomingEvents[0] = &transaction.Event{Topics: [][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hash"), []byte("hash1"), []byte("hash2")}, Identifier: []byte(eventIDExecutedOutGoingBridgeOp)}
-		err = handler.AddHeader([]byte("hash"), incomingHeader)
-		requireErrorIsInvalidNumTopics(t, err, 0, 4)
+		incomingHeader.IncomingEvents[0] = &transaction.Event{Topics: [][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hash"), []byte("hash1"), []byte{0x01}, []byte("hash2")}, Identifier: []byte(eventIDExecutedOutGoingBridgeOp)}
+		requireInvalidEventError(t, handler, incomingHeader, errInvalidNumTopicsIncomingEvent)
 
-		require.Equal(t, 0, numConfirmedOperations)
+		incomingHeader.IncomingEvents[0] = &transaction.Event{Topics: [][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hash"), []byte("hash1"), []byte{0x02}}, Identifier: []byte(eventIDExecutedOutGoingBridgeOp)}
+		requireInvalidEventError(t, handler, incomingHeader, errInvalidExecutionStatus)
 
 		incomingHeader.IncomingEvents[0] = &transaction.Event{Topics: [][]byte{[]byte("topicID")}, Identifier: []byte(eventIDExecutedOutGoingBridgeOp)}
-		err = handler.AddHeader([]byte("hash"), incomingHeader)
-		require.True(t, strings.Contains(err.Error(), errInvalidIncomingTopicIdentifier.Error()))
+		requireInvalidEventError(t, handler, incomingHeader, errInvalidIncomingTopicIdentifier)
+
+		require.Equal(t, 0, numConfirmedOperations)
 	})
 
 	t.Run("invalid event id, should return error", func(t *testing.T) {
@@ -342,8 +505,7 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		}
 
 		handler, _ := NewIncomingHeaderProcessor(args)
-		err := handler.AddHeader([]byte("hash"), incomingHeader)
-		require.Equal(t, errInvalidIncomingEventIdentifier, err)
+		requireInvalidEventError(t, handler, incomingHeader, errInvalidIncomingEventIdentifier)
 	})
 
 	t.Run("cannot compute scr hash, should return error", func(t *testing.T) {
@@ -411,8 +573,7 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		}
 
 		handler, _ := NewIncomingHeaderProcessor(args)
-		err := handler.AddHeader([]byte("hash"), incomingHeader)
-		require.ErrorContains(t, err, errCannotDeserializeEventData.Error())
+		requireInvalidEventError(t, handler, incomingHeader, errCannotDeserializeEventData)
 	})
 
 	t.Run("cannot create token data, should return error", func(t *testing.T) {
@@ -439,8 +600,7 @@ func TestIncomingHeaderHandler_AddHeaderErrorCases(t *testing.T) {
 		}
 
 		handler, _ := NewIncomingHeaderProcessor(args)
-		err := handler.AddHeader([]byte("hash"), incomingHeader)
-		require.ErrorContains(t, err, errCannotDeserializeTokenData.Error())
+		requireInvalidEventError(t, handler, incomingHeader, errCannotDeserializeTokenData)
 	})
 }
 
@@ -728,3 +888,877 @@ func TestIncomingHeaderHandler_AddHeader(t *testing.T) {
 	require.True(t, wasAddedInTxPool)
 	require.True(t, wasOutGoingOpConfirmed)
 }
+
+func TestIncomingHeaderHandler_AddHeaderFromAnotherIncomingChain(t *testing.T) {
+	t.Parallel()
+
+	incomingChainShardID := core.MainChainShardId - 1
+	startRound := uint64(5)
+
+	args := createArgs()
+	args.ShardID = incomingChainShardID
+	args.IncomingChainsHandler = &sovTests.IncomingChainsHandlerMock{
+		GetShardIDForHeaderCalled: func(header data.HeaderHandler) (uint32, error) {
+			if string(header.GetChainID()) == "sov2" {
+				return incomingChainShardID, nil
+			}
+			return core.MainChainShardId, nil
+		},
+		IsIncomingChainShardCalled: func(shardID uint32) bool {
+			return shardID == core.MainChainShardId || shardID == incomingChainShardID
+		},
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.MainChainShardId, incomingChainShardID}
+		},
+		GetNotarizationStartRoundCalled: func(shardID uint32) uint64 {
+			if shardID == incomingChainShardID {
+				return startRound
+			}
+			return 0
+		},
+	}
+	addedInShard := make([]uint32, 0)
+	args.HeadersPool = &mock.HeadersCacherStub{
+		AddHeaderInShardCalled: func(_ []byte, _ data.HeaderHandler, shardID uint32) {
+			addedInShard = append(addedInShard, shardID)
+		},
+	}
+	handler, _ := NewIncomingHeaderProcessor(args)
+
+	incomingHeader := &sovereign.IncomingHeader{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				ChainID: []byte("sov2"),
+				Round:   startRound - 2,
+			},
+		},
+	}
+	err := handler.AddHeader([]byte("hash1"), incomingHeader)
+	require.Nil(t, err)
+	require.Empty(t, addedInShard)
+
+	incomingHeader.Header.Header.Round = startRound
+	err = handler.AddHeader([]byte("hash2"), incomingHeader)
+	require.Nil(t, err)
+	require.Equal(t, []uint32{incomingChainShardID}, addedInShard)
+
+	incomingHeader.Header.Header.ChainID = []byte("1")
+	err = handler.AddHeader([]byte("hash3"), incomingHeader)
+	require.ErrorIs(t, err, errHeaderFromOtherIncomingChain)
+	require.Equal(t, []uint32{incomingChainShardID}, addedInShard)
+
+	_, err = handler.CreateExtendedHeader(incomingHeader)
+	require.ErrorIs(t, err, errHeaderFromOtherIncomingChain)
+}
+
+func TestIncomingHeaderHandler_CreateExtendedHeaderWithInvalidEventsProofs(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs()
+	args.EventsProofVerifier = &sovTests.EventsProofVerifierMock{
+		VerifyEventsProofsCalled: func(header sovereign.IncomingHeaderHandler) error {
+			return errorsMx.ErrInvalidIncomingEventProof
+		},
+	}
+	args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			require.Fail(t, "should not count the invalid proofs of extended headers received from other nodes")
+		},
+	}
+
+	handler, _ := NewIncomingHeaderProcessor(args)
+	headers := createIncomingHeadersWithIncrementalRound(1)
+
+	extendedHeader, err := handler.CreateExtendedHeader(headers[1])
+	require.ErrorIs(t, err, errorsMx.ErrInvalidIncomingEventProof)
+	require.Nil(t, extendedHeader)
+}
+
+func createEventWithMetadata(t *testing.T, event *transaction.Event, metadata *EventMetadata) *transaction.Event {
+	eventWithMetadata, err := AttachEventMetadata(event, metadata)
+	require.Nil(t, err)
+
+	return eventWithMetadata
+}
+
+func TestIncomingHeaderHandler_CreateExtendedHeaderWithEventsTxInfo(t *testing.T) {
+	t.Parallel()
+
+	depositEvent := &transaction.Event{
+		Identifier: []byte(eventIDDepositIncomingTransfer),
+		Topics:     [][]byte{[]byte(topicIDDepositIncomingTransfer), []byte("addr"), []byte("token"), []byte("nonce"), []byte("tokenData")},
+		Data:       []byte("eventData"),
+	}
+	confirmedOpEvent := &transaction.Event{
+		Identifier: []byte(eventIDExecutedOutGoingBridgeOp),
+		Topics:     [][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hashOfHashes"), []byte("hashOfBridgeOp")},
+	}
+	incomingHeader := &sovereign.IncomingHeader{
+		Header:         &block.HeaderV2{},
+		IncomingEvents: []*transaction.Event{depositEvent, confirmedOpEvent},
+	}
+
+	args := createArgs()
+	args.DataCodec = &sovTests.DataCodecMock{
+		DeserializeEventDataCalled: func(_ []byte) (*sovereign.EventData, error) {
+			return &sovereign.EventData{}, nil
+		},
+		DeserializeTokenDataCalled: func(_ []byte) (*sovereign.EsdtTokenData, error) {
+			return &sovereign.EsdtTokenData{
+				TokenType: core.Fungible,
+				Amount:    big.NewInt(100),
+			}, nil
+		},
+	}
+	handler, _ := NewIncomingHeaderProcessor(args)
+
+	t.Run("events without metadata should not set original tx hash", func(t *testing.T) {
+		res, err := handler.eventsProc.processIncomingEvents(incomingHeader)
+		require.Nil(t, err)
+		require.Len(t, res.scrs, 1)
+		require.Nil(t, res.scrs[0].SCR.OriginalTxHash)
+		require.Nil(t, res.scrs[0].SCR.PrevTxHash)
+		require.Nil(t, res.scrs[0].SCR.ReturnMessage)
+	})
+	t.Run("invalid event metadata should error", func(t *testing.T) {
+		invalidDepositEvent := *depositEvent
+		invalidDepositEvent.AdditionalData = [][]byte{append(append([]byte{}, eventMetadataPrefix...), []byte("invalid")...)}
+		headerWithTxInfo := &sovereign.IncomingHeader{
+			Header:         &block.HeaderV2{},
+			IncomingEvents: []*transaction.Event{&invalidDepositEvent, confirmedOpEvent},
+		}
+
+		extendedHeader, err := handler.CreateExtendedHeader(headerWithTxInfo)
+		require.True(t, errors.Is(err, errInvalidEventMetadata))
+		require.Nil(t, extendedHeader)
+	})
+	t.Run("should set main chain tx hash as original tx hash without overloading the return message", func(t *testing.T) {
+		mainChainTxHash := []byte("mainChainTxHash")
+		headerWithTxInfo := &sovereign.IncomingHeader{
+			Header: &block.HeaderV2{},
+			IncomingEvents: []*transaction.Event{
+				createEventWithMetadata(t, depositEvent, &EventMetadata{TxHash: mainChainTxHash, EventIndex: 1}),
+				createEventWithMetadata(t, confirmedOpEvent, &EventMetadata{TxHash: []byte("otherTxHash"), EventIndex: 0}),
+			},
+		}
+
+		res, err := handler.eventsProc.processIncomingEvents(headerWithTxInfo)
+		require.Nil(t, err)
+		require.Len(t, res.scrs, 1)
+		require.Len(t, res.confirmedBridgeOps, 1)
+
+		scr := res.scrs[0].SCR
+		require.Equal(t, mainChainTxHash, scr.OriginalTxHash)
+		require.Equal(t, mainChainTxHash, scr.PrevTxHash)
+		require.Nil(t, scr.ReturnMessage)
+
+		expectedHash, err := core.CalculateHash(args.Marshaller, args.Hasher, scr)
+		require.Nil(t, err)
+		require.Equal(t, expectedHash, res.scrs[0].Hash)
+
+		extendedHeader, err := handler.CreateExtendedHeader(headerWithTxInfo)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{expectedHash}, extendedHeader.GetIncomingMiniBlockHandlers()[0].(*block.MiniBlock).TxHashes)
+	})
+	t.Run("different deposits of the same tx should create different scrs", func(t *testing.T) {
+		depositNonce := uint64(0)
+		argsWithNonces := createArgs()
+		argsWithNonces.DataCodec = &sovTests.DataCodecMock{
+			DeserializeEventDataCalled: func(_ []byte) (*sovereign.EventData, error) {
+				depositNonce++
+				return &sovereign.EventData{Nonce: depositNonce}, nil
+			},
+			DeserializeTokenDataCalled: args.DataCodec.(*sovTests.DataCodecMock).DeserializeTokenDataCalled,
+		}
+		handlerWithNonces, _ := NewIncomingHeaderProcessor(argsWithNonces)
+
+		mainChainTxHash := []byte("mainChainTxHash")
+		headerWithTxInfo := &sovereign.IncomingHeader{
+			Header: &block.HeaderV2{},
+			IncomingEvents: []*transaction.Event{
+				createEventWithMetadata(t, depositEvent, &EventMetadata{TxHash: mainChainTxHash, EventIndex: 0}),
+				createEventWithMetadata(t, depositEvent, &EventMetadata{TxHash: mainChainTxHash, EventIndex: 1}),
+			},
+		}
+
+		res, err := handlerWithNonces.eventsProc.processIncomingEvents(headerWithTxInfo)
+		require.Nil(t, err)
+		require.Len(t, res.scrs, 2)
+		require.Nil(t, res.scrs[0].SCR.ReturnMessage)
+		require.Nil(t, res.scrs[1].SCR.ReturnMessage)
+		require.Equal(t, mainChainTxHash, res.scrs[0].SCR.OriginalTxHash)
+		require.Equal(t, mainChainTxHash, res.scrs[1].SCR.OriginalTxHash)
+		require.NotEqual(t, res.scrs[0].Hash, res.scrs[1].Hash)
+	})
+	t.Run("invalid event after valid ones should reject all the events", func(t *testing.T) {
+		headerWithInvalidEvent := &sovereign.IncomingHeader{
+			Header: &block.HeaderV2{},
+			IncomingEvents: []*transaction.Event{
+				depositEvent,
+				{
+					Identifier: []byte(eventIDExecutedOutGoingBridgeOp),
+					Topics:     [][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hash")},
+				},
+			},
+		}
+
+		res, err := handler.eventsProc.processIncomingEvents(headerWithInvalidEvent)
+		require.ErrorIs(t, err, errInvalidNumTopicsIncomingEvent)
+		require.ErrorContains(t, err, "event idx = 1")
+		require.Nil(t, res)
+	})
+}
+
+func createIncomingHeaderWithNonce(nonce uint64, chainID string) *sovereign.IncomingHeader {
+	return &sovereign.IncomingHeader{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				ChainID: []byte(chainID),
+				Nonce:   nonce,
+				Round:   nonce + 10,
+			},
+		},
+	}
+}
+
+func createArgsWithConfirmationDepth(confirmationDepth uint64, addedHeaders *[]uint64, removedHeaders *[][]byte) ArgsIncomingHeaderProcessor {
+	args := createArgs()
+	args.IncomingChainsHandler = &sovTests.IncomingChainsHandlerMock{
+		GetShardIDForHeaderCalled: func(_ data.HeaderHandler) (uint32, error) {
+			return core.MainChainShardId, nil
+		},
+		GetNotarizationStartRoundCalled: func(_ uint32) uint64 {
+			return 5
+		},
+		GetConfirmationDepthCalled: func(_ uint32) uint64 {
+			return confirmationDepth
+		},
+	}
+	args.HeadersPool = &mock.HeadersCacherStub{
+		AddHeaderInShardCalled: func(_ []byte, header data.HeaderHandler, _ uint32) {
+			*addedHeaders = append(*addedHeaders, header.GetNonce())
+		},
+		RemoveHeaderByHashCalled: func(headerHash []byte) {
+			*removedHeaders = append(*removedHeaders, headerHash)
+		},
+	}
+
+	return args
+}
+
+func TestIncomingHeaderHandler_AddHeaderWithConfirmationDepth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("headers should be added in pool only after enough confirmations", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		handler, _ := NewIncomingHeaderProcessor(createArgsWithConfirmationDepth(2, &addedHeaders, &removedHeaders))
+
+		for nonce := uint64(1); nonce <= 2; nonce++ {
+			err := handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(nonce, "1"))
+			require.Nil(t, err)
+		}
+		require.Empty(t, addedHeaders)
+
+		err := handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(3, "1"))
+		require.Nil(t, err)
+		require.Equal(t, []uint64{1}, addedHeaders)
+
+		err = handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(4, "1"))
+		require.Nil(t, err)
+		require.Equal(t, []uint64{1, 2}, addedHeaders)
+		require.Equal(t, 2, handler.queue.numPending(core.MainChainShardId))
+	})
+	t.Run("header on fork should replace pending headers", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		args := createArgsWithConfirmationDepth(1, &addedHeaders, &removedHeaders)
+		numReverted := uint64(0)
+		args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+			AddUint64Handler: func(key string, value uint64) {
+				require.Equal(t, common.MetricNumRevertedIncomingHeaders, key)
+				numReverted += value
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		err := handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(1, "1"))
+		require.Nil(t, err)
+		require.Empty(t, addedHeaders)
+
+		forkHeader := createIncomingHeaderWithNonce(1, "1")
+		forkHeader.Header.Header.Round++
+		err = handler.AddHeader([]byte("hash"), forkHeader)
+		require.Nil(t, err)
+		require.Empty(t, addedHeaders)
+		require.Equal(t, uint64(1), numReverted)
+
+		err = handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(2, "1"))
+		require.Nil(t, err)
+		require.Equal(t, []uint64{1}, addedHeaders)
+
+		forkHeaderHash, err := core.CalculateHash(args.Marshaller, args.Hasher, forkHeader.GetHeaderHandler())
+		require.Nil(t, err)
+		require.Equal(t, forkHeaderHash, handler.queue.releasedHeaders[core.MainChainShardId][0].hash)
+	})
+	t.Run("finalized header should release all pending ancestors", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		args := createArgsWithConfirmationDepth(10, &addedHeaders, &removedHeaders)
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		headers := make([]*sovereign.IncomingHeader, 0)
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			header := createIncomingHeaderWithNonce(nonce, "1")
+			headers = append(headers, header)
+			err := handler.AddHeader([]byte("hash"), header)
+			require.Nil(t, err)
+		}
+		require.Empty(t, addedHeaders)
+
+		err := handler.FinalizeHeader([]byte("unknown"))
+		require.Nil(t, err)
+		require.Empty(t, addedHeaders)
+
+		hashOfSecond, err := core.CalculateHash(args.Marshaller, args.Hasher, headers[1].GetHeaderHandler())
+		require.Nil(t, err)
+		err = handler.FinalizeHeader(hashOfSecond)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{1, 2}, addedHeaders)
+		require.Equal(t, 1, handler.queue.numPending(core.MainChainShardId))
+	})
+	t.Run("extended header received from network should not wait for confirmations", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		args := createArgsWithConfirmationDepth(10, &addedHeaders, &removedHeaders)
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		err := handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(1, "1"))
+		require.Nil(t, err)
+		require.Empty(t, addedHeaders)
+
+		extendedHeader, err := handler.CreateExtendedHeader(createIncomingHeaderWithNonce(2, "1"))
+		require.Nil(t, err)
+		err = handler.AddHeader([]byte("extendedHash"), extendedHeader)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{1, 2}, addedHeaders)
+		require.Zero(t, handler.queue.numPending(core.MainChainShardId))
+	})
+	t.Run("pending headers should be loaded after restart", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		args := createArgsWithConfirmationDepth(2, &addedHeaders, &removedHeaders)
+		args.Marshaller = &marshal.GogoProtoMarshalizer{}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		for nonce := uint64(1); nonce <= 2; nonce++ {
+			err := handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(nonce, "1"))
+			require.Nil(t, err)
+		}
+		require.Empty(t, addedHeaders)
+
+		restartedHandler, err := NewIncomingHeaderProcessor(args)
+		require.Nil(t, err)
+		require.Equal(t, 2, restartedHandler.queue.numPending(core.MainChainShardId))
+		for idx, pendingHeader := range handler.queue.pendingHeaders[core.MainChainShardId] {
+			loadedHeader := restartedHandler.queue.pendingHeaders[core.MainChainShardId][idx]
+			require.Equal(t, pendingHeader.hash, loadedHeader.hash)
+			require.Equal(t, pendingHeader.nonce, loadedHeader.nonce)
+
+			expectedHash, _ := core.CalculateHash(args.Marshaller, args.Hasher, pendingHeader.extendedHeader)
+			loadedHash, _ := core.CalculateHash(args.Marshaller, args.Hasher, loadedHeader.extendedHeader)
+			require.Equal(t, expectedHash, loadedHash)
+		}
+
+		err = restartedHandler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(3, "1"))
+		require.Nil(t, err)
+		require.Equal(t, []uint64{1}, addedHeaders)
+	})
+}
+
+func TestIncomingHeaderHandler_GetSetIncomingHeadersQueue(t *testing.T) {
+	t.Parallel()
+
+	addedHeaders := make([]uint64, 0)
+	removedHeaders := make([][]byte, 0)
+	args := createArgsWithConfirmationDepth(2, &addedHeaders, &removedHeaders)
+	args.Marshaller = &marshal.GogoProtoMarshalizer{}
+	handler, _ := NewIncomingHeaderProcessor(args)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		err := handler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(nonce, "1"))
+		require.Nil(t, err)
+	}
+	require.Empty(t, addedHeaders)
+
+	queue, err := handler.GetIncomingHeadersQueue()
+	require.Nil(t, err)
+	require.NotEmpty(t, queue)
+
+	importArgs := createArgsWithConfirmationDepth(2, &addedHeaders, &removedHeaders)
+	importArgs.Marshaller = &marshal.GogoProtoMarshalizer{}
+	importedHandler, _ := NewIncomingHeaderProcessor(importArgs)
+	require.Zero(t, importedHandler.queue.numPending(core.MainChainShardId))
+
+	err = importedHandler.SetIncomingHeadersQueue([]byte("invalid"))
+	require.NotNil(t, err)
+
+	err = importedHandler.SetIncomingHeadersQueue(queue)
+	require.Nil(t, err)
+	require.Equal(t, 2, importedHandler.queue.numPending(core.MainChainShardId))
+
+	err = importedHandler.AddHeader([]byte("hash"), createIncomingHeaderWithNonce(3, "1"))
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1}, addedHeaders)
+}
+
+func TestIncomingHeaderHandler_RevertHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown header should not error", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		handler, _ := NewIncomingHeaderProcessor(createArgsWithConfirmationDepth(1, &addedHeaders, &removedHeaders))
+
+		err := handler.RevertHeader([]byte("unknown"))
+		require.Nil(t, err)
+		require.Empty(t, removedHeaders)
+	})
+	t.Run("pending header should be dropped together with its descendants", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		args := createArgsWithConfirmationDepth(5, &addedHeaders, &removedHeaders)
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		headers := make([]*sovereign.IncomingHeader, 0)
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			header := createIncomingHeaderWithNonce(nonce, "1")
+			headers = append(headers, header)
+			err := handler.AddHeader([]byte("hash"), header)
+			require.Nil(t, err)
+		}
+
+		hashOfSecond, err := core.CalculateHash(args.Marshaller, args.Hasher, headers[1].GetHeaderHandler())
+		require.Nil(t, err)
+
+		err = handler.RevertHeader(hashOfSecond)
+		require.Nil(t, err)
+		require.Equal(t, 1, handler.queue.numPending(core.MainChainShardId))
+		require.Empty(t, addedHeaders)
+		require.Empty(t, removedHeaders)
+	})
+	t.Run("released header should be evicted from pools", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		args := createArgsWithConfirmationDepth(0, &addedHeaders, &removedHeaders)
+		addedSCRs := make([][]byte, 0)
+		removedSCRs := make([][]byte, 0)
+		args.TxPool = &testscommon.ShardedDataStub{
+			AddDataCalled: func(key []byte, _ interface{}, _ int, _ string) {
+				addedSCRs = append(addedSCRs, key)
+			},
+			RemoveDataCalled: func(key []byte, cacheID string) {
+				require.Equal(t, process.ShardCacherIdentifier(core.MainChainShardId, core.SovereignChainShardId), cacheID)
+				removedSCRs = append(removedSCRs, key)
+			},
+		}
+		numReverted := 0
+		args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+			IncrementHandler: func(key string) {
+				if key == common.MetricNumRevertedIncomingHeaders {
+					numReverted++
+				}
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		header := createIncomingHeadersWithIncrementalRound(6)[6].(*sovereign.IncomingHeader)
+		err := handler.AddHeader([]byte("hash"), header)
+		require.Nil(t, err)
+		require.Len(t, addedHeaders, 1)
+		require.Len(t, addedSCRs, 1)
+
+		headerHash, err := core.CalculateHash(args.Marshaller, args.Hasher, header.GetHeaderHandler())
+		require.Nil(t, err)
+
+		err = handler.RevertHeader(headerHash)
+		require.Nil(t, err)
+		require.Len(t, removedHeaders, 1)
+		require.Equal(t, addedSCRs, removedSCRs)
+		require.Equal(t, 1, numReverted)
+
+		err = handler.RevertHeader(headerHash)
+		require.Nil(t, err)
+		require.Len(t, removedHeaders, 1)
+	})
+	t.Run("header included in a committed block should not be reverted", func(t *testing.T) {
+		t.Parallel()
+
+		addedHeaders := make([]uint64, 0)
+		removedHeaders := make([][]byte, 0)
+		args := createArgsWithConfirmationDepth(0, &addedHeaders, &removedHeaders)
+		var extendedHeaderHash []byte
+		args.HeadersPool = &mock.HeadersCacherStub{
+			AddHeaderInShardCalled: func(headerHash []byte, _ data.HeaderHandler, _ uint32) {
+				extendedHeaderHash = headerHash
+			},
+			RemoveHeaderByHashCalled: func(_ []byte) {
+				require.Fail(t, "should not remove header")
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		header := createIncomingHeaderWithNonce(1, "1")
+		err := handler.AddHeader([]byte("hash"), header)
+		require.Nil(t, err)
+
+		err = args.ExtendedHeadersStorer.Put(extendedHeaderHash, []byte("extended header"))
+		require.Nil(t, err)
+
+		headerHash, err := core.CalculateHash(args.Marshaller, args.Hasher, header.GetHeaderHandler())
+		require.Nil(t, err)
+
+		err = handler.RevertHeader(headerHash)
+		require.ErrorIs(t, err, errRevertIncludedIncomingHeader)
+		_, found := handler.queue.getReleased(headerHash)
+		require.True(t, found)
+	})
+}
+
+func TestIncomingHeaderHandler_ExecutedBridgeOpWithStatus(t *testing.T) {
+	t.Parallel()
+
+	scAddr := make([]byte, 32)
+	copy(scAddr[10:], "originatingContract")
+	require.True(t, core.IsSmartContractAddress(scAddr))
+
+	token1 := []byte("token1")
+	token2 := []byte("token2")
+	operation := &sovereign.Operation{
+		Tokens: []sovereign.EsdtToken{
+			{
+				Identifier: token1,
+				Data: sovereign.EsdtTokenData{
+					TokenType: core.Fungible,
+					Amount:    big.NewInt(100),
+				},
+			},
+			{
+				Identifier: token2,
+				Nonce:      4,
+				Data: sovereign.EsdtTokenData{
+					TokenType: core.NonFungible,
+					Amount:    big.NewInt(1),
+					Royalties: big.NewInt(0),
+				},
+			},
+		},
+		Data: &sovereign.EventData{
+			Nonce:  7,
+			Sender: scAddr,
+		},
+	}
+	opData := []byte("operation")
+	opHash := (&hashingMocks.HasherMock{}).Compute(string(opData))
+	mainChainTxHash := []byte("mainChainTxHash")
+
+	createExecutedOpEvent := func(topics [][]byte) *sovereign.IncomingHeader {
+		return &sovereign.IncomingHeader{
+			Header: &block.HeaderV2{},
+			IncomingEvents: []*transaction.Event{
+				createEventWithMetadata(t, &transaction.Event{
+					Identifier: []byte(eventIDExecutedOutGoingBridgeOp),
+					Topics:     topics,
+					Data:       opData,
+				}, &EventMetadata{TxHash: mainChainTxHash}),
+			},
+		}
+	}
+	createExecutedOpHeader := func(status []byte) *sovereign.IncomingHeader {
+		return createExecutedOpEvent([][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hashOfHashes"), opHash, status})
+	}
+
+	createArgsWithOperation := func() ArgsIncomingHeaderProcessor {
+		args := createArgs()
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeOperationCalled: func(data []byte) (*sovereign.Operation, error) {
+				require.Equal(t, opData, data)
+				return operation, nil
+			},
+		}
+		return args
+	}
+
+	t.Run("successful operation without callback should only confirm the operation", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := NewIncomingHeaderProcessor(createArgsWithOperation())
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpHeader([]byte{0x01}))
+		require.Nil(t, err)
+		require.Empty(t, res.scrs)
+		require.Equal(t, []*ConfirmedBridgeOp{{
+			HashOfHashes: []byte("hashOfHashes"),
+			Hash:         opHash,
+			Status:       BridgeOpStatusSuccessful,
+		}}, res.confirmedBridgeOps)
+	})
+	t.Run("legacy event without status should confirm the operation with unknown status", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithOperation()
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeOperationCalled: func(_ []byte) (*sovereign.Operation, error) {
+				require.Fail(t, "should not deserialize the operation")
+				return nil, nil
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpEvent([][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hashOfHashes"), opHash}))
+		require.Nil(t, err)
+		require.Empty(t, res.scrs)
+		require.Equal(t, []*ConfirmedBridgeOp{{
+			HashOfHashes: []byte("hashOfHashes"),
+			Hash:         opHash,
+			Status:       BridgeOpStatusUnknown,
+		}}, res.confirmedBridgeOps)
+	})
+	t.Run("operation not matching the confirmed hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithOperation()
+		args.ExecutedOpCallback = config.ExecutedBridgeOpCallback{
+			Enabled:  true,
+			Function: "callback",
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+		requireInvalidEventError(t, handler, createExecutedOpEvent([][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hashOfHashes"), []byte("hashOfBridgeOp"), nil}), errInvalidExecutedBridgeOpData)
+	})
+	t.Run("operation matching the confirmed hash of any operations hasher should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithOperation()
+		args.OperationsHashers = []hashing.Hasher{
+			&hashingMocks.HasherMock{},
+			&testscommon.HasherStub{
+				ComputeCalled: func(s string) []byte {
+					return []byte("hashOfBridgeOp")
+				},
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpEvent([][]byte{[]byte(topicIDConfirmedOutGoingOperation), []byte("hashOfHashes"), []byte("hashOfBridgeOp"), nil}))
+		require.Nil(t, err)
+		require.Len(t, res.confirmedBridgeOps, 1)
+		require.Len(t, res.scrs, 1)
+	})
+	t.Run("invalid operation data should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithOperation()
+		args.DataCodec = &sovTests.DataCodecMock{}
+		handler, _ := NewIncomingHeaderProcessor(args)
+		requireInvalidEventError(t, handler, createExecutedOpHeader(nil), errInvalidExecutedBridgeOpData)
+	})
+	t.Run("failed operation should refund the sender", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithOperation()
+		handler, _ := NewIncomingHeaderProcessor(args)
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpHeader(nil))
+		require.Nil(t, err)
+		require.Len(t, res.confirmedBridgeOps, 1)
+		require.Equal(t, BridgeOpStatusFailed, res.confirmedBridgeOps[0].Status)
+		require.Len(t, res.scrs, 1)
+
+		token2Data, err := createDigitalTokenBytes(args.Marshaller, 4, &operation.Tokens[1].Data)
+		require.Nil(t, err)
+		expectedSCR := &smartContractResult.SmartContractResult{
+			Nonce:   7,
+			Value:   big.NewInt(0),
+			RcvAddr: scAddr,
+			SndAddr: core.ESDTSCAddress,
+			Data: []byte(core.BuiltInFunctionMultiESDTNFTTransfer + "@02" +
+				"@" + hex.EncodeToString(token1) +
+				"@" +
+				"@" + hex.EncodeToString(big.NewInt(100).Bytes()) +
+				"@" + hex.EncodeToString(token2) +
+				"@04" +
+				"@" + hex.EncodeToString(token2Data)),
+			OriginalTxHash: mainChainTxHash,
+			PrevTxHash:     opHash,
+		}
+		require.Equal(t, expectedSCR, res.scrs[0].SCR)
+
+		expectedHash, err := core.CalculateHash(args.Marshaller, args.Hasher, expectedSCR)
+		require.Nil(t, err)
+		require.Equal(t, expectedHash, res.scrs[0].Hash)
+	})
+	t.Run("callback should be sent to the originating contract on both outcomes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithOperation()
+		args.ExecutedOpCallback = config.ExecutedBridgeOpCallback{
+			Enabled:  true,
+			Function: "callback",
+			GasLimit: 1000,
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpHeader([]byte{0x01}))
+		require.Nil(t, err)
+		require.Len(t, res.scrs, 1)
+		require.Equal(t, &smartContractResult.SmartContractResult{
+			Nonce:          7,
+			Value:          big.NewInt(0),
+			RcvAddr:        scAddr,
+			SndAddr:        core.ESDTSCAddress,
+			Data:           []byte("callback@" + hex.EncodeToString(opHash) + "@01"),
+			GasLimit:       1000,
+			OriginalTxHash: mainChainTxHash,
+			PrevTxHash:     mainChainTxHash,
+		}, res.scrs[0].SCR)
+
+		res, err = handler.eventsProc.processIncomingEvents(createExecutedOpHeader(nil))
+		require.Nil(t, err)
+		require.Len(t, res.scrs, 2)
+		require.True(t, strings.HasPrefix(string(res.scrs[0].SCR.Data), core.BuiltInFunctionMultiESDTNFTTransfer))
+		require.Equal(t, []byte("callback@"+hex.EncodeToString(opHash)+"@"), res.scrs[1].SCR.Data)
+	})
+	t.Run("failed operation without native tokens unlock should not refund the native tokens", func(t *testing.T) {
+		t.Parallel()
+
+		nativeToken := []byte("sov-NATIVE-abcdef")
+		args := createArgsWithOperation()
+		args.NativeTokensPrefix = "sov"
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeOperationCalled: func(_ []byte) (*sovereign.Operation, error) {
+				return &sovereign.Operation{
+					Tokens: []sovereign.EsdtToken{
+						{
+							Identifier: nativeToken,
+							Data: sovereign.EsdtTokenData{
+								TokenType: core.Fungible,
+								Amount:    big.NewInt(50),
+							},
+						},
+						operation.Tokens[0],
+					},
+					Data: operation.Data,
+				}, nil
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpHeader(nil))
+		require.Nil(t, err)
+		require.Len(t, res.scrs, 1)
+		require.Equal(t, []byte(core.BuiltInFunctionMultiESDTNFTTransfer+"@01"+
+			"@"+hex.EncodeToString(token1)+
+			"@"+
+			"@"+hex.EncodeToString(big.NewInt(100).Bytes())), res.scrs[0].SCR.Data)
+	})
+	t.Run("failed operation with native tokens unlock should unlock the native tokens from the safe", func(t *testing.T) {
+		t.Parallel()
+
+		nativeToken := []byte("sov-NATIVE-abcdef")
+		safeAddr := []byte("sovereignSafeAddress")
+		args := createArgsWithOperation()
+		args.NativeTokensPrefix = "sov"
+		args.NativeTokensUnlock = NativeTokensUnlock{
+			Address:  safeAddr,
+			Function: "unlock",
+			GasLimit: 2000,
+		}
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeOperationCalled: func(_ []byte) (*sovereign.Operation, error) {
+				return &sovereign.Operation{
+					Tokens: []sovereign.EsdtToken{
+						{
+							Identifier: nativeToken,
+							Data: sovereign.EsdtTokenData{
+								TokenType: core.Fungible,
+								Amount:    big.NewInt(50),
+							},
+						},
+						operation.Tokens[0],
+					},
+					Data: operation.Data,
+				}, nil
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpHeader(nil))
+		require.Nil(t, err)
+		require.Len(t, res.scrs, 2)
+		require.Equal(t, []byte(core.BuiltInFunctionMultiESDTNFTTransfer+"@01"+
+			"@"+hex.EncodeToString(token1)+
+			"@"+
+			"@"+hex.EncodeToString(big.NewInt(100).Bytes())), res.scrs[0].SCR.Data)
+
+		expectedSCR := &smartContractResult.SmartContractResult{
+			Nonce:   7,
+			Value:   big.NewInt(0),
+			RcvAddr: safeAddr,
+			SndAddr: core.ESDTSCAddress,
+			Data: []byte("unlock" +
+				"@" + hex.EncodeToString(opHash) +
+				"@" + hex.EncodeToString(scAddr) +
+				"@" + hex.EncodeToString(nativeToken) +
+				"@" +
+				"@" + hex.EncodeToString(big.NewInt(50).Bytes())),
+			GasLimit:       2000,
+			OriginalTxHash: mainChainTxHash,
+			PrevTxHash:     opHash,
+		}
+		require.Equal(t, expectedSCR, res.scrs[1].SCR)
+
+		expectedHash, err := core.CalculateHash(args.Marshaller, args.Hasher, expectedSCR)
+		require.Nil(t, err)
+		require.Equal(t, expectedHash, res.scrs[1].Hash)
+	})
+	t.Run("failed operation with only native tokens should not create any refund", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsWithOperation()
+		args.NativeTokensPrefix = "sov"
+		args.DataCodec = &sovTests.DataCodecMock{
+			DeserializeOperationCalled: func(_ []byte) (*sovereign.Operation, error) {
+				return &sovereign.Operation{
+					Tokens: []sovereign.EsdtToken{
+						{
+							Identifier: []byte("sov-NATIVE-abcdef"),
+							Data: sovereign.EsdtTokenData{
+								TokenType: core.Fungible,
+								Amount:    big.NewInt(50),
+							},
+						},
+					},
+					Data: operation.Data,
+				}, nil
+			},
+		}
+		handler, _ := NewIncomingHeaderProcessor(args)
+
+		res, err := handler.eventsProc.processIncomingEvents(createExecutedOpHeader(nil))
+		require.Nil(t, err)
+		require.Empty(t, res.scrs)
+		require.Len(t, res.confirmedBridgeOps, 1)
+	})
+}
@@ -0,0 +1,307 @@
+package incomingHeader
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const maxReleasedHeadersPerChain = 100
+
+// pendingIncomingHeader holds an incoming header which was already processed, but whose extended header and scrs were
+// not yet added in pools, since the header does not have enough confirmations on top of it
+type pendingIncomingHeader struct {
+	hash               []byte
+	nonce              uint64
+	isFinal            bool
+	extendedHeader     *block.ShardHeaderExtended
+	scrs               []*SCRInfo
+	confirmedBridgeOps []*ConfirmedBridgeOp
+}
+
+// releasedIncomingHeader holds the pools data of an incoming header which became executable, so that it can be evicted
+// from pools if the header is later reverted
+type releasedIncomingHeader struct {
+	hash               []byte
+	nonce              uint64
+	extendedHeaderHash []byte
+	scrHashes          [][]byte
+}
+
+// storedIncomingHeadersQueue is the persisted form of the incoming headers queue of one incoming chain
+type storedIncomingHeadersQueue struct {
+	Pending  []*storedPendingIncomingHeader
+	Released []*storedReleasedIncomingHeader
+}
+
+// storedPendingIncomingHeader is the persisted form of a pending incoming header. The extended header is kept as
+// marshalled by the incoming header processor, so that its hash does not change once it is loaded.
+type storedPendingIncomingHeader struct {
+	Hash               []byte
+	Nonce              uint64
+	IsFinal            bool
+	ExtendedHeader     []byte
+	SCRs               []*SCRInfo
+	ConfirmedBridgeOps []*ConfirmedBridgeOp
+}
+
+// storedReleasedIncomingHeader is the persisted form of a released incoming header
+type storedReleasedIncomingHeader struct {
+	Hash               []byte
+	Nonce              uint64
+	ExtendedHeaderHash []byte
+	SCRHashes          [][]byte
+}
+
+// incomingHeadersQueue holds, for each incoming chain, the received headers which are waiting for confirmations and the
+// latest headers which became executable. The queue of each chain is saved in the provided storer, so that it is not
+// lost on a restart. It is not concurrent safe, the caller should protect it.
+type incomingHeadersQueue struct {
+	pendingHeaders  map[uint32][]*pendingIncomingHeader
+	releasedHeaders map[uint32][]*releasedIncomingHeader
+
+	storer            storage.Storer
+	storageMarshaller marshal.Marshalizer
+	headerMarshaller  marshal.Marshalizer
+}
+
+func newIncomingHeadersQueue(storer storage.Storer, headerMarshaller marshal.Marshalizer) *incomingHeadersQueue {
+	return &incomingHeadersQueue{
+		pendingHeaders:    make(map[uint32][]*pendingIncomingHeader),
+		releasedHeaders:   make(map[uint32][]*releasedIncomingHeader),
+		storer:            storer,
+		storageMarshaller: &marshal.JsonMarshalizer{},
+		headerMarshaller:  headerMarshaller,
+	}
+}
+
+// add will add the header in the pending queue of its chain and returns, in order, the pending headers which became
+// executable. Pending headers with a nonce higher or equal to the received header nonce are dropped, since the received
+// header replaces them on a fork. A header marked as final also finalizes all its pending ancestors. The returned number
+// of dropped headers does not count a pending header which is received again.
+func (queue *incomingHeadersQueue) add(shardID uint32, header *pendingIncomingHeader, confirmationDepth uint64) (executable []*pendingIncomingHeader, numDropped int) {
+	pending := queue.pendingHeaders[shardID]
+	for idx, pendingHeader := range pending {
+		if pendingHeader.nonce >= header.nonce {
+			for _, droppedHeader := range pending[idx:] {
+				if !bytes.Equal(droppedHeader.hash, header.hash) {
+					numDropped++
+				}
+			}
+			pending = pending[:idx]
+			break
+		}
+	}
+
+	pending = append(pending, header)
+	if header.isFinal {
+		markFinal(pending)
+	}
+	queue.pendingHeaders[shardID] = pending
+
+	return queue.popExecutable(shardID, header.nonce, confirmationDepth), numDropped
+}
+
+// finalize will mark the pending header with the provided hash, together with all its pending ancestors, as final. It
+// returns the incoming chain of the header and, in order, the pending headers which became executable.
+func (queue *incomingHeadersQueue) finalize(hash []byte, confirmationDepth uint64) (shardID uint32, executable []*pendingIncomingHeader, found bool) {
+	for shardID, pending := range queue.pendingHeaders {
+		for idx, pendingHeader := range pending {
+			if !bytes.Equal(pendingHeader.hash, hash) {
+				continue
+			}
+
+			markFinal(pending[:idx+1])
+			lastNonce := pending[len(pending)-1].nonce
+			return shardID, queue.popExecutable(shardID, lastNonce, confirmationDepth), true
+		}
+	}
+
+	return 0, nil, false
+}
+
+func markFinal(headers []*pendingIncomingHeader) {
+	for _, header := range headers {
+		header.isFinal = true
+	}
+}
+
+// popExecutable removes and returns, in order, the pending headers which are either final or have enough confirmations
+// on top of them, given the nonce of the latest received header
+func (queue *incomingHeadersQueue) popExecutable(shardID uint32, lastNonce uint64, confirmationDepth uint64) []*pendingIncomingHeader {
+	pending := queue.pendingHeaders[shardID]
+
+	numExecutable := 0
+	for _, pendingHeader := range pending {
+		isConfirmed := lastNonce >= pendingHeader.nonce+confirmationDepth
+		if !pendingHeader.isFinal && !isConfirmed {
+			break
+		}
+		numExecutable++
+	}
+
+	queue.pendingHeaders[shardID] = append(make([]*pendingIncomingHeader, 0, len(pending)-numExecutable), pending[numExecutable:]...)
+	return pending[:numExecutable]
+}
+
+// addReleased will keep track of a header which became executable. Only the latest released headers of each chain are kept.
+func (queue *incomingHeadersQueue) addReleased(shardID uint32, header *releasedIncomingHeader) {
+	released := append(queue.releasedHeaders[shardID], header)
+	if len(released) > maxReleasedHeadersPerChain {
+		released = released[len(released)-maxReleasedHeadersPerChain:]
+	}
+
+	queue.releasedHeaders[shardID] = released
+}
+
+// removePending will remove the pending header with the provided hash, together with all pending headers on top of it.
+// It returns the number of removed headers.
+func (queue *incomingHeadersQueue) removePending(hash []byte) int {
+	for shardID, pending := range queue.pendingHeaders {
+		for idx, pendingHeader := range pending {
+			if bytes.Equal(pendingHeader.hash, hash) {
+				queue.pendingHeaders[shardID] = pending[:idx]
+				return len(pending) - idx
+			}
+		}
+	}
+
+	return 0
+}
+
+// getReleased returns the released header with the provided hash, if it is still tracked
+func (queue *incomingHeadersQueue) getReleased(hash []byte) (*releasedIncomingHeader, bool) {
+	for _, released := range queue.releasedHeaders {
+		for _, releasedHeader := range released {
+			if bytes.Equal(releasedHeader.hash, hash) {
+				return releasedHeader, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// removeReleased will remove the released header with the provided hash, if it is still tracked
+func (queue *incomingHeadersQueue) removeReleased(hash []byte) {
+	for shardID, released := range queue.releasedHeaders {
+		for idx, releasedHeader := range released {
+			if bytes.Equal(releasedHeader.hash, hash) {
+				queue.releasedHeaders[shardID] = append(released[:idx:idx], released[idx+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// numPending returns the number of pending headers of the incoming chain with the provided shard ID
+func (queue *incomingHeadersQueue) numPending(shardID uint32) int {
+	return len(queue.pendingHeaders[shardID])
+}
+
+func createQueueStorageKey(shardID uint32) []byte {
+	return []byte(fmt.Sprintf("incomingHeadersQueue_%d", shardID))
+}
+
+// save will save the queue of the incoming chain with the provided shard ID
+func (queue *incomingHeadersQueue) save(shardID uint32) {
+	storedBytes, err := queue.marshal(shardID)
+	if err != nil {
+		log.Error("incomingHeadersQueue.save: could not marshal incoming headers queue", "shard", shardID, "error", err)
+		return
+	}
+
+	err = queue.storer.Put(createQueueStorageKey(shardID), storedBytes)
+	if err != nil {
+		log.Error("incomingHeadersQueue.save: could not save incoming headers queue", "shard", shardID, "error", err)
+	}
+}
+
+// marshal returns the persisted form of the queue of the incoming chain with the provided shard ID
+func (queue *incomingHeadersQueue) marshal(shardID uint32) ([]byte, error) {
+	stored := &storedIncomingHeadersQueue{
+		Pending:  make([]*storedPendingIncomingHeader, 0, len(queue.pendingHeaders[shardID])),
+		Released: make([]*storedReleasedIncomingHeader, 0, len(queue.releasedHeaders[shardID])),
+	}
+	for _, pendingHeader := range queue.pendingHeaders[shardID] {
+		extendedHeaderBytes, err := queue.headerMarshaller.Marshal(pendingHeader.extendedHeader)
+		if err != nil {
+			return nil, fmt.Errorf("%w while marshalling the pending extended header with nonce %d", err, pendingHeader.nonce)
+		}
+
+		stored.Pending = append(stored.Pending, &storedPendingIncomingHeader{
+			Hash:               pendingHeader.hash,
+			Nonce:              pendingHeader.nonce,
+			IsFinal:            pendingHeader.isFinal,
+			ExtendedHeader:     extendedHeaderBytes,
+			SCRs:               pendingHeader.scrs,
+			ConfirmedBridgeOps: pendingHeader.confirmedBridgeOps,
+		})
+	}
+	for _, releasedHeader := range queue.releasedHeaders[shardID] {
+		stored.Released = append(stored.Released, &storedReleasedIncomingHeader{
+			Hash:               releasedHeader.hash,
+			Nonce:              releasedHeader.nonce,
+			ExtendedHeaderHash: releasedHeader.extendedHeaderHash,
+			SCRHashes:          releasedHeader.scrHashes,
+		})
+	}
+
+	return queue.storageMarshaller.Marshal(stored)
+}
+
+// load will load the saved queue of the incoming chain with the provided shard ID, if any
+func (queue *incomingHeadersQueue) load(shardID uint32) error {
+	storedBytes, err := queue.storer.Get(createQueueStorageKey(shardID))
+	if err != nil || len(storedBytes) == 0 {
+		return nil
+	}
+
+	return queue.unmarshal(shardID, storedBytes)
+}
+
+// unmarshal replaces the queue of the incoming chain with the provided shard ID with the provided persisted form
+func (queue *incomingHeadersQueue) unmarshal(shardID uint32, storedBytes []byte) error {
+	stored := &storedIncomingHeadersQueue{}
+	err := queue.storageMarshaller.Unmarshal(stored, storedBytes)
+	if err != nil {
+		return fmt.Errorf("%w while loading the incoming headers queue of shard %d", err, shardID)
+	}
+
+	pending := make([]*pendingIncomingHeader, 0, len(stored.Pending))
+	for _, storedHeader := range stored.Pending {
+		extendedHeader := &block.ShardHeaderExtended{}
+		err = queue.headerMarshaller.Unmarshal(extendedHeader, storedHeader.ExtendedHeader)
+		if err != nil {
+			return fmt.Errorf("%w while loading the pending extended header with nonce %d of shard %d", err, storedHeader.Nonce, shardID)
+		}
+
+		pending = append(pending, &pendingIncomingHeader{
+			hash:               storedHeader.Hash,
+			nonce:              storedHeader.Nonce,
+			isFinal:            storedHeader.IsFinal,
+			extendedHeader:     extendedHeader,
+			scrs:               storedHeader.SCRs,
+			confirmedBridgeOps: storedHeader.ConfirmedBridgeOps,
+		})
+	}
+
+	released := make([]*releasedIncomingHeader, 0, len(stored.Released))
+	for _, storedHeader := range stored.Released {
+		released = append(released, &releasedIncomingHeader{
+			hash:               storedHeader.Hash,
+			nonce:              storedHeader.Nonce,
+			extendedHeaderHash: storedHeader.ExtendedHeaderHash,
+			scrHashes:          storedHeader.SCRHashes,
+		})
+	}
+
+	queue.pendingHeaders[shardID] = pending
+	queue.releasedHeaders[shardID] = released
+
+	return nil
+}
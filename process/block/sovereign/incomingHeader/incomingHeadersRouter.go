@@ -0,0 +1,174 @@
+package incomingHeader
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+// ArgsIncomingHeadersRouter holds the arguments needed to create an incoming headers router
+type ArgsIncomingHeadersRouter struct {
+	// IncomingHeaderHandlers holds the incoming header processor of each incoming chain, by the chain's shard ID
+	IncomingHeaderHandlers map[uint32]IncomingHeaderHandler
+	IncomingChainsHandler  IncomingChainsHandler
+}
+
+type incomingHeadersRouter struct {
+	incomingHeaderHandlers map[uint32]IncomingHeaderHandler
+	incomingChainsHandler  IncomingChainsHandler
+	sortedShardIDs         []uint32
+}
+
+// NewIncomingHeadersRouter creates an incoming header subscriber which forwards each incoming header to the incoming
+// header processor of the chain it was received from. Headers from unknown chains are rejected.
+func NewIncomingHeadersRouter(args ArgsIncomingHeadersRouter) (*incomingHeadersRouter, error) {
+	if check.IfNil(args.IncomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
+	for _, shardID := range args.IncomingChainsHandler.GetShardIDs() {
+		if check.IfNil(args.IncomingHeaderHandlers[shardID]) {
+			return nil, fmt.Errorf("%w, shard: %d", errIncomingHeaderHandlerNotFound, shardID)
+		}
+	}
+
+	sortedShardIDs := make([]uint32, 0, len(args.IncomingHeaderHandlers))
+	for shardID := range args.IncomingHeaderHandlers {
+		sortedShardIDs = append(sortedShardIDs, shardID)
+	}
+	sort.Slice(sortedShardIDs, func(i, j int) bool {
+		return sortedShardIDs[i] > sortedShardIDs[j]
+	})
+
+	return &incomingHeadersRouter{
+		incomingHeaderHandlers: args.IncomingHeaderHandlers,
+		incomingChainsHandler:  args.IncomingChainsHandler,
+		sortedShardIDs:         sortedShardIDs,
+	}, nil
+}
+
+// AddHeader forwards the incoming header to the incoming header processor of its chain
+func (router *incomingHeadersRouter) AddHeader(headerHash []byte, header sovereign.IncomingHeaderHandler) error {
+	handler, err := router.getIncomingHeaderHandlerForHeader(header)
+	if err != nil {
+		return err
+	}
+
+	return handler.AddHeader(headerHash, header)
+}
+
+// RevertHeader reverts the incoming header from all the incoming header processors. Since only the hash is provided,
+// each processor ignores the headers it does not know about.
+func (router *incomingHeadersRouter) RevertHeader(headerHash []byte) error {
+	for _, shardID := range router.sortedShardIDs {
+		err := router.incomingHeaderHandlers[shardID].RevertHeader(headerHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FinalizeHeader finalizes the incoming header in all the incoming header processors. Since only the hash is provided,
+// each processor ignores the headers it does not know about.
+func (router *incomingHeadersRouter) FinalizeHeader(headerHash []byte) error {
+	for _, shardID := range router.sortedShardIDs {
+		err := router.incomingHeaderHandlers[shardID].FinalizeHeader(headerHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateExtendedHeader creates the extended shard header using the incoming header processor of the header's chain
+func (router *incomingHeadersRouter) CreateExtendedHeader(header sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error) {
+	handler, err := router.getIncomingHeaderHandlerForHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.CreateExtendedHeader(header)
+}
+
+// RegisterEventHandler registers an extra incoming event processor to the incoming header processors of all chains
+func (router *incomingHeadersRouter) RegisterEventHandler(event string, proc IncomingEventHandler) error {
+	for _, shardID := range router.sortedShardIDs {
+		err := router.incomingHeaderHandlers[shardID].RegisterEventHandler(event, proc)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetIncomingHeadersQueues returns the persisted form of the incoming headers queue of each incoming chain, by the
+// chain's shard ID
+func (router *incomingHeadersRouter) GetIncomingHeadersQueues() (map[uint32][]byte, error) {
+	queues := make(map[uint32][]byte, len(router.sortedShardIDs))
+	for _, shardID := range router.sortedShardIDs {
+		queue, err := router.incomingHeaderHandlers[shardID].GetIncomingHeadersQueue()
+		if err != nil {
+			return nil, fmt.Errorf("%w, shard: %d", err, shardID)
+		}
+
+		queues[shardID] = queue
+	}
+
+	return queues, nil
+}
+
+// SetIncomingHeadersQueues replaces the incoming headers queue of each incoming chain with the provided persisted form.
+// The queues of chains which are no longer tracked are ignored.
+func (router *incomingHeadersRouter) SetIncomingHeadersQueues(queues map[uint32][]byte) error {
+	for shardID, queue := range queues {
+		handler, found := router.incomingHeaderHandlers[shardID]
+		if !found {
+			log.Warn("incomingHeadersRouter.SetIncomingHeadersQueues: ignored queue of untracked incoming chain", "shard", shardID)
+			continue
+		}
+
+		err := handler.SetIncomingHeadersQueue(queue)
+		if err != nil {
+			return fmt.Errorf("%w, shard: %d", err, shardID)
+		}
+	}
+
+	return nil
+}
+
+// GetIncomingHeaderHandler returns the incoming header processor of the incoming chain tracked under the provided shard
+// ID, to be attached to the chain's own notifier
+func (router *incomingHeadersRouter) GetIncomingHeaderHandler(shardID uint32) (IncomingHeaderHandler, error) {
+	handler, found := router.incomingHeaderHandlers[shardID]
+	if !found {
+		return nil, fmt.Errorf("%w, shard: %d", errIncomingHeaderHandlerNotFound, shardID)
+	}
+
+	return handler, nil
+}
+
+func (router *incomingHeadersRouter) getIncomingHeaderHandlerForHeader(header sovereign.IncomingHeaderHandler) (IncomingHeaderHandler, error) {
+	if check.IfNil(header) || check.IfNil(header.GetHeaderHandler()) {
+		return nil, data.ErrNilHeader
+	}
+
+	shardID, err := router.incomingChainsHandler.GetShardIDForHeader(header.GetHeaderHandler())
+	if err != nil {
+		return nil, err
+	}
+
+	return router.GetIncomingHeaderHandler(shardID)
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (router *incomingHeadersRouter) IsInterfaceNil() bool {
+	return router == nil
+}
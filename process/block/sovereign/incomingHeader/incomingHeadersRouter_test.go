@@ -0,0 +1,171 @@
+package incomingHeader
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/stretchr/testify/require"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+const incomingChainShardID = core.MainChainShardId - 1
+
+func createIncomingChainsHandlerForRouter() *sovTests.IncomingChainsHandlerMock {
+	return &sovTests.IncomingChainsHandlerMock{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.MainChainShardId, incomingChainShardID}
+		},
+		GetShardIDForHeaderCalled: func(header data.HeaderHandler) (uint32, error) {
+			switch string(header.GetChainID()) {
+			case "1":
+				return core.MainChainShardId, nil
+			case "sov2":
+				return incomingChainShardID, nil
+			default:
+				return 0, errorsMx.ErrUnknownIncomingChainID
+			}
+		},
+		IsIncomingChainShardCalled: func(shardID uint32) bool {
+			return shardID == core.MainChainShardId || shardID == incomingChainShardID
+		},
+	}
+}
+
+func createRouterArgs(addedInShard *[]uint32) ArgsIncomingHeadersRouter {
+	incomingChainsHandler := createIncomingChainsHandlerForRouter()
+	handlers := make(map[uint32]IncomingHeaderHandler)
+	for _, shardID := range incomingChainsHandler.GetShardIDs() {
+		args := createArgs()
+		args.ShardID = shardID
+		args.IncomingChainsHandler = incomingChainsHandler
+		args.HeadersPool = &mock.HeadersCacherStub{
+			AddHeaderInShardCalled: func(_ []byte, _ data.HeaderHandler, shardID uint32) {
+				*addedInShard = append(*addedInShard, shardID)
+			},
+		}
+		handlers[shardID], _ = NewIncomingHeaderProcessor(args)
+	}
+
+	return ArgsIncomingHeadersRouter{
+		IncomingHeaderHandlers: handlers,
+		IncomingChainsHandler:  incomingChainsHandler,
+	}
+}
+
+func createIncomingHeaderForChain(chainID string) *sovereign.IncomingHeader {
+	return &sovereign.IncomingHeader{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				ChainID: []byte(chainID),
+				Round:   1,
+			},
+		},
+	}
+}
+
+func TestNewIncomingHeadersRouter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil incoming chains handler, should return error", func(t *testing.T) {
+		args := createRouterArgs(&[]uint32{})
+		args.IncomingChainsHandler = nil
+
+		router, err := NewIncomingHeadersRouter(args)
+		require.Equal(t, errorsMx.ErrNilIncomingChainsHandler, err)
+		require.Nil(t, router)
+	})
+
+	t.Run("missing incoming header handler, should return error", func(t *testing.T) {
+		args := createRouterArgs(&[]uint32{})
+		delete(args.IncomingHeaderHandlers, incomingChainShardID)
+
+		router, err := NewIncomingHeadersRouter(args)
+		require.ErrorIs(t, err, errIncomingHeaderHandlerNotFound)
+		require.Nil(t, router)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		router, err := NewIncomingHeadersRouter(createRouterArgs(&[]uint32{}))
+		require.Nil(t, err)
+		require.False(t, router.IsInterfaceNil())
+		require.Equal(t, []uint32{core.MainChainShardId, incomingChainShardID}, router.sortedShardIDs)
+	})
+}
+
+func TestIncomingHeadersRouter_AddHeader(t *testing.T) {
+	t.Parallel()
+
+	addedInShard := make([]uint32, 0)
+	router, _ := NewIncomingHeadersRouter(createRouterArgs(&addedInShard))
+
+	err := router.AddHeader([]byte("hash"), nil)
+	require.Equal(t, data.ErrNilHeader, err)
+
+	err = router.AddHeader([]byte("hash"), createIncomingHeaderForChain("sov3"))
+	require.ErrorIs(t, err, errorsMx.ErrUnknownIncomingChainID)
+	require.Empty(t, addedInShard)
+
+	err = router.AddHeader([]byte("hash"), createIncomingHeaderForChain("sov2"))
+	require.Nil(t, err)
+	require.Equal(t, []uint32{incomingChainShardID}, addedInShard)
+
+	err = router.AddHeader([]byte("hash"), createIncomingHeaderForChain("1"))
+	require.Nil(t, err)
+	require.Equal(t, []uint32{incomingChainShardID, core.MainChainShardId}, addedInShard)
+}
+
+func TestIncomingHeadersRouter_CreateExtendedHeader(t *testing.T) {
+	t.Parallel()
+
+	router, _ := NewIncomingHeadersRouter(createRouterArgs(&[]uint32{}))
+
+	extendedHeader, err := router.CreateExtendedHeader(createIncomingHeaderForChain("sov3"))
+	require.ErrorIs(t, err, errorsMx.ErrUnknownIncomingChainID)
+	require.Nil(t, extendedHeader)
+
+	extendedHeader, err = router.CreateExtendedHeader(createIncomingHeaderForChain("sov2"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("sov2"), extendedHeader.GetHeaderHandler().GetChainID())
+
+	extendedHeader, err = router.CreateExtendedHeader(createIncomingHeaderForChain("1"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("1"), extendedHeader.GetHeaderHandler().GetChainID())
+}
+
+func TestIncomingHeadersRouter_GetIncomingHeaderHandler(t *testing.T) {
+	t.Parallel()
+
+	args := createRouterArgs(&[]uint32{})
+	router, _ := NewIncomingHeadersRouter(args)
+
+	handler, err := router.GetIncomingHeaderHandler(incomingChainShardID - 1)
+	require.ErrorIs(t, err, errIncomingHeaderHandlerNotFound)
+	require.Nil(t, handler)
+
+	handler, err = router.GetIncomingHeaderHandler(incomingChainShardID)
+	require.Nil(t, err)
+	require.True(t, handler == args.IncomingHeaderHandlers[incomingChainShardID])
+}
+
+func TestIncomingHeadersRouter_RegisterEventHandler(t *testing.T) {
+	t.Parallel()
+
+	args := createRouterArgs(&[]uint32{})
+	router, _ := NewIncomingHeadersRouter(args)
+
+	err := router.RegisterEventHandler("event", nil)
+	require.NotNil(t, err)
+
+	eventProc := &depositEventProc{}
+	err = router.RegisterEventHandler("event", eventProc)
+	require.Nil(t, err)
+	for _, handler := range args.IncomingHeaderHandlers {
+		require.True(t, handler.(*incomingHeaderProcessor).eventsProc.handlers["event"] == eventProc)
+	}
+}
@@ -5,18 +5,21 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 
 	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
+	"github.com/multiversx/mx-chain-go/process"
 	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
 )
 
-// HeadersPool should be able to add new headers in pool
+// HeadersPool should be able to add and remove headers from pool
 type HeadersPool interface {
 	AddHeaderInShard(headerHash []byte, header data.HeaderHandler, shardID uint32)
+	RemoveHeaderByHash(headerHash []byte)
 	IsInterfaceNil() bool
 }
 
-// TransactionPool should be able to add a new transaction in the pool
+// TransactionPool should be able to add and remove transactions from pool
 type TransactionPool interface {
 	AddData(key []byte, data interface{}, sizeInBytes int, cacheId string)
+	RemoveData(key []byte, cacheId string)
 	IsInterfaceNil() bool
 }
 
@@ -26,6 +29,28 @@ type TopicsChecker interface {
 	IsInterfaceNil() bool
 }
 
+// IncomingChainsHandler should be able to map incoming headers to the incoming chain they were received from
+type IncomingChainsHandler interface {
+	GetShardIDs() []uint32
+	GetShardIDForHeader(header data.HeaderHandler) (uint32, error)
+	GetNotarizationStartRound(shardID uint32) uint64
+	GetConfirmationDepth(shardID uint32) uint64
+	IsIncomingChainShard(shardID uint32) bool
+	IsInterfaceNil() bool
+}
+
+// EventsProofVerifier should be able to verify that incoming events were generated by transactions executed in their
+// main chain header
+type EventsProofVerifier interface {
+	VerifyEventsProofs(header sovereign.IncomingHeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// eventWithAdditionalDataHandler defines an incoming event which also carries additional data, such as its metadata
+type eventWithAdditionalDataHandler interface {
+	GetAdditionalData() [][]byte
+}
+
 // SovereignDataCodec is the interface for serializing/deserializing data
 type SovereignDataCodec interface {
 	SerializeEventData(eventData sovereign.EventData) ([]byte, error)
@@ -33,6 +58,7 @@ type SovereignDataCodec interface {
 	SerializeTokenData(tokenData sovereign.EsdtTokenData) ([]byte, error)
 	DeserializeTokenData(data []byte) (*sovereign.EsdtTokenData, error)
 	SerializeOperation(operation sovereign.Operation) ([]byte, error)
+	DeserializeOperation(data []byte) (*sovereign.Operation, error)
 	IsInterfaceNil() bool
 }
 
@@ -41,11 +67,32 @@ type RunTypeComponentsHolder interface {
 	OutGoingOperationsPoolHandler() sovereignBlock.OutGoingOperationsPool
 	DataCodecHandler() sovBlock.DataCodecHandler
 	TopicsCheckerHandler() sovBlock.TopicsCheckerHandler
+	IncomingChainsHandler() process.IncomingChainsHandler
+	IsInterfaceNil() bool
+}
+
+// IncomingHeaderHandler defines the incoming header processor of one incoming chain
+type IncomingHeaderHandler interface {
+	AddHeader(headerHash []byte, header sovereign.IncomingHeaderHandler) error
+	RevertHeader(headerHash []byte) error
+	FinalizeHeader(headerHash []byte) error
+	CreateExtendedHeader(header sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error)
+	RegisterEventHandler(event string, proc IncomingEventHandler) error
+	GetIncomingHeadersQueue() ([]byte, error)
+	SetIncomingHeadersQueue(queue []byte) error
 	IsInterfaceNil() bool
 }
 
+// IncomingHeadersRouter defines an incoming header subscriber which forwards each incoming header to the incoming
+// header processor of the chain it was received from
+type IncomingHeadersRouter interface {
+	process.IncomingHeaderSubscriber
+	RegisterEventHandler(event string, proc IncomingEventHandler) error
+	GetIncomingHeaderHandler(shardID uint32) (IncomingHeaderHandler, error)
+}
+
 // IncomingEventHandler defines the behaviour of an incoming cross chain event processor handler
 type IncomingEventHandler interface {
-	ProcessEvent(event data.EventHandler) (*EventResult, error)
+	ProcessEvent(event data.EventHandler, txInfo *EventTxInfo) (*EventResult, error)
 	IsInterfaceNil() bool
 }
@@ -2,20 +2,58 @@ package incomingHeader
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
 )
 
-type topicsChecker struct{}
+var metachainIdentifier = []byte{255}
+
+const (
+	receiverIndex       = 1
+	maxTokenNonceLength = 8
+	separator           = "-"
+)
+
+// ArgsTopicsChecker holds the arguments needed to create a topics checker
+type ArgsTopicsChecker struct {
+	PubKeyConverter core.PubkeyConverter
+	DataCodec       SovereignDataCodec
+}
+
+type topicsChecker struct {
+	addressLen int
+	dataCodec  SovereignDataCodec
+}
 
 // NewTopicsChecker creates a topics checker which is able to validate topics
-func NewTopicsChecker() *topicsChecker {
-	return &topicsChecker{}
+func NewTopicsChecker(args ArgsTopicsChecker) (*topicsChecker, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, errors.ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.DataCodec) {
+		return nil, errors.ErrNilDataCodec
+	}
+
+	return &topicsChecker{
+		addressLen: args.PubKeyConverter.Len(),
+		dataCodec:  args.DataCodec,
+	}, nil
 }
 
-// CheckValidity will receive the topics and validate them
+// CheckValidity will receive the topics and validate them. Expected topics format is:
+// [identifier, receiver address, tokenID1, nonce1, tokenData1, ..., tokenIDN, nonceN, tokenDataN]
+// Message events, which only carry a contract call, have no token topics: [identifier, receiver address].
+// The incoming scrs are sent from the ESDT system SC address, hence the system account and the system smart contracts
+// are rejected as receivers. In case of an invalid topic, an ErrInvalidTopic holding the topic index is returned.
 func (tc *topicsChecker) CheckValidity(topics [][]byte) error {
-	// TODO: Check each param validity (e.g. check that topic[0] == valid address)
-	if len(topics) < minTopicsInTransferEvent || len(topics[2:])%numTransferTopics != 0 {
-		log.Error("incomingHeaderHandler.createIncomingSCRs",
+	if len(topics) < minTopicsInDepositEvent || len(topics[tokensIndex:])%numTransferTopics != 0 {
+		log.Error("topicsChecker.CheckValidity",
 			"error", errInvalidNumTopicsIncomingEvent,
 			"num topics", len(topics),
 			"topics", topics)
@@ -23,9 +61,75 @@ func (tc *topicsChecker) CheckValidity(topics [][]byte) error {
 		return fmt.Errorf("%w for %s; num topics = %d", errInvalidNumTopicsIncomingEvent, "eventIDDepositIncomingTransfer", len(topics))
 	}
 
+	if len(topics[receiverIndex]) != tc.addressLen {
+		return newErrInvalidTopic(receiverIndex, fmt.Errorf("%w, expected len = %d, received len = %d",
+			errInvalidReceiverAddress, tc.addressLen, len(topics[receiverIndex])))
+	}
+	if isProtectedAddress(topics[receiverIndex]) {
+		return newErrInvalidTopic(receiverIndex, fmt.Errorf("%w, protected receiver = %x",
+			errInvalidReceiverAddress, topics[receiverIndex]))
+	}
+
+	for idx := tokensIndex; idx < len(topics); idx += numTransferTopics {
+		err := tc.checkTokenTopics(topics, idx)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (tc *topicsChecker) checkTokenTopics(topics [][]byte, tokenIDIndex int) error {
+	tokenID := topics[tokenIDIndex]
+	if !isValidTokenIdentifier(string(tokenID)) {
+		return newErrInvalidTopic(tokenIDIndex, fmt.Errorf("%w: %s", errInvalidTokenIdentifier, tokenID))
+	}
+
+	nonceIndex := tokenIDIndex + 1
+	if len(topics[nonceIndex]) > maxTokenNonceLength {
+		return newErrInvalidTopic(nonceIndex, fmt.Errorf("%w, max len = %d, received len = %d",
+			errInvalidTokenNonce, maxTokenNonceLength, len(topics[nonceIndex])))
+	}
+
+	tokenDataIndex := tokenIDIndex + 2
+	tokenData, err := tc.dataCodec.DeserializeTokenData(topics[tokenDataIndex])
+	if err != nil {
+		return newErrInvalidTopic(tokenDataIndex, fmt.Errorf("%w: %v", errInvalidTokenData, err))
+	}
+	if tokenData.Amount == nil || tokenData.Amount.Sign() < 0 {
+		return newErrInvalidTopic(tokenDataIndex, fmt.Errorf("%w: invalid amount", errInvalidTokenData))
+	}
+
+	nonce, _ := common.ByteSliceToUint64(topics[nonceIndex])
+	if tokenData.TokenType == core.Fungible && nonce != 0 {
+		return newErrInvalidTopic(nonceIndex, fmt.Errorf("%w, fungible token %s with nonce = %d",
+			errInvalidTokenNonce, tokenID, nonce))
+	}
+
+	return nil
+}
+
+// isProtectedAddress returns true for the system account and for the system smart contracts, which can not be called
+// by the incoming scrs
+func isProtectedAddress(address []byte) bool {
+	return core.IsSystemAccountAddress(address) || core.IsSmartContractOnMetachain(metachainIdentifier, address)
+}
+
+// isValidTokenIdentifier checks the ESDT identifier format, with or without a prefix: [prefix-]TICKER-randSeq
+func isValidTokenIdentifier(tokenID string) bool {
+	tokenSplit := strings.Split(tokenID, separator)
+	switch len(tokenSplit) {
+	case 2:
+		return esdt.IsTickerValid(tokenSplit[0]) && esdt.IsRandomSeqValid(tokenSplit[1])
+	case 3:
+		_, isValid := esdt.IsValidPrefixedToken(tokenID)
+		return isValid
+	default:
+		return false
+	}
+}
+
 // IsInterfaceNil checks if the underlying pointer is nil
 func (tc *topicsChecker) IsInterfaceNil() bool {
 	return tc == nil
@@ -1,27 +1,162 @@
 package incomingHeader
 
 import (
+	"bytes"
+	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/stretchr/testify/require"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+	"github.com/multiversx/mx-chain-go/vm"
 )
 
+const addressLen = 32
+
+func createTopicsCheckerArgs() ArgsTopicsChecker {
+	return ArgsTopicsChecker{
+		PubKeyConverter: testscommon.NewPubkeyConverterMock(addressLen),
+		DataCodec: &sovTests.DataCodecMock{
+			DeserializeTokenDataCalled: func(data []byte) (*sovereign.EsdtTokenData, error) {
+				switch string(data) {
+				case "fungible":
+					return &sovereign.EsdtTokenData{TokenType: core.Fungible, Amount: big.NewInt(100)}, nil
+				case "nft":
+					return &sovereign.EsdtTokenData{TokenType: core.NonFungible, Amount: big.NewInt(1)}, nil
+				case "negative":
+					return &sovereign.EsdtTokenData{TokenType: core.Fungible, Amount: big.NewInt(-1)}, nil
+				}
+				return nil, errors.New("cannot decode token data")
+			},
+		},
+	}
+}
+
+func createValidTopics() [][]byte {
+	return [][]byte{
+		[]byte(topicIDDepositIncomingTransfer),
+		bytes.Repeat([]byte{1}, addressLen),
+		[]byte("TKN-123456"),
+		nil,
+		[]byte("fungible"),
+		[]byte("sov-NFT-abcdef"),
+		{0x01},
+		[]byte("nft"),
+	}
+}
+
+func requireErrInvalidTopic(t *testing.T, err error, expectedErr error, expectedIndex int) {
+	require.True(t, errors.Is(err, expectedErr))
+
+	errInvalidTopic := &ErrInvalidTopic{}
+	require.True(t, errors.As(err, &errInvalidTopic))
+	require.Equal(t, expectedIndex, errInvalidTopic.TopicIndex)
+}
+
 func TestNewTopicsChecker(t *testing.T) {
 	t.Parallel()
 
-	tc := NewTopicsChecker()
-	require.False(t, tc.IsInterfaceNil())
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		args := createTopicsCheckerArgs()
+		args.PubKeyConverter = nil
+
+		tc, err := NewTopicsChecker(args)
+		require.Equal(t, errorsMx.ErrNilPubKeyConverter, err)
+		require.Nil(t, tc)
+	})
+	t.Run("nil data codec should error", func(t *testing.T) {
+		args := createTopicsCheckerArgs()
+		args.DataCodec = nil
+
+		tc, err := NewTopicsChecker(args)
+		require.Equal(t, errorsMx.ErrNilDataCodec, err)
+		require.Nil(t, tc)
+	})
+	t.Run("should work", func(t *testing.T) {
+		tc, err := NewTopicsChecker(createTopicsCheckerArgs())
+		require.Nil(t, err)
+		require.False(t, tc.IsInterfaceNil())
+	})
 }
+
 func TestTopicsChecker_CheckValidity(t *testing.T) {
 	t.Parallel()
 
-	tc := NewTopicsChecker()
+	tc, _ := NewTopicsChecker(createTopicsCheckerArgs())
+
+	t.Run("invalid num topics should error", func(t *testing.T) {
+		err := tc.CheckValidity([][]byte{[]byte("topic1")})
+		require.ErrorContains(t, err, errInvalidNumTopicsIncomingEvent.Error())
+
+		err = tc.CheckValidity(createValidTopics()[:7])
+		require.ErrorContains(t, err, errInvalidNumTopicsIncomingEvent.Error())
+
+		err = tc.CheckValidity(createValidTopics()[:3])
+		require.ErrorContains(t, err, errInvalidNumTopicsIncomingEvent.Error())
+	})
+	t.Run("message topics without tokens should work", func(t *testing.T) {
+		require.Nil(t, tc.CheckValidity(createValidTopics()[:2]))
+	})
+	t.Run("invalid receiver address should error", func(t *testing.T) {
+		topics := createValidTopics()
+		topics[1] = []byte("rcv")
+
+		err := tc.CheckValidity(topics)
+		requireErrInvalidTopic(t, err, errInvalidReceiverAddress, 1)
+	})
+	t.Run("protected receiver address should error", func(t *testing.T) {
+		for _, receiver := range [][]byte{core.ESDTSCAddress, core.SystemAccountAddress, vm.GovernanceSCAddress, make([]byte, addressLen)} {
+			topics := createValidTopics()
+			topics[1] = receiver
+
+			err := tc.CheckValidity(topics)
+			requireErrInvalidTopic(t, err, errInvalidReceiverAddress, 1)
+		}
+	})
+	t.Run("invalid token identifier should error", func(t *testing.T) {
+		for _, tokenID := range []string{"", "TKN", "tkn-123456", "TKN-12345", "TKN-12345g", "PREFIX-TKN-123456", "sov-TKN-123456-1"} {
+			topics := createValidTopics()
+			topics[5] = []byte(tokenID)
+
+			err := tc.CheckValidity(topics)
+			requireErrInvalidTopic(t, err, errInvalidTokenIdentifier, 5)
+		}
+	})
+	t.Run("invalid token nonce should error", func(t *testing.T) {
+		topics := createValidTopics()
+		topics[6] = make([]byte, maxTokenNonceLength+1)
+
+		err := tc.CheckValidity(topics)
+		requireErrInvalidTopic(t, err, errInvalidTokenNonce, 6)
+	})
+	t.Run("fungible token with nonce should error", func(t *testing.T) {
+		topics := createValidTopics()
+		topics[3] = []byte{0x01}
+
+		err := tc.CheckValidity(topics)
+		requireErrInvalidTopic(t, err, errInvalidTokenNonce, 3)
+	})
+	t.Run("undecodable token data should error", func(t *testing.T) {
+		topics := createValidTopics()
+		topics[7] = []byte("invalid")
 
-	topics1 := [][]byte{[]byte("topic1")}
-	err := tc.CheckValidity(topics1)
-	require.ErrorContains(t, err, errInvalidNumTopicsIncomingEvent.Error())
+		err := tc.CheckValidity(topics)
+		requireErrInvalidTopic(t, err, errInvalidTokenData, 7)
+	})
+	t.Run("negative token amount should error", func(t *testing.T) {
+		topics := createValidTopics()
+		topics[4] = []byte("negative")
 
-	topics5 := [][]byte{[]byte("topic1"), []byte("topic2"), []byte("topic3"), []byte("topic4"), []byte("topic5")}
-	err = tc.CheckValidity(topics5)
-	require.NoError(t, err)
+		err := tc.CheckValidity(topics)
+		requireErrInvalidTopic(t, err, errInvalidTokenData, 4)
+	})
+	t.Run("should work", func(t *testing.T) {
+		err := tc.CheckValidity(createValidTopics())
+		require.Nil(t, err)
+	})
 }
@@ -0,0 +1,85 @@
+package sovereign
+
+import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+)
+
+// sovereignHeaderEnvelopePrefix marks the scheduled root hash of a header v2 which wraps a sovereign chain header.
+// Sovereign chain headers do not have scheduled data, so the field is free to hold the rest of the wrapped header.
+var sovereignHeaderEnvelopePrefix = []byte("sovereignChainHeader@")
+
+// WrapSovereignChainHeader wraps a sovereign chain header received from another sovereign chain in a header v2, since
+// incoming and extended shard headers can only hold a header v2. The header v2 shares the inner header of the sovereign
+// chain header, while the other fields of the sovereign chain header are kept in the scheduled root hash, so that the
+// sovereign chain header can be recreated and its hash recomputed.
+func WrapSovereignChainHeader(marshaller marshal.Marshalizer, header *block.SovereignChainHeader) (*block.HeaderV2, error) {
+	if check.IfNil(marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if header == nil || header.Header == nil {
+		return nil, data.ErrNilHeader
+	}
+
+	extension := *header
+	extension.Header = nil
+	extensionBytes, err := marshaller.Marshal(&extension)
+	if err != nil {
+		return nil, err
+	}
+
+	return &block.HeaderV2{
+		Header:            header.Header,
+		ScheduledRootHash: append(append([]byte{}, sovereignHeaderEnvelopePrefix...), extensionBytes...),
+	}, nil
+}
+
+// IsSovereignChainHeaderEnvelope returns true if the provided header v2 wraps a sovereign chain header
+func IsSovereignChainHeaderEnvelope(header *block.HeaderV2) bool {
+	return header != nil && bytes.HasPrefix(header.ScheduledRootHash, sovereignHeaderEnvelopePrefix)
+}
+
+// UnwrapSovereignChainHeader recreates the sovereign chain header wrapped in the provided header v2. It returns false
+// if the header v2 does not wrap a sovereign chain header.
+func UnwrapSovereignChainHeader(marshaller marshal.Marshalizer, header *block.HeaderV2) (*block.SovereignChainHeader, bool, error) {
+	if !IsSovereignChainHeaderEnvelope(header) {
+		return nil, false, nil
+	}
+	if check.IfNil(marshaller) {
+		return nil, false, core.ErrNilMarshalizer
+	}
+
+	sovereignHeader := &block.SovereignChainHeader{}
+	err := marshaller.Unmarshal(sovereignHeader, header.ScheduledRootHash[len(sovereignHeaderEnvelopePrefix):])
+	if err != nil {
+		return nil, false, err
+	}
+
+	sovereignHeader.Header = header.Header
+	return sovereignHeader, true, nil
+}
+
+// CalculateIncomingHeaderHash returns the hash of a header received from an incoming chain, as computed by that chain.
+// For a header v2 which wraps a sovereign chain header, it is the hash of the wrapped sovereign chain header.
+func CalculateIncomingHeaderHash(marshaller marshal.Marshalizer, hasher hashing.Hasher, header data.HeaderHandler) ([]byte, error) {
+	headerV2, isHeaderV2 := header.(*block.HeaderV2)
+	if !isHeaderV2 {
+		return core.CalculateHash(marshaller, hasher, header)
+	}
+
+	sovereignHeader, isEnvelope, err := UnwrapSovereignChainHeader(marshaller, headerV2)
+	if err != nil {
+		return nil, err
+	}
+	if isEnvelope {
+		return core.CalculateHash(marshaller, hasher, sovereignHeader)
+	}
+
+	return core.CalculateHash(marshaller, hasher, headerV2)
+}
@@ -0,0 +1,140 @@
+package sovereign
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/stretchr/testify/require"
+)
+
+func createSovereignChainHeader() *block.SovereignChainHeader {
+	return &block.SovereignChainHeader{
+		Header: &block.Header{
+			Nonce:    4,
+			Round:    5,
+			PrevHash: []byte("prevHash"),
+			ChainID:  []byte("sov2"),
+			Reserved: []byte("reserved"),
+		},
+		ValidatorStatsRootHash:    []byte("validatorStatsRootHash"),
+		ExtendedShardHeaderHashes: [][]byte{[]byte("extendedShardHeaderHash")},
+		IsStartOfEpoch:            true,
+	}
+}
+
+func TestWrapSovereignChainHeader(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		headerV2, err := WrapSovereignChainHeader(nil, createSovereignChainHeader())
+		require.Nil(t, headerV2)
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		headerV2, err := WrapSovereignChainHeader(marshaller, nil)
+		require.Nil(t, headerV2)
+		require.Equal(t, data.ErrNilHeader, err)
+
+		headerV2, err = WrapSovereignChainHeader(marshaller, &block.SovereignChainHeader{})
+		require.Nil(t, headerV2)
+		require.Equal(t, data.ErrNilHeader, err)
+	})
+	t.Run("should wrap and unwrap", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignHeader := createSovereignChainHeader()
+		headerV2, err := WrapSovereignChainHeader(marshaller, sovereignHeader)
+		require.Nil(t, err)
+		require.True(t, IsSovereignChainHeaderEnvelope(headerV2))
+		require.Equal(t, sovereignHeader.Header, headerV2.Header)
+		require.Equal(t, []byte("sov2"), headerV2.GetChainID())
+		require.Equal(t, uint64(4), headerV2.GetNonce())
+
+		headerV2Bytes, err := marshaller.Marshal(headerV2)
+		require.Nil(t, err)
+		receivedHeaderV2 := &block.HeaderV2{}
+		err = marshaller.Unmarshal(receivedHeaderV2, headerV2Bytes)
+		require.Nil(t, err)
+
+		unwrappedHeader, isEnvelope, err := UnwrapSovereignChainHeader(marshaller, receivedHeaderV2)
+		require.Nil(t, err)
+		require.True(t, isEnvelope)
+		require.Equal(t, sovereignHeader, unwrappedHeader)
+	})
+}
+
+func TestUnwrapSovereignChainHeader(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+
+	unwrappedHeader, isEnvelope, err := UnwrapSovereignChainHeader(marshaller, &block.HeaderV2{
+		Header:            &block.Header{},
+		ScheduledRootHash: []byte("scheduledRootHash"),
+	})
+	require.Nil(t, err)
+	require.False(t, isEnvelope)
+	require.Nil(t, unwrappedHeader)
+
+	unwrappedHeader, isEnvelope, err = UnwrapSovereignChainHeader(marshaller, nil)
+	require.Nil(t, err)
+	require.False(t, isEnvelope)
+	require.Nil(t, unwrappedHeader)
+
+	unwrappedHeader, isEnvelope, err = UnwrapSovereignChainHeader(marshaller, &block.HeaderV2{
+		Header:            &block.Header{},
+		ScheduledRootHash: append([]byte("sovereignChainHeader@"), []byte("invalid")...),
+	})
+	require.NotNil(t, err)
+	require.False(t, isEnvelope)
+	require.Nil(t, unwrappedHeader)
+}
+
+func TestCalculateIncomingHeaderHash(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	hasher := blake2b.NewBlake2b()
+
+	t.Run("header v2 should return its hash", func(t *testing.T) {
+		t.Parallel()
+
+		headerV2 := &block.HeaderV2{Header: &block.Header{Nonce: 4}}
+		expectedHash, _ := core.CalculateHash(marshaller, hasher, headerV2)
+
+		hash, err := CalculateIncomingHeaderHash(marshaller, hasher, headerV2)
+		require.Nil(t, err)
+		require.Equal(t, expectedHash, hash)
+	})
+	t.Run("wrapped sovereign chain header should return the sovereign chain header hash", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignHeader := createSovereignChainHeader()
+		expectedHash, _ := core.CalculateHash(marshaller, hasher, sovereignHeader)
+		headerV2, _ := WrapSovereignChainHeader(marshaller, sovereignHeader)
+
+		hash, err := CalculateIncomingHeaderHash(marshaller, hasher, headerV2)
+		require.Nil(t, err)
+		require.Equal(t, expectedHash, hash)
+	})
+	t.Run("other header should return its hash", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignHeader := createSovereignChainHeader()
+		expectedHash, _ := core.CalculateHash(marshaller, hasher, sovereignHeader)
+
+		hash, err := CalculateIncomingHeaderHash(marshaller, hasher, sovereignHeader)
+		require.Nil(t, err)
+		require.Equal(t, expectedHash, hash)
+	})
+}
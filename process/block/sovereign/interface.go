@@ -1,14 +1,62 @@
 package sovereign
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 )
 
 // OutgoingOperationsFormatter collects relevant outgoing events for bridge from the logs and creates outgoing data
-// that needs to be signed by validators to bridge tokens
+// batches that need to be signed by validators to bridge tokens
 type OutgoingOperationsFormatter interface {
-	CreateOutgoingTxsData(logs []*data.LogData) ([][]byte, error)
+	CreateOutgoingTxsData(logs []*data.LogData) ([][][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// OutgoingOperationsRouter routes the relevant outgoing events for bridge from the logs to their destinations and creates
+// the outgoing data batches of each destination
+type OutgoingOperationsRouter interface {
+	CreateOutgoingTxsData(logs []*data.LogData, header data.HeaderHandler) ([]*OutGoingDestinationBatches, error)
+	HoldOutgoingTxsData(logs []*data.LogData, header data.HeaderHandler) error
+	IsInterfaceNil() bool
+}
+
+// BridgeRateLimiter enforces volume caps on the bridged operations of each block
+type BridgeRateLimiter interface {
+	CheckOperations(key uint32, epoch uint32, operations []*BridgeOperationVolume) (*RateLimitResult, error)
+	FitsInLimits(key uint32, epoch uint32, operations []*BridgeOperationVolume) (bool, error)
+	AddOperations(key uint32, epoch uint32, operations []*BridgeOperationVolume) error
+	QueueOperations(key uint32, epoch uint32, operations []*BridgeOperationVolume) error
+	ReleaseQueuedOperations(key uint32) ([]*BridgeOperationVolume, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// DepositsVolumeComputer computes the token volumes moved by the incoming deposits of an extended shard header
+type DepositsVolumeComputer interface {
+	ComputeDepositsVolumes(header data.ShardHeaderExtendedHandler) ([]*TokenVolume, error)
+	IsInterfaceNil() bool
+}
+
+// BridgePauseHandler provides the bridge pause flags set through governance
+type BridgePauseHandler interface {
+	IsIncomingPaused() bool
+	IsOutGoingPaused() bool
+	IsInterfaceNil() bool
+}
+
+// EpochStartNodesComputer computes the validators set of the epoch started by an epoch start block, before the block
+// is committed
+type EpochStartNodesComputer interface {
+	ComputeNextEpochEligiblePublicKeys(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// ValidatorSetRotationCreator creates, at epoch start, the outgoing operation notifying the main chain about the new
+// validators set
+type ValidatorSetRotationCreator interface {
+	CreateValidatorSetRotationOperation(header data.HeaderHandler, body data.BodyHandler) (*OutGoingDestinationBatches, error)
 	IsInterfaceNil() bool
 }
 
@@ -19,6 +67,7 @@ type DataCodecHandler interface {
 	SerializeTokenData(tokenData sovereign.EsdtTokenData) ([]byte, error)
 	DeserializeTokenData(data []byte) (*sovereign.EsdtTokenData, error)
 	SerializeOperation(operation sovereign.Operation) ([]byte, error)
+	DeserializeOperation(data []byte) (*sovereign.Operation, error)
 	IsInterfaceNil() bool
 }
 
@@ -27,3 +76,15 @@ type TopicsCheckerHandler interface {
 	CheckValidity(topics [][]byte) error
 	IsInterfaceNil() bool
 }
+
+// BridgeOperationsHandler handles sending outgoing txs from sovereign to main chain
+type BridgeOperationsHandler interface {
+	Send(ctx context.Context, data *sovereign.BridgeOperations) (*sovereign.BridgeOperationsResponse, error)
+	IsInterfaceNil() bool
+}
+
+// OutGoingOperationsDestinationsHolder holds the destination of each outgoing operations batch
+type OutGoingOperationsDestinationsHolder interface {
+	GetDestination(hash []byte) string
+	IsInterfaceNil() bool
+}
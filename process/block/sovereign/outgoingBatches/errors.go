@@ -0,0 +1,15 @@
+package outgoingBatches
+
+import "errors"
+
+// ErrWrongTypeAssertion signals that a wrong type assertion occurred
+var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilOutGoingMiniBlockHeader signals that the sovereign header has no outgoing mini block header
+var ErrNilOutGoingMiniBlockHeader = errors.New("nil outgoing mini block header")
+
+// ErrInvalidOutGoingOperationsBatches signals that invalid outgoing operations batches have been provided
+var ErrInvalidOutGoingOperationsBatches = errors.New("invalid outgoing operations batches")
+
+// ErrInvalidOutGoingOperationsSignatures signals that invalid outgoing operations signatures have been provided
+var ErrInvalidOutGoingOperationsSignatures = errors.New("invalid outgoing operations signatures")
@@ -0,0 +1,235 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/multiversx/protobuf/protobuf  --gogoslick_out=. outGoingOperationsBatches.proto
+
+package outgoingBatches
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+)
+
+// HasOutGoingOperationsBatches returns true if the provided sovereign header holds its outgoing operations batches in
+// its reserved field. Only headers created after the outgoing operations batches activation hold them, in which case
+// the outgoing operations hash of the outgoing mini block header is the hash over all the batches hashes.
+func HasOutGoingOperationsBatches(header data.HeaderHandler) bool {
+	return len(header.GetReserved()) > 0
+}
+
+// IsReservedFieldValid returns true if the reserved field of the provided header is empty or, for sovereign headers, if
+// it holds valid outgoing operations batches
+func IsReservedFieldValid(header data.HeaderHandler) bool {
+	if len(header.GetReserved()) == 0 {
+		return true
+	}
+
+	_, err := GetOutGoingOperationsBatches(header)
+	return err == nil
+}
+
+// GetOutGoingOperationsBatches returns all the outgoing operations batches from a sovereign header, along with their
+// signatures, in batches order. Headers created before the outgoing operations batches activation hold a single batch,
+// which is saved in the outgoing mini block header.
+func GetOutGoingOperationsBatches(header data.HeaderHandler) ([]*OutGoingOperationsBatch, error) {
+	outGoingMb, err := getOutGoingMiniBlockHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if !HasOutGoingOperationsBatches(header) {
+		return getLegacyOutGoingOperationsBatch(outGoingMb)
+	}
+
+	reserved := &SovereignHeaderReserved{}
+	err = reserved.Unmarshal(header.GetReserved())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOutGoingOperationsBatches, err)
+	}
+	if len(reserved.OutGoingOperationsBatches) == 0 {
+		return nil, fmt.Errorf("%w, no outgoing operations batches", ErrInvalidOutGoingOperationsBatches)
+	}
+
+	for idx, batch := range reserved.OutGoingOperationsBatches {
+		if len(batch.Hash) == 0 {
+			return nil, fmt.Errorf("%w, empty hash for outgoing operations batch %d", ErrInvalidOutGoingOperationsBatches, idx)
+		}
+	}
+
+	return reserved.OutGoingOperationsBatches, nil
+}
+
+func getLegacyOutGoingOperationsBatch(outGoingMb data.OutGoingMiniBlockHeaderHandler) ([]*OutGoingOperationsBatch, error) {
+	if len(outGoingMb.GetOutGoingOperationsHash()) == 0 {
+		return nil, fmt.Errorf("%w, empty outgoing operations hash", ErrInvalidOutGoingOperationsBatches)
+	}
+
+	return []*OutGoingOperationsBatch{
+		{
+			Hash:                outGoingMb.GetOutGoingOperationsHash(),
+			AggregatedSignature: outGoingMb.GetAggregatedSignatureOutGoingOperations(),
+			LeaderSignature:     outGoingMb.GetLeaderSignatureOutGoingOperations(),
+		},
+	}, nil
+}
+
+// SetOutGoingOperationsBatches saves the provided outgoing operations batches in the reserved field of the sovereign
+// header. Providing no batches clears the reserved field, for headers created before the outgoing operations batches
+// activation.
+func SetOutGoingOperationsBatches(header data.HeaderHandler, batches []*OutGoingOperationsBatch) error {
+	if len(batches) == 0 && len(header.GetReserved()) == 0 {
+		return nil
+	}
+
+	sovHeader, castOk := header.(*block.SovereignChainHeader)
+	if !castOk || sovHeader.Header == nil {
+		return fmt.Errorf("%w in SetOutGoingOperationsBatches", ErrWrongTypeAssertion)
+	}
+
+	if len(batches) == 0 {
+		sovHeader.Header.Reserved = nil
+		return nil
+	}
+
+	reserved, err := (&SovereignHeaderReserved{OutGoingOperationsBatches: batches}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	sovHeader.Header.Reserved = reserved
+	return nil
+}
+
+// SetAggregatedSignatures sets the aggregated signature of each outgoing operations batch from the sovereign header,
+// in batches order
+func SetAggregatedSignatures(header data.HeaderHandler, aggregatedSignatures [][]byte) error {
+	return setSignatures(header, aggregatedSignatures, setAggregatedSignature)
+}
+
+// SetLeaderSignatures sets the leader signature of each outgoing operations batch from the sovereign header, in
+// batches order
+func SetLeaderSignatures(header data.HeaderHandler, leaderSignatures [][]byte) error {
+	return setSignatures(header, leaderSignatures, setLeaderSignature)
+}
+
+// RemoveLeaderSignatures removes the leader signatures of all the outgoing operations batches from the sovereign header
+func RemoveLeaderSignatures(header data.HeaderHandler) error {
+	return removeSignatures(header, setLeaderSignature)
+}
+
+// RemoveAllSignatures removes the aggregated and leader signatures of all the outgoing operations batches from the
+// sovereign header
+func RemoveAllSignatures(header data.HeaderHandler) error {
+	err := removeSignatures(header, setAggregatedSignature)
+	if err != nil {
+		return err
+	}
+
+	return removeSignatures(header, setLeaderSignature)
+}
+
+type signatureSetter func(batch *OutGoingOperationsBatch, outGoingMb data.OutGoingMiniBlockHeaderHandler, signature []byte) error
+
+func setAggregatedSignature(batch *OutGoingOperationsBatch, outGoingMb data.OutGoingMiniBlockHeaderHandler, signature []byte) error {
+	if batch != nil {
+		batch.AggregatedSignature = signature
+		return nil
+	}
+
+	return outGoingMb.SetAggregatedSignatureOutGoingOperations(signature)
+}
+
+func setLeaderSignature(batch *OutGoingOperationsBatch, outGoingMb data.OutGoingMiniBlockHeaderHandler, signature []byte) error {
+	if batch != nil {
+		batch.LeaderSignature = signature
+		return nil
+	}
+
+	return outGoingMb.SetLeaderSignatureOutGoingOperations(signature)
+}
+
+func removeSignatures(header data.HeaderHandler, setSignature signatureSetter) error {
+	batches, err := GetOutGoingOperationsBatches(header)
+	if err != nil {
+		return err
+	}
+
+	return setSignatures(header, make([][]byte, len(batches)), setSignature)
+}
+
+func setSignatures(header data.HeaderHandler, signatures [][]byte, setSignature signatureSetter) error {
+	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
+	if !castOk {
+		return fmt.Errorf("%w in outgoingBatches.setSignatures", ErrWrongTypeAssertion)
+	}
+
+	batches, err := GetOutGoingOperationsBatches(header)
+	if err != nil {
+		return err
+	}
+	if len(signatures) != len(batches) {
+		return fmt.Errorf("%w, num batches = %d, num signatures = %d",
+			ErrInvalidOutGoingOperationsSignatures, len(batches), len(signatures))
+	}
+
+	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
+	if !HasOutGoingOperationsBatches(header) {
+		err = setSignature(nil, outGoingMb, signatures[0])
+		if err != nil {
+			return err
+		}
+
+		return sovHeader.SetOutGoingMiniBlockHeaderHandler(outGoingMb)
+	}
+
+	for idx, batch := range batches {
+		err = setSignature(batch, outGoingMb, signatures[idx])
+		if err != nil {
+			return err
+		}
+	}
+
+	return SetOutGoingOperationsBatches(header, batches)
+}
+
+func getOutGoingMiniBlockHeader(header data.HeaderHandler) (data.OutGoingMiniBlockHeaderHandler, error) {
+	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
+	if !castOk {
+		return nil, fmt.Errorf("%w in outgoingBatches.getOutGoingMiniBlockHeader", ErrWrongTypeAssertion)
+	}
+
+	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
+	if check.IfNil(outGoingMb) {
+		return nil, ErrNilOutGoingMiniBlockHeader
+	}
+
+	return outGoingMb, nil
+}
+
+// MarshalOutGoingOperationsSignatures marshals the provided signatures of all the outgoing operations batches from a
+// sovereign block, in batches order
+func MarshalOutGoingOperationsSignatures(signatures [][]byte) ([]byte, error) {
+	return (&OutGoingOperationsSignatures{Signatures: signatures}).Marshal()
+}
+
+// GetOutGoingOperationsSignatures returns the signatures of all the outgoing operations batches from a sovereign block,
+// in batches order. There should be exactly one non-empty signature for each batch.
+func GetOutGoingOperationsSignatures(signatures []byte, numBatches int) ([][]byte, error) {
+	batchesSignatures := &OutGoingOperationsSignatures{}
+	err := batchesSignatures.Unmarshal(signatures)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOutGoingOperationsSignatures, err)
+	}
+	if len(batchesSignatures.Signatures) != numBatches {
+		return nil, fmt.Errorf("%w, num batches = %d, num signatures = %d",
+			ErrInvalidOutGoingOperationsSignatures, numBatches, len(batchesSignatures.Signatures))
+	}
+
+	for idx, signature := range batchesSignatures.Signatures {
+		if len(signature) == 0 {
+			return nil, fmt.Errorf("%w, empty signature for outgoing operations batch %d", ErrInvalidOutGoingOperationsSignatures, idx)
+		}
+	}
+
+	return batchesSignatures.Signatures, nil
+}
@@ -0,0 +1,1065 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: outGoingOperationsBatches.proto
+
+package outgoingBatches
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OutGoingOperationsBatch holds the data of an outgoing operations batch from a sovereign block. The Hash is the signed
+// batch hash, MerkleRoot is the root of the merkle tree built over the operations hashes and NumOperations is the number
+// of operations from the outgoing mini block which belong to the batch. Each batch is signed separately. Once the
+// outgoing operations merkle root is enabled, the Hash is the MerkleRoot.
+type OutGoingOperationsBatch struct {
+	Hash                []byte `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	MerkleRoot          []byte `protobuf:"bytes,2,opt,name=MerkleRoot,proto3" json:"MerkleRoot,omitempty"`
+	NumOperations       uint32 `protobuf:"varint,3,opt,name=NumOperations,proto3" json:"NumOperations,omitempty"`
+	AggregatedSignature []byte `protobuf:"bytes,4,opt,name=AggregatedSignature,proto3" json:"AggregatedSignature,omitempty"`
+	LeaderSignature     []byte `protobuf:"bytes,5,opt,name=LeaderSignature,proto3" json:"LeaderSignature,omitempty"`
+}
+
+func (m *OutGoingOperationsBatch) Reset()      { *m = OutGoingOperationsBatch{} }
+func (*OutGoingOperationsBatch) ProtoMessage() {}
+func (*OutGoingOperationsBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b698aa81f1da073d, []int{0}
+}
+func (m *OutGoingOperationsBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutGoingOperationsBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OutGoingOperationsBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutGoingOperationsBatch.Merge(m, src)
+}
+func (m *OutGoingOperationsBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutGoingOperationsBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutGoingOperationsBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutGoingOperationsBatch proto.InternalMessageInfo
+
+func (m *OutGoingOperationsBatch) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *OutGoingOperationsBatch) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
+}
+
+func (m *OutGoingOperationsBatch) GetNumOperations() uint32 {
+	if m != nil {
+		return m.NumOperations
+	}
+	return 0
+}
+
+func (m *OutGoingOperationsBatch) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
+func (m *OutGoingOperationsBatch) GetLeaderSignature() []byte {
+	if m != nil {
+		return m.LeaderSignature
+	}
+	return nil
+}
+
+// SovereignHeaderReserved holds the sovereign chain header data which is saved in the reserved field of the header
+type SovereignHeaderReserved struct {
+	OutGoingOperationsBatches []*OutGoingOperationsBatch `protobuf:"bytes,1,rep,name=OutGoingOperationsBatches,proto3" json:"OutGoingOperationsBatches,omitempty"`
+}
+
+func (m *SovereignHeaderReserved) Reset()      { *m = SovereignHeaderReserved{} }
+func (*SovereignHeaderReserved) ProtoMessage() {}
+func (*SovereignHeaderReserved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b698aa81f1da073d, []int{1}
+}
+func (m *SovereignHeaderReserved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SovereignHeaderReserved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SovereignHeaderReserved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SovereignHeaderReserved.Merge(m, src)
+}
+func (m *SovereignHeaderReserved) XXX_Size() int {
+	return m.Size()
+}
+func (m *SovereignHeaderReserved) XXX_DiscardUnknown() {
+	xxx_messageInfo_SovereignHeaderReserved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SovereignHeaderReserved proto.InternalMessageInfo
+
+func (m *SovereignHeaderReserved) GetOutGoingOperationsBatches() []*OutGoingOperationsBatch {
+	if m != nil {
+		return m.OutGoingOperationsBatches
+	}
+	return nil
+}
+
+// OutGoingOperationsSignatures holds a signature for each outgoing operations batch from a sovereign block, in batches order
+type OutGoingOperationsSignatures struct {
+	Signatures [][]byte `protobuf:"bytes,1,rep,name=Signatures,proto3" json:"Signatures,omitempty"`
+}
+
+func (m *OutGoingOperationsSignatures) Reset()      { *m = OutGoingOperationsSignatures{} }
+func (*OutGoingOperationsSignatures) ProtoMessage() {}
+func (*OutGoingOperationsSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b698aa81f1da073d, []int{2}
+}
+func (m *OutGoingOperationsSignatures) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutGoingOperationsSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OutGoingOperationsSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutGoingOperationsSignatures.Merge(m, src)
+}
+func (m *OutGoingOperationsSignatures) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutGoingOperationsSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutGoingOperationsSignatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutGoingOperationsSignatures proto.InternalMessageInfo
+
+func (m *OutGoingOperationsSignatures) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OutGoingOperationsBatch)(nil), "proto.OutGoingOperationsBatch")
+	proto.RegisterType((*SovereignHeaderReserved)(nil), "proto.SovereignHeaderReserved")
+	proto.RegisterType((*OutGoingOperationsSignatures)(nil), "proto.OutGoingOperationsSignatures")
+}
+
+func init() { proto.RegisterFile("outGoingOperationsBatches.proto", fileDescriptor_b698aa81f1da073d) }
+
+var fileDescriptor_b698aa81f1da073d = []byte{
+	// 331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x50, 0xbf, 0x4e, 0x3a, 0x41,
+	0x10, 0xbe, 0xf9, 0x01, 0xbf, 0x62, 0x85, 0x90, 0xac, 0x05, 0xa7, 0x31, 0x23, 0x21, 0x16, 0xd7,
+	0x08, 0x46, 0x7b, 0x13, 0x69, 0xc4, 0x44, 0x25, 0x39, 0x3a, 0x63, 0x73, 0xc0, 0xb8, 0x5c, 0x94,
+	0x5b, 0xb2, 0xb7, 0x8b, 0xad, 0x8f, 0xe0, 0x63, 0xf8, 0x28, 0x76, 0x52, 0x52, 0xca, 0xd2, 0x58,
+	0xf2, 0x08, 0x86, 0xc5, 0x08, 0x2a, 0x54, 0x37, 0xdf, 0x9f, 0xfb, 0x66, 0xf6, 0x63, 0xfb, 0xd2,
+	0xe8, 0x73, 0x19, 0x27, 0xa2, 0x39, 0x20, 0x15, 0xe9, 0x58, 0x26, 0x69, 0x3d, 0xd2, 0x9d, 0x1e,
+	0xa5, 0xd5, 0x81, 0x92, 0x5a, 0xf2, 0x9c, 0xfb, 0xec, 0x1e, 0x8a, 0x58, 0xf7, 0x4c, 0xbb, 0xda,
+	0x91, 0xfd, 0x9a, 0x90, 0x42, 0xd6, 0x1c, 0xdd, 0x36, 0x77, 0x0e, 0x39, 0xe0, 0xa6, 0xc5, 0x5f,
+	0x95, 0x37, 0x60, 0xa5, 0xe6, 0xfa, 0x64, 0xce, 0x59, 0xb6, 0x11, 0xa5, 0x3d, 0x1f, 0xca, 0x10,
+	0xe4, 0x43, 0x37, 0x73, 0x64, 0xec, 0x8a, 0xd4, 0xfd, 0x03, 0x85, 0x52, 0x6a, 0xff, 0x9f, 0x53,
+	0x56, 0x18, 0x7e, 0xc0, 0x0a, 0xd7, 0xa6, 0xbf, 0x4c, 0xf2, 0x33, 0x65, 0x08, 0x0a, 0xe1, 0x4f,
+	0x92, 0x1f, 0xb1, 0xed, 0x33, 0x21, 0x14, 0x89, 0x48, 0x53, 0xb7, 0x15, 0x8b, 0x24, 0xd2, 0x46,
+	0x91, 0x9f, 0x75, 0x71, 0xeb, 0x24, 0x1e, 0xb0, 0xe2, 0x25, 0x45, 0x5d, 0x52, 0x4b, 0x77, 0xce,
+	0xb9, 0x7f, 0xd3, 0x95, 0x47, 0x56, 0x6a, 0xc9, 0x21, 0x29, 0x8a, 0x45, 0xd2, 0x70, 0x5a, 0x48,
+	0x29, 0xa9, 0x21, 0x75, 0xf9, 0x2d, 0xdb, 0x69, 0x6e, 0x6a, 0xd1, 0x87, 0x72, 0x26, 0xd8, 0x3a,
+	0xc6, 0x45, 0x2f, 0xd5, 0x0d, 0xbe, 0x70, 0x73, 0x40, 0xe5, 0x94, 0xed, 0xfd, 0x15, 0xbf, 0xef,
+	0x4a, 0xe7, 0xd5, 0x2d, 0x91, 0x5b, 0x97, 0x0f, 0x57, 0x98, 0xfa, 0xc5, 0x68, 0x82, 0xde, 0x78,
+	0x82, 0xde, 0x6c, 0x82, 0xf0, 0x64, 0x11, 0x5e, 0x2c, 0xc2, 0xab, 0x45, 0x18, 0x59, 0x84, 0xb1,
+	0x45, 0x78, 0xb7, 0x08, 0x1f, 0x16, 0xbd, 0x99, 0x45, 0x78, 0x9e, 0xa2, 0x37, 0x9a, 0xa2, 0x37,
+	0x9e, 0xa2, 0x77, 0x53, 0x94, 0x46, 0x8b, 0xf9, 0xde, 0xaf, 0x53, 0xda, 0xff, 0xdd, 0x23, 0x4e,
+	0x3e, 0x07, 0x00, 0x8d, 0x2a, 0xe1, 0x04, 0x35, 0x02, 0x00, 0x00,
+}
+
+func (this *OutGoingOperationsBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutGoingOperationsBatch)
+	if !ok {
+		that2, ok := that.(OutGoingOperationsBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if !bytes.Equal(this.MerkleRoot, that1.MerkleRoot) {
+		return false
+	}
+	if this.NumOperations != that1.NumOperations {
+		return false
+	}
+	if !bytes.Equal(this.AggregatedSignature, that1.AggregatedSignature) {
+		return false
+	}
+	if !bytes.Equal(this.LeaderSignature, that1.LeaderSignature) {
+		return false
+	}
+	return true
+}
+func (this *SovereignHeaderReserved) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SovereignHeaderReserved)
+	if !ok {
+		that2, ok := that.(SovereignHeaderReserved)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.OutGoingOperationsBatches) != len(that1.OutGoingOperationsBatches) {
+		return false
+	}
+	for i := range this.OutGoingOperationsBatches {
+		if !this.OutGoingOperationsBatches[i].Equal(that1.OutGoingOperationsBatches[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *OutGoingOperationsSignatures) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutGoingOperationsSignatures)
+	if !ok {
+		that2, ok := that.(OutGoingOperationsSignatures)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Signatures) != len(that1.Signatures) {
+		return false
+	}
+	for i := range this.Signatures {
+		if !bytes.Equal(this.Signatures[i], that1.Signatures[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *OutGoingOperationsBatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&outgoingBatches.OutGoingOperationsBatch{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "MerkleRoot: "+fmt.Sprintf("%#v", this.MerkleRoot)+",\n")
+	s = append(s, "NumOperations: "+fmt.Sprintf("%#v", this.NumOperations)+",\n")
+	s = append(s, "AggregatedSignature: "+fmt.Sprintf("%#v", this.AggregatedSignature)+",\n")
+	s = append(s, "LeaderSignature: "+fmt.Sprintf("%#v", this.LeaderSignature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SovereignHeaderReserved) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&outgoingBatches.SovereignHeaderReserved{")
+	if this.OutGoingOperationsBatches != nil {
+		s = append(s, "OutGoingOperationsBatches: "+fmt.Sprintf("%#v", this.OutGoingOperationsBatches)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OutGoingOperationsSignatures) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&outgoingBatches.OutGoingOperationsSignatures{")
+	s = append(s, "Signatures: "+fmt.Sprintf("%#v", this.Signatures)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringOutGoingOperationsBatches(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *OutGoingOperationsBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutGoingOperationsBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutGoingOperationsBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LeaderSignature) > 0 {
+		i -= len(m.LeaderSignature)
+		copy(dAtA[i:], m.LeaderSignature)
+		i = encodeVarintOutGoingOperationsBatches(dAtA, i, uint64(len(m.LeaderSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintOutGoingOperationsBatches(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumOperations != 0 {
+		i = encodeVarintOutGoingOperationsBatches(dAtA, i, uint64(m.NumOperations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintOutGoingOperationsBatches(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOutGoingOperationsBatches(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SovereignHeaderReserved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SovereignHeaderReserved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SovereignHeaderReserved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutGoingOperationsBatches) > 0 {
+		for iNdEx := len(m.OutGoingOperationsBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutGoingOperationsBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOutGoingOperationsBatches(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutGoingOperationsSignatures) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutGoingOperationsSignatures) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutGoingOperationsSignatures) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintOutGoingOperationsBatches(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOutGoingOperationsBatches(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOutGoingOperationsBatches(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutGoingOperationsBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOutGoingOperationsBatches(uint64(l))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovOutGoingOperationsBatches(uint64(l))
+	}
+	if m.NumOperations != 0 {
+		n += 1 + sovOutGoingOperationsBatches(uint64(m.NumOperations))
+	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 1 + l + sovOutGoingOperationsBatches(uint64(l))
+	}
+	l = len(m.LeaderSignature)
+	if l > 0 {
+		n += 1 + l + sovOutGoingOperationsBatches(uint64(l))
+	}
+	return n
+}
+
+func (m *SovereignHeaderReserved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutGoingOperationsBatches) > 0 {
+		for _, e := range m.OutGoingOperationsBatches {
+			l = e.Size()
+			n += 1 + l + sovOutGoingOperationsBatches(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutGoingOperationsSignatures) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovOutGoingOperationsBatches(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOutGoingOperationsBatches(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutGoingOperationsBatches(x uint64) (n int) {
+	return sovOutGoingOperationsBatches(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *OutGoingOperationsBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutGoingOperationsBatch{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`MerkleRoot:` + fmt.Sprintf("%v", this.MerkleRoot) + `,`,
+		`NumOperations:` + fmt.Sprintf("%v", this.NumOperations) + `,`,
+		`AggregatedSignature:` + fmt.Sprintf("%v", this.AggregatedSignature) + `,`,
+		`LeaderSignature:` + fmt.Sprintf("%v", this.LeaderSignature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SovereignHeaderReserved) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutGoingOperationsBatches := "[]*OutGoingOperationsBatch{"
+	for _, f := range this.OutGoingOperationsBatches {
+		repeatedStringForOutGoingOperationsBatches += strings.Replace(f.String(), "OutGoingOperationsBatch", "OutGoingOperationsBatch", 1) + ","
+	}
+	repeatedStringForOutGoingOperationsBatches += "}"
+	s := strings.Join([]string{`&SovereignHeaderReserved{`,
+		`OutGoingOperationsBatches:` + repeatedStringForOutGoingOperationsBatches + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutGoingOperationsSignatures) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutGoingOperationsSignatures{`,
+		`Signatures:` + fmt.Sprintf("%v", this.Signatures) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringOutGoingOperationsBatches(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *OutGoingOperationsBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutGoingOperationsBatches
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutGoingOperationsBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutGoingOperationsBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOperations", wireType)
+			}
+			m.NumOperations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOperations |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaderSignature = append(m.LeaderSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeaderSignature == nil {
+				m.LeaderSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutGoingOperationsBatches(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SovereignHeaderReserved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutGoingOperationsBatches
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SovereignHeaderReserved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SovereignHeaderReserved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutGoingOperationsBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutGoingOperationsBatches = append(m.OutGoingOperationsBatches, &OutGoingOperationsBatch{})
+			if err := m.OutGoingOperationsBatches[len(m.OutGoingOperationsBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutGoingOperationsBatches(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutGoingOperationsSignatures) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutGoingOperationsBatches
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutGoingOperationsSignatures: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutGoingOperationsSignatures: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutGoingOperationsBatches(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthOutGoingOperationsBatches
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOutGoingOperationsBatches(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOutGoingOperationsBatches
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutGoingOperationsBatches
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOutGoingOperationsBatches
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOutGoingOperationsBatches
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOutGoingOperationsBatches
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOutGoingOperationsBatches        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOutGoingOperationsBatches          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOutGoingOperationsBatches = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,254 @@
+package outgoingBatches
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/stretchr/testify/require"
+)
+
+func createLegacySovereignHeader() *block.SovereignChainHeader {
+	return &block.SovereignChainHeader{
+		Header: &block.Header{},
+		OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
+			Hash:                                  []byte("mbHash"),
+			OutGoingOperationsHash:                []byte("hash"),
+			AggregatedSignatureOutGoingOperations: []byte("aggSig"),
+			LeaderSignatureOutGoingOperations:     []byte("leaderSig"),
+		},
+	}
+}
+
+func createSovereignHeaderWithBatches(t *testing.T, batches []*OutGoingOperationsBatch) *block.SovereignChainHeader {
+	header := &block.SovereignChainHeader{
+		Header: &block.Header{},
+		OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
+			Hash:                   []byte("mbHash"),
+			OutGoingOperationsHash: []byte("hashOfBatches"),
+		},
+	}
+	err := SetOutGoingOperationsBatches(header, batches)
+	require.Nil(t, err)
+
+	return header
+}
+
+func TestGetOutGoingOperationsBatches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a sovereign header should error", func(t *testing.T) {
+		t.Parallel()
+
+		batches, err := GetOutGoingOperationsBatches(&block.Header{})
+		require.True(t, errors.Is(err, ErrWrongTypeAssertion))
+		require.Nil(t, batches)
+	})
+	t.Run("no outgoing mini block header should error", func(t *testing.T) {
+		t.Parallel()
+
+		batches, err := GetOutGoingOperationsBatches(&block.SovereignChainHeader{Header: &block.Header{}})
+		require.Equal(t, ErrNilOutGoingMiniBlockHeader, err)
+		require.Nil(t, batches)
+	})
+	t.Run("legacy header without outgoing operations hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createLegacySovereignHeader()
+		header.OutGoingMiniBlockHeader.OutGoingOperationsHash = nil
+
+		batches, err := GetOutGoingOperationsBatches(header)
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsBatches))
+		require.Nil(t, batches)
+	})
+	t.Run("legacy header should return a single batch", func(t *testing.T) {
+		t.Parallel()
+
+		batches, err := GetOutGoingOperationsBatches(createLegacySovereignHeader())
+		require.Nil(t, err)
+		require.Equal(t, []*OutGoingOperationsBatch{
+			{
+				Hash:                []byte("hash"),
+				AggregatedSignature: []byte("aggSig"),
+				LeaderSignature:     []byte("leaderSig"),
+			},
+		}, batches)
+	})
+	t.Run("invalid reserved field should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createLegacySovereignHeader()
+		header.Header.Reserved = []byte("reserved")
+
+		batches, err := GetOutGoingOperationsBatches(header)
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsBatches))
+		require.Nil(t, batches)
+	})
+	t.Run("batch with empty hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignHeaderWithBatches(t, []*OutGoingOperationsBatch{
+			{Hash: []byte("hash1")},
+			{NumOperations: 1},
+		})
+
+		batches, err := GetOutGoingOperationsBatches(header)
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsBatches))
+		require.Nil(t, batches)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBatches := []*OutGoingOperationsBatch{
+			{Hash: []byte("hash1"), MerkleRoot: []byte("root1"), NumOperations: 2},
+			{Hash: []byte("hash2"), MerkleRoot: []byte("root2"), NumOperations: 1},
+		}
+		header := createSovereignHeaderWithBatches(t, expectedBatches)
+		require.True(t, HasOutGoingOperationsBatches(header))
+		require.Equal(t, []byte("hashOfBatches"), header.GetOutGoingMiniBlockHeaderHandler().GetOutGoingOperationsHash())
+
+		batches, err := GetOutGoingOperationsBatches(header)
+		require.Nil(t, err)
+		require.Equal(t, expectedBatches, batches)
+	})
+}
+
+func TestSetOutGoingOperationsBatches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a sovereign header should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := SetOutGoingOperationsBatches(&block.Header{}, []*OutGoingOperationsBatch{{Hash: []byte("hash")}})
+		require.True(t, errors.Is(err, ErrWrongTypeAssertion))
+	})
+	t.Run("no batches should clear the reserved field", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignHeaderWithBatches(t, []*OutGoingOperationsBatch{{Hash: []byte("hash")}})
+		require.True(t, HasOutGoingOperationsBatches(header))
+
+		err := SetOutGoingOperationsBatches(header, nil)
+		require.Nil(t, err)
+		require.False(t, HasOutGoingOperationsBatches(header))
+		require.Nil(t, header.GetReserved())
+	})
+}
+
+func TestSetSignatures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("num signatures different than num batches should error", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignHeaderWithBatches(t, []*OutGoingOperationsBatch{{Hash: []byte("hash1")}, {Hash: []byte("hash2")}})
+
+		err := SetAggregatedSignatures(header, [][]byte{[]byte("aggSig1")})
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsSignatures))
+	})
+	t.Run("legacy header should set the outgoing mini block header signatures", func(t *testing.T) {
+		t.Parallel()
+
+		header := createLegacySovereignHeader()
+
+		err := SetAggregatedSignatures(header, [][]byte{[]byte("newAggSig")})
+		require.Nil(t, err)
+		err = SetLeaderSignatures(header, [][]byte{[]byte("newLeaderSig")})
+		require.Nil(t, err)
+
+		require.Nil(t, header.GetReserved())
+		require.Equal(t, &block.OutGoingMiniBlockHeader{
+			Hash:                                  []byte("mbHash"),
+			OutGoingOperationsHash:                []byte("hash"),
+			AggregatedSignatureOutGoingOperations: []byte("newAggSig"),
+			LeaderSignatureOutGoingOperations:     []byte("newLeaderSig"),
+		}, header.OutGoingMiniBlockHeader)
+
+		err = RemoveLeaderSignatures(header)
+		require.Nil(t, err)
+		require.Equal(t, []byte("newAggSig"), header.OutGoingMiniBlockHeader.AggregatedSignatureOutGoingOperations)
+		require.Empty(t, header.OutGoingMiniBlockHeader.LeaderSignatureOutGoingOperations)
+
+		err = RemoveAllSignatures(header)
+		require.Nil(t, err)
+		require.Empty(t, header.OutGoingMiniBlockHeader.AggregatedSignatureOutGoingOperations)
+		require.Empty(t, header.OutGoingMiniBlockHeader.LeaderSignatureOutGoingOperations)
+	})
+	t.Run("header with batches should set the signature of each batch", func(t *testing.T) {
+		t.Parallel()
+
+		header := createSovereignHeaderWithBatches(t, []*OutGoingOperationsBatch{{Hash: []byte("hash1")}, {Hash: []byte("hash2")}})
+
+		err := SetAggregatedSignatures(header, [][]byte{[]byte("aggSig1"), []byte("aggSig2")})
+		require.Nil(t, err)
+		err = SetLeaderSignatures(header, [][]byte{[]byte("leaderSig1"), []byte("leaderSig2")})
+		require.Nil(t, err)
+
+		batches, err := GetOutGoingOperationsBatches(header)
+		require.Nil(t, err)
+		require.Equal(t, []*OutGoingOperationsBatch{
+			{Hash: []byte("hash1"), AggregatedSignature: []byte("aggSig1"), LeaderSignature: []byte("leaderSig1")},
+			{Hash: []byte("hash2"), AggregatedSignature: []byte("aggSig2"), LeaderSignature: []byte("leaderSig2")},
+		}, batches)
+		require.Empty(t, header.OutGoingMiniBlockHeader.AggregatedSignatureOutGoingOperations)
+		require.Empty(t, header.OutGoingMiniBlockHeader.LeaderSignatureOutGoingOperations)
+
+		err = RemoveLeaderSignatures(header)
+		require.Nil(t, err)
+		batches, _ = GetOutGoingOperationsBatches(header)
+		require.Equal(t, []*OutGoingOperationsBatch{
+			{Hash: []byte("hash1"), AggregatedSignature: []byte("aggSig1")},
+			{Hash: []byte("hash2"), AggregatedSignature: []byte("aggSig2")},
+		}, batches)
+
+		err = RemoveAllSignatures(header)
+		require.Nil(t, err)
+		batches, _ = GetOutGoingOperationsBatches(header)
+		require.Equal(t, []*OutGoingOperationsBatch{{Hash: []byte("hash1")}, {Hash: []byte("hash2")}}, batches)
+	})
+}
+
+func TestGetOutGoingOperationsSignatures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid signatures should error", func(t *testing.T) {
+		t.Parallel()
+
+		sigs, err := GetOutGoingOperationsSignatures([]byte("sig"), 1)
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsSignatures))
+		require.Nil(t, sigs)
+	})
+	t.Run("empty signatures should error", func(t *testing.T) {
+		t.Parallel()
+
+		sigs, err := GetOutGoingOperationsSignatures(nil, 2)
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsSignatures))
+		require.Nil(t, sigs)
+	})
+	t.Run("num signatures different than num batches should error", func(t *testing.T) {
+		t.Parallel()
+
+		signatures, _ := MarshalOutGoingOperationsSignatures([][]byte{[]byte("sig1"), []byte("sig2")})
+		sigs, err := GetOutGoingOperationsSignatures(signatures, 3)
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsSignatures))
+		require.Nil(t, sigs)
+	})
+	t.Run("empty batch signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		signatures, _ := MarshalOutGoingOperationsSignatures([][]byte{[]byte("sig1"), nil})
+		sigs, err := GetOutGoingOperationsSignatures(signatures, 2)
+		require.True(t, errors.Is(err, ErrInvalidOutGoingOperationsSignatures))
+		require.Nil(t, sigs)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		signatures, err := MarshalOutGoingOperationsSignatures([][]byte{[]byte("sig1"), []byte("sig22")})
+		require.Nil(t, err)
+
+		sigs, err := GetOutGoingOperationsSignatures(signatures, 2)
+		require.Nil(t, err)
+		require.Equal(t, [][]byte{[]byte("sig1"), []byte("sig22")}, sigs)
+	})
+}
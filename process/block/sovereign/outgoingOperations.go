@@ -2,9 +2,11 @@ package sovereign
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/errors"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -31,12 +33,14 @@ type ArgsOutgoingOperations struct {
 	SubscribedEvents []SubscribedEvent
 	DataCodec        DataCodecHandler
 	TopicsChecker    TopicsCheckerHandler
+	BatchConfig      config.OutGoingOperationsBatch
 }
 
 type outgoingOperations struct {
 	subscribedEvents []SubscribedEvent
 	dataCodec        DataCodecHandler
 	topicsChecker    TopicsCheckerHandler
+	batchConfig      config.OutGoingOperationsBatch
 }
 
 // TODO: We should create a common base functionality from this component. Similar behavior is also found in
@@ -56,10 +60,18 @@ func NewOutgoingOperationsFormatter(args ArgsOutgoingOperations) (*outgoingOpera
 		return nil, errors.ErrNilTopicsChecker
 	}
 
+	log.Debug("sovereign outgoing operations creator: received batch config",
+		"max size in bytes", args.BatchConfig.MaxSizeInBytes,
+		"max gas limit", args.BatchConfig.MaxGasLimit,
+		"gas limit per operation", args.BatchConfig.GasLimitPerOperation,
+		"gas limit per data byte", args.BatchConfig.GasLimitPerDataByte,
+	)
+
 	return &outgoingOperations{
 		subscribedEvents: args.SubscribedEvents,
 		dataCodec:        args.DataCodec,
 		topicsChecker:    args.TopicsChecker,
+		batchConfig:      args.BatchConfig,
 	}, nil
 }
 
@@ -102,35 +114,96 @@ func checkEmptyAddresses(addresses map[string]string) error {
 }
 
 // CreateOutgoingTxsData collects relevant outgoing events(based on subscribed addresses and topics) for bridge from the
-// logs and creates outgoing data that needs to be signed by validators to bridge tokens
-func (op *outgoingOperations) CreateOutgoingTxsData(logs []*data.LogData) ([][]byte, error) {
+// logs and creates outgoing data that needs to be signed by validators to bridge tokens and contract calls. Outgoing data is split in
+// batches, so that each batch fits in the configured size and gas limit. An invalid outgoing event fails the whole block,
+// since skipping it would lose the tokens already locked or burnt by the sovereign bridge contract for that operation.
+func (op *outgoingOperations) CreateOutgoingTxsData(logs []*data.LogData) ([][][]byte, error) {
 	outgoingEvents := op.createOutgoingEvents(logs)
 	if len(outgoingEvents) == 0 {
-		return make([][]byte, 0), nil
+		return make([][][]byte, 0), nil
 	}
 
 	txsData := make([][]byte, 0)
-	for i, event := range outgoingEvents {
-		operation, err := op.getOperationData(event)
+	for _, outEvent := range outgoingEvents {
+		operation, err := op.createOperation(outEvent.event)
 		if err != nil {
-			log.Error("outgoingOperations.CreateOutgoingTxsData error",
-				"tx hash", logs[i].TxHash,
-				"event", string(event.GetIdentifier()),
+			log.Error("outgoingOperations.CreateOutgoingTxsData: invalid outgoing event",
+				"tx hash", []byte(outEvent.txHash),
+				"event", string(outEvent.event.GetIdentifier()),
 				"error", err)
 
+			return nil, fmt.Errorf("%w for outgoing event %s of tx hash %s",
+				err, outEvent.event.GetIdentifier(), hex.EncodeToString([]byte(outEvent.txHash)))
+		}
+
+		operationBytes, err := op.dataCodec.SerializeOperation(*operation)
+		if err != nil {
 			return nil, err
 		}
 
-		txsData = append(txsData, operation)
+		txsData = append(txsData, operationBytes)
+	}
+
+	return splitInBatches(txsData, op.batchConfig), nil
+}
+
+// splitInBatches splits the outgoing operations in batches, keeping their order, so that each batch fits in the
+// configured size and gas limit
+func splitInBatches(txsData [][]byte, batchConfig config.OutGoingOperationsBatch) [][][]byte {
+	batches := make([][][]byte, 0)
+	currBatch := make([][]byte, 0)
+	currBatchSize := uint64(0)
+	currBatchGasLimit := uint64(0)
+
+	for _, txData := range txsData {
+		txDataSize := uint64(len(txData))
+		txDataGasLimit := estimateGasLimit(txData, batchConfig)
+
+		if len(currBatch) > 0 && exceedsBatchLimits(currBatchSize+txDataSize, currBatchGasLimit+txDataGasLimit, batchConfig) {
+			batches = append(batches, currBatch)
+			currBatch = make([][]byte, 0)
+			currBatchSize = 0
+			currBatchGasLimit = 0
+		}
+
+		if exceedsBatchLimits(txDataSize, txDataGasLimit, batchConfig) {
+			log.Warn("outgoingOperations.splitInBatches: outgoing operation exceeds batch limits, will be bridged in a separate batch",
+				"size", txDataSize,
+				"estimated gas limit", txDataGasLimit,
+			)
+		}
+
+		currBatch = append(currBatch, txData)
+		currBatchSize += txDataSize
+		currBatchGasLimit += txDataGasLimit
+	}
+
+	batches = append(batches, currBatch)
+	if len(batches) > 1 {
+		log.Debug("outgoingOperations.splitInBatches", "num operations", len(txsData), "num batches", len(batches))
 	}
 
-	// TODO: Check gas limit here and split tx data in multiple batches if required
-	// Task: MX-14720
-	return txsData, nil
+	return batches
+}
+
+func estimateGasLimit(txData []byte, batchConfig config.OutGoingOperationsBatch) uint64 {
+	return batchConfig.GasLimitPerOperation + batchConfig.GasLimitPerDataByte*uint64(len(txData))
 }
 
-func (op *outgoingOperations) createOutgoingEvents(logs []*data.LogData) []data.EventHandler {
-	events := make([]data.EventHandler, 0)
+func exceedsBatchLimits(size uint64, gasLimit uint64, batchConfig config.OutGoingOperationsBatch) bool {
+	exceedsSize := batchConfig.MaxSizeInBytes != 0 && size > batchConfig.MaxSizeInBytes
+	exceedsGasLimit := batchConfig.MaxGasLimit != 0 && gasLimit > batchConfig.MaxGasLimit
+
+	return exceedsSize || exceedsGasLimit
+}
+
+type outgoingEvent struct {
+	event  data.EventHandler
+	txHash string
+}
+
+func (op *outgoingOperations) createOutgoingEvents(logs []*data.LogData) []*outgoingEvent {
+	events := make([]*outgoingEvent, 0)
 
 	for _, logData := range logs {
 		eventsFromLog := op.createOutgoingEvent(logData)
@@ -140,15 +213,18 @@ func (op *outgoingOperations) createOutgoingEvents(logs []*data.LogData) []data.
 	return events
 }
 
-func (op *outgoingOperations) createOutgoingEvent(logData *data.LogData) []data.EventHandler {
-	events := make([]data.EventHandler, 0)
+func (op *outgoingOperations) createOutgoingEvent(logData *data.LogData) []*outgoingEvent {
+	events := make([]*outgoingEvent, 0)
 
 	for _, event := range logData.GetLogEvents() {
 		if !op.isSubscribed(event, logData.TxHash) {
 			continue
 		}
 
-		events = append(events, event)
+		events = append(events, &outgoingEvent{
+			event:  event,
+			txHash: logData.TxHash,
+		})
 	}
 
 	return events
@@ -173,7 +249,7 @@ func (op *outgoingOperations) isSubscribed(event data.EventHandler, txHash strin
 	return false
 }
 
-func (op *outgoingOperations) getOperationData(event data.EventHandler) ([]byte, error) {
+func (op *outgoingOperations) createOperation(event data.EventHandler) (*sovereign.Operation, error) {
 	operation, err := op.createOperationData(event.GetTopics())
 	if err != nil {
 		return nil, err
@@ -185,13 +261,17 @@ func (op *outgoingOperations) getOperationData(event data.EventHandler) ([]byte,
 	}
 
 	operation.Data = evData
-
-	operationBytes, err := op.dataCodec.SerializeOperation(*operation)
-	if err != nil {
-		return nil, err
+	if len(operation.Tokens) == 0 && !hasContractCall(evData) {
+		return nil, errEmptyMessageCall
 	}
 
-	return operationBytes, nil
+	return operation, nil
+}
+
+// hasContractCall checks if the event data holds a contract call. Message operations, which transfer no tokens, are
+// only bridged if they carry a contract call for the receiver.
+func hasContractCall(evData *sovereign.EventData) bool {
+	return evData.TransferData != nil && len(evData.TransferData.Function) != 0
 }
 
 func (op *outgoingOperations) createOperationData(topics [][]byte) (*sovereign.Operation, error) {
@@ -3,17 +3,227 @@ package sovereign
 import (
 	"fmt"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/state"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	hashingFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 )
 
+// DefaultOutGoingCodec is the name of the codec used by the outgoing destinations which do not configure one
+const DefaultOutGoingCodec = "abi"
+
+// OutGoingCodec holds the data codec and the topics checker used to create the outgoing operations of a destination
+type OutGoingCodec struct {
+	DataCodec     DataCodecHandler
+	TopicsChecker TopicsCheckerHandler
+}
+
+// CreateOutgoingOperationsRouter creates an outgoing operations router for the default destination, defined by the
+// subscribed events from the outgoing config, and for all the named destinations from the outgoing config. Each
+// destination uses the codec with its configured name from the provided codecs, the default destination using the
+// DefaultOutGoingCodec. The state of its rate limits is saved in the system account of the provided accounts adapter.
+func CreateOutgoingOperationsRouter(
+	outgoingConfig config.OutgoingSubscribedEvents,
+	operationsHasher hashing.Hasher,
+	pubKeyConverter core.PubkeyConverter,
+	codecs map[string]OutGoingCodec,
+	incomingChains []config.IncomingChain,
+	appStatusHandler core.AppStatusHandler,
+	accounts state.AccountsAdapter,
+) (OutgoingOperationsRouter, error) {
+	err := checkDuplicateSubscribedEvents(outgoingConfig, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultCodec, err := getOutGoingCodec(codecs, DefaultOutGoingCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimiter, err := NewBridgeRateLimiter(ArgsBridgeRateLimiter{
+		Config:           outgoingConfig.RateLimits,
+		Accounts:         accounts,
+		Marshaller:       &marshal.JsonMarshalizer{},
+		StateKeyPrefix:   common.BridgeOutGoingRateLimitsKeyPrefix,
+		AppStatusHandler: appStatusHandler,
+		LimitedOpsMetric: common.MetricNumRateLimitedOutGoingOperations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	incomingChainIDs := make(map[string]struct{}, len(incomingChains))
+	for _, incomingChain := range incomingChains {
+		incomingChainIDs[incomingChain.ChainID] = struct{}{}
+	}
+
+	destinations := make([]OutGoingDestination, 0, len(outgoingConfig.Destinations)+1)
+	if len(outgoingConfig.SubscribedEvents) != 0 {
+		formatter, errCreate := CreateOutgoingOperationsFormatter(outgoingConfig.SubscribedEvents, pubKeyConverter, defaultCodec.DataCodec, defaultCodec.TopicsChecker, outgoingConfig.Batch)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		destinations = append(destinations, OutGoingDestination{
+			Name:      "",
+			Formatter: formatter,
+			Hasher:    operationsHasher,
+			DataCodec: defaultCodec.DataCodec,
+		})
+	}
+
+	for _, destinationConfig := range outgoingConfig.Destinations {
+		destination, errCreate := createOutGoingDestination(destinationConfig, incomingChainIDs, operationsHasher, pubKeyConverter, codecs, outgoingConfig.Batch)
+		if errCreate != nil {
+			return nil, fmt.Errorf("%w for destination %s", errCreate, destinationConfig.Name)
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return NewOutgoingOperationsRouter(ArgsOutgoingOperationsRouter{
+		Destinations:     destinations,
+		OperationsHasher: operationsHasher,
+		RateLimiter:      rateLimiter,
+		BatchConfig:      outgoingConfig.Batch,
+	})
+}
+
+func getOutGoingCodec(codecs map[string]OutGoingCodec, name string) (OutGoingCodec, error) {
+	codec, found := codecs[name]
+	if !found {
+		return OutGoingCodec{}, fmt.Errorf("%w: %s", errUnknownOutGoingCodec, name)
+	}
+	if check.IfNil(codec.DataCodec) {
+		return OutGoingCodec{}, fmt.Errorf("%w for codec %s", errors.ErrNilDataCodec, name)
+	}
+	if check.IfNil(codec.TopicsChecker) {
+		return OutGoingCodec{}, fmt.Errorf("%w for codec %s", errors.ErrNilTopicsChecker, name)
+	}
+
+	return codec, nil
+}
+
+func createOutGoingDestination(
+	destinationConfig config.OutGoingDestination,
+	incomingChainIDs map[string]struct{},
+	operationsHasher hashing.Hasher,
+	pubKeyConverter core.PubkeyConverter,
+	codecs map[string]OutGoingCodec,
+	batchConfig config.OutGoingOperationsBatch,
+) (OutGoingDestination, error) {
+	if len(destinationConfig.Name) == 0 {
+		return OutGoingDestination{}, errEmptyOutGoingDestinationName
+	}
+	if len(destinationConfig.ChainID) != 0 {
+		if _, found := incomingChainIDs[destinationConfig.ChainID]; !found {
+			return OutGoingDestination{}, fmt.Errorf("%w: %s", errUnknownDestinationChainID, destinationConfig.ChainID)
+		}
+	}
+
+	codecName := destinationConfig.Codec
+	if len(codecName) == 0 {
+		codecName = DefaultOutGoingCodec
+	}
+	codec, err := getOutGoingCodec(codecs, codecName)
+	if err != nil {
+		return OutGoingDestination{}, err
+	}
+
+	formatter, err := CreateOutgoingOperationsFormatter(destinationConfig.SubscribedEvents, pubKeyConverter, codec.DataCodec, codec.TopicsChecker, batchConfig)
+	if err != nil {
+		return OutGoingDestination{}, err
+	}
+
+	hasher := operationsHasher
+	if len(destinationConfig.Bridge.Hasher) != 0 {
+		hasher, err = hashingFactory.NewHasher(destinationConfig.Bridge.Hasher)
+		if err != nil {
+			return OutGoingDestination{}, err
+		}
+	}
+
+	return OutGoingDestination{
+		Name:      destinationConfig.Name,
+		ChainID:   destinationConfig.ChainID,
+		Formatter: formatter,
+		Hasher:    hasher,
+		DataCodec: codec.DataCodec,
+	}, nil
+}
+
+// CreateOutGoingOperationsHashers creates all the distinct hashers used for outgoing operations: the default operations
+// hasher, followed by the hashers configured for the named destinations. Hashers which are not configured are skipped, so
+// no hasher is returned for a config without outgoing bridge (e.g. a main chain node).
+func CreateOutGoingOperationsHashers(sovereignConfig config.SovereignConfig) ([]hashing.Hasher, error) {
+	hasherTypes := []string{sovereignConfig.OutGoingBridge.Hasher}
+	for _, destinationConfig := range sovereignConfig.OutgoingSubscribedEvents.Destinations {
+		hasherTypes = append(hasherTypes, destinationConfig.Bridge.Hasher)
+	}
+
+	hashers := make([]hashing.Hasher, 0, len(hasherTypes))
+	createdHashers := make(map[string]struct{})
+	for _, hasherType := range hasherTypes {
+		if len(hasherType) == 0 {
+			continue
+		}
+		if _, found := createdHashers[hasherType]; found {
+			continue
+		}
+
+		hasher, err := hashingFactory.NewHasher(hasherType)
+		if err != nil {
+			return nil, err
+		}
+
+		hashers = append(hashers, hasher)
+		createdHashers[hasherType] = struct{}{}
+	}
+
+	return hashers, nil
+}
+
+// checkDuplicateSubscribedEvents checks that each subscribed event identifier and address pair is routed to only one
+// destination, otherwise the same event would be bridged multiple times
+func checkDuplicateSubscribedEvents(outgoingConfig config.OutgoingSubscribedEvents, pubKeyConverter core.PubkeyConverter) error {
+	allEvents := append([]config.SubscribedEvent{}, outgoingConfig.SubscribedEvents...)
+	for _, destinationConfig := range outgoingConfig.Destinations {
+		allEvents = append(allEvents, destinationConfig.SubscribedEvents...)
+	}
+
+	subscribedEvents := make(map[string]struct{})
+	for _, event := range allEvents {
+		for _, encodedAddr := range event.Addresses {
+			decodedAddr, err := pubKeyConverter.Decode(encodedAddr)
+			if err != nil {
+				return err
+			}
+
+			key := event.Identifier + string(decodedAddr)
+			if _, exists := subscribedEvents[key]; exists {
+				return fmt.Errorf("%w, identifier = %s, address = %s", errDuplicateSubscribedEvent, event.Identifier, encodedAddr)
+			}
+			subscribedEvents[key] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
 // CreateOutgoingOperationsFormatter creates an outgoing operations formatter
 func CreateOutgoingOperationsFormatter(
 	events []config.SubscribedEvent,
 	pubKeyConverter core.PubkeyConverter,
 	dataCodec DataCodecHandler,
 	topicsChecker TopicsCheckerHandler,
+	batchConfig config.OutGoingOperationsBatch,
 ) (OutgoingOperationsFormatter, error) {
 	subscribedEvents, err := getSubscribedEvents(events, pubKeyConverter)
 	if err != nil {
@@ -24,6 +234,7 @@ func CreateOutgoingOperationsFormatter(
 		SubscribedEvents: subscribedEvents,
 		DataCodec:        dataCodec,
 		TopicsChecker:    topicsChecker,
+		BatchConfig:      batchConfig,
 	}
 
 	return NewOutgoingOperationsFormatter(args)
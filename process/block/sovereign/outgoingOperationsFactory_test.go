@@ -0,0 +1,254 @@
+package sovereign
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	hashingFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/config"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
+)
+
+func createOutgoingSubscribedEventsConfig() config.OutgoingSubscribedEvents {
+	return config.OutgoingSubscribedEvents{
+		SubscribedEvents: []config.SubscribedEvent{
+			{
+				Identifier: "deposit",
+				Addresses:  []string{"aa"},
+			},
+		},
+		Destinations: []config.OutGoingDestination{
+			{
+				Name: "messaging",
+				SubscribedEvents: []config.SubscribedEvent{
+					{
+						Identifier: "sendMessage",
+						Addresses:  []string{"bb"},
+					},
+				},
+				Bridge: config.OutGoingBridge{
+					Hasher: "keccak",
+				},
+			},
+		},
+	}
+}
+
+func createOutGoingCodecs() map[string]OutGoingCodec {
+	return map[string]OutGoingCodec{
+		DefaultOutGoingCodec: {
+			DataCodec:     &sovTests.DataCodecMock{},
+			TopicsChecker: &sovTests.TopicsCheckerMock{},
+		},
+	}
+}
+
+func createOutgoingOperationsRouterFromConfig(outgoingConfig config.OutgoingSubscribedEvents) (OutgoingOperationsRouter, error) {
+	return createOutgoingOperationsRouterWithCodecs(outgoingConfig, createOutGoingCodecs())
+}
+
+func createOutgoingOperationsRouterWithCodecs(outgoingConfig config.OutgoingSubscribedEvents, codecs map[string]OutGoingCodec) (OutgoingOperationsRouter, error) {
+	return CreateOutgoingOperationsRouter(
+		outgoingConfig,
+		sha256.NewSha256(),
+		testscommon.NewPubkeyConverterMock(32),
+		codecs,
+		[]config.IncomingChain{{ChainID: "sov2"}},
+		&statusHandler.AppStatusHandlerStub{},
+		&stateMock.AccountsStub{},
+	)
+}
+
+func TestCreateOutgoingOperationsRouter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("duplicate subscribed event across destinations, should return error", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].SubscribedEvents[0].Identifier = "deposit"
+		outgoingConfig.Destinations[0].SubscribedEvents[0].Addresses = []string{"aa"}
+		router, err := createOutgoingOperationsRouterFromConfig(outgoingConfig)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errDuplicateSubscribedEvent)
+	})
+
+	t.Run("empty destination name, should return error", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].Name = ""
+		router, err := createOutgoingOperationsRouterFromConfig(outgoingConfig)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errEmptyOutGoingDestinationName)
+	})
+
+	t.Run("invalid destination hasher size, should return error", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].Bridge.Hasher = "blake2b"
+		router, err := CreateOutgoingOperationsRouter(
+			outgoingConfig,
+			&testscommon.HasherStub{SizeCalled: func() int { return 16 }},
+			testscommon.NewPubkeyConverterMock(32),
+			createOutGoingCodecs(),
+			nil,
+			&statusHandler.AppStatusHandlerStub{},
+			&stateMock.AccountsStub{},
+		)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errInvalidDestinationHasherSize)
+	})
+
+	t.Run("nil accounts adapter, should return error", func(t *testing.T) {
+		router, err := CreateOutgoingOperationsRouter(
+			createOutgoingSubscribedEventsConfig(),
+			sha256.NewSha256(),
+			testscommon.NewPubkeyConverterMock(32),
+			createOutGoingCodecs(),
+			nil,
+			&statusHandler.AppStatusHandlerStub{},
+			nil,
+		)
+		require.Nil(t, router)
+		require.Equal(t, errorsMx.ErrNilAccountsAdapter, err)
+	})
+
+	t.Run("invalid rate limits, should return error", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.RateLimits.TokenVolumeLimits = []config.TokenVolumeLimit{{Identifier: "TKN", VolumeLimit: "invalid"}}
+		router, err := createOutgoingOperationsRouterFromConfig(outgoingConfig)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errInvalidVolumeLimit)
+	})
+
+	t.Run("unknown destination chain id, should return error", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].ChainID = "sov3"
+		router, err := createOutgoingOperationsRouterFromConfig(outgoingConfig)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errUnknownDestinationChainID)
+	})
+
+	t.Run("destination to incoming chain, should work", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].ChainID = "sov2"
+		router, err := createOutgoingOperationsRouterFromConfig(outgoingConfig)
+		require.Nil(t, err)
+		require.False(t, router.IsInterfaceNil())
+	})
+
+	t.Run("no subscribed events, should return error", func(t *testing.T) {
+		router, err := createOutgoingOperationsRouterFromConfig(config.OutgoingSubscribedEvents{})
+		require.Nil(t, router)
+		require.Equal(t, errNoOutGoingDestination, err)
+	})
+
+	t.Run("only named destinations, should work", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.SubscribedEvents = nil
+		router, err := createOutgoingOperationsRouterFromConfig(outgoingConfig)
+		require.Nil(t, err)
+		require.False(t, router.IsInterfaceNil())
+	})
+
+	t.Run("no default codec, should return error", func(t *testing.T) {
+		router, err := createOutgoingOperationsRouterWithCodecs(createOutgoingSubscribedEventsConfig(), nil)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errUnknownOutGoingCodec)
+	})
+
+	t.Run("nil codec components, should return error", func(t *testing.T) {
+		codecs := createOutGoingCodecs()
+		codecs["messaging"] = OutGoingCodec{TopicsChecker: &sovTests.TopicsCheckerMock{}}
+		codecs["other"] = OutGoingCodec{DataCodec: &sovTests.DataCodecMock{}}
+
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].Codec = "messaging"
+		router, err := createOutgoingOperationsRouterWithCodecs(outgoingConfig, codecs)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errorsMx.ErrNilDataCodec)
+
+		outgoingConfig.Destinations[0].Codec = "other"
+		router, err = createOutgoingOperationsRouterWithCodecs(outgoingConfig, codecs)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errorsMx.ErrNilTopicsChecker)
+	})
+
+	t.Run("unknown destination codec, should return error", func(t *testing.T) {
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].Codec = "unknown"
+		router, err := createOutgoingOperationsRouterFromConfig(outgoingConfig)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errUnknownOutGoingCodec)
+	})
+
+	t.Run("destination codec, should be used by the destination", func(t *testing.T) {
+		messagingCodec := &sovTests.DataCodecMock{}
+		codecs := createOutGoingCodecs()
+		codecs["messaging"] = OutGoingCodec{
+			DataCodec:     messagingCodec,
+			TopicsChecker: &sovTests.TopicsCheckerMock{},
+		}
+
+		outgoingConfig := createOutgoingSubscribedEventsConfig()
+		outgoingConfig.Destinations[0].Codec = "messaging"
+		router, err := createOutgoingOperationsRouterWithCodecs(outgoingConfig, codecs)
+		require.Nil(t, err)
+
+		destinations := router.(*outgoingOperationsRouter).destinations
+		require.Len(t, destinations, 2)
+		require.True(t, destinations[0].DataCodec == codecs[DefaultOutGoingCodec].DataCodec)
+		require.True(t, destinations[1].DataCodec == messagingCodec)
+		require.True(t, destinations[1].Formatter.(*outgoingOperations).dataCodec == messagingCodec)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		router, err := createOutgoingOperationsRouterFromConfig(createOutgoingSubscribedEventsConfig())
+		require.Nil(t, err)
+		require.False(t, router.IsInterfaceNil())
+	})
+}
+
+func TestCreateOutGoingOperationsHashers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no hasher configured should return no hasher", func(t *testing.T) {
+		hashers, err := CreateOutGoingOperationsHashers(config.SovereignConfig{})
+		require.Nil(t, err)
+		require.Empty(t, hashers)
+	})
+	t.Run("invalid hasher should error", func(t *testing.T) {
+		sovConfig := config.SovereignConfig{
+			OutGoingBridge: config.OutGoingBridge{Hasher: "sha256"},
+			OutgoingSubscribedEvents: config.OutgoingSubscribedEvents{
+				Destinations: []config.OutGoingDestination{
+					{Name: "messaging", Bridge: config.OutGoingBridge{Hasher: "invalid"}},
+				},
+			},
+		}
+
+		hashers, err := CreateOutGoingOperationsHashers(sovConfig)
+		require.Equal(t, hashingFactory.ErrNoHasherInConfig, err)
+		require.Nil(t, hashers)
+	})
+	t.Run("should create distinct hashers", func(t *testing.T) {
+		sovConfig := config.SovereignConfig{
+			OutGoingBridge: config.OutGoingBridge{Hasher: "sha256"},
+			OutgoingSubscribedEvents: config.OutgoingSubscribedEvents{
+				Destinations: []config.OutGoingDestination{
+					{Name: "tokens"},
+					{Name: "messaging", Bridge: config.OutGoingBridge{Hasher: "keccak"}},
+					{Name: "other", Bridge: config.OutGoingBridge{Hasher: "sha256"}},
+				},
+			},
+		}
+
+		hashers, err := CreateOutGoingOperationsHashers(sovConfig)
+		require.Nil(t, err)
+		require.Equal(t, []hashing.Hasher{sha256.NewSha256(), keccak.NewKeccak()}, hashers)
+	})
+}
@@ -0,0 +1,323 @@
+package sovereign
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+// OutGoingDestination holds a named destination to which subscribed outgoing events are routed. The default
+// destination has an empty name. An empty chain id means the destination is on the main chain, otherwise it is the id of
+// the incoming chain to which the operations are bridged. The data codec is the one used by the destination formatter
+// to serialize the operations.
+type OutGoingDestination struct {
+	Name      string
+	ChainID   string
+	Formatter OutgoingOperationsFormatter
+	Hasher    hashing.Hasher
+	DataCodec DataCodecHandler
+}
+
+// OutGoingDestinationBatches holds the outgoing operations batches created for a destination, together with the hasher
+// which should be used for the destination operations
+type OutGoingDestinationBatches struct {
+	Destination string
+	ChainID     string
+	Hasher      hashing.Hasher
+	Batches     [][][]byte
+}
+
+// ArgsOutgoingOperationsRouter holds the arguments needed to create an outgoing operations router
+type ArgsOutgoingOperationsRouter struct {
+	Destinations     []OutGoingDestination
+	OperationsHasher hashing.Hasher
+	RateLimiter      BridgeRateLimiter
+	BatchConfig      config.OutGoingOperationsBatch
+}
+
+type outgoingOperationsRouter struct {
+	destinations []OutGoingDestination
+	dataCodecs   map[string]DataCodecHandler
+	rateLimiter  BridgeRateLimiter
+	batchConfig  config.OutGoingOperationsBatch
+}
+
+// NewOutgoingOperationsRouter creates an outgoing operations router, which routes each subscribed outgoing event to its
+// destination, so that operations for different main chain bridge contracts are batched, signed and confirmed
+// independently. All destinations hashers should have the same size as the operations hasher, since the outgoing
+// operations hash from the header holds all the batches hashes of all destinations, concatenated.
+func NewOutgoingOperationsRouter(args ArgsOutgoingOperationsRouter) (*outgoingOperationsRouter, error) {
+	if check.IfNil(args.OperationsHasher) {
+		return nil, errors.ErrNilOperationsHasher
+	}
+	if check.IfNil(args.RateLimiter) {
+		return nil, errors.ErrNilBridgeRateLimiter
+	}
+
+	err := checkDestinations(args.Destinations, args.OperationsHasher.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	dataCodecs := make(map[string]DataCodecHandler, len(args.Destinations))
+	for _, destination := range args.Destinations {
+		dataCodecs[destination.Name] = destination.DataCodec
+	}
+
+	return &outgoingOperationsRouter{
+		destinations: args.Destinations,
+		dataCodecs:   dataCodecs,
+		rateLimiter:  args.RateLimiter,
+		batchConfig:  args.BatchConfig,
+	}, nil
+}
+
+func checkDestinations(destinations []OutGoingDestination, hashSize int) error {
+	if len(destinations) == 0 {
+		return errNoOutGoingDestination
+	}
+
+	names := make(map[string]struct{}, len(destinations))
+	for idx, destination := range destinations {
+		if _, exists := names[destination.Name]; exists {
+			return fmt.Errorf("%w: %s", errDuplicateOutGoingDestination, destination.Name)
+		}
+		names[destination.Name] = struct{}{}
+
+		if check.IfNil(destination.Formatter) {
+			return fmt.Errorf("%w at destination index = %d", errors.ErrNilOutgoingOperationsFormatter, idx)
+		}
+		if check.IfNil(destination.Hasher) {
+			return fmt.Errorf("%w at destination index = %d", errors.ErrNilOperationsHasher, idx)
+		}
+		if check.IfNil(destination.DataCodec) {
+			return fmt.Errorf("%w at destination index = %d", errors.ErrNilDataCodec, idx)
+		}
+		if destination.Hasher.Size() != hashSize {
+			return fmt.Errorf("%w for destination %s, hash size = %d, expected hash size = %d",
+				errInvalidDestinationHasherSize, destination.Name, destination.Hasher.Size(), hashSize)
+		}
+
+		log.Debug("sovereign outgoing operations router: added destination", "name", destination.Name, "chainID", destination.ChainID)
+	}
+
+	return nil
+}
+
+// CreateOutgoingTxsData creates the outgoing operations batches for each destination, in the destinations order. Only
+// destinations with at least one outgoing operation are returned. If rate limits are enabled, operations exceeding them
+// are queued and bridged in the next blocks, once they fit in the limits. Operations held back while the outgoing bridge
+// was paused are bridged first.
+func (router *outgoingOperationsRouter) CreateOutgoingTxsData(logs []*data.LogData, header data.HeaderHandler) ([]*OutGoingDestinationBatches, error) {
+	destinationsBatches, err := router.createDestinationsBatchesFromLogs(logs)
+	if err != nil {
+		return nil, err
+	}
+
+	if !router.rateLimiter.IsEnabled() {
+		return router.releaseHeldOperations(destinationsBatches)
+	}
+
+	return router.applyRateLimits(destinationsBatches, header)
+}
+
+// HoldOutgoingTxsData queues all the outgoing operations from the logs, without creating any batch, so that they are
+// bridged in the next blocks created after the outgoing bridge is resumed
+func (router *outgoingOperationsRouter) HoldOutgoingTxsData(logs []*data.LogData, header data.HeaderHandler) error {
+	destinationsBatches, err := router.createDestinationsBatchesFromLogs(logs)
+	if err != nil {
+		return err
+	}
+
+	return router.holdDestinationsBatches(destinationsBatches, header)
+}
+
+// holdDestinationsBatches queues all the operations of the provided destinations batches, in order. The token volumes of
+// the operations are only needed if rate limits are enabled.
+func (router *outgoingOperationsRouter) holdDestinationsBatches(destinationsBatches []*OutGoingDestinationBatches, header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return data.ErrNilHeader
+	}
+
+	var operations []*BridgeOperationVolume
+	var err error
+	if router.rateLimiter.IsEnabled() {
+		operations, err = router.createOperationsVolumes(destinationsBatches)
+		if err != nil {
+			return err
+		}
+	} else {
+		operations = createOperationsWithoutVolumes(destinationsBatches)
+	}
+
+	if len(operations) > 0 {
+		log.Debug("outgoingOperationsRouter.HoldOutgoingTxsData outgoing bridge is paused, held outgoing operations",
+			"nonce", header.GetNonce(),
+			"num held", len(operations),
+		)
+	}
+
+	return router.rateLimiter.QueueOperations(core.SovereignChainShardId, header.GetEpoch(), operations)
+}
+
+func (router *outgoingOperationsRouter) createDestinationsBatchesFromLogs(logs []*data.LogData) ([]*OutGoingDestinationBatches, error) {
+	destinationsBatches := make([]*OutGoingDestinationBatches, 0)
+	for _, destination := range router.destinations {
+		batches, err := destination.Formatter.CreateOutgoingTxsData(logs)
+		if err != nil {
+			return nil, fmt.Errorf("%w for destination %s", err, destination.Name)
+		}
+
+		if len(batches) == 0 {
+			continue
+		}
+
+		destinationsBatches = append(destinationsBatches, &OutGoingDestinationBatches{
+			Destination: destination.Name,
+			ChainID:     destination.ChainID,
+			Hasher:      destination.Hasher,
+			Batches:     batches,
+		})
+	}
+
+	return destinationsBatches, nil
+}
+
+// releaseHeldOperations bridges the operations held back while the outgoing bridge was paused before the operations of
+// the current block. With rate limits enabled, the held operations are released by the rate limiter instead.
+func (router *outgoingOperationsRouter) releaseHeldOperations(destinationsBatches []*OutGoingDestinationBatches) ([]*OutGoingDestinationBatches, error) {
+	heldOperations, err := router.rateLimiter.ReleaseQueuedOperations(core.SovereignChainShardId)
+	if err != nil {
+		return nil, err
+	}
+	if len(heldOperations) == 0 {
+		return destinationsBatches, nil
+	}
+
+	log.Debug("outgoingOperationsRouter.CreateOutgoingTxsData released held outgoing operations", "num released", len(heldOperations))
+
+	operations := append(heldOperations, createOperationsWithoutVolumes(destinationsBatches)...)
+	return router.createDestinationsBatches(operations), nil
+}
+
+func (router *outgoingOperationsRouter) applyRateLimits(
+	destinationsBatches []*OutGoingDestinationBatches,
+	header data.HeaderHandler,
+) ([]*OutGoingDestinationBatches, error) {
+	if check.IfNil(header) {
+		return nil, data.ErrNilHeader
+	}
+
+	operations, err := router.createOperationsVolumes(destinationsBatches)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := router.rateLimiter.CheckOperations(core.SovereignChainShardId, header.GetEpoch(), operations)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Queued) > 0 {
+		log.Debug("outgoingOperationsRouter.CreateOutgoingTxsData rate limited outgoing operations",
+			"nonce", header.GetNonce(),
+			"num admitted", len(result.Admitted),
+			"num queued", len(result.Queued),
+		)
+	}
+
+	return router.createDestinationsBatches(result.Admitted), nil
+}
+
+func (router *outgoingOperationsRouter) createOperationsVolumes(destinationsBatches []*OutGoingDestinationBatches) ([]*BridgeOperationVolume, error) {
+	operations := make([]*BridgeOperationVolume, 0)
+	for _, destinationBatches := range destinationsBatches {
+		for _, batch := range destinationBatches.Batches {
+			for _, operationData := range batch {
+				operationVolume, err := router.createOperationVolume(operationData, destinationBatches)
+				if err != nil {
+					return nil, err
+				}
+
+				operations = append(operations, operationVolume)
+			}
+		}
+	}
+
+	return operations, nil
+}
+
+func createOperationsWithoutVolumes(destinationsBatches []*OutGoingDestinationBatches) []*BridgeOperationVolume {
+	operations := make([]*BridgeOperationVolume, 0)
+	for _, destinationBatches := range destinationsBatches {
+		for _, batch := range destinationBatches.Batches {
+			for _, operationData := range batch {
+				operations = append(operations, &BridgeOperationVolume{
+					Hash:        destinationBatches.Hasher.Compute(string(operationData)),
+					Data:        operationData,
+					Destination: destinationBatches.Destination,
+				})
+			}
+		}
+	}
+
+	return operations
+}
+
+func (router *outgoingOperationsRouter) createOperationVolume(operationData []byte, destinationBatches *OutGoingDestinationBatches) (*BridgeOperationVolume, error) {
+	operation, err := router.dataCodecs[destinationBatches.Destination].DeserializeOperation(operationData)
+	if err != nil {
+		return nil, fmt.Errorf("%w for destination %s", err, destinationBatches.Destination)
+	}
+
+	tokens := make([]*TokenVolume, 0, len(operation.Tokens))
+	for _, token := range operation.Tokens {
+		tokens = append(tokens, &TokenVolume{
+			Identifier: token.Identifier,
+			Amount:     token.Data.Amount,
+		})
+	}
+
+	return &BridgeOperationVolume{
+		Hash:        destinationBatches.Hasher.Compute(string(operationData)),
+		Data:        operationData,
+		Destination: destinationBatches.Destination,
+		Tokens:      tokens,
+	}, nil
+}
+
+// createDestinationsBatches groups the admitted operations by their destination, in the destinations order, and splits
+// them in batches again, since previously queued operations are bridged together with the operations of the current block
+func (router *outgoingOperationsRouter) createDestinationsBatches(admitted []*BridgeOperationVolume) []*OutGoingDestinationBatches {
+	destinationsOperations := make(map[string][][]byte)
+	for _, operation := range admitted {
+		destinationsOperations[operation.Destination] = append(destinationsOperations[operation.Destination], operation.Data)
+	}
+
+	destinationsBatches := make([]*OutGoingDestinationBatches, 0)
+	for _, destination := range router.destinations {
+		operations, found := destinationsOperations[destination.Name]
+		if !found {
+			continue
+		}
+
+		destinationsBatches = append(destinationsBatches, &OutGoingDestinationBatches{
+			Destination: destination.Name,
+			ChainID:     destination.ChainID,
+			Hasher:      destination.Hasher,
+			Batches:     splitInBatches(operations, router.batchConfig),
+		})
+	}
+
+	return destinationsBatches
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (router *outgoingOperationsRouter) IsInterfaceNil() bool {
+	return router == nil
+}
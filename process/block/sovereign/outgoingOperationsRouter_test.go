@@ -0,0 +1,323 @@
+package sovereign
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/config"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+)
+
+func createOutgoingOperationsRouterArgs() ArgsOutgoingOperationsRouter {
+	return ArgsOutgoingOperationsRouter{
+		Destinations: []OutGoingDestination{
+			{
+				Name:      "",
+				Formatter: &sovTests.OutgoingOperationsFormatterMock{},
+				Hasher:    sha256.NewSha256(),
+				DataCodec: &sovTests.DataCodecMock{},
+			},
+			{
+				Name:      "messaging",
+				Formatter: &sovTests.OutgoingOperationsFormatterMock{},
+				Hasher:    keccak.NewKeccak(),
+				DataCodec: &sovTests.DataCodecMock{},
+			},
+		},
+		OperationsHasher: sha256.NewSha256(),
+		RateLimiter:      createBridgeRateLimiter(config.BridgeRateLimits{}),
+	}
+}
+
+func TestNewOutgoingOperationsRouter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil operations hasher, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.OperationsHasher = nil
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.Equal(t, errorsMx.ErrNilOperationsHasher, err)
+	})
+
+	t.Run("nil rate limiter, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.RateLimiter = nil
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.Equal(t, errorsMx.ErrNilBridgeRateLimiter, err)
+	})
+
+	t.Run("nil destination data codec, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations[1].DataCodec = nil
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errorsMx.ErrNilDataCodec)
+	})
+
+	t.Run("no destination, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations = nil
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.Equal(t, errNoOutGoingDestination, err)
+	})
+
+	t.Run("duplicate destination, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations[1].Name = ""
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errDuplicateOutGoingDestination)
+	})
+
+	t.Run("nil formatter, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations[1].Formatter = nil
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errorsMx.ErrNilOutgoingOperationsFormatter)
+	})
+
+	t.Run("nil destination hasher, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations[1].Hasher = nil
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errorsMx.ErrNilOperationsHasher)
+	})
+
+	t.Run("invalid destination hasher size, should return error", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations[1].Hasher, _ = blake2b.NewBlake2bWithSize(16)
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, router)
+		require.ErrorIs(t, err, errInvalidDestinationHasherSize)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		router, err := NewOutgoingOperationsRouter(args)
+		require.Nil(t, err)
+		require.False(t, router.IsInterfaceNil())
+	})
+}
+
+func TestOutgoingOperationsRouter_CreateOutgoingTxsData(t *testing.T) {
+	t.Parallel()
+
+	logs := []*data.LogData{{TxHash: "txHash"}}
+
+	t.Run("should route logs to all destinations and skip the ones without operations", func(t *testing.T) {
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations[0].Formatter = &sovTests.OutgoingOperationsFormatterMock{
+			CreateOutgoingTxDataCalled: func(receivedLogs []*data.LogData) ([][][]byte, error) {
+				require.Equal(t, logs, receivedLogs)
+				return [][][]byte{{[]byte("op1"), []byte("op2")}}, nil
+			},
+		}
+		args.Destinations[1].Formatter = &sovTests.OutgoingOperationsFormatterMock{
+			CreateOutgoingTxDataCalled: func(receivedLogs []*data.LogData) ([][][]byte, error) {
+				require.Equal(t, logs, receivedLogs)
+				return [][][]byte{{[]byte("op3")}, {[]byte("op4")}}, nil
+			},
+		}
+		args.Destinations = append(args.Destinations, OutGoingDestination{
+			Name:      "empty",
+			Formatter: &sovTests.OutgoingOperationsFormatterMock{},
+			Hasher:    sha256.NewSha256(),
+			DataCodec: &sovTests.DataCodecMock{},
+		})
+		router, _ := NewOutgoingOperationsRouter(args)
+
+		destinationsBatches, err := router.CreateOutgoingTxsData(logs, &block.Header{})
+		require.Nil(t, err)
+		require.Equal(t, []*OutGoingDestinationBatches{
+			{
+				Destination: "",
+				Hasher:      args.Destinations[0].Hasher,
+				Batches:     [][][]byte{{[]byte("op1"), []byte("op2")}},
+			},
+			{
+				Destination: "messaging",
+				Hasher:      args.Destinations[1].Hasher,
+				Batches:     [][][]byte{{[]byte("op3")}, {[]byte("op4")}},
+			},
+		}, destinationsBatches)
+	})
+
+	t.Run("formatter error, should return error", func(t *testing.T) {
+		errFormatter := errors.New("formatter error")
+		args := createOutgoingOperationsRouterArgs()
+		args.Destinations[1].Formatter = &sovTests.OutgoingOperationsFormatterMock{
+			CreateOutgoingTxDataCalled: func(_ []*data.LogData) ([][][]byte, error) {
+				return nil, errFormatter
+			},
+		}
+		router, _ := NewOutgoingOperationsRouter(args)
+
+		destinationsBatches, err := router.CreateOutgoingTxsData(logs, &block.Header{})
+		require.Nil(t, destinationsBatches)
+		require.ErrorIs(t, err, errFormatter)
+		require.Contains(t, err.Error(), "messaging")
+	})
+}
+
+func TestOutgoingOperationsRouter_CreateOutgoingTxsDataWithRateLimits(t *testing.T) {
+	t.Parallel()
+
+	logs := []*data.LogData{{TxHash: "txHash"}}
+	amounts := map[string]int64{
+		"op1": 40,
+		"op2": 50,
+		"op3": 30,
+		"op4": 20,
+	}
+
+	args := createOutgoingOperationsRouterArgs()
+	accounts, storage := createSystemAccountStorage()
+	args.RateLimiter = createBridgeRateLimiterWithAccounts(config.BridgeRateLimits{
+		Enabled: true,
+		TokenVolumeLimits: []config.TokenVolumeLimit{
+			{
+				Identifier:  "TKN",
+				VolumeLimit: "100",
+			},
+		},
+	}, accounts)
+	args.Destinations[0].DataCodec = &sovTests.DataCodecMock{
+		DeserializeOperationCalled: func(data []byte) (*sovereign.Operation, error) {
+			return &sovereign.Operation{
+				Tokens: []sovereign.EsdtToken{
+					{
+						Identifier: []byte("TKN"),
+						Data:       sovereign.EsdtTokenData{Amount: big.NewInt(amounts[string(data)])},
+					},
+				},
+			}, nil
+		},
+	}
+
+	operationsPerBlock := [][][][]byte{
+		{{[]byte("op1"), []byte("op2")}, {[]byte("op3")}},
+		{{[]byte("op4")}},
+	}
+	blockIdx := 0
+	args.Destinations[0].Formatter = &sovTests.OutgoingOperationsFormatterMock{
+		CreateOutgoingTxDataCalled: func(_ []*data.LogData) ([][][]byte, error) {
+			return operationsPerBlock[blockIdx], nil
+		},
+	}
+	router, _ := NewOutgoingOperationsRouter(args)
+
+	destinationsBatches, err := router.CreateOutgoingTxsData(logs, &block.Header{Epoch: 1, Nonce: 1})
+	require.Nil(t, err)
+	require.Len(t, destinationsBatches, 1)
+	require.Equal(t, [][][]byte{{[]byte("op1"), []byte("op2")}}, destinationsBatches[0].Batches)
+
+	// same epoch, op3 is still queued and op4 is queued after it, to keep the operations order
+	blockIdx = 1
+	savedStorage := make(map[string][]byte)
+	for key, value := range storage {
+		savedStorage[key] = value
+	}
+	destinationsBatches, err = router.CreateOutgoingTxsData(logs, &block.Header{Epoch: 1, Nonce: 2})
+	require.Nil(t, err)
+	require.Empty(t, destinationsBatches)
+
+	// processing the same block again, on top of the reverted state, should not change the result
+	for key, value := range savedStorage {
+		storage[key] = value
+	}
+	destinationsBatches, err = router.CreateOutgoingTxsData(logs, &block.Header{Epoch: 1, Nonce: 2})
+	require.Nil(t, err)
+	require.Empty(t, destinationsBatches)
+
+	// new epoch, queued operations are bridged first
+	operationsPerBlock = append(operationsPerBlock, [][][]byte{})
+	blockIdx = 2
+	destinationsBatches, err = router.CreateOutgoingTxsData(logs, &block.Header{Epoch: 2, Nonce: 3})
+	require.Nil(t, err)
+	require.Len(t, destinationsBatches, 1)
+	require.Equal(t, [][][]byte{{[]byte("op3"), []byte("op4")}}, destinationsBatches[0].Batches)
+
+	// nil header should error if rate limits are enabled
+	destinationsBatches, err = router.CreateOutgoingTxsData(logs, nil)
+	require.Nil(t, destinationsBatches)
+	require.Equal(t, data.ErrNilHeader, err)
+}
+
+func TestOutgoingOperationsRouter_HoldOutgoingTxsData(t *testing.T) {
+	t.Parallel()
+
+	logs := []*data.LogData{{TxHash: "txHash"}}
+	operationsPerBlock := [][][][]byte{
+		{{[]byte("op1")}},
+		{{[]byte("op2")}},
+		{{[]byte("op4")}},
+	}
+	blockIdx := 0
+
+	args := createOutgoingOperationsRouterArgs()
+	args.Destinations[0].Formatter = &sovTests.OutgoingOperationsFormatterMock{
+		CreateOutgoingTxDataCalled: func(_ []*data.LogData) ([][][]byte, error) {
+			return operationsPerBlock[blockIdx], nil
+		},
+	}
+	args.Destinations[1].Formatter = &sovTests.OutgoingOperationsFormatterMock{
+		CreateOutgoingTxDataCalled: func(_ []*data.LogData) ([][][]byte, error) {
+			if blockIdx == 0 {
+				return [][][]byte{{[]byte("op3")}}, nil
+			}
+			return nil, nil
+		},
+	}
+	router, _ := NewOutgoingOperationsRouter(args)
+
+	err := router.HoldOutgoingTxsData(logs, nil)
+	require.Equal(t, data.ErrNilHeader, err)
+
+	// paused outgoing bridge, all operations are held back
+	err = router.HoldOutgoingTxsData(logs, &block.Header{Epoch: 1, Nonce: 1})
+	require.Nil(t, err)
+
+	// resumed outgoing bridge, held operations are bridged first, each to its destination
+	blockIdx = 1
+	destinationsBatches, err := router.CreateOutgoingTxsData(logs, &block.Header{Epoch: 1, Nonce: 2})
+	require.Nil(t, err)
+	require.Equal(t, []*OutGoingDestinationBatches{
+		{
+			Destination: "",
+			Hasher:      args.Destinations[0].Hasher,
+			Batches:     [][][]byte{{[]byte("op1"), []byte("op2")}},
+		},
+		{
+			Destination: "messaging",
+			Hasher:      args.Destinations[1].Hasher,
+			Batches:     [][][]byte{{[]byte("op3")}},
+		},
+	}, destinationsBatches)
+
+	// held operations are released only once
+	blockIdx = 2
+	destinationsBatches, err = router.CreateOutgoingTxsData(logs, &block.Header{Epoch: 1, Nonce: 3})
+	require.Nil(t, err)
+	require.Equal(t, []*OutGoingDestinationBatches{
+		{
+			Destination: "",
+			Hasher:      args.Destinations[0].Hasher,
+			Batches:     [][][]byte{{[]byte("op4")}},
+		},
+	}, destinationsBatches)
+}
@@ -1,10 +1,12 @@
 package sovereign
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/errors"
 	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
 
@@ -171,7 +173,7 @@ func TestOutgoingOperations_CreateOutgoingTxsDataErrorCases(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 0, len(outgoingTxData))
 	})
-	t.Run("deserialize token error", func(t *testing.T) {
+	t.Run("deserialize token error should fail", func(t *testing.T) {
 		t.Parallel()
 
 		outgoingOpsFormatter := createOutgoingOpsFormatter()
@@ -183,10 +185,10 @@ func TestOutgoingOperations_CreateOutgoingTxsDataErrorCases(t *testing.T) {
 		}
 
 		outgoingTxData, err := outgoingOpsFormatter.CreateOutgoingTxsData(logs)
+		require.ErrorIs(t, err, errDeserializeTokenData)
 		require.Nil(t, outgoingTxData)
-		require.Equal(t, errDeserializeTokenData, err)
 	})
-	t.Run("deserialize event error", func(t *testing.T) {
+	t.Run("deserialize event error should fail", func(t *testing.T) {
 		t.Parallel()
 
 		outgoingOpsFormatter := createOutgoingOpsFormatter()
@@ -198,8 +200,8 @@ func TestOutgoingOperations_CreateOutgoingTxsDataErrorCases(t *testing.T) {
 		}
 
 		outgoingTxData, err := outgoingOpsFormatter.CreateOutgoingTxsData(logs)
+		require.ErrorIs(t, err, errDeserializeEventData)
 		require.Nil(t, outgoingTxData)
-		require.Equal(t, errDeserializeEventData, err)
 	})
 	t.Run("serialize operation error", func(t *testing.T) {
 		t.Parallel()
@@ -216,7 +218,7 @@ func TestOutgoingOperations_CreateOutgoingTxsDataErrorCases(t *testing.T) {
 		require.Nil(t, outgoingTxData)
 		require.Equal(t, errSerializeOperation, err)
 	})
-	t.Run("check validity error", func(t *testing.T) {
+	t.Run("check validity error should fail", func(t *testing.T) {
 		t.Parallel()
 
 		outgoingOpsFormatter := createOutgoingOpsFormatter()
@@ -228,8 +230,52 @@ func TestOutgoingOperations_CreateOutgoingTxsDataErrorCases(t *testing.T) {
 		}
 
 		outgoingTxData, err := outgoingOpsFormatter.CreateOutgoingTxsData(logs)
+		require.ErrorIs(t, err, errInvalidTopics)
+		require.Nil(t, outgoingTxData)
+	})
+	t.Run("invalid event should fail the valid events as well", func(t *testing.T) {
+		t.Parallel()
+
+		validEvent := &transactionData.Event{
+			Address:    []byte("addr2"),
+			Identifier: []byte("deposit"),
+			Topics:     [][]byte{[]byte("deposit"), []byte("rcv")},
+			Data:       []byte("validData"),
+		}
+		mixedLogs := []*data.LogData{
+			{
+				LogHandler: &transactionData.Log{
+					Events: []*transactionData.Event{validEvent},
+				},
+				TxHash: "txHash",
+			},
+			{
+				LogHandler: logs[0].LogHandler,
+				TxHash:     "invalidTxHash",
+			},
+		}
+
+		errInvalidEventData := fmt.Errorf("deserialize event data error")
+		outgoingOpsFormatter := createOutgoingOpsFormatter()
+		outgoingOpsFormatter.dataCodec = &sovTests.DataCodecMock{
+			DeserializeEventDataCalled: func(data []byte) (*sovereign.EventData, error) {
+				if string(data) != "validData" {
+					return nil, errInvalidEventData
+				}
+
+				return &sovereign.EventData{
+					TransferData: &sovereign.TransferData{Function: []byte("func")},
+				}, nil
+			},
+			SerializeOperationCalled: func(operation sovereign.Operation) ([]byte, error) {
+				return operation.Address, nil
+			},
+		}
+
+		outgoingTxData, err := outgoingOpsFormatter.CreateOutgoingTxsData(mixedLogs)
+		require.ErrorIs(t, err, errInvalidEventData)
+		require.Contains(t, err.Error(), hex.EncodeToString([]byte("invalidTxHash")))
 		require.Nil(t, outgoingTxData)
-		require.Equal(t, errInvalidTopics, err)
 	})
 }
 
@@ -338,5 +384,123 @@ func TestOutgoingOperations_CreateOutgoingTxData(t *testing.T) {
 
 	outgoingTxData, err := opFormatter.CreateOutgoingTxsData(logs)
 	require.Nil(t, err)
-	require.Equal(t, [][]byte{operationBytes}, outgoingTxData)
+	require.Equal(t, [][][]byte{{operationBytes}}, outgoingTxData)
+}
+
+func TestOutgoingOperations_CreateOutgoingMessageData(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("addr")
+	receiver := []byte("rcv")
+	evData := &sovereign.EventData{
+		Nonce: 3,
+		TransferData: &sovereign.TransferData{
+			GasLimit: 20000000,
+			Function: []byte("call"),
+			Args:     [][]byte{[]byte("arg")},
+		},
+	}
+	logs := []*data.LogData{
+		{
+			LogHandler: &transactionData.Log{
+				Events: []*transactionData.Event{
+					{
+						Address:    addr,
+						Identifier: []byte("deposit"),
+						Topics:     [][]byte{[]byte("deposit"), receiver},
+						Data:       []byte("data"),
+					},
+				},
+			},
+		},
+	}
+
+	createFormatter := func(evData *sovereign.EventData) *outgoingOperations {
+		opFormatter, _ := NewOutgoingOperationsFormatter(ArgsOutgoingOperations{
+			SubscribedEvents: []SubscribedEvent{
+				{
+					Identifier: []byte("deposit"),
+					Addresses:  map[string]string{string(addr): string(addr)},
+				},
+			},
+			DataCodec: &sovTests.DataCodecMock{
+				DeserializeEventDataCalled: func(_ []byte) (*sovereign.EventData, error) {
+					return evData, nil
+				},
+				SerializeOperationCalled: func(operation sovereign.Operation) ([]byte, error) {
+					require.Equal(t, sovereign.Operation{
+						Address: receiver,
+						Tokens:  make([]sovereign.EsdtToken, 0),
+						Data:    evData,
+					}, operation)
+					return []byte("messageBytes"), nil
+				},
+			},
+			TopicsChecker: &sovTests.TopicsCheckerMock{},
+		})
+		return opFormatter
+	}
+
+	t.Run("message without contract call should fail", func(t *testing.T) {
+		t.Parallel()
+
+		outgoingTxData, err := createFormatter(&sovereign.EventData{Nonce: 3}).CreateOutgoingTxsData(logs)
+		require.ErrorIs(t, err, errEmptyMessageCall)
+		require.Nil(t, outgoingTxData)
+	})
+	t.Run("should create message operation", func(t *testing.T) {
+		t.Parallel()
+
+		outgoingTxData, err := createFormatter(evData).CreateOutgoingTxsData(logs)
+		require.Nil(t, err)
+		require.Equal(t, [][][]byte{{[]byte("messageBytes")}}, outgoingTxData)
+	})
+}
+
+func TestSplitInBatches(t *testing.T) {
+	t.Parallel()
+
+	op1 := []byte("op1")
+	op2 := []byte("op22")
+	op3 := []byte("op333")
+	txsData := [][]byte{op1, op2, op3}
+
+	t.Run("no limits, should create one batch", func(t *testing.T) {
+		t.Parallel()
+
+		require.Equal(t, [][][]byte{{op1, op2, op3}}, splitInBatches(txsData, config.OutGoingOperationsBatch{}))
+	})
+	t.Run("size limit, should create multiple batches", func(t *testing.T) {
+		t.Parallel()
+
+		batchConfig := config.OutGoingOperationsBatch{
+			MaxSizeInBytes: 7,
+		}
+		require.Equal(t, [][][]byte{{op1, op2}, {op3}}, splitInBatches(txsData, batchConfig))
+
+		batchConfig.MaxSizeInBytes = 3
+		require.Equal(t, [][][]byte{{op1}, {op2}, {op3}}, splitInBatches(txsData, batchConfig))
+	})
+	t.Run("gas limit, should create multiple batches", func(t *testing.T) {
+		t.Parallel()
+
+		batchConfig := config.OutGoingOperationsBatch{
+			MaxGasLimit:          250,
+			GasLimitPerOperation: 100,
+			GasLimitPerDataByte:  10,
+		}
+		// op1 = 130 gas, op2 = 140 gas, op3 = 150 gas
+		require.Equal(t, [][][]byte{{op1}, {op2}, {op3}}, splitInBatches(txsData, batchConfig))
+
+		batchConfig.MaxGasLimit = 290
+		require.Equal(t, [][][]byte{{op1, op2}, {op3}}, splitInBatches(txsData, batchConfig))
+	})
+	t.Run("operation exceeding limits should be added in a separate batch", func(t *testing.T) {
+		t.Parallel()
+
+		batchConfig := config.OutGoingOperationsBatch{
+			MaxSizeInBytes: 4,
+		}
+		require.Equal(t, [][][]byte{{op1}, {op2}, {op3}}, splitInBatches(txsData, batchConfig))
+	})
 }
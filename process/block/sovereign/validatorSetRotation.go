@@ -0,0 +1,113 @@
+package sovereign
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/vm"
+)
+
+// ValidatorSetRotationFunction is the main chain bridge function called by the validator set rotation operation
+const ValidatorSetRotationFunction = "changeValidatorSet"
+
+// ArgsValidatorSetRotationCreator holds the arguments needed to create a validator set rotation operation creator
+type ArgsValidatorSetRotationCreator struct {
+	NodesComputer    EpochStartNodesComputer
+	OperationsHasher hashing.Hasher
+	DataCodec        DataCodecHandler
+	Receiver         []byte
+	GasLimit         uint64
+}
+
+type validatorSetRotationCreator struct {
+	nodesComputer    EpochStartNodesComputer
+	operationsHasher hashing.Hasher
+	dataCodec        DataCodecHandler
+	receiver         []byte
+	gasLimit         uint64
+}
+
+// NewValidatorSetRotationCreator creates the component which, at epoch start, creates the outgoing operation notifying
+// the main chain about the validators set of the new epoch. The operation is added in the epoch start block, so it is
+// signed by the validators of the ending epoch, which are the ones already known by the main chain.
+func NewValidatorSetRotationCreator(args ArgsValidatorSetRotationCreator) (*validatorSetRotationCreator, error) {
+	if check.IfNil(args.NodesComputer) {
+		return nil, errors.ErrNilEpochStartNodesComputer
+	}
+	if check.IfNil(args.OperationsHasher) {
+		return nil, errors.ErrNilOperationsHasher
+	}
+	if check.IfNil(args.DataCodec) {
+		return nil, errors.ErrNilDataCodec
+	}
+	if len(args.Receiver) == 0 {
+		return nil, errEmptyValidatorSetRotationReceiver
+	}
+
+	return &validatorSetRotationCreator{
+		nodesComputer:    args.NodesComputer,
+		operationsHasher: args.OperationsHasher,
+		dataCodec:        args.DataCodec,
+		receiver:         args.Receiver,
+		gasLimit:         args.GasLimit,
+	}, nil
+}
+
+// CreateValidatorSetRotationOperation creates the validator set rotation operation for the provided epoch start block,
+// as a batch for the default outgoing destination. The operation is encoded with the data codec, as any other outgoing
+// operation, and calls the configured receiver with the new epoch and the BLS public keys of the validators which will
+// be eligible in it, computed from the validator info mini blocks of the provided body.
+func (creator *validatorSetRotationCreator) CreateValidatorSetRotationOperation(header data.HeaderHandler, body data.BodyHandler) (*OutGoingDestinationBatches, error) {
+	if check.IfNil(header) {
+		return nil, data.ErrNilHeader
+	}
+
+	pubKeys, err := creator.nodesComputer.ComputeNextEpochEligiblePublicKeys(header, body)
+	if err != nil {
+		return nil, err
+	}
+	if len(pubKeys) == 0 {
+		return nil, errNoValidatorsForNextEpoch
+	}
+
+	epoch := big.NewInt(int64(header.GetEpoch())).Bytes()
+	operation := sovereignCore.Operation{
+		Address: creator.receiver,
+		Tokens:  make([]sovereignCore.EsdtToken, 0),
+		Data: &sovereignCore.EventData{
+			Nonce:  uint64(header.GetEpoch()),
+			Sender: vm.ValidatorSCAddress,
+			TransferData: &sovereignCore.TransferData{
+				GasLimit: creator.gasLimit,
+				Function: []byte(ValidatorSetRotationFunction),
+				Args:     append([][]byte{epoch}, pubKeys...),
+			},
+		},
+	}
+
+	operationBytes, err := creator.dataCodec.SerializeOperation(operation)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("validatorSetRotationCreator.CreateValidatorSetRotationOperation",
+		"epoch", header.GetEpoch(),
+		"num validators", len(pubKeys),
+	)
+
+	return &OutGoingDestinationBatches{
+		Destination: "",
+		Hasher:      creator.operationsHasher,
+		Batches:     [][][]byte{{operationBytes}},
+	}, nil
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (creator *validatorSetRotationCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
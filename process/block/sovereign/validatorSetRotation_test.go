@@ -0,0 +1,163 @@
+package sovereign
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/stretchr/testify/require"
+
+	mxErrors "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	sovTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+	"github.com/multiversx/mx-chain-go/vm"
+)
+
+func createValidatorSetRotationArgs() ArgsValidatorSetRotationCreator {
+	return ArgsValidatorSetRotationCreator{
+		NodesComputer:    &sovTests.EpochStartNodesComputerMock{},
+		OperationsHasher: &hashingMocks.HasherMock{},
+		DataCodec:        &sovTests.DataCodecMock{},
+		Receiver:         []byte("receiver"),
+		GasLimit:         20000000,
+	}
+}
+
+func TestNewValidatorSetRotationCreator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes computer should error", func(t *testing.T) {
+		args := createValidatorSetRotationArgs()
+		args.NodesComputer = nil
+
+		creator, err := NewValidatorSetRotationCreator(args)
+		require.Nil(t, creator)
+		require.Equal(t, mxErrors.ErrNilEpochStartNodesComputer, err)
+	})
+	t.Run("nil operations hasher should error", func(t *testing.T) {
+		args := createValidatorSetRotationArgs()
+		args.OperationsHasher = nil
+
+		creator, err := NewValidatorSetRotationCreator(args)
+		require.Nil(t, creator)
+		require.Equal(t, mxErrors.ErrNilOperationsHasher, err)
+	})
+	t.Run("nil data codec should error", func(t *testing.T) {
+		args := createValidatorSetRotationArgs()
+		args.DataCodec = nil
+
+		creator, err := NewValidatorSetRotationCreator(args)
+		require.Nil(t, creator)
+		require.Equal(t, mxErrors.ErrNilDataCodec, err)
+	})
+	t.Run("empty receiver should error", func(t *testing.T) {
+		args := createValidatorSetRotationArgs()
+		args.Receiver = nil
+
+		creator, err := NewValidatorSetRotationCreator(args)
+		require.Nil(t, creator)
+		require.Equal(t, errEmptyValidatorSetRotationReceiver, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		creator, err := NewValidatorSetRotationCreator(createValidatorSetRotationArgs())
+		require.Nil(t, err)
+		require.False(t, creator.IsInterfaceNil())
+	})
+}
+
+func TestValidatorSetRotationCreator_CreateValidatorSetRotationOperation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header should error", func(t *testing.T) {
+		creator, _ := NewValidatorSetRotationCreator(createValidatorSetRotationArgs())
+
+		batches, err := creator.CreateValidatorSetRotationOperation(nil, &block.Body{})
+		require.Nil(t, batches)
+		require.Equal(t, data.ErrNilHeader, err)
+	})
+	t.Run("nodes computer error should error", func(t *testing.T) {
+		expectedErr := errors.New("local error")
+		args := createValidatorSetRotationArgs()
+		args.NodesComputer = &sovTests.EpochStartNodesComputerMock{
+			ComputeNextEpochEligiblePublicKeysCalled: func(_ data.HeaderHandler, _ data.BodyHandler) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		creator, _ := NewValidatorSetRotationCreator(args)
+
+		batches, err := creator.CreateValidatorSetRotationOperation(&block.SovereignChainHeader{Header: &block.Header{}}, &block.Body{})
+		require.Nil(t, batches)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("no validators for next epoch should error", func(t *testing.T) {
+		creator, _ := NewValidatorSetRotationCreator(createValidatorSetRotationArgs())
+
+		batches, err := creator.CreateValidatorSetRotationOperation(&block.SovereignChainHeader{Header: &block.Header{}}, &block.Body{})
+		require.Nil(t, batches)
+		require.Equal(t, errNoValidatorsForNextEpoch, err)
+	})
+	t.Run("data codec error should error", func(t *testing.T) {
+		expectedErr := errors.New("local error")
+		args := createValidatorSetRotationArgs()
+		args.NodesComputer = &sovTests.EpochStartNodesComputerMock{
+			ComputeNextEpochEligiblePublicKeysCalled: func(_ data.HeaderHandler, _ data.BodyHandler) ([][]byte, error) {
+				return [][]byte{[]byte("pk1")}, nil
+			},
+		}
+		args.DataCodec = &sovTests.DataCodecMock{
+			SerializeOperationCalled: func(_ sovereignCore.Operation) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		creator, _ := NewValidatorSetRotationCreator(args)
+
+		batches, err := creator.CreateValidatorSetRotationOperation(&block.SovereignChainHeader{Header: &block.Header{}}, &block.Body{})
+		require.Nil(t, batches)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		pk1, pk2 := []byte("pk1"), []byte("pk2")
+		hdr := &block.SovereignChainHeader{Header: &block.Header{Epoch: 11}, IsStartOfEpoch: true}
+		body := &block.Body{MiniBlocks: []*block.MiniBlock{{Type: block.PeerBlock}}}
+
+		args := createValidatorSetRotationArgs()
+		args.NodesComputer = &sovTests.EpochStartNodesComputerMock{
+			ComputeNextEpochEligiblePublicKeysCalled: func(h data.HeaderHandler, b data.BodyHandler) ([][]byte, error) {
+				require.Equal(t, hdr, h)
+				require.Equal(t, body, b)
+				return [][]byte{pk1, pk2}, nil
+			},
+		}
+		serializedOp := []byte("serializedOp")
+		args.DataCodec = &sovTests.DataCodecMock{
+			SerializeOperationCalled: func(operation sovereignCore.Operation) ([]byte, error) {
+				require.Equal(t, sovereignCore.Operation{
+					Address: args.Receiver,
+					Tokens:  make([]sovereignCore.EsdtToken, 0),
+					Data: &sovereignCore.EventData{
+						Nonce:  11,
+						Sender: vm.ValidatorSCAddress,
+						TransferData: &sovereignCore.TransferData{
+							GasLimit: args.GasLimit,
+							Function: []byte(ValidatorSetRotationFunction),
+							Args:     [][]byte{{0x0b}, pk1, pk2},
+						},
+					},
+				}, operation)
+
+				return serializedOp, nil
+			},
+		}
+		creator, _ := NewValidatorSetRotationCreator(args)
+
+		batches, err := creator.CreateValidatorSetRotationOperation(hdr, body)
+		require.Nil(t, err)
+		require.Equal(t, &OutGoingDestinationBatches{
+			Destination: "",
+			Hasher:      args.OperationsHasher,
+			Batches:     [][][]byte{{serializedOp}},
+		}, batches)
+	})
+}
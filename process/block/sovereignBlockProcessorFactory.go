@@ -2,13 +2,23 @@ package block
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/hashing/factory"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
 	mxErrors "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/sovereign"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/incomingHeader"
 	processFactory "github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/state"
 )
 
 type sovereignBlockProcessorFactory struct {
@@ -38,16 +48,25 @@ func (s *sovereignBlockProcessorFactory) CreateBlockProcessor(argumentsBaseProce
 		return nil, mxErrors.ErrWrongTypeAssertion
 	}
 
-	outgoingOpFormatter, err := sovereign.CreateOutgoingOperationsFormatter(
-		argumentsBaseProcessor.Config.SovereignConfig.OutgoingSubscribedEvents.SubscribedEvents,
-		argumentsBaseProcessor.CoreComponents.AddressPubKeyConverter(),
-		argumentsBaseProcessor.RunTypeComponents.DataCodecHandler(),
-		argumentsBaseProcessor.RunTypeComponents.TopicsCheckerHandler())
+	operationsHasher, err := factory.NewHasher(argumentsBaseProcessor.Config.SovereignConfig.OutGoingBridge.Hasher)
 	if err != nil {
 		return nil, err
 	}
 
-	operationsHasher, err := factory.NewHasher(argumentsBaseProcessor.Config.SovereignConfig.OutGoingBridge.Hasher)
+	outgoingOpRouter, err := sovereign.CreateOutgoingOperationsRouter(
+		argumentsBaseProcessor.Config.SovereignConfig.OutgoingSubscribedEvents,
+		operationsHasher,
+		argumentsBaseProcessor.CoreComponents.AddressPubKeyConverter(),
+		map[string]sovereign.OutGoingCodec{
+			sovereign.DefaultOutGoingCodec: {
+				DataCodec:     argumentsBaseProcessor.RunTypeComponents.DataCodecHandler(),
+				TopicsChecker: argumentsBaseProcessor.RunTypeComponents.TopicsCheckerHandler(),
+			},
+		},
+		argumentsBaseProcessor.Config.SovereignConfig.IncomingChains,
+		argumentsBaseProcessor.StatusCoreComponents.AppStatusHandler(),
+		argumentsBaseProcessor.AccountsDB[state.UserAccountsState],
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -62,24 +81,135 @@ func (s *sovereignBlockProcessorFactory) CreateBlockProcessor(argumentsBaseProce
 		return nil, err
 	}
 
+	outGoingOperationsPool := argumentsBaseProcessor.RunTypeComponents.OutGoingOperationsPoolHandler()
+	err = setOutGoingOperationsPoolStorer(outGoingOperationsPool, argumentsBaseProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgePauseHandler, err := sovereign.NewBridgePauseHandler(argumentsBaseProcessor.AccountsDB[state.UserAccountsState])
+	if err != nil {
+		return nil, err
+	}
+
+	validatorSetRotationCreator, err := createValidatorSetRotationCreator(argumentsBaseProcessor, operationsHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	incomingRateLimiters, err := createIncomingRateLimiters(argumentsBaseProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	depositsVolumeComputer, err := incomingHeader.NewDepositsVolumeComputer(incomingHeader.ArgsDepositsVolumeComputer{
+		Marshaller:    argumentsBaseProcessor.CoreComponents.InternalMarshalizer(),
+		Hasher:        argumentsBaseProcessor.CoreComponents.Hasher(),
+		DataCodec:     argumentsBaseProcessor.RunTypeComponents.DataCodecHandler(),
+		TopicsChecker: argumentsBaseProcessor.RunTypeComponents.TopicsCheckerHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	args := ArgsSovereignChainBlockProcessor{
-		ShardProcessor:                  shardProc,
-		ValidatorStatisticsProcessor:    argumentsBaseProcessor.ValidatorStatisticsProcessor,
-		OutgoingOperationsFormatter:     outgoingOpFormatter,
-		OutGoingOperationsPool:          argumentsBaseProcessor.RunTypeComponents.OutGoingOperationsPoolHandler(),
-		OperationsHasher:                operationsHasher,
-		ValidatorInfoCreator:            argsMetaProcessor.EpochValidatorInfoCreator,
-		EpochRewardsCreator:             argsMetaProcessor.EpochRewardsCreator,
-		EpochStartDataCreator:           argsMetaProcessor.EpochStartDataCreator,
-		EpochSystemSCProcessor:          argsMetaProcessor.EpochSystemSCProcessor,
-		SCToProtocol:                    argsMetaProcessor.SCToProtocol,
-		EpochEconomics:                  argsMetaProcessor.EpochEconomics,
-		MainChainNotarizationStartRound: argumentsBaseProcessor.Config.SovereignConfig.MainChainNotarization.MainChainNotarizationStartRound,
+		ShardProcessor:               shardProc,
+		ValidatorStatisticsProcessor: argumentsBaseProcessor.ValidatorStatisticsProcessor,
+		OutgoingOperationsRouter:     outgoingOpRouter,
+		OutGoingOperationsPool:       outGoingOperationsPool,
+		ValidatorInfoCreator:         argsMetaProcessor.EpochValidatorInfoCreator,
+		EpochRewardsCreator:          argsMetaProcessor.EpochRewardsCreator,
+		EpochStartDataCreator:        argsMetaProcessor.EpochStartDataCreator,
+		EpochSystemSCProcessor:       argsMetaProcessor.EpochSystemSCProcessor,
+		SCToProtocol:                 argsMetaProcessor.SCToProtocol,
+		EpochEconomics:               argsMetaProcessor.EpochEconomics,
+		IncomingChainsHandler:        argumentsBaseProcessor.RunTypeComponents.IncomingChainsHandler(),
+		BridgePauseHandler:           bridgePauseHandler,
+		ValidatorSetRotationCreator:  validatorSetRotationCreator,
+		IncomingRateLimiters:         incomingRateLimiters,
+		DepositsVolumeComputer:       depositsVolumeComputer,
 	}
 
 	return NewSovereignChainBlockProcessor(args)
 }
 
+// createIncomingRateLimiters creates the incoming rate limiters of the main chain and of all the incoming chains, by
+// the shard IDs under which the chains are tracked. Their state is saved in the system account of the user accounts.
+func createIncomingRateLimiters(argumentsBaseProcessor ArgBaseProcessor) (map[uint32]sovereign.BridgeRateLimiter, error) {
+	sovConfig := argumentsBaseProcessor.Config.SovereignConfig
+	incomingChainsHandler := argumentsBaseProcessor.RunTypeComponents.IncomingChainsHandler()
+	if check.IfNil(incomingChainsHandler) {
+		return nil, mxErrors.ErrNilIncomingChainsHandler
+	}
+
+	rateLimitsConfigs := map[uint32]config.BridgeRateLimits{
+		core.MainChainShardId: sovConfig.NotifierConfig.RateLimits,
+	}
+	for _, incomingChain := range sovConfig.IncomingChains {
+		shardID, found := incomingChainsHandler.GetShardIDForChainID(incomingChain.ChainID)
+		if !found {
+			return nil, fmt.Errorf("%w: %s", mxErrors.ErrUnknownIncomingChainID, incomingChain.ChainID)
+		}
+
+		rateLimitsConfigs[shardID] = incomingChain.NotifierConfig.RateLimits
+	}
+
+	rateLimiters := make(map[uint32]sovereign.BridgeRateLimiter, len(rateLimitsConfigs))
+	for shardID, rateLimitsConfig := range rateLimitsConfigs {
+		rateLimiter, err := sovereign.NewBridgeRateLimiter(sovereign.ArgsBridgeRateLimiter{
+			Config:           rateLimitsConfig,
+			Accounts:         argumentsBaseProcessor.AccountsDB[state.UserAccountsState],
+			Marshaller:       &marshal.JsonMarshalizer{},
+			StateKeyPrefix:   common.BridgeIncomingRateLimitsKeyPrefix,
+			AppStatusHandler: argumentsBaseProcessor.StatusCoreComponents.AppStatusHandler(),
+			LimitedOpsMetric: common.MetricNumRateLimitedIncomingHeaders,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		rateLimiters[shardID] = rateLimiter
+	}
+
+	return rateLimiters, nil
+}
+
+func createValidatorSetRotationCreator(argumentsBaseProcessor ArgBaseProcessor, operationsHasher hashing.Hasher) (sovereign.ValidatorSetRotationCreator, error) {
+	nodesComputer, ok := argumentsBaseProcessor.NodesCoordinator.(sovereign.EpochStartNodesComputer)
+	if !ok {
+		return nil, fmt.Errorf("%w for epoch start nodes computer", mxErrors.ErrWrongTypeAssertion)
+	}
+
+	rotationConfig := argumentsBaseProcessor.Config.SovereignConfig.OutgoingSubscribedEvents.ValidatorSetRotation
+	receiver, err := argumentsBaseProcessor.CoreComponents.AddressPubKeyConverter().Decode(rotationConfig.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w for validator set rotation address", err)
+	}
+
+	return sovereign.NewValidatorSetRotationCreator(sovereign.ArgsValidatorSetRotationCreator{
+		NodesComputer:    nodesComputer,
+		OperationsHasher: operationsHasher,
+		DataCodec:        argumentsBaseProcessor.RunTypeComponents.DataCodecHandler(),
+		Receiver:         receiver,
+		GasLimit:         rotationConfig.GasLimit,
+	})
+}
+
+func setOutGoingOperationsPoolStorer(outGoingOperationsPool sovereignBlock.OutGoingOperationsPool, argumentsBaseProcessor ArgBaseProcessor) error {
+	if check.IfNil(outGoingOperationsPool) {
+		return mxErrors.ErrNilOutGoingOperationsPool
+	}
+
+	storer, err := argumentsBaseProcessor.DataComponents.StorageService().GetStorer(dataRetriever.OutGoingOperationsUnit)
+	if err != nil {
+		return err
+	}
+
+	// outgoing bridge data structures are generated for gRPC and do not implement the gogo proto marshalling
+	// methods, hence the json marshaller is used to persist them
+	return outGoingOperationsPool.SetStorer(storer, &marshal.JsonMarshalizer{})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *sovereignBlockProcessorFactory) IsInterfaceNil() bool {
 	return s == nil
@@ -41,7 +41,9 @@ func TestSovereignBlockProcessorFactory_CreateBlockProcessor(t *testing.T) {
 	require.NotNil(t, err)
 	require.Nil(t, sbp)
 
-	metaArgument := createMockMetaArguments(createMockComponentHolders())
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createMockComponentHolders()
+	dataComponents.Storage = initStore()
+	metaArgument := createMockMetaArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
 	metaArgument.ArgBaseProcessor.BlockTracker = &testscommon.ExtendedShardHeaderTrackerStub{}
 	metaArgument.ArgBaseProcessor.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{}
 	metaArgument.ArgBaseProcessor.Config = testscommon.GetGeneralConfig()
@@ -2,6 +2,7 @@ package block
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sort"
@@ -13,7 +14,6 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	sovCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
-	"github.com/multiversx/mx-chain-core-go/hashing"
 	logger "github.com/multiversx/mx-chain-logger-go"
 
 	"github.com/multiversx/mx-chain-go/common"
@@ -25,20 +25,21 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/processedMb"
 	"github.com/multiversx/mx-chain-go/process/block/sovereign"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
 var rootHash = "uncomputed root hash"
 
 type extendedShardHeaderTrackHandler interface {
-	ComputeLongestExtendedShardChainFromLastNotarized() ([]data.HeaderHandler, [][]byte, error)
-	IsGenesisLastCrossNotarizedHeader() bool
-	RemoveLastCrossNotarizedHeaders()
+	ComputeLongestExtendedShardChainFromLastNotarized(shardID uint32) ([]data.HeaderHandler, [][]byte, error)
+	IsGenesisLastCrossNotarizedHeader(shardID uint32) bool
+	RemoveLastCrossNotarizedHeader(shardID uint32)
 	RemoveLastSelfNotarizedHeaders()
 }
 
 type extendedShardHeaderRequestHandler interface {
-	RequestExtendedShardHeaderByNonce(nonce uint64)
+	RequestIncomingChainHeaderByNonce(incomingChainShardID uint32, nonce uint64)
 	RequestExtendedShardHeader(hash []byte)
 }
 
@@ -49,9 +50,8 @@ type sovereignChainBlockProcessor struct {
 	extendedShardHeaderTracker   extendedShardHeaderTrackHandler
 	extendedShardHeaderRequester extendedShardHeaderRequestHandler
 	chRcvAllExtendedShardHdrs    chan bool
-	outgoingOperationsFormatter  sovereign.OutgoingOperationsFormatter
+	outgoingOperationsRouter     sovereign.OutgoingOperationsRouter
 	outGoingOperationsPool       sovereignBlock.OutGoingOperationsPool
-	operationsHasher             hashing.Hasher
 
 	epochStartDataCreator process.EpochStartDataCreator
 	epochRewardsCreator   process.RewardsCreator
@@ -59,23 +59,33 @@ type sovereignChainBlockProcessor struct {
 	scToProtocol          process.SmartContractToProtocolHandler
 	epochEconomics        process.EndOfEpochEconomics
 
-	mainChainNotarizationStartRound uint64
+	incomingChainsHandler          process.IncomingChainsHandler
+	bridgePauseHandler             sovereign.BridgePauseHandler
+	isIncomingBridgePaused         bool
+	isOutGoingBridgePaused         bool
+	validatorSetRotationCreator    sovereign.ValidatorSetRotationCreator
+	incomingRateLimiters           map[uint32]sovereign.BridgeRateLimiter
+	depositsVolumeComputer         sovereign.DepositsVolumeComputer
+	lastRoundWithExtendedShardHdrs map[uint32]int64
 }
 
 // ArgsSovereignChainBlockProcessor is a struct placeholder for args needed to create a new sovereign chain block processor
 type ArgsSovereignChainBlockProcessor struct {
-	ShardProcessor                  *shardProcessor
-	ValidatorStatisticsProcessor    process.ValidatorStatisticsProcessor
-	OutgoingOperationsFormatter     sovereign.OutgoingOperationsFormatter
-	OutGoingOperationsPool          sovereignBlock.OutGoingOperationsPool
-	OperationsHasher                hashing.Hasher
-	EpochStartDataCreator           process.EpochStartDataCreator
-	EpochRewardsCreator             process.RewardsCreator
-	ValidatorInfoCreator            process.EpochStartValidatorInfoCreator
-	EpochSystemSCProcessor          process.EpochStartSystemSCProcessor
-	SCToProtocol                    process.SmartContractToProtocolHandler
-	EpochEconomics                  process.EndOfEpochEconomics
-	MainChainNotarizationStartRound uint64
+	ShardProcessor               *shardProcessor
+	ValidatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	OutgoingOperationsRouter     sovereign.OutgoingOperationsRouter
+	OutGoingOperationsPool       sovereignBlock.OutGoingOperationsPool
+	EpochStartDataCreator        process.EpochStartDataCreator
+	EpochRewardsCreator          process.RewardsCreator
+	ValidatorInfoCreator         process.EpochStartValidatorInfoCreator
+	EpochSystemSCProcessor       process.EpochStartSystemSCProcessor
+	SCToProtocol                 process.SmartContractToProtocolHandler
+	EpochEconomics               process.EndOfEpochEconomics
+	IncomingChainsHandler        process.IncomingChainsHandler
+	BridgePauseHandler           sovereign.BridgePauseHandler
+	ValidatorSetRotationCreator  sovereign.ValidatorSetRotationCreator
+	IncomingRateLimiters         map[uint32]sovereign.BridgeRateLimiter
+	DepositsVolumeComputer       sovereign.DepositsVolumeComputer
 }
 
 // NewSovereignChainBlockProcessor creates a new sovereign chain block processor
@@ -86,15 +96,12 @@ func NewSovereignChainBlockProcessor(args ArgsSovereignChainBlockProcessor) (*so
 	if check.IfNil(args.ValidatorStatisticsProcessor) {
 		return nil, process.ErrNilValidatorStatistics
 	}
-	if check.IfNil(args.OutgoingOperationsFormatter) {
-		return nil, errors.ErrNilOutgoingOperationsFormatter
+	if check.IfNil(args.OutgoingOperationsRouter) {
+		return nil, errors.ErrNilOutgoingOperationsRouter
 	}
 	if check.IfNil(args.OutGoingOperationsPool) {
 		return nil, errors.ErrNilOutGoingOperationsPool
 	}
-	if check.IfNil(args.OperationsHasher) {
-		return nil, errors.ErrNilOperationsHasher
-	}
 	if check.IfNil(args.EpochStartDataCreator) {
 		return nil, process.ErrNilEpochStartDataCreator
 	}
@@ -110,19 +117,40 @@ func NewSovereignChainBlockProcessor(args ArgsSovereignChainBlockProcessor) (*so
 	if check.IfNil(args.EpochEconomics) {
 		return nil, process.ErrNilEpochEconomics
 	}
+	if check.IfNil(args.IncomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
+	if check.IfNil(args.BridgePauseHandler) {
+		return nil, errors.ErrNilBridgePauseHandler
+	}
+	if check.IfNil(args.ValidatorSetRotationCreator) {
+		return nil, errors.ErrNilValidatorSetRotationCreator
+	}
+	for shardID, rateLimiter := range args.IncomingRateLimiters {
+		if check.IfNil(rateLimiter) {
+			return nil, fmt.Errorf("%w for incoming shard %d", errors.ErrNilBridgeRateLimiter, shardID)
+		}
+	}
+	if check.IfNil(args.DepositsVolumeComputer) {
+		return nil, errors.ErrNilDepositsVolumeComputer
+	}
 
 	scbp := &sovereignChainBlockProcessor{
-		shardProcessor:                  args.ShardProcessor,
-		validatorStatisticsProcessor:    args.ValidatorStatisticsProcessor,
-		outgoingOperationsFormatter:     args.OutgoingOperationsFormatter,
-		outGoingOperationsPool:          args.OutGoingOperationsPool,
-		operationsHasher:                args.OperationsHasher,
-		epochStartDataCreator:           args.EpochStartDataCreator,
-		epochRewardsCreator:             args.EpochRewardsCreator,
-		validatorInfoCreator:            args.ValidatorInfoCreator,
-		scToProtocol:                    args.SCToProtocol,
-		epochEconomics:                  args.EpochEconomics,
-		mainChainNotarizationStartRound: args.MainChainNotarizationStartRound,
+		shardProcessor:                 args.ShardProcessor,
+		validatorStatisticsProcessor:   args.ValidatorStatisticsProcessor,
+		outgoingOperationsRouter:       args.OutgoingOperationsRouter,
+		outGoingOperationsPool:         args.OutGoingOperationsPool,
+		epochStartDataCreator:          args.EpochStartDataCreator,
+		epochRewardsCreator:            args.EpochRewardsCreator,
+		validatorInfoCreator:           args.ValidatorInfoCreator,
+		scToProtocol:                   args.SCToProtocol,
+		epochEconomics:                 args.EpochEconomics,
+		incomingChainsHandler:          args.IncomingChainsHandler,
+		bridgePauseHandler:             args.BridgePauseHandler,
+		validatorSetRotationCreator:    args.ValidatorSetRotationCreator,
+		incomingRateLimiters:           args.IncomingRateLimiters,
+		depositsVolumeComputer:         args.DepositsVolumeComputer,
+		lastRoundWithExtendedShardHdrs: make(map[uint32]int64),
 	}
 
 	scbp.baseProcessor.epochSystemSCProcessor = args.EpochSystemSCProcessor
@@ -158,6 +186,7 @@ func NewSovereignChainBlockProcessor(args ArgsSovereignChainBlockProcessor) (*so
 		baseBlockNotarizer: &baseBlockNotarizer{
 			blockTracker: scbp.blockTracker,
 		},
+		incomingChainsHandler: scbp.incomingChainsHandler,
 	}
 
 	return scbp, nil
@@ -234,6 +263,8 @@ func (scbp *sovereignChainBlockProcessor) CreateBlock(initialHdr data.HeaderHand
 		return nil, nil, err
 	}
 
+	scbp.loadBridgePauseState()
+
 	if scbp.epochStartTrigger.IsEpochStart() {
 		epoch := scbp.epochStartTrigger.MetaEpoch()
 		log.Debug("sovereignChainBlockProcessor.CreateBlock", "isEpochStart", true, "epoch from epoch start trigger", epoch)
@@ -247,7 +278,7 @@ func (scbp *sovereignChainBlockProcessor) CreateBlock(initialHdr data.HeaderHand
 			return nil, nil, err
 		}
 
-		err = scbp.createEpochStartDataCrossChain(sovereignChainHeaderHandler)
+		err = scbp.createEpochStartDataCrossChain(initialHdr)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -349,7 +380,7 @@ func (scbp *sovereignChainBlockProcessor) createAllMiniBlocks(
 	}
 
 	startTime := time.Now()
-	createIncomingMiniBlocksDestMeInfo, err := scbp.createIncomingMiniBlocksDestMe(haveTime)
+	createIncomingMiniBlocksDestMeInfo, err := scbp.createIncomingMiniBlocksDestMe(haveTime, initialHdr.GetEpoch())
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to create mbs to me", "time", elapsedTime)
 	if err != nil {
@@ -389,41 +420,78 @@ func (scbp *sovereignChainBlockProcessor) createAllMiniBlocks(
 	return crossMiniBlocks, miniBlocks, nil
 }
 
-func (scbp *sovereignChainBlockProcessor) createIncomingMiniBlocksDestMe(haveTime func() bool) (*createAndProcessMiniBlocksDestMeInfo, error) {
+func (scbp *sovereignChainBlockProcessor) createIncomingMiniBlocksDestMe(haveTime func() bool, epoch uint32) (*createAndProcessMiniBlocksDestMeInfo, error) {
 	log.Debug("createIncomingMiniBlocksDestMe has been started")
 
+	haveAdditionalTimeFalse := func() bool {
+		return false
+	}
+
+	createAndProcessInfo := &createAndProcessMiniBlocksDestMeInfo{
+		haveTime:                   haveTime,
+		haveAdditionalTime:         haveAdditionalTimeFalse,
+		miniBlocks:                 make(block.MiniBlockSlice, 0),
+		allProcessedMiniBlocksInfo: make(map[string]*processedMb.ProcessedMiniBlockInfo),
+		numTxsAdded:                uint32(0),
+		numHdrsAdded:               uint32(0),
+		scheduledMode:              true,
+	}
+
+	// extended shard headers are kept in pools while the incoming bridge is paused, to be included once it is resumed
+	if scbp.isIncomingBridgePaused {
+		log.Debug("createIncomingMiniBlocksDestMe: incoming bridge is paused, no extended shard header will be included")
+		return createAndProcessInfo, nil
+	}
+
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		err := scbp.createIncomingMiniBlocksDestMeForShard(shardID, epoch, createAndProcessInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, miniBlock := range createAndProcessInfo.miniBlocks {
+		log.Debug("mini block info",
+			"type", miniBlock.Type,
+			"sender shard", miniBlock.SenderShardID,
+			"receiver shard", miniBlock.ReceiverShardID,
+			"txs added", len(miniBlock.TxHashes))
+	}
+
+	log.Debug("createIncomingMiniBlocksDestMe has been finished",
+		"num txs added", createAndProcessInfo.numTxsAdded,
+		"num hdrs added", createAndProcessInfo.numHdrsAdded)
+
+	return createAndProcessInfo, nil
+}
+
+func (scbp *sovereignChainBlockProcessor) createIncomingMiniBlocksDestMeForShard(
+	shardID uint32,
+	epoch uint32,
+	createAndProcessInfo *createAndProcessMiniBlocksDestMeInfo,
+) error {
 	sw := core.NewStopWatch()
 	sw.Start("ComputeLongestExtendedShardChainFromLastNotarized")
-	orderedExtendedShardHeaders, orderedExtendedShardHeadersHashes, err := scbp.extendedShardHeaderTracker.ComputeLongestExtendedShardChainFromLastNotarized()
+	orderedExtendedShardHeaders, orderedExtendedShardHeadersHashes, err := scbp.extendedShardHeaderTracker.ComputeLongestExtendedShardChainFromLastNotarized(shardID)
 	sw.Stop("ComputeLongestExtendedShardChainFromLastNotarized")
 	log.Debug("measurements", sw.GetMeasurements()...)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	log.Debug("extended shard headers ordered",
+		"shard", shardID,
 		"num extended shard headers", len(orderedExtendedShardHeaders),
 	)
 
-	lastExtendedShardHdr, _, err := scbp.blockTracker.GetLastCrossNotarizedHeader(core.MainChainShardId)
+	lastExtendedShardHdr, _, err := scbp.blockTracker.GetLastCrossNotarizedHeader(shardID)
 	if err != nil {
-		return nil, err
-	}
-
-	haveAdditionalTimeFalse := func() bool {
-		return false
+		return err
 	}
 
-	createAndProcessInfo := &createAndProcessMiniBlocksDestMeInfo{
-		haveTime:                   haveTime,
-		haveAdditionalTime:         haveAdditionalTimeFalse,
-		miniBlocks:                 make(block.MiniBlockSlice, 0),
-		allProcessedMiniBlocksInfo: make(map[string]*processedMb.ProcessedMiniBlockInfo),
-		numTxsAdded:                uint32(0),
-		numHdrsAdded:               uint32(0),
-		scheduledMode:              true,
-	}
+	numHdrsAddedBefore := createAndProcessInfo.numHdrsAdded
+	admittedDeposits := make([]*sovereign.BridgeOperationVolume, 0)
 
 	// do processing in order
 	scbp.hdrsForCurrBlock.mutHdrsForBlock.Lock()
@@ -474,9 +542,22 @@ func (scbp *sovereignChainBlockProcessor) createIncomingMiniBlocksDestMe(haveTim
 		createAndProcessInfo.currProcessedMiniBlocksInfo = scbp.processedMiniBlocksTracker.GetProcessedMiniBlocksInfo(createAndProcessInfo.currentHeaderHash)
 		createAndProcessInfo.hdrAdded = false
 
+		deposits, fits, errCheck := scbp.checkIncomingDepositsFit(shardID, epoch, extendedShardHeader, createAndProcessInfo, admittedDeposits)
+		if errCheck != nil {
+			scbp.hdrsForCurrBlock.mutHdrsForBlock.Unlock()
+			return errCheck
+		}
+		if !fits {
+			break
+		}
+
 		shouldContinue, errCreated := scbp.createIncomingMiniBlocksAndTransactionsDestMe(createAndProcessInfo)
 		if errCreated != nil {
-			return nil, errCreated
+			scbp.hdrsForCurrBlock.mutHdrsForBlock.Unlock()
+			return errCreated
+		}
+		if createAndProcessInfo.hdrAdded && deposits != nil {
+			admittedDeposits = append(admittedDeposits, deposits)
 		}
 		if !shouldContinue {
 			break
@@ -486,21 +567,64 @@ func (scbp *sovereignChainBlockProcessor) createIncomingMiniBlocksDestMe(haveTim
 	}
 	scbp.hdrsForCurrBlock.mutHdrsForBlock.Unlock()
 
-	go scbp.requestExtendedShardHeadersIfNeeded(createAndProcessInfo.numHdrsAdded, lastExtendedShardHdr)
+	scbp.requestExtendedShardHeadersIfNeeded(shardID, createAndProcessInfo.numHdrsAdded-numHdrsAddedBefore, lastExtendedShardHdr)
 
-	for _, miniBlock := range createAndProcessInfo.miniBlocks {
-		log.Debug("mini block info",
-			"type", miniBlock.Type,
-			"sender shard", miniBlock.SenderShardID,
-			"receiver shard", miniBlock.ReceiverShardID,
-			"txs added", len(miniBlock.TxHashes))
+	return nil
+}
+
+// checkIncomingDepositsFit checks if the deposits of an extended shard header, which is about to be included for the
+// first time, fit in the incoming rate limits of its shard, together with the deposits already admitted in the block.
+// Headers which do not fit are held back in pools, along with all the next ones from the same shard, until the limits
+// are reset in the next epoch. The returned deposits are nil for headers without deposits or already counted ones.
+func (scbp *sovereignChainBlockProcessor) checkIncomingDepositsFit(
+	shardID uint32,
+	epoch uint32,
+	extendedShardHeader data.ShardHeaderExtendedHandler,
+	createAndProcessInfo *createAndProcessMiniBlocksDestMeInfo,
+	admittedDeposits []*sovereign.BridgeOperationVolume,
+) (*sovereign.BridgeOperationVolume, bool, error) {
+	rateLimiter, found := scbp.incomingRateLimiters[shardID]
+	if !found || !rateLimiter.IsEnabled() || len(createAndProcessInfo.currProcessedMiniBlocksInfo) != 0 {
+		return nil, true, nil
 	}
 
-	log.Debug("createIncomingMiniBlocksDestMe has been finished",
-		"num txs added", createAndProcessInfo.numTxsAdded,
-		"num hdrs added", createAndProcessInfo.numHdrsAdded)
+	deposits, err := scbp.computeDepositsVolume(extendedShardHeader, createAndProcessInfo.currentHeaderHash)
+	if err != nil || deposits == nil {
+		return nil, err == nil, err
+	}
 
-	return createAndProcessInfo, nil
+	candidates := append(make([]*sovereign.BridgeOperationVolume, 0, len(admittedDeposits)+1), admittedDeposits...)
+	fits, err := rateLimiter.FitsInLimits(shardID, epoch, append(candidates, deposits))
+	if err != nil {
+		return nil, false, err
+	}
+	if !fits {
+		log.Debug("sovereignChainBlockProcessor: incoming rate limits reached, extended shard header is held back",
+			"shard", shardID,
+			"nonce", extendedShardHeader.GetNonce(),
+			"hash", createAndProcessInfo.currentHeaderHash,
+			"epoch", epoch,
+		)
+		scbp.appStatusHandler.AddUint64(common.MetricNumRateLimitedIncomingHeaders, 1)
+		return nil, false, nil
+	}
+
+	return deposits, true, nil
+}
+
+func (scbp *sovereignChainBlockProcessor) computeDepositsVolume(
+	extendedShardHeader data.ShardHeaderExtendedHandler,
+	extendedShardHeaderHash []byte,
+) (*sovereign.BridgeOperationVolume, error) {
+	tokens, err := scbp.depositsVolumeComputer.ComputeDepositsVolumes(extendedShardHeader)
+	if err != nil || len(tokens) == 0 {
+		return nil, err
+	}
+
+	return &sovereign.BridgeOperationVolume{
+		Hash:   extendedShardHeaderHash,
+		Tokens: tokens,
+	}, nil
 }
 
 func (scbp *sovereignChainBlockProcessor) createIncomingMiniBlocksAndTransactionsDestMe(
@@ -551,12 +675,39 @@ func (scbp *sovereignChainBlockProcessor) createIncomingMiniBlocksAndTransaction
 	return true, nil
 }
 
-func (scbp *sovereignChainBlockProcessor) requestExtendedShardHeadersIfNeeded(hdrsAdded uint32, lastExtendedShardHdr data.HeaderHandler) {
+func (scbp *sovereignChainBlockProcessor) requestExtendedShardHeadersIfNeeded(shardID uint32, hdrsAdded uint32, lastExtendedShardHdr data.HeaderHandler) {
 	log.Debug("extended shard headers added",
+		"shard", shardID,
 		"num", hdrsAdded,
 		"highest nonce", lastExtendedShardHdr.GetNonce(),
 	)
-	//TODO: A request mechanism should be implemented if extended shard header(s) is(are) needed
+
+	currentRound := scbp.roundHandler.Index()
+	if hdrsAdded > 0 {
+		scbp.lastRoundWithExtendedShardHdrs[shardID] = currentRound
+		return
+	}
+
+	// extended shard headers round is an incoming chain round, so the check is done against the last self round in
+	// which extended shard headers have been added
+	lastRoundWithExtendedShardHdrs := scbp.lastRoundWithExtendedShardHdrs[shardID]
+	roundTooOld := currentRound > lastRoundWithExtendedShardHdrs+process.MaxRoundsWithoutNewBlockReceived
+	if !roundTooOld || scbp.extendedShardHeaderTracker.IsGenesisLastCrossNotarizedHeader(shardID) {
+		return
+	}
+
+	nextNonce := lastExtendedShardHdr.GetNonce() + 1
+	_, _, err := scbp.dataPool.Headers().GetHeadersByNonceAndShardId(nextNonce, shardID)
+	if err == nil {
+		return
+	}
+
+	log.Debug("requesting next extended shard header by nonce",
+		"shard", shardID,
+		"nonce", nextNonce,
+		"last round with extended shard headers", lastRoundWithExtendedShardHdrs,
+	)
+	scbp.requestMissingHeadersFunc([]uint64{nextNonce}, shardID)
 }
 
 func (scbp *sovereignChainBlockProcessor) sortExtendedShardHeaderHashesForCurrentBlockByNonce() [][]byte {
@@ -694,41 +845,46 @@ func (scbp *sovereignChainBlockProcessor) computeAndRequestEpochStartExtendedHea
 		return 0
 	}
 
-	lastCrossChainData := sovereignChainHeader.GetLastFinalizedCrossChainHeaderHandler()
-	shouldCheckEpochStartCrossChainHash := lastCrossChainData != nil && len(lastCrossChainData.GetHeaderHash()) != 0
-	if !shouldCheckEpochStartCrossChainHash {
+	crossChainData, err := sovereign.GetEpochStartCrossChainData(scbp.marshalizer, sovereignChainHeader)
+	if err != nil {
+		log.Warn("sovereignChainBlockProcessor.computeAndRequestEpochStartExtendedHeaderIfMissing", "error", err)
 		return 0
 	}
 
-	lastCrossChainHash := lastCrossChainData.GetHeaderHash()
-	if !scbp.shouldRequestEpochStartCrossChainHash(lastCrossChainHash) {
-		return 0
-	}
+	missingHdrs := uint32(0)
+	for _, lastCrossChainData := range crossChainData {
+		lastCrossChainHash := lastCrossChainData.GetHeaderHash()
+		if !scbp.shouldRequestEpochStartCrossChainHash(lastCrossChainData.GetShardID(), lastCrossChainHash) {
+			continue
+		}
 
-	scbp.hdrsForCurrBlock.mutHdrsForBlock.Lock()
-	scbp.hdrsForCurrBlock.missingHdrs++
-	scbp.hdrsForCurrBlock.hdrHashAndInfo[string(lastCrossChainHash)] = &hdrInfo{
-		hdr:         nil,
-		usedInBlock: false,
-	}
-	scbp.hdrsForCurrBlock.mutHdrsForBlock.Unlock()
+		scbp.hdrsForCurrBlock.mutHdrsForBlock.Lock()
+		scbp.hdrsForCurrBlock.missingHdrs++
+		scbp.hdrsForCurrBlock.hdrHashAndInfo[string(lastCrossChainHash)] = &hdrInfo{
+			hdr:         nil,
+			usedInBlock: false,
+		}
+		scbp.hdrsForCurrBlock.mutHdrsForBlock.Unlock()
 
-	go scbp.extendedShardHeaderRequester.RequestExtendedShardHeader(lastCrossChainHash)
+		go scbp.extendedShardHeaderRequester.RequestExtendedShardHeader(lastCrossChainHash)
+		missingHdrs++
+	}
 
-	return 1
+	return missingHdrs
 }
 
-func (scbp *sovereignChainBlockProcessor) shouldRequestEpochStartCrossChainHash(lastCrossChainHash []byte) bool {
+func (scbp *sovereignChainBlockProcessor) shouldRequestEpochStartCrossChainHash(shardID uint32, lastCrossChainHash []byte) bool {
 	_, errMissingHdrPool := process.GetExtendedShardHeaderFromPool(
 		lastCrossChainHash,
 		scbp.dataPool.Headers())
-	_, lastNotarizedHdrHash, _ := scbp.blockTracker.GetLastCrossNotarizedHeader(core.MainChainShardId)
+	_, lastNotarizedHdrHash, _ := scbp.blockTracker.GetLastCrossNotarizedHeader(shardID)
 
 	missingHeaderInTracker := !bytes.Equal(lastNotarizedHdrHash, lastCrossChainHash)
 	missingHeaderInPool := errMissingHdrPool != nil
 	shouldRequestLastCrossChainHeader := missingHeaderInTracker || missingHeaderInPool
 
 	log.Debug("sovereignChainBlockProcessor.checkAndRequestIfMissingEpochStartExtendedHeader",
+		"shard", shardID,
 		"missingHeaderInTracker", missingHeaderInTracker,
 		"missingHeaderInPool", missingHeaderInPool,
 		"shouldRequestLastCrossChainHeader", shouldRequestLastCrossChainHeader,
@@ -824,6 +980,7 @@ func (scbp *sovereignChainBlockProcessor) ProcessBlock(headerHandler data.Header
 		return nil, nil, err
 	}
 
+	scbp.loadBridgePauseState()
 	scbp.blockChainHook.SetCurrentHeader(headerHandler)
 
 	scbp.txCoordinator.RequestBlockTransactions(body)
@@ -890,6 +1047,11 @@ func (scbp *sovereignChainBlockProcessor) ProcessBlock(headerHandler data.Header
 		}
 	}()
 
+	err = scbp.checkIncomingRateLimits(sovChainHeader, headerHandler.GetEpoch())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	newBody, err := scbp.processSovereignBlockTransactions(headerHandler, body, haveTime)
 	if err != nil {
 		return nil, nil, err
@@ -903,20 +1065,67 @@ func (scbp *sovereignChainBlockProcessor) ProcessBlock(headerHandler data.Header
 	return headerHandler, newBody, nil
 }
 
-// checkExtendedShardHeadersValidity checks if used extended shard headers are valid as construction
-func (scbp *sovereignChainBlockProcessor) checkExtendedShardHeadersValidity(
-	sovChainHeader data.SovereignChainHeaderHandler,
-) error {
-	lastCrossNotarizedHeader, _, err := scbp.blockTracker.GetLastCrossNotarizedHeader(core.MainChainShardId)
+// checkIncomingRateLimits checks, in order, the deposits of the extended shard headers included for the first time in the
+// block against the incoming rate limits of their shards, and adds them to the rate limits state, which is saved in the
+// accounts trie. This way, all the validators enforce the same limits as the proposer, from the same state.
+func (scbp *sovereignChainBlockProcessor) checkIncomingRateLimits(sovChainHeader data.SovereignChainHeaderHandler, epoch uint32) error {
+	for _, extendedShardHeaderHash := range sovChainHeader.GetExtendedShardHeaderHashes() {
+		scbp.hdrsForCurrBlock.mutHdrsForBlock.RLock()
+		headerInfo, found := scbp.hdrsForCurrBlock.hdrHashAndInfo[string(extendedShardHeaderHash)]
+		scbp.hdrsForCurrBlock.mutHdrsForBlock.RUnlock()
+		if !found {
+			return fmt.Errorf("%w in sovereignChainBlockProcessor.checkIncomingRateLimits for hash %s", process.ErrMissingHeader, extendedShardHeaderHash)
+		}
+		if !headerInfo.usedInBlock {
+			continue
+		}
+
+		err := scbp.checkIncomingRateLimitsForHeader(headerInfo.hdr, extendedShardHeaderHash, epoch)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (scbp *sovereignChainBlockProcessor) checkIncomingRateLimitsForHeader(header data.HeaderHandler, headerHash []byte, epoch uint32) error {
+	shardID, err := scbp.incomingChainsHandler.GetShardIDForHeader(header)
 	if err != nil {
 		return err
 	}
 
-	log.Trace("checkExtendedShardHeadersValidity",
-		"lastCrossNotarizedHeader nonce", lastCrossNotarizedHeader.GetNonce(),
-		"lastCrossNotarizedHeader round", lastCrossNotarizedHeader.GetRound(),
-	)
+	rateLimiter, found := scbp.incomingRateLimiters[shardID]
+	if !found || !rateLimiter.IsEnabled() || len(scbp.processedMiniBlocksTracker.GetProcessedMiniBlocksInfo(headerHash)) != 0 {
+		return nil
+	}
 
+	extendedShardHeader, ok := header.(data.ShardHeaderExtendedHandler)
+	if !ok {
+		return fmt.Errorf("%w in sovereignChainBlockProcessor.checkIncomingRateLimitsForHeader", process.ErrWrongTypeAssertion)
+	}
+
+	deposits, err := scbp.computeDepositsVolume(extendedShardHeader, headerHash)
+	if err != nil || deposits == nil {
+		return err
+	}
+
+	operations := []*sovereign.BridgeOperationVolume{deposits}
+	fits, err := rateLimiter.FitsInLimits(shardID, epoch, operations)
+	if err != nil {
+		return err
+	}
+	if !fits {
+		return fmt.Errorf("%w, shard: %d, extended shard header hash: %s", errors.ErrIncomingRateLimitsExceeded, shardID, hex.EncodeToString(headerHash))
+	}
+
+	return rateLimiter.AddOperations(shardID, epoch, operations)
+}
+
+// checkExtendedShardHeadersValidity checks if used extended shard headers are valid as construction
+func (scbp *sovereignChainBlockProcessor) checkExtendedShardHeadersValidity(
+	sovChainHeader data.SovereignChainHeaderHandler,
+) error {
 	extendedShardHdrs, err := scbp.sortExtendedShardHeadersForCurrentBlockByNonce(sovChainHeader)
 	if err != nil {
 		return err
@@ -926,12 +1135,64 @@ func (scbp *sovereignChainBlockProcessor) checkExtendedShardHeadersValidity(
 		return nil
 	}
 
+	if scbp.isIncomingBridgePaused {
+		return errors.ErrIncomingBridgePaused
+	}
+
 	// we should not have an epoch start block with main chain headers to be processed
 	if sovChainHeader.IsStartOfEpochBlock() {
 		return errors.ErrReceivedSovereignEpochStartBlockWithExtendedHeaders
 	}
 
-	if scbp.isGenesisHeaderWithNoPreviousTracking(extendedShardHdrs[0]) {
+	extendedShardHdrsPerShard, err := scbp.groupExtendedShardHeadersByShard(extendedShardHdrs)
+	if err != nil {
+		return err
+	}
+
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		err = scbp.checkExtendedShardHeadersValidityForShard(shardID, extendedShardHdrsPerShard[shardID])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (scbp *sovereignChainBlockProcessor) groupExtendedShardHeadersByShard(extendedShardHdrs []data.HeaderHandler) (map[uint32][]data.HeaderHandler, error) {
+	extendedShardHdrsPerShard := make(map[uint32][]data.HeaderHandler)
+	for _, extendedShardHdr := range extendedShardHdrs {
+		shardID, err := scbp.incomingChainsHandler.GetShardIDForHeader(extendedShardHdr)
+		if err != nil {
+			return nil, err
+		}
+
+		extendedShardHdrsPerShard[shardID] = append(extendedShardHdrsPerShard[shardID], extendedShardHdr)
+	}
+
+	return extendedShardHdrsPerShard, nil
+}
+
+func (scbp *sovereignChainBlockProcessor) checkExtendedShardHeadersValidityForShard(
+	shardID uint32,
+	extendedShardHdrs []data.HeaderHandler,
+) error {
+	if len(extendedShardHdrs) == 0 {
+		return nil
+	}
+
+	lastCrossNotarizedHeader, _, err := scbp.blockTracker.GetLastCrossNotarizedHeader(shardID)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("checkExtendedShardHeadersValidity",
+		"shard", shardID,
+		"lastCrossNotarizedHeader nonce", lastCrossNotarizedHeader.GetNonce(),
+		"lastCrossNotarizedHeader round", lastCrossNotarizedHeader.GetRound(),
+	)
+
+	if scbp.isGenesisHeaderWithNoPreviousTracking(shardID, extendedShardHdrs[0]) {
 		// we are missing pre-genesis header, so we can't link it to previous header
 		if len(extendedShardHdrs) == 1 {
 			return nil
@@ -969,12 +1230,13 @@ func (scbp *sovereignChainBlockProcessor) checkExtendedShardHeadersValidity(
 	return nil
 }
 
-// this will return true if we receive the genesis main chain header in following cases:
-//   - no notifier is attached => we did not track main chain and don't have pre-genesis header
-//   - node is in re-sync/start in the exact epoch when we start to notarize main chain => no previous
-//     main chain tracking(notifier is also disabled)
-func (scbp *sovereignChainBlockProcessor) isGenesisHeaderWithNoPreviousTracking(incomingHeader data.HeaderHandler) bool {
-	return scbp.extendedShardHeaderTracker.IsGenesisLastCrossNotarizedHeader() && incomingHeader.GetRound() == scbp.mainChainNotarizationStartRound
+// this will return true if we receive the genesis header of an incoming chain in following cases:
+//   - no notifier is attached => we did not track the incoming chain and don't have pre-genesis header
+//   - node is in re-sync/start in the exact epoch when we start to notarize the incoming chain => no previous
+//     incoming chain tracking(notifier is also disabled)
+func (scbp *sovereignChainBlockProcessor) isGenesisHeaderWithNoPreviousTracking(shardID uint32, incomingHeader data.HeaderHandler) bool {
+	return scbp.extendedShardHeaderTracker.IsGenesisLastCrossNotarizedHeader(shardID) &&
+		incomingHeader.GetRound() == scbp.incomingChainsHandler.GetNotarizationStartRound(shardID)
 }
 
 func (scbp *sovereignChainBlockProcessor) processEpochStartMetaBlock(
@@ -1062,33 +1324,77 @@ func (scbp *sovereignChainBlockProcessor) processEpochStartMetaBlock(
 	finalMiniBlocks = append(finalMiniBlocks, validatorMiniBlocks...)
 	body.MiniBlocks = finalMiniBlocks
 
+	err = scbp.createAndSetValidatorSetRotationMiniBlock(header, body)
+	if err != nil {
+		return err
+	}
+
 	return scbp.applyBodyToHeaderForEpochChange(header, body)
 }
 
-func (scbp *sovereignChainBlockProcessor) createEpochStartDataCrossChain(sovHdr data.SovereignChainHeaderHandler) error {
-	lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, err := scbp.blockTracker.GetLastCrossNotarizedHeader(core.MainChainShardId)
+// createAndSetValidatorSetRotationMiniBlock adds the validator set rotation operation in the outgoing mini block of the
+// epoch start block, so that it is signed by the validators of the ending epoch and sent to the main chain through the
+// same pipeline as any other outgoing operation. It is not held back while the outgoing bridge is paused, since only the
+// validators of the ending epoch can sign it, but it is sent only once the outgoing bridge is resumed.
+func (scbp *sovereignChainBlockProcessor) createAndSetValidatorSetRotationMiniBlock(header data.HeaderHandler, body *block.Body) error {
+	if !scbp.enableEpochsHandler.IsFlagEnabledInEpoch(common.SovereignValidatorSetRotationFlag, header.GetEpoch()) {
+		return nil
+	}
+
+	destinationBatches, err := scbp.validatorSetRotationCreator.CreateValidatorSetRotationOperation(header, body)
 	if err != nil {
 		return err
 	}
-
-	if lastCrossNotarizedHeader.GetNonce() == 0 {
-		log.Debug("sovereignChainBlockProcessor.createEpochStartDataCrossChain: no cross chain header notarized yet")
+	if destinationBatches == nil {
 		return nil
 	}
 
-	log.Debug("sovereignChainBlockProcessor.createEpochStartDataCrossChain",
-		"lastCrossNotarizedHeaderHash", lastCrossNotarizedHeaderHash,
-		"lastCrossNotarizedHeaderRound", lastCrossNotarizedHeader.GetRound(),
-		"lastCrossNotarizedHeaderNonce", lastCrossNotarizedHeader.GetNonce(),
-	)
+	outGoingMb, outGoingOperationsHash, batches, err := scbp.createOutGoingMiniBlockData(header, []*sovereign.OutGoingDestinationBatches{destinationBatches})
+	if err != nil {
+		return err
+	}
+
+	return scbp.setOutGoingMiniBlock(header, body, outGoingMb, outGoingOperationsHash, batches)
+}
+
+// createEpochStartDataCrossChain records in the epoch start header the last cross notarized header of each incoming
+// chain, so that nodes bootstrapping from the epoch start header can restore the tracking of all the incoming chains
+func (scbp *sovereignChainBlockProcessor) createEpochStartDataCrossChain(header data.HeaderHandler) error {
+	sovHdr, castOk := header.(*block.SovereignChainHeader)
+	if !castOk {
+		return fmt.Errorf("%w in sovereignChainBlockProcessor.createEpochStartDataCrossChain", process.ErrWrongTypeAssertion)
+	}
+
+	crossChainData := make([]*block.EpochStartCrossChainData, 0)
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		lastCrossNotarizedHeader, lastCrossNotarizedHeaderHash, err := scbp.blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err != nil {
+			return err
+		}
+
+		if lastCrossNotarizedHeader.GetNonce() == 0 {
+			log.Debug("sovereignChainBlockProcessor.createEpochStartDataCrossChain: no cross chain header notarized yet",
+				"shard", shardID)
+			continue
+		}
+
+		log.Debug("sovereignChainBlockProcessor.createEpochStartDataCrossChain",
+			"shard", shardID,
+			"lastCrossNotarizedHeaderHash", lastCrossNotarizedHeaderHash,
+			"lastCrossNotarizedHeaderRound", lastCrossNotarizedHeader.GetRound(),
+			"lastCrossNotarizedHeaderNonce", lastCrossNotarizedHeader.GetNonce(),
+		)
 
-	return sovHdr.SetLastFinalizedCrossChainHeaderHandler(&block.EpochStartCrossChainData{
-		ShardID:    core.MainChainShardId,
-		Epoch:      lastCrossNotarizedHeader.GetEpoch(),
-		Round:      lastCrossNotarizedHeader.GetRound(),
-		Nonce:      lastCrossNotarizedHeader.GetNonce(),
-		HeaderHash: lastCrossNotarizedHeaderHash,
-	})
+		crossChainData = append(crossChainData, &block.EpochStartCrossChainData{
+			ShardID:    shardID,
+			Epoch:      lastCrossNotarizedHeader.GetEpoch(),
+			Round:      lastCrossNotarizedHeader.GetRound(),
+			Nonce:      lastCrossNotarizedHeader.GetNonce(),
+			HeaderHash: lastCrossNotarizedHeaderHash,
+		})
+	}
+
+	return sovereign.SetEpochStartCrossChainData(scbp.marshalizer, sovHdr, crossChainData)
 }
 
 func (scbp *sovereignChainBlockProcessor) applyBodyToHeaderForEpochChange(header data.HeaderHandler, body *block.Body) error {
@@ -1169,18 +1475,20 @@ func (scbp *sovereignChainBlockProcessor) saveMiniBlocksToPool(
 }
 
 func (scbp *sovereignChainBlockProcessor) checkAndRequestIfExtendedShardHeadersMissing() {
-	orderedExtendedShardHeaders, _ := scbp.blockTracker.GetTrackedHeaders(core.MainChainShardId)
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		orderedExtendedShardHeaders, _ := scbp.blockTracker.GetTrackedHeaders(shardID)
 
-	err := scbp.requestHeadersIfMissing(orderedExtendedShardHeaders, core.MainChainShardId)
-	if err != nil {
-		log.Debug("checkAndRequestIfExtendedShardHeadersMissing", "error", err.Error())
+		err := scbp.requestHeadersIfMissing(orderedExtendedShardHeaders, shardID)
+		if err != nil {
+			log.Debug("checkAndRequestIfExtendedShardHeadersMissing", "shard", shardID, "error", err.Error())
+		}
 	}
 }
 
 func (scbp *sovereignChainBlockProcessor) requestMissingHeaders(missingNonces []uint64, shardId uint32) {
 	for _, nonce := range missingNonces {
 		scbp.addHeaderIntoTrackerPool(nonce, shardId)
-		go scbp.extendedShardHeaderRequester.RequestExtendedShardHeaderByNonce(nonce)
+		go scbp.extendedShardHeaderRequester.RequestIncomingChainHeaderByNonce(shardId, nonce)
 	}
 }
 
@@ -1237,9 +1545,14 @@ func (scbp *sovereignChainBlockProcessor) verifyCrossShardMiniBlockDstMe(soverei
 }
 
 func (scbp *sovereignChainBlockProcessor) getAllMiniBlockDstMeFromExtendedShardHeaders(sovereignChainHeader data.SovereignChainHeaderHandler) (map[string][]byte, error) {
-	lastCrossNotarizedHeader, _, err := scbp.blockTracker.GetLastCrossNotarizedHeader(core.MainChainShardId)
-	if err != nil {
-		return nil, err
+	lastCrossNotarizedHeaders := make(map[uint32]data.HeaderHandler)
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		lastCrossNotarizedHeader, _, err := scbp.blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err != nil {
+			return nil, err
+		}
+
+		lastCrossNotarizedHeaders[shardID] = lastCrossNotarizedHeader
 	}
 
 	miniBlockExtendedShardHeaderHashes := make(map[string][]byte)
@@ -1254,6 +1567,14 @@ func (scbp *sovereignChainBlockProcessor) getAllMiniBlockDstMeFromExtendedShardH
 		if !ok {
 			continue
 		}
+		shardID, err := scbp.incomingChainsHandler.GetShardIDForHeader(shardHeaderExtended)
+		if err != nil {
+			continue
+		}
+		lastCrossNotarizedHeader, ok := lastCrossNotarizedHeaders[shardID]
+		if !ok {
+			continue
+		}
 		if shardHeaderExtended.GetRound() <= lastCrossNotarizedHeader.GetRound() {
 			continue
 		}
@@ -1265,6 +1586,7 @@ func (scbp *sovereignChainBlockProcessor) getAllMiniBlockDstMeFromExtendedShardH
 		for _, mb := range incomingMiniBlocks {
 			mbHash, errCalculateHash := core.CalculateHash(scbp.marshalizer, scbp.hasher, mb)
 			if errCalculateHash != nil {
+				scbp.hdrsForCurrBlock.mutHdrsForBlock.RUnlock()
 				return nil, errCalculateHash
 			}
 
@@ -1420,51 +1742,150 @@ func (scbp *sovereignChainBlockProcessor) processSovereignBlockTransactions(
 	return scbp.applyBodyToHeader(headerHandler, createdBlockBody)
 }
 
+// loadBridgePauseState loads the bridge pause flags before any transaction of the block is processed, so that the whole
+// block is created and processed with the pause flags committed by the previous block. These are the same flags the
+// consensus reads from the committed state, even if the block itself changes them.
+func (scbp *sovereignChainBlockProcessor) loadBridgePauseState() {
+	scbp.isIncomingBridgePaused = scbp.bridgePauseHandler.IsIncomingPaused()
+	scbp.isOutGoingBridgePaused = scbp.bridgePauseHandler.IsOutGoingPaused()
+}
+
+// createAndSetOutGoingMiniBlock creates the outgoing mini block from the bridge operations of the block logs. While the
+// outgoing bridge is paused, no outgoing mini block is created, since the operations are held back in the state, to be
+// bridged once the outgoing bridge is resumed.
 func (scbp *sovereignChainBlockProcessor) createAndSetOutGoingMiniBlock(headerHandler data.HeaderHandler, createdBlockBody *block.Body) error {
 	logs := scbp.txCoordinator.GetAllCurrentLogs()
-	outGoingOperations, err := scbp.outgoingOperationsFormatter.CreateOutgoingTxsData(logs)
+	if scbp.isOutGoingBridgePaused {
+		return scbp.outgoingOperationsRouter.HoldOutgoingTxsData(logs, headerHandler)
+	}
+
+	destinationsBatches, err := scbp.outgoingOperationsRouter.CreateOutgoingTxsData(logs, headerHandler)
 	if err != nil {
 		return err
 	}
 
-	if len(outGoingOperations) == 0 {
+	if len(destinationsBatches) == 0 {
 		return nil
 	}
 
-	outGoingMb, outGoingOperationsHash := scbp.createOutGoingMiniBlockData(outGoingOperations)
-	return scbp.setOutGoingMiniBlock(headerHandler, createdBlockBody, outGoingMb, outGoingOperationsHash)
+	outGoingMb, outGoingOperationsHash, batches, err := scbp.createOutGoingMiniBlockData(headerHandler, destinationsBatches)
+	if err != nil {
+		return err
+	}
+
+	return scbp.setOutGoingMiniBlock(headerHandler, createdBlockBody, outGoingMb, outGoingOperationsHash, batches)
 }
 
-func (scbp *sovereignChainBlockProcessor) createOutGoingMiniBlockData(outGoingOperations [][]byte) (*block.MiniBlock, []byte) {
-	outGoingOpHashes := make([][]byte, len(outGoingOperations))
+// createOutGoingMiniBlockData creates the outgoing mini block with all the operations from all batches of all destinations
+// and adds each batch in the outgoing operations pool, for its destination. Once the outgoing operations batches are
+// enabled, the returned batches are saved in the header, in destinations and batches order, since each batch is signed
+// and bridged separately, while the returned outgoing operations hash is the hash over all batches hashes. Before, all
+// the operations are added in a single batch, whose hash is the returned outgoing operations hash.
+func (scbp *sovereignChainBlockProcessor) createOutGoingMiniBlockData(
+	headerHandler data.HeaderHandler,
+	destinationsBatches []*sovereign.OutGoingDestinationBatches,
+) (*block.MiniBlock, []byte, []*outgoingBatches.OutGoingOperationsBatch, error) {
+	if !scbp.enableEpochsHandler.IsFlagEnabledInEpoch(common.SovereignOutGoingOperationsBatchesFlag, headerHandler.GetEpoch()) {
+		return scbp.createLegacyOutGoingMiniBlockData(headerHandler, destinationsBatches)
+	}
+
+	outGoingOpHashes := make([][]byte, 0)
+	batches := make([]*outgoingBatches.OutGoingOperationsBatch, 0)
+	batchesHashes := make([]byte, 0)
+
+	for _, destinationBatches := range destinationsBatches {
+		for _, outGoingOperations := range destinationBatches.Batches {
+			batch, batchOpHashes, err := scbp.addOutGoingOperationsBatch(outGoingOperations, destinationBatches, headerHandler.GetEpoch())
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			outGoingOpHashes = append(outGoingOpHashes, batchOpHashes...)
+			batches = append(batches, batch)
+			batchesHashes = append(batchesHashes, batch.Hash...)
+		}
+	}
+
+	return scbp.createOutGoingMiniBlock(outGoingOpHashes), scbp.hasher.Compute(string(batchesHashes)), batches, nil
+}
+
+func (scbp *sovereignChainBlockProcessor) createLegacyOutGoingMiniBlockData(
+	headerHandler data.HeaderHandler,
+	destinationsBatches []*sovereign.OutGoingDestinationBatches,
+) (*block.MiniBlock, []byte, []*outgoingBatches.OutGoingOperationsBatch, error) {
+	if len(destinationsBatches) > 1 {
+		return nil, nil, nil, fmt.Errorf("%w, num destinations = %d", process.ErrMultipleOutGoingDestinationsNotEnabled, len(destinationsBatches))
+	}
+
+	outGoingOperations := make([][]byte, 0)
+	for _, outGoingOperationsBatch := range destinationsBatches[0].Batches {
+		outGoingOperations = append(outGoingOperations, outGoingOperationsBatch...)
+	}
+
+	batch, outGoingOpHashes, err := scbp.addOutGoingOperationsBatch(outGoingOperations, destinationsBatches[0], headerHandler.GetEpoch())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return scbp.createOutGoingMiniBlock(outGoingOpHashes), batch.Hash, nil, nil
+}
+
+func (scbp *sovereignChainBlockProcessor) createOutGoingMiniBlock(outGoingOpHashes [][]byte) *block.MiniBlock {
+	return &block.MiniBlock{
+		TxHashes:        outGoingOpHashes,
+		ReceiverShardID: core.MainChainShardId,
+		SenderShardID:   scbp.shardCoordinator.SelfId(),
+	}
+}
+
+// addOutGoingOperationsBatch hashes the operations of a batch with the destination hasher and adds the batch in the
+// outgoing operations pool. Besides the signed batch hash, the returned batch holds the merkle root of its operations
+// hashes and its number of operations. Once the outgoing operations merkle root is enabled, the signed batch hash is the
+// merkle root itself, so that each operation can be proven against a signed value. Before, it is the hash over all the
+// operations hashes. The hashes of the batch operations are also returned.
+func (scbp *sovereignChainBlockProcessor) addOutGoingOperationsBatch(
+	outGoingOperations [][]byte,
+	destinationBatches *sovereign.OutGoingDestinationBatches,
+	epoch uint32,
+) (*outgoingBatches.OutGoingOperationsBatch, [][]byte, error) {
+	outGoingOpHashes := make([][]byte, 0, len(outGoingOperations))
 	aggregatedOutGoingOperations := make([]byte, 0)
-	outGoingOperationsData := make([]*sovCore.OutGoingOperation, 0)
+	outGoingOperationsData := make([]*sovCore.OutGoingOperation, 0, len(outGoingOperations))
 
-	for idx, outGoingOp := range outGoingOperations {
-		outGoingOpHash := scbp.operationsHasher.Compute(string(outGoingOp))
+	for _, outGoingOp := range outGoingOperations {
+		outGoingOpHash := destinationBatches.Hasher.Compute(string(outGoingOp))
 		aggregatedOutGoingOperations = append(aggregatedOutGoingOperations, outGoingOpHash...)
 
 		outGoingOpData := &sovCore.OutGoingOperation{
 			Hash: outGoingOpHash,
 			Data: outGoingOp,
 		}
-		outGoingOpHashes[idx] = outGoingOpHash
+		outGoingOpHashes = append(outGoingOpHashes, outGoingOpHash)
 		outGoingOperationsData = append(outGoingOperationsData, outGoingOpData)
 
 		scbp.addOutGoingTxToPool(outGoingOpData)
 	}
 
-	outGoingOperationsHash := scbp.operationsHasher.Compute(string(aggregatedOutGoingOperations))
-	scbp.outGoingOperationsPool.Add(&sovCore.BridgeOutGoingData{
-		Hash:               outGoingOperationsHash,
+	merkleRoot, err := common.ComputeOutGoingOperationsMerkleRoot(destinationBatches.Hasher, outGoingOpHashes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	batchHash := merkleRoot
+	if !scbp.enableEpochsHandler.IsFlagEnabledInEpoch(common.SovereignOutGoingOperationsMerkleRootFlag, epoch) {
+		batchHash = destinationBatches.Hasher.Compute(string(aggregatedOutGoingOperations))
+	}
+
+	scbp.outGoingOperationsPool.AddWithDestination(&sovCore.BridgeOutGoingData{
+		Hash:               batchHash,
 		OutGoingOperations: outGoingOperationsData,
-	})
+	}, destinationBatches.Destination)
 
-	return &block.MiniBlock{
-		TxHashes:        outGoingOpHashes,
-		ReceiverShardID: core.MainChainShardId,
-		SenderShardID:   scbp.shardCoordinator.SelfId(),
-	}, outGoingOperationsHash
+	return &outgoingBatches.OutGoingOperationsBatch{
+		Hash:          batchHash,
+		MerkleRoot:    merkleRoot,
+		NumOperations: uint32(len(outGoingOpHashes)),
+	}, outGoingOpHashes, nil
 }
 
 func (scbp *sovereignChainBlockProcessor) addOutGoingTxToPool(outGoingOp *sovCore.OutGoingOperation) {
@@ -1491,6 +1912,7 @@ func (scbp *sovereignChainBlockProcessor) setOutGoingMiniBlock(
 	createdBlockBody *block.Body,
 	outGoingMb *block.MiniBlock,
 	outGoingOperationsHash []byte,
+	batches []*outgoingBatches.OutGoingOperationsBatch,
 ) error {
 	outGoingMbHash, err := core.CalculateHash(scbp.marshalizer, scbp.hasher, outGoingMb)
 	if err != nil {
@@ -1512,6 +1934,11 @@ func (scbp *sovereignChainBlockProcessor) setOutGoingMiniBlock(
 		return err
 	}
 
+	err = outgoingBatches.SetOutGoingOperationsBatches(headerHandler, batches)
+	if err != nil {
+		return err
+	}
+
 	createdBlockBody.MiniBlocks = append(createdBlockBody.MiniBlocks, outGoingMb)
 	scbp.txCoordinator.AddTxsFromMiniBlocks([]*block.MiniBlock{outGoingMb})
 	return nil
@@ -1653,8 +2080,17 @@ func (scbp *sovereignChainBlockProcessor) CommitBlock(headerHandler data.HeaderH
 		return err
 	}
 
-	if len(processedExtendedShardHdrs) > 0 {
-		err = scbp.saveLastNotarizedHeader(core.MainChainShardId, processedExtendedShardHdrs)
+	processedExtendedShardHdrsPerShard, err := scbp.groupExtendedShardHeadersByShard(processedExtendedShardHdrs)
+	if err != nil {
+		return err
+	}
+
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		if len(processedExtendedShardHdrsPerShard[shardID]) == 0 {
+			continue
+		}
+
+		err = scbp.saveLastNotarizedHeader(shardID, processedExtendedShardHdrsPerShard[shardID])
 		if err != nil {
 			return err
 		}
@@ -1726,10 +2162,22 @@ func (scbp *sovereignChainBlockProcessor) CommitBlock(headerHandler data.HeaderH
 	}
 
 	scbp.indexValidatorsRatingIfNeeded(headerHandler)
+	scbp.processUnconfirmedOutGoingOperations(headerHandler)
 
 	return nil
 }
 
+// processUnconfirmedOutGoingOperations accounts the send attempts of the unconfirmed outgoing operations against the
+// committed block timestamp, so that all the nodes agree on them. While the outgoing bridge is paused, no operation is
+// sent, hence no send attempt is counted.
+func (scbp *sovereignChainBlockProcessor) processUnconfirmedOutGoingOperations(header data.HeaderHandler) {
+	if scbp.isOutGoingBridgePaused {
+		return
+	}
+
+	scbp.outGoingOperationsPool.ProcessUnconfirmedOperations(time.Unix(int64(header.GetTimeStamp()), 0))
+}
+
 func (scbp *sovereignChainBlockProcessor) indexValidatorsRatingIfNeeded(
 	header data.HeaderHandler,
 ) {
@@ -1951,15 +2399,27 @@ func (scbp *sovereignChainBlockProcessor) setProcessedMiniBlocks(
 }
 
 func (scbp *sovereignChainBlockProcessor) updateCrossShardInfo(processedExtendedShardHdrs []data.HeaderHandler) error {
-	lastCrossNotarizedHeader, _, err := scbp.blockTracker.GetLastCrossNotarizedHeader(core.MainChainShardId)
-	if err != nil {
-		return err
-	}
+	lastCrossNotarizedHeaders := make(map[uint32]data.HeaderHandler)
 
 	// processedExtendedShardHdrs is also sorted
 	for i := 0; i < len(processedExtendedShardHdrs); i++ {
 		hdr := processedExtendedShardHdrs[i]
 
+		shardID, err := scbp.incomingChainsHandler.GetShardIDForHeader(hdr)
+		if err != nil {
+			return err
+		}
+
+		lastCrossNotarizedHeader, found := lastCrossNotarizedHeaders[shardID]
+		if !found {
+			lastCrossNotarizedHeader, _, err = scbp.blockTracker.GetLastCrossNotarizedHeader(shardID)
+			if err != nil {
+				return err
+			}
+
+			lastCrossNotarizedHeaders[shardID] = lastCrossNotarizedHeader
+		}
+
 		// remove process finished
 		if hdr.GetNonce() > lastCrossNotarizedHeader.GetNonce() {
 			continue
@@ -1974,7 +2434,7 @@ func (scbp *sovereignChainBlockProcessor) updateCrossShardInfo(processedExtended
 
 		headerHash := scbp.hasher.Compute(string(marshalledHeader))
 
-		scbp.saveExtendedShardHeader(hdr, headerHash, marshalledHeader)
+		scbp.saveExtendedShardHeader(hdr, shardID, headerHash, marshalledHeader)
 
 		scbp.processedMiniBlocksTracker.RemoveHeaderHash(headerHash)
 	}
@@ -1982,12 +2442,13 @@ func (scbp *sovereignChainBlockProcessor) updateCrossShardInfo(processedExtended
 	return nil
 }
 
-func (scbp *sovereignChainBlockProcessor) saveExtendedShardHeader(header data.HeaderHandler, headerHash []byte, marshalizedHeader []byte) {
+func (scbp *sovereignChainBlockProcessor) saveExtendedShardHeader(header data.HeaderHandler, shardID uint32, headerHash []byte, marshalizedHeader []byte) {
 	startTime := time.Now()
 
 	nonceToByteSlice := scbp.uint64Converter.ToByteSlice(header.GetNonce())
+	nonceHashDataUnit := scbp.incomingChainsHandler.GetNonceHashDataUnit(shardID)
 
-	errNotCritical := scbp.store.Put(dataRetriever.ExtendedShardHeadersNonceHashDataUnit, nonceToByteSlice, headerHash)
+	errNotCritical := scbp.store.Put(nonceHashDataUnit, nonceToByteSlice, headerHash)
 	if errNotCritical != nil {
 		logging.LogErrAsWarnExceptAsDebugIfClosingError(log, errNotCritical,
 			"saveExtendedShardHeader.Put -> ExtendedShardHdrNonceHashDataUnit",
@@ -2043,7 +2504,7 @@ func (scbp *sovereignChainBlockProcessor) RestoreBlockIntoPools(header data.Head
 		return fmt.Errorf("%w in sovereignChainBlockProcessor.RestoreBlockIntoPools", errors.ErrWrongTypeAssertion)
 	}
 
-	err := scbp.restoreExtendedHeaderIntoPool(sovChainHdr.GetExtendedShardHeaderHashes())
+	restoredShardIDs, err := scbp.restoreExtendedHeaderIntoPool(sovChainHdr.GetExtendedShardHeaderHashes())
 	if err != nil {
 		return err
 	}
@@ -2056,41 +2517,57 @@ func (scbp *sovereignChainBlockProcessor) RestoreBlockIntoPools(header data.Head
 		return nil
 	}
 
-	scbp.extendedShardHeaderTracker.RemoveLastCrossNotarizedHeaders()
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		if _, found := restoredShardIDs[shardID]; found {
+			scbp.extendedShardHeaderTracker.RemoveLastCrossNotarizedHeader(shardID)
+		}
+	}
+
 	return nil
 }
 
-func (scbp *sovereignChainBlockProcessor) restoreExtendedHeaderIntoPool(extendedShardHeaderHashes [][]byte) error {
+// restoreExtendedHeaderIntoPool restores the committed extended headers into pool and returns the shard IDs of the
+// incoming chains which had extended headers notarized in the reverted block
+func (scbp *sovereignChainBlockProcessor) restoreExtendedHeaderIntoPool(extendedShardHeaderHashes [][]byte) (map[uint32]struct{}, error) {
+	restoredShardIDs := make(map[uint32]struct{})
 	for _, extendedHdrHash := range extendedShardHeaderHashes {
 		extendedHdr, errNotCritical := process.GetExtendedShardHeaderFromStorage(extendedHdrHash, scbp.marshalizer, scbp.store)
 		if errNotCritical != nil {
 			log.Debug("extended header is not fully processed yet and not committed in ExtendedShardHeadersUnit",
 				"hash", extendedHdrHash)
+			restoredShardIDs[scbp.getShardIDForExtendedHeaderFromPool(extendedHdrHash)] = struct{}{}
 			continue
 		}
 
-		scbp.dataPool.Headers().AddHeaderInShard(extendedHdrHash, extendedHdr, core.MainChainShardId)
+		shardID, err := scbp.incomingChainsHandler.GetShardIDForHeader(extendedHdr)
+		if err != nil {
+			return nil, err
+		}
+
+		restoredShardIDs[shardID] = struct{}{}
+		scbp.dataPool.Headers().AddHeaderInShard(extendedHdrHash, extendedHdr, shardID)
 
 		extendedHdrStorer, err := scbp.store.GetStorer(dataRetriever.ExtendedShardHeadersUnit)
 		if err != nil {
 			log.Error("unable to get storage unit",
 				"unit", dataRetriever.ExtendedShardHeadersUnit.String())
-			return err
+			return nil, err
 		}
 
 		err = extendedHdrStorer.Remove(extendedHdrHash)
 		if err != nil {
 			log.Error("unable to remove hash from ExtendedShardHeadersUnit",
 				"hash", extendedHdrHash)
-			return err
+			return nil, err
 		}
 
 		nonceToByteSlice := scbp.uint64Converter.ToByteSlice(extendedHdr.GetNonce())
-		extendedHdrNonceHashStorer, err := scbp.store.GetStorer(dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
+		nonceHashDataUnit := scbp.incomingChainsHandler.GetNonceHashDataUnit(shardID)
+		extendedHdrNonceHashStorer, err := scbp.store.GetStorer(nonceHashDataUnit)
 		if err != nil {
 			log.Error("unable to get storage unit",
-				"unit", dataRetriever.ExtendedShardHeadersNonceHashDataUnit.String())
-			return err
+				"unit", nonceHashDataUnit.String())
+			return nil, err
 		}
 
 		errNotCritical = extendedHdrNonceHashStorer.Remove(nonceToByteSlice)
@@ -2100,12 +2577,29 @@ func (scbp *sovereignChainBlockProcessor) restoreExtendedHeaderIntoPool(extended
 		}
 
 		log.Debug("extended block has been restored successfully",
+			"shard", shardID,
 			"round", extendedHdr.GetRound(),
 			"nonce", extendedHdr.GetNonce(),
 			"hash", extendedHdrHash)
 	}
 
-	return nil
+	return restoredShardIDs, nil
+}
+
+// getShardIDForExtendedHeaderFromPool returns the shard ID of the incoming chain of the extended header from pool. If the
+// header is not found or its chain is unknown, the main chain shard ID is returned.
+func (scbp *sovereignChainBlockProcessor) getShardIDForExtendedHeaderFromPool(extendedHdrHash []byte) uint32 {
+	extendedHdr, err := process.GetExtendedShardHeaderFromPool(extendedHdrHash, scbp.dataPool.Headers())
+	if err != nil {
+		return core.MainChainShardId
+	}
+
+	shardID, err := scbp.incomingChainsHandler.GetShardIDForHeader(extendedHdr)
+	if err != nil {
+		return core.MainChainShardId
+	}
+
+	return shardID
 }
 
 // RevertStateToBlock reverts state in tries
@@ -2273,15 +2767,20 @@ func (scbp *sovereignChainBlockProcessor) updateState(header data.HeaderHandler,
 
 // TODO: (sovereign) remove these cleanUpFunc pointer functions once shardCoordinator will return the correct shard id from task: MX-14132
 func (scbp *sovereignChainBlockProcessor) cleanupBlockTrackerPoolsForShard(shardID uint32, noncesToPrevFinal uint64) {
-	actualShardID := shardID
-	if shardID == core.MetachainShardId {
-		actualShardID = core.MainChainShardId
+	if shardID != core.MetachainShardId {
+		scbp.baseCleanupBlockTrackerPoolsForShard(shardID, noncesToPrevFinal)
+		return
+	}
+
+	for _, incomingShardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		scbp.baseCleanupBlockTrackerPoolsForShard(incomingShardID, noncesToPrevFinal)
 	}
-	scbp.baseCleanupBlockTrackerPoolsForShard(actualShardID, noncesToPrevFinal)
 }
 
 func (scbp *sovereignChainBlockProcessor) cleanupPoolsForCrossShard(_ uint32, noncesToPrevFinal uint64) {
-	scbp.baseCleanupPoolsForCrossShard(core.MainChainShardId, noncesToPrevFinal)
+	for _, shardID := range scbp.incomingChainsHandler.GetShardIDs() {
+		scbp.baseCleanupPoolsForCrossShard(shardID, noncesToPrevFinal)
+	}
 }
 
 func (scbp *sovereignChainBlockProcessor) removeStartOfEpochBlockDataFromPools(
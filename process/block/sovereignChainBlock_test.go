@@ -2,23 +2,33 @@ package block_test
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/dataRetriever/requestHandlers"
 	errMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 	blproc "github.com/multiversx/mx-chain-go/process/block"
+	sovBlock "github.com/multiversx/mx-chain-go/process/block/sovereign"
+	sovBlockDisabled "github.com/multiversx/mx-chain-go/process/block/sovereign/disabled"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/incomingHeader"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/track"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	statusHandlerMock "github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	storageStub "github.com/multiversx/mx-chain-go/testscommon/storage"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 
@@ -26,6 +36,12 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-core-go/hashing/sha256"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
 )
 
@@ -46,28 +62,100 @@ func createSovChainBaseBlockProcessorArgs() blproc.ArgShardProcessor {
 	coreComp.Hash = &hashingMocks.HasherMock{}
 
 	arguments := CreateMockArguments(coreComp, dataComp, bootstrapComp, statusComp)
-	arguments.BlockTracker, _ = track.NewSovereignChainShardBlockTrack(sbt)
+	arguments.BlockTracker, _ = track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 	arguments.RequestHandler, _ = requestHandlers.NewSovereignResolverRequestHandler(rrh)
 
 	return arguments
 }
 
+func createOutgoingOperationsRouter(formatter sovBlock.OutgoingOperationsFormatter, hasher hashing.Hasher) sovBlock.OutgoingOperationsRouter {
+	router, _ := sovBlock.NewOutgoingOperationsRouter(sovBlock.ArgsOutgoingOperationsRouter{
+		Destinations: []sovBlock.OutGoingDestination{
+			{
+				Name:      "",
+				Formatter: formatter,
+				Hasher:    hasher,
+				DataCodec: &sovereign.DataCodecMock{},
+			},
+		},
+		OperationsHasher: hasher,
+		RateLimiter:      createDisabledBridgeRateLimiter(),
+	})
+
+	return router
+}
+
+// createSystemAccountAccountsStub creates an accounts adapter whose system account saves its data in memory
+func createSystemAccountAccountsStub() *stateMock.AccountsStub {
+	systemAccountData := make(map[string][]byte)
+	systemAccount := &stateMock.UserAccountStub{
+		RetrieveValueCalled: func(key []byte) ([]byte, uint32, error) {
+			return systemAccountData[string(key)], 0, nil
+		},
+		SaveKeyValueCalled: func(key []byte, value []byte) error {
+			systemAccountData[string(key)] = value
+			return nil
+		},
+	}
+	getSystemAccount := func(_ []byte) (vmcommon.AccountHandler, error) {
+		return systemAccount, nil
+	}
+
+	return &stateMock.AccountsStub{
+		GetExistingAccountCalled: getSystemAccount,
+		LoadAccountCalled:        getSystemAccount,
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			return nil
+		},
+	}
+}
+
+func createDisabledBridgeRateLimiter() sovBlock.BridgeRateLimiter {
+	rateLimiter, _ := sovBlock.NewBridgeRateLimiter(sovBlock.ArgsBridgeRateLimiter{
+		Accounts:         createSystemAccountAccountsStub(),
+		Marshaller:       &marshallerMock.MarshalizerMock{},
+		AppStatusHandler: &statusHandlerMock.AppStatusHandlerStub{},
+	})
+
+	return rateLimiter
+}
+
+func createDepositsVolumeComputer(dataCodec incomingHeader.SovereignDataCodec) sovBlock.DepositsVolumeComputer {
+	depositsVolumeComputer, _ := incomingHeader.NewDepositsVolumeComputer(incomingHeader.ArgsDepositsVolumeComputer{
+		Marshaller:    &marshallerMock.MarshalizerMock{},
+		Hasher:        &hashingMocks.HasherMock{},
+		DataCodec:     dataCodec,
+		TopicsChecker: &sovereign.TopicsCheckerMock{},
+	})
+
+	return depositsVolumeComputer
+}
+
 func createSovChainBlockProcessorArgs() blproc.ArgsSovereignChainBlockProcessor {
 	baseArgs := createSovChainBaseBlockProcessorArgs()
 	sp, _ := blproc.NewShardProcessor(baseArgs)
 	return blproc.ArgsSovereignChainBlockProcessor{
-		ShardProcessor:                  sp,
-		ValidatorStatisticsProcessor:    &testscommon.ValidatorStatisticsProcessorStub{},
-		OutgoingOperationsFormatter:     &sovereign.OutgoingOperationsFormatterMock{},
-		OutGoingOperationsPool:          &sovereign.OutGoingOperationsPoolMock{},
-		OperationsHasher:                &testscommon.HasherStub{},
-		EpochStartDataCreator:           &mock.EpochStartDataCreatorStub{},
-		EpochRewardsCreator:             &testscommon.RewardsCreatorStub{},
-		ValidatorInfoCreator:            &testscommon.EpochValidatorInfoCreatorStub{},
-		EpochSystemSCProcessor:          &testscommon.EpochStartSystemSCStub{},
-		EpochEconomics:                  &mock.EpochEconomicsStub{},
-		SCToProtocol:                    &mock.SCToProtocolStub{},
-		MainChainNotarizationStartRound: 11,
+		ShardProcessor:               sp,
+		ValidatorStatisticsProcessor: &testscommon.ValidatorStatisticsProcessorStub{},
+		OutgoingOperationsRouter:     createOutgoingOperationsRouter(&sovereign.OutgoingOperationsFormatterMock{}, &testscommon.HasherStub{}),
+		OutGoingOperationsPool:       &sovereign.OutGoingOperationsPoolMock{},
+		EpochStartDataCreator:        &mock.EpochStartDataCreatorStub{},
+		EpochRewardsCreator:          &testscommon.RewardsCreatorStub{},
+		ValidatorInfoCreator:         &testscommon.EpochValidatorInfoCreatorStub{},
+		EpochSystemSCProcessor:       &testscommon.EpochStartSystemSCStub{},
+		EpochEconomics:               &mock.EpochEconomicsStub{},
+		SCToProtocol:                 &mock.SCToProtocolStub{},
+		IncomingChainsHandler: &sovereign.IncomingChainsHandlerMock{
+			GetNotarizationStartRoundCalled: func(_ uint32) uint64 {
+				return 11
+			},
+		},
+		BridgePauseHandler:          &sovereign.BridgePauseHandlerMock{},
+		ValidatorSetRotationCreator: sovBlockDisabled.NewDisabledValidatorSetRotationCreator(),
+		IncomingRateLimiters: map[uint32]sovBlock.BridgeRateLimiter{
+			core.MainChainShardId: createDisabledBridgeRateLimiter(),
+		},
+		DepositsVolumeComputer: createDepositsVolumeComputer(&sovereign.DataCodecMock{}),
 	}
 }
 
@@ -123,15 +211,15 @@ func TestSovereignBlockProcessor_NewSovereignChainBlockProcessorShouldWork(t *te
 		require.ErrorIs(t, err, process.ErrNilValidatorStatistics)
 	})
 
-	t.Run("should error when outgoing operations formatter is nil", func(t *testing.T) {
+	t.Run("should error when outgoing operations router is nil", func(t *testing.T) {
 		t.Parallel()
 
 		args := createSovChainBlockProcessorArgs()
-		args.OutgoingOperationsFormatter = nil
+		args.OutgoingOperationsRouter = nil
 		scbp, err := blproc.NewSovereignChainBlockProcessor(args)
 
 		require.Nil(t, scbp)
-		require.ErrorIs(t, err, errMx.ErrNilOutgoingOperationsFormatter)
+		require.ErrorIs(t, err, errMx.ErrNilOutgoingOperationsRouter)
 	})
 
 	t.Run("should error when outgoing operation pool is nil", func(t *testing.T) {
@@ -145,17 +233,6 @@ func TestSovereignBlockProcessor_NewSovereignChainBlockProcessorShouldWork(t *te
 		require.Equal(t, errMx.ErrNilOutGoingOperationsPool, err)
 	})
 
-	t.Run("should error when operations hasher is nil", func(t *testing.T) {
-		t.Parallel()
-
-		args := createSovChainBlockProcessorArgs()
-		args.OperationsHasher = nil
-		scbp, err := blproc.NewSovereignChainBlockProcessor(args)
-
-		require.Nil(t, scbp)
-		require.Equal(t, errMx.ErrNilOperationsHasher, err)
-	})
-
 	t.Run("should error when epoch start data creator is nil", func(t *testing.T) {
 		t.Parallel()
 
@@ -200,6 +277,50 @@ func TestSovereignBlockProcessor_NewSovereignChainBlockProcessorShouldWork(t *te
 		require.Equal(t, process.ErrNilEpochStartSystemSCProcessor, err)
 	})
 
+	t.Run("should error when bridge pause handler is nil", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSovChainBlockProcessorArgs()
+		args.BridgePauseHandler = nil
+		scbp, err := blproc.NewSovereignChainBlockProcessor(args)
+
+		require.Nil(t, scbp)
+		require.Equal(t, errMx.ErrNilBridgePauseHandler, err)
+	})
+
+	t.Run("should error when validator set rotation creator is nil", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSovChainBlockProcessorArgs()
+		args.ValidatorSetRotationCreator = nil
+		scbp, err := blproc.NewSovereignChainBlockProcessor(args)
+
+		require.Nil(t, scbp)
+		require.Equal(t, errMx.ErrNilValidatorSetRotationCreator, err)
+	})
+
+	t.Run("should error when an incoming rate limiter is nil", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSovChainBlockProcessorArgs()
+		args.IncomingRateLimiters[core.MainChainShardId] = nil
+		scbp, err := blproc.NewSovereignChainBlockProcessor(args)
+
+		require.Nil(t, scbp)
+		require.ErrorIs(t, err, errMx.ErrNilBridgeRateLimiter)
+	})
+
+	t.Run("should error when deposits volume computer is nil", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSovChainBlockProcessorArgs()
+		args.DepositsVolumeComputer = nil
+		scbp, err := blproc.NewSovereignChainBlockProcessor(args)
+
+		require.Nil(t, scbp)
+		require.Equal(t, errMx.ErrNilDepositsVolumeComputer, err)
+	})
+
 	t.Run("should error when type assertion to extendedShardHeaderTrackHandler fails", func(t *testing.T) {
 		t.Parallel()
 
@@ -220,7 +341,7 @@ func TestSovereignBlockProcessor_NewSovereignChainBlockProcessorShouldWork(t *te
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
 		arguments := CreateMockArguments(createComponentHolderMocks())
-		arguments.BlockTracker, _ = track.NewSovereignChainShardBlockTrack(sbt)
+		arguments.BlockTracker, _ = track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 		sp, _ := blproc.NewShardProcessor(arguments)
 		args := createSovChainBlockProcessorArgs()
 		args.ShardProcessor = sp
@@ -263,15 +384,16 @@ func TestSovereignChainBlockProcessor_createAndSetOutGoingMiniBlock(t *testing.T
 	bridgeOpsHash := outgoingOpsHasher.Compute(string(append(bridgeOp1Hash, bridgeOp2Hash...)))
 
 	outgoingOperationsFormatter := &sovereign.OutgoingOperationsFormatterMock{
-		CreateOutgoingTxDataCalled: func(logs []*data.LogData) ([][]byte, error) {
+		CreateOutgoingTxDataCalled: func(logs []*data.LogData) ([][][]byte, error) {
 			require.Equal(t, expectedLogs, logs)
-			return [][]byte{bridgeOp1, bridgeOp2}, nil
+			return [][][]byte{{bridgeOp1, bridgeOp2}}, nil
 		},
 	}
 
 	poolAddCt := 0
 	outGoingOperationsPool := &sovereign.OutGoingOperationsPoolMock{
-		AddCalled: func(data *sovereignCore.BridgeOutGoingData) {
+		AddWithDestinationCalled: func(data *sovereignCore.BridgeOutGoingData, destination string) {
+			require.Empty(t, destination)
 			defer func() {
 				poolAddCt++
 			}()
@@ -301,15 +423,18 @@ func TestSovereignChainBlockProcessor_createAndSetOutGoingMiniBlock(t *testing.T
 	scbp, _ := blproc.NewSovereignChainBlockProcessor(blproc.ArgsSovereignChainBlockProcessor{
 		ShardProcessor:               sp,
 		ValidatorStatisticsProcessor: &testscommon.ValidatorStatisticsProcessorStub{},
-		OutgoingOperationsFormatter:  outgoingOperationsFormatter,
+		OutgoingOperationsRouter:     createOutgoingOperationsRouter(outgoingOperationsFormatter, outgoingOpsHasher),
 		OutGoingOperationsPool:       outGoingOperationsPool,
-		OperationsHasher:             outgoingOpsHasher,
 		EpochStartDataCreator:        &mock.EpochStartDataCreatorStub{},
 		EpochRewardsCreator:          &testscommon.RewardsCreatorStub{},
 		ValidatorInfoCreator:         &testscommon.EpochValidatorInfoCreatorStub{},
 		EpochSystemSCProcessor:       &testscommon.EpochStartSystemSCStub{},
 		EpochEconomics:               &mock.EpochEconomicsStub{},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
+		IncomingChainsHandler:        &sovereign.IncomingChainsHandlerMock{},
+		BridgePauseHandler:           &sovereign.BridgePauseHandlerMock{},
+		ValidatorSetRotationCreator:  sovBlockDisabled.NewDisabledValidatorSetRotationCreator(),
+		DepositsVolumeComputer:       createDepositsVolumeComputer(&sovereign.DataCodecMock{}),
 	})
 
 	sovChainHdr := &block.SovereignChainHeader{}
@@ -347,6 +472,304 @@ func TestSovereignChainBlockProcessor_createAndSetOutGoingMiniBlock(t *testing.T
 	require.Equal(t, expectedSovChainHeader, sovChainHdr)
 }
 
+func TestSovereignChainBlockProcessor_createAndSetOutGoingMiniBlockWithMultipleBatches(t *testing.T) {
+	t.Parallel()
+
+	bridgeOp1 := []byte("bridgeOp@123@rcv1@token1@val1")
+	bridgeOp2 := []byte("bridgeOp@124@rcv2@token2@val2")
+	bridgeOp3 := []byte("bridgeOp@125@rcv3@token3@val3")
+
+	outgoingOpsHasher := &hashingMocks.HasherMock{}
+	bridgeOp1Hash := outgoingOpsHasher.Compute(string(bridgeOp1))
+	bridgeOp2Hash := outgoingOpsHasher.Compute(string(bridgeOp2))
+	bridgeOp3Hash := outgoingOpsHasher.Compute(string(bridgeOp3))
+
+	outgoingOperationsFormatter := &sovereign.OutgoingOperationsFormatterMock{
+		CreateOutgoingTxDataCalled: func(logs []*data.LogData) ([][][]byte, error) {
+			return [][][]byte{{bridgeOp1, bridgeOp2}, {bridgeOp3}}, nil
+		},
+	}
+	createAndSetOutGoingMiniBlock := func(
+		arguments blproc.ArgShardProcessor,
+		outGoingOperationsPool *sovereign.OutGoingOperationsPoolMock,
+	) (*block.SovereignChainHeader, *block.Body, error) {
+		sp, _ := blproc.NewShardProcessor(arguments)
+		args := createSovChainBlockProcessorArgs()
+		args.ShardProcessor = sp
+		args.OutgoingOperationsRouter = createOutgoingOperationsRouter(outgoingOperationsFormatter, outgoingOpsHasher)
+		args.OutGoingOperationsPool = outGoingOperationsPool
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+		sovChainHdr := &block.SovereignChainHeader{Header: &block.Header{}}
+		blockBody := &block.Body{}
+		err := scbp.CreateAndSetOutGoingMiniBlock(sovChainHdr, blockBody)
+
+		return sovChainHdr, blockBody, err
+	}
+	expectedOutGoingMb := func(arguments blproc.ArgShardProcessor) *block.MiniBlock {
+		return &block.MiniBlock{
+			TxHashes:        [][]byte{bridgeOp1Hash, bridgeOp2Hash, bridgeOp3Hash},
+			ReceiverShardID: core.MainChainShardId,
+			SenderShardID:   arguments.BootstrapComponents.ShardCoordinator().SelfId(),
+		}
+	}
+
+	t.Run("before outgoing operations batches activation, should add all operations in a single batch", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createSovChainBaseBlockProcessorArgs()
+		arguments.TxCoordinator = &testscommon.TransactionCoordinatorMock{}
+
+		addedBridgeData := make([]*sovereignCore.BridgeOutGoingData, 0)
+		sovChainHdr, blockBody, err := createAndSetOutGoingMiniBlock(arguments, &sovereign.OutGoingOperationsPoolMock{
+			AddWithDestinationCalled: func(data *sovereignCore.BridgeOutGoingData, destination string) {
+				addedBridgeData = append(addedBridgeData, data)
+			},
+		})
+		require.Nil(t, err)
+
+		bridgeOpsHash := outgoingOpsHasher.Compute(string(append(append(append([]byte{}, bridgeOp1Hash...), bridgeOp2Hash...), bridgeOp3Hash...)))
+		require.Equal(t, []*sovereignCore.BridgeOutGoingData{
+			{
+				Hash: bridgeOpsHash,
+				OutGoingOperations: []*sovereignCore.OutGoingOperation{
+					{
+						Hash: bridgeOp1Hash,
+						Data: bridgeOp1,
+					},
+					{
+						Hash: bridgeOp2Hash,
+						Data: bridgeOp2,
+					},
+					{
+						Hash: bridgeOp3Hash,
+						Data: bridgeOp3,
+					},
+				},
+			},
+		}, addedBridgeData)
+
+		require.Equal(t, []*block.MiniBlock{expectedOutGoingMb(arguments)}, blockBody.MiniBlocks)
+		require.Equal(t, bridgeOpsHash, sovChainHdr.GetOutGoingMiniBlockHeaderHandler().GetOutGoingOperationsHash())
+		require.False(t, outgoingBatches.HasOutGoingOperationsBatches(sovChainHdr))
+	})
+	t.Run("should add each batch in the header", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createSovChainBaseBlockProcessorArgs()
+		arguments.TxCoordinator = &testscommon.TransactionCoordinatorMock{}
+		arguments.CoreComponents.(*mock.CoreComponentsMock).EnableEpochsHandlerField = createOutGoingOperationsBatchesEnableEpochsHandler()
+
+		addedBridgeData := make([]*sovereignCore.BridgeOutGoingData, 0)
+		sovChainHdr, blockBody, err := createAndSetOutGoingMiniBlock(arguments, &sovereign.OutGoingOperationsPoolMock{
+			AddWithDestinationCalled: func(data *sovereignCore.BridgeOutGoingData, destination string) {
+				addedBridgeData = append(addedBridgeData, data)
+			},
+		})
+		require.Nil(t, err)
+
+		batch1Hash := outgoingOpsHasher.Compute(string(append(append([]byte{}, bridgeOp1Hash...), bridgeOp2Hash...)))
+		batch2Hash := outgoingOpsHasher.Compute(string(bridgeOp3Hash))
+		batch1MerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(outgoingOpsHasher, [][]byte{bridgeOp1Hash, bridgeOp2Hash})
+		batch2MerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(outgoingOpsHasher, [][]byte{bridgeOp3Hash})
+		expectedBridgeData := []*sovereignCore.BridgeOutGoingData{
+			{
+				Hash: batch1Hash,
+				OutGoingOperations: []*sovereignCore.OutGoingOperation{
+					{
+						Hash: bridgeOp1Hash,
+						Data: bridgeOp1,
+					},
+					{
+						Hash: bridgeOp2Hash,
+						Data: bridgeOp2,
+					},
+				},
+			},
+			{
+				Hash: batch2Hash,
+				OutGoingOperations: []*sovereignCore.OutGoingOperation{
+					{
+						Hash: bridgeOp3Hash,
+						Data: bridgeOp3,
+					},
+				},
+			},
+		}
+		require.Equal(t, expectedBridgeData, addedBridgeData)
+
+		require.Equal(t, []*block.MiniBlock{expectedOutGoingMb(arguments)}, blockBody.MiniBlocks)
+		require.Equal(t,
+			arguments.CoreComponents.Hasher().Compute(string(append(append([]byte{}, batch1Hash...), batch2Hash...))),
+			sovChainHdr.GetOutGoingMiniBlockHeaderHandler().GetOutGoingOperationsHash())
+
+		batches, err := outgoingBatches.GetOutGoingOperationsBatches(sovChainHdr)
+		require.Nil(t, err)
+		require.Equal(t, []*outgoingBatches.OutGoingOperationsBatch{
+			{
+				Hash:          batch1Hash,
+				MerkleRoot:    batch1MerkleRoot,
+				NumOperations: 2,
+			},
+			{
+				Hash:          batch2Hash,
+				MerkleRoot:    batch2MerkleRoot,
+				NumOperations: 1,
+			},
+		}, batches)
+	})
+	t.Run("after merkle root activation, should sign the merkle root of each batch", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createSovChainBaseBlockProcessorArgs()
+		arguments.TxCoordinator = &testscommon.TransactionCoordinatorMock{}
+		arguments.CoreComponents.(*mock.CoreComponentsMock).EnableEpochsHandlerField = &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+			IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+				return flag == common.SovereignOutGoingOperationsBatchesFlag ||
+					flag == common.SovereignOutGoingOperationsMerkleRootFlag
+			},
+		}
+
+		addedBridgeData := make([]*sovereignCore.BridgeOutGoingData, 0)
+		sovChainHdr, _, err := createAndSetOutGoingMiniBlock(arguments, &sovereign.OutGoingOperationsPoolMock{
+			AddWithDestinationCalled: func(data *sovereignCore.BridgeOutGoingData, destination string) {
+				addedBridgeData = append(addedBridgeData, data)
+			},
+		})
+		require.Nil(t, err)
+
+		batch1MerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(outgoingOpsHasher, [][]byte{bridgeOp1Hash, bridgeOp2Hash})
+		batch2MerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(outgoingOpsHasher, [][]byte{bridgeOp3Hash})
+		require.Len(t, addedBridgeData, 2)
+		require.Equal(t, batch1MerkleRoot, addedBridgeData[0].Hash)
+		require.Equal(t, batch2MerkleRoot, addedBridgeData[1].Hash)
+		require.Equal(t,
+			arguments.CoreComponents.Hasher().Compute(string(append(append([]byte{}, batch1MerkleRoot...), batch2MerkleRoot...))),
+			sovChainHdr.GetOutGoingMiniBlockHeaderHandler().GetOutGoingOperationsHash())
+
+		batches, err := outgoingBatches.GetOutGoingOperationsBatches(sovChainHdr)
+		require.Nil(t, err)
+		require.Equal(t, []*outgoingBatches.OutGoingOperationsBatch{
+			{
+				Hash:          batch1MerkleRoot,
+				MerkleRoot:    batch1MerkleRoot,
+				NumOperations: 2,
+			},
+			{
+				Hash:          batch2MerkleRoot,
+				MerkleRoot:    batch2MerkleRoot,
+				NumOperations: 1,
+			},
+		}, batches)
+	})
+}
+
+func createOutGoingOperationsBatchesEnableEpochsHandler() *enableEpochsHandlerMock.EnableEpochsHandlerStub {
+	return &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+		IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+			return flag == common.SovereignOutGoingOperationsBatchesFlag
+		},
+	}
+}
+
+func TestSovereignChainBlockProcessor_createAndSetOutGoingMiniBlockWithMultipleDestinations(t *testing.T) {
+	arguments := createSovChainBaseBlockProcessorArgs()
+	arguments.TxCoordinator = &testscommon.TransactionCoordinatorMock{}
+
+	tokensOp := []byte("bridgeOp@123@rcv1@token1@val1")
+	messageOp := []byte("bridgeOp@124@rcv2@message")
+
+	tokensHasher := sha256.NewSha256()
+	messagesHasher := keccak.NewKeccak()
+	tokensOpHash := tokensHasher.Compute(string(tokensOp))
+	tokensBatchHash := tokensHasher.Compute(string(tokensOpHash))
+	tokensMerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(tokensHasher, [][]byte{tokensOpHash})
+	messageOpHash := messagesHasher.Compute(string(messageOp))
+	messagesBatchHash := messagesHasher.Compute(string(messageOpHash))
+	messagesMerkleRoot, _ := common.ComputeOutGoingOperationsMerkleRoot(messagesHasher, [][]byte{messageOpHash})
+
+	router, err := sovBlock.NewOutgoingOperationsRouter(sovBlock.ArgsOutgoingOperationsRouter{
+		Destinations: []sovBlock.OutGoingDestination{
+			{
+				Name: "",
+				Formatter: &sovereign.OutgoingOperationsFormatterMock{
+					CreateOutgoingTxDataCalled: func(logs []*data.LogData) ([][][]byte, error) {
+						return [][][]byte{{tokensOp}}, nil
+					},
+				},
+				Hasher:    tokensHasher,
+				DataCodec: &sovereign.DataCodecMock{},
+			},
+			{
+				Name: "messaging",
+				Formatter: &sovereign.OutgoingOperationsFormatterMock{
+					CreateOutgoingTxDataCalled: func(logs []*data.LogData) ([][][]byte, error) {
+						return [][][]byte{{messageOp}}, nil
+					},
+				},
+				Hasher:    messagesHasher,
+				DataCodec: &sovereign.DataCodecMock{},
+			},
+		},
+		OperationsHasher: tokensHasher,
+		RateLimiter:      createDisabledBridgeRateLimiter(),
+	})
+	require.Nil(t, err)
+
+	addedDestinations := make(map[string][]byte)
+	outGoingOperationsPool := &sovereign.OutGoingOperationsPoolMock{
+		AddWithDestinationCalled: func(data *sovereignCore.BridgeOutGoingData, destination string) {
+			addedDestinations[destination] = data.Hash
+		},
+	}
+
+	args := createSovChainBlockProcessorArgs()
+	args.ShardProcessor, _ = blproc.NewShardProcessor(arguments)
+	args.OutgoingOperationsRouter = router
+	args.OutGoingOperationsPool = outGoingOperationsPool
+	scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+	sovChainHdr := &block.SovereignChainHeader{Header: &block.Header{}}
+	blockBody := &block.Body{}
+
+	err = scbp.CreateAndSetOutGoingMiniBlock(sovChainHdr, blockBody)
+	require.ErrorIs(t, err, process.ErrMultipleOutGoingDestinationsNotEnabled)
+
+	arguments.CoreComponents.(*mock.CoreComponentsMock).EnableEpochsHandlerField = createOutGoingOperationsBatchesEnableEpochsHandler()
+	args.ShardProcessor, _ = blproc.NewShardProcessor(arguments)
+	scbp, _ = blproc.NewSovereignChainBlockProcessor(args)
+
+	addedDestinations = make(map[string][]byte)
+	err = scbp.CreateAndSetOutGoingMiniBlock(sovChainHdr, blockBody)
+	require.Nil(t, err)
+
+	require.Equal(t, map[string][]byte{
+		"":          tokensBatchHash,
+		"messaging": messagesBatchHash,
+	}, addedDestinations)
+
+	expectedOutGoingMb := &block.MiniBlock{
+		TxHashes:        [][]byte{tokensOpHash, messageOpHash},
+		ReceiverShardID: core.MainChainShardId,
+		SenderShardID:   arguments.BootstrapComponents.ShardCoordinator().SelfId(),
+	}
+	require.Equal(t, []*block.MiniBlock{expectedOutGoingMb}, blockBody.MiniBlocks)
+	batches, err := outgoingBatches.GetOutGoingOperationsBatches(sovChainHdr)
+	require.Nil(t, err)
+	require.Equal(t, []*outgoingBatches.OutGoingOperationsBatch{
+		{
+			Hash:          tokensBatchHash,
+			MerkleRoot:    tokensMerkleRoot,
+			NumOperations: 1,
+		},
+		{
+			Hash:          messagesBatchHash,
+			MerkleRoot:    messagesMerkleRoot,
+			NumOperations: 1,
+		},
+	}, batches)
+}
+
 func TestSovereignChainBlockProcessor_RestoreBlockIntoPoolsInvalidHeaderType(t *testing.T) {
 	t.Parallel()
 
@@ -398,7 +821,8 @@ func TestSovereignChainBlockProcessor_RestoreBlockIntoPoolsShouldWorkWhenHeaderN
 		RemoveLastSelfNotarizedHeadersCalled: func() {
 			wasLastSelfNotarizedHeaderRemoved = true
 		},
-		RemoveLastCrossNotarizedHeadersCalled: func() {
+		RemoveLastCrossNotarizedHeaderCalled: func(shardID uint32) {
+			require.Equal(t, core.MainChainShardId, shardID)
 			wasLastCrossNotarizedHeaderRemoved = true
 		},
 	}
@@ -479,7 +903,8 @@ func testRestoreBlockIntoPools(t *testing.T, withExtendedHeader bool) {
 		RemoveLastSelfNotarizedHeadersCalled: func() {
 			wasLastSelfNotarizedHeaderRemoved = true
 		},
-		RemoveLastCrossNotarizedHeadersCalled: func() {
+		RemoveLastCrossNotarizedHeaderCalled: func(shardID uint32) {
+			require.Equal(t, core.MainChainShardId, shardID)
 			wasLastCrossNotarizedHeaderRemoved = true
 		},
 	}
@@ -579,3 +1004,520 @@ func createSovHeaderForRestoreBlocksTest(body *block.Body, extendedHeaderHash []
 }
 
 //TODO: More unit tests should be added. Created PR https://multiversxlabs.atlassian.net/browse/MX-14149
+
+func TestSovereignChainBlockProcessor_RequestExtendedShardHeadersIfNeeded(t *testing.T) {
+	t.Parallel()
+
+	existingNonce := uint64(5)
+	headersPool := &mock.HeadersCacherStub{
+		GetHeaderByNonceAndShardIdCalled: func(hdrNonce uint64, shardId uint32) ([]data.HeaderHandler, [][]byte, error) {
+			require.Equal(t, core.MainChainShardId, shardId)
+			if hdrNonce == existingNonce {
+				return []data.HeaderHandler{&block.ShardHeaderExtended{}}, [][]byte{[]byte("hash")}, nil
+			}
+			return nil, nil, errors.New("missing header")
+		},
+	}
+	dataPool := initDataPool([]byte(""))
+	dataPool.HeadersCalled = func() dataRetriever.HeadersPool {
+		return headersPool
+	}
+
+	roundHandler := &mock.RoundHandlerMock{}
+	requestedNonces := make(chan uint64, 1)
+
+	arguments := createSovChainBaseBlockProcessorArgs()
+	arguments.DataComponents.(*mock.DataComponentsMock).DataPool = dataPool
+	arguments.CoreComponents.(*mock.CoreComponentsMock).RoundField = roundHandler
+	arguments.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{
+		RequestIncomingChainHeaderByNonceCalled: func(incomingChainShardID uint32, nonce uint64) {
+			require.Equal(t, core.MainChainShardId, incomingChainShardID)
+			requestedNonces <- nonce
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+	args := createSovChainBlockProcessorArgs()
+	args.ShardProcessor = sp
+	scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+	requireNoRequest := func() {
+		select {
+		case nonce := <-requestedNonces:
+			require.Fail(t, "should not have requested", "nonce", nonce)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	lastExtendedShardHdr := &block.ShardHeaderExtended{Header: &block.HeaderV2{Header: &block.Header{Nonce: 3}}}
+
+	roundHandler.RoundIndex = 5
+	scbp.RequestExtendedShardHeadersIfNeeded(core.MainChainShardId, 1, lastExtendedShardHdr)
+	requireNoRequest()
+
+	roundHandler.RoundIndex = 5 + process.MaxRoundsWithoutNewBlockReceived
+	scbp.RequestExtendedShardHeadersIfNeeded(core.MainChainShardId, 0, lastExtendedShardHdr)
+	requireNoRequest()
+
+	// last cross notarized header is still the genesis one
+	roundHandler.RoundIndex = 6 + process.MaxRoundsWithoutNewBlockReceived
+	scbp.RequestExtendedShardHeadersIfNeeded(core.MainChainShardId, 0, lastExtendedShardHdr)
+	requireNoRequest()
+
+	arguments.BlockTracker.AddCrossNotarizedHeader(core.MainChainShardId, lastExtendedShardHdr, []byte("lastHash"))
+	scbp.RequestExtendedShardHeadersIfNeeded(core.MainChainShardId, 0, lastExtendedShardHdr)
+	select {
+	case nonce := <-requestedNonces:
+		require.Equal(t, lastExtendedShardHdr.GetNonce()+1, nonce)
+	case <-time.After(time.Second):
+		require.Fail(t, "should have requested next extended shard header")
+	}
+
+	// next extended shard header already in pool
+	lastExtendedShardHdr.Header.Header.Nonce = existingNonce - 1
+	scbp.RequestExtendedShardHeadersIfNeeded(core.MainChainShardId, 0, lastExtendedShardHdr)
+	requireNoRequest()
+}
+
+func TestSovereignChainBlockProcessor_IncomingBridgePaused(t *testing.T) {
+	t.Parallel()
+
+	t.Run("paused incoming bridge should not include extended shard headers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSovChainBlockProcessorArgs()
+		args.BridgePauseHandler = &sovereign.BridgePauseHandlerMock{
+			IsIncomingPausedCalled: func() bool {
+				return true
+			},
+		}
+		args.IncomingChainsHandler = &sovereign.IncomingChainsHandlerMock{
+			GetShardIDsCalled: func() []uint32 {
+				require.Fail(t, "should not search extended shard headers while the incoming bridge is paused")
+				return nil
+			},
+		}
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+		scbp.LoadBridgePauseState()
+
+		miniBlocks, numHdrsAdded, err := scbp.CreateIncomingMiniBlocksDestMe(func() bool { return true })
+		require.Nil(t, err)
+		require.Empty(t, miniBlocks)
+		require.Zero(t, numHdrsAdded)
+	})
+
+	t.Run("block with extended shard headers should be rejected while the incoming bridge is paused", func(t *testing.T) {
+		t.Parallel()
+
+		isPaused := true
+		args := createSovChainBlockProcessorArgs()
+		args.BridgePauseHandler = &sovereign.BridgePauseHandlerMock{
+			IsIncomingPausedCalled: func() bool {
+				return isPaused
+			},
+		}
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+		extendedHeaderHash := []byte("extendedHeaderHash")
+		extendedHeader := &block.ShardHeaderExtended{
+			Header: &block.HeaderV2{
+				Header: &block.Header{
+					Nonce: 1,
+					Round: 11,
+				},
+			},
+		}
+		args.ShardProcessor.SetHdrForCurrentBlock(extendedHeaderHash, extendedHeader, true)
+
+		sovHeader := &block.SovereignChainHeader{
+			Header:                    &block.Header{},
+			ExtendedShardHeaderHashes: [][]byte{extendedHeaderHash},
+		}
+		scbp.LoadBridgePauseState()
+		err := scbp.CheckExtendedShardHeadersValidity(sovHeader)
+		require.Equal(t, errMx.ErrIncomingBridgePaused, err)
+
+		// the pause flags are loaded only once, when the block processing starts
+		isPaused = false
+		err = scbp.CheckExtendedShardHeadersValidity(sovHeader)
+		require.Equal(t, errMx.ErrIncomingBridgePaused, err)
+
+		scbp.LoadBridgePauseState()
+		err = scbp.CheckExtendedShardHeadersValidity(sovHeader)
+		require.NotEqual(t, errMx.ErrIncomingBridgePaused, err)
+
+		// blocks without extended shard headers are still accepted
+		isPaused = true
+		scbp.LoadBridgePauseState()
+		err = scbp.CheckExtendedShardHeadersValidity(&block.SovereignChainHeader{Header: &block.Header{}})
+		require.Nil(t, err)
+	})
+}
+
+func TestSovereignChainBlockProcessor_ProcessUnconfirmedOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should process with the header timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		wasProcessed := false
+		args := createSovChainBlockProcessorArgs()
+		args.OutGoingOperationsPool = &sovereign.OutGoingOperationsPoolMock{
+			ProcessUnconfirmedOperationsCalled: func(currentTime time.Time) {
+				require.Equal(t, time.Unix(1700000000, 0), currentTime)
+				wasProcessed = true
+			},
+		}
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+		scbp.ProcessUnconfirmedOutGoingOperations(&block.SovereignChainHeader{Header: &block.Header{TimeStamp: 1700000000}})
+		require.True(t, wasProcessed)
+	})
+
+	t.Run("paused outgoing bridge should not process", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSovChainBlockProcessorArgs()
+		args.BridgePauseHandler = &sovereign.BridgePauseHandlerMock{
+			IsOutGoingPausedCalled: func() bool {
+				return true
+			},
+		}
+		args.OutGoingOperationsPool = &sovereign.OutGoingOperationsPoolMock{
+			ProcessUnconfirmedOperationsCalled: func(_ time.Time) {
+				require.Fail(t, "should not process unconfirmed operations while the outgoing bridge is paused")
+			},
+		}
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+		scbp.LoadBridgePauseState()
+
+		scbp.ProcessUnconfirmedOutGoingOperations(&block.SovereignChainHeader{Header: &block.Header{TimeStamp: 1700000000}})
+	})
+}
+
+func TestSovereignChainBlockProcessor_OutGoingBridgePaused(t *testing.T) {
+	t.Parallel()
+
+	bridgeOp1 := []byte("bridgeOp@123@rcv1@token1@val1")
+	bridgeOp2 := []byte("bridgeOp@124@rcv2@token2@val2")
+	outgoingOpsHasher := &hashingMocks.HasherMock{}
+	bridgeOp1Hash := outgoingOpsHasher.Compute(string(bridgeOp1))
+	bridgeOp2Hash := outgoingOpsHasher.Compute(string(bridgeOp2))
+
+	blockOperations := bridgeOp1
+	outgoingOperationsFormatter := &sovereign.OutgoingOperationsFormatterMock{
+		CreateOutgoingTxDataCalled: func(logs []*data.LogData) ([][][]byte, error) {
+			return [][][]byte{{blockOperations}}, nil
+		},
+	}
+
+	isPaused := true
+	arguments := createSovChainBaseBlockProcessorArgs()
+	arguments.TxCoordinator = &testscommon.TransactionCoordinatorMock{}
+	args := createSovChainBlockProcessorArgs()
+	args.ShardProcessor, _ = blproc.NewShardProcessor(arguments)
+	args.OutgoingOperationsRouter = createOutgoingOperationsRouter(outgoingOperationsFormatter, outgoingOpsHasher)
+	args.BridgePauseHandler = &sovereign.BridgePauseHandlerMock{
+		IsOutGoingPausedCalled: func() bool {
+			return isPaused
+		},
+	}
+	args.OutGoingOperationsPool = &sovereign.OutGoingOperationsPoolMock{
+		AddWithDestinationCalled: func(_ *sovereignCore.BridgeOutGoingData, _ string) {
+			require.False(t, isPaused, "should not add in pool any operation while the outgoing bridge is paused")
+		},
+	}
+	scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+	// paused outgoing bridge, the operations are held back without any outgoing mini block
+	scbp.LoadBridgePauseState()
+	sovChainHdr := &block.SovereignChainHeader{Header: &block.Header{Nonce: 1}}
+	blockBody := &block.Body{}
+	err := scbp.CreateAndSetOutGoingMiniBlock(sovChainHdr, blockBody)
+	require.Nil(t, err)
+	require.Empty(t, blockBody.MiniBlocks)
+	require.Nil(t, sovChainHdr.OutGoingMiniBlockHeader)
+
+	// resumed outgoing bridge, the held operations are bridged before the ones of the current block
+	isPaused = false
+	blockOperations = bridgeOp2
+	scbp.LoadBridgePauseState()
+	sovChainHdr = &block.SovereignChainHeader{Header: &block.Header{Nonce: 2}}
+	err = scbp.CreateAndSetOutGoingMiniBlock(sovChainHdr, blockBody)
+	require.Nil(t, err)
+	require.Len(t, blockBody.MiniBlocks, 1)
+	require.Equal(t, [][]byte{bridgeOp1Hash, bridgeOp2Hash}, blockBody.MiniBlocks[0].TxHashes)
+	require.NotNil(t, sovChainHdr.OutGoingMiniBlockHeader)
+}
+
+func createValidatorSetRotationEnableEpochsHandler() *enableEpochsHandlerMock.EnableEpochsHandlerStub {
+	return &enableEpochsHandlerMock.EnableEpochsHandlerStub{
+		IsFlagEnabledInEpochCalled: func(flag core.EnableEpochFlag, epoch uint32) bool {
+			return flag == common.SovereignValidatorSetRotationFlag
+		},
+	}
+}
+
+func createValidatorSetRotationCreator(nodesComputer sovBlock.EpochStartNodesComputer, dataCodec sovBlock.DataCodecHandler) sovBlock.ValidatorSetRotationCreator {
+	creator, _ := sovBlock.NewValidatorSetRotationCreator(sovBlock.ArgsValidatorSetRotationCreator{
+		NodesComputer:    nodesComputer,
+		OperationsHasher: &mock.HasherStub{},
+		DataCodec:        dataCodec,
+		Receiver:         []byte("receiver"),
+		GasLimit:         20000000,
+	})
+
+	return creator
+}
+
+func TestSovereignChainBlockProcessor_createAndSetValidatorSetRotationMiniBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("validator set rotation flag not active should not add any outgoing operation", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createSovChainBaseBlockProcessorArgs()
+		arguments.TxCoordinator = &testscommon.TransactionCoordinatorMock{}
+		args := createSovChainBlockProcessorArgs()
+		args.ShardProcessor, _ = blproc.NewShardProcessor(arguments)
+		args.ValidatorSetRotationCreator = createValidatorSetRotationCreator(&sovereign.EpochStartNodesComputerMock{
+			ComputeNextEpochEligiblePublicKeysCalled: func(_ data.HeaderHandler, _ data.BodyHandler) ([][]byte, error) {
+				require.Fail(t, "should not compute the next epoch validators")
+				return nil, nil
+			},
+		}, &sovereign.DataCodecMock{})
+		args.OutGoingOperationsPool = &sovereign.OutGoingOperationsPoolMock{
+			AddWithDestinationCalled: func(_ *sovereignCore.BridgeOutGoingData, _ string) {
+				require.Fail(t, "should not add in pool any operation")
+			},
+		}
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+		sovChainHdr := &block.SovereignChainHeader{Header: &block.Header{Epoch: 2}, IsStartOfEpoch: true}
+		blockBody := &block.Body{}
+		err := scbp.CreateAndSetValidatorSetRotationMiniBlock(sovChainHdr, blockBody)
+		require.Nil(t, err)
+		require.Empty(t, blockBody.MiniBlocks)
+		require.Nil(t, sovChainHdr.OutGoingMiniBlockHeader)
+	})
+	t.Run("nodes computer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("nodes computer error")
+		arguments := createSovChainBaseBlockProcessorArgs()
+		arguments.CoreComponents.(*mock.CoreComponentsMock).EnableEpochsHandlerField = createValidatorSetRotationEnableEpochsHandler()
+		args := createSovChainBlockProcessorArgs()
+		args.ShardProcessor, _ = blproc.NewShardProcessor(arguments)
+		args.ValidatorSetRotationCreator = createValidatorSetRotationCreator(&sovereign.EpochStartNodesComputerMock{
+			ComputeNextEpochEligiblePublicKeysCalled: func(_ data.HeaderHandler, _ data.BodyHandler) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}, &sovereign.DataCodecMock{})
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+		err := scbp.CreateAndSetValidatorSetRotationMiniBlock(&block.SovereignChainHeader{Header: &block.Header{}}, &block.Body{})
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should add the validator set rotation operation in the outgoing mini block", func(t *testing.T) {
+		t.Parallel()
+
+		arguments := createSovChainBaseBlockProcessorArgs()
+		arguments.TxCoordinator = &testscommon.TransactionCoordinatorMock{}
+		arguments.CoreComponents.(*mock.CoreComponentsMock).EnableEpochsHandlerField = createValidatorSetRotationEnableEpochsHandler()
+		sp, _ := blproc.NewShardProcessor(arguments)
+
+		validatorMb := &block.MiniBlock{Type: block.PeerBlock}
+		blockBody := &block.Body{MiniBlocks: []*block.MiniBlock{validatorMb}}
+		sovChainHdr := &block.SovereignChainHeader{Header: &block.Header{Epoch: 2}, IsStartOfEpoch: true}
+
+		outgoingOpsHasher := &mock.HasherStub{}
+		rotationOp := []byte("serializedRotationOp")
+		rotationOpHash := outgoingOpsHasher.Compute(string(rotationOp))
+		rotationOpsHash := outgoingOpsHasher.Compute(string(rotationOpHash))
+
+		poolAddCt := 0
+		args := createSovChainBlockProcessorArgs()
+		args.ShardProcessor = sp
+		args.OutGoingOperationsPool = &sovereign.OutGoingOperationsPoolMock{
+			AddWithDestinationCalled: func(data *sovereignCore.BridgeOutGoingData, destination string) {
+				poolAddCt++
+				require.Empty(t, destination)
+				require.Equal(t, &sovereignCore.BridgeOutGoingData{
+					Hash: rotationOpsHash,
+					OutGoingOperations: []*sovereignCore.OutGoingOperation{
+						{
+							Hash: rotationOpHash,
+							Data: rotationOp,
+						},
+					},
+				}, data)
+			},
+		}
+		args.ValidatorSetRotationCreator = createValidatorSetRotationCreator(&sovereign.EpochStartNodesComputerMock{
+			ComputeNextEpochEligiblePublicKeysCalled: func(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error) {
+				require.Equal(t, sovChainHdr, hdr)
+				require.Equal(t, []*block.MiniBlock{validatorMb}, body.(*block.Body).MiniBlocks)
+				return [][]byte{[]byte("pk1"), []byte("pk2")}, nil
+			},
+		}, &sovereign.DataCodecMock{
+			SerializeOperationCalled: func(operation sovereignCore.Operation) ([]byte, error) {
+				require.Equal(t, [][]byte{{0x02}, []byte("pk1"), []byte("pk2")}, operation.Data.TransferData.Args)
+				return rotationOp, nil
+			},
+		})
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+
+		err := scbp.CreateAndSetValidatorSetRotationMiniBlock(sovChainHdr, blockBody)
+		require.Nil(t, err)
+		require.Equal(t, 1, poolAddCt)
+
+		expectedOutGoingMb := &block.MiniBlock{
+			TxHashes:        [][]byte{rotationOpHash},
+			ReceiverShardID: core.MainChainShardId,
+			SenderShardID:   arguments.BootstrapComponents.ShardCoordinator().SelfId(),
+		}
+		require.Equal(t, []*block.MiniBlock{validatorMb, expectedOutGoingMb}, blockBody.MiniBlocks)
+
+		expectedOutGoingMbHash, err := core.CalculateHash(arguments.CoreComponents.InternalMarshalizer(), arguments.CoreComponents.Hasher(), expectedOutGoingMb)
+		require.Nil(t, err)
+		require.Equal(t, &block.OutGoingMiniBlockHeader{
+			Hash:                   expectedOutGoingMbHash,
+			OutGoingOperationsHash: rotationOpsHash,
+		}, sovChainHdr.OutGoingMiniBlockHeader)
+	})
+}
+
+func TestSovereignChainBlockProcessor_CheckIncomingRateLimits(t *testing.T) {
+	t.Parallel()
+
+	createIncomingRateLimiter := func() sovBlock.BridgeRateLimiter {
+		rateLimiter, _ := sovBlock.NewBridgeRateLimiter(sovBlock.ArgsBridgeRateLimiter{
+			Config: config.BridgeRateLimits{
+				Enabled: true,
+				TokenVolumeLimits: []config.TokenVolumeLimit{
+					{
+						Identifier:  "TKN1",
+						VolumeLimit: "100",
+					},
+				},
+			},
+			Accounts:         createSystemAccountAccountsStub(),
+			Marshaller:       &marshal.JsonMarshalizer{},
+			StateKeyPrefix:   common.BridgeIncomingRateLimitsKeyPrefix,
+			AppStatusHandler: &statusHandlerMock.AppStatusHandlerStub{},
+			LimitedOpsMetric: common.MetricNumRateLimitedIncomingHeaders,
+		})
+
+		return rateLimiter
+	}
+	createArgs := func() blproc.ArgsSovereignChainBlockProcessor {
+		args := createSovChainBlockProcessorArgs()
+		args.IncomingRateLimiters = map[uint32]sovBlock.BridgeRateLimiter{
+			core.MainChainShardId: createIncomingRateLimiter(),
+		}
+		args.DepositsVolumeComputer = createDepositsVolumeComputer(&sovereign.DataCodecMock{
+			DeserializeTokenDataCalled: func(data []byte) (*sovereignCore.EsdtTokenData, error) {
+				amount, _ := big.NewInt(0).SetString(string(data), 10)
+				return &sovereignCore.EsdtTokenData{
+					TokenType: core.Fungible,
+					Amount:    amount,
+				}, nil
+			},
+		})
+
+		return args
+	}
+	addExtendedHeader := func(args blproc.ArgsSovereignChainBlockProcessor, hash string, nonce uint64, amount string) []byte {
+		extendedHeader := &block.ShardHeaderExtended{
+			Header: &block.HeaderV2{
+				Header: &block.Header{
+					Nonce: nonce,
+				},
+			},
+			IncomingEvents: []*transaction.Event{
+				{
+					Identifier: []byte("deposit"),
+					Topics:     [][]byte{[]byte("deposit"), []byte("addr"), []byte("TKN1"), []byte("nonce"), []byte(amount)},
+				},
+			},
+		}
+		args.ShardProcessor.SetHdrForCurrentBlock([]byte(hash), extendedHeader, true)
+
+		return []byte(hash)
+	}
+
+	t.Run("extended shard headers which fit in the limits should be accepted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+		hash1 := addExtendedHeader(args, "hash1", 1, "60")
+		hash2 := addExtendedHeader(args, "hash2", 2, "40")
+
+		err := scbp.CheckIncomingRateLimits(&block.SovereignChainHeader{
+			Header:                    &block.Header{},
+			ExtendedShardHeaderHashes: [][]byte{hash1, hash2},
+		}, 1)
+		require.Nil(t, err)
+	})
+
+	t.Run("extended shard headers over the limits should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+		hash1 := addExtendedHeader(args, "hash1", 1, "60")
+		hash2 := addExtendedHeader(args, "hash2", 2, "41")
+
+		err := scbp.CheckIncomingRateLimits(&block.SovereignChainHeader{
+			Header:                    &block.Header{},
+			ExtendedShardHeaderHashes: [][]byte{hash1, hash2},
+		}, 1)
+		require.ErrorIs(t, err, errMx.ErrIncomingRateLimitsExceeded)
+	})
+
+	t.Run("limits should be accumulated across blocks and reset in a new epoch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+		hash1 := addExtendedHeader(args, "hash1", 1, "60")
+		hash2 := addExtendedHeader(args, "hash2", 2, "60")
+
+		err := scbp.CheckIncomingRateLimits(&block.SovereignChainHeader{
+			Header:                    &block.Header{},
+			ExtendedShardHeaderHashes: [][]byte{hash1},
+		}, 1)
+		require.Nil(t, err)
+
+		sovHeader := &block.SovereignChainHeader{
+			Header:                    &block.Header{},
+			ExtendedShardHeaderHashes: [][]byte{hash2},
+		}
+		err = scbp.CheckIncomingRateLimits(sovHeader, 1)
+		require.ErrorIs(t, err, errMx.ErrIncomingRateLimitsExceeded)
+
+		err = scbp.CheckIncomingRateLimits(sovHeader, 2)
+		require.Nil(t, err)
+	})
+
+	t.Run("extended shard header exceeding the limits by itself should only be accepted as the first one of the epoch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		scbp, _ := blproc.NewSovereignChainBlockProcessor(args)
+		hash1 := addExtendedHeader(args, "hash1", 1, "10")
+		hash2 := addExtendedHeader(args, "hash2", 2, "150")
+
+		err := scbp.CheckIncomingRateLimits(&block.SovereignChainHeader{
+			Header:                    &block.Header{},
+			ExtendedShardHeaderHashes: [][]byte{hash1, hash2},
+		}, 1)
+		require.ErrorIs(t, err, errMx.ErrIncomingRateLimitsExceeded)
+
+		err = scbp.CheckIncomingRateLimits(&block.SovereignChainHeader{
+			Header:                    &block.Header{},
+			ExtendedShardHeaderHashes: [][]byte{hash2},
+		}, 2)
+		require.Nil(t, err)
+	})
+}
@@ -1,33 +1,156 @@
 package block
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign"
 )
 
 type sovereignChainHeaderValidator struct {
 	*headerValidator
+	mainChainHeaderSigVerifier        process.InterceptedHeaderSigVerifier
+	mainChainEventsVerifier           process.EventsProofVerifier
+	sovereignChainsHeaderSigVerifiers map[string]process.InterceptedHeaderSigVerifier
+	isSigVerificationEnabled          bool
 }
 
-// NewSovereignChainHeaderValidator creates a new sovereign chain header validator
+// NewSovereignChainHeaderValidator creates a new sovereign chain header validator. The headers of the other sovereign
+// chains are verified with the header sig verifier of their chain ID, from the provided map.
 func NewSovereignChainHeaderValidator(
 	headerValidator *headerValidator,
+	mainChainHeaderSigVerifier process.InterceptedHeaderSigVerifier,
+	mainChainEventsVerifier process.EventsProofVerifier,
+	sovereignChainsHeaderSigVerifiers map[string]process.InterceptedHeaderSigVerifier,
+	isSigVerificationEnabled bool,
 ) (*sovereignChainHeaderValidator, error) {
 	if headerValidator == nil {
 		return nil, process.ErrNilHeaderValidator
 	}
+	if check.IfNil(mainChainHeaderSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
+	}
+	if check.IfNil(mainChainEventsVerifier) {
+		return nil, errors.ErrNilEventsProofVerifier
+	}
+	for chainID, sigVerifier := range sovereignChainsHeaderSigVerifiers {
+		if check.IfNil(sigVerifier) {
+			return nil, fmt.Errorf("%w for incoming chain %s", process.ErrNilHeaderSigVerifier, chainID)
+		}
+	}
 
 	schv := &sovereignChainHeaderValidator{
-		headerValidator: headerValidator,
+		headerValidator:                   headerValidator,
+		mainChainHeaderSigVerifier:        mainChainHeaderSigVerifier,
+		mainChainEventsVerifier:           mainChainEventsVerifier,
+		sovereignChainsHeaderSigVerifiers: sovereignChainsHeaderSigVerifiers,
+		isSigVerificationEnabled:          isSigVerificationEnabled,
 	}
 
 	schv.calculateHeaderHashFunc = schv.calculateHeaderHash
 	return schv, nil
 }
 
+// IsHeaderConstructionValid verifies if header is constructed correctly on top of other. For extended shard headers, it
+// also verifies that the wrapped main chain header was proposed and signed by its main chain consensus group and that
+// the incoming events are proven against the signed header, so that incoming headers and their events are not trusted
+// just because they were proposed by a sovereign leader. While the signature verification is enabled, the headers of the
+// other sovereign chains are verified in the same way against the consensus group of their own chain, while headers of
+// sovereign chains without a known validators view are rejected. Otherwise, they are only checked to be linked to the
+// previous header of their incoming chain.
+func (schv *sovereignChainHeaderValidator) IsHeaderConstructionValid(currHeader, prevHeader data.HeaderHandler) error {
+	err := schv.headerValidator.IsHeaderConstructionValid(currHeader, prevHeader)
+	if err != nil {
+		return err
+	}
+
+	shardHeaderExtended, isShardHeaderExtended := currHeader.(*block.ShardHeaderExtended)
+	if !isShardHeaderExtended {
+		return nil
+	}
+	if sovereign.IsSovereignChainHeaderEnvelope(shardHeaderExtended.Header) {
+		return schv.verifySovereignChainHeaderSignatures(shardHeaderExtended)
+	}
+
+	return schv.verifyMainChainHeaderSignatures(shardHeaderExtended)
+}
+
+func (schv *sovereignChainHeaderValidator) verifySovereignChainHeaderSignatures(shardHeaderExtended *block.ShardHeaderExtended) error {
+	if !schv.isSigVerificationEnabled {
+		return nil
+	}
+
+	chainID := shardHeaderExtended.Header.GetChainID()
+	sigVerifier, found := schv.sovereignChainsHeaderSigVerifiers[string(chainID)]
+	if !found {
+		log.Debug("sovereignChainHeaderValidator: no validators view for sovereign chain header",
+			"chainID", chainID,
+			"round", shardHeaderExtended.GetRound(),
+			"nonce", shardHeaderExtended.GetNonce())
+		return fmt.Errorf("%w, chain id = %s", errors.ErrUnverifiableSovereignChainHeader, chainID)
+	}
+
+	// the sovereign chain validators signed the wrapped sovereign chain header, not its header v2 envelope
+	sovereignHeader, _, err := sovereign.UnwrapSovereignChainHeader(schv.marshalizer, shardHeaderExtended.Header)
+	if err != nil {
+		return err
+	}
+
+	return verifyIncomingHeaderSignatures(sigVerifier, sovereignHeader)
+}
+
+func (schv *sovereignChainHeaderValidator) verifyMainChainHeaderSignatures(shardHeaderExtended *block.ShardHeaderExtended) error {
+	if check.IfNil(shardHeaderExtended.Header) {
+		return process.ErrNilHeaderHandler
+	}
+
+	err := verifyIncomingHeaderSignatures(schv.mainChainHeaderSigVerifier, shardHeaderExtended.Header)
+	if err != nil {
+		return err
+	}
+
+	// the signature only covers the wrapped main chain header, hence the incoming events should be bound to it
+	err = schv.mainChainEventsVerifier.VerifyEventsProofs(shardHeaderExtended)
+	if err != nil {
+		log.Debug("sovereignChainHeaderValidator: invalid main chain header events proofs",
+			"round", shardHeaderExtended.GetRound(),
+			"nonce", shardHeaderExtended.GetNonce(),
+			"error", err)
+		return err
+	}
+
+	return nil
+}
+
+func verifyIncomingHeaderSignatures(sigVerifier process.InterceptedHeaderSigVerifier, header data.HeaderHandler) error {
+	err := sigVerifier.VerifyRandSeedAndLeaderSignature(header)
+	if err != nil {
+		log.Debug("sovereignChainHeaderValidator: invalid incoming header leader signature",
+			"chainID", header.GetChainID(),
+			"round", header.GetRound(),
+			"nonce", header.GetNonce(),
+			"error", err)
+		return err
+	}
+
+	err = sigVerifier.VerifySignature(header)
+	if err != nil {
+		log.Debug("sovereignChainHeaderValidator: invalid incoming header signature",
+			"chainID", header.GetChainID(),
+			"round", header.GetRound(),
+			"nonce", header.GetNonce(),
+			"error", err)
+		return err
+	}
+
+	return nil
+}
+
 func (schv *sovereignChainHeaderValidator) calculateHeaderHash(headerHandler data.HeaderHandler) ([]byte, error) {
 	shardHeaderExtended, isShardHeaderExtended := headerHandler.(*block.ShardHeaderExtended)
 	if isShardHeaderExtended {
@@ -35,7 +158,7 @@ func (schv *sovereignChainHeaderValidator) calculateHeaderHash(headerHandler dat
 			return nil, process.ErrNilHeaderHandler
 		}
 
-		return core.CalculateHash(schv.marshalizer, schv.hasher, shardHeaderExtended.Header)
+		return sovereign.CalculateIncomingHeaderHash(schv.marshalizer, schv.hasher, shardHeaderExtended.Header)
 	}
 
 	return core.CalculateHash(schv.marshalizer, schv.hasher, headerHandler)
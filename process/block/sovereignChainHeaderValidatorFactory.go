@@ -2,21 +2,42 @@ package block
 
 import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
 type sovereignHeaderValidatorFactory struct {
-	headerValidatorCreator HeaderValidatorCreator
+	headerValidatorCreator            HeaderValidatorCreator
+	mainChainHeaderSigVerifier        process.InterceptedHeaderSigVerifier
+	mainChainEventsVerifier           process.EventsProofVerifier
+	sovereignChainsHeaderSigVerifiers map[string]process.InterceptedHeaderSigVerifier
+	isSigVerificationEnabled          bool
 }
 
 // NewSovereignHeaderValidatorFactory creates a new shard header validator factory
-func NewSovereignHeaderValidatorFactory(headerValidatorCreator HeaderValidatorCreator) (*sovereignHeaderValidatorFactory, error) {
+func NewSovereignHeaderValidatorFactory(
+	headerValidatorCreator HeaderValidatorCreator,
+	mainChainHeaderSigVerifier process.InterceptedHeaderSigVerifier,
+	mainChainEventsVerifier process.EventsProofVerifier,
+	sovereignChainsHeaderSigVerifiers map[string]process.InterceptedHeaderSigVerifier,
+	isSigVerificationEnabled bool,
+) (*sovereignHeaderValidatorFactory, error) {
 	if check.IfNil(headerValidatorCreator) {
 		return nil, process.ErrNilHeaderValidatorCreator
 	}
+	if check.IfNil(mainChainHeaderSigVerifier) {
+		return nil, process.ErrNilHeaderSigVerifier
+	}
+	if check.IfNil(mainChainEventsVerifier) {
+		return nil, errors.ErrNilEventsProofVerifier
+	}
 
 	return &sovereignHeaderValidatorFactory{
-		headerValidatorCreator: headerValidatorCreator,
+		headerValidatorCreator:            headerValidatorCreator,
+		mainChainHeaderSigVerifier:        mainChainHeaderSigVerifier,
+		mainChainEventsVerifier:           mainChainEventsVerifier,
+		sovereignChainsHeaderSigVerifiers: sovereignChainsHeaderSigVerifiers,
+		isSigVerificationEnabled:          isSigVerificationEnabled,
 	}, nil
 }
 
@@ -32,7 +53,13 @@ func (shvf *sovereignHeaderValidatorFactory) CreateHeaderValidator(argsHeaderVal
 		return nil, process.ErrWrongTypeAssertion
 	}
 
-	return NewSovereignChainHeaderValidator(shardHeaderValidator)
+	return NewSovereignChainHeaderValidator(
+		shardHeaderValidator,
+		shvf.mainChainHeaderSigVerifier,
+		shvf.mainChainEventsVerifier,
+		shvf.sovereignChainsHeaderSigVerifiers,
+		shvf.isSigVerificationEnabled,
+	)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
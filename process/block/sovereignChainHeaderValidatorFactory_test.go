@@ -5,19 +5,30 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 )
 
 func TestNewSovereignHeaderValidatorFactory(t *testing.T) {
 	t.Parallel()
 
-	shvf, err := NewSovereignHeaderValidatorFactory(nil)
-	require.NotNil(t, err)
+	shvf, err := NewSovereignHeaderValidatorFactory(nil, &mock.HeaderSigVerifierStub{}, &sovereign.EventsProofVerifierMock{}, nil, true)
+	require.Equal(t, process.ErrNilHeaderValidatorCreator, err)
 	require.Nil(t, shvf)
 
 	sf := NewShardHeaderValidatorFactory()
-	shvf, err = NewSovereignHeaderValidatorFactory(sf)
+	shvf, err = NewSovereignHeaderValidatorFactory(sf, nil, &sovereign.EventsProofVerifierMock{}, nil, true)
+	require.Equal(t, process.ErrNilHeaderSigVerifier, err)
+	require.Nil(t, shvf)
+
+	shvf, err = NewSovereignHeaderValidatorFactory(sf, &mock.HeaderSigVerifierStub{}, nil, nil, true)
+	require.Equal(t, errors.ErrNilEventsProofVerifier, err)
+	require.Nil(t, shvf)
+
+	shvf, err = NewSovereignHeaderValidatorFactory(sf, &mock.HeaderSigVerifierStub{}, &sovereign.EventsProofVerifierMock{}, nil, true)
 	require.Nil(t, err)
 	require.NotNil(t, shvf)
 	require.Implements(t, new(HeaderValidatorCreator), shvf)
@@ -27,7 +38,7 @@ func TestSovereignHeaderValidatorFactory_CreateHeaderValidator(t *testing.T) {
 	t.Parallel()
 
 	sf := NewShardHeaderValidatorFactory()
-	shvf, _ := NewSovereignHeaderValidatorFactory(sf)
+	shvf, _ := NewSovereignHeaderValidatorFactory(sf, &mock.HeaderSigVerifierStub{}, &sovereign.EventsProofVerifierMock{}, nil, true)
 
 	hv, err := shvf.CreateHeaderValidator(ArgsHeaderValidator{
 		Hasher:      nil,
@@ -49,6 +60,6 @@ func TestSovereignHeaderValidatorFactory_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
 	sf := NewShardHeaderValidatorFactory()
-	shvf, _ := NewSovereignHeaderValidatorFactory(sf)
+	shvf, _ := NewSovereignHeaderValidatorFactory(sf, &mock.HeaderSigVerifierStub{}, &sovereign.EventsProofVerifierMock{}, nil, true)
 	require.False(t, shvf.IsInterfaceNil())
 }
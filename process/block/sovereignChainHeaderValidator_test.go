@@ -1,25 +1,77 @@
 package block_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
 	block2 "github.com/multiversx/mx-chain-core-go/data/block"
+	sovereignCore "github.com/multiversx/mx-chain-core-go/data/sovereign"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	sovereignTests "github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestNewSovereignChainHeaderValidator_ShouldErrNilHeaderValidator(t *testing.T) {
 	t.Parallel()
 
-	schv, err := block.NewSovereignChainHeaderValidator(nil)
+	schv, err := block.NewSovereignChainHeaderValidator(nil, &mock.HeaderSigVerifierStub{}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
 	assert.Nil(t, schv)
 	assert.Equal(t, process.ErrNilHeaderValidator, err)
 }
 
+func TestNewSovereignChainHeaderValidator_ShouldErrNilMainChainHeaderSigVerifier(t *testing.T) {
+	t.Parallel()
+
+	argsHeaderValidator := block.ArgsHeaderValidator{
+		Hasher:      &mock.HasherStub{},
+		Marshalizer: &mock.MarshalizerMock{},
+	}
+	hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+
+	schv, err := block.NewSovereignChainHeaderValidator(hv, nil, &sovereignTests.EventsProofVerifierMock{}, nil, true)
+	assert.Nil(t, schv)
+	assert.Equal(t, process.ErrNilHeaderSigVerifier, err)
+}
+
+func TestNewSovereignChainHeaderValidator_ShouldErrNilMainChainEventsVerifier(t *testing.T) {
+	t.Parallel()
+
+	argsHeaderValidator := block.ArgsHeaderValidator{
+		Hasher:      &mock.HasherStub{},
+		Marshalizer: &mock.MarshalizerMock{},
+	}
+	hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+
+	schv, err := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{}, nil, nil, true)
+	assert.Nil(t, schv)
+	assert.Equal(t, errorsMx.ErrNilEventsProofVerifier, err)
+}
+
+func TestNewSovereignChainHeaderValidator_ShouldErrNilSovereignChainHeaderSigVerifier(t *testing.T) {
+	t.Parallel()
+
+	argsHeaderValidator := block.ArgsHeaderValidator{
+		Hasher:      &mock.HasherStub{},
+		Marshalizer: &mock.MarshalizerMock{},
+	}
+	hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+
+	sovereignChainsSigVerifiers := map[string]process.InterceptedHeaderSigVerifier{
+		"chainA": &mock.HeaderSigVerifierStub{},
+		"chainB": nil,
+	}
+	schv, err := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{}, &sovereignTests.EventsProofVerifierMock{}, sovereignChainsSigVerifiers, true)
+	assert.Nil(t, schv)
+	assert.ErrorIs(t, err, process.ErrNilHeaderSigVerifier)
+}
+
 func TestNewSovereignChainHeaderValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -29,7 +81,7 @@ func TestNewSovereignChainHeaderValidator_ShouldWork(t *testing.T) {
 	}
 	hv, _ := block.NewHeaderValidator(argsHeaderValidator)
 
-	schv, err := block.NewSovereignChainHeaderValidator(hv)
+	schv, err := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
 	assert.NotNil(t, schv)
 	assert.Nil(t, err)
 }
@@ -45,7 +97,7 @@ func TestGetHeaderHash_ShouldWork(t *testing.T) {
 			Marshalizer: &mock.MarshalizerMock{},
 		}
 		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
-		schv, _ := block.NewSovereignChainHeaderValidator(hv)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
 
 		shardHeaderExtended := &block2.ShardHeaderExtended{}
 		hash, err := schv.CalculateHeaderHash(shardHeaderExtended)
@@ -61,7 +113,7 @@ func TestGetHeaderHash_ShouldWork(t *testing.T) {
 			Marshalizer: &mock.MarshalizerMock{},
 		}
 		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
-		schv, _ := block.NewSovereignChainHeaderValidator(hv)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
 
 		shardHeaderExtended := &block2.ShardHeaderExtended{
 			Header: &block2.HeaderV2{
@@ -83,7 +135,7 @@ func TestGetHeaderHash_ShouldWork(t *testing.T) {
 			Marshalizer: &mock.MarshalizerMock{},
 		}
 		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
-		schv, _ := block.NewSovereignChainHeaderValidator(hv)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
 
 		header := &block2.Header{}
 
@@ -93,3 +145,271 @@ func TestGetHeaderHash_ShouldWork(t *testing.T) {
 		assert.Equal(t, expectedHash, hash)
 	})
 }
+
+func TestSovereignChainHeaderValidator_IsHeaderConstructionValid(t *testing.T) {
+	t.Parallel()
+
+	argsHeaderValidator := block.ArgsHeaderValidator{
+		Hasher:      &hashingMocks.HasherMock{},
+		Marshalizer: &mock.MarshalizerMock{},
+	}
+	prevExtendedHeader := &block2.ShardHeaderExtended{
+		Header: &block2.HeaderV2{
+			Header: &block2.Header{
+				Nonce: 1,
+				Round: 1,
+			},
+		},
+	}
+	prevHash, _ := core.CalculateHash(argsHeaderValidator.Marshalizer, argsHeaderValidator.Hasher, prevExtendedHeader.Header)
+	currExtendedHeader := &block2.ShardHeaderExtended{
+		Header: &block2.HeaderV2{
+			Header: &block2.Header{
+				Nonce:    2,
+				Round:    2,
+				PrevHash: prevHash,
+			},
+		},
+	}
+
+	t.Run("invalid main chain leader signature, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid leader signature")
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{
+			VerifyRandSeedAndLeaderSignatureCalled: func(header data.HeaderHandler) error {
+				assert.Equal(t, currExtendedHeader.Header, header)
+				return expectedErr
+			},
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
+
+		err := schv.IsHeaderConstructionValid(currExtendedHeader, prevExtendedHeader)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("invalid main chain aggregated signature, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid aggregated signature")
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				assert.Equal(t, currExtendedHeader.Header, header)
+				return expectedErr
+			},
+		}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
+
+		err := schv.IsHeaderConstructionValid(currExtendedHeader, prevExtendedHeader)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("invalid main chain events proofs, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("invalid events proofs")
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{}, &sovereignTests.EventsProofVerifierMock{
+			VerifyEventsProofsCalled: func(header sovereignCore.IncomingHeaderHandler) error {
+				assert.Equal(t, currExtendedHeader, header)
+				return expectedErr
+			},
+		}, nil, true)
+
+		err := schv.IsHeaderConstructionValid(currExtendedHeader, prevExtendedHeader)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("invalid construction, should not verify signatures", func(t *testing.T) {
+		t.Parallel()
+
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
+
+		err := schv.IsHeaderConstructionValid(prevExtendedHeader, currExtendedHeader)
+		assert.Equal(t, process.ErrLowerRoundInBlock, err)
+	})
+
+	t.Run("sovereign headers signatures are not verified against the main chain", func(t *testing.T) {
+		t.Parallel()
+
+		prevHeader := &block2.SovereignChainHeader{Header: &block2.Header{Nonce: 1, Round: 1}}
+		prevHeaderHash, _ := core.CalculateHash(argsHeaderValidator.Marshalizer, argsHeaderValidator.Hasher, prevHeader)
+		currHeader := &block2.SovereignChainHeader{Header: &block2.Header{Nonce: 2, Round: 2, PrevHash: prevHeaderHash}}
+
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}, &sovereignTests.EventsProofVerifierMock{}, nil, true)
+
+		err := schv.IsHeaderConstructionValid(currHeader, prevHeader)
+		assert.Nil(t, err)
+	})
+
+	t.Run("headers of other sovereign chains are linked by their sovereign hash", func(t *testing.T) {
+		t.Parallel()
+
+		prevSovHeader := &block2.SovereignChainHeader{Header: &block2.Header{Nonce: 1, Round: 1}}
+		prevSovHeaderHash, _ := core.CalculateHash(argsHeaderValidator.Marshalizer, argsHeaderValidator.Hasher, prevSovHeader)
+		currSovHeader := &block2.SovereignChainHeader{Header: &block2.Header{Nonce: 2, Round: 2, PrevHash: prevSovHeaderHash}}
+
+		prevEnvelope, _ := sovereign.WrapSovereignChainHeader(argsHeaderValidator.Marshalizer, prevSovHeader)
+		currEnvelope, _ := sovereign.WrapSovereignChainHeader(argsHeaderValidator.Marshalizer, currSovHeader)
+
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{
+			VerifyRandSeedAndLeaderSignatureCalled: func(header data.HeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}, &sovereignTests.EventsProofVerifierMock{}, nil, false)
+
+		err := schv.IsHeaderConstructionValid(
+			&block2.ShardHeaderExtended{Header: currEnvelope},
+			&block2.ShardHeaderExtended{Header: prevEnvelope},
+		)
+		assert.Nil(t, err)
+	})
+
+	t.Run("headers of unknown sovereign chains are rejected while the signature verification is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		prevSovHeader := &block2.SovereignChainHeader{Header: &block2.Header{Nonce: 1, Round: 1, ChainID: []byte("chainC")}}
+		prevSovHeaderHash, _ := core.CalculateHash(argsHeaderValidator.Marshalizer, argsHeaderValidator.Hasher, prevSovHeader)
+		currSovHeader := &block2.SovereignChainHeader{Header: &block2.Header{Nonce: 2, Round: 2, PrevHash: prevSovHeaderHash, ChainID: []byte("chainC")}}
+
+		prevEnvelope, _ := sovereign.WrapSovereignChainHeader(argsHeaderValidator.Marshalizer, prevSovHeader)
+		currEnvelope, _ := sovereign.WrapSovereignChainHeader(argsHeaderValidator.Marshalizer, currSovHeader)
+
+		sigVerifierShouldNotBeCalled := &mock.HeaderSigVerifierStub{
+			VerifyRandSeedAndLeaderSignatureCalled: func(header data.HeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		sovereignChainsSigVerifiers := map[string]process.InterceptedHeaderSigVerifier{
+			"chainA": sigVerifierShouldNotBeCalled,
+		}
+
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, sigVerifierShouldNotBeCalled, &sovereignTests.EventsProofVerifierMock{}, sovereignChainsSigVerifiers, true)
+
+		err := schv.IsHeaderConstructionValid(
+			&block2.ShardHeaderExtended{Header: currEnvelope},
+			&block2.ShardHeaderExtended{Header: prevEnvelope},
+		)
+		assert.ErrorIs(t, err, errorsMx.ErrUnverifiableSovereignChainHeader)
+	})
+
+	t.Run("headers of two incoming sovereign chains are verified against the validators of their own chain", func(t *testing.T) {
+		t.Parallel()
+
+		createSovereignChainHeaders := func(chainID string) (*block2.SovereignChainHeader, *block2.ShardHeaderExtended, *block2.ShardHeaderExtended) {
+			prevSovHeader := &block2.SovereignChainHeader{Header: &block2.Header{Nonce: 1, Round: 1, ChainID: []byte(chainID)}}
+			prevSovHeaderHash, _ := core.CalculateHash(argsHeaderValidator.Marshalizer, argsHeaderValidator.Hasher, prevSovHeader)
+			currSovHeader := &block2.SovereignChainHeader{
+				Header: &block2.Header{Nonce: 2, Round: 2, PrevHash: prevSovHeaderHash, ChainID: []byte(chainID)},
+				OutGoingMiniBlockHeader: &block2.OutGoingMiniBlockHeader{
+					OutGoingOperationsHash: []byte("outGoingOperationsHash"),
+				},
+			}
+
+			prevEnvelope, _ := sovereign.WrapSovereignChainHeader(argsHeaderValidator.Marshalizer, prevSovHeader)
+			currEnvelope, _ := sovereign.WrapSovereignChainHeader(argsHeaderValidator.Marshalizer, currSovHeader)
+
+			return currSovHeader, &block2.ShardHeaderExtended{Header: currEnvelope}, &block2.ShardHeaderExtended{Header: prevEnvelope}
+		}
+		currSovHeaderA, currHeaderA, prevHeaderA := createSovereignChainHeaders("chainA")
+		currSovHeaderB, currHeaderB, prevHeaderB := createSovereignChainHeaders("chainB")
+
+		createSigVerifier := func(expectedHeader data.HeaderHandler, numVerified *int, sigErr error) *mock.HeaderSigVerifierStub {
+			return &mock.HeaderSigVerifierStub{
+				VerifyRandSeedAndLeaderSignatureCalled: func(header data.HeaderHandler) error {
+					assert.Equal(t, expectedHeader, header)
+					*numVerified++
+					return nil
+				},
+				VerifySignatureCalled: func(header data.HeaderHandler) error {
+					assert.Equal(t, expectedHeader, header)
+					*numVerified++
+					return sigErr
+				},
+			}
+		}
+
+		errInvalidSig := errors.New("invalid chain B aggregated signature")
+		numVerifiedA, numVerifiedB, numVerifiedMainChain := 0, 0, 0
+		sovereignChainsSigVerifiers := map[string]process.InterceptedHeaderSigVerifier{
+			"chainA": createSigVerifier(currSovHeaderA, &numVerifiedA, nil),
+			"chainB": createSigVerifier(currSovHeaderB, &numVerifiedB, errInvalidSig),
+		}
+		mainChainSigVerifier := createSigVerifier(nil, &numVerifiedMainChain, nil)
+
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, mainChainSigVerifier, &sovereignTests.EventsProofVerifierMock{
+			VerifyEventsProofsCalled: func(header sovereignCore.IncomingHeaderHandler) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}, sovereignChainsSigVerifiers, true)
+
+		err := schv.IsHeaderConstructionValid(currHeaderA, prevHeaderA)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numVerifiedA)
+		assert.Equal(t, 0, numVerifiedB)
+
+		err = schv.IsHeaderConstructionValid(currHeaderB, prevHeaderB)
+		assert.Equal(t, errInvalidSig, err)
+		assert.Equal(t, 2, numVerifiedA)
+		assert.Equal(t, 2, numVerifiedB)
+		assert.Equal(t, 0, numVerifiedMainChain)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifiedLeaderSig, verifiedSig, verifiedEvents := false, false, false
+		hv, _ := block.NewHeaderValidator(argsHeaderValidator)
+		schv, _ := block.NewSovereignChainHeaderValidator(hv, &mock.HeaderSigVerifierStub{
+			VerifyRandSeedAndLeaderSignatureCalled: func(header data.HeaderHandler) error {
+				verifiedLeaderSig = true
+				return nil
+			},
+			VerifySignatureCalled: func(header data.HeaderHandler) error {
+				verifiedSig = true
+				return nil
+			},
+		}, &sovereignTests.EventsProofVerifierMock{
+			VerifyEventsProofsCalled: func(header sovereignCore.IncomingHeaderHandler) error {
+				verifiedEvents = true
+				return nil
+			},
+		}, nil, true)
+
+		err := schv.IsHeaderConstructionValid(currExtendedHeader, prevExtendedHeader)
+		assert.Nil(t, err)
+		assert.True(t, verifiedLeaderSig)
+		assert.True(t, verifiedSig)
+		assert.True(t, verifiedEvents)
+	})
+}
@@ -1,23 +1,31 @@
 package block
 
 import (
-	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
 )
 
 type sovereignShardCrossNotarizer struct {
 	*baseBlockNotarizer
+	incomingChainsHandler process.IncomingChainsHandler
 }
 
 func (scn *sovereignShardCrossNotarizer) getLastCrossNotarizedHeaders() []bootstrapStorage.BootstrapHeaderInfo {
-	bootstrapHeaderInfo := scn.getLastCrossNotarizedHeadersForShard(core.MainChainShardId)
-	if bootstrapHeaderInfo == nil {
-		return nil
+	shardIDs := scn.incomingChainsHandler.GetShardIDs()
+	lastCrossNotarizedHeaders := make([]bootstrapStorage.BootstrapHeaderInfo, 0, len(shardIDs))
+	for _, shardID := range shardIDs {
+		bootstrapHeaderInfo := scn.getLastCrossNotarizedHeadersForShard(shardID)
+		if bootstrapHeaderInfo == nil {
+			continue
+		}
+
+		bootstrapHeaderInfo.ShardId = shardID
+		lastCrossNotarizedHeaders = append(lastCrossNotarizedHeaders, *bootstrapHeaderInfo)
 	}
 
-	bootstrapHeaderInfo.ShardId = core.MainChainShardId
+	if len(lastCrossNotarizedHeaders) == 0 {
+		return nil
+	}
 
-	lastCrossNotarizedHeaders := make([]bootstrapStorage.BootstrapHeaderInfo, 0, 1)
-	lastCrossNotarizedHeaders = append(lastCrossNotarizedHeaders, *bootstrapHeaderInfo)
 	return trimSliceBootstrapHeaderInfo(lastCrossNotarizedHeaders)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,7 +21,7 @@ func TestSovereignShardCrossNotarizer_getLastCrossNotarizedHeaders(t *testing.T)
 		},
 	}
 	sovereignNotarzier := &sovereignShardCrossNotarizer{
-		&baseBlockNotarizer{
+		baseBlockNotarizer: &baseBlockNotarizer{
 			blockTracker: &testscommon.BlockTrackerStub{
 				GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
 					require.Equal(t, core.MainChainShardId, shardID)
@@ -28,6 +29,7 @@ func TestSovereignShardCrossNotarizer_getLastCrossNotarizedHeaders(t *testing.T)
 				},
 			},
 		},
+		incomingChainsHandler: &sovereign.IncomingChainsHandlerMock{},
 	}
 
 	headers := sovereignNotarzier.getLastCrossNotarizedHeaders()
@@ -40,3 +42,44 @@ func TestSovereignShardCrossNotarizer_getLastCrossNotarizedHeaders(t *testing.T)
 	}
 	require.Equal(t, expectedHeaders, headers)
 }
+
+func TestSovereignShardCrossNotarizer_getLastCrossNotarizedHeadersMultipleIncomingChains(t *testing.T) {
+	incomingChainShardID := core.MainChainShardId - 1
+	headers := map[uint32]data.HeaderHandler{
+		core.MainChainShardId: &block.Header{Nonce: 4},
+		incomingChainShardID:  &block.Header{Nonce: 7},
+	}
+	hashes := map[uint32][]byte{
+		core.MainChainShardId: []byte("hash main chain"),
+		incomingChainShardID:  []byte("hash incoming chain"),
+	}
+	sovereignNotarzier := &sovereignShardCrossNotarizer{
+		baseBlockNotarizer: &baseBlockNotarizer{
+			blockTracker: &testscommon.BlockTrackerStub{
+				GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+					return headers[shardID], hashes[shardID], nil
+				},
+			},
+		},
+		incomingChainsHandler: &sovereign.IncomingChainsHandlerMock{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{core.MainChainShardId, incomingChainShardID}
+			},
+		},
+	}
+
+	lastCrossNotarizedHeaders := sovereignNotarzier.getLastCrossNotarizedHeaders()
+	expectedHeaders := []bootstrapStorage.BootstrapHeaderInfo{
+		{
+			ShardId: core.MainChainShardId,
+			Nonce:   4,
+			Hash:    hashes[core.MainChainShardId],
+		},
+		{
+			ShardId: incomingChainShardID,
+			Nonce:   7,
+			Hash:    hashes[incomingChainShardID],
+		},
+	}
+	require.Equal(t, expectedHeaders, lastCrossNotarizedHeaders)
+}
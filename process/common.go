@@ -305,19 +305,21 @@ func GetExtendedShardHeaderFromStorage(
 	return hdr, nil
 }
 
-// GetExtendedHeaderFromStorageWithNonce method returns an extended block header from storage with a given nonce
+// GetExtendedHeaderFromStorageWithNonce method returns an extended block header from storage with a given nonce, using
+// the given nonce-hash pair data unit
 func GetExtendedHeaderFromStorageWithNonce(
 	nonce uint64,
 	storageService dataRetriever.StorageService,
 	uint64Converter typeConverters.Uint64ByteSliceConverter,
 	marshaller marshal.Marshalizer,
+	nonceHashDataUnit dataRetriever.UnitType,
 ) (*block.ShardHeaderExtended, []byte, error) {
 	hash, err := GetHeaderHashFromStorageWithNonce(
 		nonce,
 		storageService,
 		uint64Converter,
 		marshaller,
-		dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
+		nonceHashDataUnit)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1105,3 +1107,9 @@ func CheckIfIndexesAreOutOfBound(
 
 	return nil
 }
+
+// IsIncomingChainShardID returns true if the provided shard ID is one under which a sovereign chain tracks its incoming
+// chains: the main chain or one of the additional incoming chains
+func IsIncomingChainShardID(shardID uint32) bool {
+	return shardID <= core.MainChainShardId && shardID >= core.MainChainShardId-dataRetriever.MaxNumOfIncomingChains
+}
@@ -2284,6 +2284,18 @@ func Test_IsSetGuardianCall(t *testing.T) {
 	})
 }
 
+func TestIsIncomingChainShardID(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, process.IsIncomingChainShardID(core.MainChainShardId))
+	require.True(t, process.IsIncomingChainShardID(core.MainChainShardId-1))
+	require.True(t, process.IsIncomingChainShardID(core.MainChainShardId-dataRetriever.MaxNumOfIncomingChains))
+	require.False(t, process.IsIncomingChainShardID(core.MainChainShardId-dataRetriever.MaxNumOfIncomingChains-1))
+	require.False(t, process.IsIncomingChainShardID(core.SovereignChainShardId))
+	require.False(t, process.IsIncomingChainShardID(core.MetachainShardId))
+	require.False(t, process.IsIncomingChainShardID(core.AllShardId))
+}
+
 func TestCheckIfIndexesAreOutOfBound(t *testing.T) {
 	t.Parallel()
 
@@ -2440,7 +2452,8 @@ func TestGetExtendedHeaderFromStorageWithNonce(t *testing.T) {
 			nonce,
 			storageService,
 			uint64Converter,
-			marshaller)
+			marshaller,
+			dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
 
 		require.Nil(t, err)
 		require.Equal(t, hash, headerHash)
@@ -2454,7 +2467,8 @@ func TestGetExtendedHeaderFromStorageWithNonce(t *testing.T) {
 			nonce,
 			storageService,
 			uint64Converter,
-			marshaller)
+			marshaller,
+			dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
 
 		require.Equal(t, process.ErrUnmarshalWithoutSuccess, err)
 		require.Nil(t, headerHash)
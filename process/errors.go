@@ -1277,3 +1277,7 @@ var ErrNilSCProcessorCreator = errors.New("nil sc processor creator")
 
 // ErrNilSCProcessorHelper signals that a nil sc processor helper was provided
 var ErrNilSCProcessorHelper = errors.New("nil sc processor helper")
+
+// ErrMultipleOutGoingDestinationsNotEnabled signals that outgoing operations for multiple destinations were created
+// before the outgoing operations batches activation
+var ErrMultipleOutGoingDestinationsNotEnabled = errors.New("outgoing operations for multiple destinations are not enabled before the outgoing operations batches activation")
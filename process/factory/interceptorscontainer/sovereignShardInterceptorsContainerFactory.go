@@ -17,11 +17,13 @@ import (
 type ArgsSovereignShardInterceptorsContainerFactory struct {
 	ShardContainer           *shardInterceptorsContainerFactory
 	IncomingHeaderSubscriber process.IncomingHeaderSubscriber
+	IncomingChainsHandler    process.IncomingChainsHandler
 }
 
 type sovereignShardInterceptorsContainerFactory struct {
 	*shardInterceptorsContainerFactory
 	incomingHeaderSubscriber process.IncomingHeaderSubscriber
+	incomingChainsHandler    process.IncomingChainsHandler
 }
 
 // NewSovereignShardInterceptorsContainerFactory creates a new sovereign interceptors factory
@@ -34,10 +36,14 @@ func NewSovereignShardInterceptorsContainerFactory(
 	if check.IfNil(args.IncomingHeaderSubscriber) {
 		return nil, errors.ErrNilIncomingHeaderSubscriber
 	}
+	if check.IfNil(args.IncomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
 
 	return &sovereignShardInterceptorsContainerFactory{
 		shardInterceptorsContainerFactory: args.ShardContainer,
 		incomingHeaderSubscriber:          args.IncomingHeaderSubscriber,
+		incomingChainsHandler:             args.IncomingChainsHandler,
 	}, nil
 }
 
@@ -185,31 +191,39 @@ func (sicf *sovereignShardInterceptorsContainerFactory) generateSovereignExtende
 		return err
 	}
 
-	identifierHdr := factory.ExtendedHeaderProofTopic + shardC.CommunicationIdentifier(shardC.SelfId())
-
-	// only one intra shard header topic
-	interceptor, err := interceptors.NewSingleDataInterceptor(
-		interceptors.ArgSingleDataInterceptor{
-			Topic:                identifierHdr,
-			DataFactory:          hdrFactory,
-			Processor:            hdrProcessor,
-			Throttler:            sicf.globalThrottler,
-			AntifloodHandler:     sicf.antifloodHandler,
-			WhiteListRequest:     sicf.whiteListHandler,
-			CurrentPeerId:        sicf.mainMessenger.ID(),
-			PreferredPeersHolder: sicf.preferredPeersHolder,
-		},
-	)
-	if err != nil {
-		return err
+	// one intra shard header topic for each incoming chain, since headers of each incoming chain are requested by
+	// nonce on their own topic
+	incomingChainShardIDs := sicf.incomingChainsHandler.GetShardIDs()
+	keys := make([]string, 0, len(incomingChainShardIDs))
+	interceptorsSlice := make([]process.Interceptor, 0, len(incomingChainShardIDs))
+	for _, incomingChainShardID := range incomingChainShardIDs {
+		identifierHdr := factory.IncomingChainHeaderProofTopic(incomingChainShardID) + shardC.CommunicationIdentifier(shardC.SelfId())
+		interceptor, errCreate := interceptors.NewSingleDataInterceptor(
+			interceptors.ArgSingleDataInterceptor{
+				Topic:                identifierHdr,
+				DataFactory:          hdrFactory,
+				Processor:            hdrProcessor,
+				Throttler:            sicf.globalThrottler,
+				AntifloodHandler:     sicf.antifloodHandler,
+				WhiteListRequest:     sicf.whiteListHandler,
+				CurrentPeerId:        sicf.mainMessenger.ID(),
+				PreferredPeersHolder: sicf.preferredPeersHolder,
+			},
+		)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		_, errCreate = sicf.createTopicAndAssignHandler(identifierHdr, interceptor, true)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		keys = append(keys, identifierHdr)
+		interceptorsSlice = append(interceptorsSlice, interceptor)
 	}
 
-	_, err = sicf.createTopicAndAssignHandler(identifierHdr, interceptor, true)
-	if err != nil {
-		return err
-	}
-
-	return sicf.addInterceptorsToContainers([]string{identifierHdr}, []process.Interceptor{interceptor})
+	return sicf.addInterceptorsToContainers(keys, interceptorsSlice)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,13 +1,25 @@
 package interceptorscontainer
 
-import "github.com/multiversx/mx-chain-go/process"
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process"
+)
 
 type sovereignShardInterceptorsContainerFactoryCreator struct {
+	incomingChainsHandler process.IncomingChainsHandler
 }
 
 // NewSovereignShardInterceptorsContainerFactoryCreator creates a sovereign shard interceptors container factory creator
-func NewSovereignShardInterceptorsContainerFactoryCreator() *sovereignShardInterceptorsContainerFactoryCreator {
-	return &sovereignShardInterceptorsContainerFactoryCreator{}
+func NewSovereignShardInterceptorsContainerFactoryCreator(incomingChainsHandler process.IncomingChainsHandler) (*sovereignShardInterceptorsContainerFactoryCreator, error) {
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
+
+	return &sovereignShardInterceptorsContainerFactoryCreator{
+		incomingChainsHandler: incomingChainsHandler,
+	}, nil
 }
 
 // CreateInterceptorsContainerFactory creates a sovereign shard interceptors container factory
@@ -20,6 +32,7 @@ func (f *sovereignShardInterceptorsContainerFactoryCreator) CreateInterceptorsCo
 	argsSov := ArgsSovereignShardInterceptorsContainerFactory{
 		ShardContainer:           shardContainer,
 		IncomingHeaderSubscriber: args.IncomingHeaderSubscriber,
+		IncomingChainsHandler:    f.incomingChainsHandler,
 	}
 	return NewSovereignShardInterceptorsContainerFactory(argsSov)
 }
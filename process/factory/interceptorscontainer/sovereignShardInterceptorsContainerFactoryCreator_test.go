@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process/factory/interceptorscontainer"
 	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/require"
@@ -13,7 +14,12 @@ import (
 func TestNewSovereignShardInterceptorsContainerFactoryCreator(t *testing.T) {
 	t.Parallel()
 
-	factoryCreator := interceptorscontainer.NewSovereignShardInterceptorsContainerFactoryCreator()
+	factoryCreator, err := interceptorscontainer.NewSovereignShardInterceptorsContainerFactoryCreator(nil)
+	require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+	require.Nil(t, factoryCreator)
+
+	factoryCreator, err = interceptorscontainer.NewSovereignShardInterceptorsContainerFactoryCreator(&sovereign.IncomingChainsHandlerMock{})
+	require.Nil(t, err)
 	require.Implements(t, new(interceptorscontainer.InterceptorsContainerFactoryCreator), factoryCreator)
 	require.False(t, check.IfNil(factoryCreator))
 }
@@ -21,7 +27,7 @@ func TestNewSovereignShardInterceptorsContainerFactoryCreator(t *testing.T) {
 func TestSovereignShardInterceptorsContainerFactoryCreator_CreateInterceptorsContainerFactory(t *testing.T) {
 	t.Parallel()
 
-	factoryCreator := interceptorscontainer.NewSovereignShardInterceptorsContainerFactoryCreator()
+	factoryCreator, _ := interceptorscontainer.NewSovereignShardInterceptorsContainerFactoryCreator(&sovereign.IncomingChainsHandlerMock{})
 	coreComp, cryptoComp := createMockComponentHolders()
 	args := getArgumentsShard(coreComp, cryptoComp)
 	args.IncomingHeaderSubscriber = &sovereign.IncomingHeaderSubscriberStub{}
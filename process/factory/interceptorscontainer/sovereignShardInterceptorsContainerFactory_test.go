@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const incomingChainShardID = core.MainChainShardId - 1
+
 func createSovInterceptorsContainerArgs() interceptorscontainer.ArgsSovereignShardInterceptorsContainerFactory {
 	coreComp, cryptoComp := createMockComponentHolders()
 	args := getArgumentsShard(coreComp, cryptoComp)
@@ -25,6 +27,11 @@ func createSovInterceptorsContainerArgs() interceptorscontainer.ArgsSovereignSha
 	return interceptorscontainer.ArgsSovereignShardInterceptorsContainerFactory{
 		ShardContainer:           shardContainer,
 		IncomingHeaderSubscriber: &sovereign.IncomingHeaderSubscriberStub{},
+		IncomingChainsHandler: &sovereign.IncomingChainsHandlerMock{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{core.MainChainShardId, incomingChainShardID}
+			},
+		},
 	}
 }
 
@@ -45,6 +52,13 @@ func TestNewSovereignShardInterceptorsContainerFactory(t *testing.T) {
 		require.Equal(t, errors.ErrNilIncomingHeaderSubscriber, err)
 		require.Nil(t, sovContainer)
 	})
+	t.Run("nil incoming chains handler, should return error", func(t *testing.T) {
+		args := createSovInterceptorsContainerArgs()
+		args.IncomingChainsHandler = nil
+		sovContainer, err := interceptorscontainer.NewSovereignShardInterceptorsContainerFactory(args)
+		require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+		require.Nil(t, sovContainer)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createSovInterceptorsContainerArgs()
 		sovContainer, err := interceptorscontainer.NewSovereignShardInterceptorsContainerFactory(args)
@@ -73,7 +87,7 @@ func TestSovereignShardInterceptorsContainerFactory_Create(t *testing.T) {
 	numInterceptorHeartbeat := 1
 	numInterceptorsShardValidatorInfo := 1
 	numInterceptorValidatorInfo := 1
-	numInterceptorExtendedHeader := 1
+	numInterceptorExtendedHeader := 2
 	totalInterceptors := numInterceptorTxs + numInterceptorsUnsignedTxs + numInterceptorsRewardTxs +
 		numInterceptorHeaders + numInterceptorMiniBlocks + numInterceptorMetachainHeaders + numInterceptorTrieNodes +
 		numInterceptorPeerAuth + numInterceptorHeartbeat + numInterceptorsShardValidatorInfo + numInterceptorValidatorInfo +
@@ -84,17 +98,18 @@ func TestSovereignShardInterceptorsContainerFactory_Create(t *testing.T) {
 
 	sovShardIDStr := fmt.Sprintf("_%d", core.SovereignChainShardId)
 	allKeys := map[string]struct{}{
-		factory.TransactionTopic + sovShardIDStr:         {},
-		factory.UnsignedTransactionTopic + sovShardIDStr: {},
-		factory.ShardBlocksTopic + sovShardIDStr:         {},
-		factory.MiniBlocksTopic + sovShardIDStr:          {},
-		factory.ValidatorTrieNodesTopic + sovShardIDStr:  {},
-		factory.AccountTrieNodesTopic + sovShardIDStr:    {},
-		common.PeerAuthenticationTopic:                   {},
-		common.HeartbeatV2Topic + sovShardIDStr:          {},
-		common.ConnectionTopic:                           {},
-		common.ValidatorInfoTopic + sovShardIDStr:        {},
-		factory.ExtendedHeaderProofTopic + sovShardIDStr: {},
+		factory.TransactionTopic + sovShardIDStr:                                    {},
+		factory.UnsignedTransactionTopic + sovShardIDStr:                            {},
+		factory.ShardBlocksTopic + sovShardIDStr:                                    {},
+		factory.MiniBlocksTopic + sovShardIDStr:                                     {},
+		factory.ValidatorTrieNodesTopic + sovShardIDStr:                             {},
+		factory.AccountTrieNodesTopic + sovShardIDStr:                               {},
+		common.PeerAuthenticationTopic:                                              {},
+		common.HeartbeatV2Topic + sovShardIDStr:                                     {},
+		common.ConnectionTopic:                                                      {},
+		common.ValidatorInfoTopic + sovShardIDStr:                                   {},
+		factory.ExtendedHeaderProofTopic + sovShardIDStr:                            {},
+		factory.IncomingChainHeaderProofTopic(incomingChainShardID) + sovShardIDStr: {},
 	}
 
 	iterateFunc := func(key string, interceptor process.Interceptor) bool {
@@ -47,6 +47,7 @@ type vmContainerFactory struct {
 	enableEpochsHandler     common.EnableEpochsHandler
 	nodesCoordinator        vm.NodesCoordinator
 	vmContextCreatorHandler systemSmartContracts.VMContextCreatorHandler
+	chainID                 []byte
 }
 
 // ArgsNewVMContainerFactory defines the arguments needed to create a new VM container factory
@@ -68,6 +69,7 @@ type ArgsNewVMContainerFactory struct {
 	EnableEpochsHandler     common.EnableEpochsHandler
 	NodesCoordinator        vm.NodesCoordinator
 	VMContextCreatorHandler systemSmartContracts.VMContextCreatorHandler
+	ChainID                 []byte
 }
 
 // NewVMContainerFactory is responsible for creating a new virtual machine factory object
@@ -141,6 +143,7 @@ func NewVMContainerFactory(args ArgsNewVMContainerFactory) (*vmContainerFactory,
 		enableEpochsHandler:     args.EnableEpochsHandler,
 		nodesCoordinator:        args.NodesCoordinator,
 		vmContextCreatorHandler: args.VMContextCreatorHandler,
+		chainID:                 args.ChainID,
 	}, nil
 }
 
@@ -216,6 +219,7 @@ func (vmf *vmContainerFactory) createSystemVMFactoryAndEEI() (vm.SystemSCContain
 		ShardCoordinator:       vmf.shardCoordinator,
 		EnableEpochsHandler:    vmf.enableEpochsHandler,
 		NodesCoordinator:       vmf.nodesCoordinator,
+		ChainID:                vmf.chainID,
 	}
 	scFactory, err := systemVMFactory.NewSystemSCFactory(argsNewSystemScFactory)
 	if err != nil {
@@ -1,7 +1,23 @@
 package factory
 
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
 const (
 	// ExtendedHeaderProofTopic is the topic used for sharing extended shard headers as proofs of incoming
 	// events from main chain to sovereign chain
 	ExtendedHeaderProofTopic = "extendedHeader"
 )
+
+// IncomingChainHeaderProofTopic returns the topic used for sharing the extended shard headers of the incoming chain
+// tracked under the provided shard ID. Main chain extended shard headers are shared on ExtendedHeaderProofTopic.
+func IncomingChainHeaderProofTopic(shardID uint32) string {
+	if shardID == core.MainChainShardId {
+		return ExtendedHeaderProofTopic
+	}
+
+	return fmt.Sprintf("%s_%d", ExtendedHeaderProofTopic, shardID)
+}
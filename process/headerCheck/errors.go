@@ -23,3 +23,6 @@ var ErrIndexOutOfBounds = errors.New("index is out of bounds")
 
 // ErrIndexNotSelected signals that the given index is not selected
 var ErrIndexNotSelected = errors.New("index is not selected")
+
+// ErrNilMainChainNodesCoordinator signals that a nil main chain nodes coordinator has been provided
+var ErrNilMainChainNodesCoordinator = errors.New("nil main chain nodes coordinator")
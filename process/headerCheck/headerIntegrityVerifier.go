@@ -9,6 +9,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/cmd/node/factory"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 )
 
 type headerIntegrityVerifier struct {
@@ -44,7 +45,7 @@ func (hdrIntVer *headerIntegrityVerifier) GetVersion(epoch uint32) string {
 
 // Verify will check the header's fields such as the chain ID or the software version
 func (hdrIntVer *headerIntegrityVerifier) Verify(hdr data.HeaderHandler) error {
-	if len(hdr.GetReserved()) > 0 {
+	if !outgoingBatches.IsReservedFieldValid(hdr) {
 		return process.ErrReservedFieldInvalid
 	}
 
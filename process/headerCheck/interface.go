@@ -4,6 +4,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 )
 
 // ExtraHeaderSigVerifierHolder manages extra header verifiers
@@ -15,3 +16,10 @@ type ExtraHeaderSigVerifierHolder interface {
 	RegisterExtraHeaderSigVerifier(extraVerifier process.ExtraHeaderSigVerifierHandler) error
 	IsInterfaceNil() bool
 }
+
+// MainChainNodesCoordinator defines the main chain validators view used to compute the main chain consensus groups
+type MainChainNodesCoordinator interface {
+	ComputeConsensusGroup(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]nodesCoordinator.Validator, error)
+	GetConsensusValidatorsPublicKeys(randomness []byte, round uint64, shardID uint32, epoch uint32) ([]string, error)
+	IsInterfaceNil() bool
+}
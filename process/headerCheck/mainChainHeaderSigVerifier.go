@@ -0,0 +1,282 @@
+package headerCheck
+
+import (
+	"math/bits"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+var _ process.InterceptedHeaderSigVerifier = (*mainChainHeaderSigVerifier)(nil)
+
+// ArgsMainChainHeaderSigVerifier is used to store all components that are needed to create a new main chain header sig verifier
+type ArgsMainChainHeaderSigVerifier struct {
+	Marshaller        marshal.Marshalizer
+	Hasher            hashing.Hasher
+	NodesCoordinator  MainChainNodesCoordinator
+	MultiSigVerifier  crypto.MultiSigner
+	SingleSigVerifier crypto.SingleSigner
+	KeyGen            crypto.KeyGenerator
+	ExtraSigVerifier  ExtraHeaderSigVerifierHolder
+}
+
+// mainChainHeaderSigVerifier checks that a header of an incoming chain was proposed and signed by the consensus group
+// of its round, as computed from the validators view of that chain. Besides the main chain headers, it also verifies the
+// headers of other sovereign chains, for which the extra sig verifier also checks the signatures of their outgoing
+// operations, which are removed from the header before computing its signed hash, same as on that sovereign chain.
+type mainChainHeaderSigVerifier struct {
+	marshaller        marshal.Marshalizer
+	hasher            hashing.Hasher
+	nodesCoordinator  MainChainNodesCoordinator
+	multiSigVerifier  crypto.MultiSigner
+	singleSigVerifier crypto.SingleSigner
+	keyGen            crypto.KeyGenerator
+	extraSigVerifier  ExtraHeaderSigVerifierHolder
+}
+
+// NewMainChainHeaderSigVerifier creates a new main chain header sig verifier
+func NewMainChainHeaderSigVerifier(args ArgsMainChainHeaderSigVerifier) (*mainChainHeaderSigVerifier, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilMainChainNodesCoordinator
+	}
+	if check.IfNil(args.MultiSigVerifier) {
+		return nil, process.ErrNilMultiSigVerifier
+	}
+	if check.IfNil(args.SingleSigVerifier) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+	if check.IfNil(args.ExtraSigVerifier) {
+		return nil, errors.ErrNilExtraHeaderSigVerifierHolder
+	}
+
+	return &mainChainHeaderSigVerifier{
+		marshaller:        args.Marshaller,
+		hasher:            args.Hasher,
+		nodesCoordinator:  args.NodesCoordinator,
+		multiSigVerifier:  args.MultiSigVerifier,
+		singleSigVerifier: args.SingleSigVerifier,
+		keyGen:            args.KeyGen,
+		extraSigVerifier:  args.ExtraSigVerifier,
+	}, nil
+}
+
+// VerifySignature will check that the header is signed by at least the PBFT threshold of its consensus group
+func (hsv *mainChainHeaderSigVerifier) VerifySignature(header data.HeaderHandler) error {
+	if check.IfNil(header) {
+		return process.ErrNilHeaderHandler
+	}
+
+	headerCopy, err := hsv.copyHeaderWithoutSignatures(header)
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(hsv.marshaller, hsv.hasher, headerCopy)
+	if err != nil {
+		return err
+	}
+
+	pubKeysSigners, err := hsv.getConsensusSigners(header)
+	if err != nil {
+		return err
+	}
+
+	err = hsv.multiSigVerifier.VerifyAggregatedSig(pubKeysSigners, hash, header.GetSignature())
+	if err != nil {
+		return err
+	}
+
+	return hsv.extraSigVerifier.VerifyAggregatedSignature(header, hsv.multiSigVerifier, pubKeysSigners)
+}
+
+func (hsv *mainChainHeaderSigVerifier) getConsensusSigners(header data.HeaderHandler) ([][]byte, error) {
+	bitmap := header.GetPubKeysBitmap()
+	if len(bitmap) == 0 {
+		return nil, process.ErrNilPubKeysBitmap
+	}
+	if bitmap[0]&1 == 0 {
+		return nil, process.ErrBlockProposerSignatureMissing
+	}
+
+	consensusPubKeys, err := hsv.nodesCoordinator.GetConsensusValidatorsPublicKeys(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+		getEpochForConsensus(header),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyBitmapForConsensusSize(bitmap, len(consensusPubKeys))
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeysSigners := make([][]byte, 0, len(consensusPubKeys))
+	for i := range consensusPubKeys {
+		err = isIndexInBitmap(uint16(i), bitmap)
+		if err != nil {
+			continue
+		}
+		pubKeysSigners = append(pubKeysSigners, []byte(consensusPubKeys[i]))
+	}
+
+	return pubKeysSigners, nil
+}
+
+func verifyBitmapForConsensusSize(bitmap []byte, consensusSize int) error {
+	expectedBitmapSize := consensusSize / 8
+	if consensusSize%8 != 0 {
+		expectedBitmapSize++
+	}
+	if len(bitmap) != expectedBitmapSize {
+		return ErrWrongSizeBitmap
+	}
+
+	numOfOnesInBitmap := 0
+	for index := range bitmap {
+		numOfOnesInBitmap += bits.OnesCount8(bitmap[index])
+	}
+	if numOfOnesInBitmap < core.GetPBFTThreshold(consensusSize) {
+		return ErrNotEnoughSignatures
+	}
+
+	return nil
+}
+
+// VerifyRandSeed will check if rand seed is correct
+func (hsv *mainChainHeaderSigVerifier) VerifyRandSeed(header data.HeaderHandler) error {
+	leaderPubKey, err := hsv.getLeader(header)
+	if err != nil {
+		return err
+	}
+
+	return hsv.singleSigVerifier.Verify(leaderPubKey, header.GetPrevRandSeed(), header.GetRandSeed())
+}
+
+// VerifyLeaderSignature will check if leader signature is correct
+func (hsv *mainChainHeaderSigVerifier) VerifyLeaderSignature(header data.HeaderHandler) error {
+	leaderPubKey, err := hsv.getLeader(header)
+	if err != nil {
+		return err
+	}
+
+	return hsv.verifyLeaderSignature(leaderPubKey, header)
+}
+
+// VerifyRandSeedAndLeaderSignature will check if rand seed and leader signature is correct
+func (hsv *mainChainHeaderSigVerifier) VerifyRandSeedAndLeaderSignature(header data.HeaderHandler) error {
+	leaderPubKey, err := hsv.getLeader(header)
+	if err != nil {
+		return err
+	}
+
+	err = hsv.singleSigVerifier.Verify(leaderPubKey, header.GetPrevRandSeed(), header.GetRandSeed())
+	if err != nil {
+		return err
+	}
+
+	return hsv.verifyLeaderSignature(leaderPubKey, header)
+}
+
+func (hsv *mainChainHeaderSigVerifier) verifyLeaderSignature(leaderPubKey crypto.PublicKey, header data.HeaderHandler) error {
+	headerCopy := header.ShallowClone()
+	err := headerCopy.SetLeaderSignature(nil)
+	if err != nil {
+		return err
+	}
+
+	err = hsv.extraSigVerifier.RemoveLeaderSignature(headerCopy)
+	if err != nil {
+		return err
+	}
+
+	headerBytes, err := hsv.marshaller.Marshal(headerCopy)
+	if err != nil {
+		return err
+	}
+
+	err = hsv.singleSigVerifier.Verify(leaderPubKey, headerBytes, header.GetLeaderSignature())
+	if err != nil {
+		return err
+	}
+
+	return hsv.extraSigVerifier.VerifyLeaderSignature(header, leaderPubKey)
+}
+
+func (hsv *mainChainHeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.PublicKey, error) {
+	if check.IfNil(header) {
+		return nil, process.ErrNilHeaderHandler
+	}
+
+	consensusGroup, err := hsv.nodesCoordinator.ComputeConsensusGroup(
+		header.GetPrevRandSeed(),
+		header.GetRound(),
+		header.GetShardID(),
+		getEpochForConsensus(header),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(consensusGroup) == 0 {
+		return nil, process.ErrEmptyConsensusGroup
+	}
+
+	return hsv.keyGen.PublicKeyFromByteArray(consensusGroup[0].PubKey())
+}
+
+// the start of epoch block is validated by the consensus group of the previous epoch, same as on the main chain
+func getEpochForConsensus(header data.HeaderHandler) uint32 {
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	return epoch
+}
+
+func (hsv *mainChainHeaderSigVerifier) copyHeaderWithoutSignatures(header data.HeaderHandler) (data.HeaderHandler, error) {
+	headerCopy := header.ShallowClone()
+	err := headerCopy.SetSignature(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = headerCopy.SetPubKeysBitmap(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = headerCopy.SetLeaderSignature(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = hsv.extraSigVerifier.RemoveAllSignatures(headerCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	return headerCopy, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hsv *mainChainHeaderSigVerifier) IsInterfaceNil() bool {
+	return hsv == nil
+}
@@ -0,0 +1,279 @@
+package headerCheck
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
+	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/headerSigVerifier"
+	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMainChainHeaderSigVerifierArgs() ArgsMainChainHeaderSigVerifier {
+	return ArgsMainChainHeaderSigVerifier{
+		Marshaller:        &mock.MarshalizerMock{},
+		Hasher:            &hashingMocks.HasherMock{},
+		NodesCoordinator:  &shardingMocks.NodesCoordinatorMock{},
+		MultiSigVerifier:  cryptoMocks.NewMultiSigner(),
+		SingleSigVerifier: &mock.SignerMock{},
+		KeyGen:            &mock.SingleSignKeyGenMock{},
+		ExtraSigVerifier:  &headerSigVerifier.ExtraHeaderSigVerifierHolderMock{},
+	}
+}
+
+func createMainChainConsensusGroup(size int) []nodesCoordinator.Validator {
+	consensusGroup := make([]nodesCoordinator.Validator, 0, size)
+	for i := 0; i < size; i++ {
+		v, _ := nodesCoordinator.NewValidator([]byte{byte('a' + i)}, 1, defaultChancesSelection)
+		consensusGroup = append(consensusGroup, v)
+	}
+
+	return consensusGroup
+}
+
+func TestNewMainChainHeaderSigVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.Marshaller = nil
+		hsv, err := NewMainChainHeaderSigVerifier(args)
+		require.Nil(t, hsv)
+		require.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.Hasher = nil
+		hsv, err := NewMainChainHeaderSigVerifier(args)
+		require.Nil(t, hsv)
+		require.Equal(t, process.ErrNilHasher, err)
+	})
+	t.Run("nil nodes coordinator, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.NodesCoordinator = nil
+		hsv, err := NewMainChainHeaderSigVerifier(args)
+		require.Nil(t, hsv)
+		require.Equal(t, ErrNilMainChainNodesCoordinator, err)
+	})
+	t.Run("nil multi sig verifier, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.MultiSigVerifier = nil
+		hsv, err := NewMainChainHeaderSigVerifier(args)
+		require.Nil(t, hsv)
+		require.Equal(t, process.ErrNilMultiSigVerifier, err)
+	})
+	t.Run("nil single sig verifier, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.SingleSigVerifier = nil
+		hsv, err := NewMainChainHeaderSigVerifier(args)
+		require.Nil(t, hsv)
+		require.Equal(t, process.ErrNilSingleSigner, err)
+	})
+	t.Run("nil key gen, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.KeyGen = nil
+		hsv, err := NewMainChainHeaderSigVerifier(args)
+		require.Nil(t, hsv)
+		require.Equal(t, process.ErrNilKeyGen, err)
+	})
+	t.Run("nil extra sig verifier, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.ExtraSigVerifier = nil
+		hsv, err := NewMainChainHeaderSigVerifier(args)
+		require.Nil(t, hsv)
+		require.Equal(t, errorsMx.ErrNilExtraHeaderSigVerifierHolder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		hsv, err := NewMainChainHeaderSigVerifier(createMainChainHeaderSigVerifierArgs())
+		require.Nil(t, err)
+		require.False(t, hsv.IsInterfaceNil())
+	})
+}
+
+func TestMainChainHeaderSigVerifier_VerifySignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header, should return error", func(t *testing.T) {
+		hsv, _ := NewMainChainHeaderSigVerifier(createMainChainHeaderSigVerifierArgs())
+		require.Equal(t, process.ErrNilHeaderHandler, hsv.VerifySignature(nil))
+	})
+	t.Run("missing proposer signature, should return error", func(t *testing.T) {
+		hsv, _ := NewMainChainHeaderSigVerifier(createMainChainHeaderSigVerifierArgs())
+		header := &dataBlock.HeaderV2{Header: &dataBlock.Header{PubKeysBitmap: []byte{0x0e}}}
+		require.Equal(t, process.ErrBlockProposerSignatureMissing, hsv.VerifySignature(header))
+	})
+	t.Run("unknown main chain epoch, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				return nil, nodesCoordinator.ErrEpochNodesConfigDoesNotExist
+			},
+		}
+		hsv, _ := NewMainChainHeaderSigVerifier(args)
+		header := &dataBlock.HeaderV2{Header: &dataBlock.Header{PubKeysBitmap: []byte{0x0f}}}
+		require.Equal(t, nodesCoordinator.ErrEpochNodesConfigDoesNotExist, hsv.VerifySignature(header))
+	})
+	t.Run("not enough signatures, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				return createMainChainConsensusGroup(4), nil
+			},
+		}
+		args.MultiSigVerifier = &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		hsv, _ := NewMainChainHeaderSigVerifier(args)
+		header := &dataBlock.HeaderV2{Header: &dataBlock.Header{PubKeysBitmap: []byte{0x03}}}
+		require.Equal(t, ErrNotEnoughSignatures, hsv.VerifySignature(header))
+	})
+	t.Run("wrong bitmap size, should return error", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				return createMainChainConsensusGroup(4), nil
+			},
+		}
+		hsv, _ := NewMainChainHeaderSigVerifier(args)
+		header := &dataBlock.HeaderV2{Header: &dataBlock.Header{PubKeysBitmap: []byte{0x0f, 0x00}}}
+		require.Equal(t, ErrWrongSizeBitmap, hsv.VerifySignature(header))
+	})
+	t.Run("start of epoch header is verified with the previous epoch consensus", func(t *testing.T) {
+		args := createMainChainHeaderSigVerifierArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				require.Equal(t, uint32(4), epoch)
+				return createMainChainConsensusGroup(4), nil
+			},
+		}
+		hsv, _ := NewMainChainHeaderSigVerifier(args)
+		header := &dataBlock.HeaderV2{Header: &dataBlock.Header{
+			Epoch:              5,
+			EpochStartMetaHash: []byte("epochStartMetaHash"),
+			PubKeysBitmap:      []byte{0x0f},
+		}}
+		require.Nil(t, hsv.VerifySignature(header))
+	})
+	t.Run("should verify aggregated signature of the signers", func(t *testing.T) {
+		consensusGroup := createMainChainConsensusGroup(4)
+		aggSig := []byte("aggregatedSignature")
+
+		args := createMainChainHeaderSigVerifierArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				require.Equal(t, []byte("prevRandSeed"), randomness)
+				require.Equal(t, uint64(7), round)
+				return consensusGroup, nil
+			},
+		}
+		wasCalled := false
+		args.MultiSigVerifier = &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, sig []byte) error {
+				wasCalled = true
+				require.Equal(t, [][]byte{consensusGroup[0].PubKey(), consensusGroup[1].PubKey(), consensusGroup[3].PubKey()}, pubKeysSigners)
+				require.Equal(t, aggSig, sig)
+				return nil
+			},
+		}
+		hsv, _ := NewMainChainHeaderSigVerifier(args)
+		header := &dataBlock.HeaderV2{Header: &dataBlock.Header{
+			Round:         7,
+			PrevRandSeed:  []byte("prevRandSeed"),
+			PubKeysBitmap: []byte{0x0b},
+			Signature:     aggSig,
+		}}
+		require.Nil(t, hsv.VerifySignature(header))
+		require.True(t, wasCalled)
+	})
+	t.Run("should verify the extra signatures after removing them from the signed hash", func(t *testing.T) {
+		consensusGroup := createMainChainConsensusGroup(4)
+		errExtraSig := errors.New("invalid outgoing operations signature")
+
+		args := createMainChainHeaderSigVerifierArgs()
+		args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+				return consensusGroup, nil
+			},
+		}
+		removedExtraSigs := false
+		args.ExtraSigVerifier = &headerSigVerifier.ExtraHeaderSigVerifierHolderMock{
+			RemoveAllSignaturesCalled: func(header data.HeaderHandler) error {
+				removedExtraSigs = true
+				require.Nil(t, header.GetSignature())
+				return nil
+			},
+			VerifyAggregatedSignatureCalled: func(header data.HeaderHandler, multiSigVerifier crypto.MultiSigner, pubKeysSigners [][]byte) error {
+				require.True(t, removedExtraSigs)
+				require.Equal(t, [][]byte{consensusGroup[0].PubKey(), consensusGroup[1].PubKey(), consensusGroup[2].PubKey()}, pubKeysSigners)
+				return errExtraSig
+			},
+		}
+		hsv, _ := NewMainChainHeaderSigVerifier(args)
+		header := &dataBlock.SovereignChainHeader{Header: &dataBlock.Header{
+			PrevRandSeed:  []byte("prevRandSeed"),
+			PubKeysBitmap: []byte{0x07},
+			Signature:     []byte("aggregatedSignature"),
+		}}
+		require.Equal(t, errExtraSig, hsv.VerifySignature(header))
+	})
+}
+
+func TestMainChainHeaderSigVerifier_VerifyRandSeedAndLeaderSignature(t *testing.T) {
+	t.Parallel()
+
+	leaderPubKey := &mock.SingleSignPublicKey{}
+	consensusGroup := createMainChainConsensusGroup(4)
+	args := createMainChainHeaderSigVerifierArgs()
+	args.NodesCoordinator = &shardingMocks.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]nodesCoordinator.Validator, error) {
+			return consensusGroup, nil
+		},
+	}
+	args.KeyGen = &mock.SingleSignKeyGenMock{
+		PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+			require.Equal(t, consensusGroup[0].PubKey(), b)
+			return leaderPubKey, nil
+		},
+	}
+
+	header := &dataBlock.HeaderV2{Header: &dataBlock.Header{
+		PrevRandSeed:    []byte("prevRandSeed"),
+		RandSeed:        []byte("randSeed"),
+		LeaderSignature: []byte("leaderSignature"),
+	}}
+	errInvalidLeaderSig := errors.New("invalid leader signature")
+	verifiedRandSeed, verifiedLeaderSig := false, false
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			require.Equal(t, leaderPubKey, public)
+			if bytes.Equal(sig, header.GetRandSeed()) {
+				verifiedRandSeed = true
+				require.Equal(t, header.GetPrevRandSeed(), msg)
+				return nil
+			}
+
+			verifiedLeaderSig = true
+			require.Equal(t, header.GetLeaderSignature(), sig)
+			return errInvalidLeaderSig
+		},
+	}
+
+	hsv, _ := NewMainChainHeaderSigVerifier(args)
+	err := hsv.VerifyRandSeedAndLeaderSignature(header)
+	require.Equal(t, errInvalidLeaderSig, err)
+	require.True(t, verifiedRandSeed)
+	require.True(t, verifiedLeaderSig)
+}
@@ -8,6 +8,7 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 )
 
 type sovereignHeaderSigVerifier struct {
@@ -25,97 +26,87 @@ func NewSovereignHeaderSigVerifier(singleSigVerifier crypto.SingleSigner) (*sove
 	}, nil
 }
 
-// VerifyAggregatedSignature verifies aggregated sig for outgoing operations
+// VerifyAggregatedSignature verifies the aggregated sig of each outgoing operations batch
 func (hsv *sovereignHeaderSigVerifier) VerifyAggregatedSignature(
 	header data.HeaderHandler,
 	multiSigVerifier crypto.MultiSigner,
 	pubKeysSigners [][]byte,
 ) error {
-	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
-	if !castOk {
-		return fmt.Errorf("%w in sovereignHeaderSigVerifier.VerifyAggregatedSignature", errors.ErrWrongTypeAssertion)
+	batches, err := getOutGoingOperationsBatches(header)
+	if err != nil || len(batches) == 0 {
+		return err
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
-		return nil
+	for idx, batch := range batches {
+		err = multiSigVerifier.VerifyAggregatedSig(pubKeysSigners, batch.Hash, batch.AggregatedSignature)
+		if err != nil {
+			return fmt.Errorf("%w for outgoing operations batch %d", err, idx)
+		}
 	}
 
-	return multiSigVerifier.VerifyAggregatedSig(
-		pubKeysSigners,
-		outGoingMb.GetOutGoingOperationsHash(),
-		outGoingMb.GetAggregatedSignatureOutGoingOperations(),
-	)
+	return nil
 }
 
-// VerifyLeaderSignature verifies leader sig for outgoing operations
+// VerifyLeaderSignature verifies the leader sig of each outgoing operations batch
 func (hsv *sovereignHeaderSigVerifier) VerifyLeaderSignature(
 	header data.HeaderHandler,
 	leaderPubKey crypto.PublicKey,
 ) error {
-	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
-	if !castOk {
-		return fmt.Errorf("%w in sovereignHeaderSigVerifier.VerifyLeaderSignature", errors.ErrWrongTypeAssertion)
+	batches, err := getOutGoingOperationsBatches(header)
+	if err != nil || len(batches) == 0 {
+		return err
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
-		return nil
+	for idx, batch := range batches {
+		leaderMsgToSign := append(append([]byte{}, batch.Hash...), batch.AggregatedSignature...)
+		err = hsv.singleSigVerifier.Verify(leaderPubKey, leaderMsgToSign, batch.LeaderSignature)
+		if err != nil {
+			return fmt.Errorf("%w for outgoing operations batch %d", err, idx)
+		}
 	}
 
-	leaderMsgToSign := append(
-		outGoingMb.GetOutGoingOperationsHash(),
-		outGoingMb.GetAggregatedSignatureOutGoingOperations()...)
-
-	return hsv.singleSigVerifier.Verify(
-		leaderPubKey,
-		leaderMsgToSign,
-		outGoingMb.GetLeaderSignatureOutGoingOperations())
+	return nil
 }
 
 // RemoveLeaderSignature removes leader sig from outgoing operations
 func (hsv *sovereignHeaderSigVerifier) RemoveLeaderSignature(header data.HeaderHandler) error {
-	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
-	if !castOk {
-		return fmt.Errorf("%w in sovereignHeaderSigVerifier.RemoveLeaderSignature", errors.ErrWrongTypeAssertion)
-	}
-
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
-		return nil
-	}
-
-	err := outGoingMb.SetLeaderSignatureOutGoingOperations(nil)
-	if err != nil {
+	hasOutGoingMb, err := hasOutGoingMiniBlockHeader(header)
+	if err != nil || !hasOutGoingMb {
 		return err
 	}
 
-	return sovHeader.SetOutGoingMiniBlockHeaderHandler(outGoingMb)
+	return outgoingBatches.RemoveLeaderSignatures(header)
 }
 
 // RemoveAllSignatures removes aggregated + leader sig from outgoing operations
 func (hsv *sovereignHeaderSigVerifier) RemoveAllSignatures(header data.HeaderHandler) error {
-	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
-	if !castOk {
-		return fmt.Errorf("%w in sovereignHeaderSigVerifier.RemoveAllSignatures", errors.ErrWrongTypeAssertion)
+	hasOutGoingMb, err := hasOutGoingMiniBlockHeader(header)
+	if err != nil || !hasOutGoingMb {
+		return err
 	}
 
-	outGoingMb := sovHeader.GetOutGoingMiniBlockHeaderHandler()
-	if check.IfNil(outGoingMb) {
-		return nil
-	}
+	return outgoingBatches.RemoveAllSignatures(header)
+}
 
-	err := outGoingMb.SetAggregatedSignatureOutGoingOperations(nil)
-	if err != nil {
-		return err
+// getOutGoingOperationsBatches returns the outgoing operations batches of the header, which are saved in the outgoing
+// mini block header for headers created before the outgoing operations batches activation, or nothing if the header
+// has no outgoing operations
+func getOutGoingOperationsBatches(header data.HeaderHandler) ([]*outgoingBatches.OutGoingOperationsBatch, error) {
+	hasOutGoingMb, err := hasOutGoingMiniBlockHeader(header)
+	if err != nil || !hasOutGoingMb {
+		return nil, err
 	}
 
-	err = outGoingMb.SetLeaderSignatureOutGoingOperations(nil)
-	if err != nil {
-		return err
+	return outgoingBatches.GetOutGoingOperationsBatches(header)
+}
+
+func hasOutGoingMiniBlockHeader(header data.HeaderHandler) (bool, error) {
+	sovHeader, castOk := header.(data.SovereignChainHeaderHandler)
+	if !castOk {
+		return false, fmt.Errorf("%w in sovereignHeaderSigVerifier", errors.ErrWrongTypeAssertion)
 	}
 
-	return sovHeader.SetOutGoingMiniBlockHeaderHandler(outGoingMb)
+	return !check.IfNil(sovHeader.GetOutGoingMiniBlockHeaderHandler()), nil
 }
 
 // Identifier returns the unique id of the header verifier
@@ -9,6 +9,7 @@ import (
 	mock2 "github.com/multiversx/mx-chain-go/consensus/mock"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/sovereign/outgoingBatches"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
 	"github.com/stretchr/testify/require"
@@ -80,6 +81,100 @@ func TestSovereignHeaderSigVerifier_VerifyAggregatedSignature(t *testing.T) {
 	})
 }
 
+func TestSovereignHeaderSigVerifier_VerifySignaturesMultipleBatches(t *testing.T) {
+	t.Parallel()
+
+	batchesHashes := [][]byte{[]byte("has1"), []byte("has2")}
+	aggregatedSigs := [][]byte{[]byte("agg1"), []byte("agg2")}
+	leaderSigs := [][]byte{[]byte("ldr1"), []byte("ldr2")}
+	createSovHdr := func() *block.SovereignChainHeader {
+		sovHdr := &block.SovereignChainHeader{
+			Header: &block.Header{
+				Nonce: 4,
+			},
+			OutGoingMiniBlockHeader: &block.OutGoingMiniBlockHeader{
+				OutGoingOperationsHash: []byte("hashOfBatches"),
+			},
+		}
+		_ = outgoingBatches.SetOutGoingOperationsBatches(sovHdr, []*outgoingBatches.OutGoingOperationsBatch{
+			{Hash: batchesHashes[0], AggregatedSignature: aggregatedSigs[0], LeaderSignature: leaderSigs[0]},
+			{Hash: batchesHashes[1], AggregatedSignature: aggregatedSigs[1], LeaderSignature: leaderSigs[1]},
+		})
+
+		return sovHdr
+	}
+
+	t.Run("verify aggregated signature", func(t *testing.T) {
+		verifyCalledCt := 0
+		multiSigner := &cryptoMocks.MultisignerMock{
+			VerifyAggregatedSigCalled: func(pubKeysSigners [][]byte, message []byte, aggSig []byte) error {
+				require.Equal(t, batchesHashes[verifyCalledCt], message)
+				require.Equal(t, aggregatedSigs[verifyCalledCt], aggSig)
+
+				verifyCalledCt++
+				return nil
+			},
+		}
+		sovVerifier, _ := NewSovereignHeaderSigVerifier(&mock.SignerMock{})
+
+		err := sovVerifier.VerifyAggregatedSignature(createSovHdr(), multiSigner, [][]byte{[]byte("pk1")})
+		require.Nil(t, err)
+		require.Equal(t, 2, verifyCalledCt)
+	})
+
+	t.Run("verify leader signature", func(t *testing.T) {
+		verifyCalledCt := 0
+		signingHandler := &mock.SignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				require.Equal(t, append(batchesHashes[verifyCalledCt], aggregatedSigs[verifyCalledCt]...), msg)
+				require.Equal(t, leaderSigs[verifyCalledCt], sig)
+
+				verifyCalledCt++
+				return nil
+			},
+		}
+		sovVerifier, _ := NewSovereignHeaderSigVerifier(signingHandler)
+
+		err := sovVerifier.VerifyLeaderSignature(createSovHdr(), &mock2.PublicKeyMock{})
+		require.Nil(t, err)
+		require.Equal(t, 2, verifyCalledCt)
+	})
+
+	t.Run("invalid batches, should return error", func(t *testing.T) {
+		sovVerifier, _ := NewSovereignHeaderSigVerifier(&mock.SignerMock{})
+		sovHdr := createSovHdr()
+		sovHdr.Header.Reserved = []byte("has1has2")
+
+		err := sovVerifier.VerifyAggregatedSignature(sovHdr, &cryptoMocks.MultisignerMock{}, [][]byte{[]byte("pk1")})
+		require.ErrorIs(t, err, outgoingBatches.ErrInvalidOutGoingOperationsBatches)
+
+		err = sovVerifier.VerifyLeaderSignature(sovHdr, &mock2.PublicKeyMock{})
+		require.ErrorIs(t, err, outgoingBatches.ErrInvalidOutGoingOperationsBatches)
+	})
+
+	t.Run("remove signatures", func(t *testing.T) {
+		sovVerifier, _ := NewSovereignHeaderSigVerifier(&mock.SignerMock{})
+		sovHdr := createSovHdr()
+
+		err := sovVerifier.RemoveLeaderSignature(sovHdr)
+		require.Nil(t, err)
+		batches, _ := outgoingBatches.GetOutGoingOperationsBatches(sovHdr)
+		require.Equal(t, []*outgoingBatches.OutGoingOperationsBatch{
+			{Hash: batchesHashes[0], AggregatedSignature: aggregatedSigs[0]},
+			{Hash: batchesHashes[1], AggregatedSignature: aggregatedSigs[1]},
+		}, batches)
+
+		err = sovVerifier.RemoveAllSignatures(sovHdr)
+		require.Nil(t, err)
+		batches, _ = outgoingBatches.GetOutGoingOperationsBatches(sovHdr)
+		require.Equal(t, []*outgoingBatches.OutGoingOperationsBatch{
+			{Hash: batchesHashes[0]},
+			{Hash: batchesHashes[1]},
+		}, batches)
+		require.Equal(t, &block.OutGoingMiniBlockHeader{OutGoingOperationsHash: []byte("hashOfBatches")}, sovHdr.OutGoingMiniBlockHeader)
+	})
+}
+
 func TestSovereignHeaderSigVerifier_VerifyLeaderSignature(t *testing.T) {
 	t.Parallel()
 
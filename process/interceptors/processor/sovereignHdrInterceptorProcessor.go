@@ -121,10 +121,11 @@ func (hip *sovereignHeaderInterceptorProcessor) Save(data process.InterceptedDat
 
 	extendedHeaderHash := interceptedHdr.Hash()
 	extendedHeaderNonce := interceptedHdr.GetExtendedHeader().GetNonce()
-	_, hashes, err := hip.headersPool.GetHeadersByNonceAndShardId(extendedHeaderNonce, core.MainChainShardId)
 
-	// do not add header again + create scrs and mbs if already received
-	if err == nil && contains(hashes, extendedHeaderHash) {
+	// do not add header again + create scrs and mbs if already received. Lookup is done by hash, since extended
+	// headers from different incoming chains are stored in the pool under different shard ids
+	_, err := hip.headersPool.GetHeaderByHash(extendedHeaderHash)
+	if err == nil {
 		log.Debug("sovereignHeaderInterceptorProcessor.Save skipping already received extended header",
 			"nonce", extendedHeaderNonce, "hash", hex.EncodeToString(extendedHeaderHash))
 		return nil
@@ -133,16 +134,6 @@ func (hip *sovereignHeaderInterceptorProcessor) Save(data process.InterceptedDat
 	return hip.incomingHeaderSubscriber.AddHeader(extendedHeaderHash, interceptedHdr.GetExtendedHeader())
 }
 
-func contains(hashes [][]byte, hash []byte) bool {
-	for _, currHash := range hashes {
-		if bytes.Equal(currHash, hash) {
-			return true
-		}
-	}
-
-	return false
-}
-
 // RegisterHandler does nothing
 func (hip *sovereignHeaderInterceptorProcessor) RegisterHandler(_ func(topic string, hash []byte, data interface{})) {
 }
@@ -1395,10 +1395,39 @@ type SentSignaturesTracker interface {
 // IncomingHeaderSubscriber defines a subscriber to incoming headers
 type IncomingHeaderSubscriber interface {
 	AddHeader(headerHash []byte, header sovereign.IncomingHeaderHandler) error
+	RevertHeader(headerHash []byte) error
+	FinalizeHeader(headerHash []byte) error
 	CreateExtendedHeader(header sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error)
 	IsInterfaceNil() bool
 }
 
+// IncomingChainsHandler defines the chains from which a sovereign chain notarizes incoming headers. Headers of each
+// incoming chain are tracked and cross notarized independently, under the chain's own shard ID
+type IncomingChainsHandler interface {
+	GetShardIDs() []uint32
+	GetShardIDForHeader(header data.HeaderHandler) (uint32, error)
+	GetShardIDForChainID(chainID string) (uint32, bool)
+	GetNotarizationStartRound(shardID uint32) uint64
+	GetConfirmationDepth(shardID uint32) uint64
+	GetNonceHashDataUnit(shardID uint32) dataRetriever.UnitType
+	IsIncomingChainShard(shardID uint32) bool
+	IsInterfaceNil() bool
+}
+
+// MainChainValidatorsHandler defines the handler which keeps the main chain validators in sync with the eligible
+// validators and ratings published by the main chain nodes at each epoch start
+type MainChainValidatorsHandler interface {
+	SetEpochEligiblePublicKeys(epoch uint32, eligiblePubKeys map[uint32][][]byte) error
+	SetValidatorsRatings(ratings map[string]uint32)
+	IsInterfaceNil() bool
+}
+
+// EventsProofVerifier defines the verifier which proves the incoming events of an incoming header against the header
+type EventsProofVerifier interface {
+	VerifyEventsProofs(header sovereign.IncomingHeaderHandler) error
+	IsInterfaceNil() bool
+}
+
 // ExtraHeaderSigVerifierHandler defines the required properties of an extra header sig verifier for additional data
 type ExtraHeaderSigVerifierHandler interface {
 	VerifyAggregatedSignature(header data.HeaderHandler, multiSigVerifier crypto.MultiSigner, pubKeysSigners [][]byte) error
@@ -15,6 +15,7 @@ type BlockNotarizerHandlerMock struct {
 	GetNotarizedHeaderCalled                 func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error)
 	InitNotarizedHeadersCalled               func(startHeaders map[uint32]data.HeaderHandler) error
 	RemoveLastNotarizedHeaderCalled          func()
+	RemoveLastNotarizedHeaderForShardCalled  func(shardID uint32)
 	RestoreNotarizedHeadersToGenesisCalled   func()
 }
 
@@ -91,6 +92,13 @@ func (bngm *BlockNotarizerHandlerMock) RemoveLastNotarizedHeader() {
 	}
 }
 
+// RemoveLastNotarizedHeaderForShard -
+func (bngm *BlockNotarizerHandlerMock) RemoveLastNotarizedHeaderForShard(shardID uint32) {
+	if bngm.RemoveLastNotarizedHeaderForShardCalled != nil {
+		bngm.RemoveLastNotarizedHeaderForShardCalled(shardID)
+	}
+}
+
 // RestoreNotarizedHeadersToGenesis -
 func (bngm *BlockNotarizerHandlerMock) RestoreNotarizedHeadersToGenesis() {
 	if bngm.RestoreNotarizedHeadersToGenesisCalled != nil {
@@ -27,6 +27,7 @@ type RunTypeComponentsStub struct {
 	OutGoingOperationsPool                      sovereignBlock.OutGoingOperationsPool
 	DataCodec                                   sovereign.DataCodecHandler
 	TopicsChecker                               sovereign.TopicsCheckerHandler
+	IncomingChains                              process.IncomingChainsHandler
 	RequestersContainerFactoryCreatorField      requesterscontainer.RequesterContainerFactoryCreator
 	ValidatorAccountsSyncerFactoryHandlerField  syncerFactory.ValidatorAccountsSyncerFactoryHandler
 	ShardRequestersContainerCreatorHandlerField storageRequestFactory.ShardRequestersContainerCreatorHandler
@@ -43,6 +44,7 @@ func NewRunTypeComponentsStub() *RunTypeComponentsStub {
 		OutGoingOperationsPool:                      &sovereignMocks.OutGoingOperationsPoolMock{},
 		DataCodec:                                   &sovereignMocks.DataCodecMock{},
 		TopicsChecker:                               &sovereignMocks.TopicsCheckerMock{},
+		IncomingChains:                              &sovereignMocks.IncomingChainsHandlerMock{},
 		RequestersContainerFactoryCreatorField:      requesterscontainer.NewShardRequestersContainerFactoryCreator(),
 		ValidatorAccountsSyncerFactoryHandlerField:  syncerFactory.NewValidatorAccountsSyncerFactory(),
 		ShardRequestersContainerCreatorHandlerField: storageRequestFactory.NewShardRequestersContainerCreator(),
@@ -53,6 +55,9 @@ func NewRunTypeComponentsStub() *RunTypeComponentsStub {
 func NewSovereignRunTypeComponentsStub() *RunTypeComponentsStub {
 	rt := NewRunTypeComponentsStub()
 	requestHandlerFactory, _ := requestHandlers.NewSovereignResolverRequestHandlerFactory(rt.RequestHandlerFactory)
+	incomingChainsHandler := &sovereignMocks.IncomingChainsHandlerMock{}
+	requestersContainerFactoryCreator, _ := requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator(incomingChainsHandler)
+	shardRequestersContainerCreator, _ := storageRequestFactory.NewSovereignShardRequestersContainerCreator(incomingChainsHandler)
 
 	return &RunTypeComponentsStub{
 		AdditionalStorageServiceFactory:             factory.NewSovereignAdditionalStorageServiceFactory(),
@@ -63,9 +68,10 @@ func NewSovereignRunTypeComponentsStub() *RunTypeComponentsStub {
 		OutGoingOperationsPool:                      &sovereignMocks.OutGoingOperationsPoolMock{},
 		DataCodec:                                   &sovereignMocks.DataCodecMock{},
 		TopicsChecker:                               &sovereignMocks.TopicsCheckerMock{},
-		RequestersContainerFactoryCreatorField:      requesterscontainer.NewSovereignShardRequestersContainerFactoryCreator(),
+		IncomingChains:                              incomingChainsHandler,
+		RequestersContainerFactoryCreatorField:      requestersContainerFactoryCreator,
 		ValidatorAccountsSyncerFactoryHandlerField:  syncerFactory.NewSovereignValidatorAccountsSyncerFactory(),
-		ShardRequestersContainerCreatorHandlerField: storageRequestFactory.NewSovereignShardRequestersContainerCreator(),
+		ShardRequestersContainerCreatorHandlerField: shardRequestersContainerCreator,
 	}
 }
 
@@ -109,6 +115,11 @@ func (r *RunTypeComponentsStub) TopicsCheckerHandler() sovereign.TopicsCheckerHa
 	return r.TopicsChecker
 }
 
+// IncomingChainsHandler -
+func (r *RunTypeComponentsStub) IncomingChainsHandler() process.IncomingChainsHandler {
+	return r.IncomingChains
+}
+
 // RequestersContainerFactoryCreator -
 func (r *RunTypeComponentsStub) RequestersContainerFactoryCreator() requesterscontainer.RequesterContainerFactoryCreator {
 	return r.RequestersContainerFactoryCreatorField
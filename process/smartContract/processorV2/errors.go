@@ -4,4 +4,8 @@ import "errors"
 
 var errInvalidBuiltInFunctionCall = errors.New("invalid built in function call")
 
+var errInvalidSCCall = errors.New("invalid smart contract call")
+
 var errInvalidSenderAddress = errors.New("invalid sender address")
+
+var errBridgeOperationAlreadyRefunded = errors.New("outgoing bridge operation was already refunded")
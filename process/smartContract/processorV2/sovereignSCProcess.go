@@ -9,7 +9,9 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
 )
 
 // SovereignSCProcessArgs - arguments for creating a new sovereign smart contract processor
@@ -18,6 +20,8 @@ type SovereignSCProcessArgs struct {
 	TxTypeHandler            process.TxTypeHandler
 	SCProcessorHelperHandler process.SCProcessorHelperHandler
 	SmartContractProcessor   process.SmartContractProcessorFacade
+	Accounts                 state.AccountsAdapter
+	BridgeSCCallFunctions    []string
 }
 
 type sovereignSCProcessor struct {
@@ -26,6 +30,9 @@ type sovereignSCProcessor struct {
 	argsParser        process.ArgumentsParser
 	txTypeHandler     process.TxTypeHandler
 	scProcessorHelper process.SCProcessorHelperHandler
+	accounts          state.AccountsAdapter
+
+	bridgeSCCallFunctions map[string]struct{}
 }
 
 // NewSovereignSCRProcessor creates a sovereign scr processor
@@ -42,6 +49,9 @@ func NewSovereignSCRProcessor(args SovereignSCProcessArgs) (*sovereignSCProcesso
 	if check.IfNil(args.SCProcessorHelperHandler) {
 		return nil, process.ErrNilSCProcessorHelper
 	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
 
 	if check.IfNil(args.ArgsParser) {
 		return nil, process.ErrNilArgumentParser
@@ -51,15 +61,25 @@ func NewSovereignSCRProcessor(args SovereignSCProcessArgs) (*sovereignSCProcesso
 		return nil, process.ErrNilTxTypeHandler
 	}
 
+	bridgeSCCallFunctions := make(map[string]struct{}, len(args.BridgeSCCallFunctions))
+	for _, function := range args.BridgeSCCallFunctions {
+		bridgeSCCallFunctions[function] = struct{}{}
+	}
+
 	return &sovereignSCProcessor{
 		SmartContractProcessorFacade: args.SmartContractProcessor,
 		argsParser:                   args.ArgsParser,
 		txTypeHandler:                args.TxTypeHandler,
 		scProcessorHelper:            args.SCProcessorHelperHandler,
+		accounts:                     args.Accounts,
+		bridgeSCCallFunctions:        bridgeSCCallFunctions,
 	}, nil
 }
 
-// ProcessSmartContractResult updates the account state from the smart contract result
+// ProcessSmartContractResult updates the account state from the smart contract result. Incoming scrs can only transfer
+// tokens, through the multi esdt nft transfer built in function, or call one of the smart contract functions called by
+// the bridge, e.g. to unlock the sovereign native tokens of a failed outgoing bridge operation. The refunds of the failed outgoing bridge operations are saved in
+// the system account, so that an operation is not refunded twice, even if its failure is confirmed more than once.
 func (sc *sovereignSCProcessor) ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
 	if check.IfNil(scr) {
 		return 0, process.ErrNilSmartContractResult
@@ -78,6 +98,20 @@ func (sc *sovereignSCProcessor) ProcessSmartContractResult(scr *smartContractRes
 		return returnCode, err
 	}
 
+	refundKey, isRefund := createBridgeRefundKey(scr)
+	if isRefund {
+		isRefunded, errRefund := sc.isBridgeOperationRefunded(refundKey)
+		if errRefund != nil {
+			return returnCode, errRefund
+		}
+		if isRefunded {
+			log.Debug("sovereignSCProcessor.ProcessSmartContractResult: outgoing bridge operation was already refunded",
+				"receiver", scr.GetRcvAddr(),
+				"operation hash", scr.GetPrevTxHash())
+			return returnCode, sc.ProcessIfError(scrData.GetSender(), scrData.GetHash(), scr, errBridgeOperationAlreadyRefunded.Error(), scr.ReturnMessage, scrData.GetSnapshot(), 0)
+		}
+	}
+
 	txType, _, _ := sc.txTypeHandler.ComputeTransactionType(scr)
 	switch txType {
 	case process.BuiltInFunctionCall:
@@ -86,7 +120,16 @@ func (sc *sovereignSCProcessor) ProcessSmartContractResult(scr *smartContractRes
 			return returnCode, err
 		}
 
-		return sc.ExecuteBuiltInFunction(scr, nil, scrData.GetDestination())
+		returnCode, err = sc.ExecuteBuiltInFunction(scr, nil, scrData.GetDestination())
+		return returnCode, sc.saveBridgeRefundIfExecuted(refundKey, isRefund, returnCode, err)
+	case process.SCInvoking:
+		err = sc.checkBridgeSCCall(string(scr.Data))
+		if err != nil {
+			return returnCode, err
+		}
+
+		returnCode, err = sc.ExecuteSmartContractTransaction(scr, scrData.GetSender(), scrData.GetDestination())
+		return returnCode, sc.saveBridgeRefundIfExecuted(refundKey, isRefund, returnCode, err)
 	default:
 		err = process.ErrWrongTransaction
 	}
@@ -94,6 +137,70 @@ func (sc *sovereignSCProcessor) ProcessSmartContractResult(scr *smartContractRes
 	return returnCode, sc.ProcessIfError(scrData.GetSender(), scrData.GetHash(), scr, err.Error(), scr.ReturnMessage, scrData.GetSnapshot(), 0)
 }
 
+// createBridgeRefundKey returns the system account key of the refund made by the provided scr. An outgoing bridge
+// operation can be refunded by two scrs, one minting back the tokens to its sender and one unlocking its sovereign
+// native tokens from the sovereign safe contract, hence the refunds of an operation are saved by their receivers.
+func createBridgeRefundKey(scr *smartContractResult.SmartContractResult) ([]byte, bool) {
+	operationHash, isRefund := common.GetRefundedBridgeOperationHash(scr)
+	if !isRefund {
+		return nil, false
+	}
+
+	refundKey := append([]byte(common.BridgeRefundedOperationsKeyPrefix+"_"), operationHash...)
+	return append(refundKey, scr.GetRcvAddr()...), true
+}
+
+func (sc *sovereignSCProcessor) isBridgeOperationRefunded(refundKey []byte) (bool, error) {
+	account, err := sc.accounts.GetExistingAccount(core.SystemAccountAddress)
+	if err == state.ErrAccNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return false, process.ErrWrongTypeAssertion
+	}
+
+	refund, _, err := userAccount.RetrieveValue(refundKey)
+	if err == state.ErrNilTrie {
+		// the system account has no data stored yet
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return len(refund) != 0, nil
+}
+
+// saveBridgeRefundIfExecuted saves the refund of an outgoing bridge operation once its scr was successfully executed, so
+// that a refund which failed does not prevent a later refund of the same operation
+func (sc *sovereignSCProcessor) saveBridgeRefundIfExecuted(refundKey []byte, isRefund bool, returnCode vmcommon.ReturnCode, err error) error {
+	if err != nil || !isRefund || returnCode != vmcommon.Ok {
+		return err
+	}
+
+	account, err := sc.accounts.LoadAccount(core.SystemAccountAddress)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = userAccount.SaveKeyValue(refundKey, []byte{1})
+	if err != nil {
+		return err
+	}
+
+	return sc.accounts.SaveAccount(userAccount)
+}
+
 func (sc *sovereignSCProcessor) checkBuiltInFuncCall(scrData string) error {
 	function, _, err := sc.argsParser.ParseCallData(scrData)
 	if err != nil {
@@ -106,3 +213,18 @@ func (sc *sovereignSCProcessor) checkBuiltInFuncCall(scrData string) error {
 
 	return nil
 }
+
+// checkBridgeSCCall checks that an incoming scr only calls one of the configured smart contract functions called by the
+// bridge, i.e. the unlock of the sovereign native tokens and the executed bridge operation callbacks
+func (sc *sovereignSCProcessor) checkBridgeSCCall(scrData string) error {
+	function, _, err := sc.argsParser.ParseCallData(scrData)
+	if err != nil {
+		return err
+	}
+
+	if _, isBridgeFunction := sc.bridgeSCCallFunctions[function]; !isBridgeFunction {
+		return fmt.Errorf("%w, function %s is not called by the bridge", errInvalidSCCall, function)
+	}
+
+	return nil
+}
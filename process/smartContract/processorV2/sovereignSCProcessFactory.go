@@ -7,16 +7,19 @@ import (
 )
 
 type sovereignSCProcessFactory struct {
-	scrProcessorCreator scrCommon.SCProcessorCreator
+	scrProcessorCreator   scrCommon.SCProcessorCreator
+	bridgeSCCallFunctions []string
 }
 
-// NewSovereignSCProcessFactory creates a new smart contract process factory
-func NewSovereignSCProcessFactory(creator scrCommon.SCProcessorCreator) (*sovereignSCProcessFactory, error) {
+// NewSovereignSCProcessFactory creates a new smart contract process factory. The created processors only execute the
+// incoming smart contract calls to the provided functions called by the bridge.
+func NewSovereignSCProcessFactory(creator scrCommon.SCProcessorCreator, bridgeSCCallFunctions []string) (*sovereignSCProcessFactory, error) {
 	if check.IfNil(creator) {
 		return nil, process.ErrNilSCProcessorCreator
 	}
 	return &sovereignSCProcessFactory{
-		scrProcessorCreator: creator,
+		scrProcessorCreator:   creator,
+		bridgeSCCallFunctions: bridgeSCCallFunctions,
 	}, nil
 }
 
@@ -49,6 +52,8 @@ func (scpf *sovereignSCProcessFactory) CreateSCProcessor(args scrCommon.ArgsNewS
 		TxTypeHandler:            args.TxTypeHandler,
 		SmartContractProcessor:   scProc,
 		SCProcessorHelperHandler: scpHelper,
+		Accounts:                 args.AccountsDB,
+		BridgeSCCallFunctions:    scpf.bridgeSCCallFunctions,
 	})
 }
 
@@ -11,12 +11,12 @@ import (
 func TestNewSovereignSCProcessFactory(t *testing.T) {
 	t.Parallel()
 
-	fact, err := processorV2.NewSovereignSCProcessFactory(nil)
+	fact, err := processorV2.NewSovereignSCProcessFactory(nil, nil)
 	require.NotNil(t, err)
 	require.Nil(t, fact)
 
 	f, _ := processorV2.NewSCProcessFactory()
-	fact, err = processorV2.NewSovereignSCProcessFactory(f)
+	fact, err = processorV2.NewSovereignSCProcessFactory(f, []string{"unlockNativeTokens"})
 	require.Nil(t, err)
 	require.NotNil(t, fact)
 	require.Implements(t, new(scrCommon.SCProcessorCreator), fact)
@@ -27,7 +27,7 @@ func TestSovereignSCProcessFactory_CreateSCProcessor(t *testing.T) {
 
 	t.Run("Nil EpochNotifier should not fail because it is not used", func(t *testing.T) {
 		f, _ := processorV2.NewSCProcessFactory()
-		fact, _ := processorV2.NewSovereignSCProcessFactory(f)
+		fact, _ := processorV2.NewSovereignSCProcessFactory(f, []string{"unlockNativeTokens"})
 
 		args := processorV2.CreateMockSmartContractProcessorArguments()
 		args.EpochNotifier = nil
@@ -38,7 +38,7 @@ func TestSovereignSCProcessFactory_CreateSCProcessor(t *testing.T) {
 
 	t.Run("CreateSCProcessor should work", func(t *testing.T) {
 		f, _ := processorV2.NewSCProcessFactory()
-		fact, _ := processorV2.NewSovereignSCProcessFactory(f)
+		fact, _ := processorV2.NewSovereignSCProcessFactory(f, []string{"unlockNativeTokens"})
 
 		args := processorV2.CreateMockSmartContractProcessorArguments()
 		scProcessor, err := fact.CreateSCProcessor(args)
@@ -52,6 +52,6 @@ func TestSovereignSCProcessFactory_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
 	f, _ := processorV2.NewSCProcessFactory()
-	fact, _ := processorV2.NewSovereignSCProcessFactory(f)
+	fact, _ := processorV2.NewSovereignSCProcessFactory(f, []string{"unlockNativeTokens"})
 	require.False(t, fact.IsInterfaceNil())
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
@@ -290,6 +291,14 @@ func TestNewSovereignSCRProcessor(t *testing.T) {
 		require.Nil(t, sovProc)
 		require.Equal(t, process.ErrNilSCProcessorHelper, err)
 	})
+	t.Run("nil Accounts should err", func(t *testing.T) {
+		args := createSSCProcessArgs()
+		args.Accounts = nil
+
+		sovProc, err := NewSovereignSCRProcessor(args)
+		require.Nil(t, sovProc)
+		require.Equal(t, process.ErrNilAccountsAdapter, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createSSCProcessArgs()
 
@@ -316,6 +325,7 @@ func TestSovereignSCProcessor_ProcessSmartContractResultIncomingSCR(t *testing.T
 		TxTypeHandler:            arguments.TxTypeHandler,
 		SmartContractProcessor:   sc,
 		SCProcessorHelperHandler: scpHelper,
+		Accounts:                 arguments.AccountsDB,
 	})
 
 	scAddress := generateRandomBytes(32)
@@ -352,6 +362,118 @@ func TestSovereignSCProcessor_ProcessSmartContractResultIncomingSCR(t *testing.T
 	requireTokenExists(t, acc, token2, esdtTransferNonce.Uint64(), esdtTransferVal)
 }
 
+func TestSovereignSCProcessor_ProcessSmartContractResultBridgeRefund(t *testing.T) {
+	t.Parallel()
+
+	args := createSSCProcessArgs()
+	sovProc, _ := NewSovereignSCRProcessor(args)
+
+	receiver := generateRandomBytes(32)
+	token := []byte("token")
+	refundedValue := big.NewInt(50)
+	createRefundSCR := func(operationHash []byte) *smartContractResult.SmartContractResult {
+		return &smartContractResult.SmartContractResult{
+			SndAddr: core.ESDTSCAddress,
+			RcvAddr: receiver,
+			Data: []byte("MultiESDTNFTTransfer@01@" +
+				hex.EncodeToString(token) + "@" +
+				hex.EncodeToString(big.NewInt(0).Bytes()) + "@" +
+				hex.EncodeToString(refundedValue.Bytes())),
+			Value:          big.NewInt(0),
+			OriginalTxHash: []byte("mainChainTxHash"),
+			PrevTxHash:     operationHash,
+		}
+	}
+
+	returnCode, err := sovProc.ProcessSmartContractResult(createRefundSCR([]byte("opHash1")))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, returnCode)
+
+	acc, err := args.Accounts.LoadAccount(receiver)
+	require.Nil(t, err)
+	requireTokenExists(t, acc, token, 0, refundedValue)
+
+	// the same operation is not refunded twice
+	returnCode, err = sovProc.ProcessSmartContractResult(createRefundSCR([]byte("opHash1")))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.UserError, returnCode)
+
+	acc, err = args.Accounts.LoadAccount(receiver)
+	require.Nil(t, err)
+	requireTokenExists(t, acc, token, 0, refundedValue)
+
+	returnCode, err = sovProc.ProcessSmartContractResult(createRefundSCR([]byte("opHash2")))
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, returnCode)
+
+	acc, err = args.Accounts.LoadAccount(receiver)
+	require.Nil(t, err)
+	requireTokenExists(t, acc, token, 0, big.NewInt(0).Mul(refundedValue, big.NewInt(2)))
+}
+
+func TestSovereignSCProcessor_ProcessSmartContractResultSCCall(t *testing.T) {
+	t.Parallel()
+
+	unlockFunction := "unlockNativeTokens"
+	createSCCallArgs := func(executedFunctions *[]string) SovereignSCProcessArgs {
+		args := createSSCProcessArgs()
+		args.BridgeSCCallFunctions = []string{unlockFunction, "executedBridgeOp"}
+		args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType, bool) {
+				return process.SCInvoking, process.SCInvoking, false
+			},
+		}
+		args.SmartContractProcessor = &testscommon.SCProcessorMock{
+			ExecuteSmartContractTransactionCalled: func(tx data.TransactionHandler, acntSrc, acntDst state.UserAccountHandler) (vmcommon.ReturnCode, error) {
+				*executedFunctions = append(*executedFunctions, string(tx.GetData()))
+				return vmcommon.Ok, nil
+			},
+		}
+
+		return args
+	}
+	createSCCallSCR := func(function string) *smartContractResult.SmartContractResult {
+		return &smartContractResult.SmartContractResult{
+			SndAddr:        core.ESDTSCAddress,
+			RcvAddr:        generateRandomBytes(32),
+			Data:           []byte(function + "@" + hex.EncodeToString([]byte("arg"))),
+			Value:          big.NewInt(0),
+			OriginalTxHash: []byte("mainChainTxHash"),
+			PrevTxHash:     []byte("opHash"),
+		}
+	}
+
+	t.Run("function called by the bridge should be executed once", func(t *testing.T) {
+		t.Parallel()
+
+		executedFunctions := make([]string, 0)
+		sovProc, _ := NewSovereignSCRProcessor(createSCCallArgs(&executedFunctions))
+
+		scr := createSCCallSCR(unlockFunction)
+		returnCode, err := sovProc.ProcessSmartContractResult(scr)
+		require.Nil(t, err)
+		require.Equal(t, vmcommon.Ok, returnCode)
+		require.Equal(t, []string{string(scr.Data)}, executedFunctions)
+
+		// the same operation is not refunded twice to the same receiver
+		returnCode, err = sovProc.ProcessSmartContractResult(scr)
+		require.Nil(t, err)
+		require.Equal(t, vmcommon.UserError, returnCode)
+		require.Equal(t, []string{string(scr.Data)}, executedFunctions)
+	})
+	t.Run("function not called by the bridge should error", func(t *testing.T) {
+		t.Parallel()
+
+		executedFunctions := make([]string, 0)
+		sovProc, _ := NewSovereignSCRProcessor(createSCCallArgs(&executedFunctions))
+
+		returnCode, err := sovProc.ProcessSmartContractResult(createSCCallSCR("transferOwnership"))
+		require.ErrorIs(t, err, errInvalidSCCall)
+		require.Equal(t, vmcommon.UserError, returnCode)
+		require.Empty(t, executedFunctions)
+	})
+}
+
 func createSSCProcessArgs() SovereignSCProcessArgs {
 	arguments := createSovereignSmartContractProcessorArguments()
 	sc, _ := NewSmartContractProcessorV2(arguments)
@@ -367,5 +489,6 @@ func createSSCProcessArgs() SovereignSCProcessArgs {
 		TxTypeHandler:            arguments.TxTypeHandler,
 		SmartContractProcessor:   sc,
 		SCProcessorHelperHandler: scpHelper,
+		Accounts:                 arguments.AccountsDB,
 	}
 }
@@ -190,6 +190,11 @@ func (boot *ShardBootstrap) RequestMiniBlocksFromHeaderWithNonceIfMissing(header
 	boot.requestMiniBlocksFromHeaderWithNonceIfMissing(headerHandler)
 }
 
+// RequestMiniBlocksAndExtendedShardHeadersIfMissing -
+func (scsb *SovereignChainShardBootstrap) RequestMiniBlocksAndExtendedShardHeadersIfMissing(headerHandler data.HeaderHandler) {
+	scsb.requestMiniBlocksAndExtendedShardHeadersIfMissing(headerHandler)
+}
+
 // IsHeaderReceivedTooLate -
 func (bfd *baseForkDetector) IsHeaderReceivedTooLate(header data.HeaderHandler, state process.BlockHeaderState, finality int64) bool {
 	return bfd.isHeaderReceivedTooLate(header, state, finality)
@@ -21,6 +21,11 @@ type blockBootstrapper interface {
 	requestHeaderByNonce(nonce uint64)
 }
 
+// extendedShardHeaderRequestHandler defines the requests needed by the sovereign bootstrapper for extended shard headers
+type extendedShardHeaderRequestHandler interface {
+	RequestExtendedShardHeader(hash []byte)
+}
+
 // syncStarter defines the behavior of component that can start sync-ing blocks
 type syncStarter interface {
 	SyncBlock(ctx context.Context) error
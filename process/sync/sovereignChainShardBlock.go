@@ -1,10 +1,17 @@
 package sync
 
-import "github.com/multiversx/mx-chain-go/process"
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-go/process"
+)
 
 // SovereignChainShardBootstrap implements the bootstrap mechanism
 type SovereignChainShardBootstrap struct {
 	*ShardBootstrap
+	extendedShardHeaderRequester extendedShardHeaderRequestHandler
 }
 
 // NewSovereignChainShardBootstrap creates a new Bootstrap object
@@ -13,8 +20,14 @@ func NewSovereignChainShardBootstrap(shardBootstrap *ShardBootstrap) (*Sovereign
 		return nil, process.ErrNilShardBootstrap
 	}
 
+	extendedShardHeaderRequester, ok := shardBootstrap.requestHandler.(extendedShardHeaderRequestHandler)
+	if !ok {
+		return nil, fmt.Errorf("%w in NewSovereignChainShardBootstrap for extendedShardHeaderRequester", process.ErrWrongTypeAssertion)
+	}
+
 	scsb := &SovereignChainShardBootstrap{
-		shardBootstrap,
+		ShardBootstrap:               shardBootstrap,
+		extendedShardHeaderRequester: extendedShardHeaderRequester,
 	}
 
 	scsb.processAndCommitFunc = scsb.sovereignChainProcessAndCommit
@@ -22,6 +35,41 @@ func NewSovereignChainShardBootstrap(shardBootstrap *ShardBootstrap) (*Sovereign
 	scsb.getRootHashFromBlockFunc = scsb.sovereignChainGetRootHashFromBlock
 	scsb.doProcessReceivedHeaderJobFunc = scsb.sovereignChainDoProcessReceivedHeaderJob
 	scsb.syncShardAccountsDBsFunc = scsb.syncAccountsDBs
+	scsb.requestMiniBlocks = scsb.requestMiniBlocksAndExtendedShardHeadersIfMissing
 
 	return scsb, nil
 }
+
+func (scsb *SovereignChainShardBootstrap) requestMiniBlocksAndExtendedShardHeadersIfMissing(headerHandler data.HeaderHandler) {
+	scsb.requestMiniBlocksFromHeaderWithNonceIfMissing(headerHandler)
+	scsb.requestExtendedShardHeadersFromHeaderWithNonceIfMissing(headerHandler)
+}
+
+// requestExtendedShardHeadersFromHeaderWithNonceIfMissing requests in advance the extended shard headers referenced by
+// a received sovereign header, so that they are available in pool when the header will be processed
+func (scsb *SovereignChainShardBootstrap) requestExtendedShardHeadersFromHeaderWithNonceIfMissing(headerHandler data.HeaderHandler) {
+	nextBlockNonce := scsb.getNonceForNextBlock()
+	maxNonce := core.MinUint64(nextBlockNonce+process.MaxHeadersToRequestInAdvance-1, scsb.forkDetector.ProbableHighestNonce())
+	if headerHandler.GetNonce() < nextBlockNonce || headerHandler.GetNonce() > maxNonce {
+		return
+	}
+
+	sovereignChainHeader, ok := headerHandler.(data.SovereignChainHeaderHandler)
+	if !ok {
+		log.Warn("cannot convert headerHandler in data.SovereignChainHeaderHandler")
+		return
+	}
+
+	for _, extendedShardHeaderHash := range sovereignChainHeader.GetExtendedShardHeaderHashes() {
+		_, err := process.GetExtendedShardHeaderFromPool(extendedShardHeaderHash, scsb.headers)
+		if err == nil {
+			continue
+		}
+
+		log.Trace("requesting in advance extended shard header",
+			"hash", extendedShardHeaderHash,
+			"header nonce", headerHandler.GetNonce(),
+		)
+		scsb.extendedShardHeaderRequester.RequestExtendedShardHeader(extendedShardHeaderHash)
+	}
+}
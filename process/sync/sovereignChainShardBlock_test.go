@@ -1,16 +1,22 @@
 package sync_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/sync"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	dataRetrieverMock "github.com/multiversx/mx-chain-go/testscommon/dataRetriever"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,10 +29,22 @@ func TestNewSovereignChainShardBootstrap_ShouldErrNilShardBootstrap(t *testing.T
 	assert.Equal(t, process.ErrNilShardBootstrap, err)
 }
 
+func TestNewSovereignChainShardBootstrap_InvalidRequestHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := CreateShardBootstrapMockArguments()
+	sb, _ := sync.NewShardBootstrap(args)
+
+	scsb, err := sync.NewSovereignChainShardBootstrap(sb)
+	assert.Nil(t, scsb)
+	assert.True(t, errors.Is(err, process.ErrWrongTypeAssertion))
+}
+
 func TestNewSovereignChainShardBootstrap_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	args := CreateShardBootstrapMockArguments()
+	args.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{}
 	sb, _ := sync.NewShardBootstrap(args)
 
 	scsb, err := sync.NewSovereignChainShardBootstrap(sb)
@@ -39,6 +57,7 @@ func TestNewSovereignChainShardBootstrap_SyncBlockGetValidatorNodeDBErrorShouldS
 
 	errGetNodeFromDB := core.NewGetNodeFromDBErrWithKey([]byte("key"), errors.New("get error"), dataRetriever.PeerAccountsUnit.String())
 	args := createArgsForSyncBlockGetNodeDBError(errGetNodeFromDB)
+	args.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{}
 
 	syncCalled := false
 	args.ValidatorDBSyncer = &mock.AccountsDBSyncerStub{
@@ -53,3 +72,79 @@ func TestNewSovereignChainShardBootstrap_SyncBlockGetValidatorNodeDBErrorShouldS
 	require.Equal(t, errGetNodeFromDB, err)
 	require.True(t, syncCalled)
 }
+
+func TestSovereignChainShardBootstrap_RequestMiniBlocksAndExtendedShardHeadersIfMissing(t *testing.T) {
+	t.Parallel()
+
+	existingExtendedHdrHash := []byte("existingExtendedHdrHash")
+	missingExtendedHdrHash := []byte("missingExtendedHdrHash")
+	hdr := &block.SovereignChainHeader{
+		Header: &block.Header{
+			Nonce: 2,
+		},
+		ExtendedShardHeaderHashes: [][]byte{existingExtendedHdrHash, missingExtendedHdrHash},
+	}
+
+	args := CreateShardBootstrapMockArguments()
+	pools := dataRetrieverMock.NewPoolsHolderStub()
+	pools.HeadersCalled = func() dataRetriever.HeadersPool {
+		return &mock.HeadersCacherStub{
+			RegisterHandlerCalled: func(func(header data.HeaderHandler, key []byte)) {},
+			GetHeaderByHashCalled: func(hash []byte) (data.HeaderHandler, error) {
+				if bytes.Equal(hash, existingExtendedHdrHash) {
+					return &block.ShardHeaderExtended{}, nil
+				}
+				return nil, errors.New("missing header")
+			},
+		}
+	}
+	pools.MiniBlocksCalled = func() storage.Cacher {
+		return testscommon.NewCacherStub()
+	}
+	args.PoolsHolder = pools
+	blkc := initBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return &block.SovereignChainHeader{Header: &block.Header{Nonce: 1}}
+	}
+	args.ChainHandler = blkc
+	args.ForkDetector = &mock.ForkDetectorMock{
+		ProbableHighestNonceCalled: func() uint64 {
+			return 5
+		},
+	}
+
+	requestedMiniBlocks := false
+	requestedExtendedHdrsHashes := make([][]byte, 0)
+	args.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{
+		RequestHandlerStub: testscommon.RequestHandlerStub{
+			RequestMiniBlocksHandlerCalled: func(destShardID uint32, miniblocksHashes [][]byte) {
+				requestedMiniBlocks = true
+			},
+		},
+		RequestExtendedShardHeaderCalled: func(hash []byte) {
+			requestedExtendedHdrsHashes = append(requestedExtendedHdrsHashes, hash)
+		},
+	}
+	args.MiniblocksProvider = &mock.MiniBlocksProviderStub{
+		GetMiniBlocksFromPoolCalled: func(hashes [][]byte) ([]*block.MiniblockAndHash, [][]byte) {
+			return make([]*block.MiniblockAndHash, 0), [][]byte{[]byte("mbHash")}
+		},
+	}
+
+	sb, _ := sync.NewShardBootstrap(args)
+	scsb, _ := sync.NewSovereignChainShardBootstrap(sb)
+
+	t.Run("header nonce out of requesting window should not request", func(t *testing.T) {
+		scsb.RequestMiniBlocksAndExtendedShardHeadersIfMissing(&block.SovereignChainHeader{
+			Header:                    &block.Header{Nonce: 10},
+			ExtendedShardHeaderHashes: [][]byte{missingExtendedHdrHash},
+		})
+		require.False(t, requestedMiniBlocks)
+		require.Empty(t, requestedExtendedHdrsHashes)
+	})
+	t.Run("should request only missing extended shard headers", func(t *testing.T) {
+		scsb.RequestMiniBlocksAndExtendedShardHeadersIfMissing(hdr)
+		require.True(t, requestedMiniBlocks)
+		require.Equal(t, [][]byte{missingExtendedHdrHash}, requestedExtendedHdrsHashes)
+	})
+}
@@ -49,6 +49,7 @@ type ArgsBaseStorageBootstrapper struct {
 // ArgsShardStorageBootstrapper is structure used to create a new storage bootstrapper for shard
 type ArgsShardStorageBootstrapper struct {
 	ArgsBaseStorageBootstrapper
+	IncomingChainsHandler process.IncomingChainsHandler
 }
 
 // ArgsMetaStorageBootstrapper is structure used to create a new storage bootstrapper for metachain
@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/epochNotifier"
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
@@ -45,7 +46,7 @@ func TestShardStorageBootstrapperFactory_IsInterfaceNil(t *testing.T) {
 func getDefaultArgShardBootstrapper() ArgsShardStorageBootstrapper {
 	bootStorer := genericMocks.NewStorerMock()
 	argBaseBoostrapper := ArgsShardStorageBootstrapper{
-		ArgsBaseStorageBootstrapper{
+		ArgsBaseStorageBootstrapper: ArgsBaseStorageBootstrapper{
 			BootStorer:     &mock.BoostrapStorerMock{},
 			ForkDetector:   &mock.ForkDetectorMock{},
 			BlockProcessor: &testscommon.BlockProcessorStub{},
@@ -69,6 +70,7 @@ func getDefaultArgShardBootstrapper() ArgsShardStorageBootstrapper {
 			ProcessedMiniBlocksTracker:   &testscommon.ProcessedMiniBlocksTrackerStub{},
 			AppStatusHandler:             statusHandler.NewAppStatusHandlerMock(),
 		},
+		IncomingChainsHandler: &sovereign.IncomingChainsHandlerMock{},
 	}
 
 	return argBaseBoostrapper
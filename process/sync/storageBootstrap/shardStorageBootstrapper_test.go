@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/shardingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	storageMock "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
@@ -50,7 +51,7 @@ func TestShardStorageBootstrapper_LoadFromStorageShouldWork(t *testing.T) {
 	_ = blockStorerMock.Put(hdrHash, hdrBytes)
 
 	args := ArgsShardStorageBootstrapper{
-		ArgsBaseStorageBootstrapper{
+		ArgsBaseStorageBootstrapper: ArgsBaseStorageBootstrapper{
 			BootStorer: &mock.BoostrapStorerMock{
 				GetHighestRoundCalled: func() int64 {
 					return int64(startRound)
@@ -218,6 +219,7 @@ func testCleanupNotarizedStorageForHigherNoncesIfExist(
 
 	args := ArgsShardStorageBootstrapper{
 		ArgsBaseStorageBootstrapper: baseArgs,
+		IncomingChainsHandler:       &sovereign.IncomingChainsHandlerMock{},
 	}
 	ssb, err := factory.CreateBootstrapperFromStorage(args)
 	require.Nil(t, err)
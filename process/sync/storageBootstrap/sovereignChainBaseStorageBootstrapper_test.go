@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,7 +17,7 @@ func TestBaseStorageBootstrapper_SovereignChainGetScheduledRootHash(t *testing.T
 	}
 
 	ssb, _ := NewShardStorageBootstrapper(args)
-	scssb, _ := NewSovereignChainShardStorageBootstrapper(ssb)
+	scssb, _ := NewSovereignChainShardStorageBootstrapper(ssb, &sovereign.IncomingChainsHandlerMock{})
 
 	expectedRootHash := []byte("rootHash")
 	hdr := &block.Header{
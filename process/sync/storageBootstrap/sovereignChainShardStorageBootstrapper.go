@@ -1,25 +1,34 @@
 package storageBootstrap
 
 import (
-	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
 )
 
 type sovereignChainShardStorageBootstrapper struct {
 	*shardStorageBootstrapper
+	incomingChainsHandler process.IncomingChainsHandler
 }
 
 // NewSovereignChainShardStorageBootstrapper creates a new instance of sovereignChainShardStorageBootstrapper
-func NewSovereignChainShardStorageBootstrapper(shardStorageBootstrapper *shardStorageBootstrapper) (*sovereignChainShardStorageBootstrapper, error) {
+func NewSovereignChainShardStorageBootstrapper(
+	shardStorageBootstrapper *shardStorageBootstrapper,
+	incomingChainsHandler process.IncomingChainsHandler,
+) (*sovereignChainShardStorageBootstrapper, error) {
 	if shardStorageBootstrapper == nil {
 		return nil, process.ErrNilShardStorageBootstrapper
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errorsMx.ErrNilIncomingChainsHandler
+	}
 
 	scssb := &sovereignChainShardStorageBootstrapper{
-		shardStorageBootstrapper,
+		shardStorageBootstrapper: shardStorageBootstrapper,
+		incomingChainsHandler:    incomingChainsHandler,
 	}
 
 	scssb.getScheduledRootHashMethod = scssb.sovereignChainGetScheduledRootHash
@@ -31,7 +40,7 @@ func NewSovereignChainShardStorageBootstrapper(shardStorageBootstrapper *shardSt
 
 func (ssb *sovereignChainShardStorageBootstrapper) applyCrossNotarizedHeaders(crossNotarizedHeaders []bootstrapStorage.BootstrapHeaderInfo) error {
 	for _, crossNotarizedHeader := range crossNotarizedHeaders {
-		if crossNotarizedHeader.ShardId != core.MainChainShardId {
+		if !ssb.incomingChainsHandler.IsIncomingChainShard(crossNotarizedHeader.ShardId) {
 			continue
 		}
 
@@ -41,12 +50,12 @@ func (ssb *sovereignChainShardStorageBootstrapper) applyCrossNotarizedHeaders(cr
 		}
 
 		log.Debug("added cross notarized header in block tracker",
-			"shard", core.MainChainShardId,
+			"shard", crossNotarizedHeader.ShardId,
 			"round", extendedHeader.GetRound(),
 			"nonce", extendedHeader.GetNonce(),
 			"hash", crossNotarizedHeader.Hash)
 
-		ssb.blockTracker.AddCrossNotarizedHeader(core.MainChainShardId, extendedHeader, crossNotarizedHeader.Hash)
+		ssb.blockTracker.AddCrossNotarizedHeader(crossNotarizedHeader.ShardId, extendedHeader, crossNotarizedHeader.Hash)
 		ssb.blockTracker.AddTrackedHeader(extendedHeader, crossNotarizedHeader.Hash)
 	}
 
@@ -86,23 +95,40 @@ func (ssb *sovereignChainShardStorageBootstrapper) cleanupNotarizedStorage(shard
 			"nonce", extendedHeader.GetNonce(),
 			"hash", extendedHeaderHash)
 
-		ssb.removeHdrFromHeaderNonceToHashUnit(extendedHeader, extendedHeaderHash, dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
+		shardID, err := ssb.incomingChainsHandler.GetShardIDForHeader(extendedHeader)
+		if err != nil {
+			log.Debug("unknown incoming chain of extended header", "hash", extendedHeaderHash, "error", err)
+			continue
+		}
+
+		nonceHashDataUnit := ssb.incomingChainsHandler.GetNonceHashDataUnit(shardID)
+		ssb.removeHdrFromHeaderNonceToHashUnit(extendedHeader, extendedHeaderHash, nonceHashDataUnit)
 		ssb.removeBlockFromBlockUnit(extendedHeader, extendedHeaderHash, dataRetriever.ExtendedShardHeadersUnit)
 	}
 }
 
 func (ssb *sovereignChainShardStorageBootstrapper) cleanupNotarizedStorageForHigherNoncesIfExist(
 	crossNotarizedHeaders []bootstrapStorage.BootstrapHeaderInfo,
+) {
+	for _, shardID := range ssb.incomingChainsHandler.GetShardIDs() {
+		ssb.cleanupNotarizedStorageForHigherNoncesIfExistForShard(crossNotarizedHeaders, shardID)
+	}
+}
+
+func (ssb *sovereignChainShardStorageBootstrapper) cleanupNotarizedStorageForHigherNoncesIfExistForShard(
+	crossNotarizedHeaders []bootstrapStorage.BootstrapHeaderInfo,
+	shardID uint32,
 ) {
 	var numConsecutiveNoncesNotFound int
 
-	lastCrossNotarizedNonce, err := getLastCrossNotarizedHeaderNonce(crossNotarizedHeaders, core.MainChainShardId)
+	lastCrossNotarizedNonce, err := getLastCrossNotarizedHeaderNonce(crossNotarizedHeaders, shardID)
 	if err != nil {
-		log.Warn("cleanupNotarizedStorageForHigherNoncesIfExist", "error", err.Error())
+		log.Warn("cleanupNotarizedStorageForHigherNoncesIfExist", "shard", shardID, "error", err.Error())
 		return
 	}
 
-	log.Debug("cleanup notarized storage has been started", "from nonce", lastCrossNotarizedNonce+1)
+	nonceHashDataUnit := ssb.incomingChainsHandler.GetNonceHashDataUnit(shardID)
+	log.Debug("cleanup notarized storage has been started", "shard", shardID, "from nonce", lastCrossNotarizedNonce+1)
 	nonce := lastCrossNotarizedNonce
 
 	for {
@@ -113,6 +139,7 @@ func (ssb *sovereignChainShardStorageBootstrapper) cleanupNotarizedStorageForHig
 			ssb.store,
 			ssb.uint64Converter,
 			ssb.marshalizer,
+			nonceHashDataUnit,
 		)
 		if err != nil {
 			log.Debug("sovereignChainShardStorageBootstrapper.cleanupNotarizedStorageForHigherNoncesIfExist:"+
@@ -137,7 +164,7 @@ func (ssb *sovereignChainShardStorageBootstrapper) cleanupNotarizedStorageForHig
 			"nonce", extendedBlock.GetNonce(),
 			"hash", extendedBlockHash)
 
-		ssb.removeHdrFromHeaderNonceToHashUnit(extendedBlock, extendedBlockHash, dataRetriever.ExtendedShardHeadersNonceHashDataUnit)
+		ssb.removeHdrFromHeaderNonceToHashUnit(extendedBlock, extendedBlockHash, nonceHashDataUnit)
 		ssb.removeBlockFromBlockUnit(extendedBlock, extendedBlockHash, dataRetriever.ExtendedShardHeadersUnit)
 	}
 }
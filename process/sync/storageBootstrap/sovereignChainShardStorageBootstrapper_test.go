@@ -7,11 +7,13 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,17 +30,27 @@ func TestNewSovereignChainShardStorageBootstrapper(t *testing.T) {
 	t.Run("should error when shard storage bootstrapper is nil", func(t *testing.T) {
 		t.Parallel()
 
-		scesb, err := NewSovereignChainShardStorageBootstrapper(nil)
+		scesb, err := NewSovereignChainShardStorageBootstrapper(nil, &sovereign.IncomingChainsHandlerMock{})
 
 		assert.Nil(t, scesb)
 		assert.Equal(t, process.ErrNilShardStorageBootstrapper, err)
 	})
 
+	t.Run("should error when incoming chains handler is nil", func(t *testing.T) {
+		t.Parallel()
+
+		ssb, _ := NewShardStorageBootstrapper(args)
+		scesb, err := NewSovereignChainShardStorageBootstrapper(ssb, nil)
+
+		assert.Nil(t, scesb)
+		assert.Equal(t, errorsMx.ErrNilIncomingChainsHandler, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
 		ssb, _ := NewShardStorageBootstrapper(args)
-		scssb, err := NewSovereignChainShardStorageBootstrapper(ssb)
+		scssb, err := NewSovereignChainShardStorageBootstrapper(ssb, &sovereign.IncomingChainsHandlerMock{})
 
 		assert.NotNil(t, scssb)
 		assert.Nil(t, err)
@@ -95,7 +107,7 @@ func TestSovereignShardBootstrapFactory_applyCrossNotarizedHeaders(t *testing.T)
 		ArgsBaseStorageBootstrapper: baseArgs,
 	}
 	ssb, _ := NewShardStorageBootstrapper(args)
-	scssb, _ := NewSovereignChainShardStorageBootstrapper(ssb)
+	scssb, _ := NewSovereignChainShardStorageBootstrapper(ssb, &sovereign.IncomingChainsHandlerMock{})
 
 	crossNotarizedHeaders := []bootstrapStorage.BootstrapHeaderInfo{
 		{
@@ -190,7 +202,7 @@ func TestSovereignShardBootstrapFactory_cleanupNotarizedStorage(t *testing.T) {
 		ArgsBaseStorageBootstrapper: baseArgs,
 	}
 	ssb, _ := NewShardStorageBootstrapper(args)
-	scssb, _ := NewSovereignChainShardStorageBootstrapper(ssb)
+	scssb, _ := NewSovereignChainShardStorageBootstrapper(ssb, &sovereign.IncomingChainsHandlerMock{})
 
 	scssb.cleanupNotarizedStorage([]byte("hash"))
 	require.True(t, wasExtendedHeaderRemoved)
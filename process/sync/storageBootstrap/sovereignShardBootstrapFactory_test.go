@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/multiversx/mx-chain-go/process/sync"
+	"github.com/multiversx/mx-chain-go/testscommon"
 )
 
 func TestNewSovereignShardBootstrapFactory(t *testing.T) {
@@ -23,7 +24,9 @@ func TestSovereignShardBootstrapFactory_CreateShardBootstrapFactory(t *testing.T
 	_, err := ssbf.CreateBootstrapper(sync.ArgShardBootstrapper{})
 	require.NotNil(t, err)
 
-	bootStrapper, err := ssbf.CreateBootstrapper(getDefaultArgs())
+	args := getDefaultArgs()
+	args.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{}
+	bootStrapper, err := ssbf.CreateBootstrapper(args)
 	require.Nil(t, err)
 	require.Equal(t, "*sync.SovereignChainShardBootstrap", fmt.Sprintf("%T", bootStrapper))
 }
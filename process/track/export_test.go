@@ -49,15 +49,18 @@ func (scsbt *sovereignChainShardBlockTrack) ReceivedExtendedShardHeader(
 }
 
 func (scsbt *sovereignChainShardBlockTrack) ShouldAddExtendedShardHeader(extendedShardHeaderHandler data.ShardHeaderExtendedHandler) bool {
-	return scsbt.shouldAddExtendedShardHeader(extendedShardHeaderHandler)
+	shardID, _ := scsbt.incomingChainsHandler.GetShardIDForHeader(extendedShardHeaderHandler)
+	return scsbt.shouldAddExtendedShardHeader(shardID, extendedShardHeaderHandler)
 }
 
 func (scsbt *sovereignChainShardBlockTrack) DoWhitelistWithExtendedShardHeaderIfNeeded(extendedShardHeaderHandler data.ShardHeaderExtendedHandler) {
-	scsbt.doWhitelistWithExtendedShardHeaderIfNeeded(extendedShardHeaderHandler)
+	shardID, _ := scsbt.incomingChainsHandler.GetShardIDForHeader(extendedShardHeaderHandler)
+	scsbt.doWhitelistWithExtendedShardHeaderIfNeeded(shardID, extendedShardHeaderHandler)
 }
 
 func (scsbt *sovereignChainShardBlockTrack) IsExtendedShardHeaderOutOfRange(extendedShardHeaderHandler data.ShardHeaderExtendedHandler) bool {
-	return scsbt.isExtendedShardHeaderOutOfRange(extendedShardHeaderHandler)
+	shardID, _ := scsbt.incomingChainsHandler.GetShardIDForHeader(extendedShardHeaderHandler)
+	return scsbt.isExtendedShardHeaderOutOfRange(shardID, extendedShardHeaderHandler)
 }
 
 func (scsbt *sovereignChainShardBlockTrack) GetFinalHeader(headerHandler data.HeaderHandler) (data.HeaderHandler, error) {
@@ -16,6 +16,7 @@ type blockNotarizerHandler interface {
 	GetNotarizedHeader(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error)
 	InitNotarizedHeaders(startHeaders map[uint32]data.HeaderHandler) error
 	RemoveLastNotarizedHeader()
+	RemoveLastNotarizedHeaderForShard(shardID uint32)
 	RestoreNotarizedHeadersToGenesis()
 	IsInterfaceNil() bool
 }
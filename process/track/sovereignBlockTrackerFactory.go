@@ -2,20 +2,31 @@ package track
 
 import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
 type sovereignBlockTrackerFactory struct {
-	blockTrackerCreator BlockTrackerCreator
+	blockTrackerCreator   BlockTrackerCreator
+	incomingChainsHandler process.IncomingChainsHandler
 }
 
 // NewSovereignBlockTrackerFactory creates a new shard block tracker factory for sovereign chain
-func NewSovereignBlockTrackerFactory(btc BlockTrackerCreator) (*sovereignBlockTrackerFactory, error) {
+func NewSovereignBlockTrackerFactory(
+	btc BlockTrackerCreator,
+	incomingChainsHandler process.IncomingChainsHandler,
+) (*sovereignBlockTrackerFactory, error) {
 	if check.IfNil(btc) {
 		return nil, process.ErrNilBlockTrackerCreator
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errorsMx.ErrNilIncomingChainsHandler
+	}
+
 	return &sovereignBlockTrackerFactory{
-		blockTrackerCreator: btc,
+		blockTrackerCreator:   btc,
+		incomingChainsHandler: incomingChainsHandler,
 	}, nil
 }
 
@@ -30,7 +41,7 @@ func (sbtcf *sovereignBlockTrackerFactory) CreateBlockTracker(argBaseTracker Arg
 		return nil, process.ErrWrongTypeAssertion
 	}
 
-	return NewSovereignChainShardBlockTrack(shardBlockTracker)
+	return NewSovereignChainShardBlockTrack(shardBlockTracker, sbtcf.incomingChainsHandler)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
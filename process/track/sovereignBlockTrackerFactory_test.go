@@ -8,17 +8,18 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/track"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 )
 
 func TestNewSovereignBlockTrackerFactory(t *testing.T) {
 	t.Parallel()
 
-	sbtcf, err := track.NewSovereignBlockTrackerFactory(nil)
+	sbtcf, err := track.NewSovereignBlockTrackerFactory(nil, &sovereign.IncomingChainsHandlerMock{})
 	require.NotNil(t, err)
 	require.Nil(t, sbtcf)
 
 	sf := track.NewShardBlockTrackerFactory()
-	sbtcf, err = track.NewSovereignBlockTrackerFactory(sf)
+	sbtcf, err = track.NewSovereignBlockTrackerFactory(sf, &sovereign.IncomingChainsHandlerMock{})
 	require.Nil(t, err)
 	require.NotNil(t, sbtcf)
 	require.Implements(t, new(track.BlockTrackerCreator), sbtcf)
@@ -28,7 +29,7 @@ func TestSovereignBlockTrackerFactory_CreateBlockTracker(t *testing.T) {
 	t.Parallel()
 
 	sf := track.NewShardBlockTrackerFactory()
-	sbtcf, _ := track.NewSovereignBlockTrackerFactory(sf)
+	sbtcf, _ := track.NewSovereignBlockTrackerFactory(sf, &sovereign.IncomingChainsHandlerMock{})
 
 	bt, err := sbtcf.CreateBlockTracker(track.ArgShardTracker{})
 	require.NotNil(t, err)
@@ -46,7 +47,7 @@ func TestSovereignBlockTrackerFactory_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
 	sf := track.NewShardBlockTrackerFactory()
-	sbtcf, _ := track.NewSovereignBlockTrackerFactory(sf)
+	sbtcf, _ := track.NewSovereignBlockTrackerFactory(sf, &sovereign.IncomingChainsHandlerMock{})
 
 	require.False(t, sbtcf.IsInterfaceNil())
 }
@@ -3,30 +3,39 @@ package track
 import (
 	"fmt"
 
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
 type extendedShardHeaderRequestHandler interface {
-	RequestExtendedShardHeaderByNonce(nonce uint64)
+	RequestIncomingChainHeaderByNonce(incomingChainShardID uint32, nonce uint64)
 }
 
 type sovereignChainBlockProcessor struct {
 	*blockProcessor
 	extendedShardHeaderRequester extendedShardHeaderRequestHandler
+	incomingChainsHandler        process.IncomingChainsHandler
 }
 
 // NewSovereignChainBlockProcessor creates an object for processing the received tracked blocks
-func NewSovereignChainBlockProcessor(blockProcessor *blockProcessor) (*sovereignChainBlockProcessor, error) {
+func NewSovereignChainBlockProcessor(
+	blockProcessor *blockProcessor,
+	incomingChainsHandler process.IncomingChainsHandler,
+) (*sovereignChainBlockProcessor, error) {
 	if blockProcessor == nil {
 		return nil, process.ErrNilBlockProcessor
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errorsMx.ErrNilIncomingChainsHandler
+	}
 
 	scbp := &sovereignChainBlockProcessor{
-		blockProcessor: blockProcessor,
+		blockProcessor:        blockProcessor,
+		incomingChainsHandler: incomingChainsHandler,
 	}
 
 	scbp.shouldProcessReceivedHeaderFunc = scbp.shouldProcessReceivedHeader
@@ -52,7 +61,14 @@ func (scbp *sovereignChainBlockProcessor) shouldProcessReceivedHeader(headerHand
 
 	_, isExtendedShardHeaderReceived := headerHandler.(*block.ShardHeaderExtended)
 	if isExtendedShardHeaderReceived {
-		lastNotarizedHeader, _, err = scbp.crossNotarizer.GetLastNotarizedHeader(core.MainChainShardId)
+		var shardID uint32
+		shardID, err = scbp.incomingChainsHandler.GetShardIDForHeader(headerHandler)
+		if err != nil {
+			log.Debug("shouldProcessReceivedHeader: incomingChainsHandler.GetShardIDForHeader", "error", err.Error())
+			return false
+		}
+
+		lastNotarizedHeader, _, err = scbp.crossNotarizer.GetLastNotarizedHeader(shardID)
 		if err != nil {
 			log.Warn("shouldProcessReceivedHeader: crossNotarizer.GetLastNotarizedHeader",
 				"shard", headerHandler.GetShardID(), "error", err.Error())
@@ -74,7 +90,13 @@ func (scbp *sovereignChainBlockProcessor) shouldProcessReceivedHeader(headerHand
 func (scbp *sovereignChainBlockProcessor) processReceivedHeader(headerHandler data.HeaderHandler) {
 	_, isExtendedShardHeaderReceived := headerHandler.(*block.ShardHeaderExtended)
 	if isExtendedShardHeaderReceived {
-		scbp.doJobOnReceivedCrossNotarizedHeaderFunc(core.MainChainShardId)
+		shardID, err := scbp.incomingChainsHandler.GetShardIDForHeader(headerHandler)
+		if err != nil {
+			log.Debug("processReceivedHeader: incomingChainsHandler.GetShardIDForHeader", "error", err.Error())
+			return
+		}
+
+		scbp.doJobOnReceivedCrossNotarizedHeaderFunc(shardID)
 		return
 	}
 
@@ -95,8 +117,8 @@ func (scbp *sovereignChainBlockProcessor) doJobOnReceivedCrossNotarizedHeader(sh
 func (scbp *sovereignChainBlockProcessor) requestHeaderWithShardAndNonce(shardID uint32, nonce uint64) {
 	if shardID == scbp.shardCoordinator.SelfId() {
 		scbp.requestHandler.RequestShardHeaderByNonce(shardID, nonce)
-	} else if shardID == core.MainChainShardId {
-		scbp.extendedShardHeaderRequester.RequestExtendedShardHeaderByNonce(nonce)
+	} else if scbp.incomingChainsHandler.IsIncomingChainShard(shardID) {
+		scbp.extendedShardHeaderRequester.RequestIncomingChainHeaderByNonce(shardID, nonce)
 	} else {
 		log.Warn("sovereignChainBlockProcessor.requestHeaderWithShardAndNonce requested header for unknown shard",
 			"shardID", shardID, "nonce", nonce)
@@ -113,7 +135,7 @@ func (scbp *sovereignChainBlockProcessor) requestHeadersIfNothingNewIsReceived(
 		return
 	}
 	// double-checking here for both shardID and header type just to be safe
-	if shardID == core.MainChainShardId {
+	if scbp.incomingChainsHandler.IsIncomingChainShard(shardID) {
 		return
 	}
 	if _, isExtendedHeader := latestValidHeader.(data.ShardHeaderExtendedHandler); isExtendedHeader {
@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -32,7 +33,7 @@ func CreateSovereignChainBlockProcessorMockArguments() track.ArgBlockProcessor {
 		Marshalizer: &mock.MarshalizerMock{},
 	}
 	headerValidator, _ := processBlock.NewHeaderValidator(argsHeaderValidator)
-	sovereignChainHeaderValidator, _ := processBlock.NewSovereignChainHeaderValidator(headerValidator)
+	sovereignChainHeaderValidator, _ := processBlock.NewSovereignChainHeaderValidator(headerValidator, &mock.HeaderSigVerifierStub{}, &sovereign.EventsProofVerifierMock{}, nil, true)
 	blockProcessorArguments.HeaderValidator = sovereignChainHeaderValidator
 	blockProcessorArguments.ShardCoordinator = sharding.NewSovereignShardCoordinator()
 
@@ -42,7 +43,7 @@ func CreateSovereignChainBlockProcessorMockArguments() track.ArgBlockProcessor {
 func TestNewSovereignChainBlockProcessor_ShouldErrNilBlockProcessor(t *testing.T) {
 	t.Parallel()
 
-	scpb, err := track.NewSovereignChainBlockProcessor(nil)
+	scpb, err := track.NewSovereignChainBlockProcessor(nil, &sovereign.IncomingChainsHandlerMock{})
 	assert.Nil(t, scpb)
 	assert.Equal(t, process.ErrNilBlockProcessor, err)
 }
@@ -53,7 +54,7 @@ func TestNewSovereignChainBlockProcessor_ShouldErrWrongTypeAssertion(t *testing.
 	blockProcessorArguments := CreateBlockProcessorMockArguments()
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
 
-	scpb, err := track.NewSovereignChainBlockProcessor(bp)
+	scpb, err := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 	assert.Nil(t, scpb)
 	assert.ErrorIs(t, err, process.ErrWrongTypeAssertion)
 }
@@ -64,7 +65,7 @@ func TestNewSovereignChainBlockProcessor_ShouldWork(t *testing.T) {
 	blockProcessorArguments := CreateSovereignChainBlockProcessorMockArguments()
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
 
-	scpb, err := track.NewSovereignChainBlockProcessor(bp)
+	scpb, err := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 	require.NotNil(t, scpb)
 	require.Zero(t, scpb.GetBlockFinality())
 	require.Nil(t, err)
@@ -88,7 +89,7 @@ func TestSovereignChainBlockProcessor_ShouldProcessReceivedHeaderShouldReturnFal
 	}
 
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
-	scbp, _ := track.NewSovereignChainBlockProcessor(bp)
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 
 	assert.False(t, scbp.ShouldProcessReceivedHeader(&block.Header{}))
 	assert.False(t, scbp.ShouldProcessReceivedHeader(&block.ShardHeaderExtended{}))
@@ -117,7 +118,7 @@ func TestSovereignChainBlockProcessor_ShouldProcessReceivedHeaderShouldWork(t *t
 	}
 
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
-	scbp, _ := track.NewSovereignChainBlockProcessor(bp)
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 
 	assert.False(t, scbp.ShouldProcessReceivedHeader(&block.Header{Nonce: 14}))
 	assert.False(t, scbp.ShouldProcessReceivedHeader(&block.Header{Nonce: 15}))
@@ -158,7 +159,7 @@ func TestSovereignChainBlockProcessor_ProcessReceivedHeaderShouldWorkWhenHeaderI
 	}
 
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
-	scbp, _ := track.NewSovereignChainBlockProcessor(bp)
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 
 	scbp.ProcessReceivedHeader(&block.Header{Nonce: 1})
 
@@ -189,7 +190,7 @@ func TestSovereignChainBlockProcessor_ProcessReceivedHeaderShouldWorkWhenHeaderI
 	}
 
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
-	scbp, _ := track.NewSovereignChainBlockProcessor(bp)
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 
 	shardHeaderExtended := &block.ShardHeaderExtended{
 		Header: &block.HeaderV2{
@@ -279,7 +280,7 @@ func TestSovereignChainBlockProcessor_DoJobOnReceivedCrossNotarizedHeaderShouldW
 	}
 
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
-	scbp, _ := track.NewSovereignChainBlockProcessor(bp)
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 
 	scbp.DoJobOnReceivedCrossNotarizedHeader(core.SovereignChainShardId)
 	require.False(t, wasCalled)
@@ -321,7 +322,7 @@ func TestSovereignChainBlockProcessor_RequestHeadersShouldAddAndRequestForShardH
 	}
 
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
-	scbp, _ := track.NewSovereignChainBlockProcessor(bp)
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 
 	shardID := blockProcessorArguments.ShardCoordinator.SelfId()
 	fromNonce := uint64(1)
@@ -367,16 +368,16 @@ func TestSovereignChainBlockProcessor_RequestHeadersShouldAddAndRequestForExtend
 	shardIDRequestCalled := make([]uint32, 0)
 	nonceRequestCalled := make([]uint64, 0)
 	blockProcessorArguments.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{
-		RequestExtendedShardHeaderByNonceCalled: func(nonce uint64) {
+		RequestIncomingChainHeaderByNonceCalled: func(incomingChainShardID uint32, nonce uint64) {
 			mutRequest.Lock()
-			shardIDRequestCalled = append(shardIDRequestCalled, core.MainChainShardId)
+			shardIDRequestCalled = append(shardIDRequestCalled, incomingChainShardID)
 			nonceRequestCalled = append(nonceRequestCalled, nonce)
 			mutRequest.Unlock()
 		},
 	}
 
 	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
-	scbp, _ := track.NewSovereignChainBlockProcessor(bp)
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{})
 
 	shardID := core.MainChainShardId
 	fromNonce := uint64(1)
@@ -401,3 +402,63 @@ func TestSovereignChainBlockProcessor_RequestHeadersShouldAddAndRequestForExtend
 	assert.Equal(t, []uint32{shardID}, shardIDRequestCalled)
 	assert.Equal(t, []uint64{fromNonce}, nonceRequestCalled)
 }
+
+func TestSovereignChainBlockProcessor_RequestHeadersShouldAddAndRequestForIncomingChainExtendedShardHeaders(t *testing.T) {
+	t.Parallel()
+
+	var mutRequest sync.Mutex
+
+	blockProcessorArguments := CreateSovereignChainBlockProcessorMockArguments()
+
+	shardIDAddCalled := make([]uint32, 0)
+	nonceAddCalled := make([]uint64, 0)
+
+	blockProcessorArguments.BlockTracker = &mock.BlockTrackerHandlerMock{
+		AddHeaderFromPoolCalled: func(shardID uint32, nonce uint64) {
+			shardIDAddCalled = append(shardIDAddCalled, shardID)
+			nonceAddCalled = append(nonceAddCalled, nonce)
+		},
+	}
+
+	shardIDRequestCalled := make([]uint32, 0)
+	nonceRequestCalled := make([]uint64, 0)
+	blockProcessorArguments.RequestHandler = &testscommon.ExtendedShardHeaderRequestHandlerStub{
+		RequestIncomingChainHeaderByNonceCalled: func(incomingChainShardID uint32, nonce uint64) {
+			mutRequest.Lock()
+			shardIDRequestCalled = append(shardIDRequestCalled, incomingChainShardID)
+			nonceRequestCalled = append(nonceRequestCalled, nonce)
+			mutRequest.Unlock()
+		},
+	}
+
+	bp, _ := track.NewBlockProcessor(blockProcessorArguments)
+	incomingChainShardID := core.MainChainShardId - 1
+	scbp, _ := track.NewSovereignChainBlockProcessor(bp, &sovereign.IncomingChainsHandlerMock{
+		IsIncomingChainShardCalled: func(shardID uint32) bool {
+			return shardID == core.MainChainShardId || shardID == incomingChainShardID
+		},
+	})
+
+	shardID := incomingChainShardID
+	fromNonce := uint64(1)
+
+	scbp.RequestHeaders(shardID, fromNonce)
+
+	time.Sleep(100 * time.Millisecond)
+
+	mutRequest.Lock()
+	sort.Slice(nonceRequestCalled, func(i, j int) bool {
+		return nonceRequestCalled[i] < nonceRequestCalled[j]
+	})
+	mutRequest.Unlock()
+
+	require.Equal(t, 1, len(shardIDAddCalled))
+	require.Equal(t, 1, len(nonceAddCalled))
+	require.Equal(t, 1, len(shardIDRequestCalled))
+	require.Equal(t, 1, len(nonceRequestCalled))
+
+	assert.Equal(t, []uint32{shardID}, shardIDAddCalled)
+	assert.Equal(t, []uint64{fromNonce}, nonceAddCalled)
+	assert.Equal(t, []uint32{shardID}, shardIDRequestCalled)
+	assert.Equal(t, []uint64{fromNonce}, nonceRequestCalled)
+}
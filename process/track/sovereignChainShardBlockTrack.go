@@ -9,21 +9,31 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 
+	errorsMx "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
 type sovereignChainShardBlockTrack struct {
 	*shardBlockTrack
+	incomingChainsHandler process.IncomingChainsHandler
 }
 
-// NewSovereignChainShardBlockTrack creates an object for tracking the received shard blocks
-func NewSovereignChainShardBlockTrack(shardBlockTrack *shardBlockTrack) (*sovereignChainShardBlockTrack, error) {
+// NewSovereignChainShardBlockTrack creates an object for tracking the received shard blocks. Extended shard headers of
+// each incoming chain are tracked and cross notarized independently, under the incoming chain's shard ID.
+func NewSovereignChainShardBlockTrack(
+	shardBlockTrack *shardBlockTrack,
+	incomingChainsHandler process.IncomingChainsHandler,
+) (*sovereignChainShardBlockTrack, error) {
 	if shardBlockTrack == nil {
 		return nil, process.ErrNilBlockTracker
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errorsMx.ErrNilIncomingChainsHandler
+	}
 
 	scsbt := &sovereignChainShardBlockTrack{
-		shardBlockTrack,
+		shardBlockTrack:       shardBlockTrack,
+		incomingChainsHandler: incomingChainsHandler,
 	}
 
 	bp, ok := scsbt.blockProcessor.(*blockProcessor)
@@ -31,7 +41,7 @@ func NewSovereignChainShardBlockTrack(shardBlockTrack *shardBlockTrack) (*sovere
 		return nil, process.ErrWrongTypeAssertion
 	}
 
-	scbp, err := NewSovereignChainBlockProcessor(bp)
+	scbp, err := NewSovereignChainBlockProcessor(bp, incomingChainsHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +73,10 @@ func (scsbt *sovereignChainShardBlockTrack) initCrossNotarizedStartHeaders() err
 		return fmt.Errorf("%w in sovereignChainShardBlockTrack.initCrossNotarizedStartHeaders", err)
 	}
 
-	scsbt.AddCrossNotarizedHeader(core.MainChainShardId, extendedShardHeader, extendedShardHeaderHash)
+	for _, shardID := range scsbt.incomingChainsHandler.GetShardIDs() {
+		scsbt.AddCrossNotarizedHeader(shardID, extendedShardHeader, extendedShardHeaderHash)
+	}
+
 	return nil
 }
 
@@ -98,9 +111,17 @@ func (scsbt *sovereignChainShardBlockTrack) receivedExtendedShardHeader(
 	extendedShardHeaderHandler data.ShardHeaderExtendedHandler,
 	extendedShardHeaderHash []byte,
 ) {
+	shardID, err := scsbt.incomingChainsHandler.GetShardIDForHeader(extendedShardHeaderHandler)
+	if err != nil {
+		log.Debug("sovereignChainShardBlockTrack.receivedExtendedShardHeader: rejected extended shard header",
+			"hash", extendedShardHeaderHash,
+			"error", err,
+		)
+		return
+	}
 
 	log.Debug("received extended shard header from network in block tracker",
-		"shard", extendedShardHeaderHandler.GetShardID(),
+		"shard", shardID,
 		"epoch", extendedShardHeaderHandler.GetEpoch(),
 		"round", extendedShardHeaderHandler.GetRound(),
 		"nonce", extendedShardHeaderHandler.GetNonce(),
@@ -109,27 +130,28 @@ func (scsbt *sovereignChainShardBlockTrack) receivedExtendedShardHeader(
 
 	// TODO: This condition will permit to the sovereign chain to follow the main chain headers starting with a header
 	// having a nonce higher than nonce 1 (the first block after genesis)
-	if scsbt.IsGenesisLastCrossNotarizedHeader() {
-		scsbt.crossNotarizer.AddNotarizedHeader(core.MainChainShardId, extendedShardHeaderHandler, extendedShardHeaderHash)
+	if scsbt.IsGenesisLastCrossNotarizedHeader(shardID) {
+		scsbt.crossNotarizer.AddNotarizedHeader(shardID, extendedShardHeaderHandler, extendedShardHeaderHash)
 	}
 
-	if !scsbt.shouldAddExtendedShardHeader(extendedShardHeaderHandler) {
+	if !scsbt.shouldAddExtendedShardHeader(shardID, extendedShardHeaderHandler) {
 		log.Trace("received extended shard header is out of range", "nonce", extendedShardHeaderHandler.GetNonce())
 		return
 	}
 
-	if !scsbt.addHeader(extendedShardHeaderHandler, extendedShardHeaderHash, core.MainChainShardId) {
+	if !scsbt.addHeader(extendedShardHeaderHandler, extendedShardHeaderHash, shardID) {
 		log.Trace("received extended shard header was not added", "nonce", extendedShardHeaderHandler.GetNonce())
 		return
 	}
 
-	scsbt.doWhitelistWithExtendedShardHeaderIfNeeded(extendedShardHeaderHandler)
+	scsbt.doWhitelistWithExtendedShardHeaderIfNeeded(shardID, extendedShardHeaderHandler)
 	scsbt.blockProcessor.ProcessReceivedHeader(extendedShardHeaderHandler)
 }
 
-// IsGenesisLastCrossNotarizedHeader returns true if the last cross chain notarized header is the dummy genesis header
-func (scsbt *sovereignChainShardBlockTrack) IsGenesisLastCrossNotarizedHeader() bool {
-	lastNotarizedHeader, _, err := scsbt.crossNotarizer.GetLastNotarizedHeader(core.MainChainShardId)
+// IsGenesisLastCrossNotarizedHeader returns true if the last cross chain notarized header of the incoming chain with the
+// provided shard ID is the dummy genesis header
+func (scsbt *sovereignChainShardBlockTrack) IsGenesisLastCrossNotarizedHeader(shardID uint32) bool {
+	lastNotarizedHeader, _, err := scsbt.crossNotarizer.GetLastNotarizedHeader(shardID)
 
 	isGenesisLastCrossNotarizedHeader := err != nil && errors.Is(err, process.ErrNotarizedHeadersSliceForShardIsNil) ||
 		lastNotarizedHeader != nil && lastNotarizedHeader.GetNonce() == 0
@@ -137,11 +159,11 @@ func (scsbt *sovereignChainShardBlockTrack) IsGenesisLastCrossNotarizedHeader()
 	return isGenesisLastCrossNotarizedHeader
 }
 
-func (scsbt *sovereignChainShardBlockTrack) shouldAddExtendedShardHeader(extendedShardHeaderHandler data.ShardHeaderExtendedHandler) bool {
-	lastNotarizedHeader, _, err := scsbt.crossNotarizer.GetLastNotarizedHeader(core.MainChainShardId)
+func (scsbt *sovereignChainShardBlockTrack) shouldAddExtendedShardHeader(shardID uint32, extendedShardHeaderHandler data.ShardHeaderExtendedHandler) bool {
+	lastNotarizedHeader, _, err := scsbt.crossNotarizer.GetLastNotarizedHeader(shardID)
 	if err != nil {
 		log.Debug("shouldAddExtendedShardHeader.GetLastNotarizedHeader",
-			"shard", extendedShardHeaderHandler.GetShardID(),
+			"shard", shardID,
 			"error", err.Error())
 		return false
 	}
@@ -152,11 +174,11 @@ func (scsbt *sovereignChainShardBlockTrack) shouldAddExtendedShardHeader(extende
 	return !isHeaderOutOfRange
 }
 
-func (scsbt *sovereignChainShardBlockTrack) doWhitelistWithExtendedShardHeaderIfNeeded(extendedShardHeaderHandler data.ShardHeaderExtendedHandler) {
+func (scsbt *sovereignChainShardBlockTrack) doWhitelistWithExtendedShardHeaderIfNeeded(shardID uint32, extendedShardHeaderHandler data.ShardHeaderExtendedHandler) {
 	if check.IfNil(extendedShardHeaderHandler) {
 		return
 	}
-	if scsbt.isExtendedShardHeaderOutOfRange(extendedShardHeaderHandler) {
+	if scsbt.isExtendedShardHeaderOutOfRange(shardID, extendedShardHeaderHandler) {
 		return
 	}
 
@@ -176,11 +198,11 @@ func (scsbt *sovereignChainShardBlockTrack) doWhitelistWithExtendedShardHeaderIf
 	scsbt.whitelistHandler.Add(keys)
 }
 
-func (scsbt *sovereignChainShardBlockTrack) isExtendedShardHeaderOutOfRange(extendedShardHeaderHandler data.ShardHeaderExtendedHandler) bool {
-	lastCrossNotarizedHeader, _, err := scsbt.GetLastCrossNotarizedHeader(core.MainChainShardId)
+func (scsbt *sovereignChainShardBlockTrack) isExtendedShardHeaderOutOfRange(shardID uint32, extendedShardHeaderHandler data.ShardHeaderExtendedHandler) bool {
+	lastCrossNotarizedHeader, _, err := scsbt.GetLastCrossNotarizedHeader(shardID)
 	if err != nil {
 		log.Debug("isExtendedShardHeaderOutOfRange.GetLastCrossNotarizedHeader",
-			"shard", extendedShardHeaderHandler.GetShardID(),
+			"shard", shardID,
 			"error", err.Error())
 		return true
 	}
@@ -189,16 +211,17 @@ func (scsbt *sovereignChainShardBlockTrack) isExtendedShardHeaderOutOfRange(exte
 	return isExtendedShardHeaderOutOfRange
 }
 
-// ComputeLongestExtendedShardChainFromLastNotarized returns the longest valid chain for extended shard chain from its last cross notarized header
-func (scsbt *sovereignChainShardBlockTrack) ComputeLongestExtendedShardChainFromLastNotarized() ([]data.HeaderHandler, [][]byte, error) {
-	lastCrossNotarizedHeader, _, err := scsbt.GetLastCrossNotarizedHeader(core.MainChainShardId)
+// ComputeLongestExtendedShardChainFromLastNotarized returns the longest valid chain for the extended shard chain of the
+// incoming chain with the provided shard ID, from its last cross notarized header
+func (scsbt *sovereignChainShardBlockTrack) ComputeLongestExtendedShardChainFromLastNotarized(shardID uint32) ([]data.HeaderHandler, [][]byte, error) {
+	lastCrossNotarizedHeader, _, err := scsbt.GetLastCrossNotarizedHeader(shardID)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	log.Debug("sovereignChainShardBlockTrack.ComputeLongestExtendedShardChainFromLastNotarized: GetLastCrossNotarizedHeader", "nonce", lastCrossNotarizedHeader.GetNonce())
+	log.Debug("sovereignChainShardBlockTrack.ComputeLongestExtendedShardChainFromLastNotarized: GetLastCrossNotarizedHeader", "shard", shardID, "nonce", lastCrossNotarizedHeader.GetNonce())
 
-	hdrsForShard, hdrsHashesForShard := scsbt.ComputeLongestChain(core.MainChainShardId, lastCrossNotarizedHeader)
+	hdrsForShard, hdrsHashesForShard := scsbt.ComputeLongestChain(shardID, lastCrossNotarizedHeader)
 
 	log.Debug("sovereignChainShardBlockTrack.ComputeLongestExtendedShardChainFromLastNotarized: ComputeLongestChain", "num headers", len(hdrsForShard))
 	for index := range hdrsForShard {
@@ -214,7 +237,12 @@ func (scsbt *sovereignChainShardBlockTrack) CleanupHeadersBehindNonce(
 	selfNotarizedNonce uint64,
 	crossNotarizedNonce uint64,
 ) {
-	scsbt.cleanupHeadersBehindNonce(shardID, core.MainChainShardId, selfNotarizedNonce, crossNotarizedNonce)
+	crossShardID := core.MainChainShardId
+	if scsbt.incomingChainsHandler.IsIncomingChainShard(shardID) {
+		crossShardID = shardID
+	}
+
+	scsbt.cleanupHeadersBehindNonce(shardID, crossShardID, selfNotarizedNonce, crossNotarizedNonce)
 }
 
 // DisplayTrackedHeaders displays tracked headers
@@ -222,8 +250,10 @@ func (scsbt *sovereignChainShardBlockTrack) DisplayTrackedHeaders() {
 	scsbt.displayTrackedHeadersForShard(scsbt.shardCoordinator.SelfId(), "tracked headers")
 	scsbt.selfNotarizer.DisplayNotarizedHeaders(scsbt.shardCoordinator.SelfId(), "self notarized headers")
 
-	scsbt.displayTrackedHeadersForShard(core.MainChainShardId, "cross tracked headers")
-	scsbt.crossNotarizer.DisplayNotarizedHeaders(core.MainChainShardId, "cross notarized headers")
+	for _, shardID := range scsbt.incomingChainsHandler.GetShardIDs() {
+		scsbt.displayTrackedHeadersForShard(shardID, "cross tracked headers")
+		scsbt.crossNotarizer.DisplayNotarizedHeaders(shardID, "cross notarized headers")
+	}
 }
 
 func (scsbt *sovereignChainShardBlockTrack) getFinalHeader(headerHandler data.HeaderHandler) (data.HeaderHandler, error) {
@@ -231,7 +261,11 @@ func (scsbt *sovereignChainShardBlockTrack) getFinalHeader(headerHandler data.He
 
 	_, isExtendedShardHeaderReceived := headerHandler.(*block.ShardHeaderExtended)
 	if isExtendedShardHeaderReceived {
-		shardID = core.MainChainShardId
+		var err error
+		shardID, err = scsbt.incomingChainsHandler.GetShardIDForHeader(headerHandler)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	finalHeader, _, err := scsbt.getFinalHeaderForShard(shardID)
@@ -256,9 +290,10 @@ func (scsbt *sovereignChainShardBlockTrack) IsShardStuck(_ uint32) bool {
 func (scsbt *sovereignChainShardBlockTrack) ComputeCrossInfo(_ []data.HeaderHandler) {
 }
 
-// RemoveLastCrossNotarizedHeaders removes last cross notarized headers from tracker list
-func (scsbt *sovereignChainShardBlockTrack) RemoveLastCrossNotarizedHeaders() {
-	scsbt.crossNotarizer.RemoveLastNotarizedHeader()
+// RemoveLastCrossNotarizedHeader removes the last cross notarized header of the incoming chain with the provided shard ID
+// from tracker list
+func (scsbt *sovereignChainShardBlockTrack) RemoveLastCrossNotarizedHeader(shardID uint32) {
+	scsbt.crossNotarizer.RemoveLastNotarizedHeaderForShard(shardID)
 }
 
 // RemoveLastSelfNotarizedHeaders removes last self notarized headers from tracker list
@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/track"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 )
 
 // CreateSovereignChainShardTrackerMockArguments -
@@ -32,7 +33,7 @@ func CreateSovereignChainShardTrackerMockArguments() track.ArgShardTracker {
 		Marshalizer: &mock.MarshalizerMock{},
 	}
 	headerValidator, _ := processBlock.NewHeaderValidator(argsHeaderValidator)
-	sovereignChainHeaderValidator, _ := processBlock.NewSovereignChainHeaderValidator(headerValidator)
+	sovereignChainHeaderValidator, _ := processBlock.NewSovereignChainHeaderValidator(headerValidator, &mock.HeaderSigVerifierStub{}, &sovereign.EventsProofVerifierMock{}, nil, true)
 	shardBlockTrackArguments.HeaderValidator = sovereignChainHeaderValidator
 
 	return shardBlockTrackArguments
@@ -41,7 +42,7 @@ func CreateSovereignChainShardTrackerMockArguments() track.ArgShardTracker {
 func TestNewSovereignChainShardBlockTrack_ShouldErrNilBlockTracker(t *testing.T) {
 	t.Parallel()
 
-	scsbt, err := track.NewSovereignChainShardBlockTrack(nil)
+	scsbt, err := track.NewSovereignChainShardBlockTrack(nil, &sovereign.IncomingChainsHandlerMock{})
 	assert.Nil(t, scsbt)
 	assert.Equal(t, process.ErrNilBlockTracker, err)
 }
@@ -54,7 +55,7 @@ func TestNewSovereignChainShardBlockTrack_ShouldErrWrongTypeAssertion(t *testing
 
 	sbt.SetBlockProcessor(nil)
 
-	scsbt, err := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, err := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 	assert.Nil(t, scsbt)
 	assert.Equal(t, process.ErrWrongTypeAssertion, err)
 }
@@ -67,7 +68,7 @@ func TestNewSovereignChainShardBlockTrack_ShouldNotErrInitCrossNotarizedStartHea
 
 	sbt.ClearStartHeaders()
 
-	scsbt, err := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, err := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 	require.NotNil(t, scsbt)
 	require.Nil(t, err)
 }
@@ -78,7 +79,7 @@ func TestNewSovereignChainShardBlockTrack_ShouldWork(t *testing.T) {
 	shardBlockTrackArguments := CreateSovereignChainShardTrackerMockArguments()
 	sbt, _ := track.NewShardBlockTrack(shardBlockTrackArguments)
 
-	scsbt, err := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, err := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 	assert.NotNil(t, scsbt)
 	assert.Nil(t, err)
 }
@@ -102,7 +103,7 @@ func TestSovereignChainShardBlockTrack_ComputeLongestSelfChainShouldWork(t *test
 		},
 	}
 	sbt.SetSelfNotarizer(selfNotarizer)
-	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 	header, hash, headers, hashes := scsbt.ComputeLongestSelfChain()
 
@@ -131,7 +132,7 @@ func TestSovereignChainShardBlockTrack_GetSelfNotarizedHeaderShouldWork(t *testi
 		},
 	}
 	sbt.SetSelfNotarizer(selfNotarizer)
-	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 	header, hash, err := scsbt.GetSelfNotarizedHeader(core.MetachainShardId, 0)
 
@@ -149,7 +150,7 @@ func TestSovereignChainShardBlockTrack_ReceivedHeaderShouldWork(t *testing.T) {
 		shardBlockTrackArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardBlockTrackArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		header := &block.Header{Nonce: 1}
 		headerV2 := &block.HeaderV2{Header: header}
@@ -170,7 +171,7 @@ func TestSovereignChainShardBlockTrack_ReceivedHeaderShouldWork(t *testing.T) {
 		shardBlockTrackArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardBlockTrackArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		header := &block.Header{Nonce: 1}
 		headerHash := []byte("hash")
@@ -191,7 +192,7 @@ func TestSovereignChainShardBlockTrack_ReceivedExtendedShardHeaderShouldWork(t *
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		shardHeaderExtendedInit := &block.ShardHeaderExtended{
 			Header: &block.HeaderV2{
@@ -222,7 +223,7 @@ func TestSovereignChainShardBlockTrack_ReceivedExtendedShardHeaderShouldWork(t *
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		shardHeaderExtendedInit := &block.ShardHeaderExtended{
 			Header: &block.HeaderV2{
@@ -250,6 +251,72 @@ func TestSovereignChainShardBlockTrack_ReceivedExtendedShardHeaderShouldWork(t *
 	})
 }
 
+func TestSovereignChainShardBlockTrack_ReceivedExtendedShardHeadersFromMultipleIncomingChains(t *testing.T) {
+	t.Parallel()
+
+	incomingChainShardID := core.MainChainShardId - 1
+	incomingChainsHandler := &sovereign.IncomingChainsHandlerMock{
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{core.MainChainShardId, incomingChainShardID}
+		},
+		GetShardIDForHeaderCalled: func(header data.HeaderHandler) (uint32, error) {
+			if string(header.GetChainID()) == "sov2" {
+				return incomingChainShardID, nil
+			}
+			return core.MainChainShardId, nil
+		},
+		IsIncomingChainShardCalled: func(shardID uint32) bool {
+			return shardID == core.MainChainShardId || shardID == incomingChainShardID
+		},
+	}
+
+	shardArguments := CreateSovereignChainShardTrackerMockArguments()
+	sbt, _ := track.NewShardBlockTrack(shardArguments)
+	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, incomingChainsHandler)
+
+	for _, shardID := range incomingChainsHandler.GetShardIDs() {
+		require.True(t, scsbt.IsGenesisLastCrossNotarizedHeader(shardID))
+	}
+
+	mainChainHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				ChainID: []byte("1"),
+				Nonce:   1,
+			},
+		},
+	}
+	incomingChainHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				ChainID: []byte("sov2"),
+				Nonce:   1,
+			},
+		},
+	}
+
+	scsbt.ReceivedExtendedShardHeader(mainChainHeader, []byte("main chain hash"))
+	scsbt.ReceivedExtendedShardHeader(incomingChainHeader, []byte("incoming chain hash"))
+
+	headers, hashes := scsbt.GetTrackedHeaders(core.MainChainShardId)
+	require.Equal(t, []data.HeaderHandler{mainChainHeader}, headers)
+	require.Equal(t, [][]byte{[]byte("main chain hash")}, hashes)
+
+	headers, hashes = scsbt.GetTrackedHeaders(incomingChainShardID)
+	require.Equal(t, []data.HeaderHandler{incomingChainHeader}, headers)
+	require.Equal(t, [][]byte{[]byte("incoming chain hash")}, hashes)
+
+	// each incoming chain header is cross notarized under its own chain shard ID
+	lastCrossNotarizedHeader, _, _ := scsbt.GetLastCrossNotarizedHeader(core.MainChainShardId)
+	require.Equal(t, mainChainHeader, lastCrossNotarizedHeader)
+	lastCrossNotarizedHeader, _, _ = scsbt.GetLastCrossNotarizedHeader(incomingChainShardID)
+	require.Equal(t, incomingChainHeader, lastCrossNotarizedHeader)
+
+	scsbt.RemoveLastCrossNotarizedHeader(incomingChainShardID)
+	require.True(t, scsbt.IsGenesisLastCrossNotarizedHeader(incomingChainShardID))
+	require.False(t, scsbt.IsGenesisLastCrossNotarizedHeader(core.MainChainShardId))
+}
+
 func TestSovereignChainShardBlockTrack_ShouldAddExtendedShardHeaderShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -259,7 +326,7 @@ func TestSovereignChainShardBlockTrack_ShouldAddExtendedShardHeaderShouldWork(t
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		maxNumHeadersToKeepPerShard := uint64(scsbt.GetMaxNumHeadersToKeepPerShard())
 
@@ -290,7 +357,7 @@ func TestSovereignChainShardBlockTrack_ShouldAddExtendedShardHeaderShouldWork(t
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		maxNumHeadersToKeepPerShard := uint64(scsbt.GetMaxNumHeadersToKeepPerShard())
 
@@ -336,7 +403,7 @@ func TestSovereignChainShardBlockTrack_DoWhitelistWithExtendedShardHeaderIfNeede
 		}
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		shardHeaderExtendedInit := &block.ShardHeaderExtended{
 			Header: &block.HeaderV2{
@@ -387,7 +454,7 @@ func TestSovereignChainShardBlockTrack_DoWhitelistWithExtendedShardHeaderIfNeede
 		}
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		shardHeaderExtendedInit := &block.ShardHeaderExtended{
 			Header: &block.HeaderV2{
@@ -431,7 +498,7 @@ func TestSovereignChainShardBlockTrack_IsExtendedShardHeaderOutOfRangeShouldWork
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		nonce := uint64(8)
 		shardHeaderExtendedInit := &block.ShardHeaderExtended{
@@ -462,7 +529,7 @@ func TestSovereignChainShardBlockTrack_IsExtendedShardHeaderOutOfRangeShouldWork
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		nonce := uint64(8)
 		shardHeaderExtendedInit := &block.ShardHeaderExtended{
@@ -497,7 +564,7 @@ func TestSovereignChainShardBlockTrack_ComputeLongestExtendedShardChainFromLastN
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		shardHeaderExtendedInit := &block.ShardHeaderExtended{
 			Header: &block.HeaderV2{
@@ -562,7 +629,7 @@ func TestSovereignChainShardBlockTrack_ComputeLongestExtendedShardChainFromLastN
 		scsbt.AddTrackedHeader(shardHeaderExtended2, shardHeaderExtendedHash2)
 		scsbt.AddTrackedHeader(shardHeaderExtended3, shardHeaderExtendedHash3)
 
-		headers, _, _ := scsbt.ComputeLongestExtendedShardChainFromLastNotarized()
+		headers, _, _ := scsbt.ComputeLongestExtendedShardChainFromLastNotarized(core.MainChainShardId)
 
 		require.Equal(t, 3, len(headers))
 		assert.Equal(t, shardHeaderExtended1, headers[0])
@@ -577,7 +644,7 @@ func TestSovereignChainShardBlockTrack_CleanupHeadersBehindNonceShouldWork(t *te
 	shardArguments := CreateSovereignChainShardTrackerMockArguments()
 	sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 	header := &block.Header{
 		ShardID: shardArguments.ShardCoordinator.SelfId(),
@@ -619,7 +686,7 @@ func TestSovereignChainShardBlockTrack_CleanupHeadersBehindNonceForMainChainHead
 	shardArguments := CreateSovereignChainShardTrackerMockArguments()
 	sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 	header := &block.Header{
 		ShardID: core.SovereignChainShardId,
@@ -668,7 +735,7 @@ func TestSovereignChainShardBlockTrack_DisplayTrackedHeadersShouldNotPanic(t *te
 	shardArguments := CreateSovereignChainShardTrackerMockArguments()
 	sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+	scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 	header := &block.Header{
 		ShardID: shardArguments.ShardCoordinator.SelfId(),
@@ -702,7 +769,7 @@ func TestSovereignChainShardBlockTrack_GetFinalHeaderShouldWork(t *testing.T) {
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		expectedSelfNotarizerErr := errors.New("expected self notarizer err")
 		scsbt.SetSelfNotarizer(
@@ -745,7 +812,7 @@ func TestSovereignChainShardBlockTrack_GetFinalHeaderShouldWork(t *testing.T) {
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		expectedShardHeader := &block.Header{Nonce: 69}
 		scsbt.SetSelfNotarizer(
@@ -798,7 +865,7 @@ func TestSovereignChainShardBlockTrack_InitCrossNotarizedStartHeadersShouldWork(
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		scsbt.ClearStartHeaders()
 
@@ -813,7 +880,7 @@ func TestSovereignChainShardBlockTrack_InitCrossNotarizedStartHeadersShouldWork(
 		shardArguments := CreateSovereignChainShardTrackerMockArguments()
 		sbt, _ := track.NewShardBlockTrack(shardArguments)
 
-		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt)
+		scsbt, _ := track.NewSovereignChainShardBlockTrack(sbt, &sovereign.IncomingChainsHandlerMock{})
 
 		_ = scsbt.InitNotarizedHeaders(make(map[uint32]data.HeaderHandler))
 
@@ -129,3 +129,15 @@ var ErrInvalidSovereignChainShardId = errors.New("shard id does not match core.S
 
 // ErrMetachainShardIdNotFound signals that the MetachainShardId was not found
 var ErrMetachainShardIdNotFound = errors.New("core.MetachainShardId was not found")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNotEpochStartBlock signals that the provided header is not an epoch start block
+var ErrNotEpochStartBlock = errors.New("not an epoch start block")
+
+// ErrNilNodesCoordinatorRegistry signals that a nil nodes coordinator registry has been provided
+var ErrNilNodesCoordinatorRegistry = errors.New("nil nodes coordinator registry")
+
+// ErrEmptySelectionChances signals that no selection chances have been provided
+var ErrEmptySelectionChances = errors.New("empty selection chances")
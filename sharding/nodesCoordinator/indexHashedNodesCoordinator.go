@@ -784,6 +784,18 @@ func (ihnc *indexHashedNodesCoordinator) GetChance(_ uint32) uint32 {
 
 func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromList(
 	validatorInfos []*state.ShardValidatorInfo,
+) (*epochNodesConfig, error) {
+	flags := stakingV4Flags{
+		started: ihnc.flagStakingV4Started.IsSet(),
+		step2:   ihnc.flagStakingV4Step2.IsSet(),
+	}
+
+	return ihnc.computeNodesConfigFromListWithFlags(validatorInfos, flags)
+}
+
+func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromListWithFlags(
+	validatorInfos []*state.ShardValidatorInfo,
+	flags stakingV4Flags,
 ) (*epochNodesConfig, error) {
 	eligibleMap := make(map[uint32][]Validator)
 	waitingMap := make(map[uint32][]Validator)
@@ -819,9 +831,10 @@ func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromList(
 				waitingMap,
 				currentValidator,
 				validatorInfo,
+				flags.started,
 			)
 		case string(common.NewList):
-			if ihnc.flagStakingV4Step2.IsSet() {
+			if flags.step2 {
 				return nil, epochStart.ErrReceivedNewListNodeInStakingV4
 			}
 			log.Debug("new node registered", "pk", validatorInfo.PublicKey)
@@ -832,7 +845,7 @@ func (ihnc *indexHashedNodesCoordinator) computeNodesConfigFromList(
 			log.Debug("jailed validator", "pk", validatorInfo.PublicKey)
 		case string(common.SelectedFromAuctionList):
 			log.Debug("selected node from auction", "pk", validatorInfo.PublicKey)
-			if ihnc.flagStakingV4Step2.IsSet() {
+			if flags.step2 {
 				auctionList = append(auctionList, currentValidator)
 			} else {
 				return nil, ErrReceivedAuctionValidatorsBeforeStakingV4
@@ -875,6 +888,7 @@ func (ihnc *indexHashedNodesCoordinator) addValidatorToPreviousMap(
 	waitingMap map[uint32][]Validator,
 	currentValidator *validator,
 	validatorInfo *state.ShardValidatorInfo,
+	isStakingV4Started bool,
 ) {
 	shardId := validatorInfo.ShardId
 	previousList := validatorInfo.PreviousList
@@ -887,7 +901,7 @@ func (ihnc *indexHashedNodesCoordinator) addValidatorToPreviousMap(
 		"pk", currentValidator.PubKey(),
 		"shardId", shardId)
 
-	if !ihnc.flagStakingV4Started.IsSet() || len(previousList) == 0 {
+	if !isStakingV4Started || len(previousList) == 0 {
 		log.Debug("leaving node before staking v4 or with not previous list set node found in",
 			"list", "eligible", "shardId", shardId, "previous list", previousList)
 		eligibleMap[shardId] = append(eligibleMap[shardId], currentValidator)
@@ -1353,13 +1367,28 @@ func (ihnc *indexHashedNodesCoordinator) getShardValidatorInfoData(txHash []byte
 }
 
 func (ihnc *indexHashedNodesCoordinator) updateEpochFlags(epoch uint32) {
-	ihnc.flagStakingV4Started.SetValue(epoch >= ihnc.enableEpochsHandler.GetActivationEpoch(common.StakingV4Step1Flag))
+	flags := ihnc.getStakingV4FlagsInEpoch(epoch)
+
+	ihnc.flagStakingV4Started.SetValue(flags.started)
 	log.Debug("indexHashedNodesCoordinator: flagStakingV4Started", "enabled", ihnc.flagStakingV4Started.IsSet())
 
-	ihnc.flagStakingV4Step2.SetValue(epoch >= ihnc.enableEpochsHandler.GetActivationEpoch(common.StakingV4Step2Flag))
+	ihnc.flagStakingV4Step2.SetValue(flags.step2)
 	log.Debug("indexHashedNodesCoordinator: flagStakingV4Step2", "enabled", ihnc.flagStakingV4Step2.IsSet())
 }
 
+// stakingV4Flags holds the staking v4 flags of an epoch, as used when computing the nodes config
+type stakingV4Flags struct {
+	started bool
+	step2   bool
+}
+
+func (ihnc *indexHashedNodesCoordinator) getStakingV4FlagsInEpoch(epoch uint32) stakingV4Flags {
+	return stakingV4Flags{
+		started: epoch >= ihnc.enableEpochsHandler.GetActivationEpoch(common.StakingV4Step1Flag),
+		step2:   epoch >= ihnc.enableEpochsHandler.GetActivationEpoch(common.StakingV4Step2Flag),
+	}
+}
+
 // GetWaitingEpochsLeftForPublicKey returns the number of epochs left for the public key until it becomes eligible
 func (ihnc *indexHashedNodesCoordinator) GetWaitingEpochsLeftForPublicKey(publicKey []byte) (uint32, error) {
 	if len(publicKey) == 0 {
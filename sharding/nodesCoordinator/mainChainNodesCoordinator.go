@@ -0,0 +1,328 @@
+package nodesCoordinator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+)
+
+// ArgsMainChainNodesCoordinator holds the arguments needed to create a main chain nodes coordinator
+type ArgsMainChainNodesCoordinator struct {
+	Hasher             hashing.Hasher
+	ChanceComputer     ChanceComputer
+	ConsensusGroupSize int
+	StartRating        uint32
+	NodesRegistry      NodesCoordinatorRegistryHandler
+	RegistryFilePath   string
+}
+
+type mainChainEpochNodesConfig struct {
+	eligibleMap map[uint32][]Validator
+	selectors   map[uint32]RandomSelector
+}
+
+// mainChainNodesCoordinator is a read only view of the main chain eligible validators, used to compute the main chain
+// consensus groups which signed the received main chain headers. It starts from a main chain nodes registry and is kept
+// in sync with the eligible validators and ratings published by the main chain nodes at each epoch start. It does no
+// shuffling on its own.
+type mainChainNodesCoordinator struct {
+	hasher             hashing.Hasher
+	chanceComputer     ChanceComputer
+	consensusGroupSize int
+	startRating        uint32
+	registryFilePath   string
+	mutNodesConfig     sync.RWMutex
+	nodesConfig        map[uint32]*mainChainEpochNodesConfig
+	ratings            map[string]uint32
+	lastEpoch          uint32
+}
+
+// NewMainChainNodesCoordinator creates a new main chain nodes coordinator
+func NewMainChainNodesCoordinator(args ArgsMainChainNodesCoordinator) (*mainChainNodesCoordinator, error) {
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.ChanceComputer) {
+		return nil, ErrNilChanceComputer
+	}
+	if args.ConsensusGroupSize < 1 {
+		return nil, ErrInvalidConsensusGroupSize
+	}
+	if args.NodesRegistry == nil {
+		return nil, ErrNilNodesCoordinatorRegistry
+	}
+
+	mcnc := &mainChainNodesCoordinator{
+		hasher:             args.Hasher,
+		chanceComputer:     args.ChanceComputer,
+		consensusGroupSize: args.ConsensusGroupSize,
+		startRating:        args.StartRating,
+		registryFilePath:   args.RegistryFilePath,
+		nodesConfig:        make(map[uint32]*mainChainEpochNodesConfig, nodesCoordinatorStoredEpochs),
+		ratings:            make(map[string]uint32),
+	}
+
+	err := mcnc.SetNodesCoordinatorRegistry(args.NodesRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	return mcnc, nil
+}
+
+// SetNodesCoordinatorRegistry sets the main chain eligible validators of all the epochs from the provided registry,
+// keeping only the last stored epochs
+func (mcnc *mainChainNodesCoordinator) SetNodesCoordinatorRegistry(registry NodesCoordinatorRegistryHandler) error {
+	if registry == nil {
+		return ErrNilNodesCoordinatorRegistry
+	}
+
+	epochsConfig := registry.GetEpochsConfig()
+	if len(epochsConfig) == 0 {
+		return ErrMapSizeZero
+	}
+
+	for epochStr, epochValidators := range epochsConfig {
+		epoch, err := strconv.ParseUint(epochStr, 10, 32)
+		if err != nil {
+			return err
+		}
+		if epochValidators == nil {
+			return fmt.Errorf("%w epoch=%v", ErrNilInputNodesMap, epoch)
+		}
+
+		eligibleMap, err := SerializableValidatorsToValidators(epochValidators.GetEligibleValidators())
+		if err != nil {
+			return err
+		}
+
+		err = mcnc.SetEpochEligibleValidators(uint32(epoch), eligibleMap)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetEpochEligibleValidators sets the main chain eligible validators for the provided epoch
+func (mcnc *mainChainNodesCoordinator) SetEpochEligibleValidators(epoch uint32, eligibleMap map[uint32][]Validator) error {
+	if len(eligibleMap) == 0 {
+		return ErrMapSizeZero
+	}
+
+	selectors := make(map[uint32]RandomSelector, len(eligibleMap))
+	for shardID, eligibleList := range eligibleMap {
+		if len(eligibleList) < mcnc.consensusGroupSize {
+			return fmt.Errorf("%w epoch=%v shard=%v", ErrSmallShardEligibleListSize, epoch, shardID)
+		}
+
+		selector, err := NewSelectorExpandedList(validatorsChances(eligibleList), mcnc.hasher)
+		if err != nil {
+			return err
+		}
+		selectors[shardID] = selector
+	}
+
+	mcnc.mutNodesConfig.Lock()
+	defer mcnc.mutNodesConfig.Unlock()
+
+	mcnc.nodesConfig[epoch] = &mainChainEpochNodesConfig{
+		eligibleMap: eligibleMap,
+		selectors:   selectors,
+	}
+	if epoch > mcnc.lastEpoch {
+		mcnc.lastEpoch = epoch
+	}
+
+	for storedEpoch := range mcnc.nodesConfig {
+		if storedEpoch+nodesCoordinatorStoredEpochs <= mcnc.lastEpoch {
+			delete(mcnc.nodesConfig, storedEpoch)
+		}
+	}
+
+	log.Debug("mainChainNodesCoordinator.SetEpochEligibleValidators", "epoch", epoch, "num shards", len(eligibleMap))
+
+	return nil
+}
+
+// SetValidatorsRatings records the latest known ratings of the main chain validators, as published by the main chain
+// nodes at epoch start. The ratings are used to compute the chances of the validators from the next synced epochs.
+func (mcnc *mainChainNodesCoordinator) SetValidatorsRatings(ratings map[string]uint32) {
+	mcnc.mutNodesConfig.Lock()
+	for pubKey, rating := range ratings {
+		mcnc.ratings[pubKey] = rating
+	}
+	mcnc.mutNodesConfig.Unlock()
+
+	log.Debug("mainChainNodesCoordinator.SetValidatorsRatings", "num validators", len(ratings))
+}
+
+// SetEpochEligiblePublicKeys sets the main chain eligible validators for the provided epoch from their public keys, as
+// published by the main chain nodes at epoch start. The chances of each validator are computed from its latest known
+// rating, or from the start rating if its rating is not known. The resulting registry is saved, so that the synced
+// epochs are kept after a restart.
+func (mcnc *mainChainNodesCoordinator) SetEpochEligiblePublicKeys(epoch uint32, eligiblePubKeys map[uint32][][]byte) error {
+	eligibleMap := make(map[uint32][]Validator, len(eligiblePubKeys))
+
+	mcnc.mutNodesConfig.RLock()
+	for shardID, pubKeys := range eligiblePubKeys {
+		validators := make([]Validator, 0, len(pubKeys))
+		for idx, pubKey := range pubKeys {
+			validator, err := NewValidator(pubKey, mcnc.computeChances(pubKey), uint32(idx))
+			if err != nil {
+				mcnc.mutNodesConfig.RUnlock()
+				return err
+			}
+
+			validators = append(validators, validator)
+		}
+
+		eligibleMap[shardID] = validators
+	}
+	mcnc.mutNodesConfig.RUnlock()
+
+	err := mcnc.SetEpochEligibleValidators(epoch, eligibleMap)
+	if err != nil {
+		return err
+	}
+
+	return mcnc.saveRegistry()
+}
+
+// computeChances returns the chances of a validator, same as the main chain nodes coordinator does: validators with a
+// rating below the minimum get the minimum chances, so that all the eligible validators can be selected
+func (mcnc *mainChainNodesCoordinator) computeChances(pubKey []byte) uint32 {
+	rating, found := mcnc.ratings[string(pubKey)]
+	if !found {
+		rating = mcnc.startRating
+	}
+
+	minChances := mcnc.chanceComputer.GetChance(0)
+	chances := mcnc.chanceComputer.GetChance(rating)
+	if chances < minChances {
+		return minChances
+	}
+
+	return chances
+}
+
+// NodesCoordinatorRegistry returns the registry holding the main chain eligible validators of all the stored epochs
+func (mcnc *mainChainNodesCoordinator) NodesCoordinatorRegistry() *NodesCoordinatorRegistry {
+	mcnc.mutNodesConfig.RLock()
+	defer mcnc.mutNodesConfig.RUnlock()
+
+	registry := &NodesCoordinatorRegistry{
+		EpochsConfig: make(map[string]*EpochValidators, len(mcnc.nodesConfig)),
+		CurrentEpoch: mcnc.lastEpoch,
+	}
+	for epoch, nodesConfig := range mcnc.nodesConfig {
+		eligibleValidators := make(map[string][]*SerializableValidator, len(nodesConfig.eligibleMap))
+		for shardID, validators := range nodesConfig.eligibleMap {
+			eligibleValidators[strconv.FormatUint(uint64(shardID), 10)] = ValidatorArrayToSerializableValidatorArray(validators)
+		}
+
+		registry.EpochsConfig[strconv.FormatUint(uint64(epoch), 10)] = &EpochValidators{
+			EligibleValidators: eligibleValidators,
+		}
+	}
+
+	return registry
+}
+
+func (mcnc *mainChainNodesCoordinator) saveRegistry() error {
+	if len(mcnc.registryFilePath) == 0 {
+		return nil
+	}
+
+	registryBytes, err := json.MarshalIndent(mcnc.NodesCoordinatorRegistry(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the registry is first written in a temporary file, so that a crash while saving does not corrupt the saved one
+	tmpFilePath := mcnc.registryFilePath + ".tmp"
+	err = os.WriteFile(tmpFilePath, registryBytes, core.FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFilePath, mcnc.registryFilePath)
+}
+
+func validatorsChances(validators []Validator) []uint32 {
+	weights := make([]uint32, len(validators))
+	for i, v := range validators {
+		weights[i] = v.Chances()
+	}
+
+	return weights
+}
+
+// ComputeConsensusGroup returns the main chain consensus group for the provided randomness, round, shard and epoch
+func (mcnc *mainChainNodesCoordinator) ComputeConsensusGroup(
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]Validator, error) {
+	if len(randomness) == 0 {
+		return nil, ErrNilRandomness
+	}
+
+	mcnc.mutNodesConfig.RLock()
+	nodesConfig, ok := mcnc.nodesConfig[epoch]
+	mcnc.mutNodesConfig.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w epoch=%v", ErrEpochNodesConfigDoesNotExist, epoch)
+	}
+
+	selector, ok := nodesConfig.selectors[shardID]
+	if !ok {
+		return nil, fmt.Errorf("%w shard=%v", ErrInvalidShardId, shardID)
+	}
+
+	randomness = []byte(fmt.Sprintf("%d-%s", round, randomness))
+
+	return selectValidators(selector, randomness, uint32(mcnc.consensusGroupSize), nodesConfig.eligibleMap[shardID])
+}
+
+// GetConsensusValidatorsPublicKeys returns the public keys of the main chain consensus group
+func (mcnc *mainChainNodesCoordinator) GetConsensusValidatorsPublicKeys(
+	randomness []byte,
+	round uint64,
+	shardID uint32,
+	epoch uint32,
+) ([]string, error) {
+	consensusNodes, err := mcnc.ComputeConsensusGroup(randomness, round, shardID, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeys := make([]string, 0, len(consensusNodes))
+	for _, v := range consensusNodes {
+		pubKeys = append(pubKeys, string(v.PubKey()))
+	}
+
+	return pubKeys, nil
+}
+
+// IsEpochInConfig checks whether the main chain validators of the specified epoch are known
+func (mcnc *mainChainNodesCoordinator) IsEpochInConfig(epoch uint32) bool {
+	mcnc.mutNodesConfig.RLock()
+	_, exists := mcnc.nodesConfig[epoch]
+	mcnc.mutNodesConfig.RUnlock()
+
+	return exists
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (mcnc *mainChainNodesCoordinator) IsInterfaceNil() bool {
+	return mcnc == nil
+}
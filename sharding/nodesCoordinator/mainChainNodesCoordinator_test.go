@@ -0,0 +1,237 @@
+package nodesCoordinator
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMainChainNodesCoordinatorArgs() ArgsMainChainNodesCoordinator {
+	return ArgsMainChainNodesCoordinator{
+		Hasher:             &hashingMocks.HasherMock{},
+		ChanceComputer:     createMainChainChanceComputer(),
+		ConsensusGroupSize: 3,
+		StartRating:        5000001,
+		NodesRegistry: &NodesCoordinatorRegistry{
+			EpochsConfig: map[string]*EpochValidators{
+				"4": {
+					EligibleValidators: map[string][]*SerializableValidator{
+						"0": createSerializableValidators(5, "epoch4"),
+					},
+				},
+			},
+			CurrentEpoch: 4,
+		},
+	}
+}
+
+func createMainChainChanceComputer() ChanceComputer {
+	chanceComputer, _ := NewSelectionChancesComputer([]*config.SelectionChance{
+		{MaxThreshold: 0, ChancePercent: 5},
+		{MaxThreshold: 1000000, ChancePercent: 0},
+		{MaxThreshold: 5000000, ChancePercent: 16},
+		{MaxThreshold: 10000000, ChancePercent: 24},
+	})
+
+	return chanceComputer
+}
+
+func createSerializableValidators(numValidators int, suffix string) []*SerializableValidator {
+	validators := make([]*SerializableValidator, 0, numValidators)
+	for i := 0; i < numValidators; i++ {
+		validators = append(validators, &SerializableValidator{
+			PubKey:  []byte(fmt.Sprintf("pk_%s_%d", suffix, i)),
+			Chances: defaultSelectionChances,
+			Index:   uint32(i),
+		})
+	}
+
+	return validators
+}
+
+func TestNewMainChainNodesCoordinator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hasher, should return error", func(t *testing.T) {
+		args := createMainChainNodesCoordinatorArgs()
+		args.Hasher = nil
+
+		mcnc, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, mcnc)
+		require.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("nil chance computer, should return error", func(t *testing.T) {
+		args := createMainChainNodesCoordinatorArgs()
+		args.ChanceComputer = nil
+
+		mcnc, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, mcnc)
+		require.Equal(t, ErrNilChanceComputer, err)
+	})
+	t.Run("invalid consensus size, should return error", func(t *testing.T) {
+		args := createMainChainNodesCoordinatorArgs()
+		args.ConsensusGroupSize = 0
+
+		mcnc, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, mcnc)
+		require.Equal(t, ErrInvalidConsensusGroupSize, err)
+	})
+	t.Run("nil nodes registry, should return error", func(t *testing.T) {
+		args := createMainChainNodesCoordinatorArgs()
+		args.NodesRegistry = nil
+
+		mcnc, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, mcnc)
+		require.Equal(t, ErrNilNodesCoordinatorRegistry, err)
+	})
+	t.Run("small eligible list, should return error", func(t *testing.T) {
+		args := createMainChainNodesCoordinatorArgs()
+		args.ConsensusGroupSize = 6
+
+		mcnc, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, mcnc)
+		require.True(t, errors.Is(err, ErrSmallShardEligibleListSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMainChainNodesCoordinatorArgs()
+
+		mcnc, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, err)
+		require.False(t, mcnc.IsInterfaceNil())
+		require.True(t, mcnc.IsEpochInConfig(4))
+		require.False(t, mcnc.IsEpochInConfig(5))
+	})
+}
+
+func TestMainChainNodesCoordinator_ComputeConsensusGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil randomness, should return error", func(t *testing.T) {
+		mcnc, _ := NewMainChainNodesCoordinator(createMainChainNodesCoordinatorArgs())
+
+		consensus, err := mcnc.ComputeConsensusGroup(nil, 1, 0, 4)
+		require.Nil(t, consensus)
+		require.Equal(t, ErrNilRandomness, err)
+	})
+	t.Run("unknown epoch, should return error", func(t *testing.T) {
+		mcnc, _ := NewMainChainNodesCoordinator(createMainChainNodesCoordinatorArgs())
+
+		consensus, err := mcnc.ComputeConsensusGroup([]byte("rand"), 1, 0, 5)
+		require.Nil(t, consensus)
+		require.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+	})
+	t.Run("unknown shard, should return error", func(t *testing.T) {
+		mcnc, _ := NewMainChainNodesCoordinator(createMainChainNodesCoordinatorArgs())
+
+		consensus, err := mcnc.ComputeConsensusGroup([]byte("rand"), 1, 1, 4)
+		require.Nil(t, consensus)
+		require.True(t, errors.Is(err, ErrInvalidShardId))
+	})
+	t.Run("should compute the same consensus group as the main chain nodes coordinator", func(t *testing.T) {
+		arguments := createArguments()
+		arguments.ShardConsensusGroupSize = 3
+		arguments.MetaConsensusGroupSize = 3
+		ihnc, err := NewIndexHashedNodesCoordinator(arguments)
+		require.Nil(t, err)
+
+		args := createMainChainNodesCoordinatorArgs()
+		args.Hasher = arguments.Hasher
+		args.NodesRegistry = ihnc.NodesCoordinatorToRegistry(arguments.Epoch)
+		mcnc, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, err)
+
+		for round := uint64(0); round < 10; round++ {
+			randomness := []byte(fmt.Sprintf("randomness_%d", round))
+
+			expectedPubKeys, errCompute := ihnc.GetConsensusValidatorsPublicKeys(randomness, round, 0, arguments.Epoch)
+			require.Nil(t, errCompute)
+
+			pubKeys, errCompute := mcnc.GetConsensusValidatorsPublicKeys(randomness, round, 0, arguments.Epoch)
+			require.Nil(t, errCompute)
+			require.Equal(t, expectedPubKeys, pubKeys)
+		}
+	})
+}
+
+func TestMainChainNodesCoordinator_SetEpochEligibleValidators(t *testing.T) {
+	t.Parallel()
+
+	mcnc, _ := NewMainChainNodesCoordinator(createMainChainNodesCoordinatorArgs())
+
+	err := mcnc.SetEpochEligibleValidators(5, nil)
+	require.Equal(t, ErrMapSizeZero, err)
+
+	for epoch := uint32(5); epoch < 5+nodesCoordinatorStoredEpochs; epoch++ {
+		eligible, errConvert := SerializableShardValidatorListToValidatorList(createSerializableValidators(4, fmt.Sprintf("epoch%d", epoch)))
+		require.Nil(t, errConvert)
+
+		err = mcnc.SetEpochEligibleValidators(epoch, map[uint32][]Validator{0: eligible})
+		require.Nil(t, err)
+		require.True(t, mcnc.IsEpochInConfig(epoch))
+	}
+
+	require.False(t, mcnc.IsEpochInConfig(4))
+	require.Len(t, mcnc.nodesConfig, nodesCoordinatorStoredEpochs)
+
+	consensus, err := mcnc.ComputeConsensusGroup([]byte("rand"), 1, 0, 8)
+	require.Nil(t, err)
+	require.Len(t, consensus, 3)
+}
+
+func TestMainChainNodesCoordinator_SetEpochEligiblePublicKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty public keys, should return error", func(t *testing.T) {
+		mcnc, _ := NewMainChainNodesCoordinator(createMainChainNodesCoordinatorArgs())
+
+		err := mcnc.SetEpochEligiblePublicKeys(5, nil)
+		require.Equal(t, ErrMapSizeZero, err)
+		require.False(t, mcnc.IsEpochInConfig(5))
+	})
+	t.Run("should compute the chances from the known ratings and save the registry", func(t *testing.T) {
+		args := createMainChainNodesCoordinatorArgs()
+		args.RegistryFilePath = filepath.Join(t.TempDir(), "mainChainNodesRegistry.json")
+		mcnc, _ := NewMainChainNodesCoordinator(args)
+
+		mcnc.SetValidatorsRatings(map[string]uint32{
+			"pk0": 10000000,
+			"pk1": 500000,
+		})
+		err := mcnc.SetEpochEligiblePublicKeys(5, map[uint32][][]byte{
+			0: {[]byte("pk0"), []byte("pk1"), []byte("pk2")},
+		})
+		require.Nil(t, err)
+		require.True(t, mcnc.IsEpochInConfig(5))
+
+		expectedValidators := []*SerializableValidator{
+			{PubKey: []byte("pk0"), Chances: 24, Index: 0},
+			{PubKey: []byte("pk1"), Chances: 5, Index: 1},
+			{PubKey: []byte("pk2"), Chances: 24, Index: 2},
+		}
+		registry := mcnc.NodesCoordinatorRegistry()
+		require.Equal(t, uint32(5), registry.CurrentEpoch)
+		require.Equal(t, expectedValidators, registry.EpochsConfig["5"].EligibleValidators["0"])
+
+		savedRegistry := &NodesCoordinatorRegistry{}
+		err = core.LoadJsonFile(savedRegistry, args.RegistryFilePath)
+		require.Nil(t, err)
+		require.Equal(t, registry, savedRegistry)
+
+		args.NodesRegistry = savedRegistry
+		restartedCoordinator, err := NewMainChainNodesCoordinator(args)
+		require.Nil(t, err)
+		require.True(t, restartedCoordinator.IsEpochInConfig(4))
+		require.True(t, restartedCoordinator.IsEpochInConfig(5))
+
+		expectedPubKeys, _ := mcnc.GetConsensusValidatorsPublicKeys([]byte("rand"), 1, 0, 5)
+		pubKeys, err := restartedCoordinator.GetConsensusValidatorsPublicKeys([]byte("rand"), 1, 0, 5)
+		require.Nil(t, err)
+		require.Equal(t, expectedPubKeys, pubKeys)
+	})
+}
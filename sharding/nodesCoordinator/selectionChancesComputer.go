@@ -0,0 +1,45 @@
+package nodesCoordinator
+
+import (
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+type selectionChancesComputer struct {
+	selectionChances []*config.SelectionChance
+}
+
+// NewSelectionChancesComputer creates a chance computer which maps ratings to chances as the main chain rater does, by
+// using the provided selection chances, ordered ascending by their max threshold (see SelectionChances from ratings.toml)
+func NewSelectionChancesComputer(selectionChances []*config.SelectionChance) (*selectionChancesComputer, error) {
+	if len(selectionChances) == 0 {
+		return nil, ErrEmptySelectionChances
+	}
+	for _, selectionChance := range selectionChances {
+		if selectionChance == nil {
+			return nil, ErrEmptySelectionChances
+		}
+	}
+
+	return &selectionChancesComputer{
+		selectionChances: selectionChances,
+	}, nil
+}
+
+// GetChance returns the chance percent of the first selection chance whose max threshold is not lower than the rating
+func (scc *selectionChancesComputer) GetChance(rating uint32) uint32 {
+	chance := scc.selectionChances[0].ChancePercent
+	for _, selectionChance := range scc.selectionChances {
+		if rating > selectionChance.MaxThreshold {
+			continue
+		}
+
+		return selectionChance.ChancePercent
+	}
+
+	return chance
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scc *selectionChancesComputer) IsInterfaceNil() bool {
+	return scc == nil
+}
@@ -0,0 +1,46 @@
+package nodesCoordinator
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSelectionChancesComputer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty selection chances, should return error", func(t *testing.T) {
+		scc, err := NewSelectionChancesComputer(nil)
+		require.Nil(t, scc)
+		require.Equal(t, ErrEmptySelectionChances, err)
+	})
+	t.Run("nil selection chance, should return error", func(t *testing.T) {
+		scc, err := NewSelectionChancesComputer([]*config.SelectionChance{{MaxThreshold: 0, ChancePercent: 5}, nil})
+		require.Nil(t, scc)
+		require.Equal(t, ErrEmptySelectionChances, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		scc, err := NewSelectionChancesComputer([]*config.SelectionChance{{MaxThreshold: 0, ChancePercent: 5}})
+		require.Nil(t, err)
+		require.False(t, scc.IsInterfaceNil())
+	})
+}
+
+func TestSelectionChancesComputer_GetChance(t *testing.T) {
+	t.Parallel()
+
+	scc, _ := NewSelectionChancesComputer([]*config.SelectionChance{
+		{MaxThreshold: 0, ChancePercent: 5},
+		{MaxThreshold: 1000000, ChancePercent: 0},
+		{MaxThreshold: 2000000, ChancePercent: 16},
+		{MaxThreshold: 10000000, ChancePercent: 24},
+	})
+
+	require.Equal(t, uint32(5), scc.GetChance(0))
+	require.Equal(t, uint32(0), scc.GetChance(1))
+	require.Equal(t, uint32(0), scc.GetChance(1000000))
+	require.Equal(t, uint32(16), scc.GetChance(1000001))
+	require.Equal(t, uint32(24), scc.GetChance(10000000))
+	require.Equal(t, uint32(5), scc.GetChance(10000001))
+}
@@ -232,25 +232,100 @@ func (ihnc *sovereignIndexHashedNodesCoordinator) EpochStartPrepare(hdr data.Hea
 
 	ihnc.updateEpochFlags(newEpoch)
 
-	allValidatorInfo, err := ihnc.createValidatorInfoFromBody(body, ihnc.numTotalEligible, newEpoch)
+	nodesLists, err := ihnc.computeEpochStartNodesLists(randomness, newEpoch, body, ihnc.getStakingV4FlagsInEpoch(newEpoch))
 	if err != nil {
-		log.Error("could not create validator info from body - do nothing on sovereignIndexHashedNodesCoordinator epochStartPrepare", "error", err.Error())
+		log.Error("could not compute nodes lists - do nothing on sovereignIndexHashedNodesCoordinator epochStartPrepare", "error", err)
 		return
 	}
 
+	newNodesConfig := nodesLists.nodesConfig
+	additionalLeavingMap := nodesLists.additionalLeaving
+	resUpdateNodes := nodesLists.updatedNodes
+
+	leavingNodesMap, stillRemainingNodesMap := createActuallyLeavingPerShards(
+		newNodesConfig.leavingMap,
+		additionalLeavingMap,
+		resUpdateNodes.Leaving,
+	)
+
+	err = ihnc.setNodesPerShards(resUpdateNodes.Eligible, resUpdateNodes.Waiting, leavingNodesMap, resUpdateNodes.ShuffledOut, newEpoch, resUpdateNodes.LowWaitingList)
+	if err != nil {
+		log.Error("set nodes per shard failed", "error", err.Error())
+	}
+
+	ihnc.fillPublicKeyToValidatorMap()
+	err = ihnc.saveState(randomness, newEpoch)
+	ihnc.handleErrorLog(err, "saving nodes coordinator config failed")
+
+	displaySovereignNodesConfiguration(
+		resUpdateNodes.Eligible,
+		resUpdateNodes.Waiting,
+		leavingNodesMap,
+		stillRemainingNodesMap,
+		resUpdateNodes.ShuffledOut)
+
+	ihnc.mutSavedStateKey.Lock()
+	ihnc.savedStateKey = randomness
+	ihnc.mutSavedStateKey.Unlock()
+
+	ihnc.consensusGroupCacher.Clear()
+}
+
+// ComputeNextEpochEligiblePublicKeys computes, without saving anything, the public keys of the validators which will be
+// eligible in the epoch started by the provided epoch start block. The keys are computed from the validator info mini
+// blocks of the provided body, in the same way as on EpochStartPrepare, so they are known before the block is committed.
+// The coordinator epoch flags are not updated, so processing a block which is later rejected leaves no trace.
+func (ihnc *sovereignIndexHashedNodesCoordinator) ComputeNextEpochEligiblePublicKeys(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error) {
+	if check.IfNil(hdr) {
+		return nil, ErrNilHeader
+	}
+	if !hdr.IsStartOfEpochBlock() {
+		return nil, ErrNotEpochStartBlock
+	}
+
+	epochFlags := ihnc.getStakingV4FlagsInEpoch(hdr.GetEpoch())
+	nodesLists, err := ihnc.computeEpochStartNodesLists(hdr.GetPrevRandSeed(), hdr.GetEpoch(), body, epochFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	eligible := nodesLists.updatedNodes.Eligible[core.SovereignChainShardId]
+	pubKeys := make([][]byte, 0, len(eligible))
+	for _, validator := range eligible {
+		pubKeys = append(pubKeys, validator.PubKey())
+	}
+
+	return pubKeys, nil
+}
+
+type epochStartNodesLists struct {
+	nodesConfig       *epochNodesConfig
+	additionalLeaving map[uint32][]Validator
+	updatedNodes      *ResUpdateNodes
+}
+
+func (ihnc *sovereignIndexHashedNodesCoordinator) computeEpochStartNodesLists(
+	randomness []byte,
+	newEpoch uint32,
+	body data.BodyHandler,
+	flags stakingV4Flags,
+) (*epochStartNodesLists, error) {
+	allValidatorInfo, err := ihnc.createValidatorInfoFromBody(body, ihnc.numTotalEligible, newEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating validator info from body", err)
+	}
+
 	// TODO: compare with previous nodesConfig if exists
-	newNodesConfig, err := ihnc.computeNodesConfigFromList(allValidatorInfo)
+	newNodesConfig, err := ihnc.computeNodesConfigFromListWithFlags(allValidatorInfo, flags)
 	if err != nil {
-		log.Error("could not compute nodes config from list - do nothing on sovereignIndexHashedNodesCoordinator epochStartPrepare", "error", err)
-		return
+		return nil, fmt.Errorf("%w while computing nodes config from list", err)
 	}
 
 	newNodesConfig.nbShards = 1
 
 	additionalLeavingMap, err := ihnc.nodesCoordinatorHelper.ComputeAdditionalLeaving(allValidatorInfo)
 	if err != nil {
-		log.Error("could not compute additionalLeaving Nodes  - do nothing on sovereignIndexHashedNodesCoordinator epochStartPrepare")
-		return
+		return nil, fmt.Errorf("%w while computing additional leaving nodes", err)
 	}
 
 	unStakeLeavingList := ihnc.createSortedListFromMap(newNodesConfig.leavingMap)
@@ -270,37 +345,14 @@ func (ihnc *sovereignIndexHashedNodesCoordinator) EpochStartPrepare(hdr data.Hea
 
 	resUpdateNodes, err := ihnc.shuffler.UpdateNodeLists(shufflerArgs)
 	if err != nil {
-		log.Error("could not compute UpdateNodeLists - do nothing on sovereignIndexHashedNodesCoordinator epochStartPrepare", "err", err.Error())
-		return
-	}
-
-	leavingNodesMap, stillRemainingNodesMap := createActuallyLeavingPerShards(
-		newNodesConfig.leavingMap,
-		additionalLeavingMap,
-		resUpdateNodes.Leaving,
-	)
-
-	err = ihnc.setNodesPerShards(resUpdateNodes.Eligible, resUpdateNodes.Waiting, leavingNodesMap, resUpdateNodes.ShuffledOut, newEpoch, resUpdateNodes.LowWaitingList)
-	if err != nil {
-		log.Error("set nodes per shard failed", "error", err.Error())
+		return nil, fmt.Errorf("%w while updating node lists", err)
 	}
 
-	ihnc.fillPublicKeyToValidatorMap()
-	err = ihnc.saveState(randomness, newEpoch)
-	ihnc.handleErrorLog(err, "saving nodes coordinator config failed")
-
-	displaySovereignNodesConfiguration(
-		resUpdateNodes.Eligible,
-		resUpdateNodes.Waiting,
-		leavingNodesMap,
-		stillRemainingNodesMap,
-		resUpdateNodes.ShuffledOut)
-
-	ihnc.mutSavedStateKey.Lock()
-	ihnc.savedStateKey = randomness
-	ihnc.mutSavedStateKey.Unlock()
-
-	ihnc.consensusGroupCacher.Clear()
+	return &epochStartNodesLists{
+		nodesConfig:       newNodesConfig,
+		additionalLeaving: additionalLeavingMap,
+		updatedNodes:      resUpdateNodes,
+	}, nil
 }
 
 func displaySovereignNodesConfiguration(
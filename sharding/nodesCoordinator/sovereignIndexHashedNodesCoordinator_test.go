@@ -5,7 +5,11 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever/dataPool"
 )
 
 func TestNewSovereignIndexHashedNodesCoordinator(t *testing.T) {
@@ -122,3 +126,86 @@ func TestSovereignIndexHashedNodesCoordinator_GetConsensusValidatorsPublicKeys(t
 		require.Nil(t, err)
 	})
 }
+
+func TestSovereignIndexHashedNodesCoordinator_ComputeNextEpochEligiblePublicKeys(t *testing.T) {
+	t.Parallel()
+
+	createCoordinator := func() *sovereignIndexHashedNodesCoordinator {
+		arguments := createArguments()
+		arguments.EligibleNodes = map[uint32][]Validator{
+			core.SovereignChainShardId: createDummyNodesList(10, "eligible"),
+		}
+		arguments.WaitingNodes = map[uint32][]Validator{
+			core.SovereignChainShardId: createDummyNodesList(3, "waiting"),
+		}
+		arguments.ValidatorInfoCacher = dataPool.NewCurrentEpochValidatorInfoPool()
+
+		ihnc, err := NewSovereignIndexHashedNodesCoordinator(arguments)
+		require.Nil(t, err)
+
+		return ihnc
+	}
+	createBody := func(ihnc *sovereignIndexHashedNodesCoordinator) *block.Body {
+		body := &block.Body{MiniBlocks: make([]*block.MiniBlock, 0)}
+		mbs := createMiniBlocksForNodesMap(ihnc.nodesConfig[0].eligibleMap, string(common.EligibleList), ihnc.marshalizer, ihnc.hasher, ihnc.validatorInfoCacher)
+		body.MiniBlocks = append(body.MiniBlocks, mbs...)
+		mbs = createMiniBlocksForNodesMap(ihnc.nodesConfig[0].waitingMap, string(common.WaitingList), ihnc.marshalizer, ihnc.hasher, ihnc.validatorInfoCacher)
+		body.MiniBlocks = append(body.MiniBlocks, mbs...)
+
+		return body
+	}
+	epochStartHeader := &block.SovereignChainHeader{
+		Header: &block.Header{
+			Epoch:        1,
+			PrevRandSeed: []byte("rand seed"),
+		},
+		IsStartOfEpoch: true,
+	}
+
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc := createCoordinator()
+		pubKeys, err := ihnc.ComputeNextEpochEligiblePublicKeys(nil, createBody(ihnc))
+		require.Equal(t, ErrNilHeader, err)
+		require.Nil(t, pubKeys)
+	})
+	t.Run("not epoch start block should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc := createCoordinator()
+		pubKeys, err := ihnc.ComputeNextEpochEligiblePublicKeys(&block.SovereignChainHeader{Header: &block.Header{}}, createBody(ihnc))
+		require.Equal(t, ErrNotEpochStartBlock, err)
+		require.Nil(t, pubKeys)
+	})
+	t.Run("nil body should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc := createCoordinator()
+		pubKeys, err := ihnc.ComputeNextEpochEligiblePublicKeys(epochStartHeader, nil)
+		require.ErrorIs(t, err, ErrNilBlockBody)
+		require.Nil(t, pubKeys)
+	})
+	t.Run("should compute the same eligible list as on epoch start prepare, without saving it", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc := createCoordinator()
+		ihnc.flagStakingV4Started.SetValue(false)
+		ihnc.flagStakingV4Step2.SetValue(false)
+		body := createBody(ihnc)
+
+		pubKeys, err := ihnc.ComputeNextEpochEligiblePublicKeys(epochStartHeader, body)
+		require.Nil(t, err)
+		require.Len(t, pubKeys, 10)
+		require.False(t, ihnc.flagStakingV4Started.IsSet())
+		require.False(t, ihnc.flagStakingV4Step2.IsSet())
+
+		_, err = ihnc.GetAllEligibleValidatorsPublicKeys(1)
+		require.ErrorIs(t, err, ErrEpochNodesConfigDoesNotExist)
+
+		ihnc.EpochStartPrepare(epochStartHeader, body)
+		eligible, err := ihnc.GetAllEligibleValidatorsPublicKeys(1)
+		require.Nil(t, err)
+		require.Equal(t, eligible[core.SovereignChainShardId], pubKeys)
+	})
+}
@@ -339,6 +339,26 @@ func (psf *StorageServiceFactory) createAndAddStorageUnitsForSovereign(
 	}
 	store.AddStorer(dataRetriever.ExtendedShardHeadersNonceHashDataUnit, extendedShardHdrHashNonceUnit)
 
+	for idx, incomingChain := range psf.generalConfig.SovereignConfig.IncomingChains {
+		incomingChainHdrNonceHashUnit, errCreate := psf.createStaticStorageUnit(incomingChain.ExtendedShardHdrNonceHashStorage, shardID, shardID)
+		if errCreate != nil {
+			return fmt.Errorf("%w for ExtendedShardHdrNonceHashStorage of incoming chain %s", errCreate, incomingChain.ChainID)
+		}
+		store.AddStorer(dataRetriever.IncomingChainHdrNonceHashDataUnit+dataRetriever.UnitType(idx), incomingChainHdrNonceHashUnit)
+	}
+
+	outGoingOperationsUnit, err := psf.createStaticStorageUnit(psf.generalConfig.SovereignConfig.OutGoingOperationsStorage, shardID, shardID)
+	if err != nil {
+		return fmt.Errorf("%w for OutGoingOperationsStorage", err)
+	}
+	store.AddStorer(dataRetriever.OutGoingOperationsUnit, outGoingOperationsUnit)
+
+	incomingHeadersQueueUnit, err := psf.createStaticStorageUnit(psf.generalConfig.SovereignConfig.IncomingHeadersQueueStorage, shardID, shardID)
+	if err != nil {
+		return fmt.Errorf("%w for IncomingHeadersQueueStorage", err)
+	}
+	store.AddStorer(dataRetriever.IncomingHeadersQueueUnit, incomingHeadersQueueUnit)
+
 	return nil
 }
 
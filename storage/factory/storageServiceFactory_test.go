@@ -53,6 +53,8 @@ func createMockArgument(t *testing.T) StorageServiceFactoryArgs {
 			SovereignConfig: config.SovereignConfig{
 				ExtendedShardHdrNonceHashStorage: createMockStorageConfig("ExtendedShardHdrNonceHashStorage"),
 				ExtendedShardHeaderStorage:       createMockStorageConfig("ExtendedShardHeaderStorage"),
+				OutGoingOperationsStorage:        createMockStorageConfig("OutGoingOperationsStorage"),
+				IncomingHeadersQueueStorage:      createMockStorageConfig("IncomingHeadersQueueStorage"),
 			},
 			DbLookupExtensions: config.DbLookupExtensionsConfig{
 				Enabled:                            true,
@@ -522,6 +524,36 @@ func TestStorageServiceFactory_CreateForSovereign(t *testing.T) {
 		require.True(t, check.IfNil(storageService))
 	})
 
+	t.Run("wrong config for OutGoingOperationsStorage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.AdditionalStorageServiceCreator = &testscommon.AdditionalStorageServiceFactoryMock{
+			WorkAsSovereign: true,
+		}
+		args.Config.SovereignConfig.OutGoingOperationsStorage.Cache.Type = ""
+
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		require.Equal(t, expectedErrForCacheString+" for OutGoingOperationsStorage", err.Error())
+		require.True(t, check.IfNil(storageService))
+	})
+
+	t.Run("wrong config for IncomingHeadersQueueStorage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.AdditionalStorageServiceCreator = &testscommon.AdditionalStorageServiceFactoryMock{
+			WorkAsSovereign: true,
+		}
+		args.Config.SovereignConfig.IncomingHeadersQueueStorage.Cache.Type = ""
+
+		storageServiceFactory, _ := NewStorageServiceFactory(args)
+		storageService, err := storageServiceFactory.CreateForShard()
+		require.Equal(t, expectedErrForCacheString+" for IncomingHeadersQueueStorage", err.Error())
+		require.True(t, check.IfNil(storageService))
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -536,7 +568,7 @@ func TestStorageServiceFactory_CreateForSovereign(t *testing.T) {
 		require.False(t, check.IfNil(storageService))
 
 		allStorers := storageService.GetAllStorers()
-		expectedStorers := numShardStoreres + 2 // ExtendedShardHeadersUnit + ExtendedShardHeadersNonceHashDataUnit
+		expectedStorers := numShardStoreres + 4 // ExtendedShardHeadersUnit + ExtendedShardHeadersNonceHashDataUnit + OutGoingOperationsUnit + IncomingHeadersQueueUnit
 		require.Equal(t, expectedStorers, len(allStorers))
 		_ = storageService.CloseAll()
 	})
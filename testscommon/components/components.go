@@ -44,6 +44,7 @@ import (
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	commonMocks "github.com/multiversx/mx-chain-go/testscommon/common"
+	consensusMocks "github.com/multiversx/mx-chain-go/testscommon/consensus"
 	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
@@ -220,6 +221,7 @@ func GetConsensusArgs(shardCoordinator sharding.Coordinator) consensusComp.Conse
 		RunTypeComponents:    GetRunTypeComponents(),
 		ExtraSignersHolder:   &subRoundsHolder.ExtraSignersHolderMock{},
 		SubRoundEndV2Creator: bls.NewSubRoundEndV2Creator(),
+		SigningJournal:       &consensusMocks.SigningJournalStub{},
 	}
 }
 
@@ -269,6 +271,7 @@ func GetSovereignConsensusArgs(shardCoordinator sharding.Coordinator) consensusC
 		RunTypeComponents:    GetSovereignRunTypeComponents(),
 		ExtraSignersHolder:   &subRoundsHolder.ExtraSignersHolderMock{},
 		SubRoundEndV2Creator: bls.NewSubRoundEndV2Creator(),
+		SigningJournal:       &consensusMocks.SigningJournalStub{},
 	}
 }
 
@@ -1203,6 +1206,7 @@ func GetRunTypeComponentsStub(rt factory.RunTypeComponentsHandler) *mainFactoryM
 		OutGoingOperationsPool:                      rt.OutGoingOperationsPoolHandler(),
 		DataCodec:                                   rt.DataCodecHandler(),
 		TopicsChecker:                               rt.TopicsCheckerHandler(),
+		IncomingChains:                              rt.IncomingChainsHandler(),
 		ShardCoordinatorFactory:                     rt.ShardCoordinatorCreator(),
 		NodesCoordinatorWithRaterFactory:            rt.NodesCoordinatorWithRaterCreator(),
 		RequestersContainerFactory:                  rt.RequestersContainerFactoryCreator(),
@@ -1323,6 +1327,12 @@ func createSovRunTypeArgs() runType.ArgsSovereignRunTypeComponents {
 			GenesisConfig: config.GenesisConfig{
 				NativeESDT: "WEGLD-ab47da",
 			},
+			MainChainNotarization: config.MainChainNotarization{
+				MainChainID: "1",
+			},
+			OutGoingBridge: config.OutGoingBridge{
+				Hasher: "sha256",
+			},
 		},
 		DataCodec:     &sovereign.DataCodecMock{},
 		TopicsChecker: &sovereign.TopicsCheckerMock{},
@@ -1362,6 +1372,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["ValidatorToDelegation"] = value
 	gasMap["GetActiveFund"] = value
 	gasMap["FixWaitingListSize"] = value
+	gasMap["SubmitEquivocationProof"] = value
 
 	return gasMap
 }
@@ -205,6 +205,9 @@ func GetGeneralConfig() config.Config {
 			RefreshIntervalInSec: 1,
 		},
 		SovereignConfig: config.SovereignConfig{
+			MainChainNotarization: config.MainChainNotarization{
+				MainChainID: "1",
+			},
 			NotifierConfig: config.NotifierConfig{
 				SubscribedEvents: []config.SubscribedEvent{
 					{
@@ -220,6 +223,10 @@ func GetGeneralConfig() config.Config {
 						Addresses:  []string{"erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"},
 					},
 				},
+				ValidatorSetRotation: config.ValidatorSetRotation{
+					Address:  "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+					GasLimit: 20000000,
+				},
 			},
 		},
 	}
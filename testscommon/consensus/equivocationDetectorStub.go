@@ -0,0 +1,31 @@
+package consensus
+
+import (
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	CheckProposalCalled  func(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P)
+	CheckSignatureCalled func(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P)
+}
+
+// CheckProposal -
+func (stub *EquivocationDetectorStub) CheckProposal(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P) {
+	if stub.CheckProposalCalled != nil {
+		stub.CheckProposalCalled(cnsMsg, p2pMsg)
+	}
+}
+
+// CheckSignature -
+func (stub *EquivocationDetectorStub) CheckSignature(cnsMsg *consensus.Message, p2pMsg p2p.MessageP2P) {
+	if stub.CheckSignatureCalled != nil {
+		stub.CheckSignatureCalled(cnsMsg, p2pMsg)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -12,6 +12,7 @@ type ConsensusComponentsStub struct {
 	BroadcastMessengerHandler consensus.BroadcastMessenger
 	GroupSize                 int
 	BootstrapperHandler       process.Bootstrapper
+	EquivocationDetectorField factory.EquivocationDetector
 }
 
 // Create -
@@ -54,6 +55,11 @@ func (ccs *ConsensusComponentsStub) Bootstrapper() process.Bootstrapper {
 	return ccs.BootstrapperHandler
 }
 
+// EquivocationDetector -
+func (ccs *ConsensusComponentsStub) EquivocationDetector() factory.EquivocationDetector {
+	return ccs.EquivocationDetectorField
+}
+
 // ConsensusGroupSize -
 func (ccs *ConsensusComponentsStub) ConsensusGroupSize() (int, error) {
 	return ccs.GroupSize, nil
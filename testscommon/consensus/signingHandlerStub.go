@@ -14,6 +14,7 @@ type SigningHandlerStub struct {
 	AggregateSigsCalled                    func(bitmap []byte, epoch uint32) ([]byte, error)
 	SetAggregatedSigCalled                 func(_ []byte) error
 	VerifyCalled                           func(msg []byte, bitmap []byte, epoch uint32) error
+	ShallowCloneCalled                     func() consensus.SigningHandler
 }
 
 // Reset -
@@ -108,6 +109,10 @@ func (stub *SigningHandlerStub) Verify(msg []byte, bitmap []byte, epoch uint32)
 
 // ShallowClone -
 func (stub *SigningHandlerStub) ShallowClone() consensus.SigningHandler {
+	if stub.ShallowCloneCalled != nil {
+		return stub.ShallowCloneCalled()
+	}
+
 	return &SigningHandlerStub{}
 }
 
@@ -0,0 +1,30 @@
+package consensus
+
+// SigningJournalStub -
+type SigningJournalStub struct {
+	CheckAndRecordCalled          func(pubKey []byte, round int64, epoch uint32, headerHash []byte) error
+	CheckAndRecordExtraDataCalled func(identifier string, pubKey []byte, round int64, epoch uint32, dataHash []byte) error
+}
+
+// CheckAndRecord -
+func (stub *SigningJournalStub) CheckAndRecord(pubKey []byte, round int64, epoch uint32, headerHash []byte) error {
+	if stub.CheckAndRecordCalled != nil {
+		return stub.CheckAndRecordCalled(pubKey, round, epoch, headerHash)
+	}
+
+	return nil
+}
+
+// CheckAndRecordExtraData -
+func (stub *SigningJournalStub) CheckAndRecordExtraData(identifier string, pubKey []byte, round int64, epoch uint32, dataHash []byte) error {
+	if stub.CheckAndRecordExtraDataCalled != nil {
+		return stub.CheckAndRecordExtraDataCalled(identifier, pubKey, round, epoch, dataHash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SigningJournalStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,31 @@
+package cryptoMocks
+
+// RemotePrivateKeyStub -
+type RemotePrivateKeyStub struct {
+	PrivateKeyStub
+	SignCalled           func(message []byte) ([]byte, error)
+	PublicKeyBytesCalled func() []byte
+}
+
+// Sign -
+func (stub *RemotePrivateKeyStub) Sign(message []byte) ([]byte, error) {
+	if stub.SignCalled != nil {
+		return stub.SignCalled(message)
+	}
+
+	return nil, nil
+}
+
+// PublicKeyBytes -
+func (stub *RemotePrivateKeyStub) PublicKeyBytes() []byte {
+	if stub.PublicKeyBytesCalled != nil {
+		return stub.PublicKeyBytesCalled()
+	}
+
+	return []byte("public key")
+}
+
+// IsInterfaceNil -
+func (stub *RemotePrivateKeyStub) IsInterfaceNil() bool {
+	return stub == nil
+}
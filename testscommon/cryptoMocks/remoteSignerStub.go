@@ -0,0 +1,20 @@
+package cryptoMocks
+
+// RemoteSignerStub -
+type RemoteSignerStub struct {
+	SignCalled func(publicKey []byte, message []byte) ([]byte, error)
+}
+
+// Sign -
+func (stub *RemoteSignerStub) Sign(publicKey []byte, message []byte) ([]byte, error) {
+	if stub.SignCalled != nil {
+		return stub.SignCalled(publicKey, message)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *RemoteSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
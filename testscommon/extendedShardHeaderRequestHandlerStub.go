@@ -4,6 +4,7 @@ package testscommon
 type ExtendedShardHeaderRequestHandlerStub struct {
 	RequestHandlerStub
 	RequestExtendedShardHeaderByNonceCalled func(nonce uint64)
+	RequestIncomingChainHeaderByNonceCalled func(incomingChainShardID uint32, nonce uint64)
 	RequestExtendedShardHeaderCalled        func(hash []byte)
 }
 
@@ -14,6 +15,13 @@ func (eshrhs *ExtendedShardHeaderRequestHandlerStub) RequestExtendedShardHeaderB
 	}
 }
 
+// RequestIncomingChainHeaderByNonce -
+func (eshrhs *ExtendedShardHeaderRequestHandlerStub) RequestIncomingChainHeaderByNonce(incomingChainShardID uint32, nonce uint64) {
+	if eshrhs.RequestIncomingChainHeaderByNonceCalled != nil {
+		eshrhs.RequestIncomingChainHeaderByNonceCalled(incomingChainShardID, nonce)
+	}
+}
+
 // RequestExtendedShardHeader -
 func (eshrhs *ExtendedShardHeaderRequestHandlerStub) RequestExtendedShardHeader(hash []byte) {
 	if eshrhs.RequestExtendedShardHeaderCalled != nil {
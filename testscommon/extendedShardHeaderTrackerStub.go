@@ -7,23 +7,24 @@ import (
 // ExtendedShardHeaderTrackerStub -
 type ExtendedShardHeaderTrackerStub struct {
 	BlockTrackerStub
-	ComputeLongestExtendedShardChainFromLastNotarizedCalled func() ([]data.HeaderHandler, [][]byte, error)
-	RemoveLastCrossNotarizedHeadersCalled                   func()
+	ComputeLongestExtendedShardChainFromLastNotarizedCalled func(shardID uint32) ([]data.HeaderHandler, [][]byte, error)
+	IsGenesisLastCrossNotarizedHeaderCalled                 func(shardID uint32) bool
+	RemoveLastCrossNotarizedHeaderCalled                    func(shardID uint32)
 	RemoveLastSelfNotarizedHeadersCalled                    func()
 }
 
 // ComputeLongestExtendedShardChainFromLastNotarized -
-func (eshts *ExtendedShardHeaderTrackerStub) ComputeLongestExtendedShardChainFromLastNotarized() ([]data.HeaderHandler, [][]byte, error) {
+func (eshts *ExtendedShardHeaderTrackerStub) ComputeLongestExtendedShardChainFromLastNotarized(shardID uint32) ([]data.HeaderHandler, [][]byte, error) {
 	if eshts.ComputeLongestExtendedShardChainFromLastNotarizedCalled != nil {
-		return eshts.ComputeLongestMetaChainFromLastNotarizedCalled()
+		return eshts.ComputeLongestExtendedShardChainFromLastNotarizedCalled(shardID)
 	}
 	return nil, nil, nil
 }
 
-// RemoveLastCrossNotarizedHeaders -
-func (eshts *ExtendedShardHeaderTrackerStub) RemoveLastCrossNotarizedHeaders() {
-	if eshts.RemoveLastCrossNotarizedHeadersCalled != nil {
-		eshts.RemoveLastCrossNotarizedHeadersCalled()
+// RemoveLastCrossNotarizedHeader -
+func (eshts *ExtendedShardHeaderTrackerStub) RemoveLastCrossNotarizedHeader(shardID uint32) {
+	if eshts.RemoveLastCrossNotarizedHeaderCalled != nil {
+		eshts.RemoveLastCrossNotarizedHeaderCalled(shardID)
 	}
 }
 
@@ -35,6 +36,9 @@ func (eshts *ExtendedShardHeaderTrackerStub) RemoveLastSelfNotarizedHeaders() {
 }
 
 // IsGenesisLastCrossNotarizedHeader -
-func (eshts *ExtendedShardHeaderTrackerStub) IsGenesisLastCrossNotarizedHeader() bool {
+func (eshts *ExtendedShardHeaderTrackerStub) IsGenesisLastCrossNotarizedHeader(shardID uint32) bool {
+	if eshts.IsGenesisLastCrossNotarizedHeaderCalled != nil {
+		return eshts.IsGenesisLastCrossNotarizedHeaderCalled(shardID)
+	}
 	return false
 }
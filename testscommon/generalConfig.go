@@ -430,6 +430,9 @@ func GetGeneralConfig() config.Config {
 			RefreshIntervalInSec: 1,
 		},
 		SovereignConfig: config.SovereignConfig{
+			MainChainNotarization: config.MainChainNotarization{
+				MainChainID: "1",
+			},
 			NotifierConfig: config.NotifierConfig{
 				SubscribedEvents: []config.SubscribedEvent{
 					{
@@ -449,6 +452,10 @@ func GetGeneralConfig() config.Config {
 						Addresses:  []string{"erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"},
 					},
 				},
+				ValidatorSetRotation: config.ValidatorSetRotation{
+					Address:  "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th",
+					GasLimit: 20000000,
+				},
 			},
 			OutGoingBridge: config.OutGoingBridge{
 				Hasher: "sha256",
@@ -482,6 +489,32 @@ func GetGeneralConfig() config.Config {
 					MaxOpenFiles:      10,
 				},
 			},
+			OutGoingOperationsStorage: config.StorageConfig{
+				Cache: config.CacheConfig{
+					Type:     "LRU",
+					Capacity: 1000,
+				},
+				DB: config.DBConfig{
+					FilePath:          AddTimestampSuffix("OutGoingOperationsStorage"),
+					Type:              string(storageunit.MemoryDB),
+					BatchDelaySeconds: 5,
+					MaxBatchSize:      100,
+					MaxOpenFiles:      10,
+				},
+			},
+			IncomingHeadersQueueStorage: config.StorageConfig{
+				Cache: config.CacheConfig{
+					Type:     "LRU",
+					Capacity: 1000,
+				},
+				DB: config.DBConfig{
+					FilePath:          AddTimestampSuffix("IncomingHeadersQueueStorage"),
+					Type:              string(storageunit.MemoryDB),
+					BatchDelaySeconds: 5,
+					MaxBatchSize:      100,
+					MaxOpenFiles:      10,
+				},
+			},
 		},
 	}
 }
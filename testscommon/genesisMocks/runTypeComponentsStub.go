@@ -3,6 +3,7 @@ package genesisMocks
 import (
 	nodeFactory "github.com/multiversx/mx-chain-go/cmd/node/factory"
 	"github.com/multiversx/mx-chain-go/config"
+	sovereignBlock "github.com/multiversx/mx-chain-go/dataRetriever/dataPool/sovereign"
 	"github.com/multiversx/mx-chain-go/factory/block"
 	factoryVm "github.com/multiversx/mx-chain-go/factory/vm"
 	"github.com/multiversx/mx-chain-go/genesis"
@@ -22,6 +23,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/testscommon/marshallerMock"
+	sovereignMocks "github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/multiversx/mx-chain-go/testscommon/vmContext"
 	"github.com/multiversx/mx-chain-go/vm/systemSmartContracts"
 )
@@ -41,6 +43,7 @@ type RunTypeComponentsStub struct {
 	VmContainerMetaFactory                    factoryVm.VmContainerCreator
 	PreProcessorsContainerFactoryCreatorField data.PreProcessorsContainerFactoryCreator
 	VersionedHeaderFactoryField               genesis.VersionedHeaderFactory
+	OutGoingOperationsPool                    sovereignBlock.OutGoingOperationsPool
 }
 
 // NewRunTypeComponentsStub -
@@ -67,6 +70,7 @@ func NewRunTypeComponentsStub() *RunTypeComponentsStub {
 		VmContainerMetaFactory:                    vmContainerMeta,
 		PreProcessorsContainerFactoryCreatorField: shard.NewPreProcessorContainerFactoryCreator(),
 		VersionedHeaderFactoryField:               hdrFactory,
+		OutGoingOperationsPool:                    &sovereignMocks.OutGoingOperationsPoolMock{},
 	}
 }
 
@@ -75,7 +79,7 @@ func NewSovereignRunTypeComponentsStub() *RunTypeComponentsStub {
 	runTypeComponents := NewRunTypeComponentsStub()
 	transactionCoordinatorFactory, _ := coordinator.NewSovereignTransactionCoordinatorFactory(runTypeComponents.TransactionCoordinatorFactory)
 	scResultsPreProcessorCreator, _ := preprocess.NewSovereignSmartContractResultPreProcessorFactory(runTypeComponents.SCResultsPreProcessorFactory)
-	scProcessorCreator, _ := processorV2.NewSovereignSCProcessFactory(runTypeComponents.SCProcessorFactory)
+	scProcessorCreator, _ := processorV2.NewSovereignSCProcessFactory(runTypeComponents.SCProcessorFactory, nil)
 	accountsCreator, _ := factory.NewSovereignAccountCreator(factory.ArgsSovereignAccountCreator{
 		ArgsAccountCreator: factory.ArgsAccountCreator{
 			Hasher:              &hashingMocks.HasherMock{},
@@ -105,6 +109,7 @@ func NewSovereignRunTypeComponentsStub() *RunTypeComponentsStub {
 		VmContainerMetaFactory:                    sovVMContainerMeta,
 		PreProcessorsContainerFactoryCreatorField: sovereign.NewSovereignPreProcessorContainerFactoryCreator(),
 		VersionedHeaderFactoryField:               sovHdrFactory,
+		OutGoingOperationsPool:                    &sovereignMocks.OutGoingOperationsPoolMock{},
 	}
 }
 
@@ -181,6 +186,11 @@ func (r *RunTypeComponentsStub) PreProcessorsContainerFactoryCreator() data.PreP
 	return r.PreProcessorsContainerFactoryCreatorField
 }
 
+// OutGoingOperationsPoolHandler -
+func (r *RunTypeComponentsStub) OutGoingOperationsPoolHandler() sovereignBlock.OutGoingOperationsPool {
+	return r.OutGoingOperationsPool
+}
+
 // VersionedHeaderFactory  -
 func (r *RunTypeComponentsStub) VersionedHeaderFactory() genesis.VersionedHeaderFactory {
 	return r.VersionedHeaderFactoryField
@@ -74,6 +74,9 @@ type RunTypeComponentsStub struct {
 	OutGoingOperationsPool                      sovereignBlock.OutGoingOperationsPool
 	DataCodec                                   sovereign.DataCodecHandler
 	TopicsChecker                               sovereign.TopicsCheckerHandler
+	IncomingChains                              process.IncomingChainsHandler
+	MainChainValidators                         process.MainChainValidatorsHandler
+	IncomingChainsValidators                    map[string]process.MainChainValidatorsHandler
 	ShardCoordinatorFactory                     sharding.ShardCoordinatorFactory
 	NodesCoordinatorWithRaterFactory            nodesCoord.NodesCoordinatorWithRaterFactory
 	RequestersContainerFactory                  requesterscontainer.RequesterContainerFactoryCreator
@@ -133,6 +136,9 @@ func NewRunTypeComponentsStub() *RunTypeComponentsStub {
 		OutGoingOperationsPool:                      &sovereignMocks.OutGoingOperationsPoolMock{},
 		DataCodec:                                   &sovereignMocks.DataCodecMock{},
 		TopicsChecker:                               &sovereignMocks.TopicsCheckerMock{},
+		IncomingChains:                              &sovereignMocks.IncomingChainsHandlerMock{},
+		MainChainValidators:                         &sovereignMocks.MainChainValidatorsHandlerMock{},
+		IncomingChainsValidators:                    make(map[string]process.MainChainValidatorsHandler),
 		ShardCoordinatorFactory:                     &testscommon.MultiShardCoordinatorFactoryMock{},
 		NodesCoordinatorWithRaterFactory:            &testscommon.NodesCoordinatorFactoryMock{},
 		RequestersContainerFactory:                  &testFactory.RequestersContainerFactoryMock{},
@@ -302,6 +308,21 @@ func (r *RunTypeComponentsStub) TopicsCheckerHandler() sovereign.TopicsCheckerHa
 	return r.TopicsChecker
 }
 
+// IncomingChainsHandler -
+func (r *RunTypeComponentsStub) IncomingChainsHandler() process.IncomingChainsHandler {
+	return r.IncomingChains
+}
+
+// MainChainValidatorsHandler -
+func (r *RunTypeComponentsStub) MainChainValidatorsHandler() process.MainChainValidatorsHandler {
+	return r.MainChainValidators
+}
+
+// IncomingChainsValidatorsHandlers -
+func (r *RunTypeComponentsStub) IncomingChainsValidatorsHandlers() map[string]process.MainChainValidatorsHandler {
+	return r.IncomingChainsValidators
+}
+
 // ShardCoordinatorCreator -
 func (r *RunTypeComponentsStub) ShardCoordinatorCreator() sharding.ShardCoordinatorFactory {
 	return r.ShardCoordinatorFactory
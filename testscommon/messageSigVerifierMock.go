@@ -2,10 +2,15 @@ package testscommon
 
 // MessageSignVerifierMock -
 type MessageSignVerifierMock struct {
+	VerifyCalled func(message []byte, signedMessage []byte, pubKey []byte) error
 }
 
 // Verify -
-func (m *MessageSignVerifierMock) Verify(_ []byte, _ []byte, _ []byte) error {
+func (m *MessageSignVerifierMock) Verify(message []byte, signedMessage []byte, pubKey []byte) error {
+	if m.VerifyCalled != nil {
+		return m.VerifyCalled(message, signedMessage, pubKey)
+	}
+
 	return nil
 }
 
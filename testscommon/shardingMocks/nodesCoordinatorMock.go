@@ -31,6 +31,7 @@ type NodesCoordinatorMock struct {
 	GetShuffledOutToAuctionValidatorsPublicKeysCalled func(epoch uint32) (map[uint32][][]byte, error)
 	GetNumTotalEligibleCalled                         func() uint64
 	NodesCoordinatorToRegistryCalled                  func(epoch uint32) nodesCoordinator.NodesCoordinatorRegistryHandler
+	ComputeNextEpochEligiblePublicKeysCalled          func(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error)
 }
 
 // NewNodesCoordinatorMock -
@@ -321,6 +322,15 @@ func (ncm *NodesCoordinatorMock) NodesCoordinatorToRegistry(epoch uint32) nodesC
 	return nil
 }
 
+// ComputeNextEpochEligiblePublicKeys -
+func (ncm *NodesCoordinatorMock) ComputeNextEpochEligiblePublicKeys(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error) {
+	if ncm.ComputeNextEpochEligiblePublicKeysCalled != nil {
+		return ncm.ComputeNextEpochEligiblePublicKeysCalled(hdr, body)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (ncm *NodesCoordinatorMock) IsInterfaceNil() bool {
 	return ncm == nil
@@ -22,6 +22,7 @@ type NodesCoordinatorStub struct {
 	GetOwnPublicKeyCalled                    func() []byte
 	GetWaitingEpochsLeftForPublicKeyCalled   func(publicKey []byte) (uint32, error)
 	GetNumTotalEligibleCalled                func() uint64
+	ComputeNextEpochEligiblePublicKeysCalled func(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error)
 }
 
 // NodesCoordinatorToRegistry -
@@ -210,6 +211,15 @@ func (ncm *NodesCoordinatorStub) GetWaitingEpochsLeftForPublicKey(publicKey []by
 	return 0, nil
 }
 
+// ComputeNextEpochEligiblePublicKeys -
+func (ncm *NodesCoordinatorStub) ComputeNextEpochEligiblePublicKeys(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error) {
+	if ncm.ComputeNextEpochEligiblePublicKeysCalled != nil {
+		return ncm.ComputeNextEpochEligiblePublicKeysCalled(hdr, body)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ncm *NodesCoordinatorStub) IsInterfaceNil() bool {
 	return ncm == nil
@@ -0,0 +1,30 @@
+package sovereign
+
+// BridgePauseHandlerMock -
+type BridgePauseHandlerMock struct {
+	IsIncomingPausedCalled func() bool
+	IsOutGoingPausedCalled func() bool
+}
+
+// IsIncomingPaused -
+func (mock *BridgePauseHandlerMock) IsIncomingPaused() bool {
+	if mock.IsIncomingPausedCalled != nil {
+		return mock.IsIncomingPausedCalled()
+	}
+
+	return false
+}
+
+// IsOutGoingPaused -
+func (mock *BridgePauseHandlerMock) IsOutGoingPaused() bool {
+	if mock.IsOutGoingPausedCalled != nil {
+		return mock.IsOutGoingPausedCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (mock *BridgePauseHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
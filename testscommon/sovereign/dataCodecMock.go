@@ -10,6 +10,7 @@ type DataCodecMock struct {
 	DeserializeTokenDataCalled func(data []byte) (*sovereign.EsdtTokenData, error)
 	GetTokenDataBytesCalled    func(tokenNonce []byte, tokenData []byte) ([]byte, error)
 	SerializeOperationCalled   func(operation sovereign.Operation) ([]byte, error)
+	DeserializeOperationCalled func(data []byte) (*sovereign.Operation, error)
 }
 
 // SerializeEventData -
@@ -66,6 +67,15 @@ func (dcm *DataCodecMock) SerializeOperation(operation sovereign.Operation) ([]b
 	return make([]byte, 0), nil
 }
 
+// DeserializeOperation -
+func (dcm *DataCodecMock) DeserializeOperation(data []byte) (*sovereign.Operation, error) {
+	if dcm.DeserializeOperationCalled != nil {
+		return dcm.DeserializeOperationCalled(data)
+	}
+
+	return &sovereign.Operation{}, nil
+}
+
 // IsInterfaceNil -
 func (dcm *DataCodecMock) IsInterfaceNil() bool {
 	return dcm == nil
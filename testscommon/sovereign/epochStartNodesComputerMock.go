@@ -0,0 +1,22 @@
+package sovereign
+
+import "github.com/multiversx/mx-chain-core-go/data"
+
+// EpochStartNodesComputerMock -
+type EpochStartNodesComputerMock struct {
+	ComputeNextEpochEligiblePublicKeysCalled func(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error)
+}
+
+// ComputeNextEpochEligiblePublicKeys -
+func (mock *EpochStartNodesComputerMock) ComputeNextEpochEligiblePublicKeys(hdr data.HeaderHandler, body data.BodyHandler) ([][]byte, error) {
+	if mock.ComputeNextEpochEligiblePublicKeysCalled != nil {
+		return mock.ComputeNextEpochEligiblePublicKeysCalled(hdr, body)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (mock *EpochStartNodesComputerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,22 @@
+package sovereign
+
+import "github.com/multiversx/mx-chain-core-go/data/sovereign"
+
+// EventsProofVerifierMock -
+type EventsProofVerifierMock struct {
+	VerifyEventsProofsCalled func(header sovereign.IncomingHeaderHandler) error
+}
+
+// VerifyEventsProofs -
+func (epv *EventsProofVerifierMock) VerifyEventsProofs(header sovereign.IncomingHeaderHandler) error {
+	if epv.VerifyEventsProofsCalled != nil {
+		return epv.VerifyEventsProofsCalled(header)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (epv *EventsProofVerifierMock) IsInterfaceNil() bool {
+	return epv == nil
+}
@@ -7,8 +7,12 @@ import (
 
 // IncomingHeaderSubscriberStub -
 type IncomingHeaderSubscriberStub struct {
-	AddHeaderCalled            func(headerHash []byte, header sovereign.IncomingHeaderHandler) error
-	CreateExtendedHeaderCalled func(header sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error)
+	AddHeaderCalled                func(headerHash []byte, header sovereign.IncomingHeaderHandler) error
+	RevertHeaderCalled             func(headerHash []byte) error
+	FinalizeHeaderCalled           func(headerHash []byte) error
+	CreateExtendedHeaderCalled     func(header sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error)
+	GetIncomingHeadersQueuesCalled func() (map[uint32][]byte, error)
+	SetIncomingHeadersQueuesCalled func(queues map[uint32][]byte) error
 }
 
 // AddHeader -
@@ -20,6 +24,24 @@ func (ihs *IncomingHeaderSubscriberStub) AddHeader(headerHash []byte, header sov
 	return nil
 }
 
+// RevertHeader -
+func (ihs *IncomingHeaderSubscriberStub) RevertHeader(headerHash []byte) error {
+	if ihs.RevertHeaderCalled != nil {
+		return ihs.RevertHeaderCalled(headerHash)
+	}
+
+	return nil
+}
+
+// FinalizeHeader -
+func (ihs *IncomingHeaderSubscriberStub) FinalizeHeader(headerHash []byte) error {
+	if ihs.FinalizeHeaderCalled != nil {
+		return ihs.FinalizeHeaderCalled(headerHash)
+	}
+
+	return nil
+}
+
 // CreateExtendedHeader -
 func (ihs *IncomingHeaderSubscriberStub) CreateExtendedHeader(header sovereign.IncomingHeaderHandler) (data.ShardHeaderExtendedHandler, error) {
 	if ihs.CreateExtendedHeaderCalled != nil {
@@ -29,6 +51,24 @@ func (ihs *IncomingHeaderSubscriberStub) CreateExtendedHeader(header sovereign.I
 	return nil, nil
 }
 
+// GetIncomingHeadersQueues -
+func (ihs *IncomingHeaderSubscriberStub) GetIncomingHeadersQueues() (map[uint32][]byte, error) {
+	if ihs.GetIncomingHeadersQueuesCalled != nil {
+		return ihs.GetIncomingHeadersQueuesCalled()
+	}
+
+	return make(map[uint32][]byte), nil
+}
+
+// SetIncomingHeadersQueues -
+func (ihs *IncomingHeaderSubscriberStub) SetIncomingHeadersQueues(queues map[uint32][]byte) error {
+	if ihs.SetIncomingHeadersQueuesCalled != nil {
+		return ihs.SetIncomingHeadersQueuesCalled(queues)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (ihs *IncomingHeaderSubscriberStub) IsInterfaceNil() bool {
 	return ihs == nil
@@ -0,0 +1,87 @@
+package sovereign
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+// IncomingChainsHandlerMock -
+type IncomingChainsHandlerMock struct {
+	GetShardIDsCalled               func() []uint32
+	GetShardIDForHeaderCalled       func(header data.HeaderHandler) (uint32, error)
+	GetShardIDForChainIDCalled      func(chainID string) (uint32, bool)
+	GetNotarizationStartRoundCalled func(shardID uint32) uint64
+	GetConfirmationDepthCalled      func(shardID uint32) uint64
+	GetNonceHashDataUnitCalled      func(shardID uint32) dataRetriever.UnitType
+	IsIncomingChainShardCalled      func(shardID uint32) bool
+}
+
+// GetShardIDs -
+func (mock *IncomingChainsHandlerMock) GetShardIDs() []uint32 {
+	if mock.GetShardIDsCalled != nil {
+		return mock.GetShardIDsCalled()
+	}
+
+	return []uint32{core.MainChainShardId}
+}
+
+// GetShardIDForHeader -
+func (mock *IncomingChainsHandlerMock) GetShardIDForHeader(header data.HeaderHandler) (uint32, error) {
+	if mock.GetShardIDForHeaderCalled != nil {
+		return mock.GetShardIDForHeaderCalled(header)
+	}
+
+	return core.MainChainShardId, nil
+}
+
+// GetShardIDForChainID -
+func (mock *IncomingChainsHandlerMock) GetShardIDForChainID(chainID string) (uint32, bool) {
+	if mock.GetShardIDForChainIDCalled != nil {
+		return mock.GetShardIDForChainIDCalled(chainID)
+	}
+
+	return core.MainChainShardId, len(chainID) == 0
+}
+
+// GetNotarizationStartRound -
+func (mock *IncomingChainsHandlerMock) GetNotarizationStartRound(shardID uint32) uint64 {
+	if mock.GetNotarizationStartRoundCalled != nil {
+		return mock.GetNotarizationStartRoundCalled(shardID)
+	}
+
+	return 0
+}
+
+// GetConfirmationDepth -
+func (mock *IncomingChainsHandlerMock) GetConfirmationDepth(shardID uint32) uint64 {
+	if mock.GetConfirmationDepthCalled != nil {
+		return mock.GetConfirmationDepthCalled(shardID)
+	}
+
+	return 0
+}
+
+// GetNonceHashDataUnit -
+func (mock *IncomingChainsHandlerMock) GetNonceHashDataUnit(shardID uint32) dataRetriever.UnitType {
+	if mock.GetNonceHashDataUnitCalled != nil {
+		return mock.GetNonceHashDataUnitCalled(shardID)
+	}
+
+	return dataRetriever.ExtendedShardHeadersNonceHashDataUnit
+}
+
+// IsIncomingChainShard -
+func (mock *IncomingChainsHandlerMock) IsIncomingChainShard(shardID uint32) bool {
+	if mock.IsIncomingChainShardCalled != nil {
+		return mock.IsIncomingChainShardCalled(shardID)
+	}
+
+	return shardID == core.MainChainShardId
+}
+
+// IsInterfaceNil -
+func (mock *IncomingChainsHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -0,0 +1,28 @@
+package sovereign
+
+// MainChainValidatorsHandlerMock -
+type MainChainValidatorsHandlerMock struct {
+	SetEpochEligiblePublicKeysCalled func(epoch uint32, eligiblePubKeys map[uint32][][]byte) error
+	SetValidatorsRatingsCalled       func(ratings map[string]uint32)
+}
+
+// SetEpochEligiblePublicKeys -
+func (mock *MainChainValidatorsHandlerMock) SetEpochEligiblePublicKeys(epoch uint32, eligiblePubKeys map[uint32][][]byte) error {
+	if mock.SetEpochEligiblePublicKeysCalled != nil {
+		return mock.SetEpochEligiblePublicKeysCalled(epoch, eligiblePubKeys)
+	}
+
+	return nil
+}
+
+// SetValidatorsRatings -
+func (mock *MainChainValidatorsHandlerMock) SetValidatorsRatings(ratings map[string]uint32) {
+	if mock.SetValidatorsRatingsCalled != nil {
+		mock.SetValidatorsRatingsCalled(ratings)
+	}
+}
+
+// IsInterfaceNil -
+func (mock *MainChainValidatorsHandlerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
@@ -1,15 +1,31 @@
 package sovereign
 
-import "github.com/multiversx/mx-chain-core-go/data/sovereign"
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/storage"
+)
 
 // OutGoingOperationsPoolMock -
 type OutGoingOperationsPoolMock struct {
-	AddCalled                      func(data *sovereign.BridgeOutGoingData)
-	GetCalled                      func(hash []byte) *sovereign.BridgeOutGoingData
-	DeleteCalled                   func(hash []byte)
-	GetUnconfirmedOperationsCalled func() []*sovereign.BridgeOutGoingData
-	ConfirmOperationCalled         func(hashOfHashes []byte, hash []byte) error
-	ResetTimerCalled               func(hashes [][]byte)
+	AddCalled                           func(data *sovereign.BridgeOutGoingData)
+	AddWithDestinationCalled            func(data *sovereign.BridgeOutGoingData, destination string)
+	GetDestinationCalled                func(hash []byte) string
+	GetCalled                           func(hash []byte) *sovereign.BridgeOutGoingData
+	GetByOperationHashCalled            func(hash []byte) *sovereign.BridgeOutGoingData
+	SetSignaturesCalled                 func(hash []byte, leaderSignature []byte, aggregatedSignature []byte) error
+	DeleteCalled                        func(hash []byte)
+	GetUnconfirmedOperationsCalled      func() []*sovereign.BridgeOutGoingData
+	ConfirmOperationCalled              func(hashOfHashes []byte, hash []byte) error
+	ProcessUnconfirmedOperationsCalled  func(currentTime time.Time)
+	GetDeadLetteredOperationsCalled     func() []*sovereign.BridgeOutGoingData
+	GetPendingOperationsCalled          func() map[string][]*sovereign.BridgeOutGoingData
+	RequeueDeadLetteredOperationsCalled func(hash []byte) error
+	DropDeadLetteredOperationsCalled    func(hash []byte) error
+	SetStorerCalled                     func(storer storage.Storer, marshaller marshal.Marshalizer) error
 }
 
 // Add -
@@ -19,6 +35,21 @@ func (mock *OutGoingOperationsPoolMock) Add(data *sovereign.BridgeOutGoingData)
 	}
 }
 
+// AddWithDestination -
+func (mock *OutGoingOperationsPoolMock) AddWithDestination(data *sovereign.BridgeOutGoingData, destination string) {
+	if mock.AddWithDestinationCalled != nil {
+		mock.AddWithDestinationCalled(data, destination)
+	}
+}
+
+// GetDestination -
+func (mock *OutGoingOperationsPoolMock) GetDestination(hash []byte) string {
+	if mock.GetDestinationCalled != nil {
+		return mock.GetDestinationCalled(hash)
+	}
+	return ""
+}
+
 // Get -
 func (mock *OutGoingOperationsPoolMock) Get(hash []byte) *sovereign.BridgeOutGoingData {
 	if mock.GetCalled != nil {
@@ -27,6 +58,22 @@ func (mock *OutGoingOperationsPoolMock) Get(hash []byte) *sovereign.BridgeOutGoi
 	return nil
 }
 
+// GetByOperationHash -
+func (mock *OutGoingOperationsPoolMock) GetByOperationHash(hash []byte) *sovereign.BridgeOutGoingData {
+	if mock.GetByOperationHashCalled != nil {
+		return mock.GetByOperationHashCalled(hash)
+	}
+	return nil
+}
+
+// SetSignatures -
+func (mock *OutGoingOperationsPoolMock) SetSignatures(hash []byte, leaderSignature []byte, aggregatedSignature []byte) error {
+	if mock.SetSignaturesCalled != nil {
+		return mock.SetSignaturesCalled(hash, leaderSignature, aggregatedSignature)
+	}
+	return nil
+}
+
 // Delete -
 func (mock *OutGoingOperationsPoolMock) Delete(hash []byte) {
 	if mock.DeleteCalled != nil {
@@ -50,11 +97,51 @@ func (mock *OutGoingOperationsPoolMock) ConfirmOperation(hashOfHashes []byte, ha
 	return nil
 }
 
-// ResetTimer -
-func (mock *OutGoingOperationsPoolMock) ResetTimer(hashes [][]byte) {
-	if mock.ResetTimerCalled != nil {
-		mock.ResetTimerCalled(hashes)
+// ProcessUnconfirmedOperations -
+func (mock *OutGoingOperationsPoolMock) ProcessUnconfirmedOperations(currentTime time.Time) {
+	if mock.ProcessUnconfirmedOperationsCalled != nil {
+		mock.ProcessUnconfirmedOperationsCalled(currentTime)
+	}
+}
+
+// GetDeadLetteredOperations -
+func (mock *OutGoingOperationsPoolMock) GetDeadLetteredOperations() []*sovereign.BridgeOutGoingData {
+	if mock.GetDeadLetteredOperationsCalled != nil {
+		return mock.GetDeadLetteredOperationsCalled()
+	}
+	return nil
+}
+
+// GetPendingOperations -
+func (mock *OutGoingOperationsPoolMock) GetPendingOperations() map[string][]*sovereign.BridgeOutGoingData {
+	if mock.GetPendingOperationsCalled != nil {
+		return mock.GetPendingOperationsCalled()
+	}
+	return nil
+}
+
+// RequeueDeadLetteredOperations -
+func (mock *OutGoingOperationsPoolMock) RequeueDeadLetteredOperations(hash []byte) error {
+	if mock.RequeueDeadLetteredOperationsCalled != nil {
+		return mock.RequeueDeadLetteredOperationsCalled(hash)
 	}
+	return nil
+}
+
+// DropDeadLetteredOperations -
+func (mock *OutGoingOperationsPoolMock) DropDeadLetteredOperations(hash []byte) error {
+	if mock.DropDeadLetteredOperationsCalled != nil {
+		return mock.DropDeadLetteredOperationsCalled(hash)
+	}
+	return nil
+}
+
+// SetStorer -
+func (mock *OutGoingOperationsPoolMock) SetStorer(storer storage.Storer, marshaller marshal.Marshalizer) error {
+	if mock.SetStorerCalled != nil {
+		return mock.SetStorerCalled(storer, marshaller)
+	}
+	return nil
 }
 
 // IsInterfaceNil -
@@ -4,16 +4,16 @@ import "github.com/multiversx/mx-chain-core-go/data"
 
 // OutgoingOperationsFormatterMock -
 type OutgoingOperationsFormatterMock struct {
-	CreateOutgoingTxDataCalled func(logs []*data.LogData) ([][]byte, error)
+	CreateOutgoingTxDataCalled func(logs []*data.LogData) ([][][]byte, error)
 }
 
 // CreateOutgoingTxsData -
-func (stub *OutgoingOperationsFormatterMock) CreateOutgoingTxsData(logs []*data.LogData) ([][]byte, error) {
+func (stub *OutgoingOperationsFormatterMock) CreateOutgoingTxsData(logs []*data.LogData) ([][][]byte, error) {
 	if stub.CreateOutgoingTxDataCalled != nil {
 		return stub.CreateOutgoingTxDataCalled(logs)
 	}
 
-	return make([][]byte, 0), nil
+	return make([][][]byte, 0), nil
 }
 
 // IsInterfaceNil -
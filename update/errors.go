@@ -298,3 +298,12 @@ var ErrNilEnableEpochsHandler = errors.New("nil enable epochs handler")
 
 // ErrNilNetworkComponents signals that a nil network components instance was provided
 var ErrNilNetworkComponents = errors.New("nil network components")
+
+// ErrNilOutGoingOperationsPool signals that a nil outgoing operations pool was provided
+var ErrNilOutGoingOperationsPool = errors.New("nil outgoing operations pool")
+
+// ErrNilIncomingHeadersQueuesHandler signals that a nil incoming headers queues handler was provided
+var ErrNilIncomingHeadersQueuesHandler = errors.New("nil incoming headers queues handler")
+
+// ErrDuplicatedExtendedHeader signals that more than one extended header was provided for the same incoming chain
+var ErrDuplicatedExtendedHeader = errors.New("duplicated extended header for incoming chain")
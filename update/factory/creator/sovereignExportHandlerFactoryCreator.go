@@ -3,7 +3,7 @@ package creator
 import (
 	mxFactory "github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/update"
-	"github.com/multiversx/mx-chain-go/update/disabled"
+	"github.com/multiversx/mx-chain-go/update/factory"
 )
 
 type sovereignExportHandlerFactoryCreator struct {
@@ -14,9 +14,9 @@ func NewSovereignExportHandlerFactoryCreator() *sovereignExportHandlerFactoryCre
 	return &sovereignExportHandlerFactoryCreator{}
 }
 
-// CreateExportFactoryHandler creates a disabled export factory handler
-func (f *sovereignExportHandlerFactoryCreator) CreateExportFactoryHandler(_ mxFactory.ArgsExporter) (update.ExportFactoryHandler, error) {
-	return &disabled.ExportFactoryHandler{}, nil
+// CreateExportFactoryHandler creates a sovereign export factory handler
+func (f *sovereignExportHandlerFactoryCreator) CreateExportFactoryHandler(args mxFactory.ArgsExporter) (update.ExportFactoryHandler, error) {
+	return factory.NewSovereignExportHandlerFactory(args)
 }
 
 // IsInterfaceNil checks if the underlying pointer is nil
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
@@ -244,7 +245,7 @@ func NewExportHandlerFactory(args mxFactory.ArgsExporter) (*exportHandlerFactory
 
 // Create makes a new export handler
 func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
-	err := e.prepareFolders(e.exportFolder)
+	err := prepareFolders(e.exportFolder)
 	if err != nil {
 		return nil, err
 	}
@@ -459,39 +460,16 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		return nil, err
 	}
 
-	var keysStorer storage.Storer
-	var keysVals storage.Storer
-
+	hs, err := createHardforkStorer(e.exportStateKeysConfig, e.exportStateStorageConfig, e.exportFolder, e.coreComponents.InternalMarshalizer())
+	if err != nil {
+		return nil, err
+	}
 	defer func() {
 		if err != nil {
-			if !check.IfNil(keysStorer) {
-				_ = keysStorer.Close()
-			}
-			if !check.IfNil(keysVals) {
-				_ = keysVals.Close()
-			}
+			_ = hs.Close()
 		}
 	}()
 
-	keysStorer, err = createStorer(e.exportStateKeysConfig, e.exportFolder)
-	if err != nil {
-		return nil, fmt.Errorf("%w while creating keys storer", err)
-	}
-	keysVals, err = createStorer(e.exportStateStorageConfig, e.exportFolder)
-	if err != nil {
-		return nil, fmt.Errorf("%w while creating keys-values storer", err)
-	}
-
-	arg := storing.ArgHardforkStorer{
-		KeysStore:   keysStorer,
-		KeyValue:    keysVals,
-		Marshalizer: e.coreComponents.InternalMarshalizer(),
-	}
-	hs, err := storing.NewHardforkStorer(arg)
-	if err != nil {
-		return nil, fmt.Errorf("%w while creating hardfork storer", err)
-	}
-
 	argsExporter := genesis.ArgsNewStateExporter{
 		ShardCoordinator:         e.shardCoordinator,
 		StateSyncer:              stateSyncer,
@@ -526,7 +504,7 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 	return exportHandler, nil
 }
 
-func (e *exportHandlerFactory) prepareFolders(folder string) error {
+func prepareFolders(folder string) error {
 	err := os.RemoveAll(folder)
 	if err != nil {
 		return err
@@ -577,6 +555,49 @@ func (e *exportHandlerFactory) createInterceptors() error {
 	return nil
 }
 
+func createHardforkStorer(
+	keysConfig config.StorageConfig,
+	stateConfig config.StorageConfig,
+	folder string,
+	marshaller marshal.Marshalizer,
+) (update.HardforkStorer, error) {
+	var err error
+	var keysStorer storage.Storer
+	var keysVals storage.Storer
+
+	defer func() {
+		if err != nil {
+			if !check.IfNil(keysStorer) {
+				_ = keysStorer.Close()
+			}
+			if !check.IfNil(keysVals) {
+				_ = keysVals.Close()
+			}
+		}
+	}()
+
+	keysStorer, err = createStorer(keysConfig, folder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys storer", err)
+	}
+	keysVals, err = createStorer(stateConfig, folder)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating keys-values storer", err)
+	}
+
+	arg := storing.ArgHardforkStorer{
+		KeysStore:   keysStorer,
+		KeyValue:    keysVals,
+		Marshalizer: marshaller,
+	}
+	hs, err := storing.NewHardforkStorer(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating hardfork storer", err)
+	}
+
+	return hs, nil
+}
+
 func createStorer(storageConfig config.StorageConfig, folder string) (storage.Storer, error) {
 	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
 	dbConfig.FilePath = path.Join(folder, storageConfig.DB.FilePath)
@@ -0,0 +1,122 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	mxFactory "github.com/multiversx/mx-chain-go/factory"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/update"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/multiversx/mx-chain-go/update/sync"
+)
+
+type sovereignExportHandlerFactory struct {
+	coreComponents           process.CoreComponentsHolder
+	storageService           dataRetriever.StorageService
+	shardCoordinator         sharding.Coordinator
+	activeAccountsDBs        map[state.AccountsDbIdentifier]state.AccountsAdapter
+	outGoingOperationsPool   update.OutGoingOperationsPool
+	incomingHeadersQueues    update.IncomingHeadersQueuesHandler
+	exportFolder             string
+	exportStateStorageConfig config.StorageConfig
+	exportStateKeysConfig    config.StorageConfig
+}
+
+// NewSovereignExportHandlerFactory creates a sovereign exporter factory. The state of a sovereign chain is exported
+// from the local storage, so no network syncing components are needed
+func NewSovereignExportHandlerFactory(args mxFactory.ArgsExporter) (*sovereignExportHandlerFactory, error) {
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, update.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.CoreComponents) {
+		return nil, update.ErrNilCoreComponents
+	}
+	if check.IfNil(args.CoreComponents.Hasher()) {
+		return nil, update.ErrNilHasher
+	}
+	if check.IfNil(args.CoreComponents.InternalMarshalizer()) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.CoreComponents.AddressPubKeyConverter()) {
+		return nil, update.ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.CoreComponents.ValidatorPubKeyConverter()) {
+		return nil, update.ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, update.ErrNilStorage
+	}
+	if args.ActiveAccountsDBs == nil {
+		return nil, update.ErrNilAccounts
+	}
+	if check.IfNil(args.OutGoingOperationsPool) {
+		return nil, update.ErrNilOutGoingOperationsPool
+	}
+	if check.IfNil(args.IncomingHeadersQueues) {
+		return nil, update.ErrNilIncomingHeadersQueuesHandler
+	}
+
+	return &sovereignExportHandlerFactory{
+		coreComponents:           args.CoreComponents,
+		storageService:           args.StorageService,
+		shardCoordinator:         args.ShardCoordinator,
+		activeAccountsDBs:        args.ActiveAccountsDBs,
+		outGoingOperationsPool:   args.OutGoingOperationsPool,
+		incomingHeadersQueues:    args.IncomingHeadersQueues,
+		exportFolder:             args.ExportFolder,
+		exportStateStorageConfig: args.ExportStateStorageConfig,
+		exportStateKeysConfig:    args.ExportStateKeysConfig,
+	}, nil
+}
+
+// Create makes a new sovereign export handler
+func (e *sovereignExportHandlerFactory) Create() (update.ExportHandler, error) {
+	err := prepareFolders(e.exportFolder)
+	if err != nil {
+		return nil, err
+	}
+
+	stateSyncer, err := sync.NewSovereignSyncState(sync.ArgsNewSovereignSyncState{
+		Marshaller:        e.coreComponents.InternalMarshalizer(),
+		StorageService:    e.storageService,
+		ActiveAccountsDBs: e.activeAccountsDBs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hs, err := createHardforkStorer(e.exportStateKeysConfig, e.exportStateStorageConfig, e.exportFolder, e.coreComponents.InternalMarshalizer())
+	if err != nil {
+		return nil, err
+	}
+
+	argsExporter := genesis.ArgsNewSovereignStateExporter{
+		ShardCoordinator:         e.shardCoordinator,
+		StateSyncer:              stateSyncer,
+		Marshalizer:              e.coreComponents.InternalMarshalizer(),
+		Hasher:                   e.coreComponents.Hasher(),
+		HardforkStorer:           hs,
+		ExportFolder:             e.exportFolder,
+		AddressPubKeyConverter:   e.coreComponents.AddressPubKeyConverter(),
+		ValidatorPubKeyConverter: e.coreComponents.ValidatorPubKeyConverter(),
+		GenesisNodesSetupHandler: e.coreComponents.GenesisNodesSetup(),
+		OutGoingOperationsPool:   e.outGoingOperationsPool,
+		IncomingHeadersQueues:    e.incomingHeadersQueues,
+	}
+	exportHandler, err := genesis.NewSovereignStateExporter(argsExporter)
+	if err != nil {
+		_ = hs.Close()
+		return nil, err
+	}
+
+	return exportHandler, nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (e *sovereignExportHandlerFactory) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -6,10 +6,12 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/update"
 )
@@ -32,6 +34,33 @@ const MiniBlocksIdentifier = "miniBlocks"
 // TrieIdentifier is the constant which defines the export/import identifier for tries
 const TrieIdentifier = "trie"
 
+// EpochStartSovereignHeaderIdentifier is the constant which defines the export/import identifier for epoch start sovereign header
+const EpochStartSovereignHeaderIdentifier = "epochStartSovereignHeader"
+
+// LastFinalizedExtendedHeaderIdentifier is the constant which defines the export/import identifier for the last
+// finalized extended headers of the incoming chains of a sovereign chain
+const LastFinalizedExtendedHeaderIdentifier = "lastFinalizedExtendedHeader"
+
+// IncomingHeadersQueuesIdentifier is the constant which defines the export/import identifier for the queues of
+// incoming headers waiting for confirmations of a sovereign chain
+const IncomingHeadersQueuesIdentifier = "incomingHeadersQueues"
+
+// PendingOutGoingOperationsIdentifier is the constant which defines the export/import identifier for the pending
+// outgoing operations of a sovereign chain
+const PendingOutGoingOperationsIdentifier = "pendingOutGoingOperations"
+
+// PendingOutGoingOperations holds the exported outgoing operations which were not yet confirmed by the main chain
+type PendingOutGoingOperations struct {
+	Destination string                        `json:"destination"`
+	Data        *sovereign.BridgeOutGoingData `json:"data"`
+}
+
+// IncomingHeadersQueue holds the exported queue of incoming headers waiting for confirmations of an incoming chain
+type IncomingHeadersQueue struct {
+	ShardID uint32 `json:"shardID"`
+	Queue   []byte `json:"queue"`
+}
+
 // Type identifies the type of the export / import
 type Type uint8
 
@@ -58,6 +87,14 @@ const (
 	ValidatorAccount
 	// DataTrie identifies the data trie kept under a specific account
 	DataTrie
+	// SovereignHeader is the export/import type for sovereign headers
+	SovereignHeader
+	// ExtendedShardHeader is the export/import type for extended shard headers
+	ExtendedShardHeader
+	// OutGoingOperations is the export/import type for pending outgoing operations
+	OutGoingOperations
+	// IncomingHeadersQueues is the export/import type for the queues of incoming headers waiting for confirmations
+	IncomingHeadersQueues
 )
 
 // atSep is a separator used for export and import to decipher needed types
@@ -80,6 +117,14 @@ func NewObject(objType Type) (interface{}, error) {
 		return &block.Header{}, nil
 	case MetaHeader:
 		return &block.MetaBlock{}, nil
+	case SovereignHeader:
+		return &block.SovereignChainHeader{}, nil
+	case ExtendedShardHeader:
+		return &block.ShardHeaderExtended{}, nil
+	case OutGoingOperations:
+		return &PendingOutGoingOperations{}, nil
+	case IncomingHeadersQueues:
+		return &IncomingHeadersQueue{}, nil
 	case RootHash:
 		return make([]byte, 0), nil
 	}
@@ -157,9 +202,17 @@ func GetKeyTypeAndHash(key string) (Type, []byte, error) {
 
 	switch splitString[0] {
 	case "meta":
-		return getHeaderTypeAndHash(splitString)
+		return getHeaderTypeAndHash(splitString, MetaHeader)
+	case "sov":
+		return getHeaderTypeAndHash(splitString, SovereignHeader)
+	case "ext":
+		return getHeaderTypeAndHash(splitString, ExtendedShardHeader)
 	case "mb":
 		return getMbTypeAndHash(splitString)
+	case "op":
+		return getOutGoingOperationsTypeAndHash(splitString)
+	case "iq":
+		return IncomingHeadersQueues, []byte(splitString[1]), nil
 	case "tx":
 		return getTransactionKeyTypeAndHash(splitString[1:])
 	case "tr":
@@ -171,7 +224,7 @@ func GetKeyTypeAndHash(key string) (Type, []byte, error) {
 	return Unknown, nil, update.ErrUnknownType
 }
 
-func getHeaderTypeAndHash(splitString []string) (Type, []byte, error) {
+func getHeaderTypeAndHash(splitString []string, headerType Type) (Type, []byte, error) {
 	if len(splitString) < 3 {
 		return Unknown, nil, update.ErrUnknownType
 	}
@@ -181,7 +234,7 @@ func getHeaderTypeAndHash(splitString []string) (Type, []byte, error) {
 		return Unknown, nil, err
 	}
 
-	return MetaHeader, hash, nil
+	return headerType, hash, nil
 }
 
 func getMbTypeAndHash(splitString []string) (Type, []byte, error) {
@@ -193,11 +246,56 @@ func getMbTypeAndHash(splitString []string) (Type, []byte, error) {
 	return MiniBlock, hash, nil
 }
 
+func getOutGoingOperationsTypeAndHash(splitString []string) (Type, []byte, error) {
+	hash, err := hex.DecodeString(splitString[1])
+	if err != nil {
+		return Unknown, nil, err
+	}
+
+	return OutGoingOperations, hash, nil
+}
+
 // CreateVersionKey creates a version key from the given metaBlock
 func CreateVersionKey(meta data.HeaderHandler, hash []byte) string {
 	return "meta" + atSep + string(meta.GetChainID()) + atSep + hex.EncodeToString(hash)
 }
 
+// CreateSovereignHeaderKey creates a key from the given sovereign header
+func CreateSovereignHeaderKey(header data.HeaderHandler, hash []byte) string {
+	return "sov" + atSep + string(header.GetChainID()) + atSep + hex.EncodeToString(hash)
+}
+
+// CreateExtendedHeaderKey creates a key from the given extended shard header of the incoming chain with the given shard ID
+func CreateExtendedHeaderKey(shardID uint32, header data.HeaderHandler, hash []byte) string {
+	return "ext" + atSep + string(header.GetChainID()) + atSep + hex.EncodeToString(hash) + atSep + strconv.FormatUint(uint64(shardID), 10)
+}
+
+// GetExtendedHeaderKeyShardID returns the shard ID of the incoming chain from the given extended shard header key. Keys
+// exported before multiple incoming chains were supported belong to the main chain.
+func GetExtendedHeaderKeyShardID(key string) (uint32, error) {
+	splitString := strings.Split(key, atSep)
+	if len(splitString) < 4 {
+		return core.MainChainShardId, nil
+	}
+
+	shardID, err := strconv.ParseUint(splitString[3], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(shardID), nil
+}
+
+// CreateIncomingHeadersQueueKey creates a key for the incoming headers queue of the incoming chain with the given shard ID
+func CreateIncomingHeadersQueueKey(shardID uint32) string {
+	return "iq" + atSep + strconv.FormatUint(uint64(shardID), 10)
+}
+
+// CreateOutGoingOperationsKey creates a key for the outgoing operations with the given hash
+func CreateOutGoingOperationsKey(hash []byte) string {
+	return "op" + atSep + hex.EncodeToString(hash)
+}
+
 // CreateAccountKey creates a key for an account according to its type, shard ID and address
 func CreateAccountKey(accType Type, shId uint32, address []byte) string {
 	key := CreateTrieIdentifier(shId, accType)
@@ -11,6 +11,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
@@ -26,6 +27,7 @@ import (
 )
 
 var _ update.ImportHandler = (*stateImport)(nil)
+var _ update.SovereignImportHandler = (*stateImport)(nil)
 
 const maxTrieLevelInMemory = uint(5)
 
@@ -48,6 +50,9 @@ type stateImport struct {
 	miniBlocks                   map[string]*block.MiniBlock
 	importedEpochStartMetaBlock  data.MetaHeaderHandler
 	importedUnFinishedMetaBlocks map[string]data.MetaHeaderHandler
+	importedExtendedHeaders      map[uint32]data.ShardHeaderExtendedHandler
+	importedIncomingHdrsQueues   map[uint32][]byte
+	importedOutGoingOperations   map[string][]*sovereign.BridgeOutGoingData
 	tries                        map[string]common.Trie
 	accountDBsMap                map[uint32]state.AccountsDBImporter
 	validatorDB                  state.AccountsDBImporter
@@ -93,6 +98,9 @@ func NewStateImport(args ArgsNewStateImport) (*stateImport, error) {
 		miniBlocks:                   make(map[string]*block.MiniBlock),
 		importedEpochStartMetaBlock:  &block.MetaBlock{},
 		importedUnFinishedMetaBlocks: make(map[string]data.MetaHeaderHandler),
+		importedExtendedHeaders:      make(map[uint32]data.ShardHeaderExtendedHandler),
+		importedIncomingHdrsQueues:   make(map[uint32][]byte),
+		importedOutGoingOperations:   make(map[string][]*sovereign.BridgeOutGoingData),
 		tries:                        make(map[string]common.Trie),
 		hasher:                       args.Hasher,
 		marshalizer:                  args.Marshalizer,
@@ -124,6 +132,12 @@ func (si *stateImport) ImportAll() error {
 			err = si.importMiniBlocks(identifier, keys)
 		case TransactionsIdentifier:
 			err = si.importTransactions(identifier, keys)
+		case LastFinalizedExtendedHeaderIdentifier:
+			err = si.importLastFinalizedExtendedHeaders(identifier, keys)
+		case IncomingHeadersQueuesIdentifier:
+			err = si.importIncomingHeadersQueues(identifier, keys)
+		case PendingOutGoingOperationsIdentifier:
+			err = si.importPendingOutGoingOperations(identifier, keys)
 		default:
 			splitString := strings.Split(identifier, atSep)
 			canImportState := len(splitString) > 1 && splitString[0] == TrieIdentifier
@@ -197,6 +211,104 @@ func (si *stateImport) importUnFinishedMetaBlocks(identifier string, keys [][]by
 	return nil
 }
 
+func (si *stateImport) importLastFinalizedExtendedHeaders(identifier string, keys [][]byte) error {
+	for _, key := range keys {
+		err := si.importLastFinalizedExtendedHeader(identifier, string(key))
+		if err != nil {
+			return fmt.Errorf("%w identifier %s", err, LastFinalizedExtendedHeaderIdentifier)
+		}
+	}
+
+	return nil
+}
+
+func (si *stateImport) importLastFinalizedExtendedHeader(identifier string, key string) error {
+	shardID, err := GetExtendedHeaderKeyShardID(key)
+	if err != nil {
+		return err
+	}
+	if _, exists := si.importedExtendedHeaders[shardID]; exists {
+		return fmt.Errorf("%w, shard: %d", update.ErrDuplicatedExtendedHeader, shardID)
+	}
+
+	object, err := si.createElement(identifier, key)
+	if err != nil {
+		return err
+	}
+
+	extendedHeader, ok := object.(*block.ShardHeaderExtended)
+	if !ok {
+		return update.ErrWrongTypeAssertion
+	}
+
+	_, originalHash, err := GetKeyTypeAndHash(key)
+	if err != nil {
+		return err
+	}
+	hash, err := core.CalculateHash(si.marshalizer, si.hasher, extendedHeader)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, originalHash) {
+		log.Warn("imported extended header hash does not match original", "shard", shardID, "new", hash, "old", originalHash)
+	}
+
+	si.importedExtendedHeaders[shardID] = extendedHeader
+
+	return nil
+}
+
+func (si *stateImport) importIncomingHeadersQueues(identifier string, keys [][]byte) error {
+	var err error
+	var object interface{}
+	for _, key := range keys {
+		object, err = si.createElement(identifier, string(key))
+		if err != nil {
+			break
+		}
+
+		queue, ok := object.(*IncomingHeadersQueue)
+		if !ok {
+			err = fmt.Errorf("%w: wanted incoming headers queue", update.ErrWrongTypeAssertion)
+			break
+		}
+
+		si.importedIncomingHdrsQueues[queue.ShardID] = queue.Queue
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w identifier %s", err, IncomingHeadersQueuesIdentifier)
+	}
+
+	return nil
+}
+
+func (si *stateImport) importPendingOutGoingOperations(identifier string, keys [][]byte) error {
+	var err error
+	var object interface{}
+	for _, key := range keys {
+		object, err = si.createElement(identifier, string(key))
+		if err != nil {
+			break
+		}
+
+		pendingOperations, ok := object.(*PendingOutGoingOperations)
+		if !ok || pendingOperations.Data == nil {
+			err = fmt.Errorf("%w: wanted pending outgoing operations", update.ErrWrongTypeAssertion)
+			break
+		}
+
+		destination := pendingOperations.Destination
+		si.importedOutGoingOperations[destination] = append(si.importedOutGoingOperations[destination], pendingOperations.Data)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w identifier %s", err, PendingOutGoingOperationsIdentifier)
+	}
+
+	return nil
+}
+
 func (si *stateImport) importTransactions(identifier string, keys [][]byte) error {
 	var err error
 	var object interface{}
@@ -608,6 +720,23 @@ func (si *stateImport) GetMiniBlocks() map[string]*block.MiniBlock {
 	return si.miniBlocks
 }
 
+// GetLastFinalizedExtendedHeaders returns the imported last finalized extended headers of a sovereign chain, by the
+// shard ID of their incoming chain
+func (si *stateImport) GetLastFinalizedExtendedHeaders() map[uint32]data.ShardHeaderExtendedHandler {
+	return si.importedExtendedHeaders
+}
+
+// GetIncomingHeadersQueues returns the imported queues of incoming headers waiting for confirmations of a sovereign
+// chain, by the shard ID of their incoming chain
+func (si *stateImport) GetIncomingHeadersQueues() map[uint32][]byte {
+	return si.importedIncomingHdrsQueues
+}
+
+// GetPendingOutGoingOperations returns the imported outgoing operations of a sovereign chain, grouped by destination
+func (si *stateImport) GetPendingOutGoingOperations() map[string][]*sovereign.BridgeOutGoingData {
+	return si.importedOutGoingOperations
+}
+
 // GetValidatorAccountsDB returns the imported validator accounts DB
 func (si *stateImport) GetValidatorAccountsDB() state.AccountsAdapter {
 	accountsAdapter, ok := si.validatorDB.(state.AccountsAdapter)
@@ -7,7 +7,9 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/state"
@@ -134,3 +136,155 @@ func TestStateImport_ImportUnFinishedMetaBlocksShouldWork(t *testing.T) {
 	require.Nil(t, err)
 	assert.Equal(t, importState.importedUnFinishedMetaBlocks[string(metaBlockHash)], metaBlock)
 }
+
+func TestStateImport_ImportLastFinalizedExtendedHeaders(t *testing.T) {
+	t.Parallel()
+
+	mainChainHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				Round:   4,
+				ChainID: []byte("mainChainId"),
+			},
+		},
+	}
+	incomingChainHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				Round:   7,
+				ChainID: []byte("incomingChainId"),
+			},
+		},
+	}
+	incomingChainShardID := core.MainChainShardId - 1
+
+	t.Run("no extended header, should work", func(t *testing.T) {
+		t.Parallel()
+
+		importState, _ := NewStateImport(createArgsNewStateImport())
+
+		err := importState.importLastFinalizedExtendedHeaders(LastFinalizedExtendedHeaderIdentifier, nil)
+		require.Nil(t, err)
+		require.Empty(t, importState.GetLastFinalizedExtendedHeaders())
+	})
+	t.Run("more extended headers of the same chain, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsNewStateImport()
+		args.HardforkStorer = &mock.HardforkStorerStub{
+			GetCalled: func(identifier string, key []byte) ([]byte, error) {
+				return args.Marshalizer.Marshal(mainChainHeader)
+			},
+		}
+		importState, _ := NewStateImport(args)
+
+		err := importState.importLastFinalizedExtendedHeaders(LastFinalizedExtendedHeaderIdentifier, [][]byte{
+			[]byte(CreateExtendedHeaderKey(core.MainChainShardId, mainChainHeader, []byte("hash1"))),
+			[]byte(CreateExtendedHeaderKey(core.MainChainShardId, mainChainHeader, []byte("hash2"))),
+		})
+		require.ErrorIs(t, err, update.ErrDuplicatedExtendedHeader)
+	})
+	t.Run("key without shard id should be imported for the main chain", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsNewStateImport()
+		args.HardforkStorer = &mock.HardforkStorerStub{
+			GetCalled: func(identifier string, key []byte) ([]byte, error) {
+				return args.Marshalizer.Marshal(mainChainHeader)
+			},
+		}
+		importState, _ := NewStateImport(args)
+
+		key := fmt.Sprintf("ext@mainChainId@%s", hex.EncodeToString([]byte("hash")))
+		err := importState.importLastFinalizedExtendedHeaders(LastFinalizedExtendedHeaderIdentifier, [][]byte{[]byte(key)})
+		require.Nil(t, err)
+		require.Equal(t, mainChainHeader, importState.GetLastFinalizedExtendedHeaders()[core.MainChainShardId])
+	})
+	t.Run("should import the extended headers of all incoming chains", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsNewStateImport()
+		mainChainHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, mainChainHeader)
+		incomingChainHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, incomingChainHeader)
+		mainChainKey := CreateExtendedHeaderKey(core.MainChainShardId, mainChainHeader, mainChainHeaderHash)
+		incomingChainKey := CreateExtendedHeaderKey(incomingChainShardID, incomingChainHeader, incomingChainHeaderHash)
+		args.HardforkStorer = &mock.HardforkStorerStub{
+			GetCalled: func(identifier string, key []byte) ([]byte, error) {
+				if string(key) == incomingChainKey {
+					return args.Marshalizer.Marshal(incomingChainHeader)
+				}
+				return args.Marshalizer.Marshal(mainChainHeader)
+			},
+		}
+		importState, _ := NewStateImport(args)
+
+		err := importState.importLastFinalizedExtendedHeaders(LastFinalizedExtendedHeaderIdentifier, [][]byte{
+			[]byte(mainChainKey),
+			[]byte(incomingChainKey),
+		})
+		require.Nil(t, err)
+		require.Equal(t, map[uint32]data.ShardHeaderExtendedHandler{
+			core.MainChainShardId: mainChainHeader,
+			incomingChainShardID:  incomingChainHeader,
+		}, importState.GetLastFinalizedExtendedHeaders())
+	})
+}
+
+func TestStateImport_ImportIncomingHeadersQueues(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNewStateImport()
+	queues := map[string]*IncomingHeadersQueue{
+		CreateIncomingHeadersQueueKey(core.MainChainShardId):     {ShardID: core.MainChainShardId, Queue: []byte("queue1")},
+		CreateIncomingHeadersQueueKey(core.MainChainShardId - 1): {ShardID: core.MainChainShardId - 1, Queue: []byte("queue2")},
+	}
+	args.HardforkStorer = &mock.HardforkStorerStub{
+		GetCalled: func(identifier string, key []byte) ([]byte, error) {
+			return args.Marshalizer.Marshal(queues[string(key)])
+		},
+	}
+	importState, _ := NewStateImport(args)
+
+	err := importState.importIncomingHeadersQueues(IncomingHeadersQueuesIdentifier, [][]byte{
+		[]byte(CreateIncomingHeadersQueueKey(core.MainChainShardId)),
+		[]byte(CreateIncomingHeadersQueueKey(core.MainChainShardId - 1)),
+	})
+	require.Nil(t, err)
+	require.Equal(t, map[uint32][]byte{
+		core.MainChainShardId:     []byte("queue1"),
+		core.MainChainShardId - 1: []byte("queue2"),
+	}, importState.GetIncomingHeadersQueues())
+}
+
+func TestStateImport_ImportPendingOutGoingOperations(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNewStateImport()
+	pendingOperations := map[string]*PendingOutGoingOperations{
+		CreateOutGoingOperationsKey([]byte("hash1")): {Destination: "dest1", Data: &sovereign.BridgeOutGoingData{Hash: []byte("hash1")}},
+		CreateOutGoingOperationsKey([]byte("hash2")): {Destination: "dest2", Data: &sovereign.BridgeOutGoingData{Hash: []byte("hash2")}},
+		CreateOutGoingOperationsKey([]byte("hash3")): {Destination: "dest1", Data: &sovereign.BridgeOutGoingData{Hash: []byte("hash3")}},
+	}
+	args.HardforkStorer = &mock.HardforkStorerStub{
+		GetCalled: func(identifier string, key []byte) ([]byte, error) {
+			return args.Marshalizer.Marshal(pendingOperations[string(key)])
+		},
+	}
+	importState, _ := NewStateImport(args)
+
+	err := importState.importPendingOutGoingOperations(PendingOutGoingOperationsIdentifier, [][]byte{
+		[]byte(CreateOutGoingOperationsKey([]byte("hash1"))),
+		[]byte(CreateOutGoingOperationsKey([]byte("hash2"))),
+		[]byte(CreateOutGoingOperationsKey([]byte("hash3"))),
+	})
+	require.Nil(t, err)
+	require.Equal(t, map[string][]*sovereign.BridgeOutGoingData{
+		"dest1": {
+			{Hash: []byte("hash1")},
+			{Hash: []byte("hash3")},
+		},
+		"dest2": {
+			{Hash: []byte("hash2")},
+		},
+	}, importState.GetPendingOutGoingOperations())
+}
@@ -0,0 +1,278 @@
+package genesis
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/update"
+)
+
+var _ update.ExportHandler = (*sovereignStateExport)(nil)
+
+// ArgsNewSovereignStateExporter defines the arguments needed to create new sovereign state exporter
+type ArgsNewSovereignStateExporter struct {
+	ShardCoordinator         sharding.Coordinator
+	StateSyncer              update.SovereignStateSyncer
+	Marshalizer              marshal.Marshalizer
+	Hasher                   hashing.Hasher
+	HardforkStorer           update.HardforkStorer
+	ExportFolder             string
+	AddressPubKeyConverter   core.PubkeyConverter
+	ValidatorPubKeyConverter core.PubkeyConverter
+	GenesisNodesSetupHandler update.GenesisNodesSetupHandler
+	OutGoingOperationsPool   update.OutGoingOperationsPool
+	IncomingHeadersQueues    update.IncomingHeadersQueuesHandler
+}
+
+type sovereignStateExport struct {
+	*stateExport
+	sovereignStateSyncer   update.SovereignStateSyncer
+	outGoingOperationsPool update.OutGoingOperationsPool
+	incomingHeadersQueues  update.IncomingHeadersQueuesHandler
+}
+
+// NewSovereignStateExporter exports the state of a sovereign chain at a specific moment to a hardfork storer
+func NewSovereignStateExporter(args ArgsNewSovereignStateExporter) (*sovereignStateExport, error) {
+	if check.IfNil(args.OutGoingOperationsPool) {
+		return nil, update.ErrNilOutGoingOperationsPool
+	}
+	if check.IfNil(args.IncomingHeadersQueues) {
+		return nil, update.ErrNilIncomingHeadersQueuesHandler
+	}
+
+	baseExporter, err := NewStateExporter(ArgsNewStateExporter{
+		ShardCoordinator:         args.ShardCoordinator,
+		StateSyncer:              args.StateSyncer,
+		Marshalizer:              args.Marshalizer,
+		Hasher:                   args.Hasher,
+		HardforkStorer:           args.HardforkStorer,
+		ExportFolder:             args.ExportFolder,
+		AddressPubKeyConverter:   args.AddressPubKeyConverter,
+		ValidatorPubKeyConverter: args.ValidatorPubKeyConverter,
+		GenesisNodesSetupHandler: args.GenesisNodesSetupHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &sovereignStateExport{
+		stateExport:            baseExporter,
+		sovereignStateSyncer:   args.StateSyncer,
+		outGoingOperationsPool: args.OutGoingOperationsPool,
+		incomingHeadersQueues:  args.IncomingHeadersQueues,
+	}, nil
+}
+
+// ExportAll exports the epoch start sovereign header of the provided epoch, together with the accounts and validators
+// tries, the last finalized extended header of each incoming chain, the incoming headers waiting for confirmations and
+// the outgoing operations not yet confirmed by the main chain. The bridge rate limits state is kept in the system
+// account, so it is exported together with the accounts trie.
+func (sse *sovereignStateExport) ExportAll(epoch uint32) error {
+	defer func() {
+		errClose := sse.hardforkStorer.Close()
+		log.LogIfError(errClose)
+	}()
+
+	err := sse.stateSyncer.SyncAllState(epoch)
+	if err != nil {
+		return err
+	}
+
+	err = sse.exportEpochStartSovereignHeader()
+	if err != nil {
+		return err
+	}
+
+	err = sse.exportAllTries()
+	if err != nil {
+		return err
+	}
+
+	err = sse.exportLastFinalizedExtendedHeaders()
+	if err != nil {
+		return err
+	}
+
+	err = sse.exportIncomingHeadersQueues()
+	if err != nil {
+		return err
+	}
+
+	return sse.exportPendingOutGoingOperations()
+}
+
+func (sse *sovereignStateExport) exportEpochStartSovereignHeader() error {
+	header, err := sse.sovereignStateSyncer.GetEpochStartMetaBlock()
+	if err != nil {
+		return err
+	}
+
+	jsonData, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(sse.marshalizer, sse.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	key := CreateSovereignHeaderKey(header, hash)
+	err = sse.hardforkStorer.Write(EpochStartSovereignHeaderIdentifier, []byte(key), jsonData)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("exported epoch start sovereign header",
+		"hash", hash,
+		"epoch", header.GetEpoch(),
+		"round", header.GetRound(),
+		"nonce", header.GetNonce(),
+		"rootHash", header.GetRootHash(),
+	)
+
+	return sse.hardforkStorer.FinishedIdentifier(EpochStartSovereignHeaderIdentifier)
+}
+
+func (sse *sovereignStateExport) exportLastFinalizedExtendedHeaders() error {
+	extendedHeaders, err := sse.sovereignStateSyncer.GetLastFinalizedExtendedHeaders()
+	if err != nil {
+		return err
+	}
+	if len(extendedHeaders) == 0 {
+		log.Debug("no last finalized extended header to export")
+	}
+
+	shardIDs := make([]uint32, 0, len(extendedHeaders))
+	for shardID := range extendedHeaders {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sortShardIDs(shardIDs)
+
+	for _, shardID := range shardIDs {
+		err = sse.exportLastFinalizedExtendedHeader(shardID, extendedHeaders[shardID])
+		if err != nil {
+			return err
+		}
+	}
+
+	return sse.hardforkStorer.FinishedIdentifier(LastFinalizedExtendedHeaderIdentifier)
+}
+
+func (sse *sovereignStateExport) exportLastFinalizedExtendedHeader(shardID uint32, extendedHeader data.ShardHeaderExtendedHandler) error {
+	if check.IfNil(extendedHeader) {
+		return nil
+	}
+
+	jsonData, err := json.Marshal(extendedHeader)
+	if err != nil {
+		return err
+	}
+
+	hash, err := core.CalculateHash(sse.marshalizer, sse.hasher, extendedHeader)
+	if err != nil {
+		return err
+	}
+
+	key := CreateExtendedHeaderKey(shardID, extendedHeader, hash)
+	err = sse.hardforkStorer.Write(LastFinalizedExtendedHeaderIdentifier, []byte(key), jsonData)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("exported last finalized extended header",
+		"shard", shardID,
+		"hash", hash,
+		"epoch", extendedHeader.GetEpoch(),
+		"round", extendedHeader.GetRound(),
+		"nonce", extendedHeader.GetNonce(),
+	)
+
+	return nil
+}
+
+func (sse *sovereignStateExport) exportIncomingHeadersQueues() error {
+	queues, err := sse.incomingHeadersQueues.GetIncomingHeadersQueues()
+	if err != nil {
+		return err
+	}
+
+	shardIDs := make([]uint32, 0, len(queues))
+	for shardID := range queues {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sortShardIDs(shardIDs)
+
+	for _, shardID := range shardIDs {
+		jsonData, errMarshal := json.Marshal(&IncomingHeadersQueue{
+			ShardID: shardID,
+			Queue:   queues[shardID],
+		})
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		key := CreateIncomingHeadersQueueKey(shardID)
+		err = sse.hardforkStorer.Write(IncomingHeadersQueuesIdentifier, []byte(key), jsonData)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("exported incoming headers queues", "num", len(queues))
+
+	return sse.hardforkStorer.FinishedIdentifier(IncomingHeadersQueuesIdentifier)
+}
+
+func sortShardIDs(shardIDs []uint32) {
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+}
+
+func (sse *sovereignStateExport) exportPendingOutGoingOperations() error {
+	pendingOperations := sse.outGoingOperationsPool.GetPendingOperations()
+
+	destinations := make([]string, 0, len(pendingOperations))
+	for destination := range pendingOperations {
+		destinations = append(destinations, destination)
+	}
+	sort.Strings(destinations)
+
+	numExported := 0
+	for _, destination := range destinations {
+		for _, outGoingData := range pendingOperations[destination] {
+			jsonData, err := json.Marshal(&PendingOutGoingOperations{
+				Destination: destination,
+				Data:        outGoingData,
+			})
+			if err != nil {
+				return err
+			}
+
+			key := CreateOutGoingOperationsKey(outGoingData.Hash)
+			err = sse.hardforkStorer.Write(PendingOutGoingOperationsIdentifier, []byte(key), jsonData)
+			if err != nil {
+				return err
+			}
+
+			numExported++
+		}
+	}
+
+	log.Debug("exported pending outgoing operations", "num", numExported)
+
+	return sse.hardforkStorer.FinishedIdentifier(PendingOutGoingOperationsIdentifier)
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (sse *sovereignStateExport) IsInterfaceNil() bool {
+	return sse == nil
+}
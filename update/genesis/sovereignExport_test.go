@@ -0,0 +1,248 @@
+package genesis
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	sovereignMocks "github.com/multiversx/mx-chain-go/testscommon/sovereign"
+	"github.com/multiversx/mx-chain-go/update"
+	"github.com/multiversx/mx-chain-go/update/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func getDefaultSovereignStateExporterArgs() ArgsNewSovereignStateExporter {
+	return ArgsNewSovereignStateExporter{
+		ShardCoordinator:         mock.NewOneShardCoordinatorMock(),
+		Marshalizer:              &mock.MarshalizerMock{},
+		StateSyncer:              &mock.StateSyncStub{},
+		HardforkStorer:           &mock.HardforkStorerStub{},
+		Hasher:                   &hashingMocks.HasherMock{},
+		AddressPubKeyConverter:   &testscommon.PubkeyConverterStub{},
+		ValidatorPubKeyConverter: &testscommon.PubkeyConverterStub{},
+		ExportFolder:             "test",
+		GenesisNodesSetupHandler: &mock.GenesisNodesSetupHandlerStub{},
+		OutGoingOperationsPool:   &sovereignMocks.OutGoingOperationsPoolMock{},
+		IncomingHeadersQueues:    &sovereignMocks.IncomingHeaderSubscriberStub{},
+	}
+}
+
+func TestNewSovereignStateExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil outgoing operations pool, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSovereignStateExporterArgs()
+		args.OutGoingOperationsPool = nil
+		exporter, err := NewSovereignStateExporter(args)
+		require.Equal(t, update.ErrNilOutGoingOperationsPool, err)
+		require.Nil(t, exporter)
+	})
+	t.Run("nil incoming headers queues handler, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSovereignStateExporterArgs()
+		args.IncomingHeadersQueues = nil
+		exporter, err := NewSovereignStateExporter(args)
+		require.Equal(t, update.ErrNilIncomingHeadersQueuesHandler, err)
+		require.Nil(t, exporter)
+	})
+	t.Run("nil state syncer, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSovereignStateExporterArgs()
+		args.StateSyncer = nil
+		exporter, err := NewSovereignStateExporter(args)
+		require.Equal(t, update.ErrNilStateSyncer, err)
+		require.Nil(t, exporter)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := NewSovereignStateExporter(getDefaultSovereignStateExporterArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(exporter))
+	})
+}
+
+func TestSovereignStateExport_ExportAll(t *testing.T) {
+	t.Parallel()
+
+	sovereignHeader := &block.SovereignChainHeader{
+		Header: &block.Header{
+			Round:   2,
+			ChainID: []byte("chainId"),
+		},
+	}
+	extendedHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				Round:   4,
+				ChainID: []byte("mainChainId"),
+			},
+		},
+	}
+	incomingChainHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				Round:   7,
+				ChainID: []byte("incomingChainId"),
+			},
+		},
+	}
+	incomingChainShardID := core.MainChainShardId - 1
+	pendingOperations := map[string][]*sovereign.BridgeOutGoingData{
+		"dest2": {{Hash: []byte("hash2")}},
+		"dest1": {{Hash: []byte("hash1")}, {Hash: []byte("hash3")}},
+	}
+
+	t.Run("sync error, should return error and close the storer", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("sync error")
+		storerClosed := false
+		args := getDefaultSovereignStateExporterArgs()
+		args.StateSyncer = &mock.StateSyncStub{
+			SyncAllStateCalled: func(epoch uint32) error {
+				return expectedErr
+			},
+		}
+		args.HardforkStorer = &mock.HardforkStorerStub{
+			CloseCalled: func() error {
+				storerClosed = true
+				return nil
+			},
+		}
+		exporter, _ := NewSovereignStateExporter(args)
+
+		err := exporter.ExportAll(1)
+		require.Equal(t, expectedErr, err)
+		require.True(t, storerClosed)
+	})
+	t.Run("should export the sovereign specific data", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSovereignStateExporterArgs()
+		args.StateSyncer = &mock.StateSyncStub{
+			GetEpochStartMetaBlockCalled: func() (data.MetaHeaderHandler, error) {
+				return sovereignHeader, nil
+			},
+			GetLastFinalizedExtendedHeadersCalled: func() (map[uint32]data.ShardHeaderExtendedHandler, error) {
+				return map[uint32]data.ShardHeaderExtendedHandler{
+					core.MainChainShardId: extendedHeader,
+					incomingChainShardID:  incomingChainHeader,
+				}, nil
+			},
+		}
+		args.IncomingHeadersQueues = &sovereignMocks.IncomingHeaderSubscriberStub{
+			GetIncomingHeadersQueuesCalled: func() (map[uint32][]byte, error) {
+				return map[uint32][]byte{
+					core.MainChainShardId: []byte("queue"),
+				}, nil
+			},
+		}
+		args.OutGoingOperationsPool = &sovereignMocks.OutGoingOperationsPoolMock{
+			GetPendingOperationsCalled: func() map[string][]*sovereign.BridgeOutGoingData {
+				return pendingOperations
+			},
+		}
+
+		writtenKeys := make(map[string][]string)
+		finishedIdentifiers := make(map[string]struct{})
+		exportedOperations := make([]*PendingOutGoingOperations, 0)
+		exportedQueues := make([]*IncomingHeadersQueue, 0)
+		args.HardforkStorer = &mock.HardforkStorerStub{
+			WriteCalled: func(identifier string, key []byte, value []byte) error {
+				writtenKeys[identifier] = append(writtenKeys[identifier], string(key))
+				switch identifier {
+				case PendingOutGoingOperationsIdentifier:
+					operations := &PendingOutGoingOperations{}
+					err := json.Unmarshal(value, operations)
+					require.Nil(t, err)
+					exportedOperations = append(exportedOperations, operations)
+				case IncomingHeadersQueuesIdentifier:
+					queue := &IncomingHeadersQueue{}
+					err := json.Unmarshal(value, queue)
+					require.Nil(t, err)
+					exportedQueues = append(exportedQueues, queue)
+				}
+				return nil
+			},
+			FinishedIdentifierCalled: func(identifier string) error {
+				finishedIdentifiers[identifier] = struct{}{}
+				return nil
+			},
+		}
+		exporter, _ := NewSovereignStateExporter(args)
+
+		err := exporter.ExportAll(1)
+		require.Nil(t, err)
+
+		require.Len(t, writtenKeys[EpochStartSovereignHeaderIdentifier], 1)
+		extendedHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, extendedHeader)
+		incomingChainHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, incomingChainHeader)
+		require.Equal(t, []string{
+			CreateExtendedHeaderKey(incomingChainShardID, incomingChainHeader, incomingChainHeaderHash),
+			CreateExtendedHeaderKey(core.MainChainShardId, extendedHeader, extendedHeaderHash),
+		}, writtenKeys[LastFinalizedExtendedHeaderIdentifier])
+		require.Equal(t, []*IncomingHeadersQueue{
+			{ShardID: core.MainChainShardId, Queue: []byte("queue")},
+		}, exportedQueues)
+		require.Equal(t, []string{
+			CreateOutGoingOperationsKey([]byte("hash1")),
+			CreateOutGoingOperationsKey([]byte("hash3")),
+			CreateOutGoingOperationsKey([]byte("hash2")),
+		}, writtenKeys[PendingOutGoingOperationsIdentifier])
+		require.Equal(t, []*PendingOutGoingOperations{
+			{Destination: "dest1", Data: pendingOperations["dest1"][0]},
+			{Destination: "dest1", Data: pendingOperations["dest1"][1]},
+			{Destination: "dest2", Data: pendingOperations["dest2"][0]},
+		}, exportedOperations)
+
+		require.Contains(t, finishedIdentifiers, EpochStartSovereignHeaderIdentifier)
+		require.Contains(t, finishedIdentifiers, LastFinalizedExtendedHeaderIdentifier)
+		require.Contains(t, finishedIdentifiers, IncomingHeadersQueuesIdentifier)
+		require.Contains(t, finishedIdentifiers, PendingOutGoingOperationsIdentifier)
+	})
+	t.Run("no finalized extended header, should only finish its identifier", func(t *testing.T) {
+		t.Parallel()
+
+		args := getDefaultSovereignStateExporterArgs()
+		args.StateSyncer = &mock.StateSyncStub{
+			GetEpochStartMetaBlockCalled: func() (data.MetaHeaderHandler, error) {
+				return sovereignHeader, nil
+			},
+		}
+
+		extendedHeaderWritten := false
+		extendedHeaderFinished := false
+		args.HardforkStorer = &mock.HardforkStorerStub{
+			WriteCalled: func(identifier string, key []byte, value []byte) error {
+				if identifier == LastFinalizedExtendedHeaderIdentifier {
+					extendedHeaderWritten = true
+				}
+				return nil
+			},
+			FinishedIdentifierCalled: func(identifier string) error {
+				if identifier == LastFinalizedExtendedHeaderIdentifier {
+					extendedHeaderFinished = true
+				}
+				return nil
+			},
+		}
+		exporter, _ := NewSovereignStateExporter(args)
+
+		err := exporter.ExportAll(1)
+		require.Nil(t, err)
+		require.False(t, extendedHeaderWritten)
+		require.True(t, extendedHeaderFinished)
+	})
+}
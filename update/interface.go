@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -25,6 +26,26 @@ type StateSyncer interface {
 	IsInterfaceNil() bool
 }
 
+// SovereignStateSyncer defines the methods needed to get all the states of a sovereign chain
+type SovereignStateSyncer interface {
+	StateSyncer
+	GetLastFinalizedExtendedHeaders() (map[uint32]data.ShardHeaderExtendedHandler, error)
+}
+
+// OutGoingOperationsPool defines the outgoing operations pool methods needed to export the pending operations
+type OutGoingOperationsPool interface {
+	GetPendingOperations() map[string][]*sovereign.BridgeOutGoingData
+	IsInterfaceNil() bool
+}
+
+// IncomingHeadersQueuesHandler defines the methods needed to export and import the queues of incoming headers waiting
+// for confirmations, by the shard ID of their incoming chain
+type IncomingHeadersQueuesHandler interface {
+	GetIncomingHeadersQueues() (map[uint32][]byte, error)
+	SetIncomingHeadersQueues(queues map[uint32][]byte) error
+	IsInterfaceNil() bool
+}
+
 // TrieSyncer synchronizes the trie, asking on the network for the missing nodes
 type TrieSyncer interface {
 	StartSyncing(rootHash []byte, ctx context.Context) error
@@ -102,6 +123,14 @@ type ImportHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SovereignImportHandler defines the methods needed to import the state of a sovereign chain
+type SovereignImportHandler interface {
+	ImportHandler
+	GetLastFinalizedExtendedHeaders() map[uint32]data.ShardHeaderExtendedHandler
+	GetIncomingHeadersQueues() map[uint32][]byte
+	GetPendingOutGoingOperations() map[string][]*sovereign.BridgeOutGoingData
+}
+
 // HardForkBlockProcessor defines the methods to process after hardfork
 type HardForkBlockProcessor interface {
 	CreateBlock(body *block.Body, chainID string, round uint64, nonce uint64, epoch uint32) (data.HeaderHandler, error)
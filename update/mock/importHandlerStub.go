@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/sovereign"
 	"github.com/multiversx/mx-chain-go/state"
 )
 
@@ -16,6 +17,10 @@ type ImportHandlerStub struct {
 	GetTransactionsCalled         func() map[string]data.TransactionHandler
 	GetAccountsDBForShardCalled   func(shardID uint32) state.AccountsAdapter
 	CloseCalled                   func() error
+
+	GetLastFinalizedExtendedHeadersCalled func() map[uint32]data.ShardHeaderExtendedHandler
+	GetIncomingHeadersQueuesCalled        func() map[uint32][]byte
+	GetPendingOutGoingOperationsCalled    func() map[string][]*sovereign.BridgeOutGoingData
 }
 
 // ImportAll -
@@ -74,6 +79,30 @@ func (ihs *ImportHandlerStub) GetAccountsDBForShard(shardID uint32) state.Accoun
 	return nil
 }
 
+// GetLastFinalizedExtendedHeaders -
+func (ihs *ImportHandlerStub) GetLastFinalizedExtendedHeaders() map[uint32]data.ShardHeaderExtendedHandler {
+	if ihs.GetLastFinalizedExtendedHeadersCalled != nil {
+		return ihs.GetLastFinalizedExtendedHeadersCalled()
+	}
+	return nil
+}
+
+// GetIncomingHeadersQueues -
+func (ihs *ImportHandlerStub) GetIncomingHeadersQueues() map[uint32][]byte {
+	if ihs.GetIncomingHeadersQueuesCalled != nil {
+		return ihs.GetIncomingHeadersQueuesCalled()
+	}
+	return nil
+}
+
+// GetPendingOutGoingOperations -
+func (ihs *ImportHandlerStub) GetPendingOutGoingOperations() map[string][]*sovereign.BridgeOutGoingData {
+	if ihs.GetPendingOutGoingOperationsCalled != nil {
+		return ihs.GetPendingOutGoingOperationsCalled()
+	}
+	return nil
+}
+
 // Close -
 func (ihs *ImportHandlerStub) Close() error {
 	if ihs.CloseCalled != nil {
@@ -16,6 +16,8 @@ type StateSyncStub struct {
 	GetAllTransactionsCalled      func() (map[string]data.TransactionHandler, error)
 	GetAllValidatorsInfoCalled    func() (map[string]*state.ShardValidatorInfo, error)
 	GetAllMiniBlocksCalled        func() (map[string]*block.MiniBlock, error)
+
+	GetLastFinalizedExtendedHeadersCalled func() (map[uint32]data.ShardHeaderExtendedHandler, error)
 }
 
 // GetEpochStartMetaBlock -
@@ -74,6 +76,14 @@ func (sss *StateSyncStub) GetAllMiniBlocks() (map[string]*block.MiniBlock, error
 	return nil, nil
 }
 
+// GetLastFinalizedExtendedHeaders -
+func (sss *StateSyncStub) GetLastFinalizedExtendedHeaders() (map[uint32]data.ShardHeaderExtendedHandler, error) {
+	if sss.GetLastFinalizedExtendedHeadersCalled != nil {
+		return sss.GetLastFinalizedExtendedHeadersCalled()
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (sss *StateSyncStub) IsInterfaceNil() bool {
 	return sss == nil
@@ -1,29 +1,37 @@
 package sync
 
 import (
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 )
 
 type extendedHeaderRequester struct {
-	requestHandler ExtendedShardHeaderRequestHandler
+	requestHandler        ExtendedShardHeaderRequestHandler
+	incomingChainsHandler process.IncomingChainsHandler
 }
 
 // NewExtendedHeaderRequester creates an extended header requester wrapper
-func NewExtendedHeaderRequester(requestHandler ExtendedShardHeaderRequestHandler) (*extendedHeaderRequester, error) {
+func NewExtendedHeaderRequester(
+	requestHandler ExtendedShardHeaderRequestHandler,
+	incomingChainsHandler process.IncomingChainsHandler,
+) (*extendedHeaderRequester, error) {
 	if check.IfNil(requestHandler) {
 		return nil, process.ErrNilRequestHandler
 	}
+	if check.IfNil(incomingChainsHandler) {
+		return nil, errors.ErrNilIncomingChainsHandler
+	}
 
 	return &extendedHeaderRequester{
-		requestHandler: requestHandler,
+		requestHandler:        requestHandler,
+		incomingChainsHandler: incomingChainsHandler,
 	}, nil
 }
 
-// ShouldRequestHeader returns true if the shard id is main chain
+// ShouldRequestHeader returns true if the shard id belongs to an incoming chain
 func (ehr *extendedHeaderRequester) ShouldRequestHeader(shardId uint32) bool {
-	return shardId == core.MainChainShardId
+	return ehr.incomingChainsHandler.IsIncomingChainShard(shardId)
 }
 
 // RequestHeader requests extended shard header by hash
@@ -4,19 +4,25 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/sovereign"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewExtendedHeaderRequester(t *testing.T) {
 	t.Parallel()
 
-	headerRequester, err := NewExtendedHeaderRequester(nil)
+	headerRequester, err := NewExtendedHeaderRequester(nil, &sovereign.IncomingChainsHandlerMock{})
 	require.Equal(t, process.ErrNilRequestHandler, err)
 	require.Nil(t, headerRequester)
 
-	headerRequester, err = NewExtendedHeaderRequester(&testscommon.ExtendedShardHeaderRequestHandlerStub{})
+	headerRequester, err = NewExtendedHeaderRequester(&testscommon.ExtendedShardHeaderRequestHandlerStub{}, nil)
+	require.Equal(t, errors.ErrNilIncomingChainsHandler, err)
+	require.Nil(t, headerRequester)
+
+	headerRequester, err = NewExtendedHeaderRequester(&testscommon.ExtendedShardHeaderRequestHandlerStub{}, &sovereign.IncomingChainsHandlerMock{})
 	require.Nil(t, err)
 	require.False(t, headerRequester.IsInterfaceNil())
 }
@@ -24,13 +30,20 @@ func TestNewExtendedHeaderRequester(t *testing.T) {
 func TestExtendedHeaderRequester_ShouldRequestHeader(t *testing.T) {
 	t.Parallel()
 
-	headerRequester, _ := NewExtendedHeaderRequester(&testscommon.ExtendedShardHeaderRequestHandlerStub{})
+	incomingChainShardID := core.MainChainShardId - 1
+	incomingChainsHandler := &sovereign.IncomingChainsHandlerMock{
+		IsIncomingChainShardCalled: func(shardID uint32) bool {
+			return shardID == core.MainChainShardId || shardID == incomingChainShardID
+		},
+	}
+	headerRequester, _ := NewExtendedHeaderRequester(&testscommon.ExtendedShardHeaderRequestHandlerStub{}, incomingChainsHandler)
 	require.False(t, headerRequester.IsInterfaceNil())
 
 	require.False(t, headerRequester.ShouldRequestHeader(0))
 	require.False(t, headerRequester.ShouldRequestHeader(1))
 	require.False(t, headerRequester.ShouldRequestHeader(core.MetachainShardId))
 	require.True(t, headerRequester.ShouldRequestHeader(core.MainChainShardId))
+	require.True(t, headerRequester.ShouldRequestHeader(incomingChainShardID))
 }
 
 func TestExtendedHeaderRequester_RequestHeader(t *testing.T) {
@@ -44,7 +57,7 @@ func TestExtendedHeaderRequester_RequestHeader(t *testing.T) {
 			wasHeaderRequested = true
 		},
 	}
-	headerRequester, _ := NewExtendedHeaderRequester(requester)
+	headerRequester, _ := NewExtendedHeaderRequester(requester, &sovereign.IncomingChainsHandlerMock{})
 	headerRequester.RequestHeader(headerHash)
 	require.True(t, wasHeaderRequested)
 }
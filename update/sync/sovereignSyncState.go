@@ -0,0 +1,296 @@
+package sync
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/update"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+)
+
+var _ update.SovereignStateSyncer = (*sovereignSyncState)(nil)
+
+// ArgsNewSovereignSyncState defines the arguments for the new sovereign sync state
+type ArgsNewSovereignSyncState struct {
+	Marshaller        marshal.Marshalizer
+	StorageService    dataRetriever.StorageService
+	ActiveAccountsDBs map[state.AccountsDbIdentifier]state.AccountsAdapter
+}
+
+type sovereignSyncState struct {
+	marshaller        marshal.Marshalizer
+	storageService    dataRetriever.StorageService
+	activeAccountsDBs map[state.AccountsDbIdentifier]state.AccountsAdapter
+
+	mutSynced               sync.RWMutex
+	synced                  bool
+	epochStartHeader        data.MetaHeaderHandler
+	lastFinalizedExtHeaders map[uint32]data.ShardHeaderExtendedHandler
+	tries                   map[string]common.Trie
+}
+
+// NewSovereignSyncState creates a syncer which gets the state of a sovereign chain from the local storage. All
+// validators of a sovereign chain are in the same shard, so there is nothing to be requested from the network.
+func NewSovereignSyncState(args ArgsNewSovereignSyncState) (*sovereignSyncState, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, update.ErrNilMarshalizer
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, update.ErrNilStorage
+	}
+	if check.IfNil(args.ActiveAccountsDBs[state.UserAccountsState]) {
+		return nil, fmt.Errorf("%w for user accounts", update.ErrNilAccounts)
+	}
+	if check.IfNil(args.ActiveAccountsDBs[state.PeerAccountsState]) {
+		return nil, fmt.Errorf("%w for peer accounts", update.ErrNilAccounts)
+	}
+
+	return &sovereignSyncState{
+		marshaller:              args.Marshaller,
+		storageService:          args.StorageService,
+		activeAccountsDBs:       args.ActiveAccountsDBs,
+		lastFinalizedExtHeaders: make(map[uint32]data.ShardHeaderExtendedHandler),
+		tries:                   make(map[string]common.Trie),
+	}, nil
+}
+
+// SyncAllState loads the epoch start sovereign header of the provided epoch, together with the tries it points to
+// and the last finalized extended header of each incoming chain
+func (ss *sovereignSyncState) SyncAllState(epoch uint32) error {
+	ss.mutSynced.Lock()
+	defer ss.mutSynced.Unlock()
+
+	ss.synced = false
+	epochStartID := []byte(core.EpochStartIdentifier(epoch))
+	header, err := process.GetSovereignChainHeaderFromStorage(epochStartID, ss.marshaller, ss.storageService)
+	if err != nil {
+		return fmt.Errorf("%w in sovereignSyncState.SyncAllState - epoch start header for epoch %d", err, epoch)
+	}
+
+	sovHeader, ok := header.(data.SovereignChainHeaderHandler)
+	if !ok {
+		return fmt.Errorf("%w in sovereignSyncState.SyncAllState - epoch start header", update.ErrWrongTypeAssertion)
+	}
+	metaHeader, ok := header.(data.MetaHeaderHandler)
+	if !ok {
+		return fmt.Errorf("%w in sovereignSyncState.SyncAllState - epoch start header", update.ErrWrongTypeAssertion)
+	}
+
+	log.Info("epoch start sovereign header",
+		"nonce", header.GetNonce(),
+		"round", header.GetRound(),
+		"root hash", header.GetRootHash(),
+		"validator stats root hash", sovHeader.GetValidatorStatsRootHash(),
+		"epoch", header.GetEpoch(),
+	)
+
+	tries := make(map[string]common.Trie)
+	err = ss.recreateTries(tries, state.UserAccountsState, genesis.UserAccount, header.GetRootHash())
+	if err != nil {
+		return err
+	}
+	err = ss.recreateTries(tries, state.PeerAccountsState, genesis.ValidatorAccount, sovHeader.GetValidatorStatsRootHash())
+	if err != nil {
+		return err
+	}
+
+	lastFinalizedExtHeaders, err := ss.getLastFinalizedExtendedHeaders(sovHeader)
+	if err != nil {
+		return err
+	}
+
+	ss.epochStartHeader = metaHeader
+	ss.lastFinalizedExtHeaders = lastFinalizedExtHeaders
+	ss.tries = tries
+	ss.synced = true
+
+	return nil
+}
+
+func (ss *sovereignSyncState) recreateTries(
+	tries map[string]common.Trie,
+	trieID state.AccountsDbIdentifier,
+	accountType genesis.Type,
+	rootHash []byte,
+) error {
+	trieIdentifier := genesis.CreateTrieIdentifier(core.SovereignChainShardId, accountType)
+	recreatedTries, err := ss.activeAccountsDBs[trieID].RecreateAllTries(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w in sovereignSyncState.SyncAllState - RecreateAllTries for %s", err, trieIdentifier)
+	}
+
+	for hash, recreatedTrie := range recreatedTries {
+		if bytes.Equal(rootHash, []byte(hash)) {
+			tries[trieIdentifier] = recreatedTrie
+			continue
+		}
+
+		dataTrieIdentifier := genesis.CreateTrieIdentifier(core.SovereignChainShardId, genesis.DataTrie)
+		tries[genesis.AddRootHashToIdentifier(dataTrieIdentifier, hash)] = recreatedTrie
+	}
+
+	return nil
+}
+
+// getLastFinalizedExtendedHeaders returns the last finalized extended header of each incoming chain. The one of the main
+// chain is notarized by the epoch start sovereign header, while the ones of the other incoming chains are taken from the
+// last cross notarized headers saved in the bootstrap data of the epoch start round.
+func (ss *sovereignSyncState) getLastFinalizedExtendedHeaders(sovHeader data.SovereignChainHeaderHandler) (map[uint32]data.ShardHeaderExtendedHandler, error) {
+	extendedHeaders := make(map[uint32]data.ShardHeaderExtendedHandler)
+
+	lastCrossChainData := sovHeader.GetLastFinalizedCrossChainHeaderHandler()
+	if lastCrossChainData != nil && len(lastCrossChainData.GetHeaderHash()) != 0 {
+		err := ss.addLastFinalizedExtendedHeader(extendedHeaders, core.MainChainShardId, lastCrossChainData.GetHeaderHash())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		log.Debug("sovereignSyncState.SyncAllState: no main chain header finalized yet")
+	}
+
+	lastCrossNotarizedHeaders, err := ss.getLastCrossNotarizedHeaders(sovHeader.GetRound())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, headerInfo := range lastCrossNotarizedHeaders {
+		isGenesisHeader := headerInfo.GetNonce() == 0
+		if headerInfo.GetShardId() == core.MainChainShardId || isGenesisHeader {
+			continue
+		}
+
+		err = ss.addLastFinalizedExtendedHeader(extendedHeaders, headerInfo.GetShardId(), headerInfo.GetHash())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return extendedHeaders, nil
+}
+
+func (ss *sovereignSyncState) getLastCrossNotarizedHeaders(round uint64) ([]bootstrapStorage.BootstrapHeaderInfo, error) {
+	bootStorer, err := ss.storageService.GetStorer(dataRetriever.BootstrapUnit)
+	if err != nil {
+		return nil, fmt.Errorf("%w in sovereignSyncState.SyncAllState - bootstrap unit", err)
+	}
+
+	bootstrapStorer, err := bootstrapStorage.NewBootstrapStorer(ss.marshaller, bootStorer)
+	if err != nil {
+		return nil, err
+	}
+
+	bootData, err := bootstrapStorer.Get(int64(round))
+	if err != nil {
+		log.Debug("sovereignSyncState.SyncAllState: no bootstrap data for the epoch start round, "+
+			"only the main chain extended header is exported", "round", round, "error", err)
+		return nil, nil
+	}
+
+	return bootData.LastCrossNotarizedHeaders, nil
+}
+
+func (ss *sovereignSyncState) addLastFinalizedExtendedHeader(
+	extendedHeaders map[uint32]data.ShardHeaderExtendedHandler,
+	shardID uint32,
+	hash []byte,
+) error {
+	extendedHeader, err := process.GetExtendedShardHeaderFromStorage(hash, ss.marshaller, ss.storageService)
+	if err != nil {
+		return fmt.Errorf("%w in sovereignSyncState.SyncAllState - last finalized extended header of shard %d", err, shardID)
+	}
+
+	log.Info("last finalized extended header",
+		"shard", shardID,
+		"hash", hash,
+		"nonce", extendedHeader.GetNonce(),
+		"round", extendedHeader.GetRound(),
+		"epoch", extendedHeader.GetEpoch(),
+	)
+
+	extendedHeaders[shardID] = extendedHeader
+	return nil
+}
+
+// GetEpochStartMetaBlock returns the synced epoch start sovereign header
+func (ss *sovereignSyncState) GetEpochStartMetaBlock() (data.MetaHeaderHandler, error) {
+	ss.mutSynced.RLock()
+	defer ss.mutSynced.RUnlock()
+
+	if !ss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	return ss.epochStartHeader, nil
+}
+
+// GetLastFinalizedExtendedHeaders returns the last finalized extended header of each incoming chain, by the shard ID of
+// the chain, as of the synced epoch start sovereign header. The incoming chains without any notarized header are missing.
+func (ss *sovereignSyncState) GetLastFinalizedExtendedHeaders() (map[uint32]data.ShardHeaderExtendedHandler, error) {
+	ss.mutSynced.RLock()
+	defer ss.mutSynced.RUnlock()
+
+	if !ss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	extendedHeaders := make(map[uint32]data.ShardHeaderExtendedHandler, len(ss.lastFinalizedExtHeaders))
+	for shardID, extendedHeader := range ss.lastFinalizedExtHeaders {
+		extendedHeaders[shardID] = extendedHeader
+	}
+
+	return extendedHeaders, nil
+}
+
+// GetUnFinishedMetaBlocks returns an empty map, since a sovereign chain has no cross shard headers
+func (ss *sovereignSyncState) GetUnFinishedMetaBlocks() (map[string]data.MetaHeaderHandler, error) {
+	return make(map[string]data.MetaHeaderHandler), nil
+}
+
+// GetAllTries returns the synced tries
+func (ss *sovereignSyncState) GetAllTries() (map[string]common.Trie, error) {
+	ss.mutSynced.RLock()
+	defer ss.mutSynced.RUnlock()
+
+	if !ss.synced {
+		return nil, update.ErrNotSynced
+	}
+
+	tries := make(map[string]common.Trie, len(ss.tries))
+	for key, tr := range ss.tries {
+		tries[key] = tr
+	}
+
+	return tries, nil
+}
+
+// GetAllTransactions returns an empty map, since a sovereign chain has no pending cross shard transactions
+func (ss *sovereignSyncState) GetAllTransactions() (map[string]data.TransactionHandler, error) {
+	return make(map[string]data.TransactionHandler), nil
+}
+
+// GetAllValidatorsInfo returns an empty map, the validators being exported from the validator accounts trie
+func (ss *sovereignSyncState) GetAllValidatorsInfo() (map[string]*state.ShardValidatorInfo, error) {
+	return make(map[string]*state.ShardValidatorInfo), nil
+}
+
+// GetAllMiniBlocks returns an empty map, since a sovereign chain has no pending cross shard miniblocks
+func (ss *sovereignSyncState) GetAllMiniBlocks() (map[string]*block.MiniBlock, error) {
+	return make(map[string]*block.MiniBlock), nil
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (ss *sovereignSyncState) IsInterfaceNil() bool {
+	return ss == nil
+}
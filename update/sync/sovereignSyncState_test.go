@@ -0,0 +1,281 @@
+package sync
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/block/bootstrapStorage"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/multiversx/mx-chain-go/update"
+	"github.com/multiversx/mx-chain-go/update/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsNewSovereignSyncState() ArgsNewSovereignSyncState {
+	return ArgsNewSovereignSyncState{
+		Marshaller:     &marshal.GogoProtoMarshalizer{},
+		StorageService: genericMocks.NewChainStorerMock(0),
+		ActiveAccountsDBs: map[state.AccountsDbIdentifier]state.AccountsAdapter{
+			state.UserAccountsState: &stateMock.AccountsStub{},
+			state.PeerAccountsState: &stateMock.AccountsStub{},
+		},
+	}
+}
+
+func TestNewSovereignSyncState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsNewSovereignSyncState()
+		args.Marshaller = nil
+		ss, err := NewSovereignSyncState(args)
+		require.Equal(t, update.ErrNilMarshalizer, err)
+		require.Nil(t, ss)
+	})
+	t.Run("nil storage service, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsNewSovereignSyncState()
+		args.StorageService = nil
+		ss, err := NewSovereignSyncState(args)
+		require.Equal(t, update.ErrNilStorage, err)
+		require.Nil(t, ss)
+	})
+	t.Run("nil user accounts, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsNewSovereignSyncState()
+		delete(args.ActiveAccountsDBs, state.UserAccountsState)
+		ss, err := NewSovereignSyncState(args)
+		require.ErrorIs(t, err, update.ErrNilAccounts)
+		require.Nil(t, ss)
+	})
+	t.Run("nil peer accounts, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsNewSovereignSyncState()
+		args.ActiveAccountsDBs[state.PeerAccountsState] = nil
+		ss, err := NewSovereignSyncState(args)
+		require.ErrorIs(t, err, update.ErrNilAccounts)
+		require.Nil(t, ss)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ss, err := NewSovereignSyncState(createArgsNewSovereignSyncState())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(ss))
+	})
+}
+
+func TestSovereignSyncState_SyncAllState(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(3)
+	rootHash := []byte("rootHash")
+	validatorRootHash := []byte("validatorRootHash")
+	dataTrieRootHash := []byte("dataTrieRootHash")
+	extendedHeaderHash := []byte("extendedHeaderHash")
+	extendedHeader := &block.ShardHeaderExtended{
+		Header: &block.HeaderV2{
+			Header: &block.Header{
+				Nonce: 7,
+			},
+		},
+	}
+	createSovereignHeader := func(lastFinalizedHash []byte) *block.SovereignChainHeader {
+		return &block.SovereignChainHeader{
+			Header: &block.Header{
+				Epoch:           epoch,
+				RootHash:        rootHash,
+				SoftwareVersion: process.SovereignHeaderVersion,
+			},
+			ValidatorStatsRootHash: validatorRootHash,
+			IsStartOfEpoch:         true,
+			EpochStart: block.EpochStartSovereign{
+				LastFinalizedCrossChainHeader: block.EpochStartCrossChainData{
+					HeaderHash: lastFinalizedHash,
+				},
+			},
+		}
+	}
+	createArgs := func(t *testing.T, header *block.SovereignChainHeader) ArgsNewSovereignSyncState {
+		args := createArgsNewSovereignSyncState()
+
+		headerBuff, err := args.Marshaller.Marshal(header)
+		require.Nil(t, err)
+		err = args.StorageService.Put(dataRetriever.BlockHeaderUnit, []byte(core.EpochStartIdentifier(epoch)), headerBuff)
+		require.Nil(t, err)
+
+		extendedHeaderBuff, err := args.Marshaller.Marshal(extendedHeader)
+		require.Nil(t, err)
+		err = args.StorageService.Put(dataRetriever.ExtendedShardHeadersUnit, extendedHeaderHash, extendedHeaderBuff)
+		require.Nil(t, err)
+
+		args.ActiveAccountsDBs[state.UserAccountsState] = &stateMock.AccountsStub{
+			RecreateAllTriesCalled: func(hash []byte) (map[string]common.Trie, error) {
+				require.Equal(t, rootHash, hash)
+				return map[string]common.Trie{
+					string(rootHash):         &trieMock.TrieStub{},
+					string(dataTrieRootHash): &trieMock.TrieStub{},
+				}, nil
+			},
+		}
+		args.ActiveAccountsDBs[state.PeerAccountsState] = &stateMock.AccountsStub{
+			RecreateAllTriesCalled: func(hash []byte) (map[string]common.Trie, error) {
+				require.Equal(t, validatorRootHash, hash)
+				return map[string]common.Trie{
+					string(validatorRootHash): &trieMock.TrieStub{},
+				}, nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("not synced, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		ss, _ := NewSovereignSyncState(createArgsNewSovereignSyncState())
+
+		header, err := ss.GetEpochStartMetaBlock()
+		require.Equal(t, update.ErrNotSynced, err)
+		require.Nil(t, header)
+
+		tries, err := ss.GetAllTries()
+		require.Equal(t, update.ErrNotSynced, err)
+		require.Nil(t, tries)
+
+		extendedHdrs, err := ss.GetLastFinalizedExtendedHeaders()
+		require.Equal(t, update.ErrNotSynced, err)
+		require.Nil(t, extendedHdrs)
+	})
+	t.Run("missing epoch start header, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		ss, _ := NewSovereignSyncState(createArgsNewSovereignSyncState())
+
+		err := ss.SyncAllState(epoch)
+		require.NotNil(t, err)
+
+		_, err = ss.GetEpochStartMetaBlock()
+		require.Equal(t, update.ErrNotSynced, err)
+	})
+	t.Run("recreate tries error, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("recreate error")
+		args := createArgs(t, createSovereignHeader(nil))
+		args.ActiveAccountsDBs[state.PeerAccountsState] = &stateMock.AccountsStub{
+			RecreateAllTriesCalled: func(_ []byte) (map[string]common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		ss, _ := NewSovereignSyncState(args)
+
+		err := ss.SyncAllState(epoch)
+		require.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("missing last finalized extended header, should return error", func(t *testing.T) {
+		t.Parallel()
+
+		ss, _ := NewSovereignSyncState(createArgs(t, createSovereignHeader([]byte("missingHash"))))
+
+		err := ss.SyncAllState(epoch)
+		require.NotNil(t, err)
+	})
+	t.Run("no finalized extended header, should work", func(t *testing.T) {
+		t.Parallel()
+
+		ss, _ := NewSovereignSyncState(createArgs(t, createSovereignHeader(nil)))
+
+		err := ss.SyncAllState(epoch)
+		require.Nil(t, err)
+
+		extendedHdrs, err := ss.GetLastFinalizedExtendedHeaders()
+		require.Nil(t, err)
+		require.Empty(t, extendedHdrs)
+	})
+	t.Run("should get the last finalized extended headers of the other incoming chains from bootstrap data", func(t *testing.T) {
+		t.Parallel()
+
+		incomingChainShardID := core.MainChainShardId - 1
+		incomingChainHeaderHash := []byte("incomingChainHeaderHash")
+		incomingChainHeader := &block.ShardHeaderExtended{
+			Header: &block.HeaderV2{
+				Header: &block.Header{
+					Nonce: 9,
+				},
+			},
+		}
+
+		sovereignHeader := createSovereignHeader(extendedHeaderHash)
+		args := createArgs(t, sovereignHeader)
+		incomingChainHeaderBuff, err := args.Marshaller.Marshal(incomingChainHeader)
+		require.Nil(t, err)
+		err = args.StorageService.Put(dataRetriever.ExtendedShardHeadersUnit, incomingChainHeaderHash, incomingChainHeaderBuff)
+		require.Nil(t, err)
+
+		bootUnit, _ := args.StorageService.GetStorer(dataRetriever.BootstrapUnit)
+		bootStorer, _ := bootstrapStorage.NewBootstrapStorer(args.Marshaller, bootUnit)
+		err = bootStorer.Put(int64(sovereignHeader.GetRound()), bootstrapStorage.BootstrapData{
+			LastCrossNotarizedHeaders: []bootstrapStorage.BootstrapHeaderInfo{
+				{ShardId: core.MainChainShardId, Nonce: 7, Hash: extendedHeaderHash},
+				{ShardId: incomingChainShardID, Nonce: 9, Hash: incomingChainHeaderHash},
+				{ShardId: incomingChainShardID - 1, Nonce: 0, Hash: []byte("genesisHash")},
+			},
+		})
+		require.Nil(t, err)
+
+		ss, _ := NewSovereignSyncState(args)
+		err = ss.SyncAllState(epoch)
+		require.Nil(t, err)
+
+		extendedHdrs, err := ss.GetLastFinalizedExtendedHeaders()
+		require.Nil(t, err)
+		require.Equal(t, map[uint32]data.ShardHeaderExtendedHandler{
+			core.MainChainShardId: extendedHeader,
+			incomingChainShardID:  incomingChainHeader,
+		}, extendedHdrs)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sovereignHeader := createSovereignHeader(extendedHeaderHash)
+		ss, _ := NewSovereignSyncState(createArgs(t, sovereignHeader))
+
+		err := ss.SyncAllState(epoch)
+		require.Nil(t, err)
+
+		header, err := ss.GetEpochStartMetaBlock()
+		require.Nil(t, err)
+		require.Equal(t, sovereignHeader, header)
+
+		extendedHdrs, err := ss.GetLastFinalizedExtendedHeaders()
+		require.Nil(t, err)
+		require.Equal(t, map[uint32]data.ShardHeaderExtendedHandler{
+			core.MainChainShardId: extendedHeader,
+		}, extendedHdrs)
+
+		tries, err := ss.GetAllTries()
+		require.Nil(t, err)
+		require.Len(t, tries, 3)
+		require.Contains(t, tries, genesis.CreateTrieIdentifier(core.SovereignChainShardId, genesis.UserAccount))
+		require.Contains(t, tries, genesis.CreateTrieIdentifier(core.SovereignChainShardId, genesis.ValidatorAccount))
+		dataTrieIdentifier := genesis.CreateTrieIdentifier(core.SovereignChainShardId, genesis.DataTrie)
+		require.Contains(t, tries, genesis.AddRootHashToIdentifier(dataTrieIdentifier, string(dataTrieRootHash)))
+	})
+}
@@ -279,3 +279,12 @@ var ErrWaitingListDisabled = errors.New("waiting list is disabled since staking
 
 // ErrCannotChangeToDynamic signals that tokenID cannot be change to type dynamic
 var ErrCannotChangeToDynamic = errors.New("cannot change to dynamic because of duplicated roles")
+
+// ErrNilHeader signals that a nil header was provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrInvalidChainID signals that an invalid chain ID was provided
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidShardID signals that an invalid shard ID was provided
+var ErrInvalidShardID = errors.New("invalid shard ID")
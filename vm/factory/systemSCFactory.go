@@ -32,6 +32,7 @@ type systemSCFactory struct {
 	shardCoordinator       sharding.Coordinator
 	enableEpochsHandler    common.EnableEpochsHandler
 	nodesCoordinator       vm.NodesCoordinator
+	chainID                []byte
 }
 
 // ArgsNewSystemSCFactory defines the arguments struct needed to create the system SCs
@@ -48,6 +49,7 @@ type ArgsNewSystemSCFactory struct {
 	ShardCoordinator       sharding.Coordinator
 	EnableEpochsHandler    common.EnableEpochsHandler
 	NodesCoordinator       vm.NodesCoordinator
+	ChainID                []byte
 }
 
 // NewSystemSCFactory creates a factory which will instantiate the system smart contracts
@@ -98,6 +100,7 @@ func NewSystemSCFactory(args ArgsNewSystemSCFactory) (*systemSCFactory, error) {
 		shardCoordinator:       args.ShardCoordinator,
 		enableEpochsHandler:    args.EnableEpochsHandler,
 		nodesCoordinator:       args.NodesCoordinator,
+		chainID:                args.ChainID,
 	}
 
 	err := scf.createGasConfig(args.GasSchedule.LatestGasSchedule())
@@ -197,6 +200,7 @@ func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, e
 		ValidatorSCAddress:     vm.ValidatorSCAddress,
 		GasCost:                scf.gasCost,
 		Marshalizer:            scf.marshalizer,
+		Hasher:                 scf.hasher,
 		GenesisTotalSupply:     scf.economics.GenesisTotalSupply(),
 		MinDeposit:             scf.systemSCConfig.DelegationManagerSystemSCConfig.MinCreationDeposit,
 		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
@@ -204,6 +208,7 @@ func (scf *systemSCFactory) createValidatorContract() (vm.SystemSmartContract, e
 		ShardCoordinator:       scf.shardCoordinator,
 		EnableEpochsHandler:    scf.enableEpochsHandler,
 		NodesCoordinator:       scf.nodesCoordinator,
+		ChainID:                scf.chainID,
 	}
 	validatorSC, err := systemSmartContracts.NewValidatorSmartContract(args)
 	return validatorSC, err
@@ -87,6 +87,7 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["ValidatorToDelegation"] = value
 	gasMap["GetActiveFund"] = value
 	gasMap["FixWaitingListSize"] = value
+	gasMap["SubmitEquivocationProof"] = value
 
 	return gasMap
 }
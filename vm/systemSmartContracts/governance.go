@@ -3,6 +3,7 @@ package systemSmartContracts
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sync"
@@ -25,6 +26,7 @@ const yesString = "yes"
 const noString = "no"
 const vetoString = "veto"
 const abstainString = "abstain"
+const bridgePauseProposalPrefix = "bp_"
 const commitHashLength = 40
 const maxPercentage = float64(10000.0)
 
@@ -165,6 +167,17 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.claimAccumulatedFees(args)
 	}
 
+	if g.isBridgePauseEnabled() {
+		switch args.Function {
+		case "proposeBridgePause":
+			return g.proposeBridgePause(args)
+		case "emergencyBridgePause":
+			return g.emergencyBridgePause(args)
+		case "viewBridgePause":
+			return g.viewBridgePause(args)
+		}
+	}
+
 	g.eei.AddReturnMessage("invalid method to call")
 	return vmcommon.FunctionNotFound
 }
@@ -293,6 +306,17 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		g.eei.AddReturnMessage("invalid number of arguments, expected 3")
 		return vmcommon.FunctionWrongSignature
 	}
+
+	return g.createProposal(args, args.Arguments[0], args.Arguments[1], args.Arguments[2])
+}
+
+// createProposal saves a new proposal with the provided commit hash, to be voted between the provided epochs
+func (g *governanceContract) createProposal(
+	args *vmcommon.ContractCallInput,
+	commitHash []byte,
+	argStartVoteEpoch []byte,
+	argEndVoteEpoch []byte,
+) vmcommon.ReturnCode {
 	generalConfig, err := g.getConfig()
 	if err != nil {
 		g.eei.AddReturnMessage(err.Error())
@@ -311,7 +335,6 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		return vmcommon.UserError
 	}
 
-	commitHash := args.Arguments[0]
 	if len(commitHash) != commitHashLength {
 		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", commitHashLength))
 		return vmcommon.UserError
@@ -321,7 +344,7 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		return vmcommon.UserError
 	}
 
-	startVoteEpoch, endVoteEpoch, err := g.startEndEpochFromArguments(args.Arguments[1], args.Arguments[2])
+	startVoteEpoch, endVoteEpoch, err := g.startEndEpochFromArguments(argStartVoteEpoch, argEndVoteEpoch)
 	if err != nil {
 		g.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
@@ -354,7 +377,7 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 	logEntry := &vmcommon.LogEntry{
 		Identifier: []byte(args.Function),
 		Address:    args.CallerAddr,
-		Topics:     [][]byte{nonceAsBytes, commitHash, args.Arguments[1], args.Arguments[2]},
+		Topics:     [][]byte{nonceAsBytes, commitHash, argStartVoteEpoch, argEndVoteEpoch},
 	}
 	g.eei.AddLogEntry(logEntry)
 
@@ -642,6 +665,8 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 		return vmcommon.UserError
 	}
 
+	g.applyBridgePauseProposal(generalProposal)
+
 	tokensToReturn := big.NewInt(0).Set(generalProposal.ProposalCost)
 	if !generalProposal.Passed {
 		tokensToReturn.Sub(tokensToReturn, baseConfig.LostProposalFee)
@@ -701,6 +726,210 @@ func (g *governanceContract) claimAccumulatedFees(args *vmcommon.ContractCallInp
 	return vmcommon.Ok
 }
 
+// proposeBridgePause creates a proposal to pause or resume the sovereign bridge, separately for each direction. The
+// proposal is voted as any other proposal, while the pause flags are applied only when it is closed, if it passed. While
+// the incoming bridge is paused, no incoming transactions are included in blocks, while if the outgoing bridge is
+// paused, the outgoing operations are held back and no longer signed or sent to the main chain.
+//
+//	args.Arguments[0] - incoming paused - "true" or "false"
+//	args.Arguments[1] - outgoing paused - "true" or "false"
+//	args.Arguments[2] - start vote epoch
+//	args.Arguments[3] - end vote epoch
+func (g *governanceContract) proposeBridgePause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 4 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 4")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	incomingPaused, err := parseBoolArgument(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error() + " for incoming paused")
+		return vmcommon.UserError
+	}
+	outGoingPaused, err := parseBoolArgument(args.Arguments[1])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error() + " for outgoing paused")
+		return vmcommon.UserError
+	}
+
+	generalConfig, err := g.getConfig()
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	nextNonce := generalConfig.LastProposalNonce + 1
+	commitHash := g.createBridgePauseCommitHash(nextNonce, incomingPaused, outGoingPaused)
+	returnCode := g.createProposal(args, commitHash, args.Arguments[2], args.Arguments[3])
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	g.eei.SetStorage(createBridgePauseProposalKey(nextNonce), []byte{boolToByte(incomingPaused), boolToByte(outGoingPaused)})
+
+	return vmcommon.Ok
+}
+
+// createBridgePauseCommitHash creates a unique identifier of a bridge pause proposal, having the same length as the
+// commit hash of any other proposal
+func (g *governanceContract) createBridgePauseCommitHash(nonce uint64, incomingPaused bool, outGoingPaused bool) []byte {
+	proposalData := fmt.Sprintf("bridgePause@%d@%t@%t", nonce, incomingPaused, outGoingPaused)
+	commitHash := hex.EncodeToString(g.hasher.Compute(proposalData))
+
+	return []byte(commitHash[:commitHashLength])
+}
+
+func createBridgePauseProposalKey(nonce uint64) []byte {
+	return append([]byte(bridgePauseProposalPrefix), big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+// applyBridgePauseProposal sets the bridge pause flags of a closed bridge pause proposal, if it passed. The pause flags
+// of the proposal are removed, so that they are applied only once.
+func (g *governanceContract) applyBridgePauseProposal(generalProposal *GeneralProposal) {
+	if !g.isBridgePauseEnabled() {
+		return
+	}
+
+	proposalKey := createBridgePauseProposalKey(generalProposal.Nonce)
+	pauseFlags := g.eei.GetStorage(proposalKey)
+	if len(pauseFlags) != 2 {
+		return
+	}
+
+	g.eei.SetStorage(proposalKey, nil)
+	if !generalProposal.Passed {
+		return
+	}
+
+	incomingPaused := pauseFlags[0] == 1
+	outGoingPaused := pauseFlags[1] == 1
+	g.eei.SetStorage([]byte(common.BridgeIncomingPausedKey), pauseFlagToStorageValue(incomingPaused))
+	g.eei.SetStorage([]byte(common.BridgeOutGoingPausedKey), pauseFlagToStorageValue(outGoingPaused))
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte("bridgePause"),
+		Address:    g.governanceSCAddress,
+		Topics: [][]byte{
+			big.NewInt(0).SetUint64(generalProposal.Nonce).Bytes(),
+			boolToSlice(incomingPaused),
+			boolToSlice(outGoingPaused),
+		},
+	}
+	g.eei.AddLogEntry(logEntry)
+}
+
+// emergencyBridgePause immediately pauses the sovereign bridge, separately for each direction, without a proposal. It can
+// only be called by the owner of the contract (e.g. a multisig), so that the bridge can be stopped as soon as an incident
+// is detected. It can only pause the bridge: a "false" argument keeps the current flag of that direction, while resuming
+// the bridge still has to pass a proposeBridgePause vote.
+//
+//	args.Arguments[0] - pause incoming - "true" or "false"
+//	args.Arguments[1] - pause outgoing - "true" or "false"
+func (g *governanceContract) emergencyBridgePause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, g.ownerAddress) {
+		g.eei.AddReturnMessage("emergencyBridgePause can be called only by owner")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("callValue expected to be 0")
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.CloseProposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 2")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	pauseIncoming, err := parseBoolArgument(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error() + " for incoming paused")
+		return vmcommon.UserError
+	}
+	pauseOutGoing, err := parseBoolArgument(args.Arguments[1])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error() + " for outgoing paused")
+		return vmcommon.UserError
+	}
+
+	if pauseIncoming {
+		g.eei.SetStorage([]byte(common.BridgeIncomingPausedKey), pauseFlagToStorageValue(true))
+	}
+	if pauseOutGoing {
+		g.eei.SetStorage([]byte(common.BridgeOutGoingPausedKey), pauseFlagToStorageValue(true))
+	}
+
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte("emergencyBridgePause"),
+		Address:    g.governanceSCAddress,
+		Topics: [][]byte{
+			args.CallerAddr,
+			boolToSlice(pauseIncoming),
+			boolToSlice(pauseOutGoing),
+		},
+	}
+	g.eei.AddLogEntry(logEntry)
+
+	return vmcommon.Ok
+}
+
+// viewBridgePause returns the incoming and outgoing bridge pause flags
+func (g *governanceContract) viewBridgePause(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	err := g.checkViewFuncArguments(args, 0)
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(boolToSlice(len(g.eei.GetStorage([]byte(common.BridgeIncomingPausedKey))) > 0))
+	g.eei.Finish(boolToSlice(len(g.eei.GetStorage([]byte(common.BridgeOutGoingPausedKey))) > 0))
+
+	return vmcommon.Ok
+}
+
+// isBridgePauseEnabled returns true if the bridge pause proposals are activated. The flag is defined only for sovereign
+// chains.
+func (g *governanceContract) isBridgePauseEnabled() bool {
+	return g.enableEpochsHandler.IsFlagDefined(common.SovereignBridgePauseFlag) &&
+		g.enableEpochsHandler.IsFlagEnabled(common.SovereignBridgePauseFlag)
+}
+
+func parseBoolArgument(arg []byte) (bool, error) {
+	switch string(arg) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, vm.ErrInvalidArgument
+	}
+}
+
+func boolToByte(value bool) byte {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+// pauseFlagToStorageValue returns an empty value for a resumed bridge, so that the storage entry is removed
+func pauseFlagToStorageValue(paused bool) []byte {
+	if !paused {
+		return nil
+	}
+
+	return []byte{1}
+}
+
 // viewVotingPower returns the total voting power
 func (g *governanceContract) viewVotingPower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	err := g.checkViewFuncArguments(args, 1)
@@ -2008,3 +2008,178 @@ func TestGovernanceContract_ClaimAccumulatedFees(t *testing.T) {
 
 	require.Equal(t, big.NewInt(0), gsc.getAccumulatedFees())
 }
+
+func TestGovernanceContract_EmergencyBridgePause(t *testing.T) {
+	t.Parallel()
+
+	t.Run("flag not active, should not find the function", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+
+		callInput := createVMInput(big.NewInt(0), "emergencyBridgePause", gsc.ownerAddress, vm.GovernanceSCAddress, [][]byte{[]byte("true"), []byte("true")})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode)
+		require.Equal(t, "invalid method to call", eei.GetReturnMessage())
+	})
+	t.Run("invalid calls, should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.SovereignBridgePauseFlag)
+
+		callInput := createVMInput(big.NewInt(0), "emergencyBridgePause", bytes.Repeat([]byte{2}, 32), vm.GovernanceSCAddress, [][]byte{[]byte("true"), []byte("true")})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Equal(t, "emergencyBridgePause can be called only by owner", eei.GetReturnMessage())
+
+		callInput.CallerAddr = gsc.ownerAddress
+		callInput.CallValue = big.NewInt(1)
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+
+		callInput.CallValue = big.NewInt(0)
+		callInput.Arguments = [][]byte{[]byte("true")}
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+		callInput.Arguments = [][]byte{[]byte("yes"), []byte("true")}
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.True(t, strings.Contains(eei.GetReturnMessage(), vm.ErrInvalidArgument.Error()+" for incoming paused"))
+		require.Empty(t, eei.GetStorage([]byte(common.BridgeIncomingPausedKey)))
+		require.Empty(t, eei.GetStorage([]byte(common.BridgeOutGoingPausedKey)))
+	})
+	t.Run("owner should pause immediately, but not resume", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.SovereignBridgePauseFlag)
+
+		callInput := createVMInput(big.NewInt(0), "emergencyBridgePause", gsc.ownerAddress, vm.GovernanceSCAddress, [][]byte{[]byte("false"), []byte("true")})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+		require.Empty(t, eei.GetStorage([]byte(common.BridgeIncomingPausedKey)))
+		require.Equal(t, []byte{1}, eei.GetStorage([]byte(common.BridgeOutGoingPausedKey)))
+
+		logs := eei.GetLogs()
+		require.Equal(t, []byte("emergencyBridgePause"), logs[len(logs)-1].Identifier)
+		require.Equal(t, [][]byte{gsc.ownerAddress, []byte("false"), []byte("true")}, logs[len(logs)-1].Topics)
+
+		// a "false" argument should keep the current flag, resuming only passes through a proposal
+		callInput.Arguments = [][]byte{[]byte("true"), []byte("false")}
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+		require.Equal(t, []byte{1}, eei.GetStorage([]byte(common.BridgeIncomingPausedKey)))
+		require.Equal(t, []byte{1}, eei.GetStorage([]byte(common.BridgeOutGoingPausedKey)))
+	})
+}
+
+func TestGovernanceContract_ProposeBridgePause(t *testing.T) {
+	t.Parallel()
+
+	callerAddress := bytes.Repeat([]byte{2}, 32)
+	callInputArgs := [][]byte{[]byte("true"), []byte("false"), big.NewInt(50).Bytes(), big.NewInt(55).Bytes()}
+
+	t.Run("flag not active, should not find the function", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+
+		callInput := createVMInput(big.NewInt(500), "proposeBridgePause", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode)
+		require.Equal(t, "invalid method to call", eei.GetReturnMessage())
+
+		viewInput := createVMInput(big.NewInt(0), "viewBridgePause", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil)
+		retCode = gsc.Execute(viewInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode)
+	})
+	t.Run("invalid arguments, should error", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, _, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.SovereignBridgePauseFlag)
+
+		callInput := createVMInput(big.NewInt(500), "proposeBridgePause", callerAddress, vm.GovernanceSCAddress, callInputArgs[:3])
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+		require.Equal(t, "invalid number of arguments, expected 4", eei.GetReturnMessage())
+
+		callInput.Arguments = [][]byte{[]byte("true"), []byte("1"), big.NewInt(50).Bytes(), big.NewInt(55).Bytes()}
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.True(t, strings.Contains(eei.GetReturnMessage(), vm.ErrInvalidArgument.Error()+" for outgoing paused"))
+
+		callInput.Arguments = callInputArgs
+		callInput.CallValue = big.NewInt(1)
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.OutOfFunds, retCode)
+	})
+	t.Run("passed proposal should set the pause flags when closed", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, blockchainHook, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.SovereignBridgePauseFlag)
+
+		callInput := createVMInput(big.NewInt(500), "proposeBridgePause", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		proposal, err := gsc.getProposalFromNonce(big.NewInt(1))
+		require.Nil(t, err)
+		require.Equal(t, gsc.createBridgePauseCommitHash(1, true, false), proposal.CommitHash)
+		require.Len(t, proposal.CommitHash, commitHashLength)
+
+		currentEpoch := uint32(52)
+		blockchainHook.CurrentEpochCalled = func() uint32 {
+			return currentEpoch
+		}
+
+		callInput = createVMInput(big.NewInt(0), "vote", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes(), []byte("yes")})
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+		require.Empty(t, eei.GetStorage([]byte(common.BridgeIncomingPausedKey)))
+
+		currentEpoch = 56
+		callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+		require.Equal(t, []byte{1}, eei.GetStorage([]byte(common.BridgeIncomingPausedKey)))
+		require.Empty(t, eei.GetStorage([]byte(common.BridgeOutGoingPausedKey)))
+		require.Empty(t, eei.GetStorage(createBridgePauseProposalKey(1)))
+
+		logs := eei.GetLogs()
+		require.Equal(t, []byte("bridgePause"), logs[len(logs)-2].Identifier)
+		require.Equal(t, [][]byte{big.NewInt(1).Bytes(), []byte("true"), []byte("false")}, logs[len(logs)-2].Topics)
+
+		viewInput := createVMInput(big.NewInt(0), "viewBridgePause", vm.GovernanceSCAddress, vm.GovernanceSCAddress, nil)
+		retCode = gsc.Execute(viewInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+		returnData := eei.CreateVMOutput().ReturnData
+		require.Equal(t, [][]byte{[]byte("true"), []byte("false")}, returnData[len(returnData)-2:])
+	})
+	t.Run("rejected proposal should not set the pause flags", func(t *testing.T) {
+		t.Parallel()
+
+		gsc, blockchainHook, eei := createGovernanceBlockChainHookStubContextHandler()
+		gsc.enableEpochsHandler = enableEpochsHandlerMock.NewEnableEpochsHandlerStub(common.GovernanceFlag, common.SovereignBridgePauseFlag)
+
+		callInput := createVMInput(big.NewInt(500), "proposeBridgePause", callerAddress, vm.GovernanceSCAddress, callInputArgs)
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		blockchainHook.CurrentEpochCalled = func() uint32 {
+			return 56
+		}
+
+		callInput = createVMInput(big.NewInt(0), "closeProposal", callerAddress, vm.GovernanceSCAddress, [][]byte{big.NewInt(1).Bytes()})
+		retCode = gsc.Execute(callInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		proposal, _ := gsc.getProposalFromNonce(big.NewInt(1))
+		require.False(t, proposal.Passed)
+		require.Empty(t, eei.GetStorage([]byte(common.BridgeIncomingPausedKey)))
+		require.Empty(t, eei.GetStorage(createBridgePauseProposalKey(1)))
+	})
+}
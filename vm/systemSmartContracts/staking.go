@@ -392,7 +392,10 @@ func (s *stakingSC) unJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 }
 
 func (s *stakingSC) jail(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	if !bytes.Equal(args.CallerAddr, s.jailAccessAddr) {
+	// the validator system smart contract jails the keys for which an equivocation proof was submitted
+	isValidatorSCAllowed := isEquivocationProofsEnabled(s.enableEpochsHandler) && bytes.Equal(args.CallerAddr, s.stakeAccessAddr)
+	isCallerAllowed := bytes.Equal(args.CallerAddr, s.jailAccessAddr) || isValidatorSCAllowed
+	if !isCallerAllowed {
 		return vmcommon.UserError
 	}
 
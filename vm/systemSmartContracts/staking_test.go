@@ -1205,6 +1205,36 @@ func TestStakingSc_StakeJailAndUnJail(t *testing.T) {
 	doUnJail(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, vmcommon.Ok)
 }
 
+func TestStakingSc_JailFromStakeAccessAddress(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	eei := createDefaultEei()
+	eei.blockChainHook = blockChainHook
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerAddress := []byte("stakerAddr")
+	stakerPubKey := []byte("stakerPublicKey")
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, stakerPubKey)
+
+	// the validator system smart contract can jail only after the equivocation proofs are activated
+	doJail(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, vmcommon.UserError)
+
+	// the validator system smart contract jails the keys proven to have equivocated
+	args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub).AddActiveFlags(common.SovereignEquivocationProofsFlag)
+	doJail(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, vmcommon.Ok)
+
+	stakedData, _ := stakingSmartContract.getOrCreateRegisteredData(stakerPubKey)
+	require.True(t, stakedData.Jailed)
+	require.Equal(t, uint32(1), stakedData.NumJailed)
+}
+
 func TestStakingSc_ExecuteStakeStakeJailAndSwitch(t *testing.T) {
 	t.Parallel()
 
@@ -11,6 +11,8 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
@@ -21,6 +23,8 @@ import (
 
 const unJailedFunds = "unJailFunds"
 const unStakeUnBondPauseKey = "unStakeUnBondPause"
+const equivocationProofPrefix = "equivocationProof"
+const numEquivocationProofArguments = 5
 const minPercentage = 0.0001
 const numberOfNodesTooHigh = "number of nodes too high, no new nodes activated"
 
@@ -45,6 +49,7 @@ type validatorSC struct {
 	walletAddressLen       int
 	gasCost                vm.GasCost
 	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
 	minUnstakeTokensValue  *big.Int
 	minDeposit             *big.Int
 	mutExecution           sync.RWMutex
@@ -56,6 +61,7 @@ type validatorSC struct {
 	nodesCoordinator       vm.NodesCoordinator
 	totalStakeLimit        *big.Int
 	nodeLimitPercentage    float64
+	chainID                []byte
 }
 
 // ArgsValidatorSmartContract is the arguments structure to create a new ValidatorSmartContract
@@ -68,6 +74,7 @@ type ArgsValidatorSmartContract struct {
 	ValidatorSCAddress     []byte
 	GasCost                vm.GasCost
 	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
 	EndOfEpochAddress      []byte
 	MinDeposit             string
 	DelegationMgrSCAddress []byte
@@ -75,6 +82,7 @@ type ArgsValidatorSmartContract struct {
 	ShardCoordinator       sharding.Coordinator
 	EnableEpochsHandler    common.EnableEpochsHandler
 	NodesCoordinator       vm.NodesCoordinator
+	ChainID                []byte
 }
 
 // NewValidatorSmartContract creates an validator smart contract
@@ -93,6 +101,9 @@ func NewValidatorSmartContract(
 	if check.IfNil(args.Marshalizer) {
 		return nil, fmt.Errorf("%w in validatorSC", vm.ErrNilMarshalizer)
 	}
+	if check.IfNil(args.Hasher) {
+		return nil, fmt.Errorf("%w in validatorSC", vm.ErrNilHasher)
+	}
 	if check.IfNil(args.SigVerifier) {
 		return nil, fmt.Errorf("%w in validatorSC", vm.ErrNilMessageSignVerifier)
 	}
@@ -176,6 +187,7 @@ func NewValidatorSmartContract(
 		validatorSCAddress:     args.ValidatorSCAddress,
 		gasCost:                args.GasCost,
 		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
 		minUnstakeTokensValue:  minUnstakeTokensValue,
 		walletAddressLen:       len(args.ValidatorSCAddress),
 		endOfEpochAddress:      args.EndOfEpochAddress,
@@ -186,6 +198,7 @@ func NewValidatorSmartContract(
 		enableEpochsHandler:    args.EnableEpochsHandler,
 		nodeLimitPercentage:    args.StakingSCConfig.NodeLimitPercentage,
 		nodesCoordinator:       args.NodesCoordinator,
+		chainID:                args.ChainID,
 	}
 
 	reg.totalStakeLimit = core.GetIntTrimmedPercentageOfValue(args.GenesisTotalSupply, args.StakingSCConfig.StakeLimitPercentage)
@@ -259,6 +272,8 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.mergeValidatorData(args)
 	case "changeOwnerOfValidatorData":
 		return v.changeOwnerOfValidatorData(args)
+	case "submitEquivocationProof":
+		return v.submitEquivocationProof(args)
 	}
 
 	v.eei.AddReturnMessage("invalid method to call")
@@ -421,6 +436,103 @@ func (v *validatorSC) unJail(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 	return vmcommon.Ok
 }
 
+// submitEquivocationProof jails a BLS key which signed two different headers of the local chain in the same round. The
+// arguments are the BLS key followed by each of the two headers and the signature share of the key over the header hash
+func (v *validatorSC) submitEquivocationProof(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !isEquivocationProofsEnabled(v.enableEpochsHandler) {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != numEquivocationProofArguments {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected %d, got %d", numEquivocationProofArguments, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.SubmitEquivocationProof)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	blsKey := args.Arguments[0]
+	firstHeader, firstHash, err := v.getSignedHeader(blsKey, args.Arguments[1], args.Arguments[2])
+	if err != nil {
+		v.eei.AddReturnMessage("invalid first header: " + err.Error())
+		return vmcommon.UserError
+	}
+	secondHeader, secondHash, err := v.getSignedHeader(blsKey, args.Arguments[3], args.Arguments[4])
+	if err != nil {
+		v.eei.AddReturnMessage("invalid second header: " + err.Error())
+		return vmcommon.UserError
+	}
+	if bytes.Equal(firstHash, secondHash) {
+		v.eei.AddReturnMessage("the signed headers are the same")
+		return vmcommon.UserError
+	}
+	if firstHeader.GetRound() != secondHeader.GetRound() {
+		v.eei.AddReturnMessage("the signed headers are not from the same round")
+		return vmcommon.UserError
+	}
+
+	proofKey := createEquivocationProofKey(blsKey, firstHeader.GetRound())
+	if len(v.eei.GetStorage(proofKey)) > 0 {
+		v.eei.AddReturnMessage("equivocation proof already submitted")
+		return vmcommon.UserError
+	}
+
+	vmOutput, err := v.executeOnStakingSC([]byte("jail@" + hex.EncodeToString(blsKey)))
+	if err != nil {
+		v.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	v.eei.SetStorage(proofKey, []byte{1})
+
+	return vmcommon.Ok
+}
+
+func (v *validatorSC) getSignedHeader(blsKey []byte, marshalledHeader []byte, signatureShare []byte) (*block.SovereignChainHeader, []byte, error) {
+	header := &block.SovereignChainHeader{}
+	err := v.marshalizer.Unmarshal(header, marshalledHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.GetHeader() == nil {
+		return nil, nil, vm.ErrNilHeader
+	}
+	if !bytes.Equal(header.GetChainID(), v.chainID) {
+		return nil, nil, fmt.Errorf("%w, expected %s, got %s", vm.ErrInvalidChainID, v.chainID, header.GetChainID())
+	}
+	if header.GetShardID() != v.shardCoordinator.SelfId() {
+		return nil, nil, fmt.Errorf("%w, expected %d, got %d", vm.ErrInvalidShardID, v.shardCoordinator.SelfId(), header.GetShardID())
+	}
+
+	headerHash := v.hasher.Compute(string(marshalledHeader))
+	err = v.sigVerifier.Verify(headerHash, signatureShare, blsKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, headerHash, nil
+}
+
+// isEquivocationProofsEnabled returns true if the equivocation proofs are accepted. The flag is defined only for
+// sovereign chains.
+func isEquivocationProofsEnabled(enableEpochsHandler common.EnableEpochsHandler) bool {
+	return enableEpochsHandler.IsFlagDefined(common.SovereignEquivocationProofsFlag) &&
+		enableEpochsHandler.IsFlagEnabled(common.SovereignEquivocationProofsFlag)
+}
+
+func createEquivocationProofKey(blsKey []byte, round uint64) []byte {
+	return []byte(fmt.Sprintf("%s_%s_%d", equivocationProofPrefix, hex.EncodeToString(blsKey), round))
+}
+
 func (v *validatorSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if args.CallValue.Cmp(zero) != 0 {
 		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
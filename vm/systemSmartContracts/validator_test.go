@@ -14,11 +14,13 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/testscommon/enableEpochsHandlerMock"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/multiversx/mx-chain-go/vm"
 	"github.com/multiversx/mx-chain-go/vm/mock"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -55,6 +57,7 @@ func createMockArgumentsForValidatorSCWithSystemScAddresses(
 			NodeLimitPercentage:                  100.0,
 		},
 		Marshalizer:            &mock.MarshalizerMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
 		GenesisTotalSupply:     big.NewInt(100000000),
 		MinDeposit:             "0",
 		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
@@ -283,6 +286,17 @@ func TestNewStakingValidatorSmartContract_NilMarshalizer(t *testing.T) {
 	assert.True(t, errors.Is(err, vm.ErrNilMarshalizer))
 }
 
+func TestNewStakingValidatorSmartContract_NilHasher(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArgumentsForValidatorSC()
+	arguments.Hasher = nil
+
+	asc, err := NewValidatorSmartContract(arguments)
+	require.Nil(t, asc)
+	assert.True(t, errors.Is(err, vm.ErrNilHasher))
+}
+
 func TestNewStakingValidatorSmartContract_InvalidGenesisTotalSupply(t *testing.T) {
 	t.Parallel()
 
@@ -724,6 +738,251 @@ func TestStakingValidatorSC_ExecuteStakeUnJail(t *testing.T) {
 	assert.Equal(t, vmcommon.UserError, retCode)
 }
 
+func createValidatorSCWithStakedKey(t *testing.T, stakerPubKey []byte, sigVerifier vm.MessageSignVerifier) (*validatorSC, *stakingSC) {
+	args := createMockArgumentsForValidatorSC()
+	args.SigVerifier = sigVerifier
+	args.ChainID = equivocationProofsChainID
+	args.ShardCoordinator = &mock.ShardCoordinatorStub{
+		SelfIdCalled: func() uint32 {
+			return core.SovereignChainShardId
+		},
+	}
+	args.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub).AddActiveFlags(common.SovereignEquivocationProofsFlag)
+
+	eei := createDefaultEei()
+	eei.inputParser = parsers.NewCallArgsParser()
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = "10000000"
+	argsStaking.EnableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub).AddActiveFlags(common.SovereignEquivocationProofsFlag)
+	argsStaking.Eei = eei
+	stakingSc, _ := NewStakingSmartContract(argsStaking)
+
+	eei.SetSCAddress([]byte("addr"))
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return stakingSc, nil
+	}})
+
+	args.Eei = eei
+	args.StakingSCConfig = argsStaking.StakingSCConfig
+	sc, _ := NewValidatorSmartContract(args)
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = []byte("address")
+	arguments.Arguments = [][]byte{big.NewInt(1).Bytes(), stakerPubKey, []byte("signed")}
+	arguments.CallValue = big.NewInt(10000000)
+	retCode := sc.Execute(arguments)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	return sc, stakingSc
+}
+
+func getStakedDataFromStakingSC(stakingSc *stakingSC, stakingSCAddress []byte, blsKey []byte) *StakedDataV2_0 {
+	eei := stakingSc.eei.(*vmContext)
+	currentSCAddress := eei.scAddress
+	eei.SetSCAddress(stakingSCAddress)
+	stakedData, _ := stakingSc.getOrCreateRegisteredData(blsKey)
+	eei.SetSCAddress(currentSCAddress)
+
+	return stakedData
+}
+
+var equivocationProofsChainID = []byte("chainID")
+
+func createEquivocationProofHeader(round uint64, nonce uint64) *block.SovereignChainHeader {
+	return &block.SovereignChainHeader{
+		Header: &block.Header{
+			ChainID: equivocationProofsChainID,
+			ShardID: core.SovereignChainShardId,
+			Round:   round,
+			Nonce:   nonce,
+		},
+	}
+}
+
+func createSubmitEquivocationProofArguments(marshaller marshal.Marshalizer, blsKey []byte, firstRound uint64, secondRound uint64) *vmcommon.ContractCallInput {
+	firstHeader, _ := marshaller.Marshal(createEquivocationProofHeader(firstRound, 1))
+	secondHeader, _ := marshaller.Marshal(createEquivocationProofHeader(secondRound, 2))
+
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "submitEquivocationProof"
+	arguments.CallerAddr = []byte("reporter")
+	arguments.Arguments = [][]byte{blsKey, firstHeader, []byte("firstShare"), secondHeader, []byte("secondShare")}
+
+	return arguments
+}
+
+func TestStakingValidatorSC_ExecuteSubmitEquivocationProof(t *testing.T) {
+	t.Parallel()
+
+	stakerPubKey := []byte("blsPubKey")
+
+	t.Run("flag not active, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, stakingSc := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		sc.enableEpochsHandler.(*enableEpochsHandlerMock.EnableEpochsHandlerStub).RemoveActiveFlags(common.SovereignEquivocationProofsFlag)
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Equal(t, "invalid method to call", sc.eei.(*vmContext).returnMessage)
+
+		stakedData := getStakedDataFromStakingSC(stakingSc, sc.stakingSCAddress, stakerPubKey)
+		require.False(t, stakedData.Jailed)
+	})
+	t.Run("invalid number of arguments, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, _ := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+		arguments.Arguments = arguments.Arguments[:4]
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+	})
+	t.Run("call value not zero, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, _ := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+		arguments.CallValue = big.NewInt(1)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+	})
+	t.Run("invalid signature share, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sigVerifier := &mock.MessageSignVerifierMock{
+			VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+				if bytes.Equal(signedMessage, []byte("secondShare")) {
+					return errors.New("invalid signature")
+				}
+				return nil
+			},
+		}
+		sc, stakingSc := createValidatorSCWithStakedKey(t, stakerPubKey, sigVerifier)
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+
+		stakedData := getStakedDataFromStakingSC(stakingSc, sc.stakingSCAddress, stakerPubKey)
+		require.False(t, stakedData.Jailed)
+	})
+	t.Run("header of another chain, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, stakingSc := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+		otherChainHeader := createEquivocationProofHeader(10, 2)
+		otherChainHeader.Header.ChainID = []byte("otherChainID")
+		arguments.Arguments[3], _ = sc.marshalizer.Marshal(otherChainHeader)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, sc.eei.(*vmContext).returnMessage, vm.ErrInvalidChainID.Error())
+
+		stakedData := getStakedDataFromStakingSC(stakingSc, sc.stakingSCAddress, stakerPubKey)
+		require.False(t, stakedData.Jailed)
+	})
+	t.Run("header of another shard, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, stakingSc := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+		otherShardHeader := createEquivocationProofHeader(10, 1)
+		otherShardHeader.Header.ShardID = core.MetachainShardId
+		arguments.Arguments[1], _ = sc.marshalizer.Marshal(otherShardHeader)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+		require.Contains(t, sc.eei.(*vmContext).returnMessage, vm.ErrInvalidShardID.Error())
+
+		stakedData := getStakedDataFromStakingSC(stakingSc, sc.stakingSCAddress, stakerPubKey)
+		require.False(t, stakedData.Jailed)
+	})
+	t.Run("not enough gas, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, stakingSc := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		sc.gasCost.MetaChainSystemSCsCost.SubmitEquivocationProof = 100
+		sc.eei.(*vmContext).SetGasProvided(99)
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.OutOfGas, retCode)
+
+		stakedData := getStakedDataFromStakingSC(stakingSc, sc.stakingSCAddress, stakerPubKey)
+		require.False(t, stakedData.Jailed)
+	})
+	t.Run("headers from different rounds, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, _ := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 11)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+	})
+	t.Run("same header twice, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, _ := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+		arguments.Arguments[3] = arguments.Arguments[1]
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+	})
+	t.Run("key not staked, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		sc, _ := createValidatorSCWithStakedKey(t, stakerPubKey, &mock.MessageSignVerifierMock{})
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, []byte("otherBlsPubKey"), 10, 10)
+
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+	})
+	t.Run("should verify the shares over the header hashes and jail the key once", func(t *testing.T) {
+		t.Parallel()
+
+		verifiedMessages := make([][]byte, 0)
+		sigVerifier := &mock.MessageSignVerifierMock{
+			VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+				require.Equal(t, stakerPubKey, pubKey)
+				verifiedMessages = append(verifiedMessages, message)
+				return nil
+			},
+		}
+		sc, stakingSc := createValidatorSCWithStakedKey(t, stakerPubKey, sigVerifier)
+		arguments := createSubmitEquivocationProofArguments(sc.marshalizer, stakerPubKey, 10, 10)
+
+		// the staking itself verifies a signature
+		verifiedMessages = make([][]byte, 0)
+		retCode := sc.Execute(arguments)
+		require.Equal(t, vmcommon.Ok, retCode)
+
+		expectedMessages := [][]byte{
+			sc.hasher.Compute(string(arguments.Arguments[1])),
+			sc.hasher.Compute(string(arguments.Arguments[3])),
+		}
+		require.Equal(t, expectedMessages, verifiedMessages)
+
+		stakedData := getStakedDataFromStakingSC(stakingSc, sc.stakingSCAddress, stakerPubKey)
+		require.True(t, stakedData.Jailed)
+		require.Equal(t, uint32(1), stakedData.NumJailed)
+
+		retCode = sc.Execute(arguments)
+		require.Equal(t, vmcommon.UserError, retCode)
+
+		stakedData = getStakedDataFromStakingSC(stakingSc, sc.stakingSCAddress, stakerPubKey)
+		require.Equal(t, uint32(1), stakedData.NumJailed)
+	})
+}
+
 func TestStakingValidatorSC_ExecuteStakeUnStakeOneBlsPubKey(t *testing.T) {
 	t.Parallel()
 